@@ -152,6 +152,8 @@ func (api *PublicDebugAPI) DumpBlock(blockNr rpc.BlockNumber) (state.Dump, error
 	var block *types.Block
 	if blockNr == rpc.LatestBlockNumber {
 		block = api.dac.blockchain.CurrentBlock()
+	} else if blockNr == rpc.FinalizedBlockNumber {
+		block = api.dac.blockchain.CurrentFinalizedBlock()
 	} else {
 		block = api.dac.blockchain.GetBlockByNumber(uint64(blockNr))
 	}
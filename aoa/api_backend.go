@@ -24,6 +24,7 @@ import (
 	aa "github.com/Aurorachain-io/go-aoa/accounts/walletType"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/math"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
 	"github.com/Aurorachain-io/go-aoa/core"
 	"github.com/Aurorachain-io/go-aoa/core/bloombits"
 	"github.com/Aurorachain-io/go-aoa/core/state"
@@ -71,6 +72,9 @@ func (b *DacApiBackend) HeaderByNumber(ctx context.Context, blockNr rpc.BlockNum
 	if blockNr == rpc.LatestBlockNumber {
 		return b.dac.blockchain.CurrentBlock().Header(), nil
 	}
+	if blockNr == rpc.FinalizedBlockNumber {
+		return b.dac.blockchain.CurrentFinalizedBlock().Header(), nil
+	}
 	return b.dac.blockchain.GetHeaderByNumber(uint64(blockNr)), nil
 }
 
@@ -84,6 +88,9 @@ func (b *DacApiBackend) BlockByNumber(ctx context.Context, blockNr rpc.BlockNumb
 	if blockNr == rpc.LatestBlockNumber {
 		return b.dac.blockchain.CurrentBlock(), nil
 	}
+	if blockNr == rpc.FinalizedBlockNumber {
+		return b.dac.blockchain.CurrentFinalizedBlock(), nil
+	}
 	return b.dac.blockchain.GetBlockByNumber(uint64(blockNr)), nil
 }
 
@@ -121,6 +128,10 @@ func (b *DacApiBackend) GetDelegatePoll(block *types.Block) (*map[common.Address
 	return &res, nil
 }
 
+func (b *DacApiBackend) DelegateStateAt(block *types.Block) (*delegatestate.DelegateDB, error) {
+	return b.dac.blockchain.DelegateStateAt(block.DelegateRoot())
+}
+
 func (b *DacApiBackend) GetReceipts(ctx context.Context, blockHash common.Hash) (types.Receipts, error) {
 	return core.GetBlockReceipts(b.dac.chainDb, blockHash, core.GetBlockNumber(b.dac.chainDb, blockHash)), nil
 }
@@ -134,6 +145,9 @@ func (b *DacApiBackend) GetEVM(ctx context.Context, msg core.Message, state *sta
 	vmError := func() error { return nil }
 
 	ctext := core.NewEVMContext(msg, header, b.dac.BlockChain(), nil)
+	if dState, err := b.dac.BlockChain().DelegateStateAt(header.DelegateRoot); err == nil {
+		ctext.Delegates = dState
+	}
 	return vm.NewEVM(ctext, state, b.dac.chainConfig, vmCfg), vmError, nil
 }
 
@@ -231,3 +245,7 @@ func (b *DacApiBackend) IsWatchInnerTxEnable() bool {
 func (b *DacApiBackend) GetInnerTxDb() watch.InnerTxDb {
 	return b.dac.BlockChain().GetInnerTxDb()
 }
+
+func (b *DacApiBackend) IsAssetHolderIndexEnable() bool {
+	return b.dac.config.EnableAssetHolderIndex
+}
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math/big"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -155,6 +156,8 @@ func (api *PrivateDebugAPI) TraceChain(ctx context.Context, start, end rpc.Block
 		from = api.dac.dposMiner.PendingBlock()
 	case rpc.LatestBlockNumber:
 		from = api.dac.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		from = api.dac.blockchain.CurrentFinalizedBlock()
 	default:
 		from = api.dac.blockchain.GetBlockByNumber(uint64(start))
 	}
@@ -163,6 +166,8 @@ func (api *PrivateDebugAPI) TraceChain(ctx context.Context, start, end rpc.Block
 		to = api.dac.dposMiner.PendingBlock()
 	case rpc.LatestBlockNumber:
 		to = api.dac.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		to = api.dac.blockchain.CurrentFinalizedBlock()
 	default:
 		to = api.dac.blockchain.GetBlockByNumber(uint64(end))
 	}
@@ -248,11 +253,13 @@ func (api *PrivateDebugAPI) traceChain(ctx context.Context, start, end *types.Bl
 			// Fetch and execute the next block trace tasks
 			for task := range tasks {
 				signer := types.MakeSigner(api.config, task.block.Number())
+				delegates := api.delegateState(api.dac.blockchain.GetHeader(task.block.ParentHash(), task.block.NumberU64()-1))
 
 				// Trace all the transactions contained within
 				for i, tx := range task.block.Transactions() {
 					msg, _ := tx.AsMessage(signer)
 					vmctx := core.NewEVMContext(msg, task.block.Header(), api.dac.blockchain, nil)
+					vmctx.Delegates = delegates
 
 					res, err := api.traceTx(ctx, msg, vmctx, task.statedb, config)
 					if err != nil {
@@ -430,6 +437,8 @@ func (api *PrivateDebugAPI) TraceBlockByNumber(ctx context.Context, number rpc.B
 		block = api.dac.dposMiner.PendingBlock()
 	case rpc.LatestBlockNumber:
 		block = api.dac.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		block = api.dac.blockchain.CurrentFinalizedBlock()
 	default:
 		block = api.dac.blockchain.GetBlockByNumber(uint64(number))
 	}
@@ -508,11 +517,13 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 		pend.Add(1)
 		go func() {
 			defer pend.Done()
+			delegates := api.delegateState(parent.Header())
 
 			// Fetch and execute the next transaction trace tasks
 			for task := range jobs {
 				msg, _ := txs[task.index].AsMessage(signer)
 				vmctx := core.NewEVMContext(msg, block.Header(), api.dac.blockchain, nil)
+				vmctx.Delegates = delegates
 
 				res, err := api.traceTx(ctx, msg, vmctx, task.statedb, config)
 				if err != nil {
@@ -525,6 +536,7 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 	}
 	// Feed the transactions into the tracers and return
 	var failed error
+	delegates := api.delegateState(parent.Header())
 	for i, tx := range txs {
 		// Send the trace task over for execution
 		jobs <- &txTraceTask{statedb: statedb.Copy(), index: i}
@@ -532,6 +544,7 @@ func (api *PrivateDebugAPI) traceBlock(ctx context.Context, block *types.Block,
 		// Generate the next state snapshot fast without tracing
 		msg, _ := tx.AsMessage(signer)
 		vmctx := core.NewEVMContext(msg, block.Header(), api.dac.blockchain, nil)
+		vmctx.Delegates = delegates
 
 		vmenv := vm.NewEVM(vmctx, statedb, api.config, vm.Config{})
 		if _, _, _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.Gas())); err != nil {
@@ -597,8 +610,9 @@ func (api *PrivateDebugAPI) computeStateDB(block *types.Block, reexec uint64) (*
 			logged = time.Now()
 		}
 		// Retrieve the next block to regenerate and process it
-		if block = api.dac.blockchain.GetBlockByNumber(block.NumberU64() + 1); block == nil {
-			return nil, fmt.Errorf("block #%d not found", block.NumberU64()+1)
+		next := block.NumberU64() + 1
+		if block = api.dac.blockchain.GetBlockByNumber(next); block == nil {
+			return nil, fmt.Errorf("block #%d not found", next)
 		}
 		delegateDB, _ := api.dac.blockchain.DelegateStateAt(block.DelegateRoot())
 		_, _, _, err := api.dac.blockchain.Processor().Process(block, statedb, vm.Config{}, delegateDB)
@@ -656,6 +670,68 @@ func (api *PrivateDebugAPI) TraceTransaction(ctx context.Context, hash common.Ha
 	return api.traceTx(ctx, msg, vmctx, statedb, config)
 }
 
+// TraceCall lets you trace a given aoa_call. It collects the structured logs
+// created during the execution of EVM if the given call was added on top of
+// the provided block, after applying the optional state overrides, and returns
+// them as a JSON object. Unlike aoa_call, the sender is charged for gas only if
+// a gas price is given.
+func (api *PrivateDebugAPI) TraceCall(ctx context.Context, args aoaapi.CallArgs, number rpc.BlockNumber, overrides *aoaapi.StateOverride, config *TraceConfig) (interface{}, error) {
+	// Fetch the block and state that we want to trace the call on
+	var (
+		block   *types.Block
+		statedb *state.StateDB
+		err     error
+	)
+	switch number {
+	case rpc.PendingBlockNumber:
+		// the pending block is only known while this node is producing
+		if block, statedb = api.dac.dposMiner.Pending(); block == nil {
+			block, statedb = api.dac.blockchain.CurrentBlock(), nil
+		}
+	case rpc.LatestBlockNumber:
+		block = api.dac.blockchain.CurrentBlock()
+	case rpc.FinalizedBlockNumber:
+		block = api.dac.blockchain.CurrentFinalizedBlock()
+	default:
+		block = api.dac.blockchain.GetBlockByNumber(uint64(number))
+	}
+	if block == nil {
+		return nil, fmt.Errorf("block #%d not found", number)
+	}
+	if statedb == nil {
+		reexec := defaultTraceReexec
+		if config != nil && config.Reexec != nil {
+			reexec = *config.Reexec
+		}
+		if statedb, err = api.computeStateDB(block, reexec); err != nil {
+			return nil, err
+		}
+	}
+	if err := overrides.Apply(statedb); err != nil {
+		return nil, err
+	}
+	// Assemble the call message and trace it
+	msg := args.ToMessage(api.dac.AccountManager(), new(big.Int))
+	vmctx := core.NewEVMContext(msg, block.Header(), api.dac.blockchain, nil)
+	vmctx.Delegates = api.delegateState(block.Header())
+
+	return api.traceTx(ctx, msg, vmctx, statedb, config)
+}
+
+// delegateState opens the delegate state at header for the DPoS precompile.
+// Tracing doesn't replay the delegate changes of a block, its transactions
+// read the state of the parent. The state is not safe for concurrent use.
+func (api *PrivateDebugAPI) delegateState(header *types.Header) vm.DelegateReader {
+	if header == nil {
+		return nil
+	}
+	dState, err := api.dac.blockchain.DelegateStateAt(header.DelegateRoot)
+	if err != nil {
+		return nil
+	}
+	return dState
+}
+
 // traceTx configures a new tracer according to the provided configuration, and
 // executes the given message in the provided environment. The return value will
 // be tracer dependent.
@@ -674,15 +750,17 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 				return nil, err
 			}
 		}
-		// Constuct the JavaScript tracer to execute with
-		if tracer, err = tracers.New(*config.Tracer); err != nil {
+		// Constuct the native or JavaScript tracer to execute with
+		if native, ok := tracers.NewNative(*config.Tracer); ok {
+			tracer = native
+		} else if tracer, err = tracers.New(*config.Tracer); err != nil {
 			return nil, err
 		}
 		// Handle timeouts and RPC cancellations
 		deadlineCtx, cancel := context.WithTimeout(ctx, timeout)
 		go func() {
 			<-deadlineCtx.Done()
-			tracer.(*tracers.Tracer).Stop(errors.New("execution timeout"))
+			tracer.(interface{ Stop(error) }).Stop(errors.New("execution timeout"))
 		}()
 		defer cancel()
 
@@ -694,7 +772,9 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 	}
 	// Run the transaction with tracing enabled.
 	vmenv := vm.NewEVM(vmctx, statedb, api.config, vm.Config{Debug: true, Tracer: tracer})
-
+	if native, ok := tracer.(tracers.Native); ok {
+		native.CaptureTxStart(vmenv, message)
+	}
 	ret, gas, failed, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.Gas()))
 	if err != nil {
 		return nil, fmt.Errorf("tracing failed: %v", err)
@@ -712,6 +792,10 @@ func (api *PrivateDebugAPI) traceTx(ctx context.Context, message core.Message, v
 	case *tracers.Tracer:
 		return tracer.GetResult()
 
+	case tracers.Native:
+		tracer.CaptureTxEnd(gas)
+		return tracer.GetResult()
+
 	default:
 		panic(fmt.Sprintf("bad tracer type %T", tracer))
 	}
@@ -735,10 +819,12 @@ func (api *PrivateDebugAPI) computeTxEnv(blockHash common.Hash, txIndex int, ree
 	// Recompute transactions up to the target index.
 	signer := types.MakeSigner(api.config, block.Number())
 
+	delegates := api.delegateState(parent.Header())
 	for idx, tx := range block.Transactions() {
 		// Assemble the transaction call message and return if the requested offset
 		msg, _ := tx.AsMessage(signer)
 		context := core.NewEVMContext(msg, block.Header(), api.dac.blockchain, nil)
+		context.Delegates = delegates
 		if idx == txIndex {
 			return msg, context, statedb, nil
 		}
@@ -25,6 +25,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/aoa/gasprice"
 	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"github.com/Aurorachain-io/go-aoa/consensus"
 	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core"
@@ -75,6 +76,8 @@ type Dacchain struct {
 	bloomRequests chan chan *bloombits.Retrieval // Channel receiving bloom data retrieval requests
 	bloomIndexer  *core.ChainIndexer             // Bloom indexer operating during block imports
 
+	assetHolderIndexer *core.ChainIndexer // Asset holder indexer, nil if disabled
+
 	ApiBackend *DacApiBackend
 
 	gasPrice *big.Int
@@ -116,6 +119,10 @@ func New(ctx *node.ServiceContext, config *Config) (*Dacchain, error) {
 	}
 	log.Info("Initialised chain configuration", "config", chainConfig)
 
+	clock := config.Clock
+	if clock == nil {
+		clock = mclock.System{}
+	}
 	dac := &Dacchain{
 		config:         config,
 		chainDb:        chainDb,
@@ -127,7 +134,7 @@ func New(ctx *node.ServiceContext, config *Config) (*Dacchain, error) {
 		gasPrice:       config.GasPrice,
 		bloomRequests:  make(chan chan *bloombits.Retrieval),
 		bloomIndexer:   NewBloomIndexer(chainDb, params.BloomBitsBlocks),
-		dacEngine:      CreateDacchainConsensusEngine(),
+		dacEngine:      dpos.NewWithClock(clock),
 		watcherDb:      watcherDb,
 	}
 
@@ -153,14 +160,18 @@ func New(ctx *node.ServiceContext, config *Config) (*Dacchain, error) {
 		core.WriteChainConfig(chainDb, genesisHash, chainConfig)
 	}
 	dac.bloomIndexer.Start(dac.blockchain)
+	if config.EnableAssetHolderIndex {
+		dac.assetHolderIndexer = core.NewAssetHolderIndexer(chainDb, params.AssetHolderIndexBlocks)
+		dac.assetHolderIndexer.Start(dac.blockchain)
+	}
 
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = ctx.ResolvePath(config.TxPool.Journal)
 	}
 
 	dac.txPool = core.NewTxPool(config.TxPool, dac.chainConfig, dac.blockchain)
-	dac.dposMiner = core.NewDposMiner(dac.chainConfig, dac, dac.dacEngine)
-	dac.dposTaskManager = NewDposTaskManager(ctx, dac.blockchain, dac.accountManager, dac.dposMiner.GetProduceCallback(), dac.dposMiner.GetShuffleHashChan())
+	dac.dposMiner = core.NewDposMiner(dac.chainConfig, dac, dac.dacEngine, clock)
+	dac.dposTaskManager = NewDposTaskManager(ctx, dac.blockchain, dac.accountManager, dac.dposMiner.GetProduceCallback(), dac.dposMiner.GetShuffleHashChan(), clock)
 	if dac.protocolManager, err = NewProtocolManager(dac.chainConfig, config.SyncMode, config.NetworkId, dac.txPool, dac.dacEngine, dac.blockchain, chainDb, dac.dposTaskManager, dac.dposMiner.GetProduceBlockChan(), dac.dposMiner.AddDelegateWalletCallback, dac.dposMiner.GetDelegateWallets()); err != nil {
 		return nil, err
 	}
@@ -310,6 +321,9 @@ func (dacchain *Dacchain) Stop() error {
 		dacchain.stopDbUpgrade()
 	}
 	dacchain.bloomIndexer.Close()
+	if dacchain.assetHolderIndexer != nil {
+		dacchain.assetHolderIndexer.Close()
+	}
 	dacchain.blockchain.Stop()
 	dacchain.protocolManager.Stop()
 	if dacchain.lesServer != nil {
@@ -19,6 +19,7 @@ package aoa
 import (
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"github.com/Aurorachain-io/go-aoa/core"
 	"github.com/Aurorachain-io/go-aoa/aoa/downloader"
 	"github.com/Aurorachain-io/go-aoa/aoa/gasprice"
@@ -94,6 +95,13 @@ type Config struct {
 
 	// Enables Watching internal transactions in a contract call.
 	EnableInterTxWatching bool
+
+	// Enables indexing the holders of every asset.
+	EnableAssetHolderIndex bool
+
+	// Clock drives block production and consensus timing. If nil, the
+	// system clock is used.
+	Clock mclock.Clock `toml:"-"`
 }
 
 type configMarshaling struct {
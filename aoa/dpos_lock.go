@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/crypto/secp256k1"
@@ -54,6 +55,7 @@ type lockManager struct {
 	lockBlock          *pendBlock
 	storeChan          chan *storeSigns
 	signMap            *blocksSignMap
+	clock              mclock.Clock
 }
 
 type pendBlock struct {
@@ -84,12 +86,13 @@ type commmitBlockData struct {
 	rlpEncodeSigns []byte
 }
 
-func newBlockLockManager(insertBlockFunc func(blocks types.Blocks) (int, error), delegateWallets map[string]*ecdsa.PrivateKey) *lockManager {
+func newBlockLockManager(insertBlockFunc func(blocks types.Blocks) (int, error), delegateWallets map[string]*ecdsa.PrivateKey, clock mclock.Clock) *lockManager {
 	// create SimpleBlockPool
 	ctx, cancelFunc := context.WithCancel(context.Background())
-	timingWheel := task.NewTimingWheel(ctx)
+	timingWheel := task.NewTimingWheelWithClock(ctx, clock)
 	lockManager := &lockManager{
 		tw:                 timingWheel,
+		clock:              clock,
 		wheelCtx:           ctx,
 		cancelFunction:     cancelFunc,
 		insertBlockFunc:    insertBlockFunc,
@@ -213,7 +216,7 @@ func (l *lockManager) dealBlockSign(storeSign *storeSigns) {
 			},
 			Ctx: context.Background(),
 		}
-		expireTimeUnix := time.Now().Unix() + int64(blockInterval*10)
+		expireTimeUnix := l.clock.Time().Unix() + int64(blockInterval*10)
 		expireTime := time.Unix(expireTimeUnix, 0)
 		l.tw.AddTimer(expireTime, -1, timeOutTask)
 	} else {
@@ -250,7 +253,7 @@ func (l *lockManager) blockGenerateCallback(signs []types.VoteSign, blockHash st
 	// go l.blockSignCallback(signs, blockHash)
 	if pendingBlock != nil {
 		block := pendingBlock.block
-		log.Info("lockManager|blockGenerateSuccess", "blockNumber", block.NumberU64(), "blockHash", blockHash, "coinbase", block.Coinbase().Hex(), "consensus cost time(s)", time.Now().Unix()-block.Time().Int64())
+		log.Info("lockManager|blockGenerateSuccess", "blockNumber", block.NumberU64(), "blockHash", blockHash, "coinbase", block.Coinbase().Hex(), "consensus cost time(s)", l.clock.Time().Unix()-block.Time().Int64())
 		if strings.EqualFold(block.Hash().Hex(), blockHash) {
 			err := l.newBlockCallback(signs, block)
 			// cancel expire task
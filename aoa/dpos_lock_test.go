@@ -73,7 +73,7 @@ func TestNew(t *testing.T) {
 	}
 	fmt.Println("==============load data success============================")
 
-	dposLockManager := newBlockLockManager(nil, delegateWallets)
+	dposLockManager := newBlockLockManager(nil, delegateWallets, mclock.System{})
 
 	header := &types.Header{
 		ParentHash: common.HexToHash("0xd2e91d3554d254eb6a3db17ea03bc8d2af305eab483a777a23fd7181ba29b563"),
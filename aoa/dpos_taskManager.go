@@ -21,7 +21,9 @@ import (
 	"fmt"
 	"github.com/Aurorachain-io/go-aoa/accounts"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/crypto"
@@ -60,16 +62,16 @@ type DposTaskManager struct {
 	shuffleHashChan         chan *types.ShuffleData // use by produce call back
 	delegateStoredb         aoadb.Database
 	mu                      sync.Mutex
+	clock                   mclock.Clock
+	initTaskBeginTime       int64
+	shuffleCount            int64
 }
 
-var initTaskBeginTime int64
-var shuffleCount int64
-
 var maxElectDelegate int
 var blockInterval int
 var delegateAmount int
 
-func NewDposTaskManager(ctx *node.ServiceContext, blockchain *core.BlockChain, accountManager *accounts.Manager, produceBlockCallback func(ctx context.Context), shuffleHashChan chan *types.ShuffleData) *DposTaskManager {
+func NewDposTaskManager(ctx *node.ServiceContext, blockchain *core.BlockChain, accountManager *accounts.Manager, produceBlockCallback func(ctx context.Context), shuffleHashChan chan *types.ShuffleData, clock mclock.Clock) *DposTaskManager {
 	genesisConfig := blockchain.Config()
 	maxElectDelegate = int(genesisConfig.MaxElectDelegate.Int64())
 	blockInterval = int(genesisConfig.BlockInterval.Int64())
@@ -77,12 +79,13 @@ func NewDposTaskManager(ctx *node.ServiceContext, blockchain *core.BlockChain, a
 	log.Info("NewDposTaskManager", "maxElectDelegate", maxElectDelegate, "blockInterval", blockInterval, "delegateAmount", delegateAmount)
 	taskManager := &DposTaskManager{
 		runningTimeIds:       make([]int64, 0, maxElectDelegate+1),
-		timingWheel:          task.NewTimingWheel(context.Background()),
+		timingWheel:          task.NewTimingWheelWithClock(context.Background(), clock),
 		produceBlockCallback: produceBlockCallback,
 		blockchain:           blockchain,
 		accountManager:       accountManager,
 		shuffleNewRoundChan:  make(chan types.ShuffleList),
 		shuffleHashChan:      shuffleHashChan,
+		clock:                clock,
 	}
 
 	delegateDB, err := createDelegateDB(ctx)
@@ -99,7 +102,7 @@ func NewDposTaskManager(ctx *node.ServiceContext, blockchain *core.BlockChain, a
 			return
 		}
 		// cal shuffle time of current round
-		shuffleTime := initTaskBeginTime + shuffleCount*int64(maxElectDelegate*blockInterval)
+		shuffleTime := taskManager.initTaskBeginTime + taskManager.shuffleCount*int64(maxElectDelegate*blockInterval)
 		exist, candidates := taskManager.checkLocalExistDelegateWhenShuffle(dState)
 		if !exist {
 			log.Info("DposTaskManager| shuffle end because doesn't exist delegate in this node", "blockNumber", currentBlock.NumberU64(), "len", len(candidates))
@@ -117,7 +120,7 @@ func NewDposTaskManager(ctx *node.ServiceContext, blockchain *core.BlockChain, a
 		for _, v := range candidates {
 			log.Info("shuffle candidate", "blockNumber", currentBlock.NumberU64(), "address", v.Address, "vote", v.Vote, "nick", v.Nickname, "registerTime", v.RegisterTime)
 		}
-		shuffleNewRound := util.ShuffleNewRound(shuffleTime, maxElectDelegate, topDelegates, int64(blockInterval))
+		shuffleNewRound := dpos.NewRoundShuffle(taskManager.blockchain, currentBlock.Header(), shuffleTime, topDelegates)
 		shuffleData := types.ShuffleDelegateData{BlockNumber: *currentBlock.Number(), ShuffleTime: *big.NewInt(shuffleTime)}
 		err = taskManager.loadShuffleDataToDB(shuffleData)
 		if err != nil {
@@ -134,7 +137,7 @@ func NewDposTaskManager(ctx *node.ServiceContext, blockchain *core.BlockChain, a
 		taskManager.shuffleHashChan <- &types.ShuffleData{ShuffleHash: &rlpShufflehash, ShuffleBlockNumber: currentBlock.Number()}
 		log.Info("shuffle", "shuffleHash", rlpShufflehash)
 		taskManager.shuffleNewRoundChan <- shuffleList
-		shuffleCount++
+		taskManager.shuffleCount++
 	}
 	taskManager.shuffleCallback = shuffleCallback
 	// init dpos task
@@ -187,10 +190,10 @@ func (taskManager *DposTaskManager) initTask() {
 	onTimeOut := &task.OnTimeOut{Callback: taskManager.shuffleCallback, Ctx: context.Background()}
 
 	genesisTime := taskManager.blockchain.Genesis().Header().Time.Int64()
-	nextRoundBeginTime, _ := generateNextRoundBeginTime(genesisTime)
+	nextRoundBeginTime, _ := generateNextRoundBeginTime(genesisTime, taskManager.clock.Time().Unix())
 	initTimeId := taskManager.timingWheel.AddTimer(time.Unix(nextRoundBeginTime, 0), time.Duration(blockInterval*maxElectDelegate*secondDuration), onTimeOut)
 	log.Info("dposTaskManager", "initTask|beginTime", time.Unix(nextRoundBeginTime, 0), "initTimeId", initTimeId)
-	initTaskBeginTime = nextRoundBeginTime
+	taskManager.initTaskBeginTime = nextRoundBeginTime
 	taskManager.runningTimeIds = append(taskManager.runningTimeIds, initTimeId)
 	//log.Info("dposTaskManager","timeIds",taskManager.runningTimeIds)
 }
@@ -215,8 +218,8 @@ func (taskManager *DposTaskManager) ShuffleWhenVerifyFail(receiveBlockNumber int
 	if len(topDelegates) > maxElectDelegate {
 		topDelegates = topDelegates[:maxElectDelegate]
 	}
-	shuffleTime := util.CalShuffleTimeByHeaderTime(initTaskBeginTime, receiveBlockTime, int64(blockInterval), int64(maxElectDelegate))
-	shuffleNewRound := util.ShuffleNewRound(shuffleTime, maxElectDelegate, topDelegates, int64(blockInterval))
+	shuffleTime := util.CalShuffleTimeByHeaderTime(taskManager.initTaskBeginTime, receiveBlockTime, int64(blockInterval), int64(maxElectDelegate))
+	shuffleNewRound := dpos.NewRoundShuffle(taskManager.blockchain, shuffleBlock.Header(), shuffleTime, topDelegates)
 	log.Info("dposTaskManager|verifyFail|shuffleEnd", "shuffleTime", shuffleTime, "blockNumber", shuffleBlock.NumberU64(), "lenCandidates", len(topDelegates), "result", shuffleNewRound)
 	shuffleData := types.ShuffleDelegateData{BlockNumber: *shuffleBlock.Number(), ShuffleTime: *big.NewInt(shuffleTime)}
 	err = taskManager.loadShuffleDataToDB(shuffleData)
@@ -232,7 +235,7 @@ func (taskManager *DposTaskManager) ShuffleWhenVerifyFail(receiveBlockNumber int
 	taskManager.currentNewRoundHash = rlpShufflehash
 	taskManager.shuffleHashChan <- &types.ShuffleData{ShuffleHash: &rlpShufflehash, ShuffleBlockNumber: shuffleBlock.Number()}
 
-	if !exist || shuffleTime < time.Now().Unix() { // shuffleTime already expire
+	if !exist || shuffleTime < taskManager.clock.Time().Unix() { // shuffleTime already expire
 		return nil
 	}
 	taskManager.shuffleNewRoundChan <- shuffleList
@@ -241,7 +244,7 @@ func (taskManager *DposTaskManager) ShuffleWhenVerifyFail(receiveBlockNumber int
 
 // check local whether exist delegate,return candidates when exist
 func (taskManager *DposTaskManager) checkLocalExistDelegateWhenShuffle(d *delegatestate.DelegateDB) (bool, []types.Candidate) {
-	candidates := d.GetDelegates()
+	candidates := d.GetShuffleDelegates()
 	log.Debug("checkLocalExistDelegateWhenShuffle", "candidates", candidates)
 	for _, v := range candidates {
 		if taskManager.checkAddressInAccounts(v.Address) {
@@ -379,7 +382,7 @@ func (taskManager *DposTaskManager) initShuffleDataFromLevelDB() error {
 		log.Error("dposTaskManager", "fail to get delegate state by block Number", err)
 		return err
 	}
-	topDelegates := delegatedb.GetDelegates()
+	topDelegates := delegatedb.GetShuffleDelegates()
 	if len(topDelegates) > maxElectDelegate {
 		topDelegates = topDelegates[:maxElectDelegate]
 	}
@@ -390,7 +393,7 @@ func (taskManager *DposTaskManager) initShuffleDataFromLevelDB() error {
 		return errors.New(errMsg)
 
 	}
-	shuffleNewRound := util.ShuffleNewRound(sdd.ShuffleTime.Int64(), maxElectDelegate, topDelegates, int64(blockInterval))
+	shuffleNewRound := dpos.NewRoundShuffle(taskManager.blockchain, block.Header(), sdd.ShuffleTime.Int64(), topDelegates)
 	shuffleList := types.ShuffleList{ShuffleDels: shuffleNewRound}
 	taskManager.mu.Lock()
 	defer taskManager.mu.Unlock()
@@ -403,8 +406,7 @@ func (taskManager *DposTaskManager) initShuffleDataFromLevelDB() error {
 }
 
 // cal begin time of next round
-func generateNextRoundBeginTime(genesisBlockTime int64) (int64, error) {
-	currentTime := time.Now().Unix()
+func generateNextRoundBeginTime(genesisBlockTime int64, currentTime int64) (int64, error) {
 	roundTime := int64(maxElectDelegate * blockInterval)
 	finishTime := (currentTime - genesisBlockTime) % int64(roundTime)
 	return currentTime + (roundTime - finishTime), nil
@@ -0,0 +1,148 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpossim
+
+import (
+	"context"
+	"github.com/Aurorachain-io/go-aoa/aoa"
+	"github.com/Aurorachain-io/go-aoa/aoa/filters"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/watch"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/internal/aoaapi"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+	"github.com/Aurorachain-io/go-aoa/rpc"
+	"math/big"
+	"testing"
+)
+
+// sendTx signs a transaction of the given node at its next pool nonce and
+// hands it to the pools of all nodes, so it gets included whoever produces the
+// next block.
+func sendTx(t *testing.T, nw *Network, from int, build func(nonce uint64) *types.Transaction) *types.Transaction {
+	n := nw.Nodes[from]
+	tx := build(n.Dacchain().TxPool().State().GetNonce(n.Address))
+	signed, err := types.SignTx(tx, types.MakeSigner(nw.Genesis.Config, n.Head().Number()), n.Key)
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	for _, m := range nw.Nodes {
+		if err := m.Dacchain().TxPool().AddLocal(signed); err != nil {
+			t.Fatalf("%s: failed to add transaction: %v", m.Name, err)
+		}
+	}
+	return signed
+}
+
+// TestAssetTransfers is a smoke test of native assets across the network: a
+// published asset is paid out by a batch transfer and every node, the producer
+// of the block included, must agree on the balances, record the internal
+// transactions and serve the ERC-20 view and Transfer logs of the asset. The
+// features themselves are covered by the unit tests of core, core/state,
+// core/vm, aoa/tracers and internal/aoaapi.
+func TestAssetTransfers(t *testing.T) {
+	nw := startNetworkConfig(t, Config{WatchInnerTx: true})
+	defer nw.Stop()
+
+	issuer := nw.Nodes[0]
+	asset := crypto.CreateAddress(issuer.Address, 0)
+	var recipients [2]common.Address
+	for i := range recipients {
+		key, _ := crypto.GenerateKey()
+		recipients[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	info, _ := types.AssetInfoToBytes(types.AssetInfo{Name: "Payroll", Symbol: "PAY", Supply: big.NewInt(1e6)})
+	sendTx(t, nw, 0, func(nonce uint64) *types.Transaction {
+		return types.NewPublishAssetTransaction(nonce, big.NewInt(0), params.TxGasAssetPublish, aoa.DefaultConfig.GasPrice, types.ActionPublishAsset, info)
+	})
+	// the first round starts one round after genesis
+	nw.RunRounds(2)
+
+	transfers := []types.BatchTransferEntry{
+		{To: recipients[0], Amount: big.NewInt(1000)},
+		{To: recipients[1], Asset: &asset, Amount: big.NewInt(500)},
+		{To: recipients[0], Asset: &asset, Amount: big.NewInt(700)},
+	}
+	data, _ := rlp.EncodeToBytes(transfers)
+	gas, _ := core.IntrinsicGas(data, types.ActionBatchTransfer)
+	gas += uint64(len(transfers)) * params.CallNewAccountGas
+	tx := sendTx(t, nw, 0, func(nonce uint64) *types.Transaction {
+		return types.NewBatchTransferTransaction(nonce, transfers, gas, aoa.DefaultConfig.GasPrice)
+	})
+	nw.RunRounds(1)
+	checkNetwork(t, nw)
+
+	_, _, blockNumber, _ := core.GetTransaction(issuer.Dacchain().ChainDb(), tx.Hash())
+	if blockNumber == 0 {
+		t.Fatalf("transaction %x not included", tx.Hash())
+	}
+	for _, n := range nw.Nodes {
+		st, err := n.BlockChain().State()
+		if err != nil {
+			t.Fatalf("%s: failed to load state: %v", n.Name, err)
+		}
+		if balance := st.GetBalance(recipients[0]); balance.Cmp(big.NewInt(1000)) != 0 {
+			t.Errorf("%s: AOA balance mismatch: have %v, want 1000", n.Name, balance)
+		}
+		if balance := st.GetAssetBalance(recipients[0], asset); balance.Cmp(big.NewInt(700)) != 0 {
+			t.Errorf("%s: asset balance mismatch: have %v, want 700", n.Name, balance)
+		}
+		if balance := st.GetAssetBalance(recipients[1], asset); balance.Cmp(big.NewInt(500)) != 0 {
+			t.Errorf("%s: asset balance mismatch: have %v, want 500", n.Name, balance)
+		}
+		itxs, err := n.BlockChain().GetInnerTxDb().Get(tx.Hash())
+		if err != nil {
+			t.Fatalf("%s: failed to load internal transactions: %v", n.Name, err)
+		}
+		if len(itxs) != len(transfers) {
+			t.Fatalf("%s: internal transaction count mismatch: have %d, want %d", n.Name, len(itxs), len(transfers))
+		}
+		for i, itx := range itxs {
+			if itx.From != issuer.Address || itx.To != transfers[i].To || itx.Value.Cmp(transfers[i].Amount) != 0 || (itx.AssetID == nil) != (transfers[i].Asset == nil) {
+				t.Errorf("%s: internal transaction %d mismatch: have %+v, want %+v", n.Name, i, itx, transfers[i])
+			}
+		}
+		// token tooling finds the asset legs as ERC-20 Transfer logs of the asset
+		topics := [][]common.Hash{{watch.TransferEventTopic}, {issuer.Address.Hash()}}
+		logs, err := filters.New(n.Dacchain().ApiBackend, 0, -1, []common.Address{asset}, topics).Logs(context.Background())
+		if err != nil {
+			t.Fatalf("%s: failed to filter logs: %v", n.Name, err)
+		}
+		if len(logs) != 2 {
+			t.Fatalf("%s: transfer log count mismatch: have %d, want 2", n.Name, len(logs))
+		}
+		for i, l := range logs {
+			leg := transfers[i+1]
+			if l.TxHash != tx.Hash() || l.BlockNumber != blockNumber || l.Topics[2] != leg.To.Hash() || new(big.Int).SetBytes(l.Data).Cmp(leg.Amount) != 0 {
+				t.Errorf("%s: transfer log %d mismatch: have %+v", n.Name, i, l)
+			}
+		}
+		// and reads its balances through the ERC-20 view
+		input := append(crypto.Keccak256([]byte("balanceOf(address)"))[:4], common.LeftPadBytes(recipients[0].Bytes(), 32)...)
+		api := aoaapi.NewPublicBlockChainAPI(n.Dacchain().ApiBackend)
+		result, err := api.Call(context.Background(), aoaapi.CallArgs{To: &asset, Data: input}, rpc.LatestBlockNumber)
+		if err != nil {
+			t.Fatalf("%s: balanceOf failed: %v", n.Name, err)
+		}
+		if balance := new(big.Int).SetBytes(result); balance.Cmp(big.NewInt(700)) != 0 {
+			t.Errorf("%s: balanceOf mismatch: have %v, want 700", n.Name, balance)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpossim
+
+import (
+	"errors"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
+	"net"
+	"sync"
+	"time"
+)
+
+var errLinkClosed = errors.New("link closed")
+
+// link is the connection between two nodes.
+type link struct {
+	a, b *linkConn
+}
+
+// close cuts the link, disconnecting the peers on both ends.
+func (l *link) close() {
+	l.a.Close()
+	l.b.Close()
+}
+
+// packet is a write waiting to be delivered.
+type packet struct {
+	data []byte
+	at   mclock.AbsTime
+}
+
+// linkConn is one end of a link. Writes are queued and delivered in order
+// once the sending node's delay has passed on the simulated clock.
+type linkConn struct {
+	net.Conn
+	nw    *Network
+	from  int
+	queue chan packet
+	quit  chan struct{}
+	once  sync.Once
+
+	lock sync.Mutex
+	err  error // first delivery error, returned by subsequent writes
+}
+
+func newLinkConn(nw *Network, from int, conn net.Conn) *linkConn {
+	c := &linkConn{
+		Conn:  conn,
+		nw:    nw,
+		from:  from,
+		queue: make(chan packet, 1024),
+		quit:  make(chan struct{}),
+	}
+	go c.loop()
+	return c
+}
+
+// Write queues b for delivery.
+func (c *linkConn) Write(b []byte) (int, error) {
+	c.lock.Lock()
+	err := c.err
+	c.lock.Unlock()
+	if err != nil {
+		return 0, err
+	}
+	p := packet{data: append([]byte(nil), b...), at: c.nw.Clock.Now().Add(c.nw.delay(c.from))}
+	select {
+	case c.queue <- p:
+		return len(b), nil
+	case <-c.quit:
+		return 0, errLinkClosed
+	}
+}
+
+// Close closes the connection, dropping all undelivered writes.
+func (c *linkConn) Close() error {
+	c.once.Do(func() { close(c.quit) })
+	return c.Conn.Close()
+}
+
+// loop delivers the queued writes when they are due.
+func (c *linkConn) loop() {
+	for {
+		select {
+		case p := <-c.queue:
+			if wait := p.at - c.nw.Clock.Now(); wait > 0 {
+				select {
+				case <-c.nw.Clock.After(time.Duration(wait)):
+				case <-c.quit:
+					return
+				}
+			}
+			if _, err := c.Conn.Write(p.data); err != nil {
+				c.lock.Lock()
+				c.err = err
+				c.lock.Unlock()
+				return
+			}
+		case <-c.quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,471 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package dpossim runs networks of in-process aoa nodes with registered
+// delegates on a simulated clock, so that consensus changes can be tested
+// against rounds of real block production, signing and import.
+package dpossim
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"github.com/Aurorachain-io/go-aoa/accounts"
+	"github.com/Aurorachain-io/go-aoa/accounts/keystore"
+	aa "github.com/Aurorachain-io/go-aoa/accounts/walletType"
+	"github.com/Aurorachain-io/go-aoa/aoa"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
+	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/node"
+	"github.com/Aurorachain-io/go-aoa/p2p"
+	"github.com/Aurorachain-io/go-aoa/p2p/discover"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// step is the virtual time the clock is moved at once. It must be below the
+	// tick period of the timing wheels so that no tick is skipped.
+	step = 100 * time.Millisecond
+
+	// connectTimeout is the real time allowed for the peers to handshake.
+	connectTimeout = 10 * time.Second
+)
+
+var (
+	errNotStarted  = errors.New("network not started")
+	errUnknownNode = errors.New("unknown node")
+)
+
+// Config is the configuration of a simulated network.
+type Config struct {
+	Delegates     int           // number of delegate nodes (default 4)
+	BlockInterval int64         // seconds between two slots (default 2)
+	Settle        time.Duration // real time the nodes get to process every clock step (default 10ms)
+
+	// AssetHolderIndex enables the asset holder index on all nodes.
+	AssetHolderIndex bool
+	// WatchInnerTx records the internal transactions on all nodes.
+	WatchInnerTx bool
+
+	// ChainConfig is the base chain configuration of the genesis block; the
+	// delegate count and the block interval are overridden from above.
+	// Defaults to params.AllDacchainProtocolChanges.
+	ChainConfig *params.ChainConfig
+}
+
+// Node is a delegate node of the network.
+type Node struct {
+	Name    string
+	Key     *ecdsa.PrivateKey // delegate key
+	Address common.Address    // delegate address
+
+	id    discover.NodeID
+	stack *node.Node
+	dac   *aoa.Dacchain
+}
+
+// Dacchain returns the aoa service of the node.
+func (n *Node) Dacchain() *aoa.Dacchain { return n.dac }
+
+// BlockChain returns the chain of the node.
+func (n *Node) BlockChain() *core.BlockChain { return n.dac.BlockChain() }
+
+// Head returns the current head block of the node.
+func (n *Node) Head() *types.Block { return n.dac.BlockChain().CurrentBlock() }
+
+// Finalized returns the last block of the node finalized by a quorum.
+func (n *Node) Finalized() *types.Block { return n.dac.BlockChain().CurrentFinalizedBlock() }
+
+// Network is a set of delegate nodes connected by in-memory pipes. The nodes
+// run on a shared simulated clock which only moves on Advance.
+type Network struct {
+	Clock   *mclock.Simulated
+	Genesis *core.Genesis
+	Nodes   []*Node
+
+	config  Config
+	lock    sync.Mutex
+	started bool
+	links   map[[2]int]*link
+	groups  []int           // partition group of every node
+	offline []bool          // nodes cut off from all peers
+	delays  []time.Duration // delay of the messages sent by every node
+}
+
+// New creates a network of delegate nodes sharing a genesis block which
+// registers all of them as agents. The nodes are not started yet.
+func New(config Config) (*Network, error) {
+	if config.Delegates == 0 {
+		config.Delegates = 4
+	}
+	if config.BlockInterval == 0 {
+		config.BlockInterval = 2
+	}
+	if config.Settle == 0 {
+		config.Settle = 10 * time.Millisecond
+	}
+	if config.ChainConfig == nil {
+		config.ChainConfig = params.AllDacchainProtocolChanges
+	}
+	chainConfig := *config.ChainConfig
+	chainConfig.MaxElectDelegate = big.NewInt(int64(config.Delegates))
+	chainConfig.BlockInterval = big.NewInt(config.BlockInterval)
+
+	genesisTime := time.Unix(1500000000, 0)
+	nw := &Network{
+		Clock: mclock.NewSimulated(genesisTime),
+		Genesis: &core.Genesis{
+			Config:    &chainConfig,
+			Timestamp: uint64(genesisTime.Unix()),
+			GasLimit:  params.GenesisGasLimit,
+			Alloc:     make(core.GenesisAlloc),
+		},
+		config:  config,
+		links:   make(map[[2]int]*link),
+		groups:  make([]int, config.Delegates),
+		offline: make([]bool, config.Delegates),
+		delays:  make([]time.Duration, config.Delegates),
+	}
+	for i := 0; i < config.Delegates; i++ {
+		// derive the keys from the index to keep the shuffles reproducible
+		key, _ := crypto.ToECDSA(crypto.Keccak256([]byte(fmt.Sprintf("dpossim delegate %d", i))))
+		nodeKey, _ := crypto.ToECDSA(crypto.Keccak256([]byte(fmt.Sprintf("dpossim node %d", i))))
+		n := &Node{
+			Name:    fmt.Sprintf("delegate%02d", i),
+			Key:     key,
+			Address: crypto.PubkeyToAddress(key.PublicKey),
+			id:      discover.PubkeyID(&nodeKey.PublicKey),
+		}
+		stack, err := node.New(&node.Config{
+			Name:              n.Name,
+			UseLightweightKDF: true,
+			NoUSB:             true,
+			P2P: p2p.Config{
+				PrivateKey:  nodeKey,
+				MaxPeers:    config.Delegates * 2,
+				NoDiscovery: true,
+				OpenTopNet:  true,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		n.stack = stack
+		if err := stack.Register(nw.newService(n)); err != nil {
+			return nil, err
+		}
+		ks := stack.AccountManager().Backends(keystore.KeyStoreType)[0].(*keystore.KeyStore)
+		if _, err := ks.ImportECDSA(key, ""); err != nil {
+			return nil, err
+		}
+		nw.Genesis.Agents = append(nw.Genesis.Agents, types.Candidate{
+			Address:      strings.ToLower(n.Address.Hex()),
+			Vote:         1,
+			Nickname:     n.Name,
+			RegisterTime: uint64(genesisTime.Unix()),
+		})
+		nw.Genesis.Alloc[n.Address] = core.GenesisAccount{Balance: new(big.Int).Mul(big.NewInt(1e6), big.NewInt(params.Em))}
+		nw.Nodes = append(nw.Nodes, n)
+	}
+	return nw, nil
+}
+
+// newService returns the constructor of the aoa service of a node.
+func (nw *Network) newService(n *Node) node.ServiceConstructor {
+	return func(ctx *node.ServiceContext) (node.Service, error) {
+		config := aoa.DefaultConfig
+		config.Genesis = nw.Genesis
+		config.NetworkId = nw.Genesis.Config.ChainId.Uint64()
+		config.Clock = nw.Clock
+		config.EnableAssetHolderIndex = nw.config.AssetHolderIndex
+		config.EnableInterTxWatching = nw.config.WatchInnerTx
+		dac, err := aoa.New(ctx, &config)
+		if err != nil {
+			return nil, err
+		}
+		n.dac = dac
+		return dac, nil
+	}
+}
+
+// Start starts all nodes, activates their delegate keys and connects them
+// to each other.
+func (nw *Network) Start() error {
+	for _, n := range nw.Nodes {
+		// the shuffle only schedules delegates whose account is known locally
+		account := accounts.Account{Address: n.Address}
+		for deadline := time.Now().Add(connectTimeout); ; {
+			if _, err := n.stack.AccountManager().Find(account); err == nil {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("%s: account %x not loaded", n.Name, n.Address)
+			}
+			time.Sleep(time.Millisecond)
+		}
+		if err := n.stack.Start(); err != nil {
+			return fmt.Errorf("%s: %v", n.Name, err)
+		}
+		n.dac.ApiBackend.GetDelegateWalletInfoCallback()(&aa.DelegateWalletInfo{
+			Address:    strings.ToLower(n.Address.Hex()),
+			PrivateKey: n.Key,
+		})
+	}
+	nw.lock.Lock()
+	nw.started = true
+	nw.lock.Unlock()
+
+	return nw.rewire()
+}
+
+// Stop disconnects and stops all nodes.
+func (nw *Network) Stop() {
+	nw.lock.Lock()
+	for key, l := range nw.links {
+		l.close()
+		delete(nw.links, key)
+	}
+	nw.started = false
+	nw.lock.Unlock()
+
+	for _, n := range nw.Nodes {
+		n.stack.Stop()
+	}
+}
+
+// RoundDuration returns the length of a round of block production.
+func (nw *Network) RoundDuration() time.Duration {
+	return time.Duration(int64(nw.config.Delegates)*nw.config.BlockInterval) * time.Second
+}
+
+// Advance moves the simulated clock forward by d, giving the nodes the
+// configured real settle time to process the events of every step.
+func (nw *Network) Advance(d time.Duration) {
+	for d > 0 {
+		next := step
+		if d < next {
+			next = d
+		}
+		nw.Clock.Run(next)
+		time.Sleep(nw.config.Settle)
+		d -= next
+	}
+}
+
+// RunRounds advances the clock by the given number of rounds.
+func (nw *Network) RunRounds(rounds int) {
+	nw.Advance(time.Duration(rounds) * nw.RoundDuration())
+}
+
+// Offline cuts the given node off from all of its peers. An offline delegate
+// neither produces nor signs blocks seen by the rest of the network.
+func (nw *Network) Offline(i int) error {
+	return nw.update(func() error {
+		if i < 0 || i >= len(nw.Nodes) {
+			return errUnknownNode
+		}
+		nw.offline[i] = true
+		return nil
+	})
+}
+
+// Online reconnects a node taken offline.
+func (nw *Network) Online(i int) error {
+	return nw.update(func() error {
+		if i < 0 || i >= len(nw.Nodes) {
+			return errUnknownNode
+		}
+		nw.offline[i] = false
+		return nil
+	})
+}
+
+// Partition splits the network into the given groups of node indexes. Nodes
+// are only connected to the nodes of their own group; nodes missing from all
+// groups are isolated.
+func (nw *Network) Partition(groups ...[]int) error {
+	return nw.update(func() error {
+		for i := range nw.groups {
+			nw.groups[i] = len(groups) + i
+		}
+		for g, group := range groups {
+			for _, i := range group {
+				if i < 0 || i >= len(nw.Nodes) {
+					return errUnknownNode
+				}
+				nw.groups[i] = g
+			}
+		}
+		return nil
+	})
+}
+
+// Heal removes all partitions and brings all offline nodes back.
+func (nw *Network) Heal() error {
+	return nw.update(func() error {
+		for i := range nw.groups {
+			nw.groups[i] = 0
+			nw.offline[i] = false
+		}
+		return nil
+	})
+}
+
+// Delay holds back every message sent by the given node, its block
+// signatures included, for d of simulated time.
+func (nw *Network) Delay(i int, d time.Duration) error {
+	nw.lock.Lock()
+	defer nw.lock.Unlock()
+
+	if i < 0 || i >= len(nw.Nodes) {
+		return errUnknownNode
+	}
+	nw.delays[i] = d
+	return nil
+}
+
+// delay returns the current message delay of a node.
+func (nw *Network) delay(i int) time.Duration {
+	nw.lock.Lock()
+	defer nw.lock.Unlock()
+
+	return nw.delays[i]
+}
+
+// CheckConverged returns an error unless all online nodes share the same
+// head block.
+func (nw *Network) CheckConverged() error {
+	nw.lock.Lock()
+	offline := append([]bool(nil), nw.offline...)
+	nw.lock.Unlock()
+
+	var first *Node
+	for i, n := range nw.Nodes {
+		if offline[i] {
+			continue
+		}
+		if first == nil {
+			first = n
+			continue
+		}
+		if a, b := first.Head(), n.Head(); a.Hash() != b.Hash() {
+			return fmt.Errorf("head mismatch: %s at #%d [%x…], %s at #%d [%x…]",
+				first.Name, a.NumberU64(), a.Hash().Bytes()[:4], n.Name, b.NumberU64(), b.Hash().Bytes()[:4])
+		}
+	}
+	return nil
+}
+
+// CheckFinality returns an error if a block finalized by one node conflicts
+// with the chain of another node, i.e. if the network forked below a
+// finalized block.
+func (nw *Network) CheckFinality() error {
+	for _, a := range nw.Nodes {
+		finalized := a.Finalized()
+		for _, b := range nw.Nodes {
+			if b.Head().NumberU64() < finalized.NumberU64() {
+				continue
+			}
+			if canon := b.BlockChain().GetBlockByNumber(finalized.NumberU64()); canon == nil || canon.Hash() != finalized.Hash() {
+				return fmt.Errorf("%s finalized #%d [%x…], not canonical on %s",
+					a.Name, finalized.NumberU64(), finalized.Hash().Bytes()[:4], b.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// update changes the topology of the network and rewires the links.
+func (nw *Network) update(fn func() error) error {
+	nw.lock.Lock()
+	err := fn()
+	nw.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	return nw.rewire()
+}
+
+// connected reports whether two nodes are linked in the current topology.
+func (nw *Network) connected(a, b int) bool {
+	return !nw.offline[a] && !nw.offline[b] && nw.groups[a] == nw.groups[b]
+}
+
+// rewire cuts the links not allowed by the topology and creates the missing
+// ones, then waits until all peers have finished the handshake.
+func (nw *Network) rewire() error {
+	nw.lock.Lock()
+	if !nw.started {
+		nw.lock.Unlock()
+		return errNotStarted
+	}
+	for key, l := range nw.links {
+		if !nw.connected(key[0], key[1]) {
+			l.close()
+			delete(nw.links, key)
+		}
+	}
+	for a := range nw.Nodes {
+		for b := a + 1; b < len(nw.Nodes); b++ {
+			key := [2]int{a, b}
+			if _, ok := nw.links[key]; !ok && nw.connected(a, b) {
+				nw.links[key] = nw.connect(a, b)
+			}
+		}
+	}
+	want := make([]int, len(nw.Nodes))
+	for key := range nw.links {
+		want[key[0]]++
+		want[key[1]]++
+	}
+	nw.lock.Unlock()
+
+	deadline := time.Now().Add(connectTimeout)
+	for i, n := range nw.Nodes {
+		for {
+			_, peers := n.stack.Server().PeerCount()
+			if peers == want[i] {
+				break
+			}
+			if time.Now().After(deadline) {
+				return fmt.Errorf("%s: have %d delegate peers, want %d", n.Name, peers, want[i])
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+	return nil
+}
+
+// connect links node b to node a as delegate peers over an in-memory pipe.
+func (nw *Network) connect(a, b int) *link {
+	pa, pb := net.Pipe()
+	l := &link{
+		a: newLinkConn(nw, a, pa),
+		b: newLinkConn(nw, b, pb),
+	}
+	dest := discover.NewNode(nw.Nodes[a].id, net.IP{127, 0, 0, 1}, 30303, 30303)
+	go nw.Nodes[a].stack.Server().SetupConn(l.a, 0, nil, discover.ConsNet)
+	go nw.Nodes[b].stack.Server().SetupConn(l.b, 0, dest, discover.ConsNet)
+	return l
+}
@@ -0,0 +1,247 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpossim
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Aurorachain-io/go-aoa/accounts/abi"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/vm"
+	"github.com/Aurorachain-io/go-aoa/internal/aoaapi"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rpc"
+)
+
+func startNetwork(t *testing.T) *Network {
+	return startNetworkConfig(t, Config{})
+}
+
+func startNetworkConfig(t *testing.T, config Config) *Network {
+	nw, err := New(config)
+	if err != nil {
+		t.Fatalf("failed to create network: %v", err)
+	}
+	if err := nw.Start(); err != nil {
+		t.Fatalf("failed to start network: %v", err)
+	}
+	return nw
+}
+
+// checkNetwork fails the test if the nodes diverged or finalized conflicting
+// blocks.
+func checkNetwork(t *testing.T, nw *Network) {
+	if err := nw.CheckConverged(); err != nil {
+		t.Fatal(err)
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFinality(t *testing.T) {
+	nw := startNetwork(t)
+	defer nw.Stop()
+
+	// the first round starts one round after genesis
+	nw.RunRounds(3)
+	checkNetwork(t, nw)
+	for _, n := range nw.Nodes {
+		if head := n.Head().NumberU64(); head < 4 {
+			t.Errorf("%s: head #%d, want at least #4", n.Name, head)
+		}
+		if n.Finalized().Hash() != n.Head().Hash() {
+			t.Errorf("%s: head #%d not finalized, finalized #%d", n.Name, n.Head().NumberU64(), n.Finalized().NumberU64())
+		}
+	}
+}
+
+func TestOfflineDelegate(t *testing.T) {
+	nw := startNetwork(t)
+	defer nw.Stop()
+
+	nw.RunRounds(2)
+	if err := nw.Offline(3); err != nil {
+		t.Fatal(err)
+	}
+	offline := nw.Nodes[3].Head().NumberU64()
+	before := nw.Nodes[0].Head().NumberU64()
+	nw.RunRounds(2)
+
+	// the remaining three delegates still form a quorum
+	checkNetwork(t, nw)
+	if head := nw.Nodes[0].Head().NumberU64(); head <= before {
+		t.Fatalf("network stalled at #%d", head)
+	}
+	if head := nw.Nodes[3].Head().NumberU64(); head != offline {
+		t.Fatalf("offline delegate moved from #%d to #%d", offline, head)
+	}
+	// the delegate catches up after coming back
+	if err := nw.Online(3); err != nil {
+		t.Fatal(err)
+	}
+	nw.RunRounds(1)
+	checkNetwork(t, nw)
+}
+
+func TestPartition(t *testing.T) {
+	nw := startNetwork(t)
+	defer nw.Stop()
+
+	nw.RunRounds(2)
+	before := nw.Nodes[0].Head().NumberU64()
+
+	// neither half of the network reaches a quorum
+	if err := nw.Partition([]int{0, 1}, []int{2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	nw.RunRounds(2)
+	for _, n := range nw.Nodes {
+		if head := n.Head().NumberU64(); head != before {
+			t.Errorf("%s: moved from #%d to #%d without a quorum", n.Name, before, head)
+		}
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+	// production resumes on a single chain once the partition heals
+	if err := nw.Heal(); err != nil {
+		t.Fatal(err)
+	}
+	nw.RunRounds(2)
+	checkNetwork(t, nw)
+	if head := nw.Nodes[0].Head().NumberU64(); head <= before {
+		t.Fatalf("network stalled at #%d after healing", head)
+	}
+}
+
+func TestDelayedSignatures(t *testing.T) {
+	nw := startNetwork(t)
+	defer nw.Stop()
+
+	nw.RunRounds(2)
+	// signatures arriving after the slot don't count towards the quorum, but
+	// the three timely delegates still confirm their blocks
+	if err := nw.Delay(2, 3*time.Duration(nw.config.BlockInterval)*time.Second); err != nil {
+		t.Fatal(err)
+	}
+	before := nw.Nodes[0].Head().NumberU64()
+	nw.RunRounds(2)
+	if head := nw.Nodes[0].Head().NumberU64(); head <= before {
+		t.Fatalf("network stalled at #%d", head)
+	}
+	if err := nw.CheckFinality(); err != nil {
+		t.Fatal(err)
+	}
+	if err := nw.Delay(2, 0); err != nil {
+		t.Fatal(err)
+	}
+	nw.RunRounds(2)
+	checkNetwork(t, nw)
+}
+
+func TestChainConfigRPC(t *testing.T) {
+	config := *params.AllDacchainProtocolChanges
+	config.ShanghaiBlock = big.NewInt(1000)
+	nw, err := New(Config{ChainConfig: &config})
+	if err != nil {
+		t.Fatalf("failed to create network: %v", err)
+	}
+	if err := nw.Start(); err != nil {
+		t.Fatalf("failed to start network: %v", err)
+	}
+	defer nw.Stop()
+	nw.RunRounds(1)
+
+	api := aoaapi.NewPublicBlockChainAPI(nw.Nodes[0].Dacchain().ApiBackend)
+	result := api.ChainConfig()
+	forks := result["forks"].([]aoaapi.RPCFork)
+	if len(forks) != len(config.Forks()) {
+		t.Fatalf("fork count mismatch: have %d, want %d", len(forks), len(config.Forks()))
+	}
+	active := make(map[string]bool)
+	for _, fork := range forks {
+		active[fork.Name] = fork.Active
+	}
+	if !active["Jail"] || !active["RegisterCost"] {
+		t.Errorf("forks from genesis reported inactive: %+v", forks)
+	}
+	if active["Shanghai"] || active["Byzantium"] {
+		t.Errorf("future forks reported active: %+v", forks)
+	}
+	// the configuration is served in its genesis form
+	blob, err := json.Marshal(result["config"])
+	if err != nil {
+		t.Fatalf("failed to encode config: %v", err)
+	}
+	var decoded params.ChainConfig
+	if err := json.Unmarshal(blob, &decoded); err != nil {
+		t.Fatalf("failed to decode config: %v", err)
+	}
+	if decoded.ShanghaiBlock == nil || decoded.ShanghaiBlock.Cmp(config.ShanghaiBlock) != 0 {
+		t.Errorf("shanghai block mismatch: have %v, want %v", decoded.ShanghaiBlock, config.ShanghaiBlock)
+	}
+	if err := config.CheckCompatible(&decoded, nw.Nodes[0].Head().NumberU64()); err != nil {
+		t.Errorf("decoded config incompatible: %v", err)
+	}
+}
+
+func TestDelegateContractCall(t *testing.T) {
+	nw := startNetwork(t)
+	defer nw.Stop()
+	nw.RunRounds(2)
+
+	// contracts read the producer schedule of the round through the precompile
+	api := aoaapi.NewPublicBlockChainAPI(nw.Nodes[0].Dacchain().ApiBackend)
+	delegateABI, _ := abi.JSON(strings.NewReader(vm.DelegateABI))
+	call := func(method string, out interface{}) {
+		input, _ := delegateABI.Pack(method)
+		result, err := api.Call(context.Background(), aoaapi.CallArgs{To: &vm.DelegateContractAddress, Data: input, Action: types.ActionCallContract}, rpc.LatestBlockNumber)
+		if err != nil {
+			t.Fatalf("%s failed: %v", method, err)
+		}
+		if err := delegateABI.Unpack(out, method, result); err != nil {
+			t.Fatalf("failed to unpack %s: %v", method, err)
+		}
+	}
+	var candidates []common.Address
+	call("candidates", &candidates)
+	if len(candidates) != len(nw.Nodes) {
+		t.Errorf("candidate count mismatch: have %d, want %d", len(candidates), len(nw.Nodes))
+	}
+	var schedule struct {
+		Producers []common.Address
+		WorkTimes []*big.Int
+	}
+	call("producers", &schedule)
+	head := nw.Nodes[0].Head()
+	scheduled := false
+	for i, producer := range schedule.Producers {
+		if producer == head.Coinbase() && schedule.WorkTimes[i].Cmp(head.Time()) == 0 {
+			scheduled = true
+		}
+	}
+	if !scheduled {
+		t.Errorf("head block producer %x not in the schedule %x", head.Coinbase(), schedule.Producers)
+	}
+}
@@ -19,11 +19,13 @@ package filters
 import (
 	"context"
 	"math/big"
+	"sort"
 
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core"
 	"github.com/Aurorachain-io/go-aoa/core/bloombits"
 	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/watch"
 	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/event"
 	"github.com/Aurorachain-io/go-aoa/rpc"
@@ -41,6 +43,9 @@ type Backend interface {
 
 	BloomStatus() (uint64, uint64)
 	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
+
+	IsWatchInnerTxEnable() bool
+	GetInnerTxDb() watch.InnerTxDb
 }
 
 // Filter can be used to retrieve and filter logs.
@@ -51,6 +56,7 @@ type Filter struct {
 	begin, end int64
 	addresses  []common.Address
 	topics     [][]common.Hash
+	transfers  bool // whether synthetic asset transfer logs may match
 
 	matcher *bloombits.Matcher
 }
@@ -85,6 +91,7 @@ func New(backend Backend, begin, end int64, addresses []common.Address, topics [
 		end:       end,
 		addresses: addresses,
 		topics:    topics,
+		transfers: backend.IsWatchInnerTxEnable() && matchesTransfers(topics),
 		db:        backend.ChainDb(),
 		matcher:   bloombits.NewMatcher(size, filters),
 	}
@@ -93,6 +100,20 @@ func New(backend Backend, begin, end int64, addresses []common.Address, topics [
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
 func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
+	// Resolve the finalized tag to the block finalized by now
+	finalized := rpc.FinalizedBlockNumber.Int64()
+	if f.begin == finalized || f.end == finalized {
+		header, _ := f.backend.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
+		if header == nil {
+			return nil, nil
+		}
+		if f.begin == finalized {
+			f.begin = header.Number.Int64()
+		}
+		if f.end == finalized {
+			f.end = header.Number.Int64()
+		}
+	}
 	// Figure out the limits of the filter range
 	header, _ := f.backend.HeaderByNumber(ctx, rpc.LatestBlockNumber)
 	if header == nil {
@@ -115,13 +136,21 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	size, sections := f.backend.BloomStatus()
 	if indexed := sections * size; indexed > uint64(f.begin) {
 		if indexed > end {
-			logs, err = f.indexedLogs(ctx, end)
-		} else {
-			logs, err = f.indexedLogs(ctx, indexed-1)
+			indexed = end + 1
 		}
-		if err != nil {
+		// The bloom bits index only covers the regular logs, the synthetic
+		// asset transfers of the indexed blocks are found by their own blooms
+		begin := uint64(f.begin)
+		if logs, err = f.indexedLogs(ctx, indexed-1); err != nil {
 			return logs, err
 		}
+		if f.transfers {
+			transfers, err := f.indexedTransferLogs(ctx, begin, indexed-1)
+			logs = mergeLogs(logs, transfers)
+			if err != nil {
+				return logs, err
+			}
+		}
 	}
 	rest, err := f.unindexedLogs(ctx, end)
 	logs = append(logs, rest...)
@@ -192,6 +221,37 @@ func (f *Filter) unindexedLogs(ctx context.Context, end uint64) ([]*types.Log, e
 			}
 			logs = append(logs, found...)
 		}
+		found, err := f.checkTransferMatches(ctx, header)
+		if err != nil {
+			return logs, err
+		}
+		logs = append(logs, found...)
+	}
+	return logs, nil
+}
+
+// indexedTransferLogs returns the synthetic asset transfer logs matching the
+// filter criteria in the given range of blocks covered by the bloom bits index.
+func (f *Filter) indexedTransferLogs(ctx context.Context, begin, end uint64) ([]*types.Log, error) {
+	var logs []*types.Log
+
+	for number := begin; number <= end; number++ {
+		if err := ctx.Err(); err != nil {
+			return logs, err
+		}
+		hash := core.GetCanonicalHash(f.db, number)
+		if !f.transferBloomFilter(hash) {
+			continue
+		}
+		header, err := f.backend.HeaderByNumber(ctx, rpc.BlockNumber(number))
+		if header == nil || err != nil {
+			return logs, err
+		}
+		found, err := f.checkTransferMatches(ctx, header)
+		if err != nil {
+			return logs, err
+		}
+		logs = append(logs, found...)
 	}
 	return logs, nil
 }
@@ -215,6 +275,64 @@ func (f *Filter) checkMatches(ctx context.Context, header *types.Header) (logs [
 	return nil, nil
 }
 
+// checkTransferMatches returns the synthetic asset transfer logs of the given
+// block matching the filter criteria.
+func (f *Filter) checkTransferMatches(ctx context.Context, header *types.Header) ([]*types.Log, error) {
+	if !f.transferBloomFilter(header.Hash()) {
+		return nil, nil
+	}
+	receipts, err := f.backend.GetReceipts(ctx, header.Hash())
+	if err != nil {
+		return nil, err
+	}
+	unfiltered := watch.BlockTransferLogs(f.backend.GetInnerTxDb(), header.Hash(), header.Number.Uint64(), receipts)
+	return filterLogs(unfiltered, nil, nil, f.addresses, f.topics), nil
+}
+
+// transferBloomFilter checks the bloom of the synthetic asset transfer logs of
+// the given block, if any were recorded.
+func (f *Filter) transferBloomFilter(blockHash common.Hash) bool {
+	if !f.transfers {
+		return false
+	}
+	bloom, err := f.backend.GetInnerTxDb().GetBloom(blockHash)
+	if err != nil {
+		return false
+	}
+	return bloomFilter(bloom, f.addresses, f.topics)
+}
+
+// mergeLogs merges two lists of logs ordered by their position in the chain.
+func mergeLogs(logs, other []*types.Log) []*types.Log {
+	if len(other) == 0 {
+		return logs
+	}
+	logs = append(logs, other...)
+	sort.SliceStable(logs, func(i, j int) bool {
+		if logs[i].BlockNumber != logs[j].BlockNumber {
+			return logs[i].BlockNumber < logs[j].BlockNumber
+		}
+		return logs[i].Index < logs[j].Index
+	})
+	return logs
+}
+
+// matchesTransfers reports whether the topic criteria admit ERC-20 Transfer logs.
+func matchesTransfers(topics [][]common.Hash) bool {
+	if len(topics) > 3 {
+		return false
+	}
+	if len(topics) == 0 || len(topics[0]) == 0 {
+		return true
+	}
+	for _, topic := range topics[0] {
+		if topic == watch.TransferEventTopic {
+			return true
+		}
+	}
+	return false
+}
+
 func includes(addresses []common.Address, a common.Address) bool {
 	for _, addr := range addresses {
 		if addr == a {
@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -160,7 +161,8 @@ func (es *EventSystem) subscribe(sub *subscription) *Subscription {
 
 // SubscribeLogs creates a subscription that will write all logs matching the
 // given criteria to the given logs channel. Default value for the from and to
-// block is "latest". If the fromBlock > toBlock an error is returned.
+// block is "latest", "finalized" is the block finalized at subscription time.
+// If the fromBlock > toBlock an error is returned.
 func (es *EventSystem) SubscribeLogs(crit dacchain.FilterQuery, logs chan []*types.Log) (*Subscription, error) {
 	var from, to rpc.BlockNumber
 	if crit.FromBlock == nil {
@@ -173,6 +175,21 @@ func (es *EventSystem) SubscribeLogs(crit dacchain.FilterQuery, logs chan []*typ
 	} else {
 		to = rpc.BlockNumber(crit.ToBlock.Int64())
 	}
+	// the finalized tag stands for the block finalized when subscribing
+	if from == rpc.FinalizedBlockNumber || to == rpc.FinalizedBlockNumber {
+		header, _ := es.backend.HeaderByNumber(context.Background(), rpc.FinalizedBlockNumber)
+		if header == nil {
+			return nil, fmt.Errorf("finalized block not found")
+		}
+		if from == rpc.FinalizedBlockNumber {
+			from = rpc.BlockNumber(header.Number.Int64())
+			crit.FromBlock = new(big.Int).Set(header.Number)
+		}
+		if to == rpc.FinalizedBlockNumber {
+			to = rpc.BlockNumber(header.Number.Int64())
+			crit.ToBlock = new(big.Int).Set(header.Number)
+		}
+	}
 
 	// only interested in pending logs
 	if from == rpc.PendingBlockNumber && to == rpc.PendingBlockNumber {
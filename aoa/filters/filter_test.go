@@ -18,12 +18,15 @@ package filters
 
 import (
 	"context"
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/bitutil"
 	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/bloombits"
 	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/watch"
 	"github.com/Aurorachain-io/go-aoa/crypto"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	"github.com/Aurorachain-io/go-aoa/event"
 	"github.com/Aurorachain-io/go-aoa/params"
 	"io/ioutil"
@@ -49,7 +52,7 @@ func BenchmarkFilters(b *testing.B) {
 	defer os.RemoveAll(dir)
 
 	var (
-		db, _      = emdb.NewLDBDatabase(dir, 0, 0)
+		db, _      = aoadb.NewLDBDatabase(dir, 0, 0)
 		mux        = new(event.TypeMux)
 		txFeed     = new(event.Feed)
 		rmLogsFeed = new(event.Feed)
@@ -65,7 +68,7 @@ func BenchmarkFilters(b *testing.B) {
 	defer db.Close()
 
 	genesis := core.GenesisBlockForTesting(db, addr1, big.NewInt(1000000))
-	chain, receipts := core.GenerateChain(params.TestChainConfig, genesis, dpos.New(), db, 100010, func(i int, gen *core.BlockGen) {
+	chain, receipts := core.GenerateChain(params.AllDacchainProtocolChanges, genesis, dpos.New(), db, 100010, func(i int, gen *core.BlockGen) {
 		switch i {
 		case 2403:
 			receipt := makeReceipt(addr1)
@@ -114,7 +117,7 @@ func TestFilters(t *testing.T) {
 	defer os.RemoveAll(dir)
 
 	var (
-		db, _      = emdb.NewLDBDatabase(dir, 0, 0)
+		db, _      = aoadb.NewLDBDatabase(dir, 0, 0)
 		mux        = new(event.TypeMux)
 		txFeed     = new(event.Feed)
 		rmLogsFeed = new(event.Feed)
@@ -132,7 +135,7 @@ func TestFilters(t *testing.T) {
 	defer db.Close()
 
 	genesis := core.GenesisBlockForTesting(db, addr, big.NewInt(1000000))
-	chain, receipts := core.GenerateChain(params.TestChainConfig, genesis, dpos.New(), db, 1000, func(i int, gen *core.BlockGen) {
+	chain, receipts := core.GenerateChain(params.AllDacchainProtocolChanges, genesis, dpos.New(), db, 1000, func(i int, gen *core.BlockGen) {
 		switch i {
 		case 1:
 			receipt := types.NewReceipt(false, 0)
@@ -240,3 +243,109 @@ func TestFilters(t *testing.T) {
 		t.Error("expected 0 log, got", len(logs))
 	}
 }
+
+// transferBackend is a test backend recording internal transactions.
+type transferBackend struct {
+	*testBackend
+	itxdb watch.InnerTxDb
+}
+
+func (b *transferBackend) IsWatchInnerTxEnable() bool    { return true }
+func (b *transferBackend) GetInnerTxDb() watch.InnerTxDb { return b.itxdb }
+
+func TestTransferLogs(t *testing.T) {
+	var (
+		db, _   = aoadb.NewMemDatabase()
+		itxdb   = watch.NewInnerTxDb(db)
+		backend = &transferBackend{&testBackend{db: db, sections: 1}, itxdb}
+
+		contract = common.HexToAddress("0x1111111111111111111111111111111111111111")
+		asset    = common.HexToAddress("0x2222222222222222222222222222222222222222")
+		sender   = common.HexToAddress("0x3333333333333333333333333333333333333333")
+		topic    = common.BytesToHash([]byte("topic"))
+	)
+	// the first section is covered by the bloom bits index, the rest is not
+	head := params.BloomBitsBlocks + 10
+	gen, err := bloombits.NewGenerator(uint(params.BloomBitsBlocks))
+	if err != nil {
+		t.Fatalf("failed to create generator: %v", err)
+	}
+	var parent common.Hash
+	for i := uint64(0); i <= head; i++ {
+		var receipts types.Receipts
+		if i == 10 || i == 20 || i == head-1 {
+			receipt := types.NewReceipt(false, 0)
+			receipt.TxHash = common.BigToHash(new(big.Int).SetUint64(i))
+			if i != 20 {
+				receipt.Logs = []*types.Log{{Address: contract, Topics: []common.Hash{topic}, BlockNumber: i, TxHash: receipt.TxHash}}
+			}
+			receipt.Bloom = types.CreateBloom(types.Receipts{receipt})
+			receipts = types.Receipts{receipt}
+
+			itxs := []*types.InnerTx{{From: sender, To: common.BigToAddress(new(big.Int).SetUint64(i)), AssetID: &asset, Value: big.NewInt(1)}}
+			if err := itxdb.Set(receipt.TxHash, itxs); err != nil {
+				t.Fatalf("failed to write internal transactions: %v", err)
+			}
+		}
+		header := &types.Header{Number: new(big.Int).SetUint64(i), ParentHash: parent, Bloom: types.CreateBloom(receipts)}
+		hash := header.Hash()
+		parent = hash
+
+		core.WriteHeader(db, header)
+		core.WriteCanonicalHash(db, hash, i)
+		core.WriteHeadBlockHash(db, hash)
+		core.WriteBlockReceipts(db, hash, i, receipts)
+		if logs := watch.BlockTransferLogs(itxdb, hash, i, receipts); len(logs) > 0 {
+			itxdb.SetBloom(hash, types.BytesToBloom(types.LogsBloom(logs).Bytes()))
+		}
+		if i < params.BloomBitsBlocks {
+			gen.AddBloom(uint(i), header.Bloom)
+		}
+		if i == params.BloomBitsBlocks-1 {
+			for bit := 0; bit < types.BloomBitLength; bit++ {
+				bits, err := gen.Bitset(uint(bit))
+				if err != nil {
+					t.Fatalf("failed to retrieve bitset: %v", err)
+				}
+				core.WriteBloomBits(db, uint(bit), 0, hash, bitutil.CompressBytes(bits))
+			}
+		}
+	}
+
+	tests := []struct {
+		addresses []common.Address
+		topics    [][]common.Hash
+		want      []uint64 // block numbers of the matching logs
+		transfers int
+	}{
+		// regular and synthetic logs, in chain order
+		{nil, nil, []uint64{10, 10, 20, head - 1, head - 1}, 3},
+		// regular logs only
+		{[]common.Address{contract}, nil, []uint64{10, head - 1}, 0},
+		{nil, [][]common.Hash{{topic}}, []uint64{10, head - 1}, 0},
+		// synthetic logs only
+		{[]common.Address{asset}, nil, []uint64{10, 20, head - 1}, 3},
+		{nil, [][]common.Hash{{watch.TransferEventTopic}, {sender.Hash()}, {common.BigToAddress(big.NewInt(20)).Hash()}}, []uint64{20}, 1},
+	}
+	for i, tt := range tests {
+		logs, err := New(backend, 0, -1, tt.addresses, tt.topics).Logs(context.Background())
+		if err != nil {
+			t.Fatalf("test %d: failed to filter logs: %v", i, err)
+		}
+		if len(logs) != len(tt.want) {
+			t.Fatalf("test %d: log count mismatch: have %d, want %d", i, len(logs), len(tt.want))
+		}
+		transfers := 0
+		for j, log := range logs {
+			if log.BlockNumber != tt.want[j] {
+				t.Errorf("test %d: log %d block mismatch: have %d, want %d", i, j, log.BlockNumber, tt.want[j])
+			}
+			if log.Address == asset {
+				transfers++
+			}
+		}
+		if transfers != tt.transfers {
+			t.Errorf("test %d: transfer log count mismatch: have %d, want %d", i, transfers, tt.transfers)
+		}
+	}
+}
@@ -198,7 +198,7 @@ func NewProtocolManager(config *params.ChainConfig, mode downloader.SyncMode, ne
 	}
 
 	manager.fetcher = fetcher.New(blockchain.GetBlockByHash, validator, manager.BroadcastBlock, heighter, insertBlockfunc, manager.removePeer)
-	manager.lockBlockManager = newBlockLockManager(insertBlockfunc, delegateWallets)
+	manager.lockBlockManager = newBlockLockManager(insertBlockfunc, delegateWallets, taskManager.clock)
 	return manager, nil
 }
 
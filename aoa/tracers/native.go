@@ -0,0 +1,60 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+
+	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/vm"
+)
+
+// Native is a transaction tracer implemented in Go. Native tracers are a lot
+// faster than the JavaScript ones and also see the actions of a transaction
+// which never reach the EVM, like DPoS votes and asset transfers.
+type Native interface {
+	vm.Tracer
+
+	// CaptureTxStart is invoked with the environment and the message before the
+	// message is applied.
+	CaptureTxStart(env *vm.EVM, msg core.Message)
+
+	// CaptureTxEnd is invoked with the gas used by the message once applied.
+	CaptureTxEnd(gasUsed uint64)
+
+	// GetResult returns the json encoded result of the trace.
+	GetResult() (json.RawMessage, error)
+
+	// Stop terminates execution of the tracer at the first opportune moment.
+	Stop(err error)
+}
+
+// natives contains the constructors of the built in native tracers by name,
+// taking precedence over the JavaScript tracers of the same name.
+var natives = map[string]func() Native{
+	"callTracer":     newCallTracer,
+	"prestateTracer": newPrestateTracer,
+}
+
+// NewNative creates the native tracer with the given name, or returns false
+// if there is none.
+func NewNative(name string) (Native, bool) {
+	if ctor, ok := natives[name]; ok {
+		return ctor(), true
+	}
+	return nil, false
+}
@@ -0,0 +1,356 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/vm"
+)
+
+// actionTypes contains the type reported by the top call frame of a
+// transaction, by action.
+var actionTypes = map[uint64]string{
+	types.ActionTrans:          "CALL",
+	types.ActionRegister:       "REGISTER",
+	types.ActionAddVote:        "ADDVOTE",
+	types.ActionSubVote:        "SUBVOTE",
+	types.ActionPublishAsset:   "PUBLISHASSET",
+	types.ActionCreateContract: "CREATE",
+	types.ActionCallContract:   "CALL",
+	types.ActionUnjail:         "UNJAIL",
+	types.ActionSlash:          "SLASH",
+	types.ActionUnregister:     "UNREGISTER",
+	types.ActionSetCommission:  "SETCOMMISSION",
+	types.ActionClaimReward:    "CLAIMREWARD",
+	types.ActionMintAsset:      "MINTASSET",
+	types.ActionBurnAsset:      "BURNASSET",
+	types.ActionUpdateAsset:    "UPDATEASSET",
+	types.ActionFreezeAsset:    "FREEZEASSET",
+	types.ActionWhitelistAsset: "WHITELISTASSET",
+	types.ActionSetFeePool:     "SETFEEPOOL",
+	types.ActionBatchTransfer:  "BATCHTRANSFER",
+	types.ActionApproveAsset:   "APPROVEASSET",
+}
+
+// callFrame is a call, a creation or an asset transfer reported by the call
+// tracer. Asset is nil for transfers of the base currency.
+type callFrame struct {
+	Type    string          `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to,omitempty"`
+	Asset   *common.Address `json:"asset,omitempty"`
+	Value   *hexutil.Big    `json:"value,omitempty"`
+	Gas     *hexutil.Uint64 `json:"gas,omitempty"`
+	GasUsed *hexutil.Uint64 `json:"gasUsed,omitempty"`
+	Input   hexutil.Bytes   `json:"input,omitempty"`
+	Output  hexutil.Bytes   `json:"output,omitempty"`
+	Error   string          `json:"error,omitempty"`
+	Votes   []types.Vote    `json:"votes,omitempty"`
+	Calls   []*callFrame    `json:"calls,omitempty"`
+
+	gasIn, gasCost uint64 // gas available to and spent on the opcode entering the frame
+	outOff, outLen uint64 // memory area receiving the output of the frame
+}
+
+// callTracer is the native version of call_tracer.js, which also reports asset
+// transfers and the actions of transactions which never reach the EVM.
+type callTracer struct {
+	callstack []*callFrame
+	descended bool       // whether we've just descended into an inner call
+	transfer  *callFrame // asset transfer made by the current opcode
+
+	interrupt uint32 // atomic flag to signal execution interruption
+	reason    error  // textual reason for the interruption
+}
+
+func newCallTracer() Native {
+	return &callTracer{callstack: []*callFrame{{}}}
+}
+
+// CaptureTxStart fills the top call frame from the message, which is all there
+// is to report for actions not executed by the EVM.
+func (t *callTracer) CaptureTxStart(env *vm.EVM, msg core.Message) {
+	top := t.callstack[0]
+	top.Type = actionTypes[msg.Action()]
+	top.From = msg.From()
+	top.To = msg.To()
+	top.Asset = msg.Asset()
+	if msg.Value() != nil {
+		top.Value = (*hexutil.Big)(new(big.Int).Set(msg.Value()))
+	}
+	gas := hexutil.Uint64(msg.Gas())
+	top.Gas = &gas
+	top.Input = msg.Data()
+	top.Votes = msg.Vote()
+
+	if msg.Action() == types.ActionBatchTransfer {
+		transfers, err := types.DecodeBatchTransfers(msg.Data())
+		if err != nil {
+			return
+		}
+		for _, transfer := range transfers {
+			to := transfer.To
+			top.Calls = append(top.Calls, &callFrame{Type: "TRANSFER", From: msg.From(), To: &to, Asset: transfer.Asset, Value: (*hexutil.Big)(transfer.Amount)})
+		}
+	}
+}
+
+// CaptureTxEnd reports the gas used by the whole transaction.
+func (t *callTracer) CaptureTxEnd(gasUsed uint64) {
+	used := hexutil.Uint64(gasUsed)
+	t.callstack[0].GasUsed = &used
+}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *callTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	top := t.callstack[0]
+	top.Type = "CALL"
+	if create {
+		top.Type = "CREATE"
+	}
+	top.From = from
+	top.To = &to
+	top.Input = common.CopyBytes(input)
+	top.Value = (*hexutil.Big)(new(big.Int).Set(value))
+	if top.Gas == nil {
+		g := hexutil.Uint64(gas)
+		top.Gas = &g
+	}
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *callTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	t.transfer = nil
+	// If tracing was interrupted, abort the execution
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		env.Cancel()
+		return nil
+	}
+	if err != nil {
+		t.fault(err)
+		return nil
+	}
+	// If we've just descended into an inner call, retrieve it's true allowance,
+	// which depends on the 63/64 rule and the call stipend.
+	if t.descended {
+		if depth >= len(t.callstack) {
+			g := hexutil.Uint64(gas)
+			t.callstack[len(t.callstack)-1].Gas = &g
+		}
+		t.descended = false
+	}
+	// If an inner call returned, pop it off the call stack
+	if depth == len(t.callstack)-1 {
+		t.exit(env, gas, memory, stack)
+	}
+	switch op {
+	case vm.CREATE, vm.CREATE2:
+		t.enter(&callFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			Input:   memorySlice(memory, stack.Back(1), stack.Back(2)),
+			Value:   (*hexutil.Big)(new(big.Int).Set(stack.Back(0))),
+			gasIn:   gas,
+			gasCost: cost,
+		})
+
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		// Skip any pre-compile invocations, those are just fancy opcodes. The
+		// native asset and DPoS contracts are reported as they touch the state.
+		to := common.BigToAddress(stack.Back(1))
+		if _, ok := vm.PrecompiledContracts[to]; ok {
+			return nil
+		}
+		off := 1
+		if op == vm.DELEGATECALL || op == vm.STATICCALL {
+			off = 0
+		}
+		call := &callFrame{
+			Type:    op.String(),
+			From:    contract.Address(),
+			To:      &to,
+			Input:   memorySlice(memory, stack.Back(2+off), stack.Back(3+off)),
+			gasIn:   gas,
+			gasCost: cost,
+			outOff:  stack.Back(4 + off).Uint64(),
+			outLen:  stack.Back(5 + off).Uint64(),
+		}
+		if off == 1 {
+			call.Value = (*hexutil.Big)(new(big.Int).Set(stack.Back(2)))
+		}
+		t.enter(call)
+
+	case vm.TRANSFERASSET, vm.SENDASSET:
+		to, asset := common.BigToAddress(stack.Back(2)), common.BigToAddress(stack.Back(1))
+		t.addTransfer(&callFrame{Type: op.String(), From: contract.Address(), To: &to, Asset: &asset, Value: (*hexutil.Big)(new(big.Int).Set(stack.Back(0)))})
+
+	case vm.TRANSFERFROM:
+		from, to, asset := common.BigToAddress(stack.Back(3)), common.BigToAddress(stack.Back(2)), common.BigToAddress(stack.Back(1))
+		t.addTransfer(&callFrame{Type: op.String(), From: from, To: &to, Asset: &asset, Value: (*hexutil.Big)(new(big.Int).Set(stack.Back(0)))})
+
+	case vm.SELFDESTRUCT:
+		to := common.BigToAddress(stack.Back(0))
+		t.addCall(&callFrame{Type: op.String(), From: contract.Address(), To: &to, Value: (*hexutil.Big)(new(big.Int).Set(env.StateDB.GetBalance(contract.Address())))})
+
+	case vm.REVERT:
+		t.callstack[len(t.callstack)-1].Error = "execution reverted"
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *callTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		return nil
+	}
+	t.fault(err)
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *callTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) error {
+	top := t.callstack[0]
+	top.Output = common.CopyBytes(output)
+	if top.GasUsed == nil {
+		used := hexutil.Uint64(gasUsed)
+		top.GasUsed = &used
+	}
+	if err != nil && top.Error == "" {
+		top.Error = err.Error()
+	}
+	return nil
+}
+
+// GetResult returns the json encoded top call frame.
+func (t *callTracer) GetResult() (json.RawMessage, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+	if len(t.callstack) != 1 {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+	top := t.callstack[0]
+	if top.Error != "" {
+		top.Output = nil
+	}
+	return json.Marshal(top)
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *callTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// enter pushes an inner call on the call stack.
+func (t *callTracer) enter(call *callFrame) {
+	t.callstack = append(t.callstack, call)
+	t.descended = true
+}
+
+// exit pops the returned inner call off the call stack and retrieves its
+// results.
+func (t *callTracer) exit(env *vm.EVM, gas uint64, memory *vm.Memory, stack *vm.Stack) {
+	call := t.callstack[len(t.callstack)-1]
+	t.callstack = t.callstack[:len(t.callstack)-1]
+
+	ret := stack.Back(0)
+	switch {
+	case call.Type == vm.CREATE.String() || call.Type == vm.CREATE2.String():
+		used := hexutil.Uint64(call.gasIn - call.gasCost - gas)
+		call.GasUsed = &used
+		if ret.Sign() != 0 {
+			to := common.BigToAddress(ret)
+			call.To = &to
+			call.Output = env.StateDB.GetCode(to)
+		} else if call.Error == "" {
+			call.Error = "internal failure"
+		}
+
+	case call.Gas != nil:
+		used := hexutil.Uint64(call.gasIn - call.gasCost + uint64(*call.Gas) - gas)
+		call.GasUsed = &used
+		if ret.Sign() != 0 {
+			call.Output = memorySlice(memory, new(big.Int).SetUint64(call.outOff), new(big.Int).SetUint64(call.outLen))
+		} else if call.Error == "" {
+			call.Error = "internal failure"
+		}
+	}
+	t.addCall(call)
+}
+
+// fault flattens the failed call into its parent, consuming all its gas.
+func (t *callTracer) fault(err error) {
+	if t.transfer != nil {
+		t.transfer.Error = err.Error()
+	}
+	// If the topmost call already reverted, don't handle the additional fault again
+	call := t.callstack[len(t.callstack)-1]
+	if call.Error != "" {
+		return
+	}
+	t.callstack = t.callstack[:len(t.callstack)-1]
+	call.Error = err.Error()
+	if call.Gas != nil {
+		used := *call.Gas
+		call.GasUsed = &used
+	}
+	if len(t.callstack) > 0 {
+		t.addCall(call)
+		return
+	}
+	// Last call failed too, leave it in the stack
+	t.callstack = append(t.callstack, call)
+}
+
+// addCall adds a finished call to the calls of the current frame.
+func (t *callTracer) addCall(call *callFrame) {
+	parent := t.callstack[len(t.callstack)-1]
+	parent.Calls = append(parent.Calls, call)
+}
+
+// addTransfer adds the asset transfer of the current opcode, which is marked
+// failed if the opcode faults.
+func (t *callTracer) addTransfer(transfer *callFrame) {
+	t.addCall(transfer)
+	t.transfer = transfer
+}
+
+// memorySlice returns a copy of the given memory area, cut off at the end of
+// the memory.
+func memorySlice(memory *vm.Memory, offset, size *big.Int) []byte {
+	data := memory.Data()
+	if !offset.IsUint64() || offset.Uint64() >= uint64(len(data)) || size.Sign() == 0 {
+		return nil
+	}
+	start, end := offset.Uint64(), uint64(len(data))
+	if size.IsUint64() && size.Uint64() < end-start {
+		end = start + size.Uint64()
+	}
+	return common.CopyBytes(data[start:end])
+}
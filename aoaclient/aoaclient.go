@@ -329,6 +329,48 @@ func (ec *Client) NonceAt(ctx context.Context, account common.Address, blockNumb
 	return uint64(result), err
 }
 
+// AssetAllowanceAt returns the amount of asset spender may take from owner.
+// The block number can be nil, in which case the allowance is taken from the latest known block.
+func (ec *Client) AssetAllowanceAt(ctx context.Context, owner, spender, asset common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var result hexutil.Big
+	err := ec.c.CallContext(ctx, &result, "aoa_getAssetAllowance", owner, spender, asset, toBlockNumArg(blockNumber))
+	return (*big.Int)(&result), err
+}
+
+// AssetHolder is a holder of an asset with its balance.
+type AssetHolder struct {
+	Address common.Address
+	Balance *big.Int
+}
+
+type rpcAssetHolder struct {
+	Address common.Address `json:"address"`
+	Balance *hexutil.Big   `json:"balance"`
+}
+
+// AssetHolders returns at most limit holders of the given asset starting at offset.
+// The node has to run the asset holder index.
+func (ec *Client) AssetHolders(ctx context.Context, asset common.Address, offset, limit uint64) ([]AssetHolder, error) {
+	var result []rpcAssetHolder
+	err := ec.c.CallContext(ctx, &result, "aoa_getAssetHolders", asset, hexutil.Uint64(offset), hexutil.Uint64(limit))
+	if err != nil {
+		return nil, err
+	}
+	holders := make([]AssetHolder, len(result))
+	for i, holder := range result {
+		holders[i] = AssetHolder{Address: holder.Address, Balance: (*big.Int)(holder.Balance)}
+	}
+	return holders, nil
+}
+
+// AssetHolderCount returns the number of holders of the given asset.
+// The node has to run the asset holder index.
+func (ec *Client) AssetHolderCount(ctx context.Context, asset common.Address) (uint64, error) {
+	var result hexutil.Uint64
+	err := ec.c.CallContext(ctx, &result, "aoa_getAssetHolderCount", asset)
+	return uint64(result), err
+}
+
 // Filters
 
 // FilterLogs executes a filter query.
@@ -110,6 +110,7 @@ var (
 		utils.ExtraDataFlag,
 		configFileFlag,
 		utils.WatchInnerTxFlag,
+		utils.AssetHolderIndexFlag,
 	}
 
 	rpcFlags = []cli.Flag{
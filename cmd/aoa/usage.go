@@ -80,6 +80,7 @@ var AppHelpFlagGroups = []flagGroup{
 			utils.LightPeersFlag,
 			utils.LightKDFFlag,
 			utils.WatchInnerTxFlag,
+			utils.AssetHolderIndexFlag,
 		},
 	},
 	{Name: "DEVELOPER CHAIN",
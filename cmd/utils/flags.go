@@ -445,6 +445,10 @@ var (
 		Name:  "watchinnertx",
 		Usage: "Enable watching internal transactions",
 	}
+	AssetHolderIndexFlag = cli.BoolFlag{
+		Name:  "assetholderindex",
+		Usage: "Enable indexing the holders of every asset",
+	}
 	//WhisperEnabledFlag = cli.BoolFlag{
 	//	Name:  "shh",
 	//	Usage: "Enable Whisper",
@@ -906,6 +910,9 @@ func SetaoaConfig(ctx *cli.Context, stack *node.Node, cfg *aoa.Config) {
 	if ctx.GlobalIsSet(WatchInnerTxFlag.Name) {
 		cfg.EnableInterTxWatching = true
 	}
+	if ctx.GlobalIsSet(AssetHolderIndexFlag.Name) {
+		cfg.EnableAssetHolderIndex = true
+	}
 
 	// Override any default configs for hard coded networks.
 	switch {
@@ -28,3 +28,43 @@ type AbsTime time.Duration // absolute monotonic time
 func Now() AbsTime {
 	return AbsTime(monotime.Now())
 }
+
+// Add returns t + d.
+func (t AbsTime) Add(d time.Duration) AbsTime {
+	return t + AbsTime(d)
+}
+
+// Clock interface makes it possible to replace the system clock with a simulated
+// clock. Besides the monotonic time it also reports the wall-clock time, which
+// the dpos slots are scheduled on.
+type Clock interface {
+	Now() AbsTime
+	Time() time.Time
+	Sleep(time.Duration)
+	After(time.Duration) <-chan AbsTime
+}
+
+// System implements Clock using the system clock.
+type System struct{}
+
+// Now returns the current monotonic time.
+func (System) Now() AbsTime {
+	return Now()
+}
+
+// Time returns the current wall-clock time.
+func (System) Time() time.Time {
+	return time.Now()
+}
+
+// Sleep blocks for the given duration.
+func (System) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// After returns a channel which receives the current time after d has elapsed.
+func (System) After(d time.Duration) <-chan AbsTime {
+	c := make(chan AbsTime, 1)
+	time.AfterFunc(d, func() { c <- Now() })
+	return c
+}
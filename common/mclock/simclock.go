@@ -0,0 +1,152 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package mclock
+
+import (
+	"sync"
+	"time"
+)
+
+// Simulated implements a virtual Clock for reproducible time-sensitive tests. It
+// simulates a scheduler on a virtual timescale where actual processing takes zero
+// time.
+//
+// The virtual clock doesn't advance on its own, call Run to advance it and
+// execute timers. Since there is no way to influence the Go scheduler, testing
+// timeout behaviour involving goroutines needs special care. A good way to test
+// such timeouts is as follows: First perform the action that is supposed to
+// time out. Ensure that the timer you want to test is created. Then run the
+// clock until after the timeout. Finally observe the effect of the timeout
+// using a channel or semaphore.
+type Simulated struct {
+	base      time.Time // wall-clock time at virtual time zero
+	now       AbsTime
+	scheduled []event
+	mu        sync.RWMutex
+	cond      *sync.Cond
+}
+
+type event struct {
+	do func()
+	at AbsTime
+}
+
+// NewSimulated creates a simulated clock whose wall-clock time starts at base.
+func NewSimulated(base time.Time) *Simulated {
+	return &Simulated{base: base}
+}
+
+// Run moves the clock by the given duration, executing all timers before that
+// duration in the order they expire.
+func (s *Simulated) Run(d time.Duration) {
+	s.mu.Lock()
+	s.init()
+
+	end := s.now.Add(d)
+	var do []func()
+	for len(s.scheduled) > 0 && s.scheduled[0].at <= end {
+		ev := s.scheduled[0]
+		s.scheduled[0] = event{}
+		s.scheduled = s.scheduled[1:]
+		s.now = ev.at
+		do = append(do, ev.do)
+	}
+	s.now = end
+	s.mu.Unlock()
+
+	for _, fn := range do {
+		fn()
+	}
+}
+
+// ActiveTimers returns the number of timers that haven't fired.
+func (s *Simulated) ActiveTimers() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.scheduled)
+}
+
+// WaitForTimers waits until the clock has at least n scheduled timers.
+func (s *Simulated) WaitForTimers(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.init()
+
+	for len(s.scheduled) < n {
+		s.cond.Wait()
+	}
+}
+
+// Now returns the current virtual time.
+func (s *Simulated) Now() AbsTime {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.now
+}
+
+// Time returns the current virtual wall-clock time.
+func (s *Simulated) Time() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.base.Add(time.Duration(s.now))
+}
+
+// Sleep blocks until the clock has advanced by d.
+func (s *Simulated) Sleep(d time.Duration) {
+	<-s.After(d)
+}
+
+// After returns a channel which receives the current time after the clock
+// has advanced by d.
+func (s *Simulated) After(d time.Duration) <-chan AbsTime {
+	after := make(chan AbsTime, 1)
+	s.insert(d, func() {
+		after <- s.Now()
+	})
+	return after
+}
+
+func (s *Simulated) insert(d time.Duration, do func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.init()
+
+	at := s.now.Add(d)
+	l, h := 0, len(s.scheduled)
+	ll := h
+	for l != h {
+		m := (l + h) / 2
+		if at < s.scheduled[m].at {
+			h = m
+		} else {
+			l = m + 1
+		}
+	}
+	s.scheduled = append(s.scheduled, event{})
+	copy(s.scheduled[l+1:], s.scheduled[l:ll])
+	s.scheduled[l] = event{do: do, at: at}
+	s.cond.Broadcast()
+}
+
+func (s *Simulated) init() {
+	if s.cond == nil {
+		s.cond = sync.NewCond(&s.mu)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package mclock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSimulatedAfter(t *testing.T) {
+	base := time.Unix(1000, 0)
+	c := NewSimulated(base)
+	first, second := c.After(2*time.Second), c.After(time.Second)
+
+	c.Run(time.Second)
+	select {
+	case now := <-second:
+		if now != AbsTime(time.Second) {
+			t.Fatalf("wrong fire time: have %v, want %v", now, time.Second)
+		}
+	default:
+		t.Fatal("timer did not fire")
+	}
+	select {
+	case <-first:
+		t.Fatal("timer fired early")
+	default:
+	}
+	if c.ActiveTimers() != 1 {
+		t.Fatalf("active timers mismatch: have %d, want 1", c.ActiveTimers())
+	}
+	c.Run(time.Second)
+	<-first
+	if have, want := c.Time(), base.Add(2*time.Second); !have.Equal(want) {
+		t.Fatalf("wall clock mismatch: have %v, want %v", have, want)
+	}
+}
@@ -47,6 +47,12 @@ type ChainReader interface {
 	GetBlock(hash common.Hash, number uint64) *types.Block
 }
 
+// DelegateReader is implemented by chains that can open the delegate state of a
+// historical block, which is needed to rebuild the shuffle list of a past round.
+type DelegateReader interface {
+	DelegateStateAt(root common.Hash) (*delegatestate.DelegateDB, error)
+}
+
 // DAC consensus engine.
 type Engine interface {
 
@@ -70,9 +76,18 @@ type Engine interface {
 	// verify confirm sign is correct
 	VerifySignatureSend(blockHash common.Hash, confirmSign []byte, currentShuffleList *types.ShuffleList) error
 
+	// verify double-sign evidence against the schedule of the chain and return the offending delegate
+	VerifyEvidence(chain ChainReader, evidence *types.DoubleSignEvidence) (common.Address, error)
+
 	// verify block when ordinary node receive
 	VerifyBlockGenerate(chain ChainReader, block *types.Block, currentShuffleList *types.ShuffleList, blockInterval int) error
 
+	// verify the delegate confirmations of an imported block against its round
+	VerifyQuorum(chain ChainReader, block *types.Block) error
+
+	// rebuild the shuffle list of the round a header was produced in
+	RoundShuffleList(chain ChainReader, header *types.Header) (*types.ShuffleList, error)
+
 	// VerifyHeaders is similar to VerifyHeader, but verifies a batch of headers
 	// concurrently. The method returns a quit channel to abort the operations and
 	// a results channel to retrieve the async verifications (the order is that of
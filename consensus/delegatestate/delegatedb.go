@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/log"
+	"github.com/Aurorachain-io/go-aoa/params"
 	"github.com/Aurorachain-io/go-aoa/rlp"
 	"github.com/Aurorachain-io/go-aoa/trie"
 	"math/big"
@@ -28,6 +30,24 @@ import (
 	"sync"
 )
 
+var (
+	missedSlotsKey = common.BytesToHash([]byte("missedSlots"))
+	jailedKey      = common.BytesToHash([]byte("jailed"))
+	unbondingKey   = common.BytesToHash([]byte("unbonding"))
+	depositKey     = common.BytesToHash([]byte("deposit"))
+	votersKey      = common.BytesToHash([]byte("voters"))
+	voterShareKey  = common.BytesToHash([]byte("voterShare"))
+	commissionKey  = common.BytesToHash([]byte("commissionRaised"))
+	revealKey      = common.BytesToHash([]byte("randaoCommit"))
+	revealCountKey = common.BytesToHash([]byte("randaoCount"))
+	rewardKey      = common.BytesToHash([]byte("rewardPerVote"))
+	rewardDebtKey  = common.BytesToHash([]byte("rewardDebt"))
+
+	// rewardPrecision scales the accumulated reward per vote to keep the
+	// rounding error of small rewards low.
+	rewardPrecision = big.NewInt(params.Em)
+)
+
 type revision struct {
 	id           int
 	journalIndex int
@@ -183,6 +203,21 @@ func (d *DelegateDB) GetDelegates() []types.Candidate {
 	return list
 }
 
+// get current sort delegates which are allowed to take part in the next shuffle,
+// jailed delegates are left out until they unjail themselves and leaving
+// delegates for good.
+func (d *DelegateDB) GetShuffleDelegates() []types.Candidate {
+	list := make([]types.Candidate, 0)
+	for _, candidate := range d.GetDelegates() {
+		address := common.HexToAddress(candidate.Address)
+		if d.IsJailed(address) || d.IsLeaving(address) {
+			continue
+		}
+		list = append(list, candidate)
+	}
+	return list
+}
+
 func (d *DelegateDB) clearJournal() {
 	d.journal = nil
 	d.validRevisions = d.validRevisions[:0]
@@ -331,6 +366,199 @@ func (d *DelegateDB) SetVote(addr common.Address, amount *big.Int) {
 	}
 }
 
+// GetMissedSlots returns the number of consecutive slots the delegate failed to produce.
+func (d *DelegateDB) GetMissedSlots(addr common.Address) uint64 {
+	return d.GetState(addr, missedSlotsKey).Big().Uint64()
+}
+
+func (d *DelegateDB) SetMissedSlots(addr common.Address, missed uint64) {
+	d.SetState(addr, missedSlotsKey, common.BigToHash(new(big.Int).SetUint64(missed)))
+}
+
+// IsJailed reports whether the delegate is excluded from shuffling.
+func (d *DelegateDB) IsJailed(addr common.Address) bool {
+	return d.GetState(addr, jailedKey) != (common.Hash{})
+}
+
+func (d *DelegateDB) Jail(addr common.Address) {
+	d.SetState(addr, jailedKey, common.BytesToHash([]byte{1}))
+}
+
+// Unjail puts the delegate back into the shuffle and resets its missed slots.
+func (d *DelegateDB) Unjail(addr common.Address) {
+	d.SetState(addr, jailedKey, common.Hash{})
+	d.SetState(addr, missedSlotsKey, common.Hash{})
+}
+
+// IsLeaving reports whether the delegate has unregistered and waits for its
+// deposit to be released.
+func (d *DelegateDB) IsLeaving(addr common.Address) bool {
+	return d.GetState(addr, unbondingKey) != (common.Hash{})
+}
+
+// GetUnbondingTime returns the time at which the deposit of a leaving delegate
+// is released, zero if the delegate is not leaving.
+func (d *DelegateDB) GetUnbondingTime(addr common.Address) uint64 {
+	return d.GetState(addr, unbondingKey).Big().Uint64()
+}
+
+// Unregister marks the delegate as leaving until the given release time.
+func (d *DelegateDB) Unregister(addr common.Address, releaseTime uint64) {
+	d.SetState(addr, unbondingKey, common.BigToHash(new(big.Int).SetUint64(releaseTime)))
+}
+
+// GetDeposit returns the registration deposit held back from a leaving delegate.
+func (d *DelegateDB) GetDeposit(addr common.Address) *big.Int {
+	return d.GetState(addr, depositKey).Big()
+}
+
+// SetDeposit records the registration deposit held back from a leaving
+// delegate, it is paid back as is once the unbonding period is over.
+func (d *DelegateDB) SetDeposit(addr common.Address, deposit *big.Int) {
+	d.SetState(addr, depositKey, common.BigToHash(deposit))
+}
+
+// GetRevealCommit returns the randao commitment the delegate has to open in
+// its next block, empty if it has none.
+func (d *DelegateDB) GetRevealCommit(addr common.Address) common.Hash {
+	return d.GetState(addr, revealKey)
+}
+
+// GetRevealCount returns the number of randao commitments the delegate made.
+func (d *DelegateDB) GetRevealCount(addr common.Address) uint64 {
+	return d.GetState(addr, revealCountKey).Big().Uint64()
+}
+
+// CommitReveal records the randao commitment the delegate made in its block.
+func (d *DelegateDB) CommitReveal(addr common.Address, commit common.Hash) {
+	d.SetState(addr, revealKey, commit)
+	d.SetState(addr, revealCountKey, common.BigToHash(new(big.Int).SetUint64(d.GetRevealCount(addr)+1)))
+}
+
+// voterSlot returns the storage key of the voter at the given index.
+func voterSlot(index uint64) common.Hash {
+	return crypto.Keccak256Hash(votersKey[:], common.BigToHash(new(big.Int).SetUint64(index)).Bytes())
+}
+
+// voterIndexSlot returns the storage key holding the position (index + 1) of the voter.
+func voterIndexSlot(voter common.Address) common.Hash {
+	return crypto.Keccak256Hash(votersKey[:], voter[:])
+}
+
+// GetVoters returns the accounts whose vote for the delegate has been indexed.
+func (d *DelegateDB) GetVoters(addr common.Address) []common.Address {
+	count := d.GetState(addr, votersKey).Big().Uint64()
+	voters := make([]common.Address, 0, count)
+	for i := uint64(0); i < count; i++ {
+		voters = append(voters, common.BytesToAddress(d.GetState(addr, voterSlot(i)).Bytes()))
+	}
+	return voters
+}
+
+// AddVoter indexes the voter of the delegate.
+func (d *DelegateDB) AddVoter(addr common.Address, voter common.Address) {
+	if d.GetState(addr, voterIndexSlot(voter)) != (common.Hash{}) {
+		return
+	}
+	count := d.GetState(addr, votersKey).Big().Uint64()
+	d.SetState(addr, voterSlot(count), voter.Hash())
+	d.SetState(addr, voterIndexSlot(voter), common.BigToHash(new(big.Int).SetUint64(count+1)))
+	d.SetState(addr, votersKey, common.BigToHash(new(big.Int).SetUint64(count+1)))
+}
+
+// RemoveVoter drops the voter from the index of the delegate, the last voter
+// is moved into the freed position.
+func (d *DelegateDB) RemoveVoter(addr common.Address, voter common.Address) {
+	position := d.GetState(addr, voterIndexSlot(voter)).Big().Uint64()
+	if position == 0 {
+		return
+	}
+	count := d.GetState(addr, votersKey).Big().Uint64()
+	last := d.GetState(addr, voterSlot(count-1))
+	if position != count {
+		d.SetState(addr, voterSlot(position-1), last)
+		d.SetState(addr, voterIndexSlot(common.BytesToAddress(last.Bytes())), common.BigToHash(new(big.Int).SetUint64(position)))
+	}
+	d.SetState(addr, voterSlot(count-1), common.Hash{})
+	d.SetState(addr, voterIndexSlot(voter), common.Hash{})
+	d.SetState(addr, votersKey, common.BigToHash(new(big.Int).SetUint64(count-1)))
+}
+
+// getRewardState reads delegate storage including removed delegates, so that
+// their voters can still collect what was earned before the removal.
+func (d *DelegateDB) getRewardState(addr common.Address, key common.Hash) common.Hash {
+	stateObject := d.getStateObjectContainDelete(addr)
+	if stateObject != nil {
+		return stateObject.GetState(d.db, key)
+	}
+	return common.Hash{}
+}
+
+func (d *DelegateDB) setRewardState(addr common.Address, key common.Hash, value common.Hash) {
+	stateObject := d.getStateObjectContainDelete(addr)
+	if stateObject != nil {
+		stateObject.SetState(d.db, key, value)
+	}
+}
+
+// GetCommission returns the share of the block rewards in basis points the
+// delegate keeps for itself. Delegates which never set a commission keep all.
+func (d *DelegateDB) GetCommission(addr common.Address) uint64 {
+	return params.CommissionDenominator - d.GetState(addr, voterShareKey).Big().Uint64()
+}
+
+func (d *DelegateDB) SetCommission(addr common.Address, commission uint64) {
+	d.SetState(addr, voterShareKey, common.BigToHash(new(big.Int).SetUint64(params.CommissionDenominator-commission)))
+}
+
+// GetCommissionRaised returns the time the delegate last raised its commission.
+func (d *DelegateDB) GetCommissionRaised(addr common.Address) uint64 {
+	return d.GetState(addr, commissionKey).Big().Uint64()
+}
+
+func (d *DelegateDB) SetCommissionRaised(addr common.Address, raised uint64) {
+	d.SetState(addr, commissionKey, common.BigToHash(new(big.Int).SetUint64(raised)))
+}
+
+// AddVoterReward distributes amount evenly over the votes of the delegate. It
+// returns the part which can't be distributed because of rounding, or the whole
+// amount if the delegate has no votes.
+func (d *DelegateDB) AddVoterReward(addr common.Address, amount *big.Int) *big.Int {
+	votes := d.GetVote(addr)
+	if votes.Sign() <= 0 {
+		return new(big.Int).Set(amount)
+	}
+	perVote := new(big.Int).Mul(amount, rewardPrecision)
+	perVote.Div(perVote, votes)
+	d.setRewardState(addr, rewardKey, common.BigToHash(new(big.Int).Add(d.getRewardState(addr, rewardKey).Big(), perVote)))
+
+	distributed := new(big.Int).Mul(perVote, votes)
+	distributed.Div(distributed, rewardPrecision)
+	return distributed.Sub(amount, distributed)
+}
+
+func rewardDebtSlot(voter common.Address) common.Hash {
+	return crypto.Keccak256Hash(rewardDebtKey[:], voter[:])
+}
+
+// PendingVoterReward returns the reward the voter has earned from its vote for
+// the delegate since it was last settled.
+func (d *DelegateDB) PendingVoterReward(addr common.Address, voter common.Address) *big.Int {
+	pending := new(big.Int).Sub(d.getRewardState(addr, rewardKey).Big(), d.getRewardState(addr, rewardDebtSlot(voter)).Big())
+	if pending.Sign() <= 0 {
+		return new(big.Int)
+	}
+	return pending.Div(pending, rewardPrecision)
+}
+
+// SettleVoterReward returns the pending reward of the voter and starts
+// accumulating again from now on.
+func (d *DelegateDB) SettleVoterReward(addr common.Address, voter common.Address) *big.Int {
+	pending := d.PendingVoterReward(addr, voter)
+	d.setRewardState(addr, rewardDebtSlot(voter), d.getRewardState(addr, rewardKey))
+	return pending
+}
+
 func (d *DelegateDB) Suicide(addr common.Address) bool {
 	stateObject := d.GetStateObject(addr)
 	if stateObject == nil {
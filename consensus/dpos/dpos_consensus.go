@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"github.com/Aurorachain-io/go-aoa/consensus"
 	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
 	"github.com/Aurorachain-io/go-aoa/core/state"
@@ -30,7 +31,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/params"
 	"github.com/Aurorachain-io/go-aoa/rlp"
 	"github.com/Aurorachain-io/go-aoa/rpc"
-	"math"
+	"github.com/hashicorp/golang-lru"
 	"math/big"
 	"runtime"
 	"strings"
@@ -41,6 +42,7 @@ import (
 var (
 	allowedFutureBlockTime = 15 * time.Second // Max time from current time allowed for blocks, before they're considered future blocks
 	errZeroBlockTime       = errors.New("timestamp equals parent's")
+	errUnknownRound        = errors.New("unknown shuffle round")
 )
 
 // Config are the configuration parameters of the ethash.
@@ -50,27 +52,33 @@ type Config struct {
 
 type DacchainDpos struct {
 	// config Config
-	lock sync.Mutex
+	lock  sync.Mutex
+	clock mclock.Clock // source of the current time, simulated in tests
+
+	shuffleLists *lru.Cache // rebuilt shuffle lists by the ShuffleHash they match
 }
 
 func New() *DacchainDpos {
-	return &DacchainDpos{}
+	return NewWithClock(mclock.System{})
 }
 
-// annul with 15% profit,AccumulateRewards credits the coinbase of the given block with the produce reward
-func accumulateEmRewards(config *params.ChainConfig, state *state.StateDB, header *types.Header) {
-	// begin with 100 reward
-	var (
-		// begin with 500 reward
-		basicReward        float64 = 500
-		annulProfit                = params.AnnulProfit
-		annulBlockAmount           = params.AnnulBlockAmount
-		blockReward                = big.NewInt(1e+18)
-	)
-	yearNumber := header.Number.Int64() / annulBlockAmount.Int64()
-	currentReward := (int64)(basicReward * math.Pow(annulProfit, float64(yearNumber)))
-	precisionReward := new(big.Int).Mul(big.NewInt(currentReward), blockReward)
-	reward := new(big.Int).Set(precisionReward)
+// NewWithClock creates a dpos engine which checks block times against the
+// given clock.
+func NewWithClock(clock mclock.Clock) *DacchainDpos {
+	shuffleLists, err := lru.New(shuffleListCacheLimit)
+	if err != nil {
+		panic(err)
+	}
+	return &DacchainDpos{clock: clock, shuffleLists: shuffleLists}
+}
+
+// accumulateEmRewards credits the coinbase of the given block with the produce
+// reward of the chain's reward schedule.
+func accumulateEmRewards(config *params.ChainConfig, state *state.StateDB, dState *delegatestate.DelegateDB, header *types.Header) {
+	reward := blockReward(config.RewardSchedule(), header.Number)
+	if config.IsRewardShare(header.Number) {
+		reward = shareVoterReward(dState, header.Coinbase, reward)
+	}
 	state.AddBalance(header.Coinbase, reward)
 }
 
@@ -84,7 +92,25 @@ func (d *DacchainDpos) Prepare(chain consensus.ChainReader, header *types.Header
 }
 
 func (d *DacchainDpos) Finalize(chain consensus.ChainReader, header *types.Header, state *state.StateDB, dState *delegatestate.DelegateDB, txs []*types.Transaction, receipts []*types.Receipt) (*types.Block, error) {
-	accumulateEmRewards(chain.Config(), state, header)
+	if chain.Config().IsRandao(header.Number) {
+		if err := applyReveal(header, dState); err != nil {
+			return nil, err
+		}
+	}
+	accumulateEmRewards(chain.Config(), state, dState, header)
+	if chain.Config().IsJail(header.Number) {
+		if err := d.trackMissedSlots(chain, header, dState); err != nil {
+			return nil, err
+		}
+	}
+	if fork := chain.Config().UnregisterBlock; fork != nil && fork.Cmp(header.Number) == 0 {
+		if err := seedVoterIndex(state, dState); err != nil {
+			return nil, err
+		}
+	}
+	if chain.Config().IsUnregister(header.Number) {
+		releaseUnbondedDelegates(chain.Config(), header, state, dState)
+	}
 
 	header.Root = state.IntermediateRoot(false)
 	header.DelegateRoot = dState.IntermediateRoot(false)
@@ -98,7 +124,7 @@ func (d *DacchainDpos) VerifyHeaderAndSign(chain consensus.ChainReader, block *t
 	header := block.Header()
 	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
 	blockTime := header.Time.Uint64()
-	currentTime := time.Now().Unix()
+	currentTime := d.clock.Time().Unix()
 	if currentTime < int64(blockTime) || (currentTime >= (int64(blockTime) + int64(blockInterval))) {
 		errMsg := fmt.Sprintf("block time is expire|blockNumber:%d blockTime:%d currentTime:%d", block.NumberU64(), blockTime, currentTime)
 		return errors.New(errMsg)
@@ -164,37 +190,62 @@ func (d *DacchainDpos) VerifySignatureSend(blockHash common.Hash, confirmSign []
 }
 
 func (d *DacchainDpos) VerifyBlockGenerate(chain consensus.ChainReader, block *types.Block, currentShuffleList *types.ShuffleList, blockInterval int) error {
-	genesisConfig := chain.Config()
-	maxElectDelegate := genesisConfig.MaxElectDelegate.Int64()
-	delegateAmount := (maxElectDelegate / 3) * 2
 	err := d.VerifyHeaderAndSign(chain, block, currentShuffleList, blockInterval)
 	if err != nil {
 		return err
 	}
-	// rlp decode
-	rlpEncodeSigns := block.RlpEncodeSigns
-	var signs []types.VoteSign
-	err = rlp.DecodeBytes(rlpEncodeSigns, &signs)
+	return verifyQuorum(chain.Config(), block, currentShuffleList)
+}
+
+// VerifyQuorum checks that the confirmations attached to an already imported
+// block were signed by more than two thirds of the delegates of its round. The
+// round is rebuilt from the chain, so this also works for historical blocks.
+func (d *DacchainDpos) VerifyQuorum(chain consensus.ChainReader, block *types.Block) error {
+	shuffleList, err := d.RoundShuffleList(chain, block.Header())
 	if err != nil {
 		return err
 	}
+	return verifyQuorum(chain.Config(), block, shuffleList)
+}
 
-	checkSignAddressMap := make(map[string]byte, 0)
-	blockHashBytes := block.Hash().Bytes()
-	for _, sign := range signs {
-		tempPubKey, _ := secp256k1.RecoverPubkey(blockHashBytes[:32], sign.Sign)
-		tempPubAddress := crypto.PubkeyToAddress(*crypto.ToECDSAPub(tempPubKey)).Hex()
-		if checkInShuffleList(currentShuffleList, tempPubAddress) {
-			checkSignAddressMap[tempPubAddress] = 1
-		}
+// verifyQuorum checks that block.RlpEncodeSigns holds the confirmations of a
+// quorum of the delegates in the shuffle list.
+func verifyQuorum(config *params.ChainConfig, block *types.Block, currentShuffleList *types.ShuffleList) error {
+	var signs []types.VoteSign
+	if err := rlp.DecodeBytes(block.RlpEncodeSigns, &signs); err != nil {
+		return err
 	}
-	if len(checkSignAddressMap) > int(delegateAmount) {
+	signers := QuorumSigners(block.Hash(), signs, currentShuffleList)
+	if len(signers) >= QuorumSize(config) {
 		return nil
 	}
-	errMsg := fmt.Sprintf("delegate sign not enough blockNumber:%d need check signs:%d actual check signs:%d", block.NumberU64(), delegateAmount, len(checkSignAddressMap))
+	errMsg := fmt.Sprintf("delegate sign not enough blockNumber:%d need check signs:%d actual check signs:%d", block.NumberU64(), QuorumSize(config), len(signers))
 	return errors.New(errMsg)
 }
 
+// QuorumSize returns the number of distinct delegate confirmations a block
+// needs, which is more than two thirds of the elected delegates.
+func QuorumSize(config *params.ChainConfig) int {
+	return int(config.MaxElectDelegate.Int64()/3*2) + 1
+}
+
+// QuorumSigners returns the distinct delegates of the shuffle list which signed
+// the block hash with one of signs. Invalid signatures are ignored.
+func QuorumSigners(hash common.Hash, signs []types.VoteSign, shuffleList *types.ShuffleList) map[common.Address]bool {
+	signers := make(map[common.Address]bool)
+	for _, sign := range signs {
+		pubkey, err := crypto.SigToPub(hash.Bytes(), sign.Sign)
+		if err != nil {
+			continue
+		}
+		address := crypto.PubkeyToAddress(*pubkey)
+		if checkInShuffleList(shuffleList, address.Hex()) {
+			signers[address] = true
+		}
+	}
+	return signers
+}
+
 // VerifyHeaders is similar to VerifyHeader, but verifies a batch of headers
 // concurrently. The method returns a quit channel to abort the operations and
 // a results channel to retrieve the async verifications.
@@ -278,12 +329,17 @@ func (d *DacchainDpos) verifyHeaders(chain consensus.ChainReader, headers []*typ
 
 // verifyHeader checks whether a header conforms to the consensus rules of the DAC engine
 func (d *DacchainDpos) verifyHeader(chain consensus.ChainReader, header, parent *types.Header) error {
-	// Ensure that the header's extra-data section is of a reasonable size
-	if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
+	// Ensure that the header's extra-data section is of a reasonable size, it
+	// holds the randao reveal of the producer since the randao fork
+	if chain.Config().IsRandao(header.Number) {
+		if err := VerifyReveal(header); err != nil {
+			return err
+		}
+	} else if uint64(len(header.Extra)) > params.MaximumExtraDataSize {
 		return fmt.Errorf("extra-data too long: %d > %d", len(header.Extra), params.MaximumExtraDataSize)
 	}
 
-	if header.Time.Cmp(big.NewInt(time.Now().Add(allowedFutureBlockTime).Unix())) > 0 {
+	if header.Time.Cmp(big.NewInt(d.clock.Time().Add(allowedFutureBlockTime).Unix())) > 0 {
 		return consensus.ErrFutureBlock
 	}
 
@@ -324,7 +380,7 @@ func (d *DacchainDpos) VerifyHeader(chain consensus.ChainReader, header *types.H
 	if chain.GetHeader(header.Hash(), number) != nil {
 		return nil
 	}
-	if time.Now().Unix() < header.Time.Int64() {
+	if d.clock.Time().Unix() < header.Time.Int64() {
 		return consensus.ErrFutureBlock
 	}
 	parent := chain.GetHeader(header.ParentHash, number-1)
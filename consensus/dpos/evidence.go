@@ -0,0 +1,94 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"errors"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"strings"
+)
+
+var (
+	errSameEvidenceBlock = errors.New("evidence headers are the same block")
+	errEvidenceSlot      = errors.New("evidence headers are not in the same slot")
+	errEvidenceSigner    = errors.New("evidence headers signed by different delegates")
+	errEvidenceNotSigned = errors.New("evidence header without signature")
+	errEvidenceRound     = errors.New("evidence round can't be rebuilt")
+	errEvidenceProducer  = errors.New("evidence signer not scheduled for the slot")
+	errEvidenceConfirmer = errors.New("evidence signer not a delegate of the round")
+)
+
+// VerifyEvidence checks that both headers of the evidence are different blocks
+// for the same slot, signed by the same delegate, and returns that delegate.
+// The shuffle list of the round of each header is rebuilt from the chain. Blocks
+// are only signed by the producer scheduled for the slot, while every delegate
+// of the round sends VoteSign confirmations, so confirmation evidence blames any
+// delegate of the round.
+func (d *DacchainDpos) VerifyEvidence(chain consensus.ChainReader, evidence *types.DoubleSignEvidence) (common.Address, error) {
+	if evidence.HeaderA == nil || evidence.HeaderB == nil || len(evidence.SignA) == 0 || len(evidence.SignB) == 0 {
+		return common.Address{}, errEvidenceNotSigned
+	}
+	if evidence.HeaderA.Hash() == evidence.HeaderB.Hash() {
+		return common.Address{}, errSameEvidenceBlock
+	}
+	if evidence.HeaderA.Time == nil || evidence.HeaderB.Time == nil || evidence.HeaderA.Time.Cmp(evidence.HeaderB.Time) != 0 {
+		return common.Address{}, errEvidenceSlot
+	}
+	signerA, signerB, err := evidence.Signers()
+	if err != nil {
+		return common.Address{}, err
+	}
+	if signerA != signerB {
+		return common.Address{}, errEvidenceSigner
+	}
+	for _, header := range []*types.Header{evidence.HeaderA, evidence.HeaderB} {
+		shuffleList, err := d.RoundShuffleList(chain, header)
+		if err != nil {
+			return common.Address{}, errEvidenceRound
+		}
+		if evidence.Confirm {
+			if !roundDelegate(shuffleList, signerA) {
+				return common.Address{}, errEvidenceConfirmer
+			}
+		} else if !slotProducer(shuffleList, header.Time.Uint64(), signerA) {
+			return common.Address{}, errEvidenceProducer
+		}
+	}
+	return signerA, nil
+}
+
+// slotProducer reports whether the delegate is scheduled to produce at slotTime.
+func slotProducer(shuffleList *types.ShuffleList, slotTime uint64, delegate common.Address) bool {
+	for _, del := range shuffleList.ShuffleDels {
+		if del.WorkTime == slotTime && strings.EqualFold(del.Address, delegate.Hex()) {
+			return true
+		}
+	}
+	return false
+}
+
+// roundDelegate reports whether the delegate is in the shuffle list of the round.
+func roundDelegate(shuffleList *types.ShuffleList, delegate common.Address) bool {
+	for _, del := range shuffleList.ShuffleDels {
+		if strings.EqualFold(del.Address, delegate.Hex()) {
+			return true
+		}
+	}
+	return false
+}
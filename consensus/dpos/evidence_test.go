@@ -0,0 +1,106 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+	"github.com/Aurorachain-io/go-aoa/util"
+	"math/big"
+	"testing"
+)
+
+func signHeader(t *testing.T, header *types.Header, key []byte) []byte {
+	prv, err := crypto.ToECDSA(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign, err := crypto.Sign(header.Hash().Bytes(), prv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sign
+}
+
+func TestVerifyEvidence(t *testing.T) {
+	offenderKey := common.FromHex("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	otherKey := common.FromHex("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a")
+	outsiderKey := common.FromHex("49a7b37aa6f6645917e7b807e9d1c00d4fa71f18343b0d4122a4d2df64dd6fee")
+	prv, _ := crypto.ToECDSA(offenderKey)
+	offender := crypto.PubkeyToAddress(prv.PublicKey)
+	prv, _ = crypto.ToECDSA(otherKey)
+	other := crypto.PubkeyToAddress(prv.PublicKey)
+
+	chain, dState := newTestChainWithDelegates(t, []common.Address{offender, other, {3}})
+	shuffleList := types.ShuffleList{ShuffleDels: util.ShuffleNewRound(1030, 3, dState.GetShuffleDelegates(), 10)}
+	var offenderSlot, otherSlot int64
+	for _, del := range shuffleList.ShuffleDels {
+		switch common.HexToAddress(del.Address) {
+		case offender:
+			offenderSlot = int64(del.WorkTime)
+		case other:
+			otherSlot = int64(del.WorkTime)
+		}
+	}
+	slotHeader := func(slot int64, extra string) *types.Header {
+		return &types.Header{Number: big.NewInt(1), Time: big.NewInt(slot), Coinbase: offender, ShuffleHash: shuffleList.Hash(), ShuffleBlockNumber: big.NewInt(0), Extra: []byte(extra)}
+	}
+	headerA := slotHeader(offenderSlot, "")
+	headerB := slotHeader(offenderSlot, "fork")
+	headerC := slotHeader(otherSlot, "")
+	headerD := slotHeader(otherSlot, "fork")
+	unknownRound := slotHeader(offenderSlot, "unknown")
+	unknownRound.ShuffleHash = common.Hash{1}
+
+	tests := []struct {
+		evidence *types.DoubleSignEvidence
+		err      error
+	}{
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, offenderKey), HeaderB: headerB, SignB: signHeader(t, headerB, offenderKey)}, nil},
+		// any delegate of the round confirms, not only the producer of the slot
+		{&types.DoubleSignEvidence{HeaderA: headerC, SignA: signHeader(t, headerC, offenderKey), HeaderB: headerD, SignB: signHeader(t, headerD, offenderKey), Confirm: true}, nil},
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, outsiderKey), HeaderB: headerB, SignB: signHeader(t, headerB, outsiderKey), Confirm: true}, errEvidenceConfirmer},
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, offenderKey), HeaderB: headerA, SignB: signHeader(t, headerA, offenderKey)}, errSameEvidenceBlock},
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, offenderKey), HeaderB: headerC, SignB: signHeader(t, headerC, offenderKey)}, errEvidenceSlot},
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, offenderKey), HeaderB: headerB, SignB: signHeader(t, headerB, otherKey)}, errEvidenceSigner},
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, offenderKey), HeaderB: headerB}, errEvidenceNotSigned},
+		// two signatures in the slot of another delegate
+		{&types.DoubleSignEvidence{HeaderA: headerC, SignA: signHeader(t, headerC, offenderKey), HeaderB: headerD, SignB: signHeader(t, headerD, offenderKey)}, errEvidenceProducer},
+		{&types.DoubleSignEvidence{HeaderA: headerA, SignA: signHeader(t, headerA, offenderKey), HeaderB: unknownRound, SignB: signHeader(t, unknownRound, offenderKey)}, errEvidenceRound},
+	}
+	engine := New()
+	for i, test := range tests {
+		// evidence travels rlp encoded in the transaction data
+		data, err := rlp.EncodeToBytes(test.evidence)
+		if err != nil {
+			t.Fatalf("test %d: failed to encode evidence: %v", i, err)
+		}
+		evidence, err := types.DecodeDoubleSignEvidence(data)
+		if err != nil {
+			t.Fatalf("test %d: failed to decode evidence: %v", i, err)
+		}
+		signer, err := engine.VerifyEvidence(chain, evidence)
+		if err != test.err {
+			t.Errorf("test %d: error mismatch: have %v, want %v", i, err, test.err)
+		}
+		if err == nil && signer != offender {
+			t.Errorf("test %d: offender mismatch: have %x, want %x", i, signer, offender)
+		}
+	}
+}
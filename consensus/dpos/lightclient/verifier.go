@@ -0,0 +1,229 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package lightclient verifies a dpos header chain from a trusted checkpoint
+// using only headers, shuffle lists and the delegate confirmations of each
+// block, without access to the state. It is meant for light clients and for
+// relays which follow the chain from another network.
+package lightclient
+
+import (
+	"errors"
+	"fmt"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+	"strings"
+	"sync"
+)
+
+var (
+	errCheckpointHash    = errors.New("checkpoint header doesn't match trusted hash")
+	errShuffleHash       = errors.New("shuffle list doesn't match shuffle hash")
+	errUnknownParent     = errors.New("header doesn't extend the verified chain")
+	errMissingShuffle    = errors.New("round transition without shuffle list")
+	errShuffleNumber     = errors.New("invalid shuffle block number")
+	errStaleRound        = errors.New("shuffle list doesn't start after the current round")
+	errNotScheduled      = errors.New("coinbase not scheduled for the header's slot")
+	errInsufficientTrust = errors.New("round transition not confirmed by the previous round")
+)
+
+// Checkpoint is a trusted block the verification starts from, usually taken
+// from a full node or hard-coded in the relay. ShuffleList must be the list of
+// the round Header was produced in.
+type Checkpoint struct {
+	Hash        common.Hash
+	Header      *types.Header
+	ShuffleList *types.ShuffleList
+}
+
+// SignedHeader is a header with the delegate confirmations collected for it.
+// ShuffleList is only needed for the first header of a new round.
+type SignedHeader struct {
+	Header      *types.Header
+	Signs       []types.VoteSign
+	ShuffleList *types.ShuffleList
+}
+
+// NewSignedHeader extracts the header and confirmations of a block received
+// from the network.
+func NewSignedHeader(block *types.Block, shuffleList *types.ShuffleList) (*SignedHeader, error) {
+	var signs []types.VoteSign
+	if err := rlp.DecodeBytes(block.RlpEncodeSigns, &signs); err != nil {
+		return nil, err
+	}
+	return &SignedHeader{Header: block.Header(), Signs: signs, ShuffleList: shuffleList}, nil
+}
+
+// Verifier follows a header chain from a checkpoint. Every header must carry
+// a quorum of confirmations of the delegates of its round. A new round is only
+// accepted if its shuffle list matches the committed ShuffleHash, was elected
+// at a block of the verified chain, and more than one third of the previous
+// round's delegates confirmed its first header, so at least one honest delegate
+// of the trusted set vouches for it.
+type Verifier struct {
+	config *params.ChainConfig
+
+	head        *types.Header
+	shuffleList *types.ShuffleList
+	delegates   map[common.Address]bool
+
+	lock sync.RWMutex
+}
+
+// New creates a verifier starting from the given checkpoint.
+func New(config *params.ChainConfig, checkpoint *Checkpoint) (*Verifier, error) {
+	if checkpoint.Header.Hash() != checkpoint.Hash {
+		return nil, errCheckpointHash
+	}
+	if checkpoint.ShuffleList.Hash() != checkpoint.Header.ShuffleHash {
+		return nil, errShuffleHash
+	}
+	return &Verifier{
+		config:      config,
+		head:        checkpoint.Header,
+		shuffleList: checkpoint.ShuffleList,
+		delegates:   shuffleDelegates(checkpoint.ShuffleList),
+	}, nil
+}
+
+// Head returns the last verified header.
+func (v *Verifier) Head() *types.Header {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	return v.head
+}
+
+// ShuffleList returns the shuffle list of the round of the last verified header.
+func (v *Verifier) ShuffleList() *types.ShuffleList {
+	v.lock.RLock()
+	defer v.lock.RUnlock()
+
+	return v.shuffleList
+}
+
+// VerifyHeaders verifies a contiguous batch of headers extending the verified
+// chain. It returns the index of the first invalid header and the error; the
+// headers before it stay verified.
+func (v *Verifier) VerifyHeaders(headers []*SignedHeader) (int, error) {
+	for i, header := range headers {
+		if err := v.VerifyHeader(header); err != nil {
+			return i, err
+		}
+	}
+	return 0, nil
+}
+
+// VerifyHeader verifies the child of the last verified header and makes it
+// the new head.
+func (v *Verifier) VerifyHeader(signed *SignedHeader) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	header := signed.Header
+	if header.ParentHash != v.head.Hash() || header.Number.Uint64() != v.head.Number.Uint64()+1 {
+		return errUnknownParent
+	}
+	if header.Time.Cmp(v.head.Time) <= 0 {
+		return fmt.Errorf("header time %v not after parent time %v", header.Time, v.head.Time)
+	}
+	// without the delegate state only the layout of the randao data can be
+	// checked, the quorum vouches for the reveal itself
+	if v.config.IsRandao(header.Number) {
+		if err := dpos.VerifyReveal(header); err != nil {
+			return err
+		}
+	}
+	shuffleList, delegates := v.shuffleList, v.delegates
+	transition := header.ShuffleHash != v.head.ShuffleHash
+	if transition {
+		if err := v.verifyTransition(signed); err != nil {
+			return err
+		}
+		shuffleList, delegates = signed.ShuffleList, shuffleDelegates(signed.ShuffleList)
+	} else if header.ShuffleBlockNumber.Cmp(v.head.ShuffleBlockNumber) != 0 {
+		return errShuffleNumber
+	}
+	if !scheduled(shuffleList, header) {
+		return errNotScheduled
+	}
+	signers := dpos.QuorumSigners(header.Hash(), signed.Signs, shuffleList)
+	if len(signers) < dpos.QuorumSize(v.config) {
+		return fmt.Errorf("not enough confirmations: have %d, want %d", len(signers), dpos.QuorumSize(v.config))
+	}
+	if transition {
+		trusted := 0
+		for signer := range signers {
+			if v.delegates[signer] {
+				trusted++
+			}
+		}
+		if trusted*3 <= len(v.delegates) {
+			return errInsufficientTrust
+		}
+	}
+	v.head, v.shuffleList, v.delegates = header, shuffleList, delegates
+	return nil
+}
+
+// verifyTransition checks the shuffle list of the first header of a round.
+func (v *Verifier) verifyTransition(signed *SignedHeader) error {
+	header := signed.Header
+	if signed.ShuffleList == nil {
+		return errMissingShuffle
+	}
+	if signed.ShuffleList.Hash() != header.ShuffleHash {
+		return errShuffleHash
+	}
+	// the round is elected from the delegate state of a block of the previous
+	// round, which is part of the verified chain
+	if header.ShuffleBlockNumber.Cmp(v.head.ShuffleBlockNumber) < 0 || header.ShuffleBlockNumber.Cmp(header.Number) >= 0 {
+		return errShuffleNumber
+	}
+	var lastSlot uint64
+	for _, del := range v.shuffleList.ShuffleDels {
+		if del.WorkTime > lastSlot {
+			lastSlot = del.WorkTime
+		}
+	}
+	for _, del := range signed.ShuffleList.ShuffleDels {
+		if del.WorkTime <= lastSlot {
+			return errStaleRound
+		}
+	}
+	return nil
+}
+
+// scheduled reports whether the coinbase of header owns the slot of its time.
+func scheduled(shuffleList *types.ShuffleList, header *types.Header) bool {
+	for _, del := range shuffleList.ShuffleDels {
+		if del.WorkTime == header.Time.Uint64() && strings.EqualFold(del.Address, header.Coinbase.Hex()) {
+			return true
+		}
+	}
+	return false
+}
+
+func shuffleDelegates(shuffleList *types.ShuffleList) map[common.Address]bool {
+	delegates := make(map[common.Address]bool, len(shuffleList.ShuffleDels))
+	for _, del := range shuffleList.ShuffleDels {
+		delegates[common.HexToAddress(del.Address)] = true
+	}
+	return delegates
+}
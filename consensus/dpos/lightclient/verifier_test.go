@@ -0,0 +1,147 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package lightclient
+
+import (
+	"crypto/ecdsa"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
+	"testing"
+)
+
+var (
+	testKeys   []*ecdsa.PrivateKey
+	testConfig = &params.ChainConfig{ChainId: big.NewInt(1), MaxElectDelegate: big.NewInt(3), BlockInterval: big.NewInt(10)}
+)
+
+func init() {
+	for i := 0; i < 6; i++ {
+		key, _ := crypto.GenerateKey()
+		testKeys = append(testKeys, key)
+	}
+}
+
+func address(i int) common.Address {
+	return crypto.PubkeyToAddress(testKeys[i].PublicKey)
+}
+
+// newShuffleList schedules the delegates with the given key indexes one after
+// another from start on.
+func newShuffleList(start uint64, delegates ...int) *types.ShuffleList {
+	list := new(types.ShuffleList)
+	for i, d := range delegates {
+		list.ShuffleDels = append(list.ShuffleDels, types.ShuffleDel{WorkTime: start + uint64(i)*10, Address: address(d).Hex()})
+	}
+	return list
+}
+
+// newSignedHeader creates the child of parent produced by the delegate in the
+// given slot and confirmed by the signers.
+func newSignedHeader(parent *types.Header, list *types.ShuffleList, shuffleNumber int64, slot int, signers ...int) *SignedHeader {
+	del := list.ShuffleDels[slot]
+	header := &types.Header{
+		ParentHash:         parent.Hash(),
+		Number:             new(big.Int).Add(parent.Number, common.Big1),
+		Time:               new(big.Int).SetUint64(del.WorkTime),
+		Coinbase:           common.HexToAddress(del.Address),
+		ShuffleHash:        list.Hash(),
+		ShuffleBlockNumber: big.NewInt(shuffleNumber),
+	}
+	signed := &SignedHeader{Header: header}
+	for _, s := range signers {
+		sign, _ := crypto.Sign(header.Hash().Bytes(), testKeys[s])
+		signed.Signs = append(signed.Signs, types.VoteSign{Sign: sign})
+	}
+	return signed
+}
+
+func newTestVerifier(t *testing.T) (*Verifier, *types.ShuffleList) {
+	list := newShuffleList(1010, 0, 1, 2)
+	genesis := &types.Header{Number: big.NewInt(0), Time: big.NewInt(1000), ShuffleHash: list.Hash(), ShuffleBlockNumber: big.NewInt(0)}
+	verifier, err := New(testConfig, &Checkpoint{Hash: genesis.Hash(), Header: genesis, ShuffleList: list})
+	if err != nil {
+		t.Fatalf("failed to create verifier: %v", err)
+	}
+	return verifier, list
+}
+
+func TestVerifyRound(t *testing.T) {
+	verifier, list := newTestVerifier(t)
+
+	var headers []*SignedHeader
+	parent := verifier.Head()
+	for slot := 0; slot < 3; slot++ {
+		signed := newSignedHeader(parent, list, 0, slot, 0, 1, 2)
+		headers = append(headers, signed)
+		parent = signed.Header
+	}
+	if i, err := verifier.VerifyHeaders(headers); err != nil {
+		t.Fatalf("header %d: failed to verify: %v", i, err)
+	}
+	// the next round keeps two of the three delegates
+	next := newShuffleList(1040, 3, 1, 2)
+	transition := newSignedHeader(parent, next, 3, 0, 1, 2, 3)
+	if err := verifier.VerifyHeader(transition); err != errMissingShuffle {
+		t.Fatalf("transition without shuffle list: have %v, want %v", err, errMissingShuffle)
+	}
+	transition.ShuffleList = next
+	if err := verifier.VerifyHeader(transition); err != nil {
+		t.Fatalf("failed to verify round transition: %v", err)
+	}
+	if verifier.Head().Hash() != transition.Header.Hash() || verifier.ShuffleList().Hash() != next.Hash() {
+		t.Fatalf("verifier didn't advance to the new round")
+	}
+}
+
+func TestVerifyInvalidHeaders(t *testing.T) {
+	verifier, list := newTestVerifier(t)
+	genesis := verifier.Head()
+
+	// missing confirmation
+	if err := verifier.VerifyHeader(newSignedHeader(genesis, list, 0, 0, 0, 1)); err == nil {
+		t.Fatalf("header without quorum accepted")
+	}
+	// confirmations by outsiders don't count
+	if err := verifier.VerifyHeader(newSignedHeader(genesis, list, 0, 0, 0, 1, 3)); err == nil {
+		t.Fatalf("header confirmed by outsider accepted")
+	}
+	// producer out of its slot
+	signed := newSignedHeader(genesis, list, 0, 0, 0, 1, 2)
+	signed.Header.Coinbase = address(1)
+	if err := verifier.VerifyHeader(signed); err != errNotScheduled {
+		t.Fatalf("unscheduled producer: have %v, want %v", err, errNotScheduled)
+	}
+	// a round of unknown delegates is rejected even with a full quorum
+	forged := newShuffleList(1010, 3, 4, 5)
+	signed = newSignedHeader(genesis, forged, 0, 0, 3, 4, 5)
+	signed.ShuffleList = forged
+	if err := verifier.VerifyHeader(signed); err != errStaleRound {
+		t.Fatalf("overlapping round: have %v, want %v", err, errStaleRound)
+	}
+	forged = newShuffleList(1040, 3, 4, 5)
+	signed = newSignedHeader(genesis, forged, 0, 0, 3, 4, 5)
+	signed.ShuffleList = forged
+	if err := verifier.VerifyHeader(signed); err != errInsufficientTrust {
+		t.Fatalf("forged round: have %v, want %v", err, errInsufficientTrust)
+	}
+	if verifier.Head().Hash() != genesis.Hash() {
+		t.Fatalf("verifier advanced on invalid headers")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"errors"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/log"
+)
+
+// shuffleListCacheLimit is the number of rebuilt rounds kept in memory.
+const shuffleListCacheLimit = 16
+
+var (
+	errNoDelegateReader = errors.New("chain can't open historical delegate states")
+	errNoDelegates      = errors.New("no delegates to shuffle")
+	errShuffleMismatch  = errors.New("rebuilt shuffle list doesn't match the shuffle hash")
+)
+
+// trackMissedSlots counts the slots between the parent block and header whose
+// scheduled delegate did not produce, and jails delegates that reach the
+// configured threshold. The producer of header has its counter reset. Only the
+// rounds of the parent and of header are walked, the chain advanced in them. The
+// rounds in between had no block at all, which is an outage of the network
+// rather than of its delegates, so they are not charged. Jailing never leaves
+// fewer delegates in the shuffle than the configured minimum.
+func (d *DacchainDpos) trackMissedSlots(chain consensus.ChainReader, header *types.Header, dState *delegatestate.DelegateDB) error {
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	if dState.GetMissedSlots(header.Coinbase) > 0 {
+		dState.SetMissedSlots(header.Coinbase, 0)
+	}
+	// the first block has no previous slot to compare against
+	if parent.Number.Sign() == 0 {
+		return nil
+	}
+	genesis := chain.GetHeaderByNumber(0)
+	if genesis == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	config := chain.Config()
+	roundTime := config.MaxElectDelegate.Int64() * config.BlockInterval.Int64()
+	rounds := []*types.Header{header}
+	if roundStart(genesis, parent.Time.Int64(), roundTime) != roundStart(genesis, header.Time.Int64(), roundTime) {
+		rounds = []*types.Header{parent, header}
+	}
+	var (
+		threshold = config.JailThreshold()
+		minActive = config.MinActiveDelegateCount()
+		active    = len(dState.GetShuffleDelegates())
+	)
+	for _, round := range rounds {
+		shuffleList, err := d.RoundShuffleList(chain, round)
+		if err != nil {
+			return err
+		}
+		for _, del := range shuffleList.ShuffleDels {
+			if del.WorkTime <= parent.Time.Uint64() || del.WorkTime >= header.Time.Uint64() {
+				continue
+			}
+			address := common.HexToAddress(del.Address)
+			if !dState.Exist(address) || dState.IsJailed(address) {
+				continue
+			}
+			missed := dState.GetMissedSlots(address) + 1
+			dState.SetMissedSlots(address, missed)
+			if missed < threshold {
+				continue
+			}
+			if active <= minActive {
+				log.Warn("dpos|too few active delegates to jail", "blockNumber", header.Number, "address", del.Address, "missedSlots", missed, "active", active)
+				continue
+			}
+			log.Info("dpos|jail delegate", "blockNumber", header.Number, "address", del.Address, "missedSlots", missed)
+			dState.Jail(address)
+			if !dState.IsLeaving(address) {
+				active--
+			}
+		}
+	}
+	return nil
+}
+
+// roundStart returns the start time of the round that blockTime falls in.
+func roundStart(genesis *types.Header, blockTime, roundTime int64) int64 {
+	return blockTime - (blockTime-genesis.Time.Int64())%roundTime
+}
+
+// RoundShuffleList rebuilds the shuffle list of the round that header was produced
+// in, from the delegate state at ShuffleBlockNumber. It fails if the list can't
+// be rebuilt or doesn't match the ShuffleHash committed by the header.
+// The list is shared with other callers and must not be modified.
+func (d *DacchainDpos) RoundShuffleList(chain consensus.ChainReader, header *types.Header) (*types.ShuffleList, error) {
+	if header.ShuffleBlockNumber == nil {
+		return nil, errUnknownRound
+	}
+	if cached, ok := d.shuffleLists.Get(header.ShuffleHash); ok {
+		return cached.(*types.ShuffleList), nil
+	}
+	genesis := chain.GetHeaderByNumber(0)
+	shuffleHeader := chain.GetHeaderByNumber(header.ShuffleBlockNumber.Uint64())
+	if genesis == nil || shuffleHeader == nil {
+		return nil, errUnknownRound
+	}
+	config := chain.Config()
+	roundTime := config.MaxElectDelegate.Int64() * config.BlockInterval.Int64()
+	shuffleList, err := NewRoundShuffleList(chain, shuffleHeader, roundStart(genesis, header.Time.Int64(), roundTime))
+	if err != nil {
+		return nil, err
+	}
+	if shuffleList.Hash() != header.ShuffleHash {
+		return nil, errShuffleMismatch
+	}
+	d.shuffleLists.Add(header.ShuffleHash, shuffleList)
+	return shuffleList, nil
+}
+
+// NewRoundShuffleList shuffles the top delegates in the delegate state of
+// shuffleHeader for the round starting at shuffleTime.
+func NewRoundShuffleList(chain consensus.ChainReader, shuffleHeader *types.Header, shuffleTime int64) (*types.ShuffleList, error) {
+	reader, ok := chain.(consensus.DelegateReader)
+	if !ok {
+		return nil, errNoDelegateReader
+	}
+	dState, err := reader.DelegateStateAt(shuffleHeader.DelegateRoot)
+	if err != nil {
+		return nil, err
+	}
+	maxElectDelegate := int(chain.Config().MaxElectDelegate.Int64())
+	topDelegates := dState.GetShuffleDelegates()
+	if len(topDelegates) == 0 {
+		return nil, errNoDelegates
+	}
+	if len(topDelegates) > maxElectDelegate {
+		topDelegates = topDelegates[:maxElectDelegate]
+	}
+	return &types.ShuffleList{ShuffleDels: NewRoundShuffle(chain, shuffleHeader, shuffleTime, topDelegates)}, nil
+}
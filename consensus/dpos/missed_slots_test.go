@@ -0,0 +1,271 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/util"
+	"math/big"
+	"testing"
+)
+
+// testChain is a minimal in-memory chain implementing consensus.ChainReader
+// and consensus.DelegateReader.
+type testChain struct {
+	config  *params.ChainConfig
+	engine  *DacchainDpos
+	memdb   *aoadb.MemDatabase
+	db      delegatestate.Database
+	headers []*types.Header
+}
+
+func (c *testChain) Config() *params.ChainConfig  { return c.config }
+func (c *testChain) CurrentHeader() *types.Header { return c.headers[len(c.headers)-1] }
+func (c *testChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if h := c.GetHeaderByNumber(number); h != nil && h.Hash() == hash {
+		return h
+	}
+	return nil
+}
+func (c *testChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number < uint64(len(c.headers)) {
+		return c.headers[number]
+	}
+	return nil
+}
+func (c *testChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, h := range c.headers {
+		if h.Hash() == hash {
+			return h
+		}
+	}
+	return nil
+}
+func (c *testChain) GetBlock(hash common.Hash, number uint64) *types.Block { return nil }
+func (c *testChain) DelegateStateAt(root common.Hash) (*delegatestate.DelegateDB, error) {
+	return delegatestate.New(root, c.db)
+}
+
+// newTestChain creates a chain with three registered delegates and a genesis
+// block at time 1000, so rounds start at 1000 + n*30.
+func newTestChain(t *testing.T) (*testChain, *delegatestate.DelegateDB) {
+	return newTestChainWithDelegates(t, []common.Address{{1}, {2}, {3}})
+}
+
+// newTestChainWithDelegates is newTestChain with the given three delegates.
+func newTestChainWithDelegates(t *testing.T, delegates []common.Address) (*testChain, *delegatestate.DelegateDB) {
+	memdb, _ := aoadb.NewMemDatabase()
+	db := delegatestate.NewDatabase(memdb)
+	dState, _ := delegatestate.New(common.Hash{}, db)
+	for i, delegate := range delegates {
+		dState.GetOrNewStateObject(delegate, "node", uint64(i+1))
+	}
+	root, err := dState.CommitTo(memdb, false)
+	if err != nil {
+		t.Fatalf("failed to commit delegate state: %v", err)
+	}
+	config := &params.ChainConfig{
+		ChainId:            big.NewInt(1),
+		MaxElectDelegate:   big.NewInt(3),
+		BlockInterval:      big.NewInt(10),
+		JailBlock:          big.NewInt(0),
+		MaxMissedSlots:     big.NewInt(2),
+		MinActiveDelegates: big.NewInt(2),
+	}
+	genesis := &types.Header{Number: big.NewInt(0), Time: big.NewInt(1000), DelegateRoot: root, ShuffleBlockNumber: big.NewInt(0)}
+	dState, _ = delegatestate.New(root, db)
+	return &testChain{config: config, engine: New(), memdb: memdb, db: db, headers: []*types.Header{genesis}}, dState
+}
+
+// addBlock appends a block produced in the given slot by the scheduled delegate.
+// A block starting a new round shuffles it from the delegate state of its parent,
+// the head when the round started.
+func (c *testChain) addBlock(t *testing.T, dState *delegatestate.DelegateDB, slotTime uint64) *types.Header {
+	parent := c.CurrentHeader()
+	shuffleTime := int64(slotTime) - (int64(slotTime)-1000)%30
+	shuffleHash, shuffleBlock := parent.ShuffleHash, parent.Number
+	if parent.Number.Sign() == 0 || parent.Time.Int64() < shuffleTime {
+		shuffleState, err := c.DelegateStateAt(parent.DelegateRoot)
+		if err != nil {
+			t.Fatalf("failed to open delegate state: %v", err)
+		}
+		shuffleList := types.ShuffleList{ShuffleDels: util.ShuffleNewRound(shuffleTime, 3, shuffleState.GetShuffleDelegates(), 10)}
+		shuffleHash = shuffleList.Hash()
+	} else {
+		shuffleBlock = parent.ShuffleBlockNumber
+	}
+	header := &types.Header{
+		ParentHash:         parent.Hash(),
+		Number:             new(big.Int).Add(parent.Number, common.Big1),
+		Time:               new(big.Int).SetUint64(slotTime),
+		ShuffleHash:        shuffleHash,
+		ShuffleBlockNumber: new(big.Int).Set(shuffleBlock),
+	}
+	shuffleList, err := c.engine.RoundShuffleList(c, header)
+	if err != nil {
+		t.Fatalf("failed to rebuild the round of block %d: %v", header.Number, err)
+	}
+	for _, del := range shuffleList.ShuffleDels {
+		if del.WorkTime == slotTime {
+			header.Coinbase = common.HexToAddress(del.Address)
+		}
+	}
+	if err := c.engine.trackMissedSlots(c, header, dState); err != nil {
+		t.Fatalf("failed to track missed slots of block %d: %v", header.Number, err)
+	}
+	if header.DelegateRoot, err = dState.CommitTo(c.memdb, false); err != nil {
+		t.Fatalf("failed to commit delegate state: %v", err)
+	}
+	c.headers = append(c.headers, header)
+	return header
+}
+
+func TestTrackMissedSlots(t *testing.T) {
+	chain, dState := newTestChain(t)
+	shuffleList := types.ShuffleList{ShuffleDels: util.ShuffleNewRound(1030, 3, dState.GetShuffleDelegates(), 10)}
+	offline := common.HexToAddress(shuffleList.ShuffleDels[1].Address)
+
+	// first block of the round, nothing can be missed yet
+	chain.addBlock(t, dState, 1030)
+	// skip the second slot of the round
+	header := chain.addBlock(t, dState, 1050)
+	if missed := dState.GetMissedSlots(offline); missed != 1 {
+		t.Fatalf("missed slots mismatch: have %d, want 1", missed)
+	}
+	if dState.IsJailed(offline) {
+		t.Fatalf("delegate %x jailed below threshold", offline)
+	}
+	if missed := dState.GetMissedSlots(header.Coinbase); missed != 0 {
+		t.Fatalf("producer missed slots mismatch: have %d, want 0", missed)
+	}
+	// keep the delegate offline in the following rounds until it is jailed
+	for round := uint64(1060); round < 1060+5*30 && !dState.IsJailed(offline); round += 30 {
+		next := types.ShuffleList{ShuffleDels: util.ShuffleNewRound(int64(round), 3, dState.GetShuffleDelegates(), 10)}
+		for _, del := range next.ShuffleDels {
+			if common.HexToAddress(del.Address) != offline {
+				chain.addBlock(t, dState, del.WorkTime)
+			}
+		}
+	}
+	if !dState.IsJailed(offline) {
+		t.Fatalf("delegate %x not jailed after %d missed slots", offline, dState.GetMissedSlots(offline))
+	}
+	for _, candidate := range dState.GetShuffleDelegates() {
+		if common.HexToAddress(candidate.Address) == offline {
+			t.Fatalf("jailed delegate %x still shuffled", offline)
+		}
+	}
+	dState.Unjail(offline)
+	if dState.IsJailed(offline) || dState.GetMissedSlots(offline) != 0 {
+		t.Fatalf("delegate %x not unjailed", offline)
+	}
+}
+
+func TestTrackMissedSlotsHalt(t *testing.T) {
+	chain, dState := newTestChain(t)
+	chain.addBlock(t, dState, 1030)
+
+	// nothing is produced for fifty rounds, the delegates are only charged the
+	// slots after the parent in its round
+	chain.addBlock(t, dState, 1030+50*30)
+	for _, candidate := range []common.Address{{1}, {2}, {3}} {
+		if dState.IsJailed(candidate) {
+			t.Errorf("delegate %x jailed after the halt", candidate)
+		}
+		if missed := dState.GetMissedSlots(candidate); missed > 1 {
+			t.Errorf("delegate %x missed slots mismatch: have %d, want at most 1", candidate, missed)
+		}
+	}
+}
+
+func TestTrackMissedSlotsMinActive(t *testing.T) {
+	chain, dState := newTestChain(t)
+	header := chain.addBlock(t, dState, 1030)
+	producer := header.Coinbase
+
+	// only the first producer stays online, jailing stops at two active delegates
+	for round := uint64(1060); round < 1060+5*30; round += 30 {
+		next := types.ShuffleList{ShuffleDels: util.ShuffleNewRound(int64(round), 3, dState.GetShuffleDelegates(), 10)}
+		for _, del := range next.ShuffleDels {
+			if common.HexToAddress(del.Address) == producer {
+				chain.addBlock(t, dState, del.WorkTime)
+			}
+		}
+	}
+	var jailed int
+	for _, candidate := range []common.Address{{1}, {2}, {3}} {
+		if candidate == producer {
+			continue
+		}
+		if dState.IsJailed(candidate) {
+			jailed++
+		} else if missed := dState.GetMissedSlots(candidate); missed < 2 {
+			t.Errorf("delegate %x missed slots mismatch: have %d, want at least 2", candidate, missed)
+		}
+	}
+	if jailed != 1 {
+		t.Errorf("jailed delegates mismatch: have %d, want 1", jailed)
+	}
+	if active := len(dState.GetShuffleDelegates()); active != 2 {
+		t.Errorf("active delegates mismatch: have %d, want 2", active)
+	}
+}
+
+func TestTrackMissedSlotsUnknownRound(t *testing.T) {
+	chain, dState := newTestChain(t)
+	chain.addBlock(t, dState, 1030)
+	parent := chain.addBlock(t, dState, 1040)
+
+	header := &types.Header{
+		ParentHash:         parent.Hash(),
+		Number:             new(big.Int).Add(parent.Number, common.Big1),
+		Time:               big.NewInt(1050),
+		DelegateRoot:       parent.DelegateRoot,
+		ShuffleHash:        common.Hash{1},
+		ShuffleBlockNumber: big.NewInt(0),
+	}
+	if err := chain.engine.trackMissedSlots(chain, header, dState); err != errShuffleMismatch {
+		t.Errorf("error mismatch: have %v, want %v", err, errShuffleMismatch)
+	}
+	if err := chain.engine.trackMissedSlots(&chainWithoutDelegates{chain}, header, dState); err != errNoDelegateReader {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoDelegateReader)
+	}
+}
+
+func TestRoundShuffleListCache(t *testing.T) {
+	chain, dState := newTestChain(t)
+	header := chain.addBlock(t, dState, 1030)
+
+	// the engine that rebuilt the round answers from its cache, another engine
+	// has to rebuild it
+	if _, err := chain.engine.RoundShuffleList(&chainWithoutDelegates{chain}, header); err != nil {
+		t.Errorf("cached round not found: %v", err)
+	}
+	if _, err := New().RoundShuffleList(&chainWithoutDelegates{chain}, header); err != errNoDelegateReader {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoDelegateReader)
+	}
+}
+
+// chainWithoutDelegates hides the delegate states of the embedded chain.
+type chainWithoutDelegates struct {
+	consensus.ChainReader
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"errors"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/util"
+)
+
+// revealLength is the size of the randao data carried in the header extra-data:
+// the reveal of the producer's last commitment followed by its next commitment.
+const revealLength = 2 * common.HashLength
+
+var errInvalidReveal = errors.New("invalid randao reveal")
+
+// VerifyReveal checks the layout of the randao data in the extra-data of
+// header. Whether the reveal opens the commitment of the producer needs the
+// delegate state, it is checked by applyReveal when the block is finalized.
+func VerifyReveal(header *types.Header) error {
+	if len(header.Extra) != revealLength {
+		return errInvalidReveal
+	}
+	return nil
+}
+
+// RevealExtra returns the randao data of a block: the reveal and the next
+// commitment.
+func RevealExtra(reveal common.Hash, commit common.Hash) []byte {
+	return append(reveal.Bytes(), commit.Bytes()...)
+}
+
+// applyReveal checks that the reveal in header is the preimage of the
+// commitment the producer made in its previous block, and records the new
+// commitment. A producer without a commitment reveals zero.
+//
+// A signature can't serve as reveal, with ECDSA the producer can make any
+// number of valid signatures of the same message and grind the seed. The
+// preimage is fixed a block earlier, before the producer knows the other
+// reveals of the round, which leaves it only the choice to withhold its block.
+func applyReveal(header *types.Header, dState *delegatestate.DelegateDB) error {
+	if err := VerifyReveal(header); err != nil {
+		return err
+	}
+	reveal := common.BytesToHash(header.Extra[:common.HashLength])
+	if commit := dState.GetRevealCommit(header.Coinbase); commit == (common.Hash{}) {
+		if reveal != (common.Hash{}) {
+			return errInvalidReveal
+		}
+	} else if crypto.Keccak256Hash(reveal[:]) != commit {
+		return errInvalidReveal
+	}
+	dState.CommitReveal(header.Coinbase, common.BytesToHash(header.Extra[common.HashLength:]))
+	return nil
+}
+
+// RoundSeed mixes the randao reveals of the blocks produced in the round before
+// shuffleTime, up to and including shuffleHeader, in chain order. Blocks before
+// the randao fork carry no reveal and are skipped, the commitments of the
+// blocks are left out as the producer chooses them freely.
+func RoundSeed(chain consensus.ChainReader, shuffleHeader *types.Header, shuffleTime int64) common.Hash {
+	config := chain.Config()
+	roundTime := config.MaxElectDelegate.Int64() * config.BlockInterval.Int64()
+	reveals := make([][]byte, 0)
+	for header := shuffleHeader; header != nil && header.Number.Sign() > 0; header = chain.GetHeader(header.ParentHash, header.Number.Uint64()-1) {
+		if header.Time.Int64() < shuffleTime-roundTime {
+			break
+		}
+		if header.Time.Int64() < shuffleTime && config.IsRandao(header.Number) {
+			reveals = append(reveals, header.Extra[:common.HashLength])
+		}
+	}
+	var mix common.Hash
+	for i := len(reveals) - 1; i >= 0; i-- {
+		mix = util.MixReveal(mix, reveals[i])
+	}
+	return mix
+}
+
+// NewRoundShuffle shuffles the top delegates for the round starting at
+// shuffleTime, with shuffleHeader being the head of the chain at that time.
+func NewRoundShuffle(chain consensus.ChainReader, shuffleHeader *types.Header, shuffleTime int64, topDelegates []types.Candidate) []types.ShuffleDel {
+	config := chain.Config()
+	maxElectDelegate := int(config.MaxElectDelegate.Int64())
+	blockInterval := config.BlockInterval.Int64()
+	if config.IsRandao(shuffleHeader.Number) {
+		return util.ShuffleNewRoundWithSeed(shuffleTime, maxElectDelegate, topDelegates, blockInterval, RoundSeed(chain, shuffleHeader, shuffleTime))
+	}
+	return util.ShuffleNewRound(shuffleTime, maxElectDelegate, topDelegates, blockInterval)
+}
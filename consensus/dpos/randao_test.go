@@ -0,0 +1,100 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/util"
+	"math/big"
+	"testing"
+)
+
+func TestApplyReveal(t *testing.T) {
+	_, dState := newTestChain(t)
+	key, _ := crypto.GenerateKey()
+	producer := common.Address{1}
+	secret := func(count uint64) common.Hash { return util.RevealSecret(key, count) }
+	commit := func(count uint64) common.Hash { return crypto.Keccak256Hash(secret(count).Bytes()) }
+	header := &types.Header{Number: big.NewInt(1), Time: big.NewInt(1010), Coinbase: producer}
+
+	// without a commitment only the zero reveal is accepted
+	header.Extra = RevealExtra(secret(0), commit(0))
+	if err := applyReveal(header, dState); err != errInvalidReveal {
+		t.Fatalf("reveal without commitment error mismatch: have %v, want %v", err, errInvalidReveal)
+	}
+	header.Extra = RevealExtra(common.Hash{}, commit(0))
+	if err := applyReveal(header, dState); err != nil {
+		t.Fatalf("first commitment rejected: %v", err)
+	}
+	// the next block has to open the commitment, nothing else
+	for _, reveal := range []common.Hash{{}, secret(1), commit(0)} {
+		header.Extra = RevealExtra(reveal, commit(1))
+		if err := applyReveal(header, dState); err != errInvalidReveal {
+			t.Fatalf("reveal %x error mismatch: have %v, want %v", reveal, err, errInvalidReveal)
+		}
+	}
+	header.Extra = RevealExtra(secret(0), commit(1))
+	if err := applyReveal(header, dState); err != nil {
+		t.Fatalf("valid reveal rejected: %v", err)
+	}
+	if have := dState.GetRevealCount(producer); have != 2 {
+		t.Fatalf("commitment count mismatch: have %d, want 2", have)
+	}
+	if have := dState.GetRevealCommit(producer); have != commit(1) {
+		t.Fatalf("commitment mismatch: have %x, want %x", have, commit(1))
+	}
+	header.Extra = header.Extra[:common.HashLength]
+	if err := VerifyReveal(header); err != errInvalidReveal {
+		t.Fatalf("short randao data error mismatch: have %v, want %v", err, errInvalidReveal)
+	}
+}
+
+func TestRoundSeed(t *testing.T) {
+	chain, _ := newTestChain(t)
+	chain.config.RandaoBlock = big.NewInt(0)
+	key, _ := crypto.GenerateKey()
+	// one block in the round before 1030, three in the round before 1060
+	var reveals [][]byte
+	for _, slot := range []uint64{1020, 1030, 1040, 1050} {
+		reveal := util.RevealSecret(key, slot)
+		reveals = append(reveals, reveal.Bytes())
+		parent := chain.CurrentHeader()
+		chain.headers = append(chain.headers, &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).Add(parent.Number, common.Big1),
+			Time:       new(big.Int).SetUint64(slot),
+			Extra:      RevealExtra(reveal, util.RevealSecret(key, slot+1)),
+		})
+	}
+	var want common.Hash
+	for _, reveal := range reveals[1:] {
+		want = util.MixReveal(want, reveal)
+	}
+	if have := RoundSeed(chain, chain.CurrentHeader(), 1060); have != want {
+		t.Errorf("seed mismatch: have %x, want %x", have, want)
+	}
+	// a head from an earlier round contributes nothing to a later round
+	if have := RoundSeed(chain, chain.headers[1], 1060); have != (common.Hash{}) {
+		t.Errorf("stale seed mismatch: have %x, want empty", have)
+	}
+	// the genesis round has nothing to reveal
+	if have := RoundSeed(chain, chain.headers[0], 1000); have != (common.Hash{}) {
+		t.Errorf("genesis seed mismatch: have %x, want empty", have)
+	}
+}
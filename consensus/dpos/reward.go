@@ -0,0 +1,72 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
+)
+
+// blockReward returns the reward of the block with the given number under the
+// schedule. It only uses integer arithmetic, so every node computes the same
+// amount regardless of the platform.
+func blockReward(schedule *params.RewardConfig, number *big.Int) *big.Int {
+	reward := new(big.Int).Set(schedule.InitialReward)
+	if schedule.Period == nil || schedule.Period.Sign() <= 0 || schedule.RatioNum == nil || schedule.RatioDenom == nil || schedule.RatioDenom.Sign() <= 0 {
+		return limitReward(schedule, reward)
+	}
+	// The reward moves monotonically with the periods, so the powers of the
+	// ratio are built from the top bit of the periods down, and the reward is
+	// final as soon as it reaches MaxReward or rounds down to zero. The decay
+	// of a long chain doesn't need the full powers.
+	var (
+		periods = new(big.Int).Div(number, schedule.Period)
+		growing = schedule.RatioNum.Cmp(schedule.RatioDenom) > 0
+		num     = big.NewInt(1)
+		denom   = big.NewInt(1)
+	)
+	for i := periods.BitLen() - 1; i >= 0; i-- {
+		num.Mul(num, num)
+		denom.Mul(denom, denom)
+		if periods.Bit(i) == 1 {
+			num.Mul(num, schedule.RatioNum)
+			denom.Mul(denom, schedule.RatioDenom)
+		}
+		reward.Mul(schedule.InitialReward, num)
+		reward.Div(reward, denom)
+		limited := limitReward(schedule, new(big.Int).Set(reward))
+		if growing && schedule.MaxReward != nil && limited.Cmp(schedule.MaxReward) == 0 {
+			return limited
+		}
+		if !growing && limited.Sign() == 0 {
+			return limited
+		}
+	}
+	return limitReward(schedule, reward)
+}
+
+// limitReward rounds reward down to the rounding of the schedule and caps it at
+// MaxReward.
+func limitReward(schedule *params.RewardConfig, reward *big.Int) *big.Int {
+	if schedule.Rounding != nil && schedule.Rounding.Sign() > 0 {
+		reward.Sub(reward, new(big.Int).Mod(reward, schedule.Rounding))
+	}
+	if schedule.MaxReward != nil && reward.Cmp(schedule.MaxReward) > 0 {
+		reward.Set(schedule.MaxReward)
+	}
+	return reward
+}
@@ -0,0 +1,108 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"encoding/json"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math"
+	"math/big"
+	"testing"
+)
+
+// legacyBlockReward is the float64 reward formula used before the reward
+// schedule became configurable.
+func legacyBlockReward(number int64) *big.Int {
+	yearNumber := number / 3153600
+	currentReward := (int64)(500 * math.Pow(1.10, float64(yearNumber)))
+	return new(big.Int).Mul(big.NewInt(currentReward), big.NewInt(1e+18))
+}
+
+func TestMainnetBlockReward(t *testing.T) {
+	schedule := params.MainnetChainConfig.RewardSchedule()
+	for year := int64(0); year <= 100; year++ {
+		for _, number := range []int64{year * 3153600, year*3153600 + 1, (year+1)*3153600 - 1} {
+			if have, want := blockReward(schedule, big.NewInt(number)), legacyBlockReward(number); have.Cmp(want) != 0 {
+				t.Fatalf("block %d: reward mismatch: have %v, want %v", number, have, want)
+			}
+		}
+	}
+}
+
+func TestCustomBlockReward(t *testing.T) {
+	var config params.ChainConfig
+	genesis := `{"chainId": 1, "reward": {"initialReward": 1000, "ratioNum": 1, "ratioDenom": 2, "period": 1000, "rounding": 10, "maxReward": 800}}`
+	if err := json.Unmarshal([]byte(genesis), &config); err != nil {
+		t.Fatalf("failed to decode config: %v", err)
+	}
+	if err := config.CheckRewardSchedule(); err != nil {
+		t.Fatalf("schedule rejected: %v", err)
+	}
+	tests := []struct {
+		number int64
+		reward int64
+	}{
+		{0, 800},    // capped
+		{999, 800},  // capped
+		{1000, 500}, // halved
+		{2000, 250},
+		{3000, 120}, // rounded down from 125
+		{7000, 0},   // rounded down from 7
+	}
+	for _, tt := range tests {
+		if have := blockReward(config.RewardSchedule(), big.NewInt(tt.number)); have.Int64() != tt.reward {
+			t.Errorf("block %d: reward mismatch: have %v, want %d", tt.number, have, tt.reward)
+		}
+	}
+}
+
+// fullBlockReward computes the reward with the full powers of the ratio.
+func fullBlockReward(schedule *params.RewardConfig, number *big.Int) *big.Int {
+	periods := new(big.Int).Div(number, schedule.Period)
+	reward := new(big.Int).Mul(schedule.InitialReward, new(big.Int).Exp(schedule.RatioNum, periods, nil))
+	reward.Div(reward, new(big.Int).Exp(schedule.RatioDenom, periods, nil))
+	if schedule.Rounding != nil {
+		reward.Sub(reward, new(big.Int).Mod(reward, schedule.Rounding))
+	}
+	if schedule.MaxReward != nil && reward.Cmp(schedule.MaxReward) > 0 {
+		reward.Set(schedule.MaxReward)
+	}
+	return reward
+}
+
+func TestBlockRewardStopsEarly(t *testing.T) {
+	var (
+		decaying = &params.RewardConfig{InitialReward: big.NewInt(1e18), RatioNum: big.NewInt(9), RatioDenom: big.NewInt(10), Period: big.NewInt(params.MinRewardPeriod), Rounding: big.NewInt(1e9)}
+		growing  = &params.RewardConfig{InitialReward: big.NewInt(1e18), RatioNum: big.NewInt(11), RatioDenom: big.NewInt(10), Period: big.NewInt(params.MinRewardPeriod), Rounding: big.NewInt(3), MaxReward: big.NewInt(5e18)}
+	)
+	// the early stop matches the full powers around the saturation
+	for _, schedule := range []*params.RewardConfig{decaying, growing} {
+		for number := int64(0); number < 400*params.MinRewardPeriod; number += params.MinRewardPeriod / 2 {
+			if have, want := blockReward(schedule, big.NewInt(number)), fullBlockReward(schedule, big.NewInt(number)); have.Cmp(want) != 0 {
+				t.Fatalf("%v block %d: reward mismatch: have %v, want %v", schedule, number, have, want)
+			}
+		}
+	}
+	// far blocks return without raising the ratio to their periods
+	number := new(big.Int).Lsh(big.NewInt(1), 62)
+	if have := blockReward(decaying, number); have.Sign() != 0 {
+		t.Errorf("decaying reward mismatch: have %v, want 0", have)
+	}
+	if have := blockReward(growing, number); have.Cmp(growing.MaxReward) != 0 {
+		t.Errorf("growing reward mismatch: have %v, want %v", have, growing.MaxReward)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/log"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
+)
+
+// releaseUnbondedDelegates removes the leaving delegates whose unbonding period
+// is over. The registration deposit they paid is given back and the votes
+// pointing at them are withdrawn, which unlocks the voters' LockBalance and
+// pays out their pending rewards.
+func releaseUnbondedDelegates(config *params.ChainConfig, header *types.Header, state *state.StateDB, dState *delegatestate.DelegateDB) {
+	for _, candidate := range dState.GetDelegates() {
+		address := common.HexToAddress(candidate.Address)
+		releaseTime := dState.GetUnbondingTime(address)
+		if releaseTime == 0 || releaseTime > header.Time.Uint64() {
+			continue
+		}
+		voters := ReleaseVoters(state, dState, address)
+		deposit := dState.GetDeposit(address)
+		dState.Unregister(address, 0)
+		dState.SetDeposit(address, common.Big0)
+		dState.Suicide(address)
+		state.AddBalance(address, deposit)
+		log.Info("Release unbonded delegate", "address", address.Hex(), "voters", voters, "blockNumber", header.Number)
+	}
+}
+
+// ReleaseVoters withdraws every indexed vote for the delegate: the pending
+// reward of the voter is paid out, its LockBalance unlocked and the vote taken
+// off the delegate. It returns the number of released voters.
+func ReleaseVoters(state *state.StateDB, dState *delegatestate.DelegateDB, delegate common.Address) int {
+	voters := dState.GetVoters(delegate)
+	for _, voter := range voters {
+		state.AddBalance(voter, dState.SettleVoterReward(delegate, voter))
+		unlockVote(state, voter, delegate)
+		dState.RemoveVoter(delegate, voter)
+		dState.SubVote(delegate, common.Big1)
+	}
+	return len(voters)
+}
+
+// seedVoterIndex indexes the votes cast before the unregister fork, so that
+// they are released like later ones when their delegate leaves.
+func seedVoterIndex(state *state.StateDB, dState *delegatestate.DelegateDB) error {
+	return state.ForEachVoteList(func(voter common.Address, voteList []common.Address) {
+		for _, delegate := range voteList {
+			if dState.Exist(delegate) {
+				dState.AddVoter(delegate, voter)
+			}
+		}
+	})
+}
+
+// unlockVote withdraws the voter's vote for the delegate and returns the locked
+// balance. A vote locks params.Em, but votes which came to the vote list
+// without locking it only give back what is left in the LockBalance.
+func unlockVote(state *state.StateDB, voter common.Address, delegate common.Address) {
+	voteList := state.GetVoteList(voter)
+	for i, candidate := range voteList {
+		if candidate != delegate {
+			continue
+		}
+		newVoteList := make([]common.Address, 0, len(voteList)-1)
+		newVoteList = append(newVoteList, voteList[:i]...)
+		newVoteList = append(newVoteList, voteList[i+1:]...)
+		state.SetVoteList(voter, newVoteList)
+		unlocked := big.NewInt(params.Em)
+		if locked := state.GetLockBalance(voter); locked.Cmp(unlocked) < 0 {
+			unlocked.Set(locked)
+		}
+		state.SubLockBalance(voter, unlocked)
+		state.AddBalance(voter, unlocked)
+		return
+	}
+}
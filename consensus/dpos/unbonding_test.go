@@ -0,0 +1,135 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
+	"testing"
+)
+
+func TestReleaseUnbondedDelegates(t *testing.T) {
+	memdb, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(memdb))
+	dState, _ := delegatestate.New(common.Hash{}, delegatestate.NewDatabase(memdb))
+
+	var (
+		leaving = common.Address{1}
+		staying = common.Address{2}
+		voter   = common.Address{3}
+		lock    = big.NewInt(2 * params.Em)
+	)
+	dState.GetOrNewStateObject(leaving, "leaving", 0)
+	dState.GetOrNewStateObject(staying, "staying", 0)
+	dState.AddVote(leaving, common.Big1)
+	dState.AddVote(staying, common.Big1)
+	dState.AddVoter(leaving, voter)
+	dState.AddVoter(staying, voter)
+	statedb.SetVoteList(voter, []common.Address{leaving, staying})
+	statedb.AddLockBalance(voter, lock)
+	dState.Unregister(leaving, 100)
+	// the delegate left while the old register cost was in force
+	paid, _ := new(big.Int).SetString(params.TxGasAgentCreationOld, 10)
+	dState.SetDeposit(leaving, paid)
+
+	config := *params.AllDacchainProtocolChanges
+	config.RegisterCostBlock = big.NewInt(2)
+
+	if delegates := dState.GetShuffleDelegates(); len(delegates) != 1 || common.HexToAddress(delegates[0].Address) != staying {
+		t.Fatalf("leaving delegate still shuffled: %v", delegates)
+	}
+	// nothing is released before the end of the unbonding period
+	releaseUnbondedDelegates(&config, &types.Header{Number: big.NewInt(1), Time: big.NewInt(99)}, statedb, dState)
+	if !dState.Exist(leaving) || statedb.GetBalance(leaving).Sign() != 0 {
+		t.Fatalf("delegate released before unbonding time")
+	}
+	releaseUnbondedDelegates(&config, &types.Header{Number: big.NewInt(2), Time: big.NewInt(100)}, statedb, dState)
+	if dState.Exist(leaving) {
+		t.Fatalf("delegate not removed after unbonding time")
+	}
+	if balance := statedb.GetBalance(leaving); balance.Cmp(paid) != 0 {
+		t.Fatalf("deposit mismatch: have %v, want %v", balance, paid)
+	}
+	if voteList := statedb.GetVoteList(voter); len(voteList) != 1 || voteList[0] != staying {
+		t.Fatalf("vote list mismatch: have %v, want [%x]", voteList, staying)
+	}
+	if have, want := statedb.GetLockBalance(voter), big.NewInt(params.Em); have.Cmp(want) != 0 {
+		t.Fatalf("lock balance mismatch: have %v, want %v", have, want)
+	}
+	if have, want := statedb.GetBalance(voter), big.NewInt(params.Em); have.Cmp(want) != 0 {
+		t.Fatalf("voter balance mismatch: have %v, want %v", have, want)
+	}
+	if voters := dState.GetVoters(staying); len(voters) != 1 || voters[0] != voter {
+		t.Fatalf("voters of remaining delegate changed: %v", voters)
+	}
+}
+
+func TestSeedVoterIndex(t *testing.T) {
+	memdb, _ := aoadb.NewMemDatabase()
+	db := state.NewDatabase(memdb)
+	statedb, _ := state.New(common.Hash{}, db)
+	dState, _ := delegatestate.New(common.Hash{}, delegatestate.NewDatabase(memdb))
+
+	var (
+		delegate = common.Address{1}
+		removed  = common.Address{2}
+		stored   = common.Address{3}
+		cached   = common.Address{4}
+	)
+	dState.GetOrNewStateObject(delegate, "delegate", 0)
+	dState.AddVote(delegate, big.NewInt(2))
+	// votes cast before the fork, one of them only in the trie. Neither voter
+	// locked a full vote for the delegate.
+	statedb.SetVoteList(stored, []common.Address{delegate, removed})
+	statedb.AddLockBalance(stored, big.NewInt(params.Em/2))
+	root, err := statedb.CommitTo(memdb, false)
+	if err != nil {
+		t.Fatalf("commit failed: %v", err)
+	}
+	statedb, _ = state.New(root, db)
+	statedb.SetVoteList(cached, []common.Address{delegate})
+
+	if err := seedVoterIndex(statedb, dState); err != nil {
+		t.Fatalf("seeding failed: %v", err)
+	}
+	if voters := dState.GetVoters(delegate); len(voters) != 2 || voters[0] != stored || voters[1] != cached {
+		t.Fatalf("voters mismatch: have %v, want [%x %x]", voters, stored, cached)
+	}
+	if voters := dState.GetVoters(removed); len(voters) != 0 {
+		t.Fatalf("voters indexed for missing delegate: %v", voters)
+	}
+	if n := ReleaseVoters(statedb, dState, delegate); n != 2 {
+		t.Fatalf("released voters mismatch: have %d, want 2", n)
+	}
+	if votes := dState.GetVote(delegate); votes.Sign() != 0 {
+		t.Fatalf("votes left after release: %v", votes)
+	}
+	// only the balance actually locked is given back
+	for voter, want := range map[common.Address]*big.Int{stored: big.NewInt(params.Em / 2), cached: new(big.Int)} {
+		if locked := statedb.GetLockBalance(voter); locked.Sign() != 0 {
+			t.Errorf("voter %x: lock balance mismatch: have %v, want 0", voter, locked)
+		}
+		if balance := statedb.GetBalance(voter); balance.Cmp(want) != 0 {
+			t.Errorf("voter %x: balance mismatch: have %v, want %v", voter, balance, want)
+		}
+	}
+}
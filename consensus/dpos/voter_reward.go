@@ -0,0 +1,42 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
+)
+
+// shareVoterReward splits the block reward of the delegate by its commission
+// rate. The voters' part is only booked in the delegate state and paid out when
+// a voter claims it or withdraws its vote, which keeps the cost per block
+// independent of the number of voters. It returns the delegate's part.
+func shareVoterReward(dState *delegatestate.DelegateDB, coinbase common.Address, reward *big.Int) *big.Int {
+	if !dState.Exist(coinbase) {
+		return reward
+	}
+	commission := new(big.Int).Mul(reward, new(big.Int).SetUint64(dState.GetCommission(coinbase)))
+	commission.Div(commission, big.NewInt(params.CommissionDenominator))
+	voterReward := new(big.Int).Sub(reward, commission)
+	if voterReward.Sign() == 0 {
+		return reward
+	}
+	// whatever can't be split evenly stays with the delegate
+	return commission.Add(commission, dState.AddVoterReward(coinbase, voterReward))
+}
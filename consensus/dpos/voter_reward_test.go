@@ -0,0 +1,72 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package dpos
+
+import (
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"math/big"
+	"testing"
+)
+
+func TestShareVoterReward(t *testing.T) {
+	memdb, _ := aoadb.NewMemDatabase()
+	dState, _ := delegatestate.New(common.Hash{}, delegatestate.NewDatabase(memdb))
+
+	var (
+		delegate = common.Address{1}
+		early    = common.Address{2}
+		late     = common.Address{3}
+	)
+	dState.GetOrNewStateObject(delegate, "delegate", 0)
+
+	// without votes or commission the delegate keeps everything
+	if kept := shareVoterReward(dState, delegate, big.NewInt(1000)); kept.Int64() != 1000 {
+		t.Fatalf("reward without votes mismatch: have %v, want 1000", kept)
+	}
+	dState.AddVote(delegate, common.Big1)
+	dState.SettleVoterReward(delegate, early)
+	if kept := shareVoterReward(dState, delegate, big.NewInt(1000)); kept.Int64() != 1000 {
+		t.Fatalf("reward with default commission mismatch: have %v, want 1000", kept)
+	}
+
+	// 20% commission, one voter takes the rest
+	dState.SetCommission(delegate, 2000)
+	if kept := shareVoterReward(dState, delegate, big.NewInt(1000)); kept.Int64() != 200 {
+		t.Fatalf("delegate share mismatch: have %v, want 200", kept)
+	}
+	if pending := dState.PendingVoterReward(delegate, early); pending.Int64() != 800 {
+		t.Fatalf("pending reward mismatch: have %v, want 800", pending)
+	}
+
+	// a later voter only earns from the blocks after its vote
+	dState.AddVote(delegate, common.Big1)
+	dState.SettleVoterReward(delegate, late)
+	if kept := shareVoterReward(dState, delegate, big.NewInt(1001)); kept.Int64() != 200 {
+		t.Fatalf("delegate share mismatch: have %v, want 200", kept)
+	}
+	if pending := dState.PendingVoterReward(delegate, late); pending.Int64() != 400 {
+		t.Fatalf("late voter reward mismatch: have %v, want 400", pending)
+	}
+	if settled := dState.SettleVoterReward(delegate, early); settled.Int64() != 1200 {
+		t.Fatalf("settled reward mismatch: have %v, want 1200", settled)
+	}
+	if pending := dState.PendingVoterReward(delegate, early); pending.Sign() != 0 {
+		t.Fatalf("reward not reset after settlement: %v", pending)
+	}
+}
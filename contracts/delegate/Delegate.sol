@@ -0,0 +1,46 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+pragma solidity ^0.4.24;
+
+// Delegate is the read-only interface of the precompiled DPoS contract at
+// address 0x0000000000000000000000000000000000000101, available from the
+// DPoS precompile fork on.
+//
+//     Delegate constant DPOS = Delegate(0x0000000000000000000000000000000000000101);
+//
+//     function isCandidate(address account) internal view returns (bool) {
+//         return DPOS.voteCount(account) > 0;
+//     }
+//
+// Calls sending value are rejected. Candidates are listed by descending vote.
+interface Delegate {
+    // candidates returns the registered candidates sorted by vote.
+    function candidates() external view returns (address[]);
+
+    // voteCount returns the votes of a candidate, zero for other accounts.
+    function voteCount(address candidate) external view returns (uint256);
+
+    // voteList returns the candidates an account votes for.
+    function voteList(address account) external view returns (address[]);
+
+    // lockBalance returns the balance an account has locked for voting.
+    function lockBalance(address account) external view returns (uint256);
+
+    // producers returns the producer schedule of the current round, with the
+    // time every producer is due to produce its block.
+    function producers() external view returns (address[] producers, uint256[] workTimes);
+}
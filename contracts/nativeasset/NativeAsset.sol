@@ -0,0 +1,45 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+pragma solidity ^0.4.24;
+
+// NativeAsset is the interface of the precompiled native asset contract at
+// address 0x0000000000000000000000000000000000000100, available from the
+// asset precompile fork on. Assets are identified by the address they were
+// published at.
+//
+//     NativeAsset constant NATIVE_ASSET = NativeAsset(0x0000000000000000000000000000000000000100);
+//
+//     function payout(address asset, address to, uint256 amount) internal {
+//         require(NATIVE_ASSET.transfer(asset, to, amount));
+//     }
+//
+// Calls sending value are rejected. transfer moves the asset held by the
+// calling contract itself and fails when reached through delegatecall or
+// callcode, or if the freeze and whitelist controls of the asset forbid it.
+interface NativeAsset {
+    // balanceOf returns the balance of an account in the asset.
+    function balanceOf(address asset, address account) external view returns (uint256);
+
+    // totalSupply returns the circulating supply of the asset.
+    function totalSupply(address asset) external view returns (uint256);
+
+    // assetInfo returns the published information of the asset.
+    function assetInfo(address asset) external view returns (string name, string symbol, uint8 decimals, address issuer);
+
+    // transfer moves amount of the asset from the caller to the recipient.
+    function transfer(address asset, address to, uint256 amount) external returns (bool);
+}
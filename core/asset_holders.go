@@ -0,0 +1,364 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/log"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+	"github.com/Aurorachain-io/go-aoa/trie"
+	"math/big"
+	"sort"
+	"time"
+)
+
+const (
+	// assetHolderConfirms is the number of confirmation blocks before a section
+	// is considered final and folded into the asset holder index.
+	assetHolderConfirms = 16
+
+	// assetHolderThrottling is the time to wait between processing two
+	// consecutive index sections while catching up with the chain.
+	assetHolderThrottling = 100 * time.Millisecond
+)
+
+// assetHolderRootKey tracks the state root the asset holder index reflects.
+var assetHolderRootKey = append(append([]byte{}, AssetHolderIndexPrefix...), "root"...)
+
+// AssetHolder is a single entry of the asset holder index.
+type AssetHolder struct {
+	Address common.Address
+	Balance *big.Int
+}
+
+// assetHolderEntry is the stored form of an indexed holder, the position of
+// the holder in the asset's holder list and its balance.
+type assetHolderEntry struct {
+	Index   uint64
+	Balance *big.Int
+}
+
+// AssetHolderIndexer implements a core.ChainIndexer, maintaining a holder to
+// balance index of every asset from the account changes of the canonical chain.
+type AssetHolderIndexer struct {
+	db  aoadb.Database // database instance to write index data into
+	sdb state.Database // state database to read the account tries from
+
+	root common.Hash // state root of the last header processed
+}
+
+// NewAssetHolderIndexer returns a chain indexer that maintains the holders of
+// every asset, processing the chain in sections of the given size.
+func NewAssetHolderIndexer(db aoadb.Database, size uint64) *ChainIndexer {
+	backend := &AssetHolderIndexer{
+		db:  db,
+		sdb: state.NewDatabase(db),
+	}
+	table := aoadb.NewTable(db, string(AssetHolderIndexPrefix))
+
+	return NewChainIndexer(db, table, backend, size, assetHolderConfirms, assetHolderThrottling, "assetholders")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new asset holder index
+// section.
+func (b *AssetHolderIndexer) Reset(section uint64, lastSectionHead common.Hash) error {
+	b.root = common.Hash{}
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, recording the state of the
+// header as the new target of the index.
+func (b *AssetHolderIndexer) Process(header *types.Header) {
+	b.root = header.Root
+}
+
+// Commit implements core.ChainIndexerBackend, applying the account changes
+// between the state the index reflects and the state of the section head.
+//
+// The index is diffed against the root it was last built from instead of the
+// previous section head, so sections reprocessed after a reorg also revert the
+// changes of the dropped blocks.
+func (b *AssetHolderIndexer) Commit() error {
+	var from common.Hash
+	if data, _ := b.db.Get(assetHolderRootKey); len(data) == common.HashLength {
+		from = common.BytesToHash(data)
+	}
+	if from == b.root {
+		return nil
+	}
+	oldTrie, err := b.sdb.OpenTrie(from)
+	if err != nil {
+		return err
+	}
+	newTrie, err := b.sdb.OpenTrie(b.root)
+	if err != nil {
+		return err
+	}
+	// Collect the accounts created, modified or deleted in either direction.
+	// Accounts without a preimage can't be resolved, they are skipped rather
+	// than stalling the index forever.
+	changed := make(map[common.Address]struct{})
+	missing := 0
+	for _, tries := range [][2]state.Trie{{oldTrie, newTrie}, {newTrie, oldTrie}} {
+		diff, _ := trie.NewDifferenceIterator(tries[0].NodeIterator(nil), tries[1].NodeIterator(nil))
+		it := trie.NewIterator(diff)
+		for it.Next() {
+			preimage := tries[1].GetKey(it.Key)
+			if preimage == nil {
+				log.Warn("Skipping account without preimage in asset holder index", "hash", common.BytesToHash(it.Key))
+				missing++
+				continue
+			}
+			changed[common.BytesToAddress(preimage)] = struct{}{}
+		}
+		if it.Err != nil {
+			return it.Err
+		}
+	}
+	// Compute the new balance of every asset held by the changed accounts
+	updates := make(map[common.Address]map[common.Address]*big.Int)
+	for addr := range changed {
+		before, err := accountAssets(oldTrie, addr)
+		if err != nil {
+			return err
+		}
+		after, err := accountAssets(newTrie, addr)
+		if err != nil {
+			return err
+		}
+		for asset := range before {
+			if _, ok := after[asset]; !ok {
+				after[asset] = new(big.Int)
+			}
+		}
+		for asset, balance := range after {
+			if prev, ok := before[asset]; ok && prev.Cmp(balance) == 0 {
+				continue
+			}
+			if updates[asset] == nil {
+				updates[asset] = make(map[common.Address]*big.Int)
+			}
+			updates[asset][addr] = balance
+		}
+	}
+	batch := b.db.NewBatch()
+	for asset, holders := range updates {
+		if err := updateAssetHolders(b.db, batch, asset, holders); err != nil {
+			return err
+		}
+	}
+	if err := batch.Put(assetHolderRootKey, b.root.Bytes()); err != nil {
+		return err
+	}
+	log.Trace("Updated asset holder index", "accounts", len(changed), "missing", missing, "assets", len(updates), "root", b.root)
+	return batch.Write()
+}
+
+// accountAssets returns the asset balances of an account in the given trie.
+func accountAssets(tr state.Trie, addr common.Address) (map[common.Address]*big.Int, error) {
+	assets := make(map[common.Address]*big.Int)
+
+	enc, err := tr.TryGet(addr[:])
+	if err != nil || len(enc) == 0 {
+		return assets, err
+	}
+	var data state.Account
+	if err := rlp.DecodeBytes(enc, &data); err != nil {
+		return nil, err
+	}
+	if data.AssetList != nil {
+		for _, asset := range data.AssetList.GetAssets() {
+			assets[asset.ID] = asset.Balance
+		}
+	}
+	return assets, nil
+}
+
+// assetHolderList is a view of the holder list of a single asset, caching the
+// modifications not yet written out to the database.
+type assetHolderList struct {
+	db    DatabaseReader
+	batch aoadb.Putter
+	asset common.Address
+
+	count   uint64
+	holders map[uint64]common.Address
+	entries map[common.Address]*assetHolderEntry
+}
+
+// updateAssetHolders sets the balances of the given holders of an asset. Holders
+// with a zero balance are removed by moving the last holder of the list into
+// their place, keeping the list dense for paginated access.
+func updateAssetHolders(db DatabaseReader, batch aoadb.Putter, asset common.Address, balances map[common.Address]*big.Int) error {
+	list := &assetHolderList{
+		db:      db,
+		batch:   batch,
+		asset:   asset,
+		count:   GetAssetHolderCount(db, asset),
+		holders: make(map[uint64]common.Address),
+		entries: make(map[common.Address]*assetHolderEntry),
+	}
+	// Apply the changes in a stable order to keep the index reproducible
+	holders := make([]common.Address, 0, len(balances))
+	for holder := range balances {
+		holders = append(holders, holder)
+	}
+	sort.Slice(holders, func(i, j int) bool { return bytes.Compare(holders[i][:], holders[j][:]) < 0 })
+
+	for _, holder := range holders {
+		if err := list.set(holder, balances[holder]); err != nil {
+			return err
+		}
+	}
+	var count [8]byte
+	binary.BigEndian.PutUint64(count[:], list.count)
+	return batch.Put(assetHolderCountKey(asset), count[:])
+}
+
+// set updates the balance of a holder, adding or removing it as needed.
+func (l *assetHolderList) set(holder common.Address, balance *big.Int) error {
+	entry := l.entry(holder)
+	switch {
+	case balance.Sign() > 0 && entry == nil:
+		entry = &assetHolderEntry{Index: l.count, Balance: balance}
+		l.count++
+		if err := l.putHolder(entry.Index, holder); err != nil {
+			return err
+		}
+		return l.putEntry(holder, entry)
+
+	case balance.Sign() > 0:
+		entry.Balance = balance
+		return l.putEntry(holder, entry)
+
+	case entry != nil:
+		l.count--
+		if entry.Index != l.count {
+			last := l.holder(l.count)
+			moved := l.entry(last)
+			moved.Index = entry.Index
+			if err := l.putHolder(moved.Index, last); err != nil {
+				return err
+			}
+			if err := l.putEntry(last, moved); err != nil {
+				return err
+			}
+		}
+		return l.putEntry(holder, nil)
+	}
+	return nil
+}
+
+func (l *assetHolderList) holder(index uint64) common.Address {
+	if holder, ok := l.holders[index]; ok {
+		return holder
+	}
+	data, _ := l.db.Get(assetHolderIndexKey(l.asset, index))
+	return common.BytesToAddress(data)
+}
+
+func (l *assetHolderList) putHolder(index uint64, holder common.Address) error {
+	l.holders[index] = holder
+	return l.batch.Put(assetHolderIndexKey(l.asset, index), holder.Bytes())
+}
+
+func (l *assetHolderList) entry(holder common.Address) *assetHolderEntry {
+	if entry, ok := l.entries[holder]; ok {
+		return entry
+	}
+	return getAssetHolderEntry(l.db, l.asset, holder)
+}
+
+// putEntry stores the entry of a holder, a nil entry deleting it. Batches can
+// not delete, so deleted entries are stored empty.
+func (l *assetHolderList) putEntry(holder common.Address, entry *assetHolderEntry) error {
+	l.entries[holder] = entry
+	if entry == nil {
+		return l.batch.Put(assetHolderKey(l.asset, holder), nil)
+	}
+	data, err := rlp.EncodeToBytes(entry)
+	if err != nil {
+		return err
+	}
+	return l.batch.Put(assetHolderKey(l.asset, holder), data)
+}
+
+func assetHolderCountKey(asset common.Address) []byte {
+	return append(append([]byte{}, assetHolderPrefix...), asset.Bytes()...)
+}
+
+func assetHolderIndexKey(asset common.Address, index uint64) []byte {
+	var enc [8]byte
+	binary.BigEndian.PutUint64(enc[:], index)
+	return append(assetHolderCountKey(asset), enc[:]...)
+}
+
+func assetHolderKey(asset common.Address, holder common.Address) []byte {
+	return append(assetHolderCountKey(asset), holder.Bytes()...)
+}
+
+func getAssetHolderEntry(db DatabaseReader, asset common.Address, holder common.Address) *assetHolderEntry {
+	data, _ := db.Get(assetHolderKey(asset, holder))
+	if len(data) == 0 {
+		return nil
+	}
+	entry := new(assetHolderEntry)
+	if err := rlp.DecodeBytes(data, entry); err != nil {
+		log.Error("Invalid asset holder entry RLP", "asset", asset, "holder", holder, "err", err)
+		return nil
+	}
+	return entry
+}
+
+// GetAssetHolderCount returns the number of indexed holders of an asset.
+func GetAssetHolderCount(db DatabaseReader, asset common.Address) uint64 {
+	data, _ := db.Get(assetHolderCountKey(asset))
+	if len(data) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(data)
+}
+
+// GetAssetHolders returns at most limit indexed holders of an asset, starting
+// at the given offset of the holder list.
+//
+// The list is not ordered: a holder dropping to a zero balance is replaced by
+// the last holder of the list, so pages read across index updates may skip or
+// repeat holders.
+func GetAssetHolders(db DatabaseReader, asset common.Address, offset, limit uint64) []AssetHolder {
+	count := GetAssetHolderCount(db, asset)
+	if offset >= count {
+		return []AssetHolder{}
+	}
+	if limit > count-offset {
+		limit = count - offset
+	}
+	holders := make([]AssetHolder, 0, limit)
+	for index := offset; index < offset+limit; index++ {
+		data, _ := db.Get(assetHolderIndexKey(asset, index))
+		holder := common.BytesToAddress(data)
+		if entry := getAssetHolderEntry(db, asset, holder); entry != nil {
+			holders = append(holders, AssetHolder{Address: holder, Balance: entry.Balance})
+		}
+	}
+	return holders
+}
@@ -0,0 +1,87 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+)
+
+func TestAssetHolderIndexer(t *testing.T) {
+	var (
+		issuer = common.HexToAddress("0x1")
+		holder = common.HexToAddress("0x2")
+		other  = common.HexToAddress("0x3")
+		asset  = crypto.CreateAddress(issuer, 0)
+	)
+	db, _ := aoadb.NewMemDatabase()
+	indexer := &AssetHolderIndexer{db: db, sdb: state.NewDatabase(db)}
+	statedb, _ := state.New(common.Hash{}, indexer.sdb)
+
+	// index commits the state and folds it into the index as a new section,
+	// the changes of the next section go into a fresh state like the next block
+	index := func() {
+		root, err := statedb.CommitTo(db, true)
+		if err != nil {
+			t.Fatalf("failed to commit state: %v", err)
+		}
+		statedb, _ = state.New(root, indexer.sdb)
+		indexer.Reset(0, common.Hash{})
+		indexer.Process(&types.Header{Root: root})
+		if err := indexer.Commit(); err != nil {
+			t.Fatalf("failed to index state: %v", err)
+		}
+	}
+	check := func(stage string, want map[common.Address]int64) {
+		if count := GetAssetHolderCount(db, asset); count != uint64(len(want)) {
+			t.Errorf("%s: holder count mismatch: have %d, want %d", stage, count, len(want))
+		}
+		// the holders are read in two pages
+		holders := append(GetAssetHolders(db, asset, 0, 1), GetAssetHolders(db, asset, 1, 10)...)
+		if len(holders) != len(want) {
+			t.Fatalf("%s: holder list length mismatch: have %d, want %d", stage, len(holders), len(want))
+		}
+		for _, h := range holders {
+			if balance, ok := want[h.Address]; !ok || h.Balance.Int64() != balance {
+				t.Errorf("%s: holder %x balance mismatch: have %v, want %d", stage, h.Address, h.Balance, balance)
+			}
+		}
+	}
+	if err := statedb.PublishAsset(issuer, types.AssetInfo{Name: "Points", Symbol: "PTS", Supply: big.NewInt(1000)}); err != nil {
+		t.Fatalf("failed to publish asset: %v", err)
+	}
+	statedb.SubAssetBalance(issuer, asset, big.NewInt(150))
+	statedb.AddAssetBalance(holder, asset, big.NewInt(100))
+	statedb.AddAssetBalance(other, asset, big.NewInt(50))
+	index()
+	check("transfers", map[common.Address]int64{issuer: 850, holder: 100, other: 50})
+
+	// a holder burning its whole balance drops out of the index
+	statedb.BurnAsset(asset, holder, big.NewInt(100))
+	index()
+	check("burn", map[common.Address]int64{issuer: 850, other: 50})
+
+	// indexing an unchanged state is a noop
+	index()
+	check("reindex", map[common.Address]int64{issuer: 850, other: 50})
+}
@@ -17,39 +17,55 @@
 package core
 
 import (
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	"github.com/Aurorachain-io/go-aoa/params"
+	"math/big"
 	"runtime"
 	"testing"
 	"time"
 )
 
+// testDelegate is the single genesis delegate of the verified test chains.
+var testDelegate = common.BytesToAddress([]byte("delegate"))
+
+// invalidTimeHeader returns a copy of header stamped at the time of its parent,
+// which the dpos engine rejects.
+func invalidTimeHeader(header, parent *types.Header) *types.Header {
+	invalid := types.CopyHeader(header)
+	invalid.Time = new(big.Int).Set(parent.Time)
+	return invalid
+}
+
 // Tests that simple header verification works, for both good and bad blocks.
 func TestHeaderVerification(t *testing.T) {
 	// Create a simple chain to verify
 	var (
-		testdb, _ = emdb.NewMemDatabase()
-		gspec     = &Genesis{Config: params.TestChainConfig}
-		genesis   = gspec.MustCommit(testdb)
-		blocks, _ = GenerateChain(params.TestChainConfig, genesis, dpos.New(), testdb, 8, nil)
+		testdb, _ = aoadb.NewMemDatabase()
+		genesis   = GenesisBlockForTesting(testdb, testDelegate, new(big.Int))
+		blocks, _ = GenerateChain(params.AllDacchainProtocolChanges, genesis, dpos.New(), testdb, 8, nil)
 	)
 	headers := make([]*types.Header, len(blocks))
 	for i, block := range blocks {
 		headers[i] = block.Header()
 	}
-	// Run the header checker for blocks one-by-one, checking for both valid and invalid nonces
-	chain, _ := NewBlockChain(testdb, params.TestChainConfig, dpos.New(), vm.Config{}, nil)
+	// Run the header checker for blocks one-by-one, checking for both valid and invalid timestamps
+	chain, _ := NewBlockChain(testdb, params.AllDacchainProtocolChanges, dpos.New(), vm.Config{}, nil)
 	defer chain.Stop()
 
 	for i := 0; i < len(blocks); i++ {
 		for j, valid := range []bool{true, false} {
 			var results <-chan error
 
+			header := headers[i]
+			if !valid {
+				header = invalidTimeHeader(header, chain.GetHeaderByHash(header.ParentHash))
+			}
 			engine := dpos.New()
-			_, results = engine.VerifyHeaders(chain, []*types.Header{headers[i]})
+			_, results = engine.VerifyHeaders(chain, []*types.Header{header})
 
 			// Wait for the verification result
 			select {
@@ -79,17 +95,13 @@ func TestHeaderConcurrentVerification32(t *testing.T) { testHeaderConcurrentVeri
 func testHeaderConcurrentVerification(t *testing.T, threads int) {
 	// Create a simple chain to verify
 	var (
-		testdb, _ = emdb.NewMemDatabase()
-		gspec     = &Genesis{Config: params.TestChainConfig}
-		genesis   = gspec.MustCommit(testdb)
-		blocks, _ = GenerateChain(params.TestChainConfig, genesis, dpos.New(), testdb, 8, nil)
+		testdb, _ = aoadb.NewMemDatabase()
+		genesis   = GenesisBlockForTesting(testdb, testDelegate, new(big.Int))
+		blocks, _ = GenerateChain(params.AllDacchainProtocolChanges, genesis, dpos.New(), testdb, 8, nil)
 	)
 	headers := make([]*types.Header, len(blocks))
-	seals := make([]bool, len(blocks))
-
 	for i, block := range blocks {
 		headers[i] = block.Header()
-		seals[i] = true
 	}
 	// Set the number of threads to verify on
 	old := runtime.GOMAXPROCS(threads)
@@ -100,8 +112,14 @@ func testHeaderConcurrentVerification(t *testing.T, threads int) {
 	for i, valid := range []bool{true, false} {
 		var results <-chan error
 
-		chain, _ := NewBlockChain(testdb, params.TestChainConfig, dpos.New(), vm.Config{}, nil)
-		_, results = chain.dacEngine.VerifyHeaders(chain, headers)
+		verified := headers
+		if !valid {
+			// We chose the last-but-one timestamp in the chain to fail
+			verified = append([]*types.Header{}, headers...)
+			verified[len(headers)-2] = invalidTimeHeader(headers[len(headers)-2], headers[len(headers)-3])
+		}
+		chain, _ := NewBlockChain(testdb, params.AllDacchainProtocolChanges, dpos.New(), vm.Config{}, nil)
+		_, results = chain.dacEngine.VerifyHeaders(chain, verified)
 		chain.Stop()
 		// Wait for all the verification results
 		checks := make(map[int]error)
@@ -116,7 +134,7 @@ func testHeaderConcurrentVerification(t *testing.T, threads int) {
 		}
 		// Check nonce check validity
 		for j := 0; j < len(blocks); j++ {
-			want := valid || (j < len(blocks)-2) // We chose the last-but-one nonce in the chain to fail
+			want := valid || (j < len(blocks)-2) // We chose the last-but-one timestamp in the chain to fail
 			if (checks[j] == nil) != want {
 				t.Errorf("test %d.%d: validity mismatch: have %v, want %v", i, j, checks[j], want)
 			}
@@ -145,24 +163,20 @@ func TestHeaderConcurrentAbortion32(t *testing.T) { testHeaderConcurrentAbortion
 func testHeaderConcurrentAbortion(t *testing.T, threads int) {
 	// Create a simple chain to verify
 	var (
-		testdb, _ = emdb.NewMemDatabase()
-		gspec     = &Genesis{Config: params.TestChainConfig}
-		genesis   = gspec.MustCommit(testdb)
-		blocks, _ = GenerateChain(params.TestChainConfig, genesis, dpos.New(), testdb, 1024, nil)
+		testdb, _ = aoadb.NewMemDatabase()
+		genesis   = GenesisBlockForTesting(testdb, testDelegate, new(big.Int))
+		blocks, _ = GenerateChain(params.AllDacchainProtocolChanges, genesis, dpos.New(), testdb, 1024, nil)
 	)
 	headers := make([]*types.Header, len(blocks))
-	seals := make([]bool, len(blocks))
-
 	for i, block := range blocks {
 		headers[i] = block.Header()
-		seals[i] = true
 	}
 	// Set the number of threads to verify on
 	old := runtime.GOMAXPROCS(threads)
 	defer runtime.GOMAXPROCS(old)
 
 	// Start the verifications and immediately abort
-	chain, _ := NewBlockChain(testdb, params.TestChainConfig, dpos.New(), vm.Config{}, nil)
+	chain, _ := NewBlockChain(testdb, params.AllDacchainProtocolChanges, dpos.New(), vm.Config{}, nil)
 	defer chain.Stop()
 
 	abort, results := chain.dacEngine.VerifyHeaders(chain, headers)
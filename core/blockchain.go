@@ -96,6 +96,7 @@ type BlockChain struct {
 	checkpoint       int          // checkpoint counts towards the new checkpoint
 	currentBlock     *types.Block // Current head of the block chain
 	currentFastBlock *types.Block // Current head of the fast-sync chain (may be above the block chain!)
+	finalizedBlock   *types.Block // Highest canonical block confirmed by a quorum of delegates
 
 	stateCache    state.Database // State database to reuse between imports (contains state cache)
 	delegateCache delegatestate.Database
@@ -184,6 +185,10 @@ func NewBlockChain(chainDb aoadb.Database, config *params.ChainConfig, dacEngine
 func (bc *BlockChain) RollbackBFT(block *types.Block) {
 	blockHash := block.Hash()
 	log.Error("BlockChain|rollbackBFT start", "blockNumber", block.NumberU64(), "blockHash", blockHash)
+	if finalized := bc.CurrentFinalizedBlock(); block.NumberU64() <= finalized.NumberU64() {
+		log.Error("BlockChain|rollbackBFT refused below finalized block", "blockNumber", block.NumberU64(), "finalized", finalized.NumberU64())
+		return
+	}
 	if header := bc.GetHeaderByHash(blockHash); header != nil {
 		headerByNumber := bc.GetHeaderByNumber(header.Number.Uint64())
 		if headerByNumber != nil && headerByNumber.Hash() == header.Hash() {
@@ -249,6 +254,18 @@ func (bc *BlockChain) loadLastState() error {
 		}
 	}
 
+	// Restore the last known finalized block. Every canonical block below a
+	// finalized one is final too, so a rewound head is finalized itself.
+	bc.finalizedBlock = bc.genesisBlock
+	if hash := GetFinalizedBlockHash(bc.chainDb); hash != (common.Hash{}) {
+		if block := bc.GetBlockByHash(hash); block != nil {
+			bc.finalizedBlock = block
+		}
+	}
+	if bc.finalizedBlock.NumberU64() > bc.currentBlock.NumberU64() {
+		bc.finalizedBlock = bc.currentBlock
+	}
+
 	// Issue a status log for the user
 	headerTd := bc.GetTd(currentHeader.Hash(), currentHeader.Number.Uint64())
 	blockTd := bc.GetTd(bc.currentBlock.Hash(), bc.currentBlock.NumberU64())
@@ -257,6 +274,7 @@ func (bc *BlockChain) loadLastState() error {
 	log.Info("Loaded most recent local header", "number", currentHeader.Number, "hash", currentHeader.Hash(), "td", headerTd)
 	log.Info("Loaded most recent local full block", "number", bc.currentBlock.Number(), "hash", bc.currentBlock.Hash(), "td", blockTd)
 	log.Info("Loaded most recent local fast block", "number", bc.currentFastBlock.Number(), "hash", bc.currentFastBlock.Hash(), "td", fastTd)
+	log.Info("Loaded most recent finalized block", "number", bc.finalizedBlock.Number(), "hash", bc.finalizedBlock.Hash())
 
 	return nil
 }
@@ -314,6 +332,11 @@ func (bc *BlockChain) SetHead(head uint64) error {
 	if err := WriteHeadFastBlockHash(bc.chainDb, bc.currentFastBlock.Hash()); err != nil {
 		log.Crit("Failed to reset head fast block", "err", err)
 	}
+	if bc.finalizedBlock != nil && bc.finalizedBlock.NumberU64() > bc.currentBlock.NumberU64() {
+		if err := WriteFinalizedBlockHash(bc.chainDb, bc.currentBlock.Hash()); err != nil {
+			log.Crit("Failed to reset finalized block", "err", err)
+		}
+	}
 	return bc.loadLastState()
 }
 
@@ -362,6 +385,36 @@ func (bc *BlockChain) CurrentBlock() *types.Block {
 	return bc.currentBlock
 }
 
+// CurrentFinalizedBlock retrieves the highest block of the canonical chain
+// which carries valid confirmations of more than two thirds of the delegates.
+// It can't be reverted by a reorg.
+func (bc *BlockChain) CurrentFinalizedBlock() *types.Block {
+	bc.mu.RLock()
+	defer bc.mu.RUnlock()
+
+	return bc.finalizedBlock
+}
+
+// finalize marks block as finalized if it is canonical, above the current
+// finalized block and its confirmations form a valid quorum certificate.
+func (bc *BlockChain) finalize(block *types.Block) {
+	if err := bc.dacEngine.VerifyQuorum(bc, block); err != nil {
+		log.Debug("Block without quorum certificate", "number", block.Number(), "hash", block.Hash(), "err", err)
+		return
+	}
+	bc.mu.Lock()
+	defer bc.mu.Unlock()
+
+	if block.NumberU64() <= bc.finalizedBlock.NumberU64() || GetCanonicalHash(bc.chainDb, block.NumberU64()) != block.Hash() {
+		return
+	}
+	if err := WriteFinalizedBlockHash(bc.chainDb, block.Hash()); err != nil {
+		log.Crit("Failed to insert finalized block", "err", err)
+	}
+	bc.finalizedBlock = block
+	log.Debug("Finalized block", "number", block.Number(), "hash", block.Hash())
+}
+
 // CurrentFastBlock retrieves the current fast-sync head block of the canonical
 // chain. The block is retrieved from the blockchain's internal cache.
 func (bc *BlockChain) CurrentFastBlock() *types.Block {
@@ -455,6 +508,7 @@ func (bc *BlockChain) ResetWithGenesisBlock(genesis *types.Block) error {
 	bc.hc.SetGenesis(bc.genesisBlock.Header())
 	bc.hc.SetCurrentHeader(bc.genesisBlock.Header())
 	bc.currentFastBlock = bc.genesisBlock
+	bc.finalizedBlock = bc.genesisBlock
 
 	return nil
 }
@@ -1166,6 +1220,9 @@ func (bc *BlockChain) insertChain(chain types.Blocks, syncCallback ...func()) (i
 			events = append(events, ChainEvent{block,
 				block.Hash(), logs})
 			lastCanon = block
+			if len(block.RlpEncodeSigns) > 0 {
+				bc.finalize(block)
+			}
 			//candidateWrapper = CountBlockVote(block, *bc.delegateList, stateDB)
 
 		case SideStatTy:
@@ -1256,7 +1313,8 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 		// These logs are later announced as deleted.
 		collectLogs = func(h common.Hash) {
 			// Coalesce logs and set 'Removed'.
-			receipts := GetBlockReceipts(bc.chainDb, h, bc.hc.GetBlockNumber(h))
+			number := bc.hc.GetBlockNumber(h)
+			receipts := GetBlockReceipts(bc.chainDb, h, number)
 			for _, receipt := range receipts {
 				for _, log := range receipt.Logs {
 					del := *log
@@ -1264,6 +1322,13 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 					deletedLogs = append(deletedLogs, &del)
 				}
 			}
+			// the synthetic asset transfer logs are announced as deleted too
+			if bc.vmConfig.WatchInnerTx {
+				for _, log := range watch.BlockTransferLogs(bc.innerTxDb, h, number, receipts) {
+					log.Removed = true
+					deletedLogs = append(deletedLogs, log)
+				}
+			}
 		}
 	)
 
@@ -1308,6 +1373,10 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("Invalid new chain")
 		}
 	}
+	if commonBlock.NumberU64() < bc.finalizedBlock.NumberU64() {
+		log.Warn("Refused reorg below finalized block", "number", commonBlock.Number(), "hash", commonBlock.Hash(), "finalized", bc.finalizedBlock.Number())
+		return ErrFinalizedReorg
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := log.Debug
@@ -1597,3 +1666,16 @@ func (bc *BlockChain) GetStateDB() state.Database {
 func (bc *BlockChain) GetInnerTxDb() watch.InnerTxDb {
 	return bc.innerTxDb
 }
+
+// indexTransferLogs indexes the synthetic asset transfer logs of a block for
+// the log filters and returns them.
+func (bc *BlockChain) indexTransferLogs(hash common.Hash, number uint64, receipts types.Receipts) []*types.Log {
+	logs := watch.BlockTransferLogs(bc.innerTxDb, hash, number, receipts)
+	if len(logs) > 0 {
+		bloom := types.BytesToBloom(types.LogsBloom(logs).Bytes())
+		if err := bc.innerTxDb.SetBloom(hash, bloom); err != nil {
+			log.Warn("save transfer logs bloom error", "err", err)
+		}
+	}
+	return logs
+}
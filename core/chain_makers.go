@@ -22,6 +22,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/consensus"
 	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core/state"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
@@ -162,13 +163,15 @@ func GenerateChain(config *params.ChainConfig, parent *types.Block, dacEngine co
 		config = params.TestChainConfig
 	}
 	blocks, receipts := make(types.Blocks, n), make([]types.Receipts, n)
+	first := parent
 	genblock := func(i int, parent *types.Block, statedb *state.StateDB, delegatedb *delegatestate.DelegateDB) (*types.Block, types.Receipts) {
 		// TODO(karalabe): This is needed for clique, which depends on multiple blocks.
 		// It's nonetheless ugly to spin up a blockchain here. Get rid of this somehow.
 		blockchain, _ := NewBlockChain(db, config, dacEngine, vm.Config{}, nil)
 		defer blockchain.Stop()
 
-		b := &BlockGen{i: i, parent: parent, chain: blocks, chainReader: blockchain, statedb: statedb, config: config, engine: dacEngine, delegatedb: delegatedb}
+		chainReader := &generatedChain{BlockChain: blockchain, parent: first, blocks: blocks[:i]}
+		b := &BlockGen{i: i, parent: parent, chain: blocks, chainReader: chainReader, statedb: statedb, config: config, engine: dacEngine, delegatedb: delegatedb}
 		b.header = makeHeader(b.chainReader, parent, statedb, delegatedb)
 
 		// Execute any user modifications to the block and finalize it
@@ -216,7 +219,7 @@ func makeHeader(chain consensus.ChainReader, parent *types.Block, state *state.S
 		time = new(big.Int).Add(parent.Time(), big.NewInt(10)) // block time is fixed at 10 seconds
 	}
 
-	return &types.Header{
+	header := &types.Header{
 		Root:         state.IntermediateRoot(false),
 		ParentHash:   parent.Hash(),
 		Coinbase:     parent.Coinbase(),
@@ -225,6 +228,73 @@ func makeHeader(chain consensus.ChainReader, parent *types.Block, state *state.S
 		Time:         time,
 		DelegateRoot: db.IntermediateRoot(false),
 	}
+	// generated blocks neither reveal nor commit to a randao secret
+	if chain.Config().IsRandao(header.Number) {
+		header.Extra = dpos.RevealExtra(common.Hash{}, common.Hash{})
+	}
+	// the round is rebuilt to track the missed slots when finalizing
+	if chain.Config().IsJail(header.Number) {
+		makeRound(chain, parent.Header(), header)
+	}
+	return header
+}
+
+// makeRound commits header to the shuffle of its round, a round starting
+// after the parent is shuffled from the parent.
+func makeRound(chain consensus.ChainReader, parent, header *types.Header) {
+	config := chain.Config()
+	genesis := chain.GetHeaderByNumber(0)
+	roundTime := config.MaxElectDelegate.Int64() * config.BlockInterval.Int64()
+	roundStart := header.Time.Int64() - (header.Time.Int64()-genesis.Time.Int64())%roundTime
+	if parent.Time.Int64() >= roundStart {
+		header.ShuffleHash, header.ShuffleBlockNumber = parent.ShuffleHash, parent.ShuffleBlockNumber
+		return
+	}
+	shuffleList, err := dpos.NewRoundShuffleList(chain, parent, roundStart)
+	if err != nil {
+		panic(fmt.Sprintf("shuffle error: %v", err))
+	}
+	header.ShuffleHash, header.ShuffleBlockNumber = shuffleList.Hash(), new(big.Int).Set(parent.Number)
+}
+
+// generatedChain is the chain reader of GenerateChain. Next to the chain in
+// the database it finds the parent given to GenerateChain and the blocks
+// generated on top of it so far.
+type generatedChain struct {
+	*BlockChain
+	parent *types.Block
+	blocks []*types.Block
+}
+
+func (c *generatedChain) block(number uint64) *types.Block {
+	if number == c.parent.NumberU64() {
+		return c.parent
+	}
+	if number > c.parent.NumberU64() && number-c.parent.NumberU64() <= uint64(len(c.blocks)) {
+		return c.blocks[number-c.parent.NumberU64()-1]
+	}
+	return nil
+}
+
+func (c *generatedChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if block := c.block(number); block != nil && block.Hash() == hash {
+		return block.Header()
+	}
+	return c.BlockChain.GetHeader(hash, number)
+}
+
+func (c *generatedChain) GetHeaderByNumber(number uint64) *types.Header {
+	if block := c.block(number); block != nil {
+		return block.Header()
+	}
+	return c.BlockChain.GetHeaderByNumber(number)
+}
+
+func (c *generatedChain) GetBlock(hash common.Hash, number uint64) *types.Block {
+	if block := c.block(number); block != nil && block.Hash() == hash {
+		return block
+	}
+	return c.BlockChain.GetBlock(hash, number)
 }
 
 // newCanonical creates a chain database, and injects a deterministic canonical
@@ -46,6 +46,7 @@ var (
 	headHeaderKey = []byte("LastHeader")
 	headBlockKey  = []byte("LastBlock")
 	headFastKey   = []byte("LastFast")
+	finalizedKey  = []byte("LastFinalized")
 
 	// Data item prefixes (use single byte to avoid mixing data walletType, avoid `i`).
 	headerPrefix        = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
@@ -56,12 +57,14 @@ var (
 	blockReceiptsPrefix = []byte("r") // blockReceiptsPrefix + num (uint64 big endian) + hash -> block receipts
 	lookupPrefix        = []byte("l") // lookupPrefix + hash -> transaction/receipt lookup metadata
 	bloomBitsPrefix     = []byte("B") // bloomBitsPrefix + bit (uint16 big endian) + section (uint64 big endian) + hash -> bloom bits
+	assetHolderPrefix   = []byte("A") // assetHolderPrefix + asset + holder -> holder entry, assetHolderPrefix + asset + index (uint64 big endian) -> holder
 
 	preimagePrefix = "secure-key-"              // preimagePrefix + hash -> preimage
 	configPrefix   = []byte("dacchain-config-") // config prefix for the db
 
 	// Chain index prefixes (use `i` + single byte to avoid mixing data walletType).
-	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	BloomBitsIndexPrefix   = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
+	AssetHolderIndexPrefix = []byte("iA") // AssetHolderIndexPrefix is the data table of the asset holder indexer to track its progress
 
 	// used by old db, now only used for conversion
 	oldReceiptsPrefix = []byte("receipts-")
@@ -136,6 +139,16 @@ func GetHeadBlockHash(db DatabaseReader) common.Hash {
 	return common.BytesToHash(data)
 }
 
+// GetFinalizedBlockHash retrieves the hash of the highest canonical block
+// confirmed by a quorum of delegates.
+func GetFinalizedBlockHash(db DatabaseReader) common.Hash {
+	data, _ := db.Get(finalizedKey)
+	if len(data) == 0 {
+		return common.Hash{}
+	}
+	return common.BytesToHash(data)
+}
+
 // GetHeadFastBlockHash retrieves the hash of the current canonical head block during
 // fast synchronization. The difference between this and GetHeadBlockHash is that
 // whereas the last block hash is only updated upon a full block import, the last
@@ -376,6 +389,14 @@ func WriteHeadFastBlockHash(db aoadb.Putter, hash common.Hash) error {
 	return nil
 }
 
+// WriteFinalizedBlockHash stores the finalized block's hash.
+func WriteFinalizedBlockHash(db aoadb.Putter, hash common.Hash) error {
+	if err := db.Put(finalizedKey, hash.Bytes()); err != nil {
+		log.Crit("Failed to store finalized block's hash", "err", err)
+	}
+	return nil
+}
+
 // WriteHeader serializes a block header into the database.
 func WriteHeader(db aoadb.Putter, header *types.Header) error {
 	data, err := rlp.EncodeToBytes(header)
@@ -18,14 +18,14 @@ package core
 
 import (
 	"bytes"
-	"math/big"
-	"testing"
 	"fmt"
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/crypto/sha3"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	"github.com/Aurorachain-io/go-aoa/rlp"
+	"math/big"
+	"testing"
 	"time"
 )
 
@@ -44,7 +44,7 @@ type candidateData struct {
 
 // Tests block header storage and retrieval operations.
 func TestHeaderStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	// Create a test header to move around the database and make sure it's really new
 	header := &types.Header{Number: big.NewInt(42), Extra: []byte("test header")}
@@ -79,7 +79,7 @@ func TestHeaderStorage(t *testing.T) {
 
 // Tests block body storage and retrieval operations.
 func TestBodyStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	// Create a test body to move around the database and make sure it's really new
 	body := &types.Body{}
@@ -119,7 +119,7 @@ func TestBodyStorage(t *testing.T) {
 
 // Tests block storage and retrieval operations.
 func TestBlockStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	// Create a test block to move around the database and make sure it's really new
 	block := types.NewBlockWithHeader(&types.Header{
@@ -170,7 +170,7 @@ func TestBlockStorage(t *testing.T) {
 
 // Tests that partial block contents don't get reassembled into full blocks.
 func TestPartialBlockStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 	block := types.NewBlockWithHeader(&types.Header{
 		Extra:       []byte("test block"),
 		TxHash:      types.EmptyRootHash,
@@ -210,7 +210,7 @@ func TestPartialBlockStorage(t *testing.T) {
 
 // Tests block total difficulty storage and retrieval operations.
 func TestTdStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	// Create a test TD to move around the database and make sure it's really new
 	hash, td := common.Hash{}, big.NewInt(314)
@@ -235,7 +235,7 @@ func TestTdStorage(t *testing.T) {
 
 // Tests that canonical numbers can be mapped to hashes and retrieved.
 func TestCanonicalMappingStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	// Create a test canonical number and assinged hash to move around
 	hash, number := common.Hash{0: 0xff}, uint64(314)
@@ -260,7 +260,7 @@ func TestCanonicalMappingStorage(t *testing.T) {
 
 // Tests that head headers and head blocks can be assigned, individually.
 func TestHeadStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	blockHead := types.NewBlockWithHeader(&types.Header{Extra: []byte("test block header")})
 	blockFull := types.NewBlockWithHeader(&types.Header{Extra: []byte("test block full")})
@@ -300,7 +300,7 @@ func TestHeadStorage(t *testing.T) {
 
 // Tests that positional lookup metadata can be stored and retrieved.
 func TestLookupStorage(t *testing.T) {
-	//db, _ := emdb.NewMemDatabase()
+	//db, _ := aoadb.NewMemDatabase()
 
 	//tx1 := walletType.NewTransaction(1, common.BytesToAddress([]byte{0x11}), big.NewInt(111), 1111, big.NewInt(11111), []byte{0x11, 0x11, 0x11})
 	//tx2 := walletType.NewTransaction(2, common.BytesToAddress([]byte{0x22}), big.NewInt(222), 2222, big.NewInt(22222), []byte{0x22, 0x22, 0x22})
@@ -345,7 +345,7 @@ func TestLookupStorage(t *testing.T) {
 
 // Tests that receipts associated with a single block can be stored and retrieved.
 func TestBlockReceiptStorage(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 
 	receipt1 := &types.Receipt{
 		Status:            types.ReceiptStatusFailed,
@@ -401,7 +401,7 @@ func TestBlockReceiptStorage(t *testing.T) {
 
 func TestWriteDelegateBodyRLP(t *testing.T) {
 
-	db, _ := emdb.NewLDBDatabase("234", 0, 0)
+	db, _ := aoadb.NewMemDatabase()
 	can := []Candidate{
 		{"0x70715a2a44255ddce2779d60ba95968b770fc759", uint64(2), "node1", nil},
 		{"0xfd48a829397a16b3bc6c319a06a47cd2ce6b3f58", uint64(3), "node2", nil},
@@ -426,7 +426,7 @@ func TestWriteDelegateBodyRLP(t *testing.T) {
 }
 
 func TestWriteDelegateShuffleBlockHeightRLP(t *testing.T) {
-	db, _ := emdb.NewLDBDatabase("456", 0, 0)
+	db, _ := aoadb.NewMemDatabase()
 
 	shuffleDelegateData := types.ShuffleDelegateData{BlockNumber: *big.NewInt(2), ShuffleTime: *big.NewInt(time.Now().Unix())}
 	data, err := rlp.EncodeToBytes(shuffleDelegateData)
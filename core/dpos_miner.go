@@ -24,8 +24,10 @@ import (
 	aa "github.com/Aurorachain-io/go-aoa/accounts/walletType"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"github.com/Aurorachain-io/go-aoa/consensus"
 	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core/state"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
@@ -33,6 +35,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/log"
 	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/util"
 	"github.com/pkg/errors"
 	"math/big"
 	"strings"
@@ -58,6 +61,7 @@ type DposMiner struct {
 	config                    *params.ChainConfig
 	current                   *worker
 	engine                    consensus.Engine
+	clock                     mclock.Clock
 	currentNewRoundHash       *types.ShuffleData
 	shuffleHashChan           chan *types.ShuffleData
 	delegateInfoMap           map[string]*ecdsa.PrivateKey
@@ -78,13 +82,14 @@ type worker struct {
 	currentMu  sync.Mutex
 }
 
-func NewDposMiner(config *params.ChainConfig, dac Backend, engine consensus.Engine) *DposMiner {
+func NewDposMiner(config *params.ChainConfig, dac Backend, engine consensus.Engine, clock mclock.Clock) *DposMiner {
 
 	dposMiner := &DposMiner{
 		blockChan:       make(chan *types.Block),
 		dac:             dac,
 		config:          config,
 		engine:          engine,
+		clock:           clock,
 		shuffleHashChan: make(chan *types.ShuffleData),
 		delegateInfoMap: make(map[string]*ecdsa.PrivateKey, 0),
 	}
@@ -136,8 +141,7 @@ func NewDposMiner(config *params.ChainConfig, dac Backend, engine consensus.Engi
 			ShuffleHash:        *shuffleData.ShuffleHash,
 			ShuffleBlockNumber: shuffleData.ShuffleBlockNumber,
 		}
-		log.Info("dpos|produceBlockCallback", "blockNumber", header.Number.Uint64(), "blockGasLimit", gasLimit, "beginTime", tstamp, "currentTime", time.Now().Unix(), "coinbase", header.Coinbase.Hex())
-
+		log.Info("dpos|produceBlockCallback", "blockNumber", header.Number.Uint64(), "blockGasLimit", gasLimit, "beginTime", tstamp, "currentTime", dposMiner.clock.Time().Unix(), "coinbase", header.Coinbase.Hex())
 		if err := engine.Prepare(dposMiner.dac.BlockChain(), header); err != nil {
 			log.Error("dpos|Failed to prepare header", "err", err)
 			return
@@ -163,10 +167,22 @@ func NewDposMiner(config *params.ChainConfig, dac Backend, engine consensus.Engi
 		no := time.Now()
 		dposMiner.commitTransactions(txs, header.Coinbase)
 		log.Info("commitTransactions end", "timestamp", time.Now().Sub(no), "whole Time", time.Now().Sub(now))
+		// the transactions may have changed the delegate of the producer
+		if dposMiner.dac.BlockChain().Config().IsRandao(header.Number) {
+			reveal, err := dposMiner.revealWithoutWallet(header.Coinbase, work.delegatedb)
+			if err != nil {
+				log.Error("dpos|Failed to create randao reveal", "err", err)
+				return
+			}
+			header.Extra = reveal
+		}
 		if work.Block, err = engine.Finalize(dposMiner.dac.BlockChain(), header, work.state, work.delegatedb, work.txs, work.receipts); err != nil {
 			log.Error("Failed to finalize block for sealing", "err", err)
 			return
 		}
+		if bc := dposMiner.dac.BlockChain(); bc.vmConfig.WatchInnerTx {
+			bc.indexTransferLogs(work.Block.Hash(), work.Block.NumberU64(), work.receipts)
+		}
 
 		if work.Block != nil {
 			err := dposMiner.signBlockWithoutWallet(work.Block, header.Coinbase)
@@ -221,6 +237,24 @@ func (d *DposMiner) signBlockWithoutWallet(block *types.Block, coinbase common.A
 	return nil
 }
 
+// reveal the randao secret committed in the last block of coinbase and commit
+// to the next one, the secrets derive from the key store in memory
+func (d *DposMiner) revealWithoutWallet(coinbase common.Address, dState *delegatestate.DelegateDB) ([]byte, error) {
+	address := strings.ToLower(coinbase.Hex())
+	key, ok := d.delegateInfoMap[address]
+	if !ok {
+		errMsg := fmt.Sprintf("create reveal fail because can not find pwd in memory address:%s lenMap:%d", coinbase.Hex(), len(d.delegateInfoMap))
+		return nil, errors.New(errMsg)
+	}
+	count := dState.GetRevealCount(coinbase)
+	var reveal common.Hash
+	if dState.GetRevealCommit(coinbase) != (common.Hash{}) {
+		reveal = util.RevealSecret(key, count-1)
+	}
+	commit := crypto.Keccak256Hash(util.RevealSecret(key, count).Bytes())
+	return dpos.RevealExtra(reveal, commit), nil
+}
+
 func (d *DposMiner) GetCurrentNewRoundHash() *types.ShuffleData {
 	return d.currentNewRoundHash
 }
@@ -263,7 +297,7 @@ func (d *DposMiner) makeCurrent(parent *types.Block, header *types.Header) error
 	work := &worker{
 		config:     d.config,
 		state:      statedb,
-		createdAt:  time.Now(),
+		createdAt:  d.clock.Time(),
 		tcount:     0,
 		signer:     types.NewAuroraSigner(d.config.ChainId),
 		header:     header,
@@ -274,8 +308,13 @@ func (d *DposMiner) makeCurrent(parent *types.Block, header *types.Header) error
 
 }
 
+// Pending returns the block being produced and its state, or nils if the node
+// hasn't started producing a block.
 func (d *DposMiner) Pending() (*types.Block, *state.StateDB) {
 	work := d.current
+	if work == nil {
+		return nil, nil
+	}
 	work.currentMu.Lock()
 	defer work.currentMu.Unlock()
 	return work.Block, work.state.Copy()
@@ -386,7 +425,7 @@ func (d *DposMiner) commitTransactions(txs *types.TransactionsByPriceAndNonce, c
 			env.tcount++
 			txs.Shift()
 
-		case ErrCancelAgent, ErrSubVote, ErrDuplicateRegisterAgent, ErrSubVoteNotEnough, ErrAddVote:
+		case ErrCancelAgent, ErrSubVote, ErrDuplicateRegisterAgent, ErrSubVoteNotEnough, ErrAddVote, ErrUnjailAgent, ErrNotJailed, ErrSlashAgent, ErrUnregisterAgent, ErrAlreadyLeaving, ErrCommissionAgent, ErrCommissionRaise:
 			log.Trace("Skipping transaction with error vote action", "tx", tx.Hash(), "nonce", tx.Nonce())
 			txs.Shift()
 
@@ -417,7 +456,7 @@ func (env *worker) commitTransaction(tx *types.Transaction, bc *BlockChain, coin
 	snap := env.state.Snapshot()
 	delegateSnap := env.delegatedb.Snapshot()
 	//now := time.Now()
-	receipt, gasUsed, err := ApplyTransaction(env.config, bc, &coinbase, gp, env.state, env.header, tx, &env.header.GasUsed, vm.Config{}, env.delegatedb, env.header.Time.Uint64(), false)
+	receipt, gasUsed, err := ApplyTransaction(env.config, bc, &coinbase, gp, env.state, env.header, tx, &env.header.GasUsed, vm.Config{}, env.delegatedb, env.header.Time.Uint64(), true)
 	// log.Debug("dposMiner|applyTransaction cost", "timestamp", time.Now().Sub(now), "err", err)
 	if err != nil {
 		env.state.RevertToSnapshot(snap)
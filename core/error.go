@@ -29,6 +29,10 @@ var (
 	// ErrBlacklistedHash is returned if a block to import is on the blacklist.
 	ErrBlacklistedHash = errors.New("blacklisted hash")
 
+	// ErrFinalizedReorg is returned if a block to import would reorganise the
+	// chain below the finalized block.
+	ErrFinalizedReorg = errors.New("reorg below finalized block")
+
 	// ErrNonceTooHigh is returned if the nonce of a transaction is higher than the
 	// next one expected based on the local chain.
 	ErrNonceTooHigh = errors.New("nonce too high")
@@ -42,4 +46,67 @@ var (
 	ErrSubVoteNotEnough = errors.New("delegate sub error,vote not enough")
 
 	ErrCancelAgent = errors.New("delegate not exist when cancel")
+
+	ErrUnjailAgent = errors.New("delegate not exist when unjail")
+
+	ErrNotJailed = errors.New("delegate is not jailed")
+
+	ErrSlashAgent = errors.New("delegate not exist when slash")
+
+	ErrUnregisterAgent = errors.New("delegate not exist when unregister")
+
+	ErrAlreadyLeaving = errors.New("delegate is already leaving")
+
+	ErrCommissionAgent = errors.New("delegate not exist when set commission")
+
+	ErrInvalidCommission = errors.New("commission exceeds 100%")
+
+	ErrCommissionRaise = errors.New("commission raised too much or too often")
+
+	// ErrAssetNotIssuer is returned if an account other than the issuer tries to
+	// mint an asset or update its metadata.
+	ErrAssetNotIssuer = errors.New("sender is not the asset issuer")
+
+	// ErrAssetFixedSupply is returned when minting an asset published with a
+	// fixed supply.
+	ErrAssetFixedSupply = errors.New("asset supply is fixed")
+
+	// ErrAssetMaxSupply is returned if minting would push the circulating supply
+	// of an asset above its maximum supply.
+	ErrAssetMaxSupply = errors.New("asset max supply exceeded")
+
+	// ErrAssetSymbolTaken is returned when publishing an asset under a symbol
+	// already registered by another asset.
+	ErrAssetSymbolTaken = errors.New("asset symbol already registered")
+
+	// ErrAssetNotFreezable is returned when freezing an asset published without
+	// the freeze control.
+	ErrAssetNotFreezable = errors.New("asset is not freezable")
+
+	// ErrAssetNoWhitelist is returned when whitelisting holders of an asset
+	// published without a whitelist.
+	ErrAssetNoWhitelist = errors.New("asset has no whitelist")
+
+	// ErrAssetTransferRestricted is returned if the freeze or whitelist controls
+	// of an asset forbid a transfer.
+	ErrAssetTransferRestricted = errors.New("asset transfer restricted by issuer")
+
+	// ErrFeePoolFunds is returned if an issuer withdraws more than the fee pool
+	// of its asset holds.
+	ErrFeePoolFunds = errors.New("insufficient fee pool funds")
+
+	// ErrFeePoolUnavailable is returned if a transaction pays its gas from a fee
+	// pool that is disabled or doesn't exist.
+	ErrFeePoolUnavailable = errors.New("fee pool unavailable")
+
+	// ErrFeePoolRate is returned if the fee pool charges more than the highest
+	// rate accepted by the transaction.
+	ErrFeePoolRate = errors.New("fee pool rate exceeds the transaction limit")
+
+	// ErrAssetAllowance is returned when approving a spender without naming the
+	// spender and an asset, or for an amount out of range.
+	ErrAssetAllowance = errors.New("invalid asset allowance")
+
+	// ErrAssetAmount is returned when minting or burning a non-positive amount.
+	ErrAssetAmount = errors.New("invalid asset amount")
 )
@@ -43,26 +43,52 @@ type ChainContext interface {
 func NewEVMContext(msg Message, header *types.Header, chain ChainContext, author *common.Address) vm.Context {
 	var delegates *map[common.Address]types.Candidate
 	var err error
-	if msg.Action() == types.ActionRegister || msg.Action() == types.ActionAddVote || msg.Action() == types.ActionSubVote {
+	if msg.Action() == types.ActionRegister || msg.Action() == types.ActionAddVote || msg.Action() == types.ActionSubVote || msg.Action() == types.ActionUnjail || msg.Action() == types.ActionSlash || msg.Action() == types.ActionUnregister || msg.Action() == types.ActionSetCommission {
 		delegates, err = chain.GetDelegatePoll()
 		if err != nil {
 			return vm.Context{}
 		}
 	}
+	var verifyEvidence vm.VerifyEvidenceFunc
+	if reader, ok := chain.(consensus.ChainReader); ok && msg.Action() == types.ActionSlash && chain.Engine() != nil {
+		verifyEvidence = func(evidence *types.DoubleSignEvidence) (common.Address, error) {
+			return chain.Engine().VerifyEvidence(reader, evidence)
+		}
+	}
 
 	return vm.Context{
-		CanTransfer:  CanTransfer,
-		Transfer:     Transfer,
-		Vote:         Vote,
-		GetHash:      GetHashFn(header, chain),
-		Origin:       msg.From(),
-		Coinbase:     header.Coinbase,
-		BlockNumber:  new(big.Int).Set(header.Number),
-		Time:         new(big.Int).Set(header.Time),
-		Difficulty:   new(big.Int).Set(types.BlockDifficult),
-		GasLimit:     header.GasLimit,
-		GasPrice:     new(big.Int).Set(msg.GasPrice()),
-		DelegateList: delegates,
+		CanTransfer:    CanTransfer,
+		Transfer:       Transfer,
+		Vote:           Vote,
+		VerifyEvidence: verifyEvidence,
+		GetHash:        GetHashFn(header, chain),
+		Origin:         msg.From(),
+		Coinbase:       header.Coinbase,
+		BlockNumber:    new(big.Int).Set(header.Number),
+		Time:           new(big.Int).Set(header.Time),
+		Difficulty:     new(big.Int).Set(types.BlockDifficult),
+		GasLimit:       header.GasLimit,
+		GasPrice:       new(big.Int).Set(msg.GasPrice()),
+		DelegateList:   delegates,
+		GetShuffleList: GetShuffleListFn(header, chain),
+	}
+}
+
+// GetShuffleListFn returns a GetShuffleListFunc which rebuilds the shuffle list
+// of the round ref is produced in with the consensus engine of the chain. The
+// list is nil if the round can't be rebuilt, e.g. the chain can't open
+// historical delegate states.
+func GetShuffleListFn(ref *types.Header, chain ChainContext) vm.GetShuffleListFunc {
+	return func() *types.ShuffleList {
+		reader, ok := chain.(consensus.ChainReader)
+		if !ok || chain.Engine() == nil {
+			return nil
+		}
+		shuffleList, err := chain.Engine().RoundShuffleList(reader, ref)
+		if err != nil {
+			return nil
+		}
+		return shuffleList
 	}
 }
 
@@ -94,25 +120,31 @@ func GetHashFn(ref *types.Header, chain ChainContext) func(n uint64) common.Hash
 
 // CanTransfer checks wether there are enough funds in the address' account to make a transfer.
 // This does not take the necessary gas in to account to make the transfer valid.
-func CanTransfer(db vm.StateDB, addr common.Address, asset *common.Address, amount *big.Int) bool {
+// Asset transfers must also pass the freeze and whitelist controls of the asset.
+func CanTransfer(db vm.StateDB, addr common.Address, recipient common.Address, asset *common.Address, amount *big.Int) bool {
 	if asset == nil {
 		return db.GetBalance(addr).Cmp(amount) >= 0
 	} else {
-		return db.GetAssetBalance(addr, *asset).Cmp(amount) >= 0
+		return db.GetAssetBalance(addr, *asset).Cmp(amount) >= 0 && db.IsAssetTransferAllowed(*asset, addr, recipient)
 	}
 }
 
-// Transfer subtracts amount from sender and adds amount to recipient using the given Db
-func Transfer(db vm.StateDB, sender, recipient common.Address, asset *common.Address, amount *big.Int) {
+// Transfer subtracts amount from sender and adds amount to recipient using the given Db.
+// An asset transfer restricted by the issuer or not covered by the balance fails.
+func Transfer(db vm.StateDB, sender, recipient common.Address, asset *common.Address, amount *big.Int) error {
 	if asset == nil {
 		db.SubBalance(sender, amount)
 		db.AddBalance(recipient, amount)
-	} else {
-		ok := db.SubAssetBalance(sender, *asset, amount)
-		if ok {
-			db.AddAssetBalance(recipient, *asset, amount)
-		}
+		return nil
+	}
+	if !db.IsAssetTransferAllowed(*asset, sender, recipient) {
+		return ErrAssetTransferRestricted
 	}
+	if !db.SubAssetBalance(sender, *asset, amount) {
+		return vm.ErrInsufficientBalance
+	}
+	db.AddAssetBalance(recipient, *asset, amount)
+	return nil
 }
 
 func Vote(db vm.StateDB, user common.Address, vote []types.Vote, delegateList *map[common.Address]types.Candidate, maxElectDelegate int64) error {
@@ -121,7 +153,7 @@ func Vote(db vm.StateDB, user common.Address, vote []types.Vote, delegateList *m
 	if err != nil {
 		return err
 	}
-	if !CanTransfer(db, user, nil, diff) {
+	if !CanTransfer(db, user, common.Address{}, nil, diff) {
 		return vm.ErrInsufficientBalance
 	}
 	lockBalance := db.GetLockBalance(user)
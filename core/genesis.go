@@ -155,6 +155,14 @@ func SetupGenesisBlock(db aoadb.Database, genesis *Genesis) (*params.ChainConfig
 	if genesis != nil && genesis.Config == nil {
 		return params.AllDacchainProtocolChanges, common.Hash{}, genesis, errGenesisNoConfig
 	}
+	if genesis != nil {
+		if err := genesis.Config.CheckConfigForkOrder(); err != nil {
+			return genesis.Config, common.Hash{}, genesis, err
+		}
+		if err := genesis.Config.CheckRewardSchedule(); err != nil {
+			return genesis.Config, common.Hash{}, genesis, err
+		}
+	}
 
 	// Just commit the new block if there is no stored genesis block.
 	stored := GetCanonicalHash(db, 0)
@@ -180,6 +188,12 @@ func SetupGenesisBlock(db aoadb.Database, genesis *Genesis) (*params.ChainConfig
 
 	// Get the existing chain configuration.
 	newcfg := genesis.configOrDefault(stored)
+	if err := newcfg.CheckConfigForkOrder(); err != nil {
+		return newcfg, stored, genesis, err
+	}
+	if err := newcfg.CheckRewardSchedule(); err != nil {
+		return newcfg, stored, genesis, err
+	}
 	storedcfg, err := GetChainConfig(db, stored)
 	if err != nil {
 		if err == ErrChainConfigNotFound {
@@ -248,7 +262,13 @@ func (g *Genesis) ToBlock() (*types.Block, *state.StateDB, *delegatestate.Delega
 	if len(topDelegates) > int(MaxElectDelegate) {
 		topDelegates = topDelegates[:int(MaxElectDelegate)]
 	}
-	shuffleNewRound := util.ShuffleNewRound(int64(g.Timestamp), int(MaxElectDelegate), topDelegates, config.BlockInterval.Int64())
+	var shuffleNewRound []types.ShuffleDel
+	if config.IsRandao(new(big.Int).SetUint64(g.Number)) {
+		// no block has revealed anything yet
+		shuffleNewRound = util.ShuffleNewRoundWithSeed(int64(g.Timestamp), int(MaxElectDelegate), topDelegates, config.BlockInterval.Int64(), common.Hash{})
+	} else {
+		shuffleNewRound = util.ShuffleNewRound(int64(g.Timestamp), int(MaxElectDelegate), topDelegates, config.BlockInterval.Int64())
+	}
 	shuffleList := types.ShuffleList{ShuffleDels: shuffleNewRound}
 	rlpShufflehash := rlpHash(shuffleList)
 
@@ -323,9 +343,14 @@ func (g *Genesis) MustCommit(db aoadb.Database) *types.Block {
 	return block
 }
 
-// GenesisBlockForTesting creates and writes a block in which addr has the given wei balance.
+// GenesisBlockForTesting creates and writes a block in which addr has the given wei balance
+// and is the only delegate.
 func GenesisBlockForTesting(db aoadb.Database, addr common.Address, balance *big.Int) *types.Block {
-	g := Genesis{Alloc: GenesisAlloc{addr: {Balance: balance}}}
+	g := Genesis{
+		Config: params.AllDacchainProtocolChanges,
+		Alloc:  GenesisAlloc{addr: {Balance: balance}},
+		Agents: GenesisAgents{{Address: addr.Hex(), Vote: 1, Nickname: "test"}},
+	}
 	return g.MustCommit(db)
 }
 
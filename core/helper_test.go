@@ -19,8 +19,8 @@ package core
 import (
 	"container/list"
 	"fmt"
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/core/types"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	"github.com/Aurorachain-io/go-aoa/event"
 )
 
@@ -29,7 +29,7 @@ type TestManager struct {
 	// stateManager *StateManager
 	eventMux *event.TypeMux
 
-	db         emdb.Database
+	db         aoadb.Database
 	txPool     *TxPool
 	blockChain *BlockChain
 	Blocks     []*types.Block
@@ -71,12 +71,12 @@ func (tm *TestManager) EventMux() *event.TypeMux {
 // 	return nil
 // }
 
-func (tm *TestManager) Db() emdb.Database {
+func (tm *TestManager) Db() aoadb.Database {
 	return tm.db
 }
 
 func NewTestManager() *TestManager {
-	db, err := emdb.NewMemDatabase()
+	db, err := aoadb.NewMemDatabase()
 	if err != nil {
 		fmt.Println("Could not create mem-db, failing")
 		return nil
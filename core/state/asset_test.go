@@ -0,0 +1,157 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+)
+
+func TestIsAssetTransferAllowed(t *testing.T) {
+	var (
+		issuer = common.HexToAddress("0x1")
+		holder = common.HexToAddress("0x2")
+		other  = common.HexToAddress("0x3")
+		asset  = crypto.CreateAddress(issuer, 0)
+	)
+	tests := []struct {
+		name    string
+		flags   uint64
+		holder  uint64 // status of the holder
+		other   uint64 // status of the other account
+		from    common.Address
+		to      common.Address
+		allowed bool
+	}{
+		{"uncontrolled", 0, 0, 0, holder, other, true},
+		{"uncontrolled ignores status", 0, types.AssetAccountFrozen, 0, holder, other, true},
+		{"freezable", types.AssetFlagFreezable, 0, 0, holder, other, true},
+		{"frozen sender", types.AssetFlagFreezable, types.AssetAccountFrozen, 0, holder, other, false},
+		{"frozen recipient", types.AssetFlagFreezable, 0, types.AssetAccountFrozen, other, holder, false},
+		{"frozen recipient of issuer", types.AssetFlagFreezable, types.AssetAccountFrozen, 0, issuer, holder, false},
+		{"global freeze", types.AssetFlagFreezable | types.AssetFlagFrozen, 0, 0, holder, other, false},
+		{"global freeze exempts issuer", types.AssetFlagFreezable | types.AssetFlagFrozen, 0, 0, issuer, holder, true},
+		{"global freeze blocks sending to issuer", types.AssetFlagFreezable | types.AssetFlagFrozen, 0, 0, holder, issuer, false},
+		{"whitelist", types.AssetFlagWhitelist, types.AssetAccountWhitelisted, types.AssetAccountWhitelisted, holder, other, true},
+		{"whitelist unlisted recipient", types.AssetFlagWhitelist, types.AssetAccountWhitelisted, 0, holder, other, false},
+		{"whitelist unlisted sender", types.AssetFlagWhitelist, 0, types.AssetAccountWhitelisted, holder, other, false},
+		{"whitelist exempts issuer", types.AssetFlagWhitelist, types.AssetAccountWhitelisted, 0, issuer, holder, true},
+		{"whitelist frozen account", types.AssetFlagWhitelist | types.AssetFlagFreezable, types.AssetAccountWhitelisted | types.AssetAccountFrozen, types.AssetAccountWhitelisted, holder, other, false},
+	}
+	for _, test := range tests {
+		db, _ := aoadb.NewMemDatabase()
+		state, _ := New(common.Hash{}, NewDatabase(db))
+		if err := state.PublishAsset(issuer, types.AssetInfo{Name: "Test", Symbol: "TST", Supply: big.NewInt(1000)}); err != nil {
+			t.Fatalf("%s: failed to publish asset: %v", test.name, err)
+		}
+		state.SetAssetFlags(asset, test.flags)
+		state.SetAssetAccountStatus(asset, holder, test.holder)
+		state.SetAssetAccountStatus(asset, other, test.other)
+
+		if allowed := state.IsAssetTransferAllowed(asset, test.from, test.to); allowed != test.allowed {
+			t.Errorf("%s: transfer allowed mismatch: have %v, want %v", test.name, allowed, test.allowed)
+		}
+	}
+}
+
+func TestAssetSupply(t *testing.T) {
+	var (
+		issuer = common.HexToAddress("0x1")
+		holder = common.HexToAddress("0x2")
+		asset  = crypto.CreateAddress(issuer, 0)
+	)
+	db, _ := aoadb.NewMemDatabase()
+	state, _ := New(common.Hash{}, NewDatabase(db))
+	if err := state.PublishAsset(issuer, types.AssetInfo{Name: "Test", Symbol: "TST", Supply: big.NewInt(1000)}); err != nil {
+		t.Fatalf("failed to publish asset: %v", err)
+	}
+	state.MintAsset(asset, holder, big.NewInt(400))
+	if !state.BurnAsset(asset, holder, big.NewInt(150)) {
+		t.Fatalf("failed to burn asset")
+	}
+	// burning more than the holder has destroys nothing
+	if state.BurnAsset(asset, holder, big.NewInt(251)) {
+		t.Errorf("burned more than the holder balance")
+	}
+	if balance := state.GetAssetBalance(holder, asset); balance.Cmp(big.NewInt(250)) != 0 {
+		t.Errorf("holder balance mismatch: have %v, want 250", balance)
+	}
+	supply, err := state.GetAssetSupply(asset)
+	if err != nil {
+		t.Fatalf("failed to get supply: %v", err)
+	}
+	if supply.Cmp(big.NewInt(1250)) != 0 {
+		t.Errorf("circulating supply mismatch: have %v, want 1250", supply)
+	}
+	if _, err := state.GetAssetSupply(holder); err == nil {
+		t.Errorf("supply of an unknown asset returned")
+	}
+}
+
+func TestAssetSymbolRegistry(t *testing.T) {
+	asset := common.HexToAddress("0xa55e7")
+
+	db, _ := aoadb.NewMemDatabase()
+	state, _ := New(common.Hash{}, NewDatabase(db))
+	state.RegisterAssetSymbol("USDT", asset)
+
+	// symbols are matched regardless of their case
+	for _, symbol := range []string{"USDT", "usdt", "uSdT"} {
+		if have := state.GetAssetBySymbol(symbol); have != asset {
+			t.Errorf("%s: registered asset mismatch: have %x, want %x", symbol, have, asset)
+		}
+	}
+	if have := state.GetAssetBySymbol("USDC"); have != (common.Address{}) {
+		t.Errorf("free symbol registered to %x", have)
+	}
+	// the registry survives the removal of empty accounts
+	state.Finalise(true)
+	if have := state.GetAssetBySymbol("usdt"); have != asset {
+		t.Errorf("registry swept away: have %x, want %x", have, asset)
+	}
+}
+
+func TestAssetAllowance(t *testing.T) {
+	var (
+		asset   = common.HexToAddress("0xa55e7")
+		owner   = common.HexToAddress("0x1")
+		spender = common.HexToAddress("0x2")
+	)
+	db, _ := aoadb.NewMemDatabase()
+	state, _ := New(common.Hash{}, NewDatabase(db))
+	state.SetAssetAllowance(asset, owner, spender, big.NewInt(1000))
+
+	tests := []struct {
+		owner, spender common.Address
+		want           int64
+	}{
+		{owner, spender, 1000},
+		// allowances are directed
+		{spender, owner, 0},
+		{owner, owner, 0},
+	}
+	for i, test := range tests {
+		if have := state.GetAssetAllowance(asset, test.owner, test.spender); have.Int64() != test.want {
+			t.Errorf("test %d: allowance mismatch: have %v, want %d", i, have, test.want)
+		}
+	}
+}
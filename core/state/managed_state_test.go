@@ -19,14 +19,14 @@ package state
 import (
 	"testing"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 )
 
 var addr = common.BytesToAddress([]byte("test"))
 
 func create() (*ManagedState, *account) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 	statedb, _ := New(common.Hash{}, NewDatabase(db))
 	ms := ManageState(statedb)
 	ms.StateDB.SetNonce(addr, 100)
@@ -118,6 +118,9 @@ func newObject(db *StateDB, address common.Address, data Account, onDirty func(a
 	if data.Balance == nil {
 		data.Balance = new(big.Int)
 	}
+	if data.LockBalance == nil {
+		data.LockBalance = new(big.Int)
+	}
 	if data.CodeHash == nil {
 		data.CodeHash = emptyCodeHash
 	}
@@ -224,6 +227,24 @@ func (self *stateObject) setState(key, value common.Hash) {
 	self.tryMarkDirty()
 }
 
+// SetStorage replaces the entire storage of the account with the given one.
+// The change is not journaled, it is meant for overriding the state of calls
+// that are never committed.
+func (self *stateObject) SetStorage(db Database, storage map[common.Hash]common.Hash) {
+	tr, err := db.OpenStorageTrie(self.addrHash, common.Hash{})
+	if err != nil {
+		self.setError(err)
+		return
+	}
+	self.trie = tr
+	self.cachedStorage = make(Storage)
+	self.dirtyStorage = make(Storage)
+	for key, value := range storage {
+		self.setState(key, value)
+	}
+	self.tryMarkDirty()
+}
+
 // updateTrie writes cached storage modifications into the object's storage trie.
 func (self *stateObject) updateTrie(db Database) Trie {
 	tr := self.getTrie(db)
@@ -515,6 +536,20 @@ func (self *stateObject) SetAssetData(hash common.Hash, data []byte) error {
 	return self.setAssetData(hash, data)
 }
 
+// UpdateAssetData replaces the asset data of an asset account.
+func (self *stateObject) UpdateAssetData(db Database, hash common.Hash, data []byte) error {
+	prev, err := self.AssetData(db)
+	if err != nil {
+		return err
+	}
+	self.db.journal = append(self.db.journal, assetDataChange{
+		account:  &self.address,
+		prevdata: prev,
+		prevhash: common.BytesToHash(self.data.AssetHash),
+	})
+	return self.setAssetData(hash, data)
+}
+
 func (self *stateObject) setAssetData(hash common.Hash, data []byte) error {
 	self.assetData = data
 	self.data.AssetHash = hash[:]
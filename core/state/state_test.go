@@ -21,14 +21,14 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/crypto"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	checker "gopkg.in/check.v1"
 )
 
 type StateSuite struct {
-	db    *emdb.MemDatabase
+	db    *aoadb.MemDatabase
 	state *StateDB
 }
 
@@ -53,7 +53,7 @@ func (s *StateSuite) TestDump(c *checker.C) {
 	// check that dump contains the state objects that are in trie
 	got := string(s.state.Dump())
 	want := `{
-    "root": "71edff0130dd2385947095001c73d9e28d862fc286fca2b922ca6f6f3cddfdd2",
+    "root": "b0d2ab16f1c6dfcf8075d436a30e694e411b624ed184ed593de6745d93ff3e16",
     "accounts": {
         "0000000000000000000000000000000000000001": {
             "balance": "22",
@@ -87,7 +87,7 @@ func (s *StateSuite) TestDump(c *checker.C) {
 }
 
 func (s *StateSuite) SetUpTest(c *checker.C) {
-	s.db, _ = emdb.NewMemDatabase()
+	s.db, _ = aoadb.NewMemDatabase()
 	s.state, _ = New(common.Hash{}, NewDatabase(s.db))
 }
 
@@ -133,7 +133,7 @@ func (s *StateSuite) TestSnapshotEmpty(c *checker.C) {
 // use testing instead of checker because checker does not support
 // printing/logging in tests (-check.vv does not work)
 func TestSnapshot2(t *testing.T) {
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 	state, _ := New(common.Hash{}, NewDatabase(db))
 
 	stateobjaddr0 := toAddr([]byte("so0"))
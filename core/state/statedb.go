@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math/big"
 	"sort"
+	"strings"
 	"sync"
 
 	"bytes"
@@ -400,6 +401,15 @@ func (self *StateDB) SetState(addr common.Address, key common.Hash, value common
 	}
 }
 
+// SetStorage replaces the entire storage of an account. The change is not
+// journaled, it can't be reverted to a snapshot.
+func (self *StateDB) SetStorage(addr common.Address, storage map[common.Hash]common.Hash) {
+	stateObject := self.GetOrNewStateObject(addr)
+	if stateObject != nil {
+		stateObject.SetStorage(self.db, storage)
+	}
+}
+
 // Suicide marks the given account as suicided.
 // This clears the account balance.
 //
@@ -541,6 +551,45 @@ func (db *StateDB) ForEachStorage(addr common.Address, cb func(key, value common
 	}
 }
 
+// ForEachVoteList calls cb with the vote list of every account that votes, in
+// ascending address order. Accounts which are only in the trie are found by
+// the preimage of their key, it fails if one is missing.
+func (db *StateDB) ForEachVoteList(cb func(voter common.Address, voteList []common.Address)) error {
+	voteLists := make(map[common.Address][]common.Address)
+	for addr, so := range db.stateObjects {
+		if !so.deleted && !so.suicided && len(so.data.VoteList) > 0 {
+			voteLists[addr] = so.data.VoteList
+		}
+	}
+	it := trie.NewIterator(db.trie.NodeIterator(nil))
+	for it.Next() {
+		key := db.trie.GetKey(it.Key)
+		if key == nil {
+			return fmt.Errorf("missing preimage of account key %x", it.Key)
+		}
+		addr := common.BytesToAddress(key)
+		if _, ok := db.stateObjects[addr]; ok {
+			continue
+		}
+		var data Account
+		if err := rlp.DecodeBytes(it.Value, &data); err != nil {
+			return err
+		}
+		if len(data.VoteList) > 0 {
+			voteLists[addr] = data.VoteList
+		}
+	}
+	voters := make([]common.Address, 0, len(voteLists))
+	for addr := range voteLists {
+		voters = append(voters, addr)
+	}
+	sort.Slice(voters, func(i, j int) bool { return bytes.Compare(voters[i][:], voters[j][:]) < 0 })
+	for _, voter := range voters {
+		cb(voter, voteLists[voter])
+	}
+	return nil
+}
+
 // Copy creates a deep, independent copy of the state.
 // Snapshots of the copied state cannot be applied to the copy.
 func (self *StateDB) Copy() *StateDB {
@@ -741,3 +790,163 @@ func (self *StateDB) GetAssetInfo(addr common.Address) (*types.AssetInfo, error)
 	}
 	return nil, fmt.Errorf("%s is not an asset account", addr.String())
 }
+
+// UpdateAssetInfo replaces the published information of an asset.
+func (self *StateDB) UpdateAssetInfo(asset common.Address, assetInfo types.AssetInfo) error {
+	stateObject := self.getStateObject(asset)
+	if stateObject == nil || !stateObject.IsAssetAccount() {
+		return fmt.Errorf("%s is not an asset account", asset.String())
+	}
+	data, err := types.AssetInfoToBytes(assetInfo)
+	if nil != err {
+		return err
+	}
+	return stateObject.UpdateAssetData(self.db, crypto.Keccak256Hash(data), data)
+}
+
+// Storage slots of an asset account holding the supply controls and the fee
+// pool rate of the asset.
+var (
+	assetFlagsKey   = common.BytesToHash([]byte("flags"))
+	assetMintedKey  = common.BytesToHash([]byte("minted"))
+	assetBurnedKey  = common.BytesToHash([]byte("burned"))
+	assetFeeRateKey = common.BytesToHash([]byte("feeRate"))
+)
+
+// SetAssetFlags records the control flags of an asset.
+func (self *StateDB) SetAssetFlags(asset common.Address, flags uint64) {
+	self.SetState(asset, assetFlagsKey, common.BigToHash(new(big.Int).SetUint64(flags)))
+}
+
+// GetAssetFlags returns the control flags of an asset.
+func (self *StateDB) GetAssetFlags(asset common.Address) uint64 {
+	return self.GetState(asset, assetFlagsKey).Big().Uint64()
+}
+
+// MintAsset issues amount new units of asset to the given account.
+func (self *StateDB) MintAsset(asset, to common.Address, amount *big.Int) {
+	minted := self.GetState(asset, assetMintedKey).Big()
+	self.SetState(asset, assetMintedKey, common.BigToHash(minted.Add(minted, amount)))
+	self.AddAssetBalance(to, asset, amount)
+}
+
+// BurnAsset destroys amount units of asset held by the given account. It
+// returns false if the account does not hold enough of the asset.
+func (self *StateDB) BurnAsset(asset, from common.Address, amount *big.Int) bool {
+	if !self.SubAssetBalance(from, asset, amount) {
+		return false
+	}
+	burned := self.GetState(asset, assetBurnedKey).Big()
+	self.SetState(asset, assetBurnedKey, common.BigToHash(burned.Add(burned, amount)))
+	return true
+}
+
+// GetAssetSupply returns the circulating supply of an asset, that is the
+// published supply plus everything minted minus everything burned since.
+func (self *StateDB) GetAssetSupply(asset common.Address) (*big.Int, error) {
+	ai, err := self.GetAssetInfo(asset)
+	if err != nil {
+		return nil, err
+	}
+	supply := new(big.Int).Set(ai.Supply)
+	supply.Add(supply, self.GetState(asset, assetMintedKey).Big())
+	return supply.Sub(supply, self.GetState(asset, assetBurnedKey).Big()), nil
+}
+
+// SetAssetFeeRate records the exchange rate of the fee pool of an asset, the
+// units of the asset charged per AOA of gas. The pool itself is the AOA
+// balance of the asset account, a zero rate disables it.
+func (self *StateDB) SetAssetFeeRate(asset common.Address, rate *big.Int) {
+	self.SetState(asset, assetFeeRateKey, common.BigToHash(rate))
+}
+
+// GetAssetFeeRate returns the exchange rate of the fee pool of an asset.
+func (self *StateDB) GetAssetFeeRate(asset common.Address) *big.Int {
+	return self.GetState(asset, assetFeeRateKey).Big()
+}
+
+// assetAccountKey returns the storage slot of an asset account holding the
+// status of the given holder.
+func assetAccountKey(account common.Address) common.Hash {
+	return crypto.Keccak256Hash(account.Bytes(), []byte("status"))
+}
+
+// SetAssetAccountStatus records the status flags of a holder of an asset.
+func (self *StateDB) SetAssetAccountStatus(asset, account common.Address, status uint64) {
+	self.SetState(asset, assetAccountKey(account), common.BigToHash(new(big.Int).SetUint64(status)))
+}
+
+// GetAssetAccountStatus returns the status flags of a holder of an asset.
+func (self *StateDB) GetAssetAccountStatus(asset, account common.Address) uint64 {
+	return self.GetState(asset, assetAccountKey(account)).Big().Uint64()
+}
+
+// assetAllowanceKey returns the storage slot of an asset account holding the
+// amount spender may still take from owner.
+func assetAllowanceKey(owner, spender common.Address) common.Hash {
+	return crypto.Keccak256Hash(owner.Bytes(), spender.Bytes(), []byte("allowance"))
+}
+
+// SetAssetAllowance records the amount of an asset spender may take from owner.
+func (self *StateDB) SetAssetAllowance(asset, owner, spender common.Address, amount *big.Int) {
+	self.SetState(asset, assetAllowanceKey(owner, spender), common.BigToHash(amount))
+}
+
+// GetAssetAllowance returns the amount of an asset spender may take from owner.
+func (self *StateDB) GetAssetAllowance(asset, owner, spender common.Address) *big.Int {
+	return self.GetState(asset, assetAllowanceKey(owner, spender)).Big()
+}
+
+// IsAssetTransferAllowed reports whether the freeze and whitelist controls of
+// an asset allow moving it from one account to another. The issuer is exempt
+// from the controls of its own asset.
+func (self *StateDB) IsAssetTransferAllowed(asset, from, to common.Address) bool {
+	flags := self.GetAssetFlags(asset)
+	if flags&(types.AssetFlagFreezable|types.AssetFlagWhitelist) == 0 {
+		return true
+	}
+	var issuer common.Address
+	if ai, err := self.GetAssetInfo(asset); err == nil && ai.Issuer != nil {
+		issuer = *ai.Issuer
+	}
+	if flags&types.AssetFlagFrozen != 0 && from != issuer {
+		return false
+	}
+	for _, account := range []common.Address{from, to} {
+		if account == issuer {
+			continue
+		}
+		status := self.GetAssetAccountStatus(asset, account)
+		if status&types.AssetAccountFrozen != 0 {
+			return false
+		}
+		if flags&types.AssetFlagWhitelist != 0 && status&types.AssetAccountWhitelisted == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// assetRegistry is the system account whose storage maps the upper-cased
+// symbols of the assets to their addresses.
+var assetRegistry = common.BytesToAddress([]byte("assetRegistry"))
+
+func assetSymbolKey(symbol string) common.Hash {
+	return crypto.Keccak256Hash([]byte(strings.ToUpper(symbol)))
+}
+
+// RegisterAssetSymbol records asset as the owner of the given symbol.
+func (self *StateDB) RegisterAssetSymbol(symbol string, asset common.Address) {
+	// keep the registry from being swept away as an empty account
+	if self.GetNonce(assetRegistry) == 0 {
+		self.SetNonce(assetRegistry, 1)
+	}
+	self.SetState(assetRegistry, assetSymbolKey(symbol), asset.Hash())
+}
+
+// GetAssetBySymbol returns the address of the asset registered under the
+// given symbol, matched case-insensitively, or the zero address if the symbol
+// is free.
+func (self *StateDB) GetAssetBySymbol(symbol string) common.Address {
+	return common.BytesToAddress(self.GetState(assetRegistry, assetSymbolKey(symbol)).Bytes())
+}
@@ -30,16 +30,16 @@ import (
 
 	check "gopkg.in/check.v1"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core/types"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 )
 
 // Tests that updating a state trie does not leak any database writes prior to
 // actually committing the state.
 func TestUpdateLeaks(t *testing.T) {
 	// Create an empty state database
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 	state, _ := New(common.Hash{}, NewDatabase(db))
 
 	// Update it with some accounts
@@ -66,8 +66,8 @@ func TestUpdateLeaks(t *testing.T) {
 // only the one right before the commit.
 func TestIntermediateLeaks(t *testing.T) {
 	// Create two state databases, one transitioning to the final state, the other final from the beginning
-	transDb, _ := emdb.NewMemDatabase()
-	finalDb, _ := emdb.NewMemDatabase()
+	transDb, _ := aoadb.NewMemDatabase()
+	finalDb, _ := aoadb.NewMemDatabase()
 	transState, _ := New(common.Hash{}, NewDatabase(transDb))
 	finalState, _ := New(common.Hash{}, NewDatabase(finalDb))
 
@@ -118,7 +118,7 @@ func TestIntermediateLeaks(t *testing.T) {
 }
 
 func TestStateDB_AddBalance(t *testing.T) {
-	mem, _ := emdb.NewMemDatabase()
+	mem, _ := aoadb.NewMemDatabase()
 	stateDb, _ := New(common.Hash{}, NewDatabase(mem))
 	address1 := common.Address{1}
 	root1 := stateDb.IntermediateRoot(false)
@@ -150,7 +150,7 @@ func TestStateDB_AddBalance(t *testing.T) {
 // https://github.com/Dacchain/go-Dacchain/pull/15549.
 func TestCopy(t *testing.T) {
 	// Create a random state test to copy and modify "independently"
-	mem, _ := emdb.NewMemDatabase()
+	mem, _ := aoadb.NewMemDatabase()
 	orig, _ := New(common.Hash{}, NewDatabase(mem))
 
 	for i := byte(0); i < 255; i++ {
@@ -362,7 +362,7 @@ func (test *snapshotTest) String() string {
 func (test *snapshotTest) run() bool {
 	// Run all actions and create snapshots.
 	var (
-		db, _        = emdb.NewMemDatabase()
+		db, _        = aoadb.NewMemDatabase()
 		state, _     = New(common.Hash{}, NewDatabase(db))
 		snapshotRevs = make([]int, len(test.snapshots))
 		sindex       = 0
@@ -0,0 +1,60 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package state
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+)
+
+func TestSetStorage(t *testing.T) {
+	var (
+		addr         = common.HexToAddress("0xc0de")
+		stale, fresh = common.HexToHash("0x01"), common.HexToHash("0x02")
+		value        = common.HexToHash("0xff")
+	)
+	db, _ := aoadb.NewMemDatabase()
+	state, _ := New(common.Hash{}, NewDatabase(db))
+	state.SetBalance(addr, big.NewInt(1))
+	state.SetState(addr, stale, value)
+	root, err := state.CommitTo(db, false)
+	if err != nil {
+		t.Fatalf("failed to commit state: %v", err)
+	}
+	// the committed slot is gone once the storage is replaced
+	state, _ = New(root, NewDatabase(db))
+	state.SetStorage(addr, map[common.Hash]common.Hash{fresh: value})
+	if have := state.GetState(addr, stale); have != (common.Hash{}) {
+		t.Errorf("replaced slot mismatch: have %x, want empty", have)
+	}
+	if have := state.GetState(addr, fresh); have != value {
+		t.Errorf("new slot mismatch: have %x, want %x", have, value)
+	}
+	if balance := state.GetBalance(addr); balance.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("balance mismatch: have %v, want 1", balance)
+	}
+	// and the account hashes as if it only ever held the new storage
+	want, _ := New(common.Hash{}, NewDatabase(db))
+	want.SetBalance(addr, big.NewInt(1))
+	want.SetState(addr, fresh, value)
+	if have, want := state.IntermediateRoot(false), want.IntermediateRoot(false); have != want {
+		t.Errorf("root mismatch: have %x, want %x", have, want)
+	}
+}
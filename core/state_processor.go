@@ -20,6 +20,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/consensus"
 	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
+	"github.com/Aurorachain-io/go-aoa/consensus/dpos"
 	"github.com/Aurorachain-io/go-aoa/core/state"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
@@ -56,6 +57,8 @@ func NewStateProcessor(config *params.ChainConfig, bc *BlockChain, engine consen
 // Process returns the receipts and logs accumulated during the process and
 // returns the amount of gas that was used in the process. If any of the
 // transactions failed to execute due to insufficient gas it will return an error.
+// When internal transactions are watched the logs include the synthetic asset
+// transfer logs of the block, so log subscriptions see them as well.
 func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg vm.Config, db *delegatestate.DelegateDB) (types.Receipts, []*types.Log, uint64, error) {
 	// TODO ADD vote changes to delegate state
 	var (
@@ -81,8 +84,13 @@ func (p *StateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg
 		receipts = append(receipts, receipt)
 		allLogs = append(allLogs, receipt.Logs...)
 	}
+	if cfg.WatchInnerTx {
+		allLogs = append(allLogs, p.bc.indexTransferLogs(block.Hash(), block.NumberU64(), receipts)...)
+	}
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
-	p.engine.Finalize(p.bc, header, statedb, db, block.Transactions(), receipts)
+	if _, err := p.engine.Finalize(p.bc, header, statedb, db, block.Transactions(), receipts); err != nil {
+		return nil, nil, 0, err
+	}
 
 	return receipts, allLogs, *usedGas, nil
 }
@@ -98,16 +106,19 @@ func ApplyTransaction(config *params.ChainConfig, bc *BlockChain, author *common
 	}
 	// Create a new context to be used in the EVM environment
 	context := NewEVMContext(msg, header, bc, author)
+	// the DPoS precompile reads the delegate state as changed by the block so far
+	context.Delegates = db
 	// Create a new environment which holds all relevant information
 	// about the transaction and calling mechanisms.
 	vmenv := vm.NewEVM(context, statedb, config, cfg)
 	vmenv.WatchInnerTx = watchInnerTx
 	// Apply the transaction to the current state (included in the env)
-	_, gas, failed, err := ApplyMessage(vmenv, msg, gp)
+	st := NewStateTransition(vmenv, msg, gp)
+	_, gas, failed, err := st.TransitionDb()
 	if err != nil {
 		return nil, 0, err
 	}
-	err = voteChangeToDelegateState(msg.From(), tx, statedb, db, blockTime, header.Number.Int64())
+	err = voteChangeToDelegateState(config, msg.From(), tx, statedb, db, blockTime, header.Number.Int64())
 	// log.Debug("applyTransaction|voteChangeToDelegateState cost", "timestamp", time.Now().Sub(now4))
 	if err != nil {
 		return nil, 0, err
@@ -121,6 +132,7 @@ func ApplyTransaction(config *params.ChainConfig, bc *BlockChain, author *common
 	receipt.Action = tx.TxDataAction()
 	receipt.TxHash = tx.Hash()
 	receipt.GasUsed = gas
+	receipt.FeeAsset, receipt.FeeAmount = st.FeePayment()
 	// if the transaction created a contract, store the creation address in the receipt.
 	if msg.Action() == types.ActionCreateContract || msg.Action() == types.ActionPublishAsset {
 		receipt.ContractAddress = crypto.CreateAddress(vmenv.Context.Origin, tx.Nonce())
@@ -142,10 +154,10 @@ func ApplyTransaction(config *params.ChainConfig, bc *BlockChain, author *common
 }
 
 // trx vote change to delegate state
-func voteChangeToDelegateState(from common.Address, tx *types.Transaction, statedb *state.StateDB, db *delegatestate.DelegateDB, blockTime uint64, blockNumber int64) error {
+func voteChangeToDelegateState(config *params.ChainConfig, from common.Address, tx *types.Transaction, statedb *state.StateDB, db *delegatestate.DelegateDB, blockTime uint64, blockNumber int64) error {
 	// beginDelegateRoot := db.IntermediateRoot(false)
 	address := strings.ToLower(from.Hex())
-	candidates, err := CountTrxVote(address, tx, statedb, db,blockNumber)
+	candidates, err := CountTrxVote(config, address, tx, statedb, db, blockNumber)
 
 	//if len(candidates) > 0 {
 	//	log.Info("voteChangeToDelegateState|", "blockNumber", blockNumber, "beginDelegateRoot", len(candidates), "err", err)
@@ -156,15 +168,43 @@ func voteChangeToDelegateState(from common.Address, tx *types.Transaction, state
 	if err != nil {
 		return err
 	}
+	rewardShare := config.IsRewardShare(big.NewInt(blockNumber))
+	if rewardShare && tx.TxDataAction() == types.ActionClaimReward {
+		claimVoterRewards(statedb, db, from)
+	}
 	if len(candidates) == 0 {
 		return nil
 	}
 	for _, v := range candidates {
-		err := voteCount(db, v, blockTime)
+		// settle the rewards of the vote before it changes, a new vote starts
+		// without any claim on earlier rewards
+		if rewardShare && (v.Action == addVote || v.Action == subVote) {
+			pending := db.SettleVoterReward(common.HexToAddress(v.Address), from)
+			if v.Action == subVote {
+				statedb.AddBalance(from, pending)
+			}
+		}
+		// the voters of a removed delegate are paid out and unlocked first
+		switch address := common.HexToAddress(v.Address); {
+		case v.Action == slash && db.Exist(address):
+			slashDelegate(config, statedb, db, address, from, blockNumber)
+		case v.Action == cancel && db.Exist(address):
+			dpos.ReleaseVoters(statedb, db, address)
+		}
+		err := voteCount(config, db, v, blockTime, blockNumber)
 		if err != nil {
 			log.Error("voteChangeToDelegateState|fail", "err", err)
 			return err
 		}
+		// index the voters so that their lock can be released when the delegate leaves
+		if config.IsUnregister(big.NewInt(blockNumber)) {
+			switch v.Action {
+			case addVote:
+				db.AddVoter(common.HexToAddress(v.Address), from)
+			case subVote:
+				db.RemoveVoter(common.HexToAddress(v.Address), from)
+			}
+		}
 	}
 	//db.Finalise(false)
 	//endDelegateRoot := db.IntermediateRoot(false)
@@ -172,7 +212,37 @@ func voteChangeToDelegateState(from common.Address, tx *types.Transaction, state
 	return err
 }
 
-func voteCount(db *delegatestate.DelegateDB, v types.VoteCandidate, blockTime uint64) error {
+// slashDelegate takes the registration deposit of a double signing delegate:
+// the deposit held back if it is leaving, its balance otherwise. The reporter
+// receives 1/SlashReporterRewardDivisor of it and the rest is burned. The
+// voters of the delegate are released before it is removed.
+func slashDelegate(config *params.ChainConfig, statedb *state.StateDB, db *delegatestate.DelegateDB, offender common.Address, reporter common.Address, blockNumber int64) {
+	var deposit *big.Int
+	if db.IsLeaving(offender) {
+		deposit = db.GetDeposit(offender)
+		db.SetDeposit(offender, common.Big0)
+		db.Unregister(offender, 0)
+	} else {
+		deposit = config.RegisterCost(big.NewInt(blockNumber))
+		if balance := statedb.GetBalance(offender); balance.Cmp(deposit) < 0 {
+			deposit.Set(balance)
+		}
+		statedb.SubBalance(offender, deposit)
+	}
+	reward := new(big.Int).Div(deposit, big.NewInt(params.SlashReporterRewardDivisor))
+	statedb.AddBalance(reporter, reward)
+	voters := dpos.ReleaseVoters(statedb, db, offender)
+	log.Info("Slash double sign delegate", "offender", offender.Hex(), "reporter", reporter.Hex(), "deposit", deposit, "reward", reward, "voters", voters)
+}
+
+// claimVoterRewards pays out the pending rewards of all delegates the voter votes for.
+func claimVoterRewards(statedb *state.StateDB, db *delegatestate.DelegateDB, voter common.Address) {
+	for _, delegate := range statedb.GetVoteList(voter) {
+		statedb.AddBalance(voter, db.SettleVoterReward(delegate, voter))
+	}
+}
+
+func voteCount(config *params.ChainConfig, db *delegatestate.DelegateDB, v types.VoteCandidate, blockTime uint64, blockNumber int64) error {
 	address := common.HexToAddress(v.Address)
 	switch v.Action {
 	case register:
@@ -183,7 +253,7 @@ func voteCount(db *delegatestate.DelegateDB, v types.VoteCandidate, blockTime ui
 		}
 		db.GetOrNewStateObject(address, v.Nickname, blockTime)
 	case addVote:
-		if !db.Exist(address) {
+		if !db.Exist(address) || db.IsLeaving(address) {
 			// return errors.New(fmt.Sprintf("delegate not exist when add vote address:%s", address.Hex()))
 			return ErrAddVote
 		}
@@ -206,6 +276,42 @@ func voteCount(db *delegatestate.DelegateDB, v types.VoteCandidate, blockTime ui
 			return ErrCancelAgent
 		}
 		db.Suicide(address)
+	case unjail:
+		if !db.Exist(address) {
+			return ErrUnjailAgent
+		}
+		if !db.IsJailed(address) {
+			return ErrNotJailed
+		}
+		db.Unjail(address)
+	case unregister:
+		if !db.Exist(address) {
+			return ErrUnregisterAgent
+		}
+		if db.IsLeaving(address) {
+			return ErrAlreadyLeaving
+		}
+		db.Unregister(address, blockTime+config.UnbondingDuration())
+		// the state transition took the deposit at the current register cost
+		db.SetDeposit(address, config.RegisterCost(big.NewInt(blockNumber)))
+	case setCommission:
+		if !db.Exist(address) {
+			return ErrCommissionAgent
+		}
+		// raises are limited so that voters can leave before most of the
+		// rewards go to the delegate
+		if current := db.GetCommission(address); v.Vote > current {
+			if v.Vote-current > params.MaxCommissionIncrease || blockTime < db.GetCommissionRaised(address)+params.CommissionCooldown {
+				return ErrCommissionRaise
+			}
+			db.SetCommissionRaised(address, blockTime)
+		}
+		db.SetCommission(address, v.Vote)
+	case slash:
+		if !db.Exist(address) {
+			return ErrSlashAgent
+		}
+		db.Suicide(address)
 	}
 	return nil
 }
@@ -24,12 +24,17 @@ import (
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
+	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/log"
 	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
 )
 
 var (
 	errInsufficientBalanceForGas = errors.New("insufficient balance to pay for gas")
+
+	// feeRateDenominator is the amount of AOA the rate of a fee pool is quoted for.
+	feeRateDenominator = big.NewInt(params.Em)
 )
 
 /*
@@ -59,6 +64,13 @@ type StateTransition struct {
 	data       []byte
 	state      vm.StateDB
 	evm        *vm.EVM
+
+	// gas paid from the fee pool of an asset, feeAsset is nil for gas paid in AOA
+	feeAsset   *common.Address
+	feeIssuer  common.Address
+	feeRate    *big.Int
+	feePaid    *big.Int
+	feeCharged *big.Int
 }
 
 // Message represents a message sent to a contract.
@@ -80,6 +92,7 @@ type Message interface {
 	AssetInfo() types.AssetInfo
 	SubAddress() string
 	Abi() string
+	MaxFeeRate() *big.Int
 }
 
 // IntrinsicGas computes the 'intrinsic gas' for a message with the given data.
@@ -99,6 +112,23 @@ func IntrinsicGas(data []byte, action uint64) (uint64, error) {
 		gas = params.TxGasContractCreation
 	case types.ActionCallContract:
 		gas = params.TxGas
+	case types.ActionUnjail, types.ActionSlash, types.ActionUnregister, types.ActionSetCommission, types.ActionClaimReward:
+		gas = params.TxGas
+	case types.ActionMintAsset, types.ActionBurnAsset:
+		gas = params.TxGas
+	case types.ActionUpdateAsset:
+		gas = params.TxGasAssetUpdate
+	case types.ActionFreezeAsset, types.ActionWhitelistAsset:
+		gas = params.TxGas
+	case types.ActionSetFeePool:
+		gas = params.TxGas
+	case types.ActionBatchTransfer:
+		// every recipient is paid for on top of the transaction, a malformed
+		// payload fails on execution
+		n, _ := types.CountBatchTransfers(data)
+		gas = params.TxGas + uint64(n)*params.TxGasBatchTransfer
+	case types.ActionApproveAsset:
+		gas = params.TxGas
 	}
 
 	// Bump the required gas by the amount of transactional data
@@ -188,6 +218,13 @@ func (st *StateTransition) buyGas() error {
 		sender = st.from()
 	)
 	mgval := new(big.Int).Mul(new(big.Int).SetUint64(st.msg.Gas()), st.gasPrice)
+	rate, err := feePoolRate(st.evm.ChainConfig(), st.evm.BlockNumber, state, st.msg.Action(), st.msg.Asset(), st.msg.MaxFeeRate(), st.msg.Gas(), st.gasPrice)
+	if err != nil {
+		return err
+	}
+	if rate != nil {
+		return st.buyAssetGas(*st.msg.Asset(), rate, mgval)
+	}
 	if state.GetBalance(sender.Address()).Cmp(mgval) < 0 {
 		return errInsufficientBalanceForGas
 	}
@@ -201,9 +238,106 @@ func (st *StateTransition) buyGas() error {
 	return nil
 }
 
+// buyAssetGas prepays the gas of the message from the fee pool of the asset,
+// taking its equivalent in the asset from the sender.
+func (st *StateTransition) buyAssetGas(asset common.Address, rate, mgval *big.Int) error {
+	sender := st.msg.From()
+	ai, err := st.state.GetAssetInfo(asset)
+	if err != nil {
+		return err
+	}
+	if ai.Issuer == nil {
+		return ErrAssetNotIssuer
+	}
+	fee := assetFee(mgval, rate)
+	if st.state.GetAssetBalance(sender, asset).Cmp(fee) < 0 {
+		return errInsufficientBalanceForGas
+	}
+	if !st.state.IsAssetTransferAllowed(asset, sender, *ai.Issuer) {
+		return ErrAssetTransferRestricted
+	}
+	if err := st.gp.SubGas(st.msg.Gas()); err != nil {
+		return err
+	}
+	st.gas += st.msg.Gas()
+
+	st.initialGas = st.msg.Gas()
+	st.state.SubAssetBalance(sender, asset, fee)
+	st.state.SubBalance(asset, mgval)
+	st.feeAsset, st.feeIssuer, st.feeRate, st.feePaid = &asset, *ai.Issuer, rate, fee
+	return nil
+}
+
+// feePoolRate returns the exchange rate of the fee pool paying the gas of a
+// transaction, or nil if the sender pays the gas in AOA. A transaction opts into
+// the fee pool of the asset it names by setting the highest rate it accepts, it
+// fails if the pool is disabled, charges more or can't cover the gas limit.
+func feePoolRate(config *params.ChainConfig, num *big.Int, statedb vm.StateDB, action uint64, asset *common.Address, maxRate *big.Int, gas uint64, gasPrice *big.Int) (*big.Int, error) {
+	if maxRate == nil {
+		return nil, nil
+	}
+	if !config.IsAssetFeePool(num) || asset == nil || *asset == (common.Address{}) || action == types.ActionSetFeePool {
+		return nil, ErrFeePoolUnavailable
+	}
+	rate := statedb.GetAssetFeeRate(*asset)
+	if rate.Sign() <= 0 {
+		return nil, ErrFeePoolUnavailable
+	}
+	if rate.Cmp(maxRate) > 0 {
+		return nil, ErrFeePoolRate
+	}
+	mgval := new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice)
+	if statedb.GetBalance(*asset).Cmp(mgval) < 0 {
+		return nil, ErrFeePoolFunds
+	}
+	return rate, nil
+}
+
+// assetFee converts a gas cost in AOA into units of an asset at the given fee
+// pool rate, rounding up.
+func assetFee(cost, rate *big.Int) *big.Int {
+	fee := new(big.Int).Mul(cost, rate)
+	fee.Add(fee, new(big.Int).Sub(feeRateDenominator, common.Big1))
+	return fee.Div(fee, feeRateDenominator)
+}
+
+// FeePayment returns the asset the gas of the message was paid in and the
+// amount of it charged, or nil if the gas was paid in AOA.
+func (st *StateTransition) FeePayment() (*common.Address, *big.Int) {
+	return st.feeAsset, st.feeCharged
+}
+
+// IsActionSupported reports whether the transaction action is enabled at the given block.
+func IsActionSupported(config *params.ChainConfig, num *big.Int, action uint64) bool {
+	switch {
+	case action <= types.ActionCallContract:
+		return true
+	case action == types.ActionUnjail:
+		return config.IsJail(num)
+	case action == types.ActionSlash:
+		return config.IsSlash(num)
+	case action == types.ActionUnregister:
+		return config.IsUnregister(num)
+	case action == types.ActionSetCommission, action == types.ActionClaimReward:
+		return config.IsRewardShare(num)
+	case action == types.ActionMintAsset, action == types.ActionBurnAsset:
+		return config.IsAssetSupply(num)
+	case action == types.ActionUpdateAsset:
+		return config.IsAssetMetadata(num)
+	case action == types.ActionFreezeAsset, action == types.ActionWhitelistAsset:
+		return config.IsAssetControl(num)
+	case action == types.ActionSetFeePool:
+		return config.IsAssetFeePool(num)
+	case action == types.ActionBatchTransfer:
+		return config.IsBatchTransfer(num)
+	case action == types.ActionApproveAsset:
+		return config.IsAssetAllowance(num)
+	}
+	return false
+}
+
 func (st *StateTransition) preCheck() error {
-	// ActionCallContract is the largest action number
-	if st.msg.Action() > types.ActionCallContract {
+	if !IsActionSupported(st.evm.ChainConfig(), st.evm.BlockNumber, st.msg.Action()) {
 		return fmt.Errorf("Illegal action: %d", st.msg.Action())
 	}
 
@@ -256,9 +390,8 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, failed bo
 
 	switch msg.Action() {
 	case types.ActionRegister:
-		registerCost := new(big.Int)
-		registerCost.SetString(params.TxGasAgentCreation, 10)
-		if !evm.Context.CanTransfer(evm.StateDB, msg.From(), nil, registerCost) {
+		registerCost := evm.ChainConfig().RegisterCost(evm.BlockNumber)
+		if !evm.Context.CanTransfer(evm.StateDB, msg.From(), common.Address{}, nil, registerCost) {
 			evm.StateDB.RevertToSnapshot(snapshot)
 			return nil, 0, true, vm.ErrInsufficientBalance
 		}
@@ -266,6 +399,79 @@ func (st *StateTransition) TransitionDb() (ret []byte, usedGas uint64, failed bo
 			evm.StateDB.RevertToSnapshot(snapshot)
 			return nil, 0, true, errors.New("Address " + msg.From().Hex() + " have already register delegate")
 		}
+	case types.ActionUnjail:
+		if _, ok := (*evm.DelegateList)[msg.From()]; !ok {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, ErrUnjailAgent
+		}
+	case types.ActionUnregister:
+		// the deposit is held back until the unbonding period is over
+		if _, ok := (*evm.DelegateList)[msg.From()]; !ok {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, ErrUnregisterAgent
+		}
+		deposit := evm.ChainConfig().RegisterCost(evm.BlockNumber)
+		if !evm.Context.CanTransfer(evm.StateDB, msg.From(), common.Address{}, nil, deposit) {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, vm.ErrInsufficientBalance
+		}
+		evm.StateDB.SubBalance(msg.From(), deposit)
+	case types.ActionSetCommission:
+		if _, ok := (*evm.DelegateList)[msg.From()]; !ok {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, ErrCommissionAgent
+		}
+		if len(st.data) > 8 || new(big.Int).SetBytes(st.data).Uint64() > params.CommissionDenominator {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, ErrInvalidCommission
+		}
+	case types.ActionClaimReward:
+		// the rewards are paid out when the delegate state changes are counted
+	case types.ActionMintAsset:
+		if err = st.mintAsset(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionBurnAsset:
+		if err = st.burnAsset(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionUpdateAsset:
+		if err = st.updateAsset(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionFreezeAsset:
+		if err = st.freezeAsset(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionWhitelistAsset:
+		if err = st.whitelistAsset(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionSetFeePool:
+		if err = st.setFeePool(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionBatchTransfer:
+		if err = st.batchTransfer(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionApproveAsset:
+		if err = st.approveAsset(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
+	case types.ActionSlash:
+		if err = st.slash(); err != nil {
+			evm.StateDB.RevertToSnapshot(snapshot)
+			return nil, 0, true, err
+		}
 	case types.ActionAddVote, types.ActionSubVote:
 		if len(msg.Vote()) == 0 {
 			evm.StateDB.RevertToSnapshot(snapshot)
@@ -326,7 +532,21 @@ func (st *StateTransition) refundGas() {
 	sender := st.from()
 
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(st.gas), st.gasPrice)
-	st.state.AddBalance(sender.Address(), remaining)
+	if st.feeAsset != nil {
+		// The unused gas goes back to the fee pool, the sender is charged in
+		// the asset for the used gas only and the issuer receives the charge.
+		asset := *st.feeAsset
+		st.state.AddBalance(asset, remaining)
+		st.feeCharged = assetFee(new(big.Int).Mul(new(big.Int).SetUint64(st.gasUsed()), st.gasPrice), st.feeRate)
+		if refund := new(big.Int).Sub(st.feePaid, st.feeCharged); refund.Sign() > 0 {
+			st.state.AddAssetBalance(sender.Address(), asset, refund)
+		}
+		if st.feeCharged.Sign() > 0 {
+			st.state.AddAssetBalance(st.feeIssuer, asset, st.feeCharged)
+		}
+	} else {
+		st.state.AddBalance(sender.Address(), remaining)
+	}
 
 	// Also return remaining gas to the block gas counter so it is
 	// available for the next transaction.
@@ -339,6 +559,295 @@ func (st *StateTransition) gasUsed() uint64 {
 }
 
 func (st *StateTransition) publishAsset() error {
-	ai := st.msg.AssetInfo()
-	return st.state.PublishAsset(st.from().Address(), ai)
+	var (
+		config = st.evm.ChainConfig()
+		num    = st.evm.BlockNumber
+		ai     = st.msg.AssetInfo()
+		from   = st.from().Address()
+	)
+	// drop the fields introduced by forks that are not active yet
+	if config.IsAssetSupply(num) {
+		if err := types.IsSupplyValid(&ai); err != nil {
+			return err
+		}
+	} else {
+		ai.MaxSupply, ai.FixedSupply = nil, false
+	}
+	if config.IsAssetMetadata(num) {
+		if err := types.IsMetadataValid(&ai); err != nil {
+			return err
+		}
+	} else {
+		ai.Decimals, ai.Icon, ai.URL = nil, "", ""
+	}
+	if !config.IsAssetControl(num) {
+		ai.Freezable, ai.Whitelist = false, false
+	}
+	if config.IsAssetRegistry(num) && st.state.GetAssetBySymbol(ai.Symbol) != (common.Address{}) {
+		return ErrAssetSymbolTaken
+	}
+	// the asset id is derived from the nonce before publishing bumps it
+	id := crypto.CreateAddress(from, st.state.GetNonce(from))
+	if err := st.state.PublishAsset(from, ai); err != nil {
+		return err
+	}
+	if config.IsAssetSupply(num) || config.IsAssetControl(num) {
+		// like contracts since EIP-161, start the nonce at 1 so the asset account
+		// is not swept away as empty at the end of the transaction
+		st.state.SetNonce(id, 1)
+		flags := ai.Flags()
+		if !config.IsAssetSupply(num) {
+			flags &^= types.AssetFlagMintable
+		}
+		st.state.SetAssetFlags(id, flags)
+	}
+	if config.IsAssetRegistry(num) {
+		st.state.RegisterAssetSymbol(ai.Symbol, id)
+	}
+	return nil
+}
+
+// mintAsset issues new units of an asset to the recipient. Only the issuer of
+// an asset published with a mintable supply may mint, and never beyond the
+// maximum supply if one was set.
+func (st *StateTransition) mintAsset() error {
+	msg := st.msg
+	if msg.To() == nil {
+		return errors.New("mint asset without recipient")
+	}
+	if msg.Value() == nil || msg.Value().Sign() <= 0 {
+		return ErrAssetAmount
+	}
+	asset, ai, err := st.issuedAsset()
+	if err != nil {
+		return err
+	}
+	if st.state.GetAssetFlags(asset)&types.AssetFlagMintable == 0 {
+		return ErrAssetFixedSupply
+	}
+	if !st.state.IsAssetTransferAllowed(asset, msg.From(), *msg.To()) {
+		return ErrAssetTransferRestricted
+	}
+	if ai.MaxSupply != nil {
+		supply, err := st.state.GetAssetSupply(asset)
+		if err != nil {
+			return err
+		}
+		if supply.Add(supply, msg.Value()).Cmp(ai.MaxSupply) > 0 {
+			return ErrAssetMaxSupply
+		}
+	}
+	st.state.MintAsset(asset, *msg.To(), msg.Value())
+	return nil
+}
+
+// updateAsset replaces the metadata of an asset, only its issuer may do so.
+func (st *StateTransition) updateAsset() error {
+	asset, ai, err := st.issuedAsset()
+	if err != nil {
+		return err
+	}
+	update := st.msg.AssetInfo()
+	if err := types.IsMetadataValid(&update); err != nil {
+		return err
+	}
+	ai.UpdateMetadata(&update)
+	return st.state.UpdateAssetInfo(asset, *ai)
+}
+
+// freezeAsset freezes or unfreezes the transfers of an asset published as
+// freezable, for the recipient of the message or, without one, for everyone
+// but the issuer.
+func (st *StateTransition) freezeAsset() error {
+	asset, _, err := st.issuedAsset()
+	if err != nil {
+		return err
+	}
+	flags := st.state.GetAssetFlags(asset)
+	if flags&types.AssetFlagFreezable == 0 {
+		return ErrAssetNotFreezable
+	}
+	freeze := new(big.Int).SetBytes(st.data).Sign() != 0
+	if to := st.msg.To(); to != nil {
+		st.state.SetAssetAccountStatus(asset, *to, setFlag(st.state.GetAssetAccountStatus(asset, *to), types.AssetAccountFrozen, freeze))
+		return nil
+	}
+	st.state.SetAssetFlags(asset, setFlag(flags, types.AssetFlagFrozen, freeze))
+	return nil
+}
+
+// whitelistAsset adds the recipient of the message to or removes it from the
+// holders whitelist of an asset.
+func (st *StateTransition) whitelistAsset() error {
+	asset, _, err := st.issuedAsset()
+	if err != nil {
+		return err
+	}
+	if st.state.GetAssetFlags(asset)&types.AssetFlagWhitelist == 0 {
+		return ErrAssetNoWhitelist
+	}
+	to := st.msg.To()
+	if to == nil {
+		return errors.New("whitelist asset without account")
+	}
+	add := new(big.Int).SetBytes(st.data).Sign() != 0
+	st.state.SetAssetAccountStatus(asset, *to, setFlag(st.state.GetAssetAccountStatus(asset, *to), types.AssetAccountWhitelisted, add))
+	return nil
+}
+
+// issuedAsset returns the asset of the message, failing unless the sender
+// is its issuer.
+func (st *StateTransition) issuedAsset() (common.Address, *types.AssetInfo, error) {
+	if st.msg.Asset() == nil {
+		return common.Address{}, nil, errors.New("asset action without asset")
+	}
+	asset := *st.msg.Asset()
+	ai, err := st.state.GetAssetInfo(asset)
+	if err != nil {
+		return asset, nil, err
+	}
+	if ai.Issuer == nil || *ai.Issuer != st.msg.From() {
+		return asset, nil, ErrAssetNotIssuer
+	}
+	return asset, ai, nil
+}
+
+// setFeePool deposits the value of the message into the fee pool of an asset,
+// withdraws from it and sets its exchange rate, only the issuer may do so.
+func (st *StateTransition) setFeePool() error {
+	asset, _, err := st.issuedAsset()
+	if err != nil {
+		return err
+	}
+	var update types.FeePoolUpdate
+	if err := rlp.DecodeBytes(st.data, &update); err != nil {
+		return err
+	}
+	from := st.msg.From()
+	if st.value.Sign() > 0 {
+		if !st.evm.Context.CanTransfer(st.state, from, asset, nil, st.value) {
+			return vm.ErrInsufficientBalance
+		}
+		st.state.SubBalance(from, st.value)
+		st.state.AddBalance(asset, st.value)
+	}
+	if update.Withdraw != nil && update.Withdraw.Sign() > 0 {
+		if st.state.GetBalance(asset).Cmp(update.Withdraw) < 0 {
+			return ErrFeePoolFunds
+		}
+		st.state.SubBalance(asset, update.Withdraw)
+		st.state.AddBalance(from, update.Withdraw)
+	}
+	rate := update.Rate
+	if rate == nil {
+		rate = new(big.Int)
+	}
+	st.state.SetAssetFeeRate(asset, rate)
+	return nil
+}
+
+// batchTransfer pays out all the transfers of the message or none of them and
+// records every leg as an internal transaction. The code of the recipients is
+// not run, recipients that don't exist yet are charged like a CALL creating
+// them.
+func (st *StateTransition) batchTransfer() error {
+	transfers, err := types.DecodeBatchTransfers(st.data)
+	if err != nil {
+		return err
+	}
+	from := st.msg.From()
+	for _, bt := range transfers {
+		asset := bt.AssetAddress()
+		if !st.state.Exist(bt.To) {
+			if err := st.useGas(params.CallNewAccountGas); err != nil {
+				return err
+			}
+		}
+		if !st.evm.Context.CanTransfer(st.state, from, bt.To, asset, bt.Amount) {
+			return vm.ErrInsufficientBalance
+		}
+		if err := st.evm.Context.Transfer(st.state, from, bt.To, asset, bt.Amount); err != nil {
+			return err
+		}
+	}
+	for _, bt := range transfers {
+		st.evm.AddInnerTx(from, bt.To, bt.AssetAddress(), bt.Amount)
+	}
+	return nil
+}
+
+// approveAsset sets the amount of an asset the recipient of the message may
+// take from the sender, replacing any earlier allowance.
+func (st *StateTransition) approveAsset() error {
+	if st.msg.Asset() == nil || *st.msg.Asset() == (common.Address{}) || st.msg.To() == nil {
+		return ErrAssetAllowance
+	}
+	if st.value.BitLen() > 256 {
+		return ErrAssetAllowance
+	}
+	asset := *st.msg.Asset()
+	if _, err := st.state.GetAssetInfo(asset); err != nil {
+		return err
+	}
+	st.state.SetAssetAllowance(asset, st.msg.From(), *st.msg.To(), st.value)
+	return nil
+}
+
+func setFlag(flags, flag uint64, set bool) uint64 {
+	if set {
+		return flags | flag
+	}
+	return flags &^ flag
+}
+
+// burnAsset destroys units of an asset held by the sender.
+func (st *StateTransition) burnAsset() error {
+	msg := st.msg
+	if msg.Asset() == nil {
+		return errors.New("burn asset without asset")
+	}
+	if msg.Value() == nil || msg.Value().Sign() <= 0 {
+		return ErrAssetAmount
+	}
+	if _, err := st.state.GetAssetInfo(*msg.Asset()); err != nil {
+		return err
+	}
+	// a frozen holder can not get rid of the asset by burning it
+	if !st.state.IsAssetTransferAllowed(*msg.Asset(), msg.From(), msg.From()) {
+		return ErrAssetTransferRestricted
+	}
+	if !st.state.BurnAsset(*msg.Asset(), msg.From(), msg.Value()) {
+		return vm.ErrInsufficientBalance
+	}
+	return nil
+}
+
+// slash verifies the double-sign evidence carried in the data. The deposit of
+// the offending delegate is taken and the delegate removed when the vote
+// changes are counted, see slashDelegate.
+func (st *StateTransition) slash() error {
+	evm := st.evm
+	if evm.VerifyEvidence == nil {
+		return ErrSlashAgent
+	}
+	evidence, err := types.DecodeDoubleSignEvidence(st.data)
+	if err != nil {
+		return err
+	}
+	offender, err := evm.VerifyEvidence(evidence)
+	if err != nil {
+		return err
+	}
+	if offender == st.msg.From() {
+		return errors.New("delegate can not report its own double sign")
+	}
+	candidate, ok := (*evm.DelegateList)[offender]
+	if !ok {
+		return ErrSlashAgent
+	}
+	// evidence from before the delegate (re-)registered has already been punished
+	if evidence.HeaderA.Time.Uint64() < candidate.RegisterTime {
+		return ErrSlashAgent
+	}
+	return nil
 }
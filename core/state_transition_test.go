@@ -0,0 +1,450 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/core/vm"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+)
+
+// testGasPrice is the gas price of the transactions applied by applyTx.
+var testGasPrice = big.NewInt(params.Shannon)
+
+// newAssetTestState returns an empty state and the given number of accounts
+// holding 10 AOA each.
+func newAssetTestState(accounts int) (*state.StateDB, []*ecdsa.PrivateKey) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	keys := make([]*ecdsa.PrivateKey, accounts)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		statedb.SetBalance(crypto.PubkeyToAddress(keys[i].PublicKey), new(big.Int).Mul(big.NewInt(10), big.NewInt(params.Em)))
+	}
+	return statedb, keys
+}
+
+// applyTx signs the transaction built for the next nonce of the key and
+// applies it to the state in block 1 of a chain with all forks enabled,
+// recording its internal transactions.
+func applyTx(t *testing.T, statedb *state.StateDB, key *ecdsa.PrivateKey, build func(nonce uint64) *types.Transaction) (*StateTransition, error) {
+	config := params.AllDacchainProtocolChanges
+	signer := types.MakeSigner(config, common.Big1)
+	tx, err := types.SignTx(build(statedb.GetNonce(crypto.PubkeyToAddress(key.PublicKey))), signer, key)
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+	msg, err := tx.AsMessage(signer)
+	if err != nil {
+		t.Fatalf("failed to convert transaction: %v", err)
+	}
+	context := vm.Context{
+		CanTransfer: CanTransfer,
+		Transfer:    Transfer,
+		Origin:      msg.From(),
+		BlockNumber: big.NewInt(1),
+		Time:        big.NewInt(1),
+		GasLimit:    msg.Gas(),
+		GasPrice:    msg.GasPrice(),
+	}
+	evm := vm.NewEVM(context, statedb, config, vm.Config{WatchInnerTx: true})
+	evm.WatchInnerTx = true
+	st := NewStateTransition(evm, msg, new(GasPool).AddGas(msg.Gas()))
+	_, _, _, err = st.TransitionDb()
+	return st, err
+}
+
+// publishTx returns a builder of a transaction publishing the given asset.
+func publishTx(info types.AssetInfo) func(nonce uint64) *types.Transaction {
+	data, _ := types.AssetInfoToBytes(info)
+	return func(nonce uint64) *types.Transaction {
+		return types.NewPublishAssetTransaction(nonce, big.NewInt(0), params.TxGasAssetPublish, testGasPrice, types.ActionPublishAsset, data)
+	}
+}
+
+// transferTx returns a builder of a transaction sending amount units of the
+// asset to the recipient.
+func transferTx(asset, to common.Address, amount int64) func(nonce uint64) *types.Transaction {
+	return func(nonce uint64) *types.Transaction {
+		return types.NewTransaction(nonce, to, big.NewInt(amount), params.TxGas, testGasPrice, nil, types.ActionTrans, &asset, "")
+	}
+}
+
+// batchTx returns a builder of a batch transfer transaction with enough gas
+// for transfers to new accounts.
+func batchTx(transfers []types.BatchTransferEntry) func(nonce uint64) *types.Transaction {
+	data, _ := rlp.EncodeToBytes(transfers)
+	gas, _ := IntrinsicGas(data, types.ActionBatchTransfer)
+	gas += uint64(len(transfers)) * params.CallNewAccountGas
+	return func(nonce uint64) *types.Transaction {
+		return types.NewBatchTransferTransaction(nonce, transfers, gas, testGasPrice)
+	}
+}
+
+// assetTxTest is a transaction of a test sequence and the error applying it
+// is expected to fail with.
+type assetTxTest struct {
+	name  string
+	from  int
+	build func(nonce uint64) *types.Transaction
+	err   error
+}
+
+// applyTxs applies the transactions of the test sequence in order.
+func applyTxs(t *testing.T, statedb *state.StateDB, keys []*ecdsa.PrivateKey, tests []assetTxTest) {
+	t.Helper()
+	for _, test := range tests {
+		if _, err := applyTx(t, statedb, keys[test.from], test.build); err != test.err {
+			t.Fatalf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+	}
+}
+
+func TestAssetFee(t *testing.T) {
+	tests := []struct {
+		cost, rate int64
+		want       int64
+	}{
+		{0, 1e18, 0},
+		{1, 1e18, 1},
+		{21000, 1e18, 21000},
+		// fractions of an asset unit are charged in full
+		{1, 1, 1},
+		{1e18 - 1, 1, 1},
+		{1e18, 1, 1},
+		{1e18 + 1, 1, 2},
+		{3e17, 5, 2},
+		{2e17, 5, 1},
+	}
+	for i, test := range tests {
+		if have := assetFee(big.NewInt(test.cost), big.NewInt(test.rate)); have.Cmp(big.NewInt(test.want)) != 0 {
+			t.Errorf("test %d: fee mismatch: have %v, want %d", i, have, test.want)
+		}
+	}
+}
+
+func TestFeePoolRate(t *testing.T) {
+	var (
+		asset    = common.HexToAddress("0xa55e7")
+		unpooled = common.HexToAddress("0xb0b")
+		zero     = common.Address{}
+		gasPrice = big.NewInt(1e9)
+		rate     = big.NewInt(1e6)
+		noFork   = *params.AllDacchainProtocolChanges
+	)
+	noFork.AssetFeePoolBlock = nil
+
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	statedb.SetAssetFeeRate(asset, rate)
+	statedb.SetBalance(asset, big.NewInt(21000*1e9))
+	statedb.SetBalance(unpooled, big.NewInt(21000*1e9))
+
+	tests := []struct {
+		name    string
+		config  *params.ChainConfig
+		action  uint64
+		asset   *common.Address
+		maxRate *big.Int
+		gas     uint64
+		want    *big.Int
+		err     error
+	}{
+		{"opted out", params.AllDacchainProtocolChanges, types.ActionTrans, &asset, nil, 21000, nil, nil},
+		{"opted in", params.AllDacchainProtocolChanges, types.ActionTrans, &asset, rate, 21000, rate, nil},
+		{"below max rate", params.AllDacchainProtocolChanges, types.ActionTrans, &asset, big.NewInt(2e6), 21000, rate, nil},
+		{"above max rate", params.AllDacchainProtocolChanges, types.ActionTrans, &asset, big.NewInt(1e6 - 1), 21000, nil, ErrFeePoolRate},
+		{"pool short of gas", params.AllDacchainProtocolChanges, types.ActionTrans, &asset, rate, 21001, nil, ErrFeePoolFunds},
+		{"before fork", &noFork, types.ActionTrans, &asset, rate, 21000, nil, ErrFeePoolUnavailable},
+		{"without asset", params.AllDacchainProtocolChanges, types.ActionTrans, nil, rate, 21000, nil, ErrFeePoolUnavailable},
+		{"zero asset", params.AllDacchainProtocolChanges, types.ActionTrans, &zero, rate, 21000, nil, ErrFeePoolUnavailable},
+		{"pool without rate", params.AllDacchainProtocolChanges, types.ActionTrans, &unpooled, rate, 21000, nil, ErrFeePoolUnavailable},
+		{"funding the pool", params.AllDacchainProtocolChanges, types.ActionSetFeePool, &asset, rate, 21000, nil, ErrFeePoolUnavailable},
+	}
+	for _, test := range tests {
+		have, err := feePoolRate(test.config, big.NewInt(1), statedb, test.action, test.asset, test.maxRate, test.gas, gasPrice)
+		if err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+		if (have == nil) != (test.want == nil) || (have != nil && have.Cmp(test.want) != 0) {
+			t.Errorf("%s: rate mismatch: have %v, want %v", test.name, have, test.want)
+		}
+	}
+}
+
+func TestMintBurnAsset(t *testing.T) {
+	statedb, keys := newAssetTestState(2)
+	issuer, holder := crypto.PubkeyToAddress(keys[0].PublicKey), crypto.PubkeyToAddress(keys[1].PublicKey)
+	asset, fixed := crypto.CreateAddress(issuer, 0), crypto.CreateAddress(issuer, 1)
+	mint := func(asset common.Address, amount int64) func(nonce uint64) *types.Transaction {
+		return func(nonce uint64) *types.Transaction {
+			return types.NewMintAssetTransaction(nonce, holder, asset, big.NewInt(amount), params.TxGas, testGasPrice)
+		}
+	}
+	burn := func(amount int64) func(nonce uint64) *types.Transaction {
+		return func(nonce uint64) *types.Transaction {
+			return types.NewBurnAssetTransaction(nonce, asset, big.NewInt(amount), params.TxGas, testGasPrice)
+		}
+	}
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Loyalty", Symbol: "LP", Supply: big.NewInt(1000), MaxSupply: big.NewInt(1500)}), nil},
+		{"publish fixed", 0, publishTx(types.AssetInfo{Name: "Share", Symbol: "SHR", Supply: big.NewInt(1000), FixedSupply: true}), nil},
+		{"mint", 0, mint(asset, 400), nil},
+		{"burn", 1, burn(150), nil},
+		{"mint by holder", 1, mint(asset, 50), ErrAssetNotIssuer},
+		{"mint beyond max supply", 0, mint(asset, 351), ErrAssetMaxSupply},
+		{"mint fixed supply", 0, mint(fixed, 1), ErrAssetFixedSupply},
+		{"mint nothing", 0, mint(asset, 0), ErrAssetAmount},
+		{"burn beyond balance", 1, burn(251), vm.ErrInsufficientBalance},
+	})
+
+	if balance := statedb.GetAssetBalance(holder, asset); balance.Cmp(big.NewInt(250)) != 0 {
+		t.Errorf("holder balance mismatch: have %v, want 250", balance)
+	}
+	if supply, err := statedb.GetAssetSupply(asset); err != nil || supply.Cmp(big.NewInt(1250)) != 0 {
+		t.Errorf("circulating supply mismatch: have %v (%v), want 1250", supply, err)
+	}
+	if flags := statedb.GetAssetFlags(asset); flags&types.AssetFlagMintable == 0 {
+		t.Errorf("asset not mintable, flags %#x", flags)
+	}
+	if supply, err := statedb.GetAssetSupply(fixed); err != nil || supply.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("fixed supply mismatch: have %v (%v), want 1000", supply, err)
+	}
+	if flags := statedb.GetAssetFlags(fixed); flags&types.AssetFlagMintable != 0 {
+		t.Errorf("fixed supply asset mintable, flags %#x", flags)
+	}
+}
+
+func TestPublishAssetSymbol(t *testing.T) {
+	statedb, keys := newAssetTestState(2)
+	asset := crypto.CreateAddress(crypto.PubkeyToAddress(keys[0].PublicKey), 0)
+
+	// the symbol is taken regardless of its case
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Tether", Symbol: "USDT", Supply: big.NewInt(1000)}), nil},
+		{"publish duplicate", 1, publishTx(types.AssetInfo{Name: "Fake Tether", Symbol: "usdt", Supply: big.NewInt(1000)}), ErrAssetSymbolTaken},
+	})
+	if have := statedb.GetAssetBySymbol("uSdT"); have != asset {
+		t.Errorf("registered asset mismatch: have %x, want %x", have, asset)
+	}
+}
+
+func TestUpdateAsset(t *testing.T) {
+	statedb, keys := newAssetTestState(2)
+	asset := crypto.CreateAddress(crypto.PubkeyToAddress(keys[0].PublicKey), 0)
+	decimals := uint8(6)
+	update := func(desc string) func(nonce uint64) *types.Transaction {
+		data, _ := types.AssetInfoToBytes(types.AssetInfo{Desc: desc, Icon: "https://usdx.example/icon.png"})
+		return func(nonce uint64) *types.Transaction {
+			return types.NewUpdateAssetTransaction(nonce, asset, data, params.TxGasAssetUpdate, testGasPrice)
+		}
+	}
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Dollar", Symbol: "USDX", Supply: big.NewInt(1000), Decimals: &decimals, URL: "https://usdx.example"}), nil},
+		{"update", 0, update("a dollar stablecoin"), nil},
+		{"update by holder", 1, update("a scam"), ErrAssetNotIssuer},
+	})
+
+	ai, err := statedb.GetAssetInfo(asset)
+	if err != nil {
+		t.Fatalf("failed to get asset info: %v", err)
+	}
+	if ai.Desc != "a dollar stablecoin" || ai.Icon != "https://usdx.example/icon.png" || ai.URL != "https://usdx.example" {
+		t.Errorf("metadata mismatch: desc %q icon %q url %q", ai.Desc, ai.Icon, ai.URL)
+	}
+	if ai.GetDecimals() != decimals || ai.Symbol != "USDX" || ai.Supply.Cmp(big.NewInt(1000)) != 0 {
+		t.Errorf("fixed fields changed: decimals %d symbol %s supply %v", ai.GetDecimals(), ai.Symbol, ai.Supply)
+	}
+}
+
+func TestAssetControls(t *testing.T) {
+	statedb, keys := newAssetTestState(3)
+	issuer, holder, other := crypto.PubkeyToAddress(keys[0].PublicKey), crypto.PubkeyToAddress(keys[1].PublicKey), crypto.PubkeyToAddress(keys[2].PublicKey)
+	asset := crypto.CreateAddress(issuer, 0)
+	whitelist := func(account common.Address) func(nonce uint64) *types.Transaction {
+		return func(nonce uint64) *types.Transaction {
+			return types.NewWhitelistAssetTransaction(nonce, asset, account, true, 2*params.TxGas, testGasPrice)
+		}
+	}
+	freeze := func(nonce uint64) *types.Transaction {
+		return types.NewFreezeAssetTransaction(nonce, asset, nil, true, 2*params.TxGas, testGasPrice)
+	}
+	// the controls of single accounts are covered by the state tests
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Security", Symbol: "SEC", Supply: big.NewInt(1000), Freezable: true, Whitelist: true}), nil},
+		{"whitelist holder", 0, whitelist(holder), nil},
+		{"transfer to holder", 0, transferTx(asset, holder, 100), nil},
+		{"transfer to unlisted", 1, transferTx(asset, other, 1), vm.ErrInsufficientBalance},
+		{"whitelist by holder", 1, whitelist(other), ErrAssetNotIssuer},
+		{"whitelist other", 0, whitelist(other), nil},
+		{"freeze", 0, freeze, nil},
+		{"transfer while frozen", 1, transferTx(asset, other, 1), vm.ErrInsufficientBalance},
+		{"transfer by issuer while frozen", 0, transferTx(asset, other, 10), nil},
+	})
+
+	if balance := statedb.GetAssetBalance(holder, asset); balance.Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("holder balance mismatch: have %v, want 100", balance)
+	}
+	if balance := statedb.GetAssetBalance(other, asset); balance.Cmp(big.NewInt(10)) != 0 {
+		t.Errorf("other balance mismatch: have %v, want 10", balance)
+	}
+}
+
+func TestFeePoolPayment(t *testing.T) {
+	statedb, keys := newAssetTestState(2)
+	issuer, other := crypto.PubkeyToAddress(keys[0].PublicKey), crypto.PubkeyToAddress(keys[1].PublicKey)
+	asset := crypto.CreateAddress(issuer, 0)
+	rate := big.NewInt(1e6)
+	deposit := big.NewInt(params.Em)
+
+	// the user holds the asset only and no AOA at all
+	userKey, _ := crypto.GenerateKey()
+	user := crypto.PubkeyToAddress(userKey.PublicKey)
+	keys = append(keys, userKey)
+
+	transfer := func(maxRate *big.Int) func(nonce uint64) *types.Transaction {
+		return func(nonce uint64) *types.Transaction {
+			return types.NewTransaction(nonce, other, big.NewInt(1000), params.TxGas, testGasPrice, nil, types.ActionTrans, &asset, "").WithMaxFeeRate(maxRate)
+		}
+	}
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Gasless", Symbol: "GSL", Supply: big.NewInt(1e9)}), nil},
+		{"set fee pool by holder", 1, func(nonce uint64) *types.Transaction {
+			return types.NewSetFeePoolTransaction(nonce, asset, deposit, types.FeePoolUpdate{Rate: rate}, 2*params.TxGas, testGasPrice)
+		}, ErrAssetNotIssuer},
+		{"set fee pool", 0, func(nonce uint64) *types.Transaction {
+			return types.NewSetFeePoolTransaction(nonce, asset, deposit, types.FeePoolUpdate{Rate: rate}, 2*params.TxGas, testGasPrice)
+		}, nil},
+		{"fund user", 0, transferTx(asset, user, 1e6), nil},
+		{"below pool rate", 2, transfer(new(big.Int).Sub(rate, common.Big1)), ErrFeePoolRate},
+	})
+	st, err := applyTx(t, statedb, userKey, transfer(rate))
+	if err != nil {
+		t.Fatalf("pooled transfer failed: %v", err)
+	}
+
+	cost := new(big.Int).Mul(new(big.Int).SetUint64(params.TxGas), testGasPrice)
+	fee := assetFee(cost, rate)
+	if feeAsset, amount := st.FeePayment(); feeAsset == nil || *feeAsset != asset || amount.Cmp(fee) != 0 {
+		t.Errorf("fee payment mismatch: have %v %v, want %x %v", feeAsset, amount, asset, fee)
+	}
+	if want := new(big.Int).Sub(big.NewInt(1e6-1000), fee); statedb.GetAssetBalance(user, asset).Cmp(want) != 0 {
+		t.Errorf("user balance mismatch: have %v, want %v", statedb.GetAssetBalance(user, asset), want)
+	}
+	if want := new(big.Int).Add(big.NewInt(1e9-1e6), fee); statedb.GetAssetBalance(issuer, asset).Cmp(want) != 0 {
+		t.Errorf("issuer balance mismatch: have %v, want %v", statedb.GetAssetBalance(issuer, asset), want)
+	}
+	// the transfers of the issuer didn't opt into the pool
+	if want := new(big.Int).Sub(deposit, cost); statedb.GetBalance(asset).Cmp(want) != 0 {
+		t.Errorf("fee pool mismatch: have %v, want %v", statedb.GetBalance(asset), want)
+	}
+	if balance := statedb.GetBalance(user); balance.Sign() != 0 {
+		t.Errorf("user AOA balance mismatch: have %v, want 0", balance)
+	}
+}
+
+func TestBatchTransfer(t *testing.T) {
+	statedb, keys := newAssetTestState(1)
+	issuer := crypto.PubkeyToAddress(keys[0].PublicKey)
+	asset := crypto.CreateAddress(issuer, 0)
+	recipients := []common.Address{common.HexToAddress("0xb0b"), common.HexToAddress("0xca401"), common.HexToAddress("0xda7e")}
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Payroll", Symbol: "PAY", Supply: big.NewInt(1e6)}), nil},
+	})
+
+	transfers := []types.BatchTransferEntry{
+		{To: recipients[0], Amount: big.NewInt(1000)},
+		{To: recipients[1], Asset: &asset, Amount: big.NewInt(500)},
+		{To: recipients[0], Asset: &asset, Amount: big.NewInt(700)},
+	}
+	st, err := applyTx(t, statedb, keys[0], batchTx(transfers))
+	if err != nil {
+		t.Fatalf("batch transfer failed: %v", err)
+	}
+	itxs := st.evm.InnerTxs
+	if len(itxs) != len(transfers) {
+		t.Fatalf("internal transaction count mismatch: have %d, want %d", len(itxs), len(transfers))
+	}
+	for i, itx := range itxs {
+		if itx.From != issuer || itx.To != transfers[i].To || itx.Value.Cmp(transfers[i].Amount) != 0 || (itx.AssetID == nil) != (transfers[i].Asset == nil) {
+			t.Errorf("internal transaction %d mismatch: have %+v, want %+v", i, itx, transfers[i])
+		}
+	}
+	// a batch that can't be paid in full pays out none of its legs
+	overdrawn := []types.BatchTransferEntry{
+		{To: recipients[2], Amount: big.NewInt(1000)},
+		{To: recipients[2], Asset: &asset, Amount: big.NewInt(1e6 - 1199)},
+	}
+	if _, err := applyTx(t, statedb, keys[0], batchTx(overdrawn)); err != vm.ErrInsufficientBalance {
+		t.Errorf("overdrawn batch error mismatch: have %v, want %v", err, vm.ErrInsufficientBalance)
+	}
+
+	tests := []struct {
+		account common.Address
+		asset   *common.Address
+		want    int64
+	}{
+		{recipients[0], nil, 1000},
+		{recipients[0], &asset, 700},
+		{recipients[1], &asset, 500},
+		{recipients[2], nil, 0},
+		{recipients[2], &asset, 0},
+		{issuer, &asset, 1e6 - 1200},
+	}
+	for _, test := range tests {
+		balance := statedb.GetBalance(test.account)
+		if test.asset != nil {
+			balance = statedb.GetAssetBalance(test.account, *test.asset)
+		}
+		if balance.Int64() != test.want {
+			t.Errorf("%x: balance mismatch (asset %v): have %v, want %d", test.account, test.asset != nil, balance, test.want)
+		}
+	}
+}
+
+func TestApproveAsset(t *testing.T) {
+	statedb, keys := newAssetTestState(1)
+	owner := crypto.PubkeyToAddress(keys[0].PublicKey)
+	asset, spender := crypto.CreateAddress(owner, 0), common.HexToAddress("0xc0de")
+	approve := func(asset common.Address, amount int64) func(nonce uint64) *types.Transaction {
+		return func(nonce uint64) *types.Transaction {
+			return types.NewApproveAssetTransaction(nonce, asset, spender, big.NewInt(amount), params.TxGas, testGasPrice)
+		}
+	}
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Escrow", Symbol: "ESC", Supply: big.NewInt(1e6)}), nil},
+		{"approve", 0, approve(asset, 1000), nil},
+		// a new approval replaces the old one, the holder keeps the units
+		{"approve again", 0, approve(asset, 400), nil},
+		{"approve base currency", 0, approve(common.Address{}, 1000), ErrAssetAllowance},
+	})
+	if allowance := statedb.GetAssetAllowance(asset, owner, spender); allowance.Cmp(big.NewInt(400)) != 0 {
+		t.Errorf("allowance mismatch: have %v, want 400", allowance)
+	}
+	if balance := statedb.GetAssetBalance(owner, asset); balance.Cmp(big.NewInt(1e6)) != 0 {
+		t.Errorf("owner balance mismatch: have %v, want %v", balance, 1e6)
+	}
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/log"
 	"github.com/Aurorachain-io/go-aoa/metrics"
 	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
 	"gopkg.in/karalabe/cookiejar.v2/collections/prque"
 	"strings"
 )
@@ -672,8 +673,7 @@ func (pool *TxPool) local() map[common.Address]types.Transactions {
 // validateTx checks whether a transaction is valid according to the consensus
 // rules and adheres to some heuristic limits of the local node (price and size).
 func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
-	// ActionCallContract is the largest action number
-	if tx.TxDataAction() > types.ActionCallContract {
+	if !IsActionSupported(pool.chainconfig, pool.nextBlock(), tx.TxDataAction()) {
 		return fmt.Errorf("Illegal action: %d", tx.TxDataAction())
 	}
 	// Heuristic limit, reject transactions over 32KB to prevent DOS attacks
@@ -707,8 +707,8 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 		return ErrNonceTooLow
 	}
 	// Transactor should have enough funds to cover the costs
-	// cost == V + GP * GL
-	cost := tx.EmCost()
+	// cost == V + GP * GL, unless the gas is paid from an asset fee pool
+	cost := pool.txCost(tx)
 	switch tx.TxDataAction() {
 	case types.ActionRegister:
 		return fmt.Errorf("not support trx type")
@@ -762,16 +762,78 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 			log.Error("ValidateAsset failed.", "err", err)
 			return err
 		}
+		if pool.chainconfig.IsAssetRegistry(pool.nextBlock()) && pool.currentState.GetAssetBySymbol(tx.AssetInfo().Symbol) != (common.Address{}) {
+			return ErrAssetSymbolTaken
+		}
 	case types.ActionCreateContract:
 		if len(tx.Data()) == 0 {
 			return errors.New("Create contract but data is nil")
 		}
+	case types.ActionMintAsset, types.ActionBurnAsset:
+		if tx.Asset() == nil || tx.Value().Sign() <= 0 {
+			return ErrAssetAmount
+		}
+	case types.ActionUpdateAsset:
+		ai := tx.AssetInfo()
+		if tx.Asset() == nil || ai == nil {
+			return errors.New("update asset without asset or metadata")
+		}
+		if err := types.IsMetadataValid(ai); err != nil {
+			return err
+		}
+	case types.ActionSetFeePool:
+		var update types.FeePoolUpdate
+		if tx.Asset() == nil {
+			return errors.New("set fee pool without asset")
+		}
+		if err := rlp.DecodeBytes(tx.Data(), &update); err != nil {
+			return err
+		}
+	case types.ActionApproveAsset:
+		if a := tx.Asset(); a == nil || *a == (common.Address{}) || tx.To() == nil || tx.Value().BitLen() > 256 {
+			return ErrAssetAllowance
+		}
+		if _, err := pool.currentState.GetAssetInfo(*tx.Asset()); err != nil {
+			return err
+		}
+	case types.ActionBatchTransfer:
+		transfers, err := types.DecodeBatchTransfers(tx.Data())
+		if err != nil {
+			return err
+		}
+		// the AOA paid out is part of the cost, assets are checked here
+		for _, bt := range transfers {
+			if a := bt.AssetAddress(); a != nil && !pool.currentState.IsAssetTransferAllowed(*a, from, bt.To) {
+				return ErrAssetTransferRestricted
+			}
+		}
+		for asset, amount := range types.BatchTransferAmounts(transfers) {
+			if asset != (common.Address{}) && pool.currentState.GetAssetBalance(from, asset).Cmp(amount) < 0 {
+				return ErrInsufficientAssetFunds
+			}
+		}
+	}
+	rate, err := pool.feePoolRate(tx)
+	if err != nil {
+		return err
 	}
 	a := tx.Asset()
-	if a != nil && (*a != common.Address{}) {
-		if pool.currentState.GetAssetBalance(from, *a).Cmp(tx.Value()) < 0 {
+	if a != nil && (*a != common.Address{}) && tx.TxDataAction() != types.ActionSetFeePool {
+		// minted units are created by the transaction and approved ones stay
+		// with the sender, neither is spent
+		spent := new(big.Int)
+		if action := tx.TxDataAction(); action != types.ActionMintAsset && action != types.ActionApproveAsset {
+			spent.Set(tx.Value())
+		}
+		if rate != nil {
+			spent.Add(spent, assetFee(new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas())), rate))
+		}
+		if pool.currentState.GetAssetBalance(from, *a).Cmp(spent) < 0 {
 			return ErrInsufficientAssetFunds
 		}
+		if action := tx.TxDataAction(); (action == types.ActionTrans || action == types.ActionCallContract) && tx.To() != nil && !pool.currentState.IsAssetTransferAllowed(*a, from, *tx.To()) {
+			return ErrAssetTransferRestricted
+		}
 	}
 	if pool.currentState.GetBalance(from).Cmp(cost) < 0 {
 		return ErrInsufficientFunds
@@ -786,6 +848,27 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	return nil
 }
 
+// feePoolRate returns the exchange rate of the asset fee pool paying the gas
+// of a transaction in the next block, or nil if the sender pays in AOA.
+func (pool *TxPool) feePoolRate(tx *types.Transaction) (*big.Int, error) {
+	return feePoolRate(pool.chainconfig, pool.nextBlock(), pool.currentState, tx.TxDataAction(), tx.Asset(), tx.MaxFeeRate(), tx.Gas(), tx.GasPrice())
+}
+
+// nextBlock returns the number of the block the pending transactions go into.
+func (pool *TxPool) nextBlock() *big.Int {
+	return new(big.Int).Add(pool.chain.CurrentBlock().Number(), common.Big1)
+}
+
+// txCost returns the AOA a transaction may cost its sender in the next block,
+// leaving out the gas paid from an asset fee pool.
+func (pool *TxPool) txCost(tx *types.Transaction) *big.Int {
+	cost := tx.EmCost(pool.chainconfig, pool.nextBlock())
+	if rate, _ := pool.feePoolRate(tx); rate != nil {
+		cost.Sub(cost, new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas())))
+	}
+	return cost
+}
+
 func validateVote(prevVoteList []common.Address, curVoteList []types.Vote, delegateList map[common.Address]types.Candidate) (*int64, error) {
 	diff := int64(0)
 	voteChange := prevVoteList
@@ -869,7 +952,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 	from, _ := types.Sender(pool.signer, tx) // already validated
 	if list := pool.pending[from]; list != nil && list.Overlaps(tx) {
 		// Nonce already pending, check if required price bump is met
-		inserted, old := list.Add(tx, pool.config.PriceBump)
+		inserted, old := list.Add(tx, pool.config.PriceBump, pool.txCost)
 		if !inserted {
 			pendingDiscardCounter.Inc(1)
 			return false, ErrReplaceUnderpriced
@@ -912,7 +995,7 @@ func (pool *TxPool) add(tx *types.Transaction, local bool) (bool, error) {
 
 func IsContractTransaction(tx *types.Transaction, db *state.StateDB) bool {
 	switch tx.TxDataAction() {
-	case types.ActionRegister, types.ActionAddVote, types.ActionSubVote, types.ActionPublishAsset:
+	case types.ActionRegister, types.ActionAddVote, types.ActionSubVote, types.ActionPublishAsset, types.ActionUnjail, types.ActionSlash, types.ActionUnregister, types.ActionSetCommission, types.ActionClaimReward, types.ActionMintAsset, types.ActionBurnAsset, types.ActionUpdateAsset, types.ActionFreezeAsset, types.ActionWhitelistAsset, types.ActionSetFeePool, types.ActionBatchTransfer, types.ActionApproveAsset:
 		return false
 	case types.ActionCreateContract, types.ActionCallContract:
 		return true
@@ -935,7 +1018,7 @@ func (pool *TxPool) enqueueTx(hash common.Hash, tx *types.Transaction) (bool, er
 	if pool.queue[from] == nil {
 		pool.queue[from] = newTxList(false)
 	}
-	inserted, old := pool.queue[from].Add(tx, pool.config.PriceBump)
+	inserted, old := pool.queue[from].Add(tx, pool.config.PriceBump, pool.txCost)
 	if !inserted {
 		// An older transaction was better, discard this
 		queuedDiscardCounter.Inc(1)
@@ -974,7 +1057,7 @@ func (pool *TxPool) promoteTx(addr common.Address, hash common.Hash, tx *types.T
 	}
 	list := pool.pending[addr]
 
-	inserted, old := list.Add(tx, pool.config.PriceBump)
+	inserted, old := list.Add(tx, pool.config.PriceBump, pool.txCost)
 	if !inserted {
 		// An older transaction was better, discard this
 		delete(pool.all, hash)
@@ -1188,7 +1271,7 @@ func (pool *TxPool) promoteExecutables(accounts []common.Address) {
 		}
 		//log.Info("Tx_Pool|promoteExecutables|After drop old transactions", "contractTxNumber", contractTxCounter.Count(), "normalTxNumber", normalTxCounter.Count(), "pending remain", int64(wholeTransactionNumber)-contractTxCounter.Count()-normalTxCounter.Count())
 		// Drop all transactions that are too costly (low balance or out of gas)
-		drops, _ := list.Filter(pool.currentState.GetBalance(addr), pool.currentMaxGas)
+		drops, _ := list.Filter(pool.currentState.GetBalance(addr), pool.currentMaxGas, pool.txCost)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			txType := tx.GetTransactionType()
@@ -1365,7 +1448,7 @@ func (pool *TxPool) demoteUnexecutables() {
 		}
 		//log.Info("Tx_Pool|demoteUnexecutables|After Drop all transactions that are deemed too old (low nonce)", "contractTxNumber", contractTxCounter.Count(), "normalTxNumber", normalTxCounter.Count(), "pending remain", int64(wholeTransactionNumber)-contractTxCounter.Count()-normalTxCounter.Count())
 		// Drop all transactions that are too costly (low balance or out of gas), and queue any invalids back for later
-		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), pool.currentMaxGas)
+		drops, invalids := list.Filter(pool.currentState.GetBalance(addr), pool.currentMaxGas, pool.txCost)
 		for _, tx := range drops {
 			hash := tx.Hash()
 			txType := tx.GetTransactionType()
@@ -26,6 +26,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/metrics"
+	"github.com/Aurorachain-io/go-aoa/params"
 	"github.com/Aurorachain-io/go-aoa/rlp"
 	"io"
 	"io/ioutil"
@@ -81,6 +82,8 @@ type rpcRes struct {
 var wg sync.WaitGroup
 
 func TestNewTxPool(t *testing.T) {
+	t.Skip("sends dac_sendTransaction from EM addresses to a node on 127.0.0.1:8545, nodes serve the aoa namespace")
+
 	runtime.GOMAXPROCS(4)
 	//result, err := netPeerCount(2)
 	//if err == nil {
@@ -217,20 +220,17 @@ func DacSendTransaction(id int, trx fulltrx) (string, error) {
 func commonRequest(requestMap map[string]interface{}) (string, error) {
 	reqBytes, err := json.Marshal(requestMap)
 	if err != nil {
-		fmt.Errorf("req json error:%v\n", err)
 		return "", err
 	}
 	reader := bytes.NewReader(reqBytes)
 	request, err := http.NewRequest("POST", url, reader)
 	if err != nil {
-		fmt.Errorf("create request  error:%v\n", err)
 		return "", err
 	}
 	request.Header.Set("Content-Type", "application/json;charset=UTF-8")
 	client := http.Client{}
 	resp, err := client.Do(request)
 	if err != nil {
-		fmt.Errorf("response  error:%v\n", err)
 		return "", err
 	}
 	respBytes, err := ioutil.ReadAll(resp.Body)
@@ -399,3 +399,63 @@ func TestDecode(t *testing.T) {
 	_ = rlp.DecodeBytes(decode, tx)
 	fmt.Println(tx)
 }
+
+// testPoolChain is a chain whose head is the genesis block, enough for the
+// fork checks of the pool.
+type testPoolChain struct {
+	blockChain
+	head *types.Block
+}
+
+func (c *testPoolChain) CurrentBlock() *types.Block { return c.head }
+
+func TestValidateAssetTx(t *testing.T) {
+	statedb, keys := newAssetTestState(2)
+	issuer, holder := crypto.PubkeyToAddress(keys[0].PublicKey), crypto.PubkeyToAddress(keys[1].PublicKey)
+	asset, restricted := crypto.CreateAddress(issuer, 0), crypto.CreateAddress(issuer, 1)
+	unlisted := common.HexToAddress("0xb0b")
+	applyTxs(t, statedb, keys, []assetTxTest{
+		{"publish", 0, publishTx(types.AssetInfo{Name: "Tether", Symbol: "USDT", Supply: big.NewInt(1e6)}), nil},
+		{"publish restricted", 0, publishTx(types.AssetInfo{Name: "Security", Symbol: "SEC", Supply: big.NewInt(1000), Whitelist: true}), nil},
+		{"set fee pool", 0, func(nonce uint64) *types.Transaction {
+			return types.NewSetFeePoolTransaction(nonce, asset, big.NewInt(params.Em), types.FeePoolUpdate{Rate: big.NewInt(1e6)}, 2*params.TxGas, testGasPrice)
+		}, nil},
+	})
+	// the holder got its units before the whitelist was enforced
+	statedb.AddAssetBalance(holder, restricted, big.NewInt(10))
+
+	config := params.AllDacchainProtocolChanges
+	pool := &TxPool{
+		chainconfig:   config,
+		chain:         &testPoolChain{head: types.NewBlockWithHeader(&types.Header{Number: new(big.Int)})},
+		gasPrice:      testGasPrice,
+		signer:        types.MakeSigner(config, common.Big1),
+		currentState:  statedb,
+		currentMaxGas: params.MaxOneContractGasLimit,
+	}
+	tests := []struct {
+		name  string
+		from  int
+		build func(nonce uint64) *types.Transaction
+		err   error
+	}{
+		{"transfer", 0, transferTx(asset, holder, 1000), nil},
+		{"overdrawn transfer", 1, transferTx(asset, issuer, 1), ErrInsufficientAssetFunds},
+		{"symbol taken", 1, publishTx(types.AssetInfo{Name: "Fake Tether", Symbol: "usdt", Supply: big.NewInt(1000)}), ErrAssetSymbolTaken},
+		{"restricted transfer", 1, transferTx(restricted, unlisted, 1), ErrAssetTransferRestricted},
+		{"overdrawn batch", 0, batchTx([]types.BatchTransferEntry{{To: holder, Asset: &asset, Amount: big.NewInt(1e6 + 1)}}), ErrInsufficientAssetFunds},
+		{"restricted batch", 1, batchTx([]types.BatchTransferEntry{{To: unlisted, Amount: big.NewInt(1)}, {To: unlisted, Asset: &restricted, Amount: big.NewInt(1)}}), ErrAssetTransferRestricted},
+		{"pool rate", 0, func(nonce uint64) *types.Transaction {
+			return transferTx(asset, holder, 1000)(nonce).WithMaxFeeRate(big.NewInt(1e6 - 1))
+		}, ErrFeePoolRate},
+	}
+	for _, test := range tests {
+		tx, err := types.SignTx(test.build(statedb.GetNonce(crypto.PubkeyToAddress(keys[test.from].PublicKey))), pool.signer, keys[test.from])
+		if err != nil {
+			t.Fatalf("%s: failed to sign transaction: %v", test.name, err)
+		}
+		if err := pool.validateTx(tx, true); err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		}
+	}
+}
@@ -28,17 +28,106 @@ const (
 	AssetNameMaxLen   = 50
 	AssetSymbolMaxLen = 20
 	AssetDescMaxLen   = 1024
+	AssetURLMaxLen    = 256
+	AssetMaxDecimals  = 18
+
+	// DefaultAssetDecimals is the number of decimals of assets published
+	// without any, matching the native coin.
+	DefaultAssetDecimals = 18
 )
 
 var validSymbol = regexp.MustCompile(`^[a-zA-Z]{1}[a-zA-Z0-9]+$`)
 var illegalSymbol = regexp.MustCompile("EM|Eminer")
 
+// Asset control flags, recorded in the asset account when the asset is
+// published. Assets published before the flags existed have none set.
+const (
+	AssetFlagMintable  uint64 = 1 << iota // the issuer can mint new supply
+	AssetFlagFreezable                    // the issuer can freeze transfers
+	AssetFlagWhitelist                    // only whitelisted accounts can hold the asset
+	AssetFlagFrozen                       // transfers are frozen for everyone but the issuer
+)
+
+// Status flags of an account holding an asset, set by the issuer.
+const (
+	AssetAccountFrozen      uint64 = 1 << iota // the account can neither send nor receive the asset
+	AssetAccountWhitelisted                    // the account can hold a whitelisted asset
+)
+
 type AssetInfo struct {
 	Issuer *common.Address `json:"issuer,omitempty" rlp:"nil"`
 	Name   string          `json:"name"`
 	Symbol string          `json:"symbol"`
 	Supply *big.Int        `json:"supply"`
 	Desc   string          `json:"desc"`
+
+	MaxSupply   *big.Int `json:"maxSupply,omitempty"`   // cap of the circulating supply, nil = uncapped
+	FixedSupply bool     `json:"fixedSupply,omitempty"` // the issuer can not mint beyond the initial supply
+
+	Decimals *uint8 `json:"decimals,omitempty"` // display precision of balances, nil = DefaultAssetDecimals
+	Icon     string `json:"icon,omitempty"`     // URL of the asset logo
+	URL      string `json:"url,omitempty"`      // URL of the asset website
+
+	Freezable bool `json:"freezable,omitempty"` // the issuer can freeze transfers, globally or per account
+	Whitelist bool `json:"whitelist,omitempty"` // only accounts whitelisted by the issuer can hold the asset
+}
+
+// GetDecimals returns the display precision of the asset balances.
+func (ai *AssetInfo) GetDecimals() uint8 {
+	if ai.Decimals == nil {
+		return DefaultAssetDecimals
+	}
+	return *ai.Decimals
+}
+
+// UpdateMetadata replaces the updatable metadata, the description, icon and
+// URL, with those set in update; the ones left empty are kept. Everything
+// else of an asset is fixed once published.
+func (ai *AssetInfo) UpdateMetadata(update *AssetInfo) {
+	if update.Desc != "" {
+		ai.Desc = update.Desc
+	}
+	if update.Icon != "" {
+		ai.Icon = update.Icon
+	}
+	if update.URL != "" {
+		ai.URL = update.URL
+	}
+}
+
+// IsMetadataValid checks the decimals and metadata of an asset info.
+func IsMetadataValid(ai *AssetInfo) error {
+	if ai.Decimals != nil && *ai.Decimals > AssetMaxDecimals {
+		return fmt.Errorf("decimals %d above maximum %d", *ai.Decimals, AssetMaxDecimals)
+	}
+	if len(ai.Desc) > AssetDescMaxLen || len(ai.Icon) > AssetURLMaxLen || len(ai.URL) > AssetURLMaxLen {
+		return fmt.Errorf("Asset desc or icon or url is too long. maxDescLen: %d; maxURLLen: %d", AssetDescMaxLen, AssetURLMaxLen)
+	}
+	return nil
+}
+
+// Flags returns the control flags requested by the asset info at publish time.
+func (ai *AssetInfo) Flags() uint64 {
+	var flags uint64
+	if !ai.FixedSupply {
+		flags |= AssetFlagMintable
+	}
+	if ai.Freezable {
+		flags |= AssetFlagFreezable
+	}
+	if ai.Whitelist {
+		flags |= AssetFlagWhitelist
+	}
+	return flags
+}
+
+// IsSupplyValid checks the supply controls of an asset info that is going to
+// be published.
+func IsSupplyValid(ai *AssetInfo) error {
+	if ai.MaxSupply != nil && ai.MaxSupply.Cmp(ai.Supply) < 0 {
+		return fmt.Errorf("max supply %v below supply %v", ai.MaxSupply, ai.Supply)
+	}
+	return nil
 }
 
 func IsAssetInfoValid(ai *AssetInfo) error {
@@ -66,3 +155,11 @@ func IsAssetInfoValid(ai *AssetInfo) error {
 		return fmt.Errorf("assetInfo is Nil")
 	}
 }
+
+// FeePoolUpdate is the payload of an ActionSetFeePool transaction. The fee pool
+// of an asset pays the gas of the transactions naming the asset in AOA and
+// charges their senders Rate units of the asset per AOA of gas instead.
+type FeePoolUpdate struct {
+	Rate     *big.Int // asset units charged per AOA (1e18 wei) of gas, zero disables the pool
+	Withdraw *big.Int // AOA taken out of the pool back to the issuer
+}
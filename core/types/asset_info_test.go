@@ -0,0 +1,83 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestIsSupplyValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		info  AssetInfo
+		valid bool
+	}{
+		{"uncapped", AssetInfo{Supply: big.NewInt(1000)}, true},
+		{"cap above supply", AssetInfo{Supply: big.NewInt(1000), MaxSupply: big.NewInt(1500)}, true},
+		{"cap at supply", AssetInfo{Supply: big.NewInt(1000), MaxSupply: big.NewInt(1000)}, true},
+		{"cap below supply", AssetInfo{Supply: big.NewInt(1000), MaxSupply: big.NewInt(999)}, false},
+		{"fixed supply", AssetInfo{Supply: big.NewInt(1000), FixedSupply: true}, true},
+		{"fixed supply below cap", AssetInfo{Supply: big.NewInt(1000), MaxSupply: big.NewInt(1500), FixedSupply: true}, true},
+	}
+	for _, test := range tests {
+		if err := IsSupplyValid(&test.info); (err == nil) != test.valid {
+			t.Errorf("%s: validity mismatch: have %v, want valid %v", test.name, err, test.valid)
+		}
+	}
+}
+
+func TestAssetInfoFlags(t *testing.T) {
+	tests := []struct {
+		info AssetInfo
+		want uint64
+	}{
+		{AssetInfo{}, AssetFlagMintable},
+		{AssetInfo{FixedSupply: true}, 0},
+		{AssetInfo{FixedSupply: true, Freezable: true}, AssetFlagFreezable},
+		{AssetInfo{Whitelist: true}, AssetFlagMintable | AssetFlagWhitelist},
+	}
+	for i, test := range tests {
+		if have := test.info.Flags(); have != test.want {
+			t.Errorf("test %d: flags mismatch: have %#x, want %#x", i, have, test.want)
+		}
+	}
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	published := AssetInfo{Name: "Dollar", Symbol: "USDX", Desc: "a dollar", Icon: "https://usdx.example/icon.png", URL: "https://usdx.example"}
+	tests := []struct {
+		update AssetInfo
+		want   AssetInfo
+	}{
+		{AssetInfo{}, published},
+		{AssetInfo{Desc: "a stablecoin"}, AssetInfo{Desc: "a stablecoin", Icon: published.Icon, URL: published.URL}},
+		{AssetInfo{Icon: "https://usdx.example/logo.png", URL: "https://usdx.org"}, AssetInfo{Desc: published.Desc, Icon: "https://usdx.example/logo.png", URL: "https://usdx.org"}},
+		// only the metadata can change
+		{AssetInfo{Name: "Euro", Symbol: "EURX"}, published},
+	}
+	for i, test := range tests {
+		ai := published
+		ai.UpdateMetadata(&test.update)
+		if ai.Name != published.Name || ai.Symbol != published.Symbol {
+			t.Errorf("test %d: fixed fields changed: name %s symbol %s", i, ai.Name, ai.Symbol)
+		}
+		if ai.Desc != test.want.Desc || ai.Icon != test.want.Icon || ai.URL != test.want.URL {
+			t.Errorf("test %d: metadata mismatch: have %q %q %q, want %q %q %q", i, ai.Desc, ai.Icon, ai.URL, test.want.Desc, test.want.Icon, test.want.URL)
+		}
+	}
+}
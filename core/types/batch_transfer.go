@@ -0,0 +1,95 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+	"math/big"
+)
+
+var (
+	errEmptyBatchTransfer    = errors.New("empty batch transfer")
+	errBatchTransferTooLarge = errors.New("too many batch transfer recipients")
+	errBatchTransferAmount   = errors.New("invalid batch transfer amount")
+)
+
+// BatchTransferEntry is one leg of a batch transfer transaction. A nil or zero
+// asset transfers AOA.
+type BatchTransferEntry struct {
+	To     common.Address
+	Asset  *common.Address `rlp:"nil"`
+	Amount *big.Int
+}
+
+// AssetAddress returns the asset moved by the transfer, or nil for AOA.
+func (bt *BatchTransferEntry) AssetAddress() *common.Address {
+	if bt.Asset == nil || *bt.Asset == (common.Address{}) {
+		return nil
+	}
+	return bt.Asset
+}
+
+// CountBatchTransfers returns the number of legs in a batch transfer payload
+// without decoding them.
+func CountBatchTransfers(data []byte) (int, error) {
+	content, _, err := rlp.SplitList(data)
+	if err != nil {
+		return 0, err
+	}
+	return rlp.CountValues(content)
+}
+
+// DecodeBatchTransfers decodes and checks the payload of a batch transfer
+// transaction.
+func DecodeBatchTransfers(data []byte) ([]BatchTransferEntry, error) {
+	var transfers []BatchTransferEntry
+	if err := rlp.DecodeBytes(data, &transfers); err != nil {
+		return nil, err
+	}
+	if len(transfers) == 0 {
+		return nil, errEmptyBatchTransfer
+	}
+	if len(transfers) > params.MaxBatchTransfers {
+		return nil, errBatchTransferTooLarge
+	}
+	for _, bt := range transfers {
+		if bt.Amount == nil || bt.Amount.Sign() <= 0 {
+			return nil, errBatchTransferAmount
+		}
+	}
+	return transfers, nil
+}
+
+// BatchTransferAmounts sums up the amounts of a batch transfer per asset, the
+// AOA total is kept under the zero address.
+func BatchTransferAmounts(transfers []BatchTransferEntry) map[common.Address]*big.Int {
+	totals := make(map[common.Address]*big.Int)
+	for _, bt := range transfers {
+		var asset common.Address
+		if a := bt.AssetAddress(); a != nil {
+			asset = *a
+		}
+		if totals[asset] == nil {
+			totals[asset] = new(big.Int)
+		}
+		totals[asset].Add(totals[asset], bt.Amount)
+	}
+	return totals
+}
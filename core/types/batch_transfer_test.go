@@ -0,0 +1,81 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/params"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+)
+
+func TestDecodeBatchTransfers(t *testing.T) {
+	var (
+		to    = common.HexToAddress("0x1")
+		asset = common.HexToAddress("0xa55e7")
+	)
+	encode := func(transfers []BatchTransferEntry) []byte {
+		data, err := rlp.EncodeToBytes(transfers)
+		if err != nil {
+			t.Fatalf("failed to encode transfers: %v", err)
+		}
+		return data
+	}
+	tooMany := make([]BatchTransferEntry, params.MaxBatchTransfers+1)
+	for i := range tooMany {
+		tooMany[i] = BatchTransferEntry{To: to, Amount: big.NewInt(1)}
+	}
+	tests := []struct {
+		name string
+		data []byte
+		legs int
+		err  error
+	}{
+		{"aoa", encode([]BatchTransferEntry{{To: to, Amount: big.NewInt(1)}}), 1, nil},
+		{"mixed", encode([]BatchTransferEntry{{To: to, Amount: big.NewInt(1)}, {To: to, Asset: &asset, Amount: big.NewInt(2)}}), 2, nil},
+		{"largest", encode(tooMany[1:]), params.MaxBatchTransfers, nil},
+		{"empty", encode(nil), 0, errEmptyBatchTransfer},
+		{"too many", encode(tooMany), 0, errBatchTransferTooLarge},
+		{"zero amount", encode([]BatchTransferEntry{{To: to, Amount: big.NewInt(1)}, {To: to, Amount: new(big.Int)}}), 0, errBatchTransferAmount},
+	}
+	for _, test := range tests {
+		transfers, err := DecodeBatchTransfers(test.data)
+		if err != test.err {
+			t.Errorf("%s: error mismatch: have %v, want %v", test.name, err, test.err)
+		} else if len(transfers) != test.legs {
+			t.Errorf("%s: transfer count mismatch: have %d, want %d", test.name, len(transfers), test.legs)
+		}
+	}
+	if _, err := DecodeBatchTransfers([]byte{0x01, 0x02}); err == nil {
+		t.Errorf("decoded invalid rlp")
+	}
+}
+
+func TestBatchTransferAmounts(t *testing.T) {
+	asset := common.HexToAddress("0xa55e7")
+	totals := BatchTransferAmounts([]BatchTransferEntry{
+		{Amount: big.NewInt(1)},
+		{Asset: &asset, Amount: big.NewInt(2)},
+		{Asset: &common.Address{}, Amount: big.NewInt(4)},
+		{Asset: &asset, Amount: big.NewInt(8)},
+	})
+	if len(totals) != 2 || totals[common.Address{}].Int64() != 5 || totals[asset].Int64() != 10 {
+		t.Errorf("totals mismatch: have %v", totals)
+	}
+}
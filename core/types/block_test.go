@@ -26,9 +26,9 @@ import (
 	"testing"
 )
 
-// from bcValidBlockTest.json, "SimpleTx"
+// the header of bcValidBlockTest.json "SimpleTx" in the dpos header encoding
 func TestBlockEncoding(t *testing.T) {
-	blockEnc := common.FromHex("f901faf901f3a0d2e91d3554d254eb6a3db17ea03bc8d2af305eab483a777a23fd7181ba29b563948888f1f195afa192cfee860698584c030f4c9db1a00000000000000000000000000000000000000000000000000000000000000000a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421b9010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000018261a880845afbaa3e8088000000000000000080a00000000000000000000000000000000000000000000000000000000000000000a00000000000000000000000000000000000000000000000000000000000000000c0806180")
+	blockEnc := common.FromHex("f901f5f901f0a0d2e91d3554d254eb6a3db17ea03bc8d2af305eab483a777a23fd7181ba29b563948888f1f195afa192cfee860698584c030f4c9db1a00000000000000000000000000000000000000000000000000000000000000000a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421a056e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421b9010000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000018261a880845afbaa3e80856e6f646531a00000000000000000000000000000000000000000000000000000000000000000a0000000000000000000000000000000000000000000000000000000000000000080c080")
 	var block Block
 	if err := rlp.DecodeBytes(blockEnc, &block); err != nil {
 		t.Fatal("block decode error: ", err)
@@ -45,7 +45,8 @@ func TestBlockEncoding(t *testing.T) {
 	check("Coinbase", block.Coinbase(), common.HexToAddress("0x8888f1f195afa192cfee860698584c030f4c9db1"))
 	check("Root", block.Root(), common.HexToHash("0000000000000000000000000000000000000000000000000000000000000000"))
 	check("DelegateRoot", block.DelegateRoot(), common.HexToHash("0000000000000000000000000000000000000000000000000000000000000000"))
-	check("Hash", block.Hash(), common.HexToHash("0xa5d7262d4a1fc3bbb5090228cdaa6239b68cf54ee55741173f7a4f177363662c"))
+	check("AgentName", block.Header().AgentName, []byte("node1"))
+	check("Hash", block.Hash(), common.HexToHash("0x4d18c001bc02eb1934bfa066adda8b140616f6d15f60a325753cbcf93fe655e5"))
 
 	check("Time", block.Time(), big.NewInt(1526442558))
 	check("Size", block.Size(), common.StorageSize(len(blockEnc)))
@@ -40,6 +40,12 @@ type ShuffleList struct {
 	ShuffleDels []ShuffleDel `json:"shuffle_dels" gencodec:"required"`
 }
 
+// Hash returns the rlp hash of the shuffle list, which is committed to by
+// the ShuffleHash of every block produced in the round.
+func (s *ShuffleList) Hash() common.Hash {
+	return rlpHash(s)
+}
+
 type Candidate struct {
 	Address      string `json:"address"`
 	Vote         uint64 `json:"vote"`
@@ -0,0 +1,81 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"errors"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/rlp"
+)
+
+var errMissingEvidenceHeader = errors.New("evidence header missing")
+
+// DoubleSignEvidence proves that a delegate signed two different blocks for the
+// same slot. The signatures are either the producer signatures of the blocks or
+// the VoteSign confirmations sent while the blocks were locked, Confirm tells
+// which of the two.
+type DoubleSignEvidence struct {
+	HeaderA *Header
+	SignA   []byte
+	HeaderB *Header
+	SignB   []byte
+	Confirm bool
+}
+
+// DecodeDoubleSignEvidence decodes the evidence carried in the data of a slash transaction.
+func DecodeDoubleSignEvidence(data []byte) (*DoubleSignEvidence, error) {
+	evidence := new(DoubleSignEvidence)
+	if err := rlp.DecodeBytes(data, evidence); err != nil {
+		return nil, err
+	}
+	if evidence.HeaderA == nil || evidence.HeaderB == nil {
+		return nil, errMissingEvidenceHeader
+	}
+	return evidence, nil
+}
+
+// Hash returns the keccak256 hash of the evidence's RLP encoding.
+func (e *DoubleSignEvidence) Hash() common.Hash {
+	return rlpHash(e)
+}
+
+// Signers recovers the addresses which signed the two headers.
+func (e *DoubleSignEvidence) Signers() (common.Address, common.Address, error) {
+	if e.HeaderA == nil || e.HeaderB == nil {
+		return common.Address{}, common.Address{}, errMissingEvidenceHeader
+	}
+	signerA, err := recoverHeaderSigner(e.HeaderA, e.SignA)
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	signerB, err := recoverHeaderSigner(e.HeaderB, e.SignB)
+	if err != nil {
+		return common.Address{}, common.Address{}, err
+	}
+	return signerA, signerB, nil
+}
+
+// recoverHeaderSigner returns the address which signed the block hash, blocks and
+// confirmations are both signed over the plain block hash.
+func recoverHeaderSigner(header *Header, sign []byte) (common.Address, error) {
+	pubkey, err := crypto.SigToPub(header.Hash().Bytes(), sign)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
@@ -5,6 +5,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"math/big"
 
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
@@ -15,15 +16,17 @@ var _ = (*receiptMarshaling)(nil)
 // MarshalJSON marshals as JSON.
 func (r Receipt) MarshalJSON() ([]byte, error) {
 	type Receipt struct {
-		PostState         hexutil.Bytes  `json:"root"`
-		Status            hexutil.Uint   `json:"status"`
-		CumulativeGasUsed hexutil.Uint64 `json:"cumulativeGasUsed" gencodec:"required"`
-		Bloom             Bloom          `json:"logsBloom"         gencodec:"required"`
-		Logs              []*Log         `json:"logs"              gencodec:"required"`
-		TxHash            common.Hash    `json:"transactionHash" gencodec:"required"`
-		ContractAddress   common.Address `json:"contractAddress"`
-		GasUsed           hexutil.Uint64 `json:"gasUsed" gencodec:"required"`
-		Action            uint64         `json:"action"`
+		PostState         hexutil.Bytes   `json:"root"`
+		Status            hexutil.Uint    `json:"status"`
+		CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed" gencodec:"required"`
+		Bloom             Bloom           `json:"logsBloom"         gencodec:"required"`
+		Logs              []*Log          `json:"logs"              gencodec:"required"`
+		TxHash            common.Hash     `json:"transactionHash" gencodec:"required"`
+		ContractAddress   common.Address  `json:"contractAddress"`
+		GasUsed           hexutil.Uint64  `json:"gasUsed" gencodec:"required"`
+		Action            uint64          `json:"action"`
+		FeeAsset          *common.Address `json:"feeAsset,omitempty"`
+		FeeAmount         *hexutil.Big    `json:"feeAmount,omitempty"`
 	}
 	var enc Receipt
 	enc.PostState = r.PostState
@@ -35,6 +38,8 @@ func (r Receipt) MarshalJSON() ([]byte, error) {
 	enc.ContractAddress = r.ContractAddress
 	enc.GasUsed = hexutil.Uint64(r.GasUsed)
 	enc.Action = r.Action
+	enc.FeeAsset = r.FeeAsset
+	enc.FeeAmount = (*hexutil.Big)(r.FeeAmount)
 	return json.Marshal(&enc)
 }
 
@@ -50,6 +55,8 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 		ContractAddress   *common.Address `json:"contractAddress"`
 		GasUsed           *hexutil.Uint64 `json:"gasUsed" gencodec:"required"`
 		Action            *uint64         `json:"action"`
+		FeeAsset          *common.Address `json:"feeAsset,omitempty"`
+		FeeAmount         *hexutil.Big    `json:"feeAmount,omitempty"`
 	}
 	var dec Receipt
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -87,5 +94,11 @@ func (r *Receipt) UnmarshalJSON(input []byte) error {
 	if dec.Action != nil {
 		r.Action = *dec.Action
 	}
+	if dec.FeeAsset != nil {
+		r.FeeAsset = dec.FeeAsset
+	}
+	if dec.FeeAmount != nil {
+		r.FeeAmount = (*big.Int)(dec.FeeAmount)
+	}
 	return nil
 }
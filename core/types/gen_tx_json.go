@@ -33,6 +33,7 @@ func (t txdata) MarshalJSON() ([]byte, error) {
 		R            *hexutil.Big    `json:"r" gencodec:"required"`
 		S            *hexutil.Big    `json:"s" gencodec:"required"`
 		Hash         *common.Hash    `json:"hash" rlp:"-"`
+		MaxFeeRate   []*hexutil.Big  `json:"maxFeeRate,omitempty" rlp:"tail"`
 	}
 	var enc txdata
 	enc.AccountNonce = hexutil.Uint64(t.AccountNonce)
@@ -52,6 +53,12 @@ func (t txdata) MarshalJSON() ([]byte, error) {
 	enc.R = (*hexutil.Big)(t.R)
 	enc.S = (*hexutil.Big)(t.S)
 	enc.Hash = t.Hash
+	if t.MaxFeeRate != nil {
+		enc.MaxFeeRate = make([]*hexutil.Big, len(t.MaxFeeRate))
+		for k, v := range t.MaxFeeRate {
+			enc.MaxFeeRate[k] = (*hexutil.Big)(v)
+		}
+	}
 	return json.Marshal(&enc)
 }
 
@@ -75,6 +82,7 @@ func (t *txdata) UnmarshalJSON(input []byte) error {
 		R            *hexutil.Big    `json:"r" gencodec:"required"`
 		S            *hexutil.Big    `json:"s" gencodec:"required"`
 		Hash         *common.Hash    `json:"hash" rlp:"-"`
+		MaxFeeRate   []*hexutil.Big  `json:"maxFeeRate,omitempty" rlp:"tail"`
 	}
 	var dec txdata
 	if err := json.Unmarshal(input, &dec); err != nil {
@@ -140,5 +148,11 @@ func (t *txdata) UnmarshalJSON(input []byte) error {
 	if dec.Hash != nil {
 		t.Hash = dec.Hash
 	}
+	if dec.MaxFeeRate != nil {
+		t.MaxFeeRate = make([]*big.Int, len(dec.MaxFeeRate))
+		for k, v := range dec.MaxFeeRate {
+			t.MaxFeeRate[k] = (*big.Int)(v)
+		}
+	}
 	return nil
 }
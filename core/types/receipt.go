@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"math/big"
 
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
@@ -51,10 +52,12 @@ type Receipt struct {
 	Logs              []*Log `json:"logs"              gencodec:"required"`
 
 	// Implementation fields (don't reorder!)
-	TxHash          common.Hash    `json:"transactionHash" gencodec:"required"`
-	ContractAddress common.Address `json:"contractAddress"`
-	GasUsed         uint64         `json:"gasUsed" gencodec:"required"`
-	Action          uint64         `json:"action"`
+	TxHash          common.Hash     `json:"transactionHash" gencodec:"required"`
+	ContractAddress common.Address  `json:"contractAddress"`
+	GasUsed         uint64          `json:"gasUsed" gencodec:"required"`
+	Action          uint64          `json:"action"`
+	FeeAsset        *common.Address `json:"feeAsset,omitempty"`  // asset the gas was paid in through its fee pool, nil for AOA
+	FeeAmount       *big.Int        `json:"feeAmount,omitempty"` // amount of the fee asset charged for the gas
 }
 
 type receiptMarshaling struct {
@@ -62,6 +65,7 @@ type receiptMarshaling struct {
 	Status            hexutil.Uint
 	CumulativeGasUsed hexutil.Uint64
 	GasUsed           hexutil.Uint64
+	FeeAmount         *hexutil.Big
 }
 
 // receiptRLP is the consensus encoding of a receipt.
@@ -81,6 +85,13 @@ type receiptStorageRLP struct {
 	Logs              []*LogForStorage
 	GasUsed           uint64
 	Action            uint64
+	Fee               []receiptFeeRLP `rlp:"tail"` // empty unless the gas was paid from an asset fee pool
+}
+
+// receiptFeeRLP is the storage encoding of the gas paid in an asset.
+type receiptFeeRLP struct {
+	Asset  common.Address
+	Amount *big.Int
 }
 
 // NewReceipt creates a barebone transaction receipt, copying the init fields.
@@ -166,6 +177,9 @@ func (r *ReceiptForStorage) EncodeRLP(w io.Writer) error {
 	for i, log := range r.Logs {
 		enc.Logs[i] = (*LogForStorage)(log)
 	}
+	if r.FeeAsset != nil {
+		enc.Fee = []receiptFeeRLP{{Asset: *r.FeeAsset, Amount: r.FeeAmount}}
+	}
 	return rlp.Encode(w, enc)
 }
 
@@ -187,6 +201,10 @@ func (r *ReceiptForStorage) DecodeRLP(s *rlp.Stream) error {
 	}
 	// Assign the implementation fields
 	r.TxHash, r.ContractAddress, r.GasUsed, r.Action = dec.TxHash, dec.ContractAddress, dec.GasUsed, dec.Action
+	if len(dec.Fee) > 0 {
+		asset := dec.Fee[0].Asset
+		r.FeeAsset, r.FeeAmount = &asset, dec.Fee[0].Amount
+	}
 	return nil
 }
 
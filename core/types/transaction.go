@@ -44,18 +44,45 @@ const (
 	ActionPublishAsset
 	ActionCreateContract
 	ActionCallContract
+	ActionUnjail
+	ActionSlash
+	ActionUnregister
+	ActionSetCommission
+	ActionClaimReward
+	ActionMintAsset
+	ActionBurnAsset
+	ActionUpdateAsset
+	ActionFreezeAsset
+	ActionWhitelistAsset
+	ActionSetFeePool
+	ActionBatchTransfer
+	ActionApproveAsset
 )
 
 const (
-	RegisterAgent  = "Register Agent"
-	VoteAgent      = "Vote Agent"
-	CreateContract = "Create Contract"
-	PublishAsset   = "Publish Asset"
+	RegisterAgent   = "Register Agent"
+	VoteAgent       = "Vote Agent"
+	CreateContract  = "Create Contract"
+	PublishAsset    = "Publish Asset"
+	UnjailAgent     = "Unjail Agent"
+	SlashAgent      = "Slash Agent"
+	UnregisterAgent = "Unregister Agent"
+	CommissionAgent = "Commission Agent"
+	ClaimReward     = "Claim Reward"
+	MintAsset       = "Mint Asset"
+	BurnAsset       = "Burn Asset"
+	UpdateAsset     = "Update Asset"
+	FreezeAsset     = "Freeze Asset"
+	WhitelistAsset  = "Whitelist Asset"
+	SetFeePool      = "Set Fee Pool"
+	BatchTransfer   = "Batch Transfer"
+	ApproveAsset    = "Approve Asset"
 )
 
 var (
 	ErrInvalidSig = errors.New("invalid transaction v, r, s values")
 	errNoSigner   = errors.New("missing signing methods")
+	errMaxFeeRate = errors.New("more than one max fee rate")
 )
 
 // deriveSigner makes a *best* guess about which signer to use.
@@ -143,6 +170,11 @@ type txdata struct {
 
 	// This is only used when marshaling to JSON.
 	Hash *common.Hash `json:"hash" rlp:"-"`
+
+	// The highest asset fee pool rate the sender accepts to pay the gas at, the
+	// gas is paid in AOA when empty. It trails the signature and holds at most
+	// one element so the transactions without it keep their encoding.
+	MaxFeeRate []*big.Int `json:"maxFeeRate,omitempty" rlp:"tail"`
 }
 
 type txdataMarshaling struct {
@@ -170,6 +202,85 @@ func NewVoteTransaction(nonce uint64, gasLimit uint64, gasPrice *big.Int, action
 	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, nil, action, vote, nil, nil, nil, "", "")
 }
 
+// create unjail delegate transaction
+func NewUnjailTransaction(nonce uint64, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, nil, ActionUnjail, nil, nil, nil, nil, "", "")
+}
+
+// create unregister delegate transaction
+func NewUnregisterTransaction(nonce uint64, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, nil, ActionUnregister, nil, nil, nil, nil, "", "")
+}
+
+// create set commission transaction, commission is given in basis points
+func NewCommissionTransaction(nonce uint64, gasLimit uint64, gasPrice *big.Int, commission uint64) *Transaction {
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, new(big.Int).SetUint64(commission).Bytes(), ActionSetCommission, nil, nil, nil, nil, "", "")
+}
+
+// create claim voter reward transaction
+func NewClaimRewardTransaction(nonce uint64, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, nil, ActionClaimReward, nil, nil, nil, nil, "", "")
+}
+
+// create asset mint transaction, the minted amount is credited to the recipient
+func NewMintAssetTransaction(nonce uint64, to common.Address, asset common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, &to, amount, gasLimit, gasPrice, nil, ActionMintAsset, nil, nil, &asset, nil, "", "")
+}
+
+// create asset burn transaction, the amount is taken from the sender
+func NewBurnAssetTransaction(nonce uint64, asset common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, nil, amount, gasLimit, gasPrice, nil, ActionBurnAsset, nil, nil, &asset, nil, "", "")
+}
+
+// create asset metadata update transaction, assetInfo carries the new metadata
+func NewUpdateAssetTransaction(nonce uint64, asset common.Address, assetInfo []byte, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, nil, ActionUpdateAsset, nil, nil, &asset, assetInfo, "", "")
+}
+
+// create asset freeze transaction, it freezes or unfreezes transfers of the
+// given account or, if account is nil, of everyone but the issuer
+func NewFreezeAssetTransaction(nonce uint64, asset common.Address, account *common.Address, freeze bool, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, account, big.NewInt(0), gasLimit, gasPrice, flagData(freeze), ActionFreezeAsset, nil, nil, &asset, nil, "", "")
+}
+
+// create asset whitelist transaction, it adds the account to or removes it from
+// the holders whitelist of the asset
+func NewWhitelistAssetTransaction(nonce uint64, asset common.Address, account common.Address, add bool, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, &account, big.NewInt(0), gasLimit, gasPrice, flagData(add), ActionWhitelistAsset, nil, nil, &asset, nil, "", "")
+}
+
+// create asset fee pool transaction, amount is deposited into the fee pool of
+// the asset and update carries its exchange rate and the amount withdrawn
+func NewSetFeePoolTransaction(nonce uint64, asset common.Address, amount *big.Int, update FeePoolUpdate, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	data, _ := rlp.EncodeToBytes(&update)
+	return newTransaction(nonce, nil, amount, gasLimit, gasPrice, data, ActionSetFeePool, nil, nil, &asset, nil, "", "")
+}
+
+// create batch transfer transaction, paying out AOA and assets to all the
+// recipients of the transfers at once
+func NewBatchTransferTransaction(nonce uint64, transfers []BatchTransferEntry, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	data, _ := rlp.EncodeToBytes(transfers)
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, data, ActionBatchTransfer, nil, nil, nil, nil, "", "")
+}
+
+// create asset approval transaction, allowing spender to take up to amount of
+// the asset from the sender
+func NewApproveAssetTransaction(nonce uint64, asset common.Address, spender common.Address, amount *big.Int, gasLimit uint64, gasPrice *big.Int) *Transaction {
+	return newTransaction(nonce, &spender, amount, gasLimit, gasPrice, nil, ActionApproveAsset, nil, nil, &asset, nil, "", "")
+}
+
+func flagData(set bool) []byte {
+	if set {
+		return []byte{1}
+	}
+	return nil
+}
+
+// create double-sign evidence transaction, data is the rlp encoded DoubleSignEvidence
+func NewSlashTransaction(nonce uint64, gasLimit uint64, gasPrice *big.Int, evidence []byte) *Transaction {
+	return newTransaction(nonce, nil, big.NewInt(0), gasLimit, gasPrice, evidence, ActionSlash, nil, nil, nil, nil, "", "")
+}
+
 // create publish asset transaction
 func NewPublishAssetTransaction(nonce uint64, amount *big.Int, gasLimit uint64, gasPrice *big.Int, action uint64, assetInfo []byte) *Transaction {
 	return newTransaction(nonce, nil, amount, gasLimit, gasPrice, nil, action, nil, nil, nil, assetInfo, "", "")
@@ -234,6 +345,32 @@ func (tx *Transaction) GetTransactionType() common.Address {
 		return common.StringToAddress(CreateContract)
 	case ActionCallContract:
 		return *tx.To()
+	case ActionUnjail:
+		return common.StringToAddress(UnjailAgent)
+	case ActionSlash:
+		return common.StringToAddress(SlashAgent)
+	case ActionUnregister:
+		return common.StringToAddress(UnregisterAgent)
+	case ActionSetCommission:
+		return common.StringToAddress(CommissionAgent)
+	case ActionClaimReward:
+		return common.StringToAddress(ClaimReward)
+	case ActionMintAsset:
+		return common.StringToAddress(MintAsset)
+	case ActionBurnAsset:
+		return common.StringToAddress(BurnAsset)
+	case ActionUpdateAsset:
+		return common.StringToAddress(UpdateAsset)
+	case ActionFreezeAsset:
+		return common.StringToAddress(FreezeAsset)
+	case ActionWhitelistAsset:
+		return common.StringToAddress(WhitelistAsset)
+	case ActionSetFeePool:
+		return common.StringToAddress(SetFeePool)
+	case ActionBatchTransfer:
+		return common.StringToAddress(BatchTransfer)
+	case ActionApproveAsset:
+		return common.StringToAddress(ApproveAsset)
 	default:
 		return common.StringToAddress(PublishAsset)
 	}
@@ -262,6 +399,9 @@ func (tx *Transaction) EncodeRLP(w io.Writer) error {
 func (tx *Transaction) DecodeRLP(s *rlp.Stream) error {
 	_, size, _ := s.Kind()
 	err := s.Decode(&tx.data)
+	if err == nil && len(tx.data.MaxFeeRate) > 1 {
+		return errMaxFeeRate
+	}
 	if err == nil {
 		tx.size.Store(common.StorageSize(rlp.ListSize(size)))
 	}
@@ -290,6 +430,9 @@ func (tx *Transaction) UnmarshalJSON(input []byte) error {
 	if !crypto.ValidateSignatureValues(V, dec.R, dec.S, false) {
 		return ErrInvalidSig
 	}
+	if len(dec.MaxFeeRate) > 1 {
+		return errMaxFeeRate
+	}
 	*tx = Transaction{data: dec}
 	return nil
 }
@@ -311,6 +454,23 @@ func (tx *Transaction) Asset() *common.Address {
 	return &a
 }
 func (tx *Transaction) SubAddress() string { return tx.data.SubAddress }
+
+// MaxFeeRate returns the highest asset fee pool rate the sender accepts to pay
+// the gas at, or nil if the gas is paid in AOA.
+func (tx *Transaction) MaxFeeRate() *big.Int {
+	if len(tx.data.MaxFeeRate) == 0 {
+		return nil
+	}
+	return new(big.Int).Set(tx.data.MaxFeeRate[0])
+}
+
+// WithMaxFeeRate returns a copy of the unsigned transaction paying its gas from
+// the fee pool of its asset at no more than the given rate.
+func (tx *Transaction) WithMaxFeeRate(rate *big.Int) *Transaction {
+	cpy := &Transaction{data: tx.data}
+	cpy.data.MaxFeeRate = []*big.Int{new(big.Int).Set(rate)}
+	return cpy
+}
 func (tx *Transaction) AssetInfo() *AssetInfo {
 	if nil == tx.data.AssetInfo {
 		return nil
@@ -325,11 +485,26 @@ func (tx *Transaction) AssetInfo() *AssetInfo {
 		issuer.Set(*assetInfo.Issuer)
 	}
 	newai := AssetInfo{
-		Supply: new(big.Int).Set(assetInfo.Supply),
-		Name:   assetInfo.Name,
-		Symbol: assetInfo.Symbol,
-		Desc:   assetInfo.Desc,
-		Issuer: issuer,
+		Name:        assetInfo.Name,
+		Symbol:      assetInfo.Symbol,
+		Desc:        assetInfo.Desc,
+		Issuer:      issuer,
+		FixedSupply: assetInfo.FixedSupply,
+		Icon:        assetInfo.Icon,
+		URL:         assetInfo.URL,
+		Freezable:   assetInfo.Freezable,
+		Whitelist:   assetInfo.Whitelist,
+	}
+	// metadata updates carry no supply
+	if assetInfo.Supply != nil {
+		newai.Supply = new(big.Int).Set(assetInfo.Supply)
+	}
+	if assetInfo.MaxSupply != nil {
+		newai.MaxSupply = new(big.Int).Set(assetInfo.MaxSupply)
+	}
+	if assetInfo.Decimals != nil {
+		decimals := *assetInfo.Decimals
+		newai.Decimals = &decimals
 	}
 
 	return &newai
@@ -403,6 +578,7 @@ func (tx *Transaction) AsMessage(s Signer) (Message, error) {
 		asset:      tx.data.Asset,
 		subAddress: tx.data.SubAddress,
 		abi:        tx.data.Abi,
+		maxFeeRate: tx.MaxFeeRate(),
 	}
 	if len(tx.data.AssetInfo) > 0 {
 		assetInfo, err := BytesToAssetInfo(tx.data.AssetInfo)
@@ -430,19 +606,30 @@ func (tx *Transaction) WithSignature(signer Signer, sig []byte) (*Transaction, e
 	return cpy, nil
 }
 
-// EmCost returns em required.
-func (tx *Transaction) EmCost() *big.Int {
+// EmCost returns em required in block num.
+func (tx *Transaction) EmCost(config *params.ChainConfig, num *big.Int) *big.Int {
 	log.Info("Transaction|EmCost,", "transaction", tx.data)
 	total := new(big.Int).Mul(tx.data.Price, new(big.Int).SetUint64(tx.data.GasLimit))
 	// agent register cost
 	switch tx.data.Action {
 	case ActionTrans:
+		// from the asset fee pool fork on the amount of an asset transfer is
+		// paid in the asset
+		if tx.data.Asset == nil || *tx.data.Asset == (common.Address{}) || !config.IsAssetFeePool(num) {
+			total.Add(total, tx.data.Amount)
+		}
+	case ActionSetFeePool:
 		total.Add(total, tx.data.Amount)
+	case ActionBatchTransfer:
+		// assets paid out are checked against the asset balances
+		if transfers, err := DecodeBatchTransfers(tx.data.Payload); err == nil {
+			if amount := BatchTransferAmounts(transfers)[common.Address{}]; amount != nil {
+				total.Add(total, amount)
+			}
+		}
 	case ActionRegister:
-		registerCost := new(big.Int)
-		registerCost.SetString(params.TxGasAgentCreation, 10)
 		// 扣除100，精度是18位
-		total.Add(total, registerCost)
+		total.Add(total, config.RegisterCost(num))
 		log.Info("register agent cost", "total", total)
 	default:
 
@@ -779,6 +966,7 @@ type Message struct {
 	assetInfo  *AssetInfo
 	subAddress string
 	abi        string
+	maxFeeRate *big.Int
 }
 
 func NewMessage(from common.Address, to *common.Address, nonce uint64, amount *big.Int, gasLimit uint64, gasPrice *big.Int, data []byte, checkNonce bool, action uint64, vote []Vote, asset *common.Address, assetInfo *AssetInfo, subAddress string, abi string) Message {
@@ -819,3 +1007,7 @@ func (m Message) AssetInfo() AssetInfo {
 	return AssetInfo{}
 }
 func (m Message) Abi() string { return m.abi }
+
+// MaxFeeRate returns the highest asset fee pool rate the sender accepts to pay
+// the gas at, or nil if the gas is paid in AOA.
+func (m Message) MaxFeeRate() *big.Int { return m.maxFeeRate }
@@ -131,7 +131,7 @@ func (s AuroraSigner) Sender(tx *Transaction) (common.Address, error) {
 // Hash returns the hash to be signed by the sender.
 // It does not uniquely identify the transaction.
 func (s AuroraSigner) Hash(tx *Transaction) common.Hash {
-	return rlpHash([]interface{}{
+	fields := []interface{}{
 		tx.data.AccountNonce,
 		tx.data.Price,
 		tx.data.GasLimit,
@@ -146,7 +146,13 @@ func (s AuroraSigner) Hash(tx *Transaction) common.Hash {
 		tx.data.SubAddress,
 		tx.data.Abi,
 		s.chainId, uint(0), uint(0),
-	})
+	}
+	// the fee pool limit is only signed when set, keeping the hashes of the
+	// transactions paying in AOA unchanged
+	for _, rate := range tx.data.MaxFeeRate {
+		fields = append(fields, rate)
+	}
+	return rlpHash(fields)
 }
 
 // WithSignature returns a new transaction with the given signature. This signature
@@ -29,7 +29,7 @@ func TestEIP155Signing(t *testing.T) {
 	key, _ := crypto.GenerateKey()
 	addr := crypto.PubkeyToAddress(key.PublicKey)
 
-	signer := DacchainSigner{chainId: big.NewInt(18)}
+	signer := NewAuroraSigner(big.NewInt(18))
 	tx, err := SignTx(NewTransaction(0, addr, new(big.Int), 0, new(big.Int), nil, 0, nil, ""), signer, key)
 	if err != nil {
 		t.Fatal(err)
@@ -48,7 +48,7 @@ func TestEIP155ChainId(t *testing.T) {
 	key, _ := crypto.GenerateKey()
 	addr := crypto.PubkeyToAddress(key.PublicKey)
 
-	signer := DacchainSigner{chainId: big.NewInt(18)}
+	signer := NewAuroraSigner(big.NewInt(18))
 	tx, err := SignTx(NewTransaction(0, addr, new(big.Int), 0, new(big.Int), nil, 0, nil, ""), signer, key)
 	if err != nil {
 		t.Fatal(err)
@@ -57,36 +57,27 @@ func TestEIP155ChainId(t *testing.T) {
 	if tx.ChainId().Cmp(signer.chainId) != 0 {
 		t.Error("expected chainId to be", signer.chainId, "got", tx.ChainId())
 	}
-
-	tx = NewTransaction(0, addr, new(big.Int), 0, new(big.Int), nil, 0, nil, "")
-	tx, err = SignTx(tx, DacchainSigner{}, key)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	if tx.ChainId().Sign() != 0 {
-		t.Error("expected chain id to be 0 got", tx.ChainId())
-	}
 }
 
 func TestEIP155SigningVitalik(t *testing.T) {
-	// Test vectors come from http://vitalik.ca/files/eip155_testvec.txt
+	// The transactions of http://vitalik.ca/files/eip155_testvec.txt in the aurora
+	// encoding, each signed by the key hashed from its index
 	for i, test := range []struct {
 		txRlp, addr string
 	}{
-		{"f864808504a817c800825208943535353535353535353535353535353535353535808025a0044852b2a670ade5407e78fb2863c51de9fcb96542a07186fe3aeda6bb8a116da0044852b2a670ade5407e78fb2863c51de9fcb96542a07186fe3aeda6bb8a116d", "0xf0f6f18bca1b28cd68e4357452947e021241e9ce"},
-		{"f864018504a817c80182a410943535353535353535353535353535353535353535018025a0489efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bcaa0489efdaa54c0f20c7adf612882df0950f5a951637e0307cdcb4c672f298b8bc6", "0x23ef145a395ea3fa3deb533b8a9e1b4c6c25d112"},
-		{"f864028504a817c80282f618943535353535353535353535353535353535353535088025a02d7c5bef027816a800da1736444fb58a807ef4c9603b7848673f7e3a68eb14a5a02d7c5bef027816a800da1736444fb58a807ef4c9603b7848673f7e3a68eb14a5", "0x2e485e0c23b4c3c542628a5f672eeab0ad4888be"},
-		{"f865038504a817c803830148209435353535353535353535353535353535353535351b8025a02a80e1ef1d7842f27f2e6be0972bb708b9a135c38860dbe73c27c3486c34f4e0a02a80e1ef1d7842f27f2e6be0972bb708b9a135c38860dbe73c27c3486c34f4de", "0x82a88539669a3fd524d669e858935de5e5410cf0"},
-		{"f865048504a817c80483019a28943535353535353535353535353535353535353535408025a013600b294191fc92924bb3ce4b969c1e7e2bab8f4c93c3fc6d0a51733df3c063a013600b294191fc92924bb3ce4b969c1e7e2bab8f4c93c3fc6d0a51733df3c060", "0xf9358f2538fd5ccfeb848b64a96b743fcc930554"},
-		{"f865058504a817c8058301ec309435353535353535353535353535353535353535357d8025a04eebf77a833b30520287ddd9478ff51abbdffa30aa90a8d655dba0e8a79ce0c1a04eebf77a833b30520287ddd9478ff51abbdffa30aa90a8d655dba0e8a79ce0c1", "0xa8f7aba377317440bc5b26198a363ad22af1f3a4"},
-		{"f866068504a817c80683023e3894353535353535353535353535353535353535353581d88025a06455bf8ea6e7463a1046a0b52804526e119b4bf5136279614e0b1e8e296a4e2fa06455bf8ea6e7463a1046a0b52804526e119b4bf5136279614e0b1e8e296a4e2d", "0xf1f571dc362a0e5b2696b8e775f8491d3e50de35"},
-		{"f867078504a817c807830290409435353535353535353535353535353535353535358201578025a052f1a9b320cab38e5da8a8f97989383aab0a49165fc91c737310e4f7e9821021a052f1a9b320cab38e5da8a8f97989383aab0a49165fc91c737310e4f7e9821021", "0xd37922162ab7cea97c97a87551ed02c9a38b7332"},
-		{"f867088504a817c8088302e2489435353535353535353535353535353535353535358202008025a064b1702d9298fee62dfeccc57d322a463ad55ca201256d01f62b45b2e1c21c12a064b1702d9298fee62dfeccc57d322a463ad55ca201256d01f62b45b2e1c21c10", "0x9bddad43f934d313c2b79ca28a432dd2b7281029"},
-		{"f867098504a817c809830334509435353535353535353535353535353535353535358202d98025a052f8f61201b2b11a78d6e866abc9c3db2ae8631fa656bfe5cb53668255367afba052f8f61201b2b11a78d6e866abc9c3db2ae8631fa656bfe5cb53668255367afb", "0x3c24d7329e92f84f08556ceb6df1cdb0104ca49f"},
+		{"f86b808504a817c80082520894353535353535353535353535353535353535353580808080808080808025a0e6b3dc25c8a33d05d356db8bdc4974ba85b118c090291c0b6e78a5d24c527072a06948cc949316842517804796a5b2ba5df879c74ecb8673e8b0e96ad942a076c4", "0xa385d2e939787af0b304512b2b6d56364f1722fa"},
+		{"f86b018504a817c80182a41094353535353535353535353535353535353535353501808080808080808025a0f17704bf1290808f37881809db144e05daf000b29bc2104fec8a9bfceb997f6fa048224070ea05016d28fb918203caf163f48731e761ae7cc68f6027fafe2422f9", "0x3eea25034397b249a3ed8614bb4d0533e5b03594"},
+		{"f86b028504a817c80282f61894353535353535353535353535353535353535353508808080808080808026a036d3fc4da88025943e63b4e561c8372f41e2d2503d7b43807622c0515d2fd006a03357b65ff2064d930e94295968babaae03d5ce74f9c3342ec09acf8eaa742f4f", "0x2d00a0b9e25235acb6187fe54fa0fea812c77231"},
+		{"f86c038504a817c803830148209435353535353535353535353535353535353535351b808080808080808026a0e4296f542a24e8346977c5062403b361630fbd311f76c3aabc14db982d3c4c39a041ed7bfce181134987ea9589d2942d81c41dc8feae84e2e279c24d224edc0470", "0x019b4ee7ad22ffd4c215e5f424faf4c75577dc36"},
+		{"f86c048504a817c80483019a2894353535353535353535353535353535353535353540808080808080808026a00ead72a4ff5d0d8f68cae66c35209c3b989fdbdf694726f3c05daa8974a458e1a074ef741ac25f1581ef8cdb4653fe2d4356a80dd2791a3868de36ca683279393d", "0x64c05352ff46bb41b3454a4c804156cf7bf66b6e"},
+		{"f86c058504a817c8058301ec309435353535353535353535353535353535353535357d808080808080808025a09e20a0b29b5d06f21d01600abcb7b760313c7468b5771c203671502d24f5d8daa03a4887d85b9a120bba6a3b68dedfa55eaa2537b5e3af4a1446ad0a5e849a6650", "0xa3a156b94ae1af4dad318b3db452a0fbbfc4c980"},
+		{"f86d068504a817c80683023e3894353535353535353535353535353535353535353581d8808080808080808026a03daf091e40bd7351f10b30d1ffeca3854ff47c62416d2ba236f34cc3e93e7b05a058c7149ab98a59e09376e488fd865d01a14441f553293787bf944abdf7882aa1", "0x65b84d3dd25d4ae7fd511917d8691ba22fbb00a7"},
+		{"f86e078504a817c80783029040943535353535353535353535353535353535353535820157808080808080808025a0534b53c2de1642d7f0ea2509e9ad47c5025a62d706894ae8d260698e9261adb0a05ba04b9ac40c6ae09458d9555cc9f617469367093a2019c9549126534fd4e6d5", "0x6eebc39f68eb30f84f7c2efd8b0164dbb6fe6a01"},
+		{"f86e088504a817c8088302e248943535353535353535353535353535353535353535820200808080808080808025a03360359e7279947995ce5281d535b48708fe6734fc7a3f04626597f982d4b1d0a024d392db051f28438c3bfbadb156cc0f940295659e55182242435f722418304e", "0x0d7e9a33a78e0fda2b472473258ae0a268de2e10"},
+		{"f86e098504a817c809830334509435353535353535353535353535353535353535358202d9808080808080808026a01a101600b5f37fbb373ef035dec1a199bc52800b6c65444ea74f1fa24c9b7199a0553ee8a8c21f4098aa8265867b1dc055579b71521ad8d90aebd9891269f22982", "0x8e109f7d973bcc09a38b1d67223a5a38d30febee"},
 	} {
 
-		signer := DacchainSigner{chainId: big.NewInt(1)}
+		signer := NewAuroraSigner(big.NewInt(1))
 
 		var tx *Transaction
 		err := rlp.DecodeBytes(common.Hex2Bytes(test.txRlp), &tx)
@@ -116,17 +107,17 @@ func TestChainId(t *testing.T) {
 
 	var err error
 
-	tx, err = SignTx(tx, DacchainSigner{chainId: big.NewInt(1)}, key)
+	tx, err = SignTx(tx, NewAuroraSigner(big.NewInt(1)), key)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	_, err = Sender(DacchainSigner{chainId: big.NewInt(2)}, tx)
+	_, err = Sender(NewAuroraSigner(big.NewInt(2)), tx)
 	if err != ErrInvalidChainId {
 		t.Error("expected error:", ErrInvalidChainId)
 	}
 
-	_, err = Sender(DacchainSigner{chainId: big.NewInt(1)}, tx)
+	_, err = Sender(NewAuroraSigner(big.NewInt(1)), tx)
 	if err != nil {
 		t.Error("expected no error")
 	}
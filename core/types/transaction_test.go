@@ -28,6 +28,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
 	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/params"
 	"github.com/Aurorachain-io/go-aoa/rlp"
 	"io"
 	"regexp"
@@ -91,13 +92,13 @@ func defaultTestKey() (*ecdsa.PrivateKey, common.Address) {
 
 func TestRecipientEmpty(t *testing.T) {
 	_, addr := defaultTestKey()
-	tx, err := decodeTx(common.Hex2Bytes("f8498080808080011ca09b16de9d5bdee2cf56c28d16275a4da68cd30273e2525f3959f5d62557489921a0372ebd8fb3345f7db7b5a86d42e24d36e983e259b0664ceb8c227ec9af572f3d"))
+	tx, err := decodeTx(common.Hex2Bytes("f8508080808080010580808080808026a0625f5d77de2fb55d7b706c270b934ef7c08289573a9c19505c530d88a9c97c8ba03d2aa33d4f6a1755a41ba622ebe1d00ea12f2b9cc74be5cba73698fc8e8b637b"))
 	if err != nil {
 		t.Error(err)
 		t.FailNow()
 	}
 
-	from, err := Sender(DacchainSigner{}, tx)
+	from, err := Sender(NewAuroraSigner(common.Big1), tx)
 	if err != nil {
 		t.Error(err)
 		t.FailNow()
@@ -110,13 +111,13 @@ func TestRecipientEmpty(t *testing.T) {
 func TestRecipientNormal(t *testing.T) {
 	_, addr := defaultTestKey()
 
-	tx, err := decodeTx(common.Hex2Bytes("f85d80808094000000000000000000000000000000000000000080011ca0527c0d8f5c63f7b9f41324a7c8a563ee1190bcbf0dac8ab446291bdbf32f5c79a0552c4ef0a09a04395074dab9ed34d3fbfb843c2f2546cc30fe89ec143ca94ca6"))
+	tx, err := decodeTx(common.Hex2Bytes("f86480808094000000000000000000000000000000000000000080018080808080808025a0095fd0fe9a17a22d4a14872cf974743ab6fd27aa25903aadb33d0a03e497f8aaa02eddc7a3de6eae9c8bf70560a5f462b716dedf27cd5a7dc1abc989916b57b5d5"))
 	if err != nil {
 		t.Error(err)
 		t.FailNow()
 	}
 
-	from, err := Sender(DacchainSigner{}, tx)
+	from, err := Sender(NewAuroraSigner(common.Big1), tx)
 	if err != nil {
 		t.Error(err)
 		t.FailNow()
@@ -137,7 +138,7 @@ func TestTransactionPriceNonceSort(t *testing.T) {
 		keys[i], _ = crypto.GenerateKey()
 	}
 
-	signer := DacchainSigner{}
+	signer := NewAuroraSigner(common.Big1)
 	// Generate a batch of transactions with overlapping values, but shifted nonces
 	groups := map[common.Address]Transactions{}
 	for start, key := range keys {
@@ -203,7 +204,7 @@ func TestTransactionJSON(t *testing.T) {
 		t.Fatalf("could not generate key: %v", err)
 	}
 
-	signer := DacchainSigner{chainId: common.Big1}
+	signer := NewAuroraSigner(common.Big1)
 
 	for i := uint64(0); i < 25; i++ {
 		var tx *Transaction
@@ -334,7 +335,7 @@ func TestDeriveSha(t *testing.T) {
 	}
 	fmt.Println(enc)
 	var dec []string
-	err = json.Unmarshal(enc, dec)
+	err = json.Unmarshal(enc, &dec)
 	if err != nil {
 		fmt.Println(err)
 		return
@@ -370,7 +371,7 @@ func TestRecoverFromAddress(t *testing.T) {
 	fmt.Println(tx)
 	//
 	//recoverPlain(s.Hash(tx), tx.data.R, tx.data.S, V, true)
-	//var f DacchainSigner
+	//var f AuroraSigner
 	//addresses, err := f.Sender(tx)
 	//if err != nil {
 	//	t.Fatal(err)
@@ -412,3 +413,28 @@ func TestDacAddress(t *testing.T) {
 	}
 	fmt.Println(match)
 }
+
+func TestEmCostAssetTransfer(t *testing.T) {
+	config := *params.AllDacchainProtocolChanges
+	config.AssetFeePoolBlock = big.NewInt(10)
+	asset := common.HexToAddress("a55e7")
+	to := common.HexToAddress("1337")
+
+	tests := []struct {
+		asset  *common.Address
+		number int64
+		cost   int64
+	}{
+		{nil, 9, 22000},
+		{nil, 10, 22000},
+		// before the fee pool fork the amount of an asset transfer is charged in AOA
+		{&asset, 9, 22000},
+		{&asset, 10, 21000},
+	}
+	for i, test := range tests {
+		tx := NewTransaction(0, to, big.NewInt(1000), 21000, big.NewInt(1), nil, ActionTrans, test.asset, "")
+		if cost := tx.EmCost(&config, big.NewInt(test.number)); cost.Int64() != test.cost {
+			t.Errorf("test %d: cost mismatch: have %v, want %d", i, cost, test.cost)
+		}
+	}
+}
@@ -20,9 +20,12 @@ import (
 	"crypto/sha256"
 	"errors"
 	"math/big"
+	"strings"
 
+	"github.com/Aurorachain-io/go-aoa/accounts/abi"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/math"
+	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/crypto/bn256"
 	"github.com/Aurorachain-io/go-aoa/params"
@@ -50,6 +53,41 @@ var PrecompiledContracts = map[common.Address]PrecompiledContract{
 	common.BytesToAddress([]byte{8}): &bn256Pairing{},
 }
 
+// NativeAssetContractAddress is the address of the precompiled contract giving
+// contracts access to native assets through an ABI-encoded interface.
+var NativeAssetContractAddress = common.BytesToAddress([]byte{1, 0})
+
+// PrecompiledContractsAsset contains the default set of pre-compiled contracts
+// and the native asset contract, used from the asset precompile fork on.
+var PrecompiledContractsAsset = map[common.Address]PrecompiledContract{
+	common.BytesToAddress([]byte{1}): &ecrecover{},
+	common.BytesToAddress([]byte{2}): &sha256hash{},
+	common.BytesToAddress([]byte{3}): &ripemd160hash{},
+	common.BytesToAddress([]byte{4}): &dataCopy{},
+	common.BytesToAddress([]byte{5}): &bigModExp{},
+	common.BytesToAddress([]byte{6}): &bn256Add{},
+	common.BytesToAddress([]byte{7}): &bn256ScalarMul{},
+	common.BytesToAddress([]byte{8}): &bn256Pairing{},
+	NativeAssetContractAddress:       &nativeAsset{},
+}
+
+// DelegateContractAddress is the address of the precompiled contract giving
+// contracts read access to the DPoS candidates and producer schedule.
+var DelegateContractAddress = common.BytesToAddress([]byte{1, 1})
+
+// PrecompiledContractsDelegate contains the DPoS contract, available from the
+// DPoS precompile fork on in addition to the contracts of the current phase.
+var PrecompiledContractsDelegate = map[common.Address]PrecompiledContract{
+	DelegateContractAddress: &delegateView{},
+}
+
+// statefulPrecompiledContract is a native Go contract reading and modifying
+// the state through the EVM running it.
+type statefulPrecompiledContract interface {
+	PrecompiledContract
+	RunStateful(evm *EVM, contract *Contract, input []byte) ([]byte, error)
+}
+
 // RunPrecompiledContract runs and evaluates the output of a precompiled contract.
 func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
 	gas := p.RequiredGas(input)
@@ -59,6 +97,16 @@ func RunPrecompiledContract(p PrecompiledContract, input []byte, contract *Contr
 	return nil, ErrOutOfGas
 }
 
+// runStatefulPrecompiledContract runs and evaluates the output of a precompiled
+// contract working on the state.
+func runStatefulPrecompiledContract(evm *EVM, p statefulPrecompiledContract, input []byte, contract *Contract) (ret []byte, err error) {
+	gas := p.RequiredGas(input)
+	if contract.UseGas(gas) {
+		return p.RunStateful(evm, contract, input)
+	}
+	return nil, ErrOutOfGas
+}
+
 // ECRECOVER implemented as a native contract.
 type ecrecover struct{}
 
@@ -368,3 +416,252 @@ func (c *bn256Pairing) Run(input []byte) ([]byte, error) {
 	}
 	return false32Byte, nil
 }
+
+// NativeAssetABI is the interface of the native asset contract, see
+// contracts/nativeasset/NativeAsset.sol.
+const NativeAssetABI = `[
+	{"constant":true,"inputs":[{"name":"asset","type":"address"},{"name":"account","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[{"name":"asset","type":"address"}],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[{"name":"asset","type":"address"}],"name":"assetInfo","outputs":[{"name":"name","type":"string"},{"name":"symbol","type":"string"},{"name":"decimals","type":"uint8"},{"name":"issuer","type":"address"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":false,"inputs":[{"name":"asset","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"name":"transfer","outputs":[{"name":"","type":"bool"}],"payable":false,"stateMutability":"nonpayable","type":"function"}
+]`
+
+var (
+	nativeAssetABI, _ = abi.JSON(strings.NewReader(NativeAssetABI))
+
+	errNativeAssetMethod   = errors.New("unknown native asset method")
+	errNativeAssetValue    = errors.New("native asset contract does not accept value")
+	errNativeAssetDelegate = errors.New("native asset transfer through delegate call")
+	errNativeAssetStateful = errors.New("native asset contract requires the evm")
+)
+
+// nativeAsset implemented as a native contract, giving contracts compiled
+// by standard compilers access to native assets.
+type nativeAsset struct{}
+
+// RequiredGas returns the gas required to execute the pre-compiled contract.
+func (c *nativeAsset) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method := nativeAssetABI.MethodById(input[:4])
+	if method == nil {
+		return 0
+	}
+	switch method.Name {
+	case "balanceOf":
+		return params.BalanceOfGas
+	case "transfer":
+		return params.TransferAssetGas
+	default:
+		return params.AssetInfoGas
+	}
+}
+
+func (c *nativeAsset) Run(input []byte) ([]byte, error) {
+	return nil, errNativeAssetStateful
+}
+
+func (c *nativeAsset) RunStateful(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
+	if contract.Value().Sign() > 0 {
+		return nil, errNativeAssetValue
+	}
+	if len(input) < 4 {
+		return nil, errNativeAssetMethod
+	}
+	method := nativeAssetABI.MethodById(input[:4])
+	if method == nil {
+		return nil, errNativeAssetMethod
+	}
+	args := input[4:]
+	switch method.Name {
+	case "balanceOf":
+		var in struct{ Asset, Account common.Address }
+		if err := method.Inputs.Unpack(&in, args); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(evm.StateDB.GetAssetBalance(in.Account, in.Asset))
+
+	case "totalSupply":
+		var asset common.Address
+		if err := method.Inputs.Unpack(&asset, args); err != nil {
+			return nil, err
+		}
+		supply, err := evm.StateDB.GetAssetSupply(asset)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(supply)
+
+	case "assetInfo":
+		var asset common.Address
+		if err := method.Inputs.Unpack(&asset, args); err != nil {
+			return nil, err
+		}
+		ai, err := evm.StateDB.GetAssetInfo(asset)
+		if err != nil {
+			return nil, err
+		}
+		var issuer common.Address
+		if ai.Issuer != nil {
+			issuer = *ai.Issuer
+		}
+		return method.Outputs.Pack(ai.Name, ai.Symbol, ai.GetDecimals(), issuer)
+
+	case "transfer":
+		// the caller spends its own asset, which is not the account running
+		// the code on a delegate call
+		if evm.interpreter.readOnly {
+			return nil, errWriteProtection
+		}
+		if contract.Address() != NativeAssetContractAddress {
+			return nil, errNativeAssetDelegate
+		}
+		var in struct {
+			Asset, To common.Address
+			Amount    *big.Int
+		}
+		if err := method.Inputs.Unpack(&in, args); err != nil {
+			return nil, err
+		}
+		from := contract.Caller()
+		if !evm.Context.CanTransfer(evm.StateDB, from, in.To, &in.Asset, in.Amount) {
+			return nil, ErrInsufficientBalance
+		}
+		if err := evm.Transfer(evm.StateDB, from, in.To, &in.Asset, in.Amount); err != nil {
+			return nil, err
+		}
+		evm.watchInnerTx(from, in.To, &in.Asset, in.Amount)
+		return method.Outputs.Pack(true)
+	}
+	return nil, errNativeAssetMethod
+}
+
+// DelegateABI is the interface of the DPoS contract, see
+// contracts/delegate/Delegate.sol.
+const DelegateABI = `[
+	{"constant":true,"inputs":[],"name":"candidates","outputs":[{"name":"","type":"address[]"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[{"name":"candidate","type":"address"}],"name":"voteCount","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"voteList","outputs":[{"name":"","type":"address[]"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[{"name":"account","type":"address"}],"name":"lockBalance","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[],"name":"producers","outputs":[{"name":"producers","type":"address[]"},{"name":"workTimes","type":"uint256[]"}],"payable":false,"stateMutability":"view","type":"function"}
+]`
+
+var (
+	delegateABI, _ = abi.JSON(strings.NewReader(DelegateABI))
+
+	errDelegateMethod    = errors.New("unknown dpos method")
+	errDelegateValue     = errors.New("dpos contract does not accept value")
+	errDelegateStateful  = errors.New("dpos contract requires the evm")
+	errDelegateCandidate = errors.New("dpos candidates unavailable")
+	errDelegateSchedule  = errors.New("dpos producer schedule unavailable")
+)
+
+// delegateView implemented as a native contract, giving contracts read-only
+// access to the DPoS candidates, the votes of accounts and the producer
+// schedule of the current round.
+type delegateView struct{}
+
+// RequiredGas returns the gas required to execute the pre-compiled contract.
+func (c *delegateView) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method := delegateABI.MethodById(input[:4])
+	if method == nil {
+		return 0
+	}
+	switch method.Name {
+	case "candidates", "producers":
+		return params.DelegateListGas
+	default:
+		return params.DelegateReadGas
+	}
+}
+
+func (c *delegateView) Run(input []byte) ([]byte, error) {
+	return nil, errDelegateStateful
+}
+
+func (c *delegateView) RunStateful(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
+	if contract.Value().Sign() > 0 {
+		return nil, errDelegateValue
+	}
+	if len(input) < 4 {
+		return nil, errDelegateMethod
+	}
+	method := delegateABI.MethodById(input[:4])
+	if method == nil {
+		return nil, errDelegateMethod
+	}
+	args := input[4:]
+	switch method.Name {
+	case "candidates":
+		candidates, err := evm.candidates()
+		if err != nil {
+			return nil, err
+		}
+		addresses := make([]common.Address, len(candidates))
+		for i, candidate := range candidates {
+			addresses[i] = common.HexToAddress(candidate.Address)
+		}
+		return method.Outputs.Pack(addresses)
+
+	case "voteCount":
+		var address common.Address
+		if err := method.Inputs.Unpack(&address, args); err != nil {
+			return nil, err
+		}
+		if evm.Context.Delegates == nil {
+			return nil, errDelegateCandidate
+		}
+		vote := new(big.Int)
+		if evm.Context.Delegates.Exist(address) {
+			vote.Set(evm.Context.Delegates.GetVote(address))
+		}
+		return method.Outputs.Pack(vote)
+
+	case "voteList":
+		var account common.Address
+		if err := method.Inputs.Unpack(&account, args); err != nil {
+			return nil, err
+		}
+		voteList := evm.StateDB.GetVoteList(account)
+		if voteList == nil {
+			voteList = []common.Address{}
+		}
+		return method.Outputs.Pack(voteList)
+
+	case "lockBalance":
+		var account common.Address
+		if err := method.Inputs.Unpack(&account, args); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(evm.StateDB.GetLockBalance(account))
+
+	case "producers":
+		if evm.Context.GetShuffleList == nil {
+			return nil, errDelegateSchedule
+		}
+		shuffleList := evm.Context.GetShuffleList()
+		if shuffleList == nil {
+			return nil, errDelegateSchedule
+		}
+		producers := make([]common.Address, len(shuffleList.ShuffleDels))
+		workTimes := make([]*big.Int, len(shuffleList.ShuffleDels))
+		for i, del := range shuffleList.ShuffleDels {
+			producers[i] = common.HexToAddress(del.Address)
+			workTimes[i] = new(big.Int).SetUint64(del.WorkTime)
+		}
+		return method.Outputs.Pack(producers, workTimes)
+	}
+	return nil, errDelegateMethod
+}
+
+// candidates returns the registered candidates of the context's delegate state.
+func (evm *EVM) candidates() ([]types.Candidate, error) {
+	if evm.Context.Delegates == nil {
+		return nil, errDelegateCandidate
+	}
+	return evm.Context.Delegates.GetDelegates(), nil
+}
@@ -21,7 +21,12 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"github.com/Aurorachain-io/go-aoa/params"
 )
 
 // precompiledTest defines the input/output pairs for precompiled contract tests.
@@ -181,6 +186,12 @@ var bn256AddTests = []precompiledTest{
 		input:    "17c139df0efee0f766bc0204762b774362e4ded88953a39ce849a8a7fa163fa901e0559bacb160664764a357af8a9fe70baa9258e0b959273ffc5718c6d4cc7c17c139df0efee0f766bc0204762b774362e4ded88953a39ce849a8a7fa163fa92e83f8d734803fc370eba25ed1f6b8768bd6d83887b87165fc2434fe11a830cb00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		expected: "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
 		name:     "cdetrio14",
+	}, {
+		// the generator plus its negation is the point at infinity, which is
+		// returned as zero bytes
+		input:    "00000000000000000000000000000000000000000000000000000000000000010000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000130644e72e131a029b85045b68181585d97816a916871ca8d3c208c16d87cfd45",
+		expected: "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		name:     "negated",
 	},
 }
 
@@ -271,6 +282,22 @@ var bn256ScalarMulTests = []precompiledTest{
 		expected:    "039730ea8dff1254c0fee9c0ea777d29a9c710b7e616683f194f18c43b43b869073a5ffcc6fc7a28c30723d6e58ce577356982d65b833a5a5c15bf9024b43d98",
 		name:        "cdetrio15",
 		noBenchmark: true,
+	}, {
+		// products at the point at infinity are returned as zero bytes
+		input:       "000000000000000000000000000000000000000000000000000000000000000100000000000000000000000000000000000000000000000000000000000000020000000000000000000000000000000000000000000000000000000000000000",
+		expected:    "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		name:        "zero_scalar",
+		noBenchmark: true,
+	}, {
+		input:       "0000000000000000000000000000000000000000000000000000000000000001000000000000000000000000000000000000000000000000000000000000000230644e72e131a029b85045b68181585d2833e84879b9709143e1f593f0000001",
+		expected:    "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		name:        "group_order",
+		noBenchmark: true,
+	}, {
+		input:       "000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000009",
+		expected:    "00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+		name:        "infinity",
+		noBenchmark: true,
 	},
 }
 
@@ -481,3 +508,206 @@ func BenchmarkPrecompiledBn256Pairing(bench *testing.B) {
 		benchmarkPrecompiled("08", test, bench)
 	}
 }
+
+func TestNativeAssetContract(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	issuer, holder := common.HexToAddress("1337"), common.HexToAddress("1338")
+	if err := statedb.PublishAsset(issuer, types.AssetInfo{Name: "Native", Symbol: "NAT", Supply: big.NewInt(1000)}); err != nil {
+		t.Fatalf("failed to publish asset: %v", err)
+	}
+	asset := crypto.CreateAddress(issuer, 0)
+
+	ctx := Context{
+		CanTransfer: func(db StateDB, from, to common.Address, asset *common.Address, amount *big.Int) bool {
+			if asset == nil {
+				return db.GetBalance(from).Cmp(amount) >= 0
+			}
+			return db.GetAssetBalance(from, *asset).Cmp(amount) >= 0
+		},
+		Transfer: func(db StateDB, from, to common.Address, asset *common.Address, amount *big.Int) error {
+			if asset == nil {
+				db.SubBalance(from, amount)
+				db.AddBalance(to, amount)
+				return nil
+			}
+			db.SubAssetBalance(from, *asset, amount)
+			db.AddAssetBalance(to, *asset, amount)
+			return nil
+		},
+		BlockNumber: new(big.Int),
+	}
+	call := func(config *params.ChainConfig, caller common.Address, method string, args ...interface{}) ([]byte, error) {
+		input, err := nativeAssetABI.Pack(method, args...)
+		if err != nil {
+			t.Fatalf("failed to pack %s: %v", method, err)
+		}
+		evm := NewEVM(ctx, statedb, config, Config{})
+		ret, _, err := evm.Call(AccountRef(caller), NativeAssetContractAddress, input, 100000, types.ActionCallContract, new(big.Int))
+		return ret, err
+	}
+	config := params.AllDacchainProtocolChanges
+
+	if _, err := call(config, issuer, "transfer", asset, holder, big.NewInt(300)); err != nil {
+		t.Fatalf("transfer failed: %v", err)
+	}
+	if _, err := call(config, holder, "transfer", asset, issuer, big.NewInt(301)); err != ErrInsufficientBalance {
+		t.Errorf("overdrawn transfer error mismatch: have %v, want %v", err, ErrInsufficientBalance)
+	}
+	for account, want := range map[common.Address]int64{issuer: 700, holder: 300} {
+		ret, err := call(config, holder, "balanceOf", asset, account)
+		if err != nil {
+			t.Fatalf("balanceOf failed: %v", err)
+		}
+		if balance := new(big.Int).SetBytes(ret); balance.Int64() != want {
+			t.Errorf("balance of %x mismatch: have %v, want %d", account, balance, want)
+		}
+	}
+	ret, err := call(config, holder, "totalSupply", asset)
+	if err != nil {
+		t.Fatalf("totalSupply failed: %v", err)
+	}
+	if supply := new(big.Int).SetBytes(ret); supply.Int64() != 1000 {
+		t.Errorf("supply mismatch: have %v, want 1000", supply)
+	}
+	ret, err = call(config, holder, "assetInfo", asset)
+	if err != nil {
+		t.Fatalf("assetInfo failed: %v", err)
+	}
+	var info struct {
+		Name, Symbol string
+		Decimals     uint8
+		Issuer       common.Address
+	}
+	if err := nativeAssetABI.Unpack(&info, "assetInfo", ret); err != nil {
+		t.Fatalf("failed to unpack asset info: %v", err)
+	}
+	if info.Name != "Native" || info.Symbol != "NAT" || info.Decimals != types.DefaultAssetDecimals || info.Issuer != issuer {
+		t.Errorf("asset info mismatch: have %+v", info)
+	}
+	// the contract does not exist before the fork
+	before := *config
+	before.AssetPrecompileBlock = nil
+	if ret, err := call(&before, holder, "balanceOf", asset, holder); err != nil || len(ret) != 0 {
+		t.Errorf("native asset contract reachable before the fork: %x %v", ret, err)
+	}
+}
+
+// testDelegates is a delegate state holding the given candidates.
+type testDelegates []types.Candidate
+
+func (d testDelegates) GetDelegates() []types.Candidate { return d }
+func (d testDelegates) Exist(addr common.Address) bool {
+	for _, candidate := range d {
+		if common.HexToAddress(candidate.Address) == addr {
+			return true
+		}
+	}
+	return false
+}
+func (d testDelegates) GetVote(addr common.Address) *big.Int {
+	for _, candidate := range d {
+		if common.HexToAddress(candidate.Address) == addr {
+			return new(big.Int).SetUint64(candidate.Vote)
+		}
+	}
+	return new(big.Int)
+}
+
+func TestDelegateContract(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	first, second, voter := common.HexToAddress("1337"), common.HexToAddress("1338"), common.HexToAddress("1339")
+	statedb.SetVoteList(voter, []common.Address{first, second})
+	statedb.SetLockBalance(voter, big.NewInt(500))
+	statedb.AddBalance(voter, big.NewInt(1))
+
+	ctx := Context{
+		CanTransfer: func(db StateDB, from, to common.Address, asset *common.Address, amount *big.Int) bool {
+			return db.GetBalance(from).Cmp(amount) >= 0
+		},
+		Transfer: func(db StateDB, from, to common.Address, asset *common.Address, amount *big.Int) error {
+			db.SubBalance(from, amount)
+			db.AddBalance(to, amount)
+			return nil
+		},
+		Delegates: testDelegates{{Address: first.Hex(), Vote: 2}, {Address: second.Hex(), Vote: 1}},
+		GetShuffleList: func() *types.ShuffleList {
+			return &types.ShuffleList{ShuffleDels: []types.ShuffleDel{{WorkTime: 1010, Address: second.Hex()}, {WorkTime: 1020, Address: first.Hex()}}}
+		},
+		BlockNumber: new(big.Int),
+	}
+	call := func(config *params.ChainConfig, value *big.Int, method string, args ...interface{}) ([]byte, error) {
+		input, err := delegateABI.Pack(method, args...)
+		if err != nil {
+			t.Fatalf("failed to pack %s: %v", method, err)
+		}
+		evm := NewEVM(ctx, statedb, config, Config{})
+		ret, _, err := evm.Call(AccountRef(voter), DelegateContractAddress, input, 100000, types.ActionCallContract, value)
+		return ret, err
+	}
+	config := params.AllDacchainProtocolChanges
+
+	ret, err := call(config, new(big.Int), "candidates")
+	if err != nil {
+		t.Fatalf("candidates failed: %v", err)
+	}
+	var candidates []common.Address
+	if err := delegateABI.Unpack(&candidates, "candidates", ret); err != nil {
+		t.Fatalf("failed to unpack candidates: %v", err)
+	}
+	if len(candidates) != 2 || candidates[0] != first || candidates[1] != second {
+		t.Errorf("candidates mismatch: have %x", candidates)
+	}
+	for account, want := range map[common.Address]int64{first: 2, second: 1, voter: 0} {
+		ret, err := call(config, new(big.Int), "voteCount", account)
+		if err != nil {
+			t.Fatalf("voteCount failed: %v", err)
+		}
+		if vote := new(big.Int).SetBytes(ret); vote.Int64() != want {
+			t.Errorf("vote count of %x mismatch: have %v, want %d", account, vote, want)
+		}
+	}
+	ret, err = call(config, new(big.Int), "voteList", voter)
+	if err != nil {
+		t.Fatalf("voteList failed: %v", err)
+	}
+	var voteList []common.Address
+	if err := delegateABI.Unpack(&voteList, "voteList", ret); err != nil {
+		t.Fatalf("failed to unpack vote list: %v", err)
+	}
+	if len(voteList) != 2 || voteList[0] != first || voteList[1] != second {
+		t.Errorf("vote list mismatch: have %x", voteList)
+	}
+	ret, err = call(config, new(big.Int), "lockBalance", voter)
+	if err != nil {
+		t.Fatalf("lockBalance failed: %v", err)
+	}
+	if locked := new(big.Int).SetBytes(ret); locked.Int64() != 500 {
+		t.Errorf("lock balance mismatch: have %v, want 500", locked)
+	}
+	ret, err = call(config, new(big.Int), "producers")
+	if err != nil {
+		t.Fatalf("producers failed: %v", err)
+	}
+	var schedule struct {
+		Producers []common.Address
+		WorkTimes []*big.Int
+	}
+	if err := delegateABI.Unpack(&schedule, "producers", ret); err != nil {
+		t.Fatalf("failed to unpack producers: %v", err)
+	}
+	if len(schedule.Producers) != 2 || schedule.Producers[0] != second || schedule.WorkTimes[1].Int64() != 1020 {
+		t.Errorf("producer schedule mismatch: have %x %v", schedule.Producers, schedule.WorkTimes)
+	}
+	if _, err := call(config, big.NewInt(1), "candidates"); err != errDelegateValue {
+		t.Errorf("value transfer error mismatch: have %v, want %v", err, errDelegateValue)
+	}
+	// the contract does not exist before the fork
+	before := *config
+	before.DelegatePrecompileBlock = nil
+	if ret, err := call(&before, new(big.Int), "candidates"); err != nil || len(ret) != 0 {
+		t.Errorf("dpos contract reachable before the fork: %x %v", ret, err)
+	}
+}
+
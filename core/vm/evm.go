@@ -34,19 +34,26 @@ var emptyCodeHash = crypto.Keccak256Hash(nil)
 
 type (
 	//the last but one arg is the asset ID. when assetid is nil then it represents our main Cash DAC.
-	CanTransferFunc func(StateDB, common.Address, *common.Address, *big.Int) bool
-	TransferFunc    func(StateDB, common.Address, common.Address, *common.Address, *big.Int)
+	CanTransferFunc func(StateDB, common.Address, common.Address, *common.Address, *big.Int) bool
+	TransferFunc    func(StateDB, common.Address, common.Address, *common.Address, *big.Int) error
 	// GetHashFunc returns the nth block hash in the blockchain
 	// and is used by the BLOCKHASH EVM op code.
 	GetHashFunc func(uint64) common.Hash
 	VoteFunc    func(StateDB, common.Address, []types.Vote, *map[common.Address]types.Candidate, int64) error
+	// VerifyEvidenceFunc checks double-sign evidence and returns the offending delegate
+	VerifyEvidenceFunc func(*types.DoubleSignEvidence) (common.Address, error)
+	// GetShuffleListFunc returns the producer schedule of the current round
+	// and is used by the DPoS precompile.
+	GetShuffleListFunc func() *types.ShuffleList
 )
 
 // run runs the given contract and takes care of running precompiles with a fallback to the byte code interpreter.
 func run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
 	if contract.CodeAddr != nil {
-		precompiles := PrecompiledContracts
-		if p := precompiles[*contract.CodeAddr]; p != nil {
+		if p := evm.precompile(*contract.CodeAddr); p != nil {
+			if sp, ok := p.(statefulPrecompiledContract); ok {
+				return runStatefulPrecompiledContract(evm, sp, input, contract)
+			}
 			return RunPrecompiledContract(p, input, contract)
 		}
 	}
@@ -66,6 +73,13 @@ type Context struct {
 
 	Vote VoteFunc
 
+	VerifyEvidence VerifyEvidenceFunc
+
+	// Delegates is the delegate state the transaction runs on
+	Delegates DelegateReader
+	// GetShuffleList returns the producer schedule of the current round
+	GetShuffleList GetShuffleListFunc
+
 	// Message information
 	Origin   common.Address // Provides information for ORIGIN
 	GasPrice *big.Int       // Provides information for GASPRICE
@@ -166,7 +180,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		return nil, gas, ErrDepth
 	}
 
-	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), asset, value) {
+	if !evm.Context.CanTransfer(evm.StateDB, caller.Address(), addr, asset, value) {
 		return nil, gas, ErrInsufficientBalance
 	}
 
@@ -175,8 +189,7 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		snapshot = evm.StateDB.Snapshot()
 	)
 	if !evm.StateDB.Exist(addr) {
-		precompiles := PrecompiledContracts
-		if precompiles[addr] == nil && value.Sign() == 0 {
+		if evm.precompile(addr) == nil && value.Sign() == 0 {
 			if evm.vmConfig.Debug && evm.depth == 0 {
 				evm.vmConfig.Tracer.CaptureStart(caller.Address(), addr, false, input, gas, value)
 				evm.vmConfig.Tracer.CaptureEnd(ret, 0, 0, nil)
@@ -186,7 +199,10 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		evm.StateDB.CreateAccount(addr)
 	}
 
-	evm.Transfer(evm.StateDB, caller.Address(), to.Address(), asset, value)
+	if err := evm.Transfer(evm.StateDB, caller.Address(), to.Address(), asset, value); err != nil {
+		evm.StateDB.RevertToSnapshot(snapshot)
+		return nil, gas, err
+	}
 
 	// Initialise a new contract and set the code that is to be used by the EVM.
 	// The contract is a scoped environment for this execution context only.
@@ -234,7 +250,7 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 		return nil, gas, ErrDepth
 	}
 	// Fail if we're trying to transfer more than the available balance
-	if !evm.CanTransfer(evm.StateDB, caller.Address(), nil, value) {
+	if !evm.CanTransfer(evm.StateDB, caller.Address(), addr, nil, value) {
 		return nil, gas, ErrInsufficientBalance
 	}
 
@@ -336,20 +352,32 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 
 // Create creates a new contract using code as deployment code.
 func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, asset *common.Address, value *big.Int, abi string) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
+	contractAddr = crypto.CreateAddress(caller.Address(), evm.StateDB.GetNonce(caller.Address()))
+	return evm.create(caller, code, gas, asset, value, contractAddr, abi)
+}
+
+// Create2 creates a new contract using code as deployment code. The address of
+// the contract is derived from the caller, the salt and the code hash instead
+// of the nonce of the caller, see EIP-1014.
+func (evm *EVM) Create2(caller ContractRef, code []byte, gas uint64, value *big.Int, salt *big.Int) (ret []byte, contractAddr common.Address, leftOverGas uint64, err error) {
+	contractAddr = crypto.CreateAddress2(caller.Address(), common.BigToHash(salt), crypto.Keccak256(code))
+	return evm.create(caller, code, gas, nil, value, contractAddr, "")
+}
 
+// create creates a new contract at the given address using code as deployment code.
+func (evm *EVM) create(caller ContractRef, code []byte, gas uint64, asset *common.Address, value *big.Int, contractAddr common.Address, abi string) ([]byte, common.Address, uint64, error) {
 	// Depth check execution. Fail if we're trying to execute above the
 	// limit.
 	if evm.depth > int(params.CallCreateDepth) {
 		return nil, common.Address{}, gas, ErrDepth
 	}
-	if !evm.CanTransfer(evm.StateDB, caller.Address(), asset, value) {
+	if !evm.CanTransfer(evm.StateDB, caller.Address(), contractAddr, asset, value) {
 		return nil, common.Address{}, gas, ErrInsufficientBalance
 	}
 	// Ensure there's no existing contract already at the designated address
 	nonce := evm.StateDB.GetNonce(caller.Address())
 	evm.StateDB.SetNonce(caller.Address(), nonce+1)
 
-	contractAddr = crypto.CreateAddress(caller.Address(), nonce)
 	contractHash := evm.StateDB.GetCodeHash(contractAddr)
 	if evm.StateDB.GetNonce(contractAddr) != 0 || (contractHash != (common.Hash{}) && contractHash != emptyCodeHash) {
 		return nil, common.Address{}, 0, ErrContractAddressCollision
@@ -360,7 +388,10 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, asset *commo
 	//if evm.ChainConfig().IsEIP158(evm.BlockNumber) {
 	//	evm.StateDB.SetNonce(contractAddr, 1)
 	//}
-	evm.Transfer(evm.StateDB, caller.Address(), contractAddr, asset, value)
+	if err := evm.Transfer(evm.StateDB, caller.Address(), contractAddr, asset, value); err != nil {
+		evm.StateDB.RevertToSnapshot(snapshot)
+		return nil, common.Address{}, gas, err
+	}
 
 	// initialise a new contract and set the code that is to be used by the
 	// E The contract is a scoped evmironment for this execution context
@@ -377,7 +408,7 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, asset *commo
 	}
 	start := time.Now()
 
-	ret, err = run(evm, contract, nil)
+	ret, err := run(evm, contract, nil)
 
 	// check whether the max code size has been exceeded
 	maxCodeSizeExceeded := len(ret) > params.MaxCodeSize
@@ -415,6 +446,20 @@ func (evm *EVM) Create(caller ContractRef, code []byte, gas uint64, asset *commo
 	return ret, contractAddr, contract.Gas, err
 }
 
+// precompile returns the precompiled contract at the given address in the
+// current phase, or nil if there is none.
+func (evm *EVM) precompile(addr common.Address) PrecompiledContract {
+	if evm.chainRules.IsDelegatePrecompile {
+		if p, ok := PrecompiledContractsDelegate[addr]; ok {
+			return p
+		}
+	}
+	if evm.chainRules.IsAssetPrecompile {
+		return PrecompiledContractsAsset[addr]
+	}
+	return PrecompiledContracts[addr]
+}
+
 // ChainConfig returns the environment's chain configuration
 func (evm *EVM) ChainConfig() *params.ChainConfig { return evm.chainConfig }
 
@@ -427,3 +472,9 @@ func (evm *EVM) watchInnerTx(from common.Address, to common.Address, asset *comm
 		evm.InnerTxs = append(evm.InnerTxs, &itx)
 	}
 }
+
+// AddInnerTx records a value transfer made on behalf of the message outside of
+// the interpreter as an internal transaction.
+func (evm *EVM) AddInnerTx(from common.Address, to common.Address, asset *common.Address, value *big.Int) {
+	evm.watchInnerTx(from, to, asset, value)
+}
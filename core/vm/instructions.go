@@ -469,6 +469,17 @@ func opExtCodeSize(pc *uint64, evm *EVM, contract *Contract, memory *Memory, sta
 	return nil, nil
 }
 
+func opExtCodeHash(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	slot := stack.peek()
+	address := common.BigToAddress(slot)
+	if evm.StateDB.Empty(address) {
+		slot.SetUint64(0)
+	} else {
+		slot.SetBytes(evm.StateDB.GetCodeHash(address).Bytes())
+	}
+	return nil, nil
+}
+
 func opCodeSize(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
 	l := evm.interpreter.intPool.get().SetInt64(int64(len(contract.Code)))
 	stack.push(l)
@@ -546,6 +557,21 @@ func opGasLimit(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack
 	return nil, nil
 }
 
+func opChainID(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	stack.push(evm.interpreter.intPool.get().Set(evm.chainRules.ChainId))
+	return nil, nil
+}
+
+func opSelfBalance(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	stack.push(evm.interpreter.intPool.get().Set(evm.StateDB.GetBalance(contract.Address())))
+	return nil, nil
+}
+
+func opPush0(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	stack.push(evm.interpreter.intPool.getZero())
+	return nil, nil
+}
+
 func opPop(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
 	evm.interpreter.intPool.put(stack.pop())
 	return nil, nil
@@ -672,6 +698,32 @@ func opCreate(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *S
 	return nil, nil
 }
 
+func opCreate2(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	var (
+		endowment    = stack.pop()
+		offset, size = stack.pop(), stack.pop()
+		salt         = stack.pop()
+		input        = memory.Get(offset.Int64(), size.Int64())
+		gas          = contract.Gas
+	)
+
+	contract.UseGas(gas)
+	res, addr, returnGas, suberr := evm.Create2(contract, input, gas, endowment, salt)
+	// Push item on the stack based on the returned error.
+	if suberr != nil {
+		stack.push(evm.interpreter.intPool.getZero())
+	} else {
+		stack.push(addr.Big())
+	}
+	contract.Gas += returnGas
+	evm.interpreter.intPool.put(endowment, offset, size, salt)
+
+	if suberr == errExecutionReverted {
+		return res, nil
+	}
+	return nil, nil
+}
+
 func opCall(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
 	// Pop gas. The actual gas in in evm.callGasTemp.
 	evm.interpreter.intPool.put(stack.pop())
@@ -889,8 +941,13 @@ func opTransferAsset(pc *uint64, evm *EVM, contract *Contract, memory *Memory, s
 	value = math.U256(value)
 	asset, toaddr := common.BigToAddress(aid), common.BigToAddress(to)
 
-	if evm.Context.CanTransfer(evm.StateDB, contract.Address(), &asset, value) {
-		evm.Transfer(evm.StateDB, contract.Address(), toaddr, &asset, value)
+	// fails on insufficient balance as well as on the freeze and whitelist
+	// controls of the asset
+	if evm.Context.CanTransfer(evm.StateDB, contract.Address(), toaddr, &asset, value) {
+		if err := evm.Transfer(evm.StateDB, contract.Address(), toaddr, &asset, value); err != nil {
+			stack.push(evm.interpreter.intPool.getZero())
+			return nil, err
+		}
 		// watch inner transaction
 		evm.watchInnerTx(contract.Address(), toaddr, &asset, value)
 
@@ -903,6 +960,44 @@ func opTransferAsset(pc *uint64, evm *EVM, contract *Contract, memory *Memory, s
 	return nil, nil
 }
 
+func opAllowance(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	aid, owner, spender := stack.pop(), stack.pop(), stack.pop()
+	allowance := evm.StateDB.GetAssetAllowance(common.BigToAddress(aid), common.BigToAddress(owner), common.BigToAddress(spender))
+	stack.push(allowance)
+	evm.interpreter.intPool.put(aid, owner, spender)
+	return nil, nil
+}
+
+func opTransferFrom(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
+	value, aid, to, from := stack.pop(), stack.pop(), stack.pop(), stack.pop()
+	value = math.U256(value)
+	asset, toaddr, fromaddr := common.BigToAddress(aid), common.BigToAddress(to), common.BigToAddress(from)
+
+	// the contract spends against the allowance the holder approved for it,
+	// the maximum allowance is never used up. A refused transfer pushes 0 so
+	// the contract can handle it.
+	allowance := evm.StateDB.GetAssetAllowance(asset, fromaddr, contract.Address())
+	if allowance.Cmp(value) < 0 || !evm.Context.CanTransfer(evm.StateDB, fromaddr, toaddr, &asset, value) {
+		stack.push(evm.interpreter.intPool.getZero())
+		evm.interpreter.intPool.put(value, aid, to, from)
+		return nil, nil
+	}
+	if err := evm.Transfer(evm.StateDB, fromaddr, toaddr, &asset, value); err != nil {
+		stack.push(evm.interpreter.intPool.getZero())
+		evm.interpreter.intPool.put(value, aid, to, from)
+		return nil, nil
+	}
+	if allowance.Cmp(math.MaxBig256) != 0 {
+		evm.StateDB.SetAssetAllowance(asset, fromaddr, contract.Address(), allowance.Sub(allowance, value))
+	}
+	// watch inner transaction
+	evm.watchInnerTx(fromaddr, toaddr, &asset, value)
+
+	stack.push(evm.interpreter.intPool.get().SetUint64(1))
+	evm.interpreter.intPool.put(value, aid, to, from)
+	return nil, nil
+}
+
 func opAsset(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error) {
 	if isEM(contract) {
 		stack.push(common.Address{}.Big())
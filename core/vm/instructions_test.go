@@ -20,7 +20,12 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/math"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/params"
 )
 
@@ -32,7 +37,7 @@ type twoOperandTest struct {
 
 func testTwoOperandOp(t *testing.T, tests []twoOperandTest, opFn func(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error)) {
 	var (
-		env   = NewEVM(Context{}, nil, params.TestChainConfig, Config{})
+		env   = NewEVM(Context{}, nil, params.AllDacchainProtocolChanges, Config{})
 		stack = newstack()
 		pc    = uint64(0)
 	)
@@ -68,7 +73,7 @@ func testTwoOperandOp(t *testing.T, tests []twoOperandTest, opFn func(pc *uint64
 
 func TestByteOp(t *testing.T) {
 	var (
-		env   = NewEVM(Context{}, nil, params.TestChainConfig, Config{})
+		env   = NewEVM(Context{}, nil, params.AllDacchainProtocolChanges, Config{})
 		stack = newstack()
 	)
 	tests := []struct {
@@ -196,9 +201,208 @@ func TestSLT(t *testing.T) {
 	testTwoOperandOp(t, tests, opSlt)
 }
 
+func TestForkInstructions(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	config := *params.AllDacchainProtocolChanges
+	config.PetersburgBlock, config.IstanbulBlock, config.ShanghaiBlock = big.NewInt(10), big.NewInt(20), big.NewInt(30)
+
+	// every program stores the word left on the stack at memory 0 and returns it
+	caller, contract := common.HexToAddress("1337"), common.HexToAddress("c0de")
+	tests := []struct {
+		name string
+		code string
+		fork uint64
+		want common.Hash
+	}{
+		{"CREATE2", "6001600060006000f5", 10, crypto.CreateAddress2(contract, common.BigToHash(big.NewInt(1)), crypto.Keccak256(nil)).Hash()},
+		{"EXTCODEHASH", "303f", 10, common.Hash{}},
+		{"CHAINID", "46", 20, common.BigToHash(config.ChainId)},
+		{"SELFBALANCE", "47", 20, common.BigToHash(big.NewInt(77))},
+		{"PUSH0", "5f19", 30, common.BigToHash(new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 256), common.Big1))},
+	}
+	for _, test := range tests {
+		code := common.FromHex(test.code + "60005260206000f3")
+		if test.name == "EXTCODEHASH" {
+			test.want = crypto.Keccak256Hash(code)
+		}
+		for _, number := range []uint64{test.fork - 1, test.fork} {
+			snapshot := statedb.Snapshot()
+			statedb.SetCode(contract, code)
+			statedb.SetBalance(contract, big.NewInt(77))
+			ctx := Context{
+				CanTransfer: func(StateDB, common.Address, common.Address, *common.Address, *big.Int) bool { return true },
+				Transfer:    func(StateDB, common.Address, common.Address, *common.Address, *big.Int) error { return nil },
+				BlockNumber: new(big.Int).SetUint64(number),
+			}
+			evm := NewEVM(ctx, statedb, &config, Config{})
+			ret, _, err := evm.Call(AccountRef(caller), contract, nil, 100000, types.ActionCallContract, new(big.Int))
+			statedb.RevertToSnapshot(snapshot)
+
+			if number < test.fork {
+				if err == nil {
+					t.Errorf("%s: executed before its fork block", test.name)
+				}
+				continue
+			}
+			if err != nil {
+				t.Errorf("%s: execution failed: %v", test.name, err)
+			} else if have := common.BytesToHash(ret); have != test.want {
+				t.Errorf("%s: result mismatch: have %x, want %x", test.name, have, test.want)
+			}
+		}
+	}
+}
+
+func TestForkInstructionsOutOfOrder(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	config := *params.AllDacchainProtocolChanges
+	config.PetersburgBlock, config.IstanbulBlock, config.ShanghaiBlock = nil, big.NewInt(20), big.NewInt(10)
+
+	caller, contract := common.HexToAddress("1337"), common.HexToAddress("c0de")
+	ctx := Context{
+		CanTransfer: func(StateDB, common.Address, common.Address, *common.Address, *big.Int) bool { return true },
+		Transfer:    func(StateDB, common.Address, common.Address, *common.Address, *big.Int) error { return nil },
+		BlockNumber: big.NewInt(10),
+	}
+	// shanghai is active while istanbul and petersburg are not yet
+	tests := []struct {
+		name  string
+		code  string
+		valid bool
+	}{
+		{"PUSH0", "5f00", true},
+		{"CHAINID", "4600", false},
+		{"SELFBALANCE", "4700", false},
+		{"EXTCODEHASH", "303f00", false},
+	}
+	for _, test := range tests {
+		statedb.SetCode(contract, common.FromHex(test.code))
+		evm := NewEVM(ctx, statedb, &config, Config{})
+		_, _, err := evm.Call(AccountRef(caller), contract, nil, 100000, types.ActionCallContract, new(big.Int))
+		if test.valid && err != nil {
+			t.Errorf("%s: execution failed: %v", test.name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: executed before its fork block", test.name)
+		}
+	}
+}
+
+func TestSloadRepricing(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	config := *params.AllDacchainProtocolChanges
+	config.IstanbulBlock = big.NewInt(10)
+
+	// PUSH1 0 SLOAD POP STOP
+	caller, contract := common.HexToAddress("1337"), common.HexToAddress("c0de")
+	statedb.SetCode(contract, common.FromHex("60005450"))
+	for _, test := range []struct {
+		number uint64
+		sload  uint64
+	}{
+		{9, params.GasTableFrontier.SLoad},
+		{10, params.GasTableIstanbul.SLoad},
+	} {
+		ctx := Context{
+			CanTransfer: func(StateDB, common.Address, common.Address, *common.Address, *big.Int) bool { return true },
+			Transfer:    func(StateDB, common.Address, common.Address, *common.Address, *big.Int) error { return nil },
+			BlockNumber: new(big.Int).SetUint64(test.number),
+		}
+		evm := NewEVM(ctx, statedb, &config, Config{})
+		_, left, err := evm.Call(AccountRef(caller), contract, nil, 100000, types.ActionCallContract, new(big.Int))
+		if err != nil {
+			t.Fatalf("block %d: execution failed: %v", test.number, err)
+		}
+		if used, want := 100000-left, GasFastestStep+test.sload+GasQuickStep; used != want {
+			t.Errorf("block %d: gas used mismatch: have %d, want %d", test.number, used, want)
+		}
+	}
+}
+
+func TestTransferFrom(t *testing.T) {
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	owner, recipient, spender := common.HexToAddress("1337"), common.HexToAddress("1338"), common.HexToAddress("c0de")
+	asset := common.HexToAddress("a55e7")
+	statedb.AddAssetBalance(owner, asset, big.NewInt(1000))
+
+	// the spender moves calldata[96:128] units of asset calldata[64:96] from
+	// calldata[0:32] to calldata[32:64] with TRANSFERFROM and returns the
+	// allowance left with ALLOWANCE followed by the result of TRANSFERFROM
+	statedb.SetCode(spender, common.FromHex("600035602035604035606035e6"+"602052"+"30600035604035e560005260406000f3"))
+	ctx := Context{
+		CanTransfer: func(db StateDB, from, to common.Address, asset *common.Address, amount *big.Int) bool {
+			if asset == nil {
+				return db.GetBalance(from).Cmp(amount) >= 0
+			}
+			return db.GetAssetBalance(from, *asset).Cmp(amount) >= 0
+		},
+		Transfer: func(db StateDB, from, to common.Address, asset *common.Address, amount *big.Int) error {
+			if asset == nil {
+				db.SubBalance(from, amount)
+				db.AddBalance(to, amount)
+				return nil
+			}
+			db.SubAssetBalance(from, *asset, amount)
+			db.AddAssetBalance(to, *asset, amount)
+			return nil
+		},
+		BlockNumber: new(big.Int),
+	}
+	transferFrom := func(amount *big.Int) (*big.Int, bool, error) {
+		input := append(common.LeftPadBytes(owner.Bytes(), 32), common.LeftPadBytes(recipient.Bytes(), 32)...)
+		input = append(input, common.LeftPadBytes(asset.Bytes(), 32)...)
+		input = append(input, common.LeftPadBytes(amount.Bytes(), 32)...)
+		evm := NewEVM(ctx, statedb, params.AllDacchainProtocolChanges, Config{})
+		ret, _, err := evm.Call(AccountRef(owner), spender, input, 100000, types.ActionCallContract, new(big.Int))
+		if err != nil {
+			return nil, false, err
+		}
+		return new(big.Int).SetBytes(ret[:32]), ret[63] == 1, nil
+	}
+
+	tests := []struct {
+		name      string
+		approve   *big.Int // allowance set before the transfer, nil keeps the previous one
+		amount    *big.Int
+		ok        bool
+		allowance *big.Int
+		received  int64
+	}{
+		{"within allowance", big.NewInt(500), big.NewInt(300), true, big.NewInt(200), 300},
+		// a refused transfer pushes 0 and leaves the allowance alone
+		{"beyond allowance", nil, big.NewInt(201), false, big.NewInt(200), 300},
+		{"whole allowance", nil, big.NewInt(200), true, new(big.Int), 500},
+		// the maximum allowance is never used up
+		{"max allowance", math.MaxBig256, big.NewInt(100), true, math.MaxBig256, 600},
+		{"beyond balance", nil, big.NewInt(401), false, math.MaxBig256, 600},
+	}
+	for _, test := range tests {
+		if test.approve != nil {
+			statedb.SetAssetAllowance(asset, owner, spender, test.approve)
+		}
+		allowance, ok, err := transferFrom(test.amount)
+		if err != nil {
+			t.Fatalf("%s: execution failed: %v", test.name, err)
+		}
+		if ok != test.ok {
+			t.Errorf("%s: result mismatch: have %v, want %v", test.name, ok, test.ok)
+		}
+		if allowance.Cmp(test.allowance) != 0 {
+			t.Errorf("%s: allowance mismatch: have %v, want %v", test.name, allowance, test.allowance)
+		}
+		if balance := statedb.GetAssetBalance(recipient, asset); balance.Int64() != test.received {
+			t.Errorf("%s: recipient balance mismatch: have %v, want %d", test.name, balance, test.received)
+		}
+	}
+}
+
 func opBenchmark(bench *testing.B, op func(pc *uint64, evm *EVM, contract *Contract, memory *Memory, stack *Stack) ([]byte, error), args ...string) {
 	var (
-		env   = NewEVM(Context{}, nil, params.TestChainConfig, Config{})
+		env   = NewEVM(Context{}, nil, params.AllDacchainProtocolChanges, Config{})
 		stack = newstack()
 	)
 	// convert args
@@ -23,6 +23,14 @@ import (
 	"github.com/Aurorachain-io/go-aoa/core/types"
 )
 
+// DelegateReader gives the DPoS precompile read access to the delegate state.
+type DelegateReader interface {
+	// GetDelegates returns the registered candidates sorted by vote
+	GetDelegates() []types.Candidate
+	Exist(common.Address) bool
+	GetVote(common.Address) *big.Int
+}
+
 // StateDB is an EVM database for full state querying.
 type StateDB interface {
 	CreateAccount(common.Address)
@@ -43,6 +51,21 @@ type StateDB interface {
 	ValidateAsset(assetInfo types.AssetInfo) error
 	PublishAsset(addr common.Address, assetInfo types.AssetInfo) error
 	GetAssetInfo(common.Address) (*types.AssetInfo, error)
+	UpdateAssetInfo(asset common.Address, assetInfo types.AssetInfo) error
+	SetAssetFlags(asset common.Address, flags uint64)
+	GetAssetFlags(asset common.Address) uint64
+	MintAsset(asset, to common.Address, amount *big.Int)
+	BurnAsset(asset, from common.Address, amount *big.Int) bool
+	GetAssetSupply(asset common.Address) (*big.Int, error)
+	SetAssetFeeRate(asset common.Address, rate *big.Int)
+	GetAssetFeeRate(asset common.Address) *big.Int
+	SetAssetAccountStatus(asset, account common.Address, status uint64)
+	GetAssetAccountStatus(asset, account common.Address) uint64
+	IsAssetTransferAllowed(asset, from, to common.Address) bool
+	SetAssetAllowance(asset, owner, spender common.Address, amount *big.Int)
+	GetAssetAllowance(asset, owner, spender common.Address) *big.Int
+	RegisterAssetSymbol(symbol string, asset common.Address)
+	GetAssetBySymbol(symbol string) common.Address
 
 	GetNonce(common.Address) uint64
 	SetNonce(common.Address, uint64)
@@ -52,8 +52,126 @@ type operation struct {
 
 var (
 	constantinopleInstructionSet = NewConstantinopleInstructionSet()
+	forkInstructionSets          = newForkInstructionSets()
 )
 
+// Flags of the forks adding instructions on top of constantinople. They
+// index forkInstructionSets.
+const (
+	assetAllowanceFork = 1 << iota
+	petersburgFork
+	istanbulFork
+	shanghaiFork
+)
+
+// newForkInstructionSets precomputes the jump table of every combination of
+// the forks adding instructions. The instructions of the forks are independent
+// of each other, CheckConfigForkOrder only keeps the evm forks in their
+// upstream order while the asset allowance fork may come at any point.
+func newForkInstructionSets() [16][256]operation {
+	var sets [16][256]operation
+	for forks := range sets {
+		sets[forks] = NewConstantinopleInstructionSet()
+		if forks&assetAllowanceFork != 0 {
+			enableAssetAllowance(&sets[forks])
+		}
+		if forks&petersburgFork != 0 {
+			enablePetersburg(&sets[forks])
+		}
+		if forks&istanbulFork != 0 {
+			enableIstanbul(&sets[forks])
+		}
+		if forks&shanghaiFork != 0 {
+			enableShanghai(&sets[forks])
+		}
+	}
+	return sets
+}
+
+// instructionSetFor returns the jump table of the instructions active
+// under the given rules.
+func instructionSetFor(rules params.Rules) [256]operation {
+	forks := 0
+	if rules.IsAssetAllowance {
+		forks |= assetAllowanceFork
+	}
+	if rules.IsPetersburg {
+		forks |= petersburgFork
+	}
+	if rules.IsIstanbul {
+		forks |= istanbulFork
+	}
+	if rules.IsShanghai {
+		forks |= shanghaiFork
+	}
+	return forkInstructionSets[forks]
+}
+
+// enableShanghai adds PUSH0 to the instruction set.
+func enableShanghai(instructionSet *[256]operation) {
+	instructionSet[PUSH0] = operation{
+		execute:       opPush0,
+		gasCost:       constGasFunc(GasQuickStep),
+		validateStack: makeStackFunc(0, 1),
+		valid:         true,
+	}
+}
+
+// enableIstanbul adds CHAINID and SELFBALANCE to the instruction set. The
+// repricing of the state reading instructions comes with the istanbul gas
+// table.
+func enableIstanbul(instructionSet *[256]operation) {
+	instructionSet[CHAINID] = operation{
+		execute:       opChainID,
+		gasCost:       constGasFunc(GasQuickStep),
+		validateStack: makeStackFunc(0, 1),
+		valid:         true,
+	}
+	instructionSet[SELFBALANCE] = operation{
+		execute:       opSelfBalance,
+		gasCost:       constGasFunc(GasFastStep),
+		validateStack: makeStackFunc(0, 1),
+		valid:         true,
+	}
+}
+
+// enablePetersburg adds CREATE2 and EXTCODEHASH to the instruction set.
+func enablePetersburg(instructionSet *[256]operation) {
+	instructionSet[CREATE2] = operation{
+		execute:       opCreate2,
+		gasCost:       gasCreate2,
+		validateStack: makeStackFunc(4, 1),
+		memorySize:    memoryCreate2,
+		valid:         true,
+		writes:        true,
+		returns:       true,
+	}
+	instructionSet[EXTCODEHASH] = operation{
+		execute:       opExtCodeHash,
+		gasCost:       gasExtCodeHash,
+		validateStack: makeStackFunc(1, 1),
+		valid:         true,
+	}
+}
+
+// enableAssetAllowance adds the instructions spending asset allowances to
+// the instruction set.
+func enableAssetAllowance(instructionSet *[256]operation) {
+	instructionSet[ALLOWANCE] = operation{
+		execute:       opAllowance,
+		gasCost:       constGasFunc(params.AllowanceGas),
+		validateStack: makeStackFunc(3, 1),
+		valid:         true,
+	}
+	instructionSet[TRANSFERFROM] = operation{
+		execute:       opTransferFrom,
+		gasCost:       gasTransferFrom,
+		validateStack: makeStackFunc(4, 1),
+		valid:         true,
+		writes:        true,
+	}
+}
+
 // NewConstantinopleInstructionSet returns the frontier, homestead
 // byzantium and contantinople instructions.
 func NewConstantinopleInstructionSet() [256]operation {
@@ -32,6 +32,14 @@ func newstack() *Stack {
 	return &Stack{data: make([]*big.Int, 0, 1024)}
 }
 
+// NewStack returns a stack holding the given items, the last one on top. It
+// lets tracers be driven outside of the interpreter.
+func NewStack(items ...*big.Int) *Stack {
+	st := newstack()
+	st.pushN(items...)
+	return st
+}
+
 func (st *Stack) Data() []*big.Int {
 	return st.data
 }
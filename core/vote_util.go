@@ -34,6 +34,10 @@ const (
 	addVote
 	subVote
 	cancel
+	unjail
+	slash
+	unregister
+	setCommission
 )
 
 var ErrInvalidSig = errors.New("invalid transaction v, r, s values")
@@ -104,7 +108,7 @@ var big8 = big.NewInt(8)
 //	return candidateWrapper
 //}
 
-func CountTrxVote(from string, tx *types.Transaction, statedb *state.StateDB, db *delegatestate.DelegateDB,blockNumber int64) ([]types.VoteCandidate, error) {
+func CountTrxVote(config *params.ChainConfig, from string, tx *types.Transaction, statedb *state.StateDB, db *delegatestate.DelegateDB, blockNumber int64) ([]types.VoteCandidate, error) {
 	candidates := make([]types.VoteCandidate, 0)
 	candidateVotes := make(map[string]int64, 0)
 	switch tx.TxDataAction() {
@@ -134,6 +138,28 @@ func CountTrxVote(from string, tx *types.Transaction, statedb *state.StateDB, db
 	case types.ActionRegister:
 		candidate := types.VoteCandidate{Address: from, Vote: 0, Nickname: string(tx.Nickname()), Action: register}
 		candidates = append(candidates, candidate)
+	case types.ActionUnjail:
+		candidate := types.VoteCandidate{Address: from, Action: unjail}
+		candidates = append(candidates, candidate)
+	case types.ActionSlash:
+		// the evidence has been verified by the state transition already
+		evidence, err := types.DecodeDoubleSignEvidence(tx.Data())
+		if err != nil {
+			return candidates, err
+		}
+		offender, _, err := evidence.Signers()
+		if err != nil {
+			return candidates, err
+		}
+		candidate := types.VoteCandidate{Address: strings.ToLower(offender.Hex()), Action: slash}
+		candidates = append(candidates, candidate)
+	case types.ActionUnregister:
+		candidate := types.VoteCandidate{Address: from, Action: unregister}
+		candidates = append(candidates, candidate)
+	case types.ActionSetCommission:
+		// the commission is carried in the vote field, checked by the state transition
+		candidate := types.VoteCandidate{Address: from, Vote: new(big.Int).SetBytes(tx.Data()).Uint64(), Action: setCommission}
+		candidates = append(candidates, candidate)
 	}
 	for address, vote := range candidateVotes {
 		var action int
@@ -146,9 +172,9 @@ func CountTrxVote(from string, tx *types.Transaction, statedb *state.StateDB, db
 		candidate := types.VoteCandidate{Address: address, Vote: uint64(vote), Action: action}
 		candidates = append(candidates, candidate)
 	}
-	if db.Exist(common.HexToAddress(from)) {
-		registerCost := new(big.Int)
-		registerCost.SetString(params.TxGasAgentCreation, 10)
+	// the deposit of a leaving delegate has been taken from its balance already
+	if db.Exist(common.HexToAddress(from)) && !db.IsLeaving(common.HexToAddress(from)) && tx.TxDataAction() != types.ActionUnregister {
+		registerCost := config.RegisterCost(big.NewInt(blockNumber))
 		log.Info("VoteUtil deal cancel", "address balance", statedb.GetBalance(common.HexToAddress(from)), "compare", registerCost)
 		if statedb.GetBalance(common.HexToAddress(from)).Cmp(registerCost) < 0 {
 			candidate := types.VoteCandidate{Address: from, Action: cancel}
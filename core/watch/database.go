@@ -29,8 +29,15 @@ type InnerTxDb interface {
 	Set(txhash common.Hash, itxs []*types.InnerTx) error
 	Has(txhash common.Hash) (bool, error)
 	Get(txhash common.Hash) ([]*types.InnerTx, error)
+
+	SetBloom(blockhash common.Hash, bloom types.Bloom) error
+	GetBloom(blockhash common.Hash) (types.Bloom, error)
 }
 
+// bloomPrefix keys the per block bloom of the synthetic transfer logs, keeping
+// it apart from the transaction hashes used for the internal transactions.
+var bloomPrefix = []byte("b")
+
 type itxdb struct {
 	db aoadb.Database
 }
@@ -67,3 +74,15 @@ func (db *itxdb) Get(txhash common.Hash) ([]*types.InnerTx, error) {
 	err = rlp.DecodeBytes(v, &itxs)
 	return itxs, err
 }
+
+func (db *itxdb) SetBloom(blockhash common.Hash, bloom types.Bloom) error {
+	return db.db.Put(append(bloomPrefix, blockhash.Bytes()...), bloom.Bytes())
+}
+
+func (db *itxdb) GetBloom(blockhash common.Hash) (types.Bloom, error) {
+	v, err := db.db.Get(append(bloomPrefix, blockhash.Bytes()...))
+	if nil != err {
+		return types.Bloom{}, err
+	}
+	return types.BytesToBloom(v), nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package watch
+
+import (
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+)
+
+// TransferEventTopic is the topic of the ERC-20 Transfer(address,address,uint256) event.
+var TransferEventTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// TransferLogs converts the asset movements among the given internal transactions
+// into synthetic ERC-20 Transfer logs emitted by the asset address, which doubles
+// as the ERC-20 view of the asset. Plain AOA transfers are skipped. The logs carry
+// no block or transaction context, callers fill it in as needed.
+func TransferLogs(itxs []*types.InnerTx) []*types.Log {
+	var logs []*types.Log
+	for _, itx := range itxs {
+		if itx.AssetID == nil || *itx.AssetID == (common.Address{}) {
+			continue
+		}
+		logs = append(logs, &types.Log{
+			Address: *itx.AssetID,
+			Topics:  []common.Hash{TransferEventTopic, itx.From.Hash(), itx.To.Hash()},
+			Data:    common.BigToHash(itx.Value).Bytes(),
+		})
+	}
+	return logs
+}
+
+// BlockTransferLogs returns the synthetic transfer logs of the successful
+// transactions behind the given receipts, with their block context filled in.
+// Log indices continue after the regular logs of the block.
+func BlockTransferLogs(db InnerTxDb, blockHash common.Hash, blockNumber uint64, receipts types.Receipts) []*types.Log {
+	var (
+		logs  []*types.Log
+		index uint
+	)
+	for _, receipt := range receipts {
+		index += uint(len(receipt.Logs))
+	}
+	for i, receipt := range receipts {
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		itxs, err := db.Get(receipt.TxHash)
+		if err != nil {
+			continue
+		}
+		for _, l := range TransferLogs(itxs) {
+			l.BlockNumber = blockNumber
+			l.BlockHash = blockHash
+			l.TxHash = receipt.TxHash
+			l.TxIndex = uint(i)
+			l.Index = index
+			index++
+			logs = append(logs, l)
+		}
+	}
+	return logs
+}
@@ -122,6 +122,23 @@ func TestNewContractAddress(t *testing.T) {
 	checkAddr(t, common.HexToAddress("c9ddedf451bc62ce88bf9292afb13df35b670699"), caddr2)
 }
 
+func TestCreateAddress2(t *testing.T) {
+	// test vectors of EIP-1014
+	tests := []struct {
+		creator  string
+		initcode string
+		want     string
+	}{
+		{"0x0000000000000000000000000000000000000000", "0x00", "0x4D1A2e2bB4F88F0250f26Ffff098B0b30B26BF38"},
+		{"0xdeadbeef00000000000000000000000000000000", "0x00", "0xB928f69Bb1D91Cd65274e3c79d8986362984fDA3"},
+		{"0x0000000000000000000000000000000000000000", "0x", "0xE33C0C7F7df4809055C3ebA6c09CFe4BaF1BD9e0"},
+	}
+	for _, tt := range tests {
+		addr := CreateAddress2(common.HexToAddress(tt.creator), [32]byte{}, Keccak256(common.FromHex(tt.initcode)))
+		checkAddr(t, common.HexToAddress(tt.want), addr)
+	}
+}
+
 func TestLoadECDSAFile(t *testing.T) {
 	keyBytes := common.FromHex(testPrivHex)
 	fileName0 := "test_key0"
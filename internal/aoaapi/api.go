@@ -33,6 +33,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/common/math"
 	"github.com/Aurorachain-io/go-aoa/common/ntp"
 	"github.com/Aurorachain-io/go-aoa/core"
+	"github.com/Aurorachain-io/go-aoa/core/state"
 	"github.com/Aurorachain-io/go-aoa/core/types"
 	"github.com/Aurorachain-io/go-aoa/core/vm"
 	"github.com/Aurorachain-io/go-aoa/crypto"
@@ -521,6 +522,42 @@ func (s *PublicBlockChainAPI) BlockNumber() *big.Int {
 	return header.Number
 }
 
+// RPCFork is a scheduled protocol upgrade of the chain.
+type RPCFork struct {
+	Name   string       `json:"name"`
+	Block  *hexutil.Big `json:"block"`
+	Active bool         `json:"active"`
+}
+
+// ChainConfig returns the chain configuration in its genesis JSON form along
+// with the scheduled forks and whether they are active at the chain head.
+func (s *PublicBlockChainAPI) ChainConfig() map[string]interface{} {
+	config := s.b.ChainConfig()
+	head := s.BlockNumber()
+	forks := make([]RPCFork, 0)
+	for _, fork := range config.Forks() {
+		forks = append(forks, RPCFork{
+			Name:   fork.Name,
+			Block:  (*hexutil.Big)(fork.Block),
+			Active: fork.Block != nil && fork.Block.Cmp(head) <= 0,
+		})
+	}
+	return map[string]interface{}{
+		"config": config,
+		"forks":  forks,
+	}
+}
+
+// GetFinalizedBlock returns the highest block confirmed by more than two thirds
+// of the delegates. It will never be reverted.
+func (s *PublicBlockChainAPI) GetFinalizedBlock(ctx context.Context, fullTx bool) (map[string]interface{}, error) {
+	block, err := s.b.BlockByNumber(ctx, rpc.FinalizedBlockNumber)
+	if block != nil {
+		return s.rpcOutputBlock(block, true, fullTx)
+	}
+	return nil, err
+}
+
 // GetBalance returns the amount of wei for the given address in the state of the
 // given block number. The rpc.LatestBlockNumber and rpc.PendingBlockNumber meta
 // block numbers are also allowed.
@@ -547,6 +584,17 @@ func (s *PublicBlockChainAPI) GetAssetBalance(ctx context.Context, address commo
 	return res, state.Error()
 }
 
+// GetAssetAllowance returns the amount of an asset spender may take from owner
+// in the state of the given block number.
+func (s *PublicBlockChainAPI) GetAssetAllowance(ctx context.Context, owner common.Address, spender common.Address, asset common.Address, blockNr rpc.BlockNumber) (*hexutil.Big, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	res := state.GetAssetAllowance(asset, owner, spender)
+	return (*hexutil.Big)(res), state.Error()
+}
+
 // Get balance, lockBalance and totalBalance of the address, and it's assets balance.
 func (s *PublicBlockChainAPI) GetDetailBalance(ctx context.Context, address common.Address, blockNr rpc.BlockNumber) (map[string]string, error) {
 	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
@@ -641,6 +689,55 @@ func (s *PublicBlockChainAPI) GetVotesList(ctx context.Context, address common.A
 	return res, state.Error()
 }
 
+// VoterRewards is the result of aoa_getPendingVoterRewards.
+type VoterRewards struct {
+	Total     *hexutil.Big                    `json:"total"`
+	Delegates map[common.Address]*hexutil.Big `json:"delegates"`
+}
+
+// GetPendingVoterRewards returns the block rewards the voter has earned from
+// its votes but not claimed yet, in total and per voted delegate.
+func (s *PublicBlockChainAPI) GetPendingVoterRewards(ctx context.Context, voter common.Address, blockNr rpc.BlockNumber) (*VoterRewards, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, err
+	}
+	block, err := s.b.BlockByNumber(ctx, blockNr)
+	if block == nil || err != nil {
+		return nil, err
+	}
+	dState, err := s.b.DelegateStateAt(block)
+	if err != nil {
+		return nil, err
+	}
+	total := new(big.Int)
+	res := &VoterRewards{Delegates: make(map[common.Address]*hexutil.Big)}
+	for _, delegate := range state.GetVoteList(voter) {
+		pending := dState.PendingVoterReward(delegate, voter)
+		total.Add(total, pending)
+		res.Delegates[delegate] = (*hexutil.Big)(pending)
+	}
+	res.Total = (*hexutil.Big)(total)
+	return res, state.Error()
+}
+
+// GetDelegateCommission returns the share of the block reward the delegate
+// keeps for itself, in units of 1/10000.
+func (s *PublicBlockChainAPI) GetDelegateCommission(ctx context.Context, address common.Address, blockNr rpc.BlockNumber) (hexutil.Uint64, error) {
+	block, err := s.b.BlockByNumber(ctx, blockNr)
+	if block == nil || err != nil {
+		return 0, err
+	}
+	dState, err := s.b.DelegateStateAt(block)
+	if err != nil {
+		return 0, err
+	}
+	if !dState.Exist(address) {
+		return 0, core.ErrCommissionAgent
+	}
+	return hexutil.Uint64(dState.GetCommission(address)), nil
+}
+
 // GetBlockByNumber returns the requested block. When blockNr is -1 the chain head is returned. When fullTx is true all
 // transactions in the block are returned in full detail, otherwise only the transaction hash is returned.
 func (s *PublicBlockChainAPI) GetBlockByNumber(ctx context.Context, blockNr rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
@@ -716,17 +813,14 @@ type CallArgs struct {
 	Abi        string           `json:"abi"`
 }
 
-func (s *PublicBlockChainAPI) doCall(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber, vmCfg vm.Config, timeout time.Duration) ([]byte, uint64, bool, error) {
-	defer func(start time.Time) { log.Debug("Executing EVM call finished", "runtime", time.Since(start)) }(time.Now())
-
-	state, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
-	if state == nil || err != nil {
-		return nil, 0, false, err
-	}
+// ToMessage converts the call arguments to a message. The sender defaults to
+// the first local account, the gas to 5000000 and the gas price to the given
+// default if none were set.
+func (args *CallArgs) ToMessage(am *accounts.Manager, defaultGasPrice *big.Int) types.Message {
 	// Set sender address or use a default if none specified
 	addr := args.From
 	if addr == (common.Address{}) {
-		if wallets := s.b.AccountManager().Wallets(); len(wallets) > 0 {
+		if wallets := am.Wallets(); len(wallets) > 0 {
 			if accounts := wallets[0].Accounts(); len(accounts) > 0 {
 				addr = accounts[0].Address
 			}
@@ -738,15 +832,69 @@ func (s *PublicBlockChainAPI) doCall(ctx context.Context, args CallArgs, blockNr
 		gas = 5000000
 	}
 	if gasPrice.Sign() == 0 {
-		gasPrice = new(big.Int).SetUint64(defaultGasPrice)
+		gasPrice = defaultGasPrice
 	}
-
-	// Create new call message
 	var ai *types.AssetInfo
 	if nil != args.AssetInfo {
 		ai = args.AssetInfo.assetinfo
 	}
-	msg := types.NewMessage(addr, args.To, 0, args.Value.ToInt(), gas, gasPrice, args.Data, false, args.Action, args.Vote, args.Asset, ai, args.SubAddress, args.Abi)
+	return types.NewMessage(addr, args.To, 0, args.Value.ToInt(), gas, gasPrice, args.Data, false, args.Action, args.Vote, args.Asset, ai, args.SubAddress, args.Abi)
+}
+
+// OverrideAccount specifies the state of an account to be overridden before
+// a call is executed. State replaces the whole storage of the account, while
+// Storage only patches the given slots.
+type OverrideAccount struct {
+	Balance *hexutil.Big                    `json:"balance"`
+	Assets  map[common.Address]*hexutil.Big `json:"assets"`
+	Code    *hexutil.Bytes                  `json:"code"`
+	State   map[common.Hash]common.Hash     `json:"state"`
+	Storage map[common.Hash]common.Hash     `json:"storage"`
+}
+
+// StateOverride is the collection of overridden accounts.
+type StateOverride map[common.Address]OverrideAccount
+
+// Apply overrides the fields of the specified accounts in the given state.
+func (diff *StateOverride) Apply(state *state.StateDB) error {
+	if diff == nil {
+		return nil
+	}
+	for addr, account := range *diff {
+		if account.Balance != nil {
+			state.SetBalance(addr, account.Balance.ToInt())
+		}
+		for asset, balance := range account.Assets {
+			if balance == nil || balance.ToInt().Sign() < 0 {
+				return fmt.Errorf("account %s has an invalid balance of asset %s", addr.Hex(), asset.Hex())
+			}
+			state.SubAssetBalance(addr, asset, state.GetAssetBalance(addr, asset))
+			state.AddAssetBalance(addr, asset, balance.ToInt())
+		}
+		if account.Code != nil {
+			state.SetCode(addr, *account.Code)
+		}
+		if account.State != nil && account.Storage != nil {
+			return fmt.Errorf("account %s has both 'state' and 'storage'", addr.Hex())
+		}
+		if account.State != nil {
+			state.SetStorage(addr, account.State)
+		}
+		for key, value := range account.Storage {
+			state.SetState(addr, key, value)
+		}
+	}
+	return state.Error()
+}
+
+func (s *PublicBlockChainAPI) doCall(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber, vmCfg vm.Config, timeout time.Duration) ([]byte, uint64, bool, error) {
+	defer func(start time.Time) { log.Debug("Executing EVM call finished", "runtime", time.Since(start)) }(time.Now())
+
+	state, header, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, 0, false, err
+	}
+	msg := args.ToMessage(s.b.AccountManager(), new(big.Int).SetUint64(defaultGasPrice))
 
 	// Setup context so it may be cancelled the call has completed
 	// or, in case of unmetered gas, setup a context with a timeout.
@@ -784,7 +932,11 @@ func (s *PublicBlockChainAPI) doCall(ctx context.Context, args CallArgs, blockNr
 
 // Call executes the given transaction on the state for the given block number.
 // It doesn't make and changes in the state/blockchain and is useful to execute and retrieve values.
+// ERC-20 view calls against an asset address are answered from the native asset state.
 func (s *PublicBlockChainAPI) Call(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber) (hexutil.Bytes, error) {
+	if result, ok, err := s.callAssetView(ctx, args, blockNr); ok || err != nil {
+		return (hexutil.Bytes)(result), err
+	}
 	result, _, _, err := s.doCall(ctx, args, blockNr, vm.Config{}, 5*time.Second)
 	return (hexutil.Bytes)(result), err
 }
@@ -796,10 +948,16 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 		if args.AssetInfo == nil {
 			return 0, errors.New(`Action is "ActionPublishAsset" but the AssetInfo is nil.`)
 		} else {
-			args.AssetInfo.assetinfo = &types.AssetInfo{Supply: (*big.Int)(args.AssetInfo.Supply), Name: args.AssetInfo.Name, Symbol: args.AssetInfo.Symbol, Desc: args.AssetInfo.Desc}
+			args.AssetInfo.assetinfo = args.AssetInfo.toAssetInfo()
 			if err := types.IsAssetInfoValid(args.AssetInfo.assetinfo); nil != err {
 				return 0, err
 			}
+			if err := types.IsSupplyValid(args.AssetInfo.assetinfo); nil != err {
+				return 0, err
+			}
+			if err := types.IsMetadataValid(args.AssetInfo.assetinfo); nil != err {
+				return 0, err
+			}
 		}
 	}
 	// Binary search the gas requirement, as it may be higher than the amount used
@@ -848,14 +1006,100 @@ func (s *PublicBlockChainAPI) EstimateGas(ctx context.Context, args CallArgs) (h
 	return hexutil.Uint64(hi), nil
 }
 
-//
-func (s *PublicBlockChainAPI) GetAssetInfo(ctx context.Context, asset common.Address) (*types.AssetInfo, error) {
+// RPCAssetInfo is an asset description along with its current circulating supply.
+// Decimals is always filled in, with the default for assets published without.
+type RPCAssetInfo struct {
+	Address common.Address `json:"address"`
+	*types.AssetInfo
+	Decimals          uint8        `json:"decimals"`
+	CirculatingSupply *hexutil.Big `json:"circulatingSupply"`
+	FeeRate           *hexutil.Big `json:"feeRate"` // asset units charged per AOA of gas paid from the fee pool
+	FeePool           *hexutil.Big `json:"feePool"` // AOA held by the fee pool
+}
+
+// GetAssetInfo returns the published information of an asset and its
+// circulating supply at the head of the chain.
+func (s *PublicBlockChainAPI) GetAssetInfo(ctx context.Context, asset common.Address) (*RPCAssetInfo, error) {
 	state, _, err := s.b.StateAndHeaderByNumber(ctx, rpc.BlockNumber(s.b.CurrentBlock().Number().Int64()))
 	if state == nil || err != nil {
 		return nil, err
 	}
-	return state.GetAssetInfo(asset)
+	return newRPCAssetInfo(state, asset)
+}
 
+// GetAssetBySymbol returns the asset registered under the given symbol, the
+// lookup is case-insensitive. Assets published before the symbol registry fork
+// are not registered.
+func (s *PublicBlockChainAPI) GetAssetBySymbol(ctx context.Context, symbol string) (*RPCAssetInfo, error) {
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, rpc.BlockNumber(s.b.CurrentBlock().Number().Int64()))
+	if state == nil || err != nil {
+		return nil, err
+	}
+	asset := state.GetAssetBySymbol(symbol)
+	if asset == (common.Address{}) {
+		return nil, fmt.Errorf("asset symbol %s not registered", symbol)
+	}
+	return newRPCAssetInfo(state, asset)
+}
+
+// maxAssetHolders is the maximum number of holders returned by a single
+// GetAssetHolders call.
+const maxAssetHolders = 1000
+
+var errAssetHolderIndexDisabled = errors.New("asset holder index not enabled")
+
+// RPCAssetHolder is a holder of an asset with its balance.
+type RPCAssetHolder struct {
+	Address common.Address `json:"address"`
+	Balance *hexutil.Big   `json:"balance"`
+}
+
+// GetAssetHolders returns at most limit holders of the given asset starting at
+// offset. The holders are read from the asset holder index, which trails the
+// chain head by a few blocks and is only available on nodes running it. The
+// holder order is unspecified and changes as holders are removed, so paging
+// through the list is only consistent while the index doesn't advance.
+func (s *PublicBlockChainAPI) GetAssetHolders(ctx context.Context, asset common.Address, offset, limit hexutil.Uint64) ([]RPCAssetHolder, error) {
+	if !s.b.IsAssetHolderIndexEnable() {
+		return nil, errAssetHolderIndexDisabled
+	}
+	if limit > maxAssetHolders {
+		return nil, fmt.Errorf("limit %d exceeds the maximum of %d holders", limit, maxAssetHolders)
+	}
+	holders := core.GetAssetHolders(s.b.ChainDb(), asset, uint64(offset), uint64(limit))
+	result := make([]RPCAssetHolder, len(holders))
+	for i, holder := range holders {
+		result[i] = RPCAssetHolder{Address: holder.Address, Balance: (*hexutil.Big)(holder.Balance)}
+	}
+	return result, nil
+}
+
+// GetAssetHolderCount returns the number of holders of the given asset in the
+// asset holder index.
+func (s *PublicBlockChainAPI) GetAssetHolderCount(ctx context.Context, asset common.Address) (hexutil.Uint64, error) {
+	if !s.b.IsAssetHolderIndexEnable() {
+		return 0, errAssetHolderIndexDisabled
+	}
+	return hexutil.Uint64(core.GetAssetHolderCount(s.b.ChainDb(), asset)), nil
+}
+
+func newRPCAssetInfo(statedb *state.StateDB, asset common.Address) (*RPCAssetInfo, error) {
+	ai, err := statedb.GetAssetInfo(asset)
+	if err != nil {
+		return nil, err
+	}
+	supply, err := statedb.GetAssetSupply(asset)
+	if err != nil {
+		return nil, err
+	}
+	return &RPCAssetInfo{
+		Address:           asset,
+		AssetInfo:         ai,
+		Decimals:          ai.GetDecimals(),
+		CirculatingSupply: (*hexutil.Big)(supply),
+		FeeRate:           (*hexutil.Big)(statedb.GetAssetFeeRate(asset)),
+		FeePool:           (*hexutil.Big)(statedb.GetBalance(asset)),
+	}, nil
 }
 
 // ExecutionResult groups all structured logs emitted by the EVM
@@ -992,6 +1236,7 @@ type RPCTransaction struct {
 	AssetInfo        *SendTxAssetInfo `json:"assetInfo,omitempty"`
 	SubAddress       string           `json:"subAddress,omitempty"`
 	Abi              string           `json:"abi,omitempty"`
+	MaxFeeRate       *hexutil.Big     `json:"maxFeeRate,omitempty"`
 }
 
 // newRPCTransaction returns a transaction that will serialize to the RPC
@@ -1033,9 +1278,10 @@ func newRPCTransaction(tx *types.Transaction, blockHash common.Hash, blockNumber
 	result.Votes = votes
 
 	result.Asset = tx.Asset()
+	result.MaxFeeRate = (*hexutil.Big)(tx.MaxFeeRate())
 	ai := tx.AssetInfo()
 	if nil != ai {
-		result.AssetInfo = &SendTxAssetInfo{Supply: (*hexutil.Big)(ai.Supply), Name: ai.Name, Symbol: ai.Symbol, Desc: ai.Desc}
+		result.AssetInfo = &SendTxAssetInfo{Supply: (*hexutil.Big)(ai.Supply), Name: ai.Name, Symbol: ai.Symbol, Desc: ai.Desc, MaxSupply: (*hexutil.Big)(ai.MaxSupply), FixedSupply: ai.FixedSupply, Decimals: ai.Decimals, Icon: ai.Icon, URL: ai.URL, Freezable: ai.Freezable, Whitelist: ai.Whitelist}
 	}
 	result.SubAddress = tx.SubAddress()
 	result.Abi = tx.Abi()
@@ -1232,6 +1478,11 @@ func (s *PublicTransactionPoolAPI) GetTransactionReceipt(hash common.Hash) (map[
 	if receipt.ContractAddress != (common.Address{}) {
 		fields["contractAddress"] = receipt.ContractAddress
 	}
+	// gas paid from the fee pool of an asset
+	if receipt.FeeAsset != nil {
+		fields["feeAsset"] = receipt.FeeAsset
+		fields["feeAmount"] = (*hexutil.Big)(receipt.FeeAmount)
+	}
 
 	// inner transactions
 	if s.b.IsWatchInnerTxEnable() {
@@ -1278,30 +1529,61 @@ type SendTxArgs struct {
 	Nonce    *hexutil.Uint64 `json:"nonce"`
 	// We accept "data" and "input" for backwards-compatibility reasons. "input" is the
 	// newer name and should be preferred by clients.
-	Data       *hexutil.Bytes   `json:"data"`
-	Input      *hexutil.Bytes   `json:"input"`
-	Action     uint64           `json:"action"`
-	Vote       []types.Vote     `json:"vote"`
-	Nickname   string           `json:"nickname"`
-	Asset      *common.Address  `json:"asset"`
-	AssetInfo  *SendTxAssetInfo `json:"assetInfo,omitempty"`
-	SubAddress string           `json:"subAddress,omitempty"`
-	Abi        string           `json:"abi,omitempty"`
+	Data       *hexutil.Bytes      `json:"data"`
+	Input      *hexutil.Bytes      `json:"input"`
+	Action     uint64              `json:"action"`
+	Vote       []types.Vote        `json:"vote"`
+	Nickname   string              `json:"nickname"`
+	Asset      *common.Address     `json:"asset"`
+	AssetInfo  *SendTxAssetInfo    `json:"assetInfo,omitempty"`
+	SubAddress string              `json:"subAddress,omitempty"`
+	Abi        string              `json:"abi,omitempty"`
+	Commission *hexutil.Uint64     `json:"commission,omitempty"` // delegate commission in basis points, for ActionSetCommission
+	Enable     *bool               `json:"enable,omitempty"`     // freeze or whitelist instead of lifting it, for ActionFreezeAsset and ActionWhitelistAsset
+	FeeRate    *hexutil.Big        `json:"feeRate,omitempty"`    // asset units charged per AOA of gas, for ActionSetFeePool
+	Withdraw   *hexutil.Big        `json:"withdraw,omitempty"`   // AOA taken out of the fee pool, for ActionSetFeePool
+	Transfers  []BatchTransferArgs `json:"transfers,omitempty"`  // recipients paid out, for ActionBatchTransfer
+	MaxFeeRate *hexutil.Big        `json:"maxFeeRate,omitempty"` // highest fee pool rate accepted to pay the gas in the asset
+}
+
+// BatchTransferArgs is one leg of a batch transfer, a nil asset transfers AOA.
+type BatchTransferArgs struct {
+	To     common.Address  `json:"to"`
+	Asset  *common.Address `json:"asset,omitempty"`
+	Amount *hexutil.Big    `json:"amount"`
+}
+
+func (args *SendTxArgs) batchTransfers() []types.BatchTransferEntry {
+	transfers := make([]types.BatchTransferEntry, len(args.Transfers))
+	for i, bt := range args.Transfers {
+		transfers[i] = types.BatchTransferEntry{To: bt.To, Asset: bt.Asset, Amount: (*big.Int)(bt.Amount)}
+	}
+	return transfers
 }
 
 type SendTxAssetInfo struct {
-	Name      string       `json:"name"`
-	Symbol    string       `json:"symbol"`
-	Supply    *hexutil.Big `json:"supply"`
-	Desc      string       `json:"desc"`
-	assetinfo *types.AssetInfo
+	Name        string       `json:"name"`
+	Symbol      string       `json:"symbol"`
+	Supply      *hexutil.Big `json:"supply"`
+	Desc        string       `json:"desc"`
+	MaxSupply   *hexutil.Big `json:"maxSupply,omitempty"`
+	FixedSupply bool         `json:"fixedSupply,omitempty"`
+	Decimals    *uint8       `json:"decimals,omitempty"`
+	Icon        string       `json:"icon,omitempty"`
+	URL         string       `json:"url,omitempty"`
+	Freezable   bool         `json:"freezable,omitempty"`
+	Whitelist   bool         `json:"whitelist,omitempty"`
+	assetinfo   *types.AssetInfo
+}
+
+func (ai *SendTxAssetInfo) toAssetInfo() *types.AssetInfo {
+	return &types.AssetInfo{Supply: (*big.Int)(ai.Supply), Name: ai.Name, Symbol: ai.Symbol, Desc: ai.Desc, MaxSupply: (*big.Int)(ai.MaxSupply), FixedSupply: ai.FixedSupply, Decimals: ai.Decimals, Icon: ai.Icon, URL: ai.URL, Freezable: ai.Freezable, Whitelist: ai.Whitelist}
 }
 
 // setDefaults is a helper function that fills in default values for unspecified tx fields.
 func (args *SendTxArgs) setDefaults(ctx context.Context, b Backend) error {
 	//log.Debug("SendTdxArgs start", "to", args.To, "assetInfo", args.AssetInfo, "args", args)
-	// ActionCallContract is the largest action number
-	if args.Action > types.ActionCallContract {
+	if !core.IsActionSupported(b.ChainConfig(), new(big.Int).Add(b.CurrentBlock().Number(), common.Big1), args.Action) {
 		return fmt.Errorf("Illegal action: %d", args.Action)
 	}
 
@@ -1334,6 +1616,18 @@ func (args *SendTxArgs) setDefaults(ctx context.Context, b Backend) error {
 	if args.Gas == nil {
 		args.Gas = new(hexutil.Uint64)
 		*(*uint64)(args.Gas) = defaultGas(args.Action)
+		if args.Action == types.ActionBatchTransfer {
+			// a batch transfer pays for every recipient and its payload
+			data, err := rlp.EncodeToBytes(args.batchTransfers())
+			if err != nil {
+				return err
+			}
+			gas, err := core.IntrinsicGas(data, args.Action)
+			if err != nil {
+				return err
+			}
+			*(*uint64)(args.Gas) = gas
+		}
 	}
 	//log.Debug("SendTdxArgs Process SubAddress", "to", args.To, "subAddress", args.SubAddress, "gas", *args.Gas)
 
@@ -1411,10 +1705,93 @@ func (args *SendTxArgs) setDefaults(ctx context.Context, b Backend) error {
 		if args.AssetInfo == nil {
 			return errors.New(`Action is "ActionPublishAsset" but the AssetInfo is nil.`)
 		} else {
-			args.AssetInfo.assetinfo = &types.AssetInfo{Supply: (*big.Int)(args.AssetInfo.Supply), Name: args.AssetInfo.Name, Symbol: args.AssetInfo.Symbol, Desc: args.AssetInfo.Desc}
+			args.AssetInfo.assetinfo = args.AssetInfo.toAssetInfo()
 			if nil == args.AssetInfo.assetinfo.Supply || args.AssetInfo.assetinfo.Supply.Cmp(common.Big0) == 0 {
 				return errors.New("Supply must be greater than 0")
 			}
+			if err := types.IsSupplyValid(args.AssetInfo.assetinfo); nil != err {
+				return err
+			}
+			if err := types.IsMetadataValid(args.AssetInfo.assetinfo); nil != err {
+				return err
+			}
+		}
+	case types.ActionFreezeAsset, types.ActionWhitelistAsset:
+		if args.Asset == nil {
+			return errors.New("asset can not be nil")
+		}
+		if args.Enable == nil {
+			return errors.New(`Action is "ActionFreezeAsset" or "ActionWhitelistAsset" but enable is nil.`)
+		}
+		if args.Action == types.ActionWhitelistAsset && args.To == "" {
+			return errors.New("whitelist account can not be empty")
+		}
+	case types.ActionSetFeePool:
+		if args.Asset == nil {
+			return errors.New("asset can not be nil")
+		}
+		if args.FeeRate == nil {
+			return errors.New(`Action is "ActionSetFeePool" but the feeRate is nil.`)
+		}
+		if args.Value == nil {
+			args.Value = new(hexutil.Big)
+		}
+	case types.ActionApproveAsset:
+		if args.Asset == nil {
+			return errors.New("asset can not be nil")
+		}
+		if args.To == "" {
+			return errors.New("spender can not be empty")
+		}
+		if args.Value == nil || args.Value.ToInt().Sign() < 0 {
+			return errors.New("Invalid value")
+		}
+	case types.ActionBatchTransfer:
+		if len(args.Transfers) == 0 {
+			return errors.New(`Action is "ActionBatchTransfer" but the transfers are empty.`)
+		}
+		if len(args.Transfers) > params.MaxBatchTransfers {
+			return fmt.Errorf("batch transfer exceeds %d recipients", params.MaxBatchTransfers)
+		}
+		for _, bt := range args.Transfers {
+			if bt.Amount == nil || bt.Amount.ToInt().Sign() <= 0 {
+				return errors.New("Invalid transfer amount to " + bt.To.Hex())
+			}
+		}
+	case types.ActionUpdateAsset:
+		if args.Asset == nil {
+			return errors.New("asset can not be nil")
+		}
+		if args.AssetInfo == nil {
+			return errors.New(`Action is "ActionUpdateAsset" but the AssetInfo is nil.`)
+		}
+		// only the desc, icon and url set in the AssetInfo are replaced, the
+		// ones left empty keep their published value
+		args.AssetInfo.assetinfo = args.AssetInfo.toAssetInfo()
+		if err := types.IsMetadataValid(args.AssetInfo.assetinfo); nil != err {
+			return err
+		}
+	case types.ActionMintAsset, types.ActionBurnAsset:
+		if args.Asset == nil {
+			return errors.New("asset can not be nil")
+		}
+		if args.Value == nil || args.Value.ToInt().Sign() <= 0 {
+			return errors.New("Invalid value")
+		}
+		// minting to nobody in particular credits the issuer itself
+		if args.Action == types.ActionMintAsset && args.To == "" {
+			args.To = args.From.Hex()
+		}
+	case types.ActionSetCommission:
+		if args.Commission == nil {
+			return errors.New(`Action is "ActionSetCommission" but the commission is nil.`)
+		}
+		if uint64(*args.Commission) > params.CommissionDenominator {
+			return core.ErrInvalidCommission
+		}
+	case types.ActionSlash:
+		if (nil == args.Input || len(*args.Input) == 0) && (nil == args.Data || len(*args.Data) == 0) {
+			return errors.New(`Action is "ActionSlash" but the evidence is nil.`)
 		}
 	case types.ActionCreateContract, types.ActionCallContract:
 		if args.Data != nil && args.Input != nil && !bytes.Equal(*args.Data, *args.Input) {
@@ -1432,6 +1809,8 @@ func defaultGas(action uint64) uint64 {
 		return params.TxGas
 	case types.ActionPublishAsset:
 		return params.TxGasAssetPublish
+	case types.ActionUpdateAsset:
+		return params.TxGasAssetUpdate
 	default:
 		return 90000
 	}
@@ -1476,7 +1855,17 @@ func sliceContains(address common.Address, slice []common.Address) (int, bool) {
 	return -1, false
 }
 
+// toTransaction builds the transaction of the arguments, paying its gas from
+// the fee pool of the asset when a maximum rate is given.
 func (args *SendTxArgs) toTransaction() (*types.Transaction, error) {
+	tx, err := args.newTransaction()
+	if err != nil || args.MaxFeeRate == nil {
+		return tx, err
+	}
+	return tx.WithMaxFeeRate((*big.Int)(args.MaxFeeRate)), nil
+}
+
+func (args *SendTxArgs) newTransaction() (*types.Transaction, error) {
 	var input []byte
 	if args.Data != nil {
 		input = *args.Data
@@ -1505,6 +1894,70 @@ func (args *SendTxArgs) toTransaction() (*types.Transaction, error) {
 	case types.ActionCreateContract:
 		return types.NewContractCreation(uint64(*args.Nonce), (*big.Int)(args.Value), uint64(*args.Gas), (*big.Int)(args.GasPrice), input, args.Abi, args.Asset), nil
 
+	case types.ActionUnjail:
+		return types.NewUnjailTransaction(uint64(*args.Nonce), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionSetCommission:
+		return types.NewCommissionTransaction(uint64(*args.Nonce), uint64(*args.Gas), (*big.Int)(args.GasPrice), uint64(*args.Commission)), nil
+
+	case types.ActionClaimReward:
+		return types.NewClaimRewardTransaction(uint64(*args.Nonce), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionUnregister:
+		return types.NewUnregisterTransaction(uint64(*args.Nonce), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionSlash:
+		return types.NewSlashTransaction(uint64(*args.Nonce), uint64(*args.Gas), (*big.Int)(args.GasPrice), input), nil
+
+	case types.ActionMintAsset:
+		if !common.IsHexAddress(args.To) && !common.IsAoaAddress(args.To) {
+			return nil, errors.New("Invalid receiver address " + args.To)
+		}
+		return types.NewMintAssetTransaction(uint64(*args.Nonce), common.HexToAddress(args.To), *args.Asset, (*big.Int)(args.Value), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionUpdateAsset:
+		// only the metadata is carried, everything else is fixed at publish time
+		metadata := types.AssetInfo{Desc: args.AssetInfo.Desc, Icon: args.AssetInfo.Icon, URL: args.AssetInfo.URL}
+		assetInfoBytes, err := types.AssetInfoToBytes(metadata)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewUpdateAssetTransaction(uint64(*args.Nonce), *args.Asset, assetInfoBytes, uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionFreezeAsset:
+		// without an account the asset is frozen for everyone but the issuer
+		var account *common.Address
+		if args.To != "" {
+			if !common.IsHexAddress(args.To) && !common.IsAoaAddress(args.To) {
+				return nil, errors.New("Invalid account address " + args.To)
+			}
+			to := common.HexToAddress(args.To)
+			account = &to
+		}
+		return types.NewFreezeAssetTransaction(uint64(*args.Nonce), *args.Asset, account, *args.Enable, uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionWhitelistAsset:
+		if !common.IsHexAddress(args.To) && !common.IsAoaAddress(args.To) {
+			return nil, errors.New("Invalid account address " + args.To)
+		}
+		return types.NewWhitelistAssetTransaction(uint64(*args.Nonce), *args.Asset, common.HexToAddress(args.To), *args.Enable, uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionBurnAsset:
+		return types.NewBurnAssetTransaction(uint64(*args.Nonce), *args.Asset, (*big.Int)(args.Value), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionSetFeePool:
+		update := types.FeePoolUpdate{Rate: (*big.Int)(args.FeeRate), Withdraw: (*big.Int)(args.Withdraw)}
+		return types.NewSetFeePoolTransaction(uint64(*args.Nonce), *args.Asset, (*big.Int)(args.Value), update, uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionApproveAsset:
+		if !common.IsHexAddress(args.To) && !common.IsAoaAddress(args.To) {
+			return nil, errors.New("Invalid spender address " + args.To)
+		}
+		return types.NewApproveAssetTransaction(uint64(*args.Nonce), *args.Asset, common.HexToAddress(args.To), (*big.Int)(args.Value), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
+	case types.ActionBatchTransfer:
+		return types.NewBatchTransferTransaction(uint64(*args.Nonce), args.batchTransfers(), uint64(*args.Gas), (*big.Int)(args.GasPrice)), nil
+
 	default:
 		if !common.IsHexAddress(args.To) && !common.IsAoaAddress(args.To) {
 			return nil, errors.New("Invalid receiver address " + args.To + args.SubAddress)
@@ -0,0 +1,86 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package aoaapi
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/common/hexutil"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+)
+
+func TestStateOverride(t *testing.T) {
+	var (
+		account = common.HexToAddress("0xc0de")
+		asset   = common.HexToAddress("0xa55e7")
+		first   = common.HexToHash("0x01")
+		second  = common.HexToHash("0x02")
+		code    = hexutil.Bytes(common.FromHex("600035602035604035e100"))
+	)
+	db, _ := aoadb.NewMemDatabase()
+	base, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	base.AddAssetBalance(account, asset, big.NewInt(100))
+	base.SetState(account, first, common.HexToHash("0x11"))
+	base.SetState(account, second, common.HexToHash("0x22"))
+	root, _ := base.CommitTo(db, true)
+
+	tests := []struct {
+		name     string
+		override OverrideAccount
+		fail     bool
+		balance  int64 // asset balance after the override
+		storage  [2]common.Hash
+	}{
+		{"none", OverrideAccount{}, false, 100, [2]common.Hash{common.HexToHash("0x11"), common.HexToHash("0x22")}},
+		{"code and assets", OverrideAccount{Code: &code, Assets: map[common.Address]*hexutil.Big{asset: (*hexutil.Big)(big.NewInt(500))}}, false, 500, [2]common.Hash{common.HexToHash("0x11"), common.HexToHash("0x22")}},
+		{"negative asset balance", OverrideAccount{Assets: map[common.Address]*hexutil.Big{asset: (*hexutil.Big)(big.NewInt(-1))}}, true, 0, [2]common.Hash{}},
+		// state replaces the whole storage, storage patches single slots
+		{"state", OverrideAccount{State: map[common.Hash]common.Hash{first: common.HexToHash("0x33")}}, false, 100, [2]common.Hash{common.HexToHash("0x33"), {}}},
+		{"storage", OverrideAccount{Storage: map[common.Hash]common.Hash{first: common.HexToHash("0x33")}}, false, 100, [2]common.Hash{common.HexToHash("0x33"), common.HexToHash("0x22")}},
+		{"state and storage", OverrideAccount{State: map[common.Hash]common.Hash{}, Storage: map[common.Hash]common.Hash{}}, true, 0, [2]common.Hash{}},
+	}
+	for _, test := range tests {
+		statedb, _ := state.New(root, state.NewDatabase(db))
+		overrides := StateOverride{account: test.override}
+		if err := overrides.Apply(statedb); (err != nil) != test.fail {
+			t.Errorf("%s: error mismatch: have %v, want failure %v", test.name, err, test.fail)
+		}
+		if test.fail {
+			continue
+		}
+		if balance := statedb.GetAssetBalance(account, asset); balance.Int64() != test.balance {
+			t.Errorf("%s: asset balance mismatch: have %v, want %d", test.name, balance, test.balance)
+		}
+		for i, key := range []common.Hash{first, second} {
+			if value := statedb.GetState(account, key); value != test.storage[i] {
+				t.Errorf("%s: slot %x mismatch: have %x, want %x", test.name, key, value, test.storage[i])
+			}
+		}
+		if test.override.Code != nil && !bytes.Equal(statedb.GetCode(account), code) {
+			t.Errorf("%s: code mismatch: have %x, want %x", test.name, statedb.GetCode(account), code)
+		}
+	}
+	// a nil override changes nothing
+	var overrides *StateOverride
+	if err := overrides.Apply(base); err != nil {
+		t.Errorf("nil override failed: %v", err)
+	}
+}
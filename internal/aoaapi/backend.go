@@ -24,6 +24,7 @@ import (
 	"github.com/Aurorachain-io/go-aoa/accounts"
 	aa "github.com/Aurorachain-io/go-aoa/accounts/walletType"
 	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/consensus/delegatestate"
 	"github.com/Aurorachain-io/go-aoa/core"
 	"github.com/Aurorachain-io/go-aoa/core/state"
 	"github.com/Aurorachain-io/go-aoa/core/types"
@@ -60,6 +61,7 @@ type Backend interface {
 	SubscribeChainHeadEvent(ch chan<- core.ChainHeadEvent) event.Subscription
 	SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) event.Subscription
 	GetDelegatePoll(block *types.Block) (*map[common.Address]types.Candidate, error)
+	DelegateStateAt(block *types.Block) (*delegatestate.DelegateDB, error)
 
 	// TxPool API
 	SendTx(ctx context.Context, signedTx *types.Transaction) error
@@ -76,6 +78,9 @@ type Backend interface {
 	// inner transactions watcher
 	IsWatchInnerTxEnable() bool
 	GetInnerTxDb() watch.InnerTxDb
+
+	// asset holder index
+	IsAssetHolderIndexEnable() bool
 }
 
 func GetAPIs(apiBackend Backend) []rpc.API {
@@ -0,0 +1,92 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package aoaapi
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Aurorachain-io/go-aoa/accounts/abi"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/rpc"
+)
+
+// erc20ViewABI is the read only part of the ERC-20 interface. Every asset
+// answers it at its own address, so standard token tooling can inspect native
+// assets through aoa_call.
+const erc20ViewABI = `[
+	{"constant":true,"inputs":[],"name":"name","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[],"name":"symbol","outputs":[{"name":"","type":"string"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[],"name":"decimals","outputs":[{"name":"","type":"uint8"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[],"name":"totalSupply","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"},
+	{"constant":true,"inputs":[{"name":"owner","type":"address"}],"name":"balanceOf","outputs":[{"name":"","type":"uint256"}],"payable":false,"stateMutability":"view","type":"function"}
+]`
+
+var erc20View, _ = abi.JSON(strings.NewReader(erc20ViewABI))
+
+// callAssetView answers an ERC-20 view call against an asset address from the
+// native asset state. It reports false if the call is not such a view call and
+// has to be executed by the EVM instead.
+func (s *PublicBlockChainAPI) callAssetView(ctx context.Context, args CallArgs, blockNr rpc.BlockNumber) ([]byte, bool, error) {
+	if args.To == nil || len(args.Data) < 4 || args.Value.ToInt().Sign() != 0 {
+		return nil, false, nil
+	}
+	if args.Action != types.ActionTrans && args.Action != types.ActionCallContract {
+		return nil, false, nil
+	}
+	method := erc20View.MethodById(args.Data[:4])
+	if method == nil {
+		return nil, false, nil
+	}
+	state, _, err := s.b.StateAndHeaderByNumber(ctx, blockNr)
+	if state == nil || err != nil {
+		return nil, false, err
+	}
+	asset := *args.To
+	ai, err := state.GetAssetInfo(asset)
+	if err != nil {
+		return nil, false, nil
+	}
+	result, err := assetViewResult(state, method, args.Data[4:], asset, ai)
+	return result, true, err
+}
+
+// assetViewResult executes the given ERC-20 view method for an asset.
+func assetViewResult(state *state.StateDB, method *abi.Method, input []byte, asset common.Address, ai *types.AssetInfo) ([]byte, error) {
+	switch method.Name {
+	case "name":
+		return method.Outputs.Pack(ai.Name)
+	case "symbol":
+		return method.Outputs.Pack(ai.Symbol)
+	case "decimals":
+		return method.Outputs.Pack(ai.GetDecimals())
+	case "totalSupply":
+		supply, err := state.GetAssetSupply(asset)
+		if err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(supply)
+	default: // balanceOf
+		var owner common.Address
+		if err := method.Inputs.Unpack(&owner, input); err != nil {
+			return nil, err
+		}
+		return method.Outputs.Pack(state.GetAssetBalance(owner, asset))
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2021 The go-aoa Authors
+// This file is part of the go-aoa library.
+//
+// The the go-aoa library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The the go-aoa library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-aoa library. If not, see <http://www.gnu.org/licenses/>.
+
+package aoaapi
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/Aurorachain-io/go-aoa/aoadb"
+	"github.com/Aurorachain-io/go-aoa/common"
+	"github.com/Aurorachain-io/go-aoa/core/state"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+)
+
+func TestAssetViewResult(t *testing.T) {
+	var (
+		issuer    = common.HexToAddress("0x1")
+		recipient = common.HexToAddress("0x2")
+		asset     = crypto.CreateAddress(issuer, 0)
+		decimals  = uint8(6)
+	)
+	db, _ := aoadb.NewMemDatabase()
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(db))
+	if err := statedb.PublishAsset(issuer, types.AssetInfo{Name: "Voucher", Symbol: "VCH", Supply: big.NewInt(1e6), Decimals: &decimals}); err != nil {
+		t.Fatalf("failed to publish asset: %v", err)
+	}
+	statedb.SubAssetBalance(issuer, asset, big.NewInt(2500))
+	statedb.AddAssetBalance(recipient, asset, big.NewInt(2500))
+	statedb.MintAsset(asset, recipient, big.NewInt(500))
+	ai, err := statedb.GetAssetInfo(asset)
+	if err != nil {
+		t.Fatalf("failed to get asset info: %v", err)
+	}
+
+	word := func(n int64) []byte { return common.LeftPadBytes(big.NewInt(n).Bytes(), 32) }
+	// strings are returned as offset, length and padded content
+	str := func(s string) []byte {
+		return append(append(word(32), word(int64(len(s)))...), common.RightPadBytes([]byte(s), 32)...)
+	}
+	tests := []struct {
+		method string
+		input  []byte
+		want   []byte
+	}{
+		{"name", nil, str("Voucher")},
+		{"symbol", nil, str("VCH")},
+		{"decimals", nil, word(int64(decimals))},
+		{"totalSupply", nil, word(1e6 + 500)},
+		{"balanceOf", common.LeftPadBytes(recipient.Bytes(), 32), word(3000)},
+		{"balanceOf", common.LeftPadBytes(issuer.Bytes(), 32), word(1e6 - 2500)},
+		{"balanceOf", common.LeftPadBytes(asset.Bytes(), 32), word(0)},
+	}
+	for _, test := range tests {
+		method := erc20View.Methods[test.method]
+		have, err := assetViewResult(statedb, &method, test.input, asset, ai)
+		if err != nil {
+			t.Errorf("%s: call failed: %v", test.method, err)
+			continue
+		}
+		if !bytes.Equal(have, test.want) {
+			t.Errorf("%s: result mismatch: have %x, want %x", test.method, have, test.want)
+		}
+	}
+}
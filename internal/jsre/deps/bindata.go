@@ -99,7 +99,7 @@ func bignumberJs() (*asset, error) {
 	return a, nil
 }
 
-var _web3Js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x6b\x5b\x1b\x39\xd2\x38\x0e\xbf\xe7\x53\x08\x3f\xf7\x13\xdb\xc1\xb1\x0d\x64\x32\x19\x33\x4c\x96\x1c\x66\xc2\xde\x49\xc8\x95\x84\x9d\xdd\x9b\x65\x73\x35\x6e\xd9\xee\x49\xbb\xdb\xbf\xee\x36\x84\x09\xfe\xee\xff\x4b\xa5\x53\xe9\xd0\x07\x03\x99\xd3\xc2\x8b\xa4\x2d\x95\x4e\xa5\x52\xa9\x54\x2a\x55\x65\xf4\xff\x2d\xa3\x8c\xee\x77\x26\xcb\x64\x5c\x44\x69\x42\x68\xa7\xe8\x25\xbd\xac\xfb\x45\xa5\xe4\x9d\xb4\xb7\xec\x7e\x89\x26\x9d\xcd\xe4\x24\x3d\xe5\x5f\x05\x7c\x9d\x07\x19\x09\xf6\x8b\xcb\x05\x4d\x27\x44\xd6\xb5\xdf\x92\x45\x5b\xf7\xee\x89\xc4\x3d\x56\x66\x79\xef\x5e\xd0\xcd\x68\xb1\xcc\x12\x12\x74\xd2\xde\xe6\xb0\xcb\xd2\x23\x99\x16\x89\x34\x56\xeb\x64\x3f\xa1\x17\xe4\x45\x96\xa5\x59\xa7\xf5\x2c\x48\x92\xb4\x20\x93\x28\x09\xc9\x3c\x0d\x97\x31\x25\xed\xd6\x56\xba\xd5\x6a\xb7\xba\x7b\xc5\x2c\x4b\x2f\xc8\xa4\x3f\x4e\x43\xba\xdf\x7a\x7d\xf4\xfc\xf8\xd5\x8b\x8f\x6f\x8e\x3e\x7c\xfc\xf1\xe8\xf8\xcd\xf3\x56\x6f\xb2\x62\xf5\xc5\xfb\xac\xef\xfb\x5f\xe8\xe7\x45\x9a\x15\xf9\xe8\xcb\x6a\xb5\xc7\xc6\x70\x32\x3c\xed\x8f\x83\x38\xee\xc4\x7d\x91\xd5\x93\xbd\xef\x50\x3e\xc0\x64\x1f\x00\xb7\x4f\x4f\xe8\xe9\x9e\xe8\x6a\xde\x49\x9e\x24\x23\xda\x5d\xf5\xe2\x9e\x2e\x49\x7b\x1c\x77\x2b\x01\xc5\x9a\x94\x99\xd0\x8b\xa8\x11\xae\x26\x69\xd6\x61\xd0\xe9\xfe\x70\x2f\xfd\x3e\xeb\xc7\x34\x99\x16\xb3\xbd\x74\x6b\xab\x9b\x77\x32\x86\x78\xd5\x8d\x55\xb7\xf3\x65\x7b\x74\xa2\xba\x2c\xaa\xe8\x71\x2c\xf5\x44\xdb\xdd\x2f\x1b\x3c\x41\x76\x66\xff\x64\x83\x90\x2f\x1b\x84\x10\xd2\x1a\xa7\x49\x5e\x04\x49\xd1\x1a\x91\x22\x5b\xd2\x1e\x4f\x8d\x92\xc5\xb2\xc8\x5b\x23\x72\x02\xbf\x25\x34\xe4\x25\xc1\x9c\xb6\x46\xa4\xf5\x31\xbd\x48\x68\xd6\xea\xe9\x1c\x36\x3a\x96\x13\x84\x61\x46\xf3\xbc\x25\x72\x56\xf0\xff\xa9\xa8\x5a\x16\x87\xff\x45\x5a\xba\x2c\xea\xdb\x4b\x3f\xa2\x22\x46\x7b\x67\x97\x05\xcd\x77\x77\xfc\xed\x49\x20\x85\xe9\x0d\x42\x56\xbd\x5b\x41\xc0\xb5\xfa\xa3\x86\x83\xb0\xd7\x0c\x01\x6b\xa3\xfa\x8f\x3a\xf4\x71\x9a\x14\x34\x29\x6e\x3c\xf8\x3f\xe5\xbc\xb3\x19\xfb\xc3\x4c\xfb\x24\x88\xf3\xdf\x6e\xe8\x19\xcd\x69\x76\xee\x5b\xf5\x7f\xf4\x49\xcb\x97\x67\xef\xe8\x34\xca\x8b\x2c\xf8\x2f\x98\xbc\x5e\x55\x1d\xf4\xe2\xe8\x46\x7c\xbf\xc8\x82\x24\x9f\x78\x59\xdf\x9f\x05\x07\x99\x45\x0a\xeb\x23\x21\xa7\xc5\xfb\x6a\x92\xba\x35\x5c\xd8\x4d\xff\x26\x8d\x7e\xe5\x09\x08\x9a\x20\xbe\xaa\x82\x45\x16\xcd\x83\xec\xd2\xdb\x8f\x34\x8d\x6b\x27\xef\x40\xb4\xf5\xe7\x45\xa1\xb9\x07\x57\x56\x53\x86\x84\x67\xa5\xdb\xf8\x1f\x09\x09\xde\xde\x87\x51\x9e\x5e\x24\x37\xe8\x79\x90\xa4\xc9\xe5\x3c\x5d\xe6\x6b\x74\x3d\x4a\x42\xfa\x99\x86\xc6\xde\x75\x6b\x13\xab\x2b\x47\xdd\x31\x6b\xbf\x88\x92\x9b\x30\xee\x83\x25\x60\xe2\x45\x12\xd2\xb0\x65\xa1\x89\x9e\x33\x42\xf8\x0b\xe0\xe8\x2c\x0a\xc3\x66\x38\xba\x5e\xfd\xe7\x41\xbc\xf4\x76\x7f\x19\x25\xc5\xce\x37\x8f\xaa\xa7\xe0\x0d\xbd\x78\x1a\xfd\x8e\xc8\xbf\xd1\x9a\x7b\x36\x0b\x92\xe9\xef\x49\x3a\xb7\x42\x39\x25\x75\x23\xa9\xbe\x92\x6a\xbc\x98\x79\xcb\x77\xa3\x5a\x04\x6d\x9c\x6e\x6c\xac\x7a\x5f\x56\xa7\xbd\x9d\xdf\xed\xd0\xff\x17\x3a\xf3\xfe\x4e\xb2\xe3\x64\x99\x84\xd7\x26\x95\x1b\x6f\x5c\x77\xc7\xde\x3f\xf7\xb1\xf7\xee\xd0\xf7\x47\x3e\x73\x78\x07\x2f\xce\x0b\x7f\x34\x69\xf3\xeb\x6e\xe6\x7a\xaf\xda\xbd\xb5\xbd\x6a\xdd\x79\x9f\x64\xe9\xfc\x86\xd3\x5e\xa4\x37\x3c\x6a\xde\x4c\xe0\xfb\x7d\xd7\xcd\x1f\x01\x7f\x51\x12\x46\x19\x1d\x17\x87\xde\x3d\x73\x8d\x9e\xdc\x6c\x22\xa2\x71\xb0\xf8\xf0\xbb\x4e\x86\x1f\x93\xcd\x4e\xbb\x74\x91\xe6\x51\xd5\x41\x7d\x11\x5c\x06\x67\x31\x35\x85\x82\xdf\x85\x2b\x95\xd1\xdc\xad\x1c\xbf\x6e\x46\x03\x07\x72\xbc\xcf\x4d\x7c\xfe\xf6\x27\x99\x5b\x41\x52\x49\xdd\xcd\xe8\xec\x77\x40\xff\x1f\x16\xeb\xb7\x71\x7e\xbc\x36\x9f\xfc\xda\x58\xb7\x99\xde\x1d\xda\x1b\xa2\xfd\xc6\x1b\xd7\xd7\x9e\xd9\x43\xcf\x96\x56\x25\xc7\x3d\x6c\x22\xc7\x81\xf1\x06\xd9\x97\x16\x0e\x9d\x76\x7f\x30\x49\xb3\x79\x50\x14\x34\xcb\xdb\xdd\x3d\x00\x78\x9f\xc6\x51\x18\x15\x97\x1f\x2e\x17\xd4\x84\x65\xed\x33\xa8\x8d\xc1\xfd\xfb\x1b\xe4\xbe\x01\x29\x74\xee\x24\xca\x49\x40\x16\x59\x9a\x32\x60\x52\xcc\x82\x82\x64\x74\xc1\x0e\x59\x49\x91\x13\x31\x77\x84\x65\xb2\x1a\x0e\x0b\x32\x0f\x8a\xf1\x8c\xe6\x23\xf6\x53\x64\xa3\xcf\x93\x53\xfc\xe3\xa1\xf1\xeb\xd4\xcc\xdc\xb5\x7e\x9f\x9e\x3c\x3a\x3d\x39\xed\x91\x7e\xbf\xbf\x41\xee\x0f\x9c\xb1\xc9\x1e\xef\x13\x65\x4d\xd3\xe9\x8a\x29\x2e\x66\x51\xde\xff\x08\x0b\xe3\x47\x89\x20\x06\xd8\xe7\xe8\x3a\x64\x19\x87\x49\xb1\x87\x80\xf9\xbe\xed\x83\x3e\x82\x1c\xd1\xdc\xde\xc6\x6a\x6f\x63\xc3\xd3\x8f\xfe\x22\x4b\x0b\x8e\xb5\x7d\x92\xd0\x0b\xa3\xaf\x9d\x2f\xab\xee\x5e\x75\xa9\x3e\x48\x2f\xd9\x72\x5c\xa4\xac\x71\x0f\x6c\x5d\xbb\xfd\x28\x17\x73\xae\x11\xc2\xc8\x51\x22\x45\xd8\xb5\x6c\x6e\xb2\xc4\x3e\xcc\x5b\x67\x20\xb0\xdd\xf9\xf7\x49\xe7\x64\xf8\xe0\xbb\xd3\xfb\xdd\x7f\x9f\x76\x9f\x0c\xba\x7c\x9c\xe6\xc1\xa1\xb4\x5b\xab\xde\x97\x16\x26\xc5\xd6\xe8\xbb\x5e\x8b\xd3\x5b\x6b\xb4\xfd\x70\x75\xda\xfb\xe6\x77\x26\xef\xa7\x69\x1a\xd7\xd0\xf6\x19\x03\x29\x21\x6c\x96\x27\xff\xe7\x54\x0a\x5f\x0f\xf5\xe7\x29\x4a\xde\xc5\x3f\xea\xc8\x18\x7a\x76\x5d\x1a\x66\x85\xd7\x21\x62\x0e\x6f\x53\x30\x4b\x5d\x93\x7c\xcd\x22\x15\xb4\xcb\x5b\xac\x2a\x7b\x1d\xaa\xfd\x0f\x43\xad\x49\xb3\xf7\xff\xa7\x11\xd1\x8a\xfe\xd4\x53\xec\xa3\xdf\x9b\x62\xd9\x1e\xa6\x48\xb6\xf0\xd3\x6c\x31\xa3\x04\x36\x3b\x20\xdc\xbe\x8f\x72\x59\xae\xfa\x10\x74\x09\x9f\x0f\xd1\xf7\x29\xce\xd8\x35\x7e\x99\xf4\x4b\xc4\xd6\xaa\x3e\x1f\x1b\xf5\x88\xa2\x1e\x2a\x87\x4e\x5e\x9b\xcc\x59\xe9\xb5\xe8\x9c\x17\x70\x08\x9d\x25\xaf\x4b\xe9\x66\x99\x2a\x52\xe7\x8d\x56\x96\xbe\x1e\xb1\xb3\x4a\x38\xa9\x7f\xd9\xee\xad\xba\xd7\x23\x7c\xd1\xbb\x7a\xca\xff\xb6\x09\xe5\x0f\xee\x43\x87\x3f\xcc\xa2\x9c\x4c\xa2\x98\x32\x4a\x5d\x04\x59\x41\xd2\x09\xb9\xa0\x67\xbb\xfd\x5f\xf2\xfe\x06\x80\x88\x5f\x0c\x60\x92\x51\x4a\xf2\x74\x52\x5c\x04\x19\x1d\x91\xcb\x74\x49\xc6\x41\x42\x32\x1a\x46\x79\x91\x45\x67\xcb\x82\x92\xa8\x20\x41\x12\x0e\xd2\x8c\xcc\xd3\x30\x9a\x5c\x42\x1d\x51\x41\x96\x49\x48\x33\x20\xf8\x82\x66\xf3\x9c\xb5\xc3\x7e\xfc\xf4\xe6\x98\xbc\xa2\x79\x4e\x33\xf2\x13\x4d\x68\x16\xc4\xe4\xed\xf2\x2c\x8e\xc6\xe4\x55\x34\xa6\x49\x4e\x49\x90\x93\x05\x4b\xc9\x67\x34\x24\x67\x97\x82\x8a\x28\xf9\x91\x75\xe6\xbd\xe8\x0c\xf9\x31\x5d\x26\x61\xc0\xc6\xdc\x23\x34\x2a\x66\x34\x23\xe7\x34\xcb\xd9\x0c\xed\xca\xb6\x44\x8d\x3d\x92\x66\x50\x4b\x27\x28\xd8\x18\x32\x92\x2e\x58\xc1\x2e\x09\x92\x4b\x12\x07\x85\x2e\xeb\xa2\x40\x8f\x34\x24\x51\x02\xd5\xce\x52\xb9\xb2\xa3\x82\x5c\x44\x71\x4c\xce\x28\x59\xe6\x74\xb2\x8c\xb9\xe0\x78\xb6\x2c\xc8\xcf\x87\x1f\x5e\x1e\x1d\x7f\x20\x07\x6f\xfe\x45\x7e\x3e\x78\xf7\xee\xe0\xcd\x87\x7f\xed\x91\x8b\xa8\x98\xa5\xcb\x82\x30\x89\x12\xea\x8a\xe6\x8b\x38\xa2\x21\xb9\x08\xb2\x2c\x48\x8a\x4b\x92\x4e\xa0\x8a\xd7\x2f\xde\x3d\x7b\x79\xf0\xe6\xc3\xc1\xd3\xc3\x57\x87\x1f\xfe\x45\xd2\x8c\xfc\x78\xf8\xe1\xcd\x8b\xf7\xef\xc9\x8f\x47\xef\xc8\x01\x79\x7b\xf0\xee\xc3\xe1\xb3\xe3\x57\x07\xef\xc8\xdb\xe3\x77\x6f\x8f\xde\xbf\xe8\x13\xf2\x9e\xb2\x8e\x51\xa8\xa1\x1e\xd1\x13\x98\xb3\x8c\x92\x90\x16\x41\x14\xcb\xf9\xff\x57\xba\x24\xf9\x2c\x5d\xc6\x21\x99\x05\xe7\x94\x64\x74\x4c\xa3\x73\x1a\x92\x80\x8c\xd3\xc5\x65\xe3\x89\x84\xca\x82\x38\x4d\xa6\x30\x6c\x45\x65\x84\x1c\x4e\x48\x92\x16\x3d\x92\x53\x4a\xbe\x9f\x15\xc5\x62\x34\x18\x5c\x5c\x5c\xf4\xa7\xc9\xb2\x9f\x66\xd3\x41\xcc\x2b\xc8\x07\x3f\xf4\x37\xee\x0f\x24\xb3\xfd\x1b\x90\xed\x38\x0d\x69\xd6\xff\x05\x58\xe4\xdf\x82\x65\x31\x4b\x33\xf2\x3a\xc8\xe8\x27\xf2\xbf\x69\x41\x2f\xa2\xf1\xaf\xe4\xfb\x39\xfb\xfd\x37\x5a\xcc\x42\x7a\xde\x1f\xa7\xf3\x1f\x00\x38\x0c\x0a\x4a\x76\x86\xdb\xdf\x00\xc3\xab\xdf\x0a\x2a\x04\x58\x54\x46\xc8\x63\xbe\xbd\x43\x48\x0a\x08\x98\xed\x82\x3e\xc8\xc3\xa4\x30\x01\xa3\xa4\xf0\xc1\x1d\x3b\x80\xcb\x12\xc8\xe7\x97\x49\x30\x8f\xc6\x92\x8d\xa3\x12\x21\xcf\x01\x1e\xe5\x2b\xf9\xbe\xc8\xa2\x64\x6a\x96\xc9\x21\xcd\x07\xfd\x8e\x06\xd6\x18\x33\x1a\x78\xc7\x78\xec\x82\x2e\xcb\x60\x3d\xdd\x56\xfd\x05\xe0\x28\x17\x03\x34\x38\x73\x8e\xaa\xe8\xc1\x0e\x2b\xf8\xb4\xb4\x10\x47\xf9\x7d\x55\x05\x6c\x23\x1c\xf8\xea\x4a\x9d\x1e\x49\x09\xf4\x41\x96\x05\x97\x1c\x9c\x33\x71\x4b\x14\x78\xc6\xe8\x13\x49\x00\x62\x25\x71\x0e\x11\x92\x22\x25\x34\x61\x34\x3c\x08\x29\xfb\x4f\xb5\xc2\x98\x71\xc0\xd9\x24\xe3\x4a\x42\xae\x35\x37\x66\x5e\x37\x1e\x31\x03\xcb\xcd\x9d\x19\x92\xc8\x3e\xd4\x90\x1b\x5d\x04\xde\x3f\xa7\xc5\x2c\x0d\x3d\xdd\xe2\xca\xf5\x34\x9b\x13\x2e\xb9\xa4\xc6\x8c\x6c\x10\xbe\x06\x45\xf1\x8f\x62\x66\x44\x16\xf9\x1b\xf4\x9e\x7c\xe1\xc4\xb3\x52\x62\xf9\xdf\x38\xe6\x73\xf2\x05\x57\xb6\x82\x2c\x78\xab\x90\x93\x2f\xf0\xae\x61\x45\xc4\xcf\x88\xf1\x06\x2e\x11\x31\x32\x84\xbe\xb0\x9d\x88\xb1\x7b\x40\x88\x81\x0c\xb4\x53\xe3\x2e\x39\x38\x92\x28\x62\xd8\xcc\x4d\xf1\x0e\x61\xad\x3f\x89\xe2\x82\x66\x1d\x54\xb6\x8b\x74\x10\x82\x8a\x0a\x21\x14\x48\x22\x00\x9d\x42\xf7\x64\x78\xba\xc7\xf9\x67\x34\x21\x9d\x4d\xdc\x08\xae\x83\x3f\xd0\xe0\x4f\x39\xda\x51\x72\x1e\xc4\x51\xa8\x69\x80\xd5\xb8\x39\x22\x6d\xb2\x45\x70\xe5\x1b\x58\xd6\xc0\x35\x9b\x14\x58\x42\x69\x64\x11\x07\x51\xc2\xe9\xcb\x9a\x46\x0e\xf0\x56\xe4\x94\xcf\xa2\x48\x3f\x3a\xfb\x85\x8e\x8b\x95\x55\xa1\x9c\x64\x5d\x8e\x57\x1b\x5a\x70\xe5\x53\x87\xba\xe1\xcc\x5c\x8f\x97\xb7\x04\x2e\x98\x34\x54\x2c\xef\x9c\x30\xe0\xd3\x1e\x39\x01\xf0\xd3\x6e\x33\xd4\xc4\x51\x0e\x12\x10\x5f\x7c\xe5\xd8\xc9\x31\x1a\x80\x05\x70\xec\xf8\xd2\x17\xba\x40\x19\x62\x9c\x66\x1b\xe1\x26\x77\x97\xbe\xc0\x4e\x5e\x46\xdf\xb9\x24\xf0\x29\x2d\xf0\x0a\xcc\x05\xe7\x10\x24\xcb\x8a\x89\xbe\xb1\x12\x46\x0d\xfd\x79\xb0\xe8\x94\xf1\x58\xd0\xca\x79\xd6\x88\xc1\x3b\x79\xcd\x1d\xde\xd3\x13\x28\x72\xca\xd9\xb3\xfc\xa5\x56\x11\xea\x8f\xd8\xa7\x8e\x26\x93\x9c\x16\x4e\xa7\x32\x1a\x2e\xc7\x14\xf5\x2b\x18\x8f\x7b\xa4\xa6\x73\x80\x9d\x22\x28\xa2\xf1\xdb\x20\x2b\x5e\xc1\x4b\x22\xab\xe6\xbe\x9d\xdf\xf1\xf4\x53\xd6\x95\x31\xa6\x44\xc3\xf7\x6e\x95\xaf\x83\x62\xd6\x9f\xc4\x69\x9a\x75\x3a\x4e\x8b\x5b\x64\x77\xbb\x4b\x06\x64\x77\xa7\x4b\xee\x93\xdd\x1d\x31\x68\x84\xbe\x60\x3c\x26\x5b\xa4\xa3\x36\x1d\x03\xeb\x25\x28\x24\x4f\xd0\xde\x45\xc8\xee\x0e\x19\x19\x09\x25\x9d\x95\xa8\xef\x91\x21\xc6\x7e\x46\xf3\x65\x5c\x48\xea\xe1\x33\xf8\x7a\x19\x17\xd1\xcf\x51\x31\xe3\x73\x22\x29\xd0\xe8\x5b\x4f\xd1\x51\xcf\x9c\x41\x59\xb9\x18\x21\xaf\xdf\x3c\xf1\xf9\x49\xdf\x6a\xd5\xb7\x06\x1a\xf6\x00\xad\x11\x35\xbc\x56\x6b\x4f\x2f\x1c\x1a\x4f\xc4\x88\x45\x67\xc5\xae\x90\x66\x2f\x82\xf1\xac\x63\x33\xa6\x08\xd3\x16\xe3\xfa\xa5\xf3\xa5\xe7\xea\xb4\x8b\x0b\x71\x84\x40\x57\xb6\x5c\x6d\x67\xc7\xec\xbe\x5c\x47\x88\x08\xd5\xda\x65\x54\x4c\xe3\x89\x00\xb1\xe7\x08\x3a\xe0\x76\x49\xe2\x09\x7e\xd8\x93\x85\x9b\x30\x97\xe2\xd6\x3e\xa1\xe2\x19\x1e\x19\x90\x1d\x0d\xba\x22\x34\xce\xa9\x35\xbc\xc1\x80\x84\x69\xd2\x2e\x48\x10\x86\x44\x94\x2a\x52\xb3\xca\x3e\x89\x8a\x76\x4e\x82\x38\xa3\x41\x78\x49\xc6\xe9\x32\x29\x68\x58\x82\xa5\xaf\x34\xce\x95\x5e\x84\x83\x01\xf9\x70\xf4\xfc\x68\x44\x26\xd1\x74\x99\x51\xc2\x0e\x6c\x09\xcd\xd9\x09\x90\x9d\xd2\x2e\x73\x93\x59\xfd\x16\x44\xf2\xc7\x99\x64\x73\x32\x28\x46\xa0\xc4\x4a\xc9\x32\x57\x68\xcd\xe8\x24\x00\x75\xcc\xc5\x2c\x8d\x29\xef\x61\x94\x4c\x37\x6b\x18\x41\x05\x0f\xb0\x39\xbf\x18\x74\x8f\xa4\xce\xca\x37\x16\xb9\x9c\x93\x5a\x51\xdf\xb3\xc5\x75\x5c\xd5\x18\x22\x20\xde\x30\xb9\x08\x34\x59\xe7\xb4\x70\xe6\x94\x93\xd5\x9b\x60\x4e\xed\x7d\x48\xe7\x60\x39\xd3\x2d\xeb\xd9\x7c\xaa\xf7\x33\x5d\xb1\xa7\x4e\xc5\x17\x05\x06\xb5\x54\x2b\xff\x2a\x86\x2d\x2b\x59\x64\xf4\x3c\x4a\x97\xb9\xea\xd0\xce\x1e\x43\x49\x94\x90\x28\x29\x9c\x12\x75\xf8\x47\xfd\xf5\x35\xc8\xfe\x26\x69\x46\xe0\x91\x70\x44\xf6\xc9\xf6\x1e\x89\xc8\xf7\x72\x00\xf2\xbd\x30\x89\xb6\xb6\xca\x8a\xb3\x3f\xab\xcf\x5b\xfb\x64\xab\x23\x71\x10\x91\x07\x64\xfb\x94\x49\xf8\xe4\xea\x8a\x0c\xf7\x4a\x2b\xa9\x60\xe5\x82\x1e\xb6\x48\x44\xee\x97\xcd\xdc\x96\xdd\x0b\x26\x1c\x94\xb1\x7d\xf9\xb7\x72\x52\xcd\x94\x55\xb7\xd3\xb5\xa6\x70\x30\x20\x93\x28\xcb\x0b\x42\x63\x3a\xa7\x49\xc1\xce\x57\x1c\x4d\x3d\x92\x7f\x8a\x16\x24\x2a\xd6\x99\x72\x03\xfb\x43\x1f\xf6\x19\xfe\x2a\x67\x00\x9e\xce\x87\x61\xc4\x1a\x09\x62\xb5\xc8\x05\x3e\x1d\xfe\xe3\xe2\xdb\xcf\x17\x35\xe9\x94\x30\x88\x93\x88\x6c\x91\xed\x53\xc9\x27\xc8\x16\x71\xba\xe1\x41\x7b\x2d\x82\x2d\xe6\xe7\x81\x14\x5b\xa5\x87\xf6\x39\x55\x5c\x9b\xf5\xfc\xa1\x99\x0a\x13\xb6\x4c\x4c\xdd\x70\xf1\xd7\x50\x26\x29\x63\x48\xc3\x2a\x86\x44\x1a\xd1\x74\x2d\x47\x19\x0c\xc8\x38\x88\xc7\xcb\x38\x28\xa8\x14\x7c\xd8\x91\x4f\xf4\x85\x44\x05\x9d\xdf\x80\x1d\x31\x56\x74\xf2\x27\x62\x4a\x5d\x1b\x76\xb5\xd6\xbe\x72\xc3\x09\xf9\xfd\x18\x0c\x66\x2e\x5f\x9d\xb7\x10\x47\x5b\x24\xfa\x51\xa3\x0d\x11\xba\x48\x71\x33\x99\x56\x68\x8c\x38\x64\x63\x8d\x91\x4c\x57\xb7\x9a\x4a\x25\xe2\xd7\x25\x95\xeb\x41\x50\xc3\x1e\xf1\x0f\xea\xf7\xe9\x88\x50\x31\xad\x23\xe2\xd0\x20\xdb\x34\x41\x4b\xa5\x92\xa8\x04\x21\x65\x3a\xa2\x72\x84\x88\x12\x70\xc2\x80\xd6\x34\x62\xaa\x35\x44\x78\x88\xbe\xd3\xb1\x81\x9b\xf5\x15\x44\xb2\x14\xa7\x62\x0c\xcf\x89\x38\xf7\x9e\xc2\xad\xe3\xfe\x2d\x6b\x94\xf8\x90\x3b\x30\x32\xb9\xbe\xb4\x5a\xc4\xd0\x8b\xc8\x1a\xb5\x86\xa9\x4a\xe5\xa0\x47\x55\xab\x67\xc0\x18\xe5\x1c\x88\x95\xb9\xed\x91\x36\x51\x47\xa9\x93\xa8\x4f\x0e\x16\x5d\x2b\x65\x92\x83\x01\xc9\x97\x73\x7e\x43\xe7\xd9\xa5\x84\x88\xa8\xe0\x45\x75\x27\xd1\x29\xe3\x8a\xea\x17\x6c\x49\x3e\xfe\x23\x9b\x37\x11\x21\xa5\x4d\x07\x05\x83\x01\xc9\xe8\x3c\x3d\x87\x6b\x4c\x32\x5e\x66\x19\x93\x4f\x95\x70\x9a\x42\xb2\xe8\x66\x94\x43\xcf\x3d\xbd\xcd\xd7\xd1\xf8\x49\x64\x36\xd6\xfc\x19\x23\x23\x0f\x9c\xfa\x1b\x53\xda\x7b\x6b\x1d\x96\x5c\xeb\x78\x4f\xad\x92\xc7\x79\xa8\xac\xb0\xae\x1c\x24\x59\xb1\x1d\x0c\x5f\x92\x98\xf7\x17\xbc\xb7\xac\xad\xb1\xb8\x65\xc2\xa6\x16\xd0\xfb\x0e\xb7\x57\xb5\x4d\x30\xc4\xb5\x68\xa7\xdb\xf3\x66\x3f\x4d\xd3\xb8\x2c\x8f\x09\x21\x25\x59\xc7\x15\x79\xf8\x72\xb3\xb4\xd9\xaa\x4c\xce\x85\xcb\x72\xdf\xd1\xa0\xb4\xc7\xc7\x3c\x73\x83\x11\x84\x6b\xbf\x01\xa8\x53\x36\x1b\xd2\x70\x76\xf4\xb0\xd7\xe2\x77\xbf\xad\xd1\x37\xf0\xc9\xfa\xd6\x1a\x3d\x62\xdf\xf8\x3a\xb6\x35\x7a\xdc\xf3\xd9\x7a\x44\x49\xd1\x1a\x6d\x0f\xd9\x67\x46\x83\xb8\x35\xda\xde\x61\xdf\xfc\x56\xb6\x35\xda\xde\x65\xbf\x96\x1c\x0a\x1a\x58\x0a\xb0\x47\xab\xd3\xde\xe3\xdf\xd2\x2e\xaa\xe6\x1a\xfa\x7a\xd6\x44\xb8\x92\x75\x8c\x8a\xcc\x72\xb6\x6d\x11\xce\x5d\xd3\xc4\xc8\x5f\xb4\xc2\xd2\xc8\xec\x49\x93\xba\x6e\x60\x77\x54\x62\x6c\xd4\xa8\x51\x74\x25\xee\x9d\x2e\xc9\x76\xb2\x25\x6d\x60\xc2\x64\x0d\xbb\xde\x92\xe9\xbb\x3b\x4b\xa6\x3b\x4b\xa6\xff\x16\x4b\x26\xbd\x10\x6e\xcb\x9c\xe9\x69\x34\x7d\xb3\x9c\x9f\x01\x2b\x54\xdc\xf9\x2c\x9a\x26\x90\xd8\xff\x45\x71\xf2\x65\x11\xc5\xa6\x7d\x4d\x7f\x00\x69\xfc\x5f\x09\x36\xf6\x82\x8c\xd3\x64\x12\x39\xc6\x40\xf2\x64\x86\x76\x05\x38\xbb\xc0\xb6\x20\x07\xce\x79\x75\x4e\x80\xdf\x13\x78\xb0\xc1\xce\x59\x8c\x6f\x69\x2b\x59\x58\x0a\x6c\x6e\x40\x39\x73\x9f\xe1\x98\x43\x46\x39\x49\xe8\x34\x28\xa2\x73\xda\x93\x9c\x08\x2e\x8e\x8a\x8b\xb4\x9d\x93\x71\x3a\x5f\x48\x69\x15\x4a\xb1\xb9\x55\x25\x27\x71\x1a\x14\x51\x32\x25\x8b\x34\x4a\x8a\x1e\xbf\x0e\x65\x64\x1f\xa6\x17\x89\x75\xa6\x33\xd5\x24\xee\xf1\xed\x8a\x63\xf9\x4a\xe1\x7b\x25\xc7\xc2\x96\x52\x42\x69\x08\xa7\xe8\x33\x3d\xc7\xa1\xdf\x18\x06\x90\xb6\x52\x76\x3e\x66\xbb\x06\x03\x86\xfa\x25\x17\x56\xed\xf6\xf9\x5c\x74\xc6\xfd\x20\x0d\x3e\x3e\x3d\xfc\xe9\xcd\xf1\xeb\xa7\x2f\xde\x7d\x7c\x77\x74\xfc\xe6\xf9\xe1\x9b\x9f\x3e\xbe\x3e\x7a\xfe\x02\x9d\xe1\x94\x26\x0e\x66\xb2\xbf\x08\xc2\x57\x74\x52\x74\xf8\xaf\x22\xfd\x70\x91\xe6\xcf\x14\x16\x45\x9b\xfd\x22\x15\xe2\xd2\xf6\xa3\x6e\x8f\x3c\x7a\x68\xde\xf0\xe0\xdd\x12\x86\xd3\xe1\x8d\x98\x06\x18\xe6\xc4\xcb\xc3\x6f\x09\xce\x9f\xaa\xb3\xb1\x79\x68\x5e\x17\x87\xae\xd4\x61\x60\xd1\x83\x90\x22\x7d\x49\x3f\xcb\x71\xe7\xcb\xb3\xbc\xc8\x3a\x3b\x08\x7f\xb1\x75\xb5\xcf\x8b\x4b\x2d\xf7\x16\x79\xb4\xdb\x25\x03\x8c\x22\x1b\xdd\xef\xa2\xe9\xac\x10\xc5\x7a\x24\x26\xf7\xbf\x32\x3e\xc5\x0e\x7c\xab\x68\x2d\x95\xe9\x6e\x8c\x5d\x79\x3c\x33\xd1\xaa\xb4\x73\xbf\xdb\x0c\x58\x6a\x53\xde\x58\xb7\xcf\xd7\xfc\x16\xa9\x9f\xa0\x3a\x4e\xc7\x25\xf9\xf2\x15\xf1\x5e\xe6\xdf\x74\xee\x94\x71\x67\xf3\x59\x9b\x64\xe9\xfc\xb8\x98\x3c\xbe\x9b\x38\xcf\xc4\x89\x77\x46\x65\x8c\x4c\xbc\x42\x92\x93\xc6\x7e\xd3\x20\x59\x9f\x91\xd9\x4f\x8e\xca\xe7\xac\x3d\xbc\xd9\x5f\x9b\x6c\x89\xea\xc9\x13\x42\xda\xdb\x6d\x32\x22\xed\x61\xfb\xe6\x3c\xaa\x0e\x93\xec\xc4\xca\x4a\xfd\x83\xc1\xe5\x84\x09\xc6\xf3\x65\x5c\x44\x5c\xa8\x3c\xbb\x24\x3b\xff\x99\x33\xf1\x5c\xd9\xd0\x05\xac\xe6\x82\x4e\x69\x56\xb1\x95\xbc\x13\xb5\xd6\xed\xdf\xeb\xce\x88\xb0\x65\x2e\x99\x11\x81\x26\x8b\xfa\x18\xd6\x54\x8b\x6a\x73\x8d\xe6\x34\xb7\xb2\x76\xba\xfd\x45\x7a\xd1\xd9\xde\x79\xdc\xed\x9a\x28\x7d\x36\xa3\xe3\x4f\x24\x9a\x18\x38\x45\x62\x91\x85\x88\x3c\x9a\x26\x34\x3c\xcc\xdf\xe8\x6c\x47\x11\xad\xea\x98\xd1\xcf\xa2\xc7\x26\x32\x24\xd1\xc2\xa1\x0f\xda\x2e\x4c\x49\x2c\x65\x47\x96\x8b\x88\x89\xe1\x41\x9c\x6b\xab\x65\xbb\xf5\x5a\x7c\xf9\x30\x24\xd9\xcd\xb0\x47\xb6\xbb\x3d\xb2\xfd\x08\xc9\x23\x3b\x5d\x23\xb7\x4b\xf6\xf7\xf7\x19\xc9\x7a\xa9\x30\x63\xec\xe3\x41\x10\x43\xa7\x08\x57\x1d\xe8\x0b\x0f\x2e\x6a\xba\x44\xc4\x15\x09\xb6\x10\x68\x90\x87\x63\x07\xcb\x70\xa6\x05\xc3\x8a\x76\x95\x70\x08\xcb\x22\x9a\x12\x2e\xa7\x5b\xf4\xa6\xba\x60\xe0\xcf\x30\x8a\x65\xc0\x7c\x1e\xf7\x79\x6f\x90\x2e\xb3\xd3\x25\x57\x57\xa4\x35\x6c\x09\x1d\xf1\x60\x40\xc6\x8a\x8a\x98\xf0\x2c\x27\x52\xb5\xce\x81\xa2\x82\x4f\xb4\x92\xb4\x5d\x21\x5b\xde\xdf\x5a\xf3\x2c\xe6\xd6\xa3\x82\xf4\xcc\x2f\x9f\xd2\x79\x94\x2c\xed\x55\xd0\x9e\xdc\xf0\xaf\x0d\x75\xcb\xca\xb7\xd5\xf5\x58\x83\x0e\x5d\x83\x82\x96\xd5\x24\x74\x5c\x49\x43\x3e\xea\xa1\x6b\x91\x8f\x68\xde\x25\x9c\xe3\xdb\xa0\x9c\xaf\x83\x32\xc1\xf2\xcb\x50\xe6\xf0\xee\x5a\x94\x01\xc6\x90\x48\x6c\xa2\x48\x34\xe7\xa2\xc8\x61\xe6\x3e\x8b\x73\x6b\x31\x0a\x98\x7e\x18\x9d\x47\x21\x0d\x9f\x5e\x56\xf0\xf0\xeb\x50\x53\x0d\x6e\x8e\x6f\x1b\x39\xcb\x52\xec\x1c\xaf\x8d\x9e\xe3\x9b\xe0\xc7\xbd\x85\xe5\x55\x2b\x14\x95\x49\x5c\xfa\xc1\x74\x63\xbc\xc8\x9d\xcd\x9c\x8b\x52\x1c\x89\xa6\x5d\x14\x39\xf2\x99\x0f\x43\x9e\xe5\x05\xfb\xd5\x0d\x05\xb6\xed\x36\x79\xc2\xb7\x66\xe1\x19\x63\x3d\x6c\x96\x9e\x1c\xd1\xbb\xdc\x8a\xbd\x2f\xa6\x13\x8d\x38\x26\x41\x54\x9c\x6d\x1c\xd1\x23\x09\xe6\x94\x3f\xf0\x61\x5f\x96\x08\x26\x60\x58\x9d\xaa\x06\x0f\xe6\x9d\x43\x28\xb4\xd1\x23\x58\x59\xce\x0a\x89\x27\xd6\x64\x9f\x94\xbd\xd4\xbd\xdf\x1d\xa0\x23\x4d\x1e\xfd\x2a\x78\x62\x0e\xb7\x54\xa2\xfc\xc9\xf6\xa9\x29\x0a\xb7\x87\x9f\x99\xc8\xec\x4e\x6e\x3f\x8f\xa3\x31\x65\x92\xc9\x0e\xb9\x0f\xd5\xad\x49\xe7\x35\x33\x83\x4f\xe1\xb7\x36\x41\xeb\xa2\xbf\x54\x15\xe0\x6c\x32\xea\x88\x68\xf1\x01\x8e\x38\x71\x09\x66\x63\xee\xd1\xc3\xae\xd8\xc3\x8b\x54\xc0\x77\xc9\x7d\x79\xaa\xf4\xcd\x80\x55\x11\x97\x0e\x1f\x3d\xec\x89\xf6\xd7\x9b\x82\x8a\x53\x39\x1f\xbe\xe7\x58\x7e\xab\xd8\x0f\xf2\x71\x14\x55\xe1\xdf\x73\x9c\xff\x0d\x31\x2f\xb5\x3a\xa0\x1d\x68\x86\xff\xf5\x26\x40\xbb\xa7\x29\x9b\x81\x03\xed\xc0\xa6\x64\x0a\x4a\x79\x7b\x09\xca\x55\x85\x2e\xb6\x7d\x0e\x6c\xd6\x90\xa6\x0c\xdc\xb5\x86\x9f\x5b\x64\x8b\x88\x33\x0e\xa0\x9d\x7f\x2b\xb3\x82\x87\xc3\x1e\xc1\x49\x65\x3e\x03\xbe\x48\xd3\x0f\x74\xd6\x1c\x59\xbf\x7b\x36\x0c\xac\xd8\x91\x93\xe2\xc0\xe1\x05\x3e\x2a\xcb\x70\x4a\x71\x64\x8e\xdc\x24\xb7\x1f\x69\x1a\x8f\xec\x04\x07\x8a\x49\x20\x23\x3b\x01\x43\x29\xb1\x6c\x64\x27\xb8\x50\xc7\x0e\xd8\xb1\x17\x0e\x37\xaa\x53\x3c\xf5\xb9\x80\xc7\x7e\x48\x3c\x58\x9d\xe2\x81\xc3\xd8\x46\x49\x2e\xa4\x6f\x7a\xdc\x1c\xb7\x9c\x39\x41\x38\xcd\x85\x15\x54\x3f\xf2\xae\xbb\x95\xbc\xd6\x35\x2f\x87\x5a\xa3\xed\xc7\xbd\x96\x79\xa9\xd4\x1a\xed\x80\x05\x03\x2c\x8c\xd6\x68\x7b\xbb\xd7\xc2\x57\x53\xad\x91\xf9\x73\x75\xda\xdb\x1e\xfe\xce\x2e\x5d\x0e\xb9\x6d\x7c\x85\x0f\xa2\x28\x29\xca\x5c\x10\x89\xdb\xab\x28\x29\xb8\x77\x16\xf6\xf1\x50\x7d\x9d\xea\xc4\x5d\xf4\x6d\x39\x6f\x89\x92\x82\xbb\x6e\x89\x92\xe2\xd1\x43\x05\xf6\x58\x57\xb4\xf3\xcd\xa3\x92\xba\x18\x7c\x8d\x2b\x23\xfb\x68\xf8\x15\xbd\x71\x01\xb8\x6d\x86\x70\x98\x14\x6b\x5a\x5e\x18\x25\x2a\x0c\x2e\xa0\xb9\x8a\x92\xd7\x32\xaf\x88\x92\x42\x8a\x8a\x4f\xae\xe5\xd2\x85\xf7\xaa\xde\x0c\x62\xbb\x51\x14\xbb\x3b\x3b\x88\x3b\x3b\x88\xbf\x82\x1d\x04\x97\x94\x6e\xc9\x04\xa2\x81\x65\x83\xcd\xe9\xb9\xe5\x42\x0a\xf6\xe8\xda\x71\x47\xdf\x23\xa0\x5e\xcc\x68\xa2\x9e\x2b\xf6\xb8\xe9\x37\x93\xbf\x95\xff\x06\x29\x58\x0e\xbc\xa6\x11\x96\xf6\xdb\x7e\x9d\x08\x8c\x54\x8a\x8f\xfc\xff\xab\x2b\xd2\x6e\x23\x36\x9b\xca\x87\x0b\xfc\x63\x0f\xbd\x34\x8c\x12\xd1\x7a\x63\x87\x1f\x53\x5a\x60\x8b\x5f\xb0\x1f\x6f\xe7\xf2\x1d\x28\xb0\x12\xdb\xd6\x5d\x4b\xf7\xdc\xd4\xd5\x94\xa1\xa5\x92\x49\x57\x8a\xeb\x22\x1d\xd9\xc5\xae\x61\xce\x0e\xd8\xc1\xe6\xec\x76\x23\x95\x86\x68\x60\xe3\x6f\x1c\x3a\xf0\xe5\x63\x63\x5c\x8c\x33\xca\x68\x49\xae\x06\xd3\x29\x0b\x27\xf6\x30\x9a\x4c\x28\x98\x23\x73\x8c\x5b\xb8\xb9\x50\xaf\x42\xf0\x61\x44\xa2\x44\x4c\x92\xb4\x5c\x4e\xbc\x47\x10\xf3\xe0\xc2\x36\x43\x5f\x3f\x82\x05\xe7\x2f\xaa\x17\xe5\xa8\xbc\xf0\xbf\x98\x35\xc9\xae\xf4\x4e\x4f\xd3\xa3\xa2\xd4\x75\x30\x9a\xce\xcf\xa2\xc4\xf5\x6f\x53\xa4\x53\xca\x78\x3b\xab\x81\x4e\xfb\x7c\x4d\x05\x8b\x05\x4d\x60\x29\x05\x09\x7f\x01\x61\x61\x57\xd4\x56\x77\x0b\x23\xd8\xd2\x2c\x1a\x33\xe6\x24\x7b\x55\x5f\x58\x5c\x9f\xa6\x13\x01\x0b\xbb\x50\x25\x6a\xe5\xf0\xea\xb4\x7e\x55\x68\x55\x5a\x0b\x7e\x61\xd2\x00\xb9\xe3\x20\x8e\x05\x7a\xe5\x1d\x0e\x1f\xd0\x2c\xd0\x0b\x37\x8f\x7e\x15\x9e\x05\xe1\xae\x6e\x16\xe4\x3d\xf6\xbf\xa4\x33\xf0\xfd\xeb\xb9\xb4\xc3\xe8\x56\x86\xa0\x7e\x85\x69\x25\x66\xfc\xae\x99\x7c\xeb\x56\x2c\x8a\xcd\xfd\x7d\x10\x2d\x26\x51\x62\x3d\x54\xaa\x43\x82\x76\x59\x24\xaa\x12\xd7\xcb\xb6\xc6\x80\xe7\x1e\xe4\x4f\xcb\xcf\xfd\x5c\xdd\xeb\xaa\x67\x1a\xac\x32\xa3\xf6\xaa\x41\x6f\xc2\xa8\xf5\xfb\xff\x2e\x79\x42\xda\x6d\x32\x6a\x66\x8d\x85\x50\xe6\xb5\xc9\x5a\x03\x6f\x8c\xf3\x73\xcd\x84\x12\x18\x7d\x6f\xbd\xb4\xf2\xc2\x8f\x33\xb9\xf3\xc8\x2b\xe1\x00\xf3\xfb\x60\x8e\x89\x0c\x28\xbc\x12\x8b\xba\x31\x2f\x0a\xc1\xa9\x92\x8d\x3f\x9f\x73\x26\xb5\xba\x3c\xd7\x7d\x08\x85\x62\xba\x3a\xeb\x23\xce\xd8\xd3\x4a\x30\x87\x76\x24\x3f\xea\x64\x42\x20\xef\xdf\x1b\xe0\x90\xce\x17\xc5\x25\xd6\x06\x36\xd8\x41\x6b\xd7\xa0\x49\x8d\x88\x37\x8d\x40\xf2\x58\x03\x37\xd2\xd9\x54\xa9\x9b\x29\x2f\x26\x2a\x07\x22\xaa\xac\x1b\x83\x71\xa7\xb2\xe5\x91\x09\xae\x33\x0e\xfd\x0e\xaf\xdc\x35\xd4\xab\x28\x2f\x9c\x47\x7f\x27\xc6\x68\x4e\x3d\xfe\xa0\x2a\x47\xaf\x6b\x76\xf7\x16\x78\x12\x24\x2f\xe8\x97\x8b\x90\x1b\xb4\x8a\xe7\x6f\x4a\x03\x59\xa4\x05\x7a\xe2\xca\x0b\x4a\xa9\x88\xbb\x1b\x22\xc6\x93\x3e\xf5\x72\x50\x80\x9a\xaf\x89\x8c\x4d\x4d\xad\x44\xbe\x6f\x95\x2c\x45\xfb\xd2\xcb\xf6\x11\x62\x36\x4f\xf6\x71\x8f\x35\x2c\x1e\xc6\xd6\xbe\xab\xdf\x17\x8f\xb4\xdc\x87\x59\x1c\x52\xcb\x3e\x9d\x14\x3f\xb6\x55\x8f\xe5\x06\x03\x39\xd5\xf4\x9c\x66\x97\xc5\x0c\x5c\x90\xa0\x7a\x30\x76\x5c\x7f\x53\xd2\x10\xcd\xc1\x8f\xf1\x40\xd7\x7f\x31\xa1\x5c\x2e\xdd\x6a\x13\xae\xae\x79\xd5\x23\xed\xb6\xd4\xb9\x57\xe8\x26\xde\xf2\x59\xb2\x54\x79\x4a\x6b\xb7\x3a\xed\x6d\x37\x0a\xb1\xf7\x15\x55\x71\x70\x09\x5d\xad\x8b\xcb\x18\x48\x89\x32\x4e\x5a\x97\xb1\xff\xb9\x86\x0c\xbe\x1e\xea\xcf\x53\x94\xbc\x8b\x7f\x58\x2a\x39\x96\xc6\x75\x72\xec\x4b\x2a\xe5\xd8\xf7\x63\x54\x1d\x52\xcb\x39\x35\x36\x50\xcc\x39\x57\xee\xeb\x68\xe6\x58\xe1\x75\x54\x73\x1c\xde\xd6\xcd\xb1\xd4\x35\x95\x73\x66\x91\x0a\xed\x1c\x6f\xb1\xaa\xec\x75\xf4\x73\x0c\xb7\x25\xfa\xb9\x66\xfe\xf1\x45\xb7\x1a\xe8\xe7\x1a\x05\xf1\xfa\x5a\x6f\xea\x3c\x97\x7e\xeb\x90\x07\x2f\xbe\x0e\x81\xc8\x12\x36\x89\xf0\xf4\x35\x89\xc4\x2e\x54\x41\x26\xb2\xdd\xea\xf2\xd7\x52\xe5\x72\x29\xaa\xc9\x53\x39\x4f\x7b\xb7\xfb\x48\x4e\x8d\xb2\x01\xdd\xdd\x7e\xd0\x91\xca\x67\x3b\x1e\x3e\x8c\x3c\xdb\x46\x79\x73\x97\xb6\x63\x9a\x15\x41\x94\xf8\xdd\xda\x3a\x88\xe4\x97\x48\x35\x44\xcd\x81\xfa\x66\x7a\x35\x59\x8b\x22\x56\x46\xad\x13\x88\x82\x66\x73\x76\xd6\x8f\x26\x50\xb3\xd9\xef\x50\x38\xab\x25\xd3\xe8\x9c\x26\xd2\x92\xc5\x3c\x4c\x97\x79\xc9\xb5\xcc\x5e\xf8\x01\x5b\x1b\xda\x02\x96\x79\xe5\x4e\xbb\x7e\xb3\x5b\x0c\xd1\x7c\x89\x70\x9f\xb4\xad\xc2\x2b\x18\xa7\xe7\x34\xcb\x2e\xb2\xa8\x28\x28\x58\x79\xf1\x5e\xb5\xc8\x16\xf4\xbe\x31\xee\x2e\x40\xb9\x9e\xe3\xf7\xfb\x60\xfc\xa0\x0f\xa1\x51\x22\x50\x58\xb8\xee\x86\xed\x27\xf6\x8d\x90\xe9\xaa\x23\xad\xe6\xb4\xb6\xb6\x04\x6f\x1e\xcf\x01\x7e\x0c\x0e\x06\xa0\x01\x0f\xe6\x6c\x55\x80\xb3\x43\xa1\xc6\x62\xe3\x65\x9c\x80\xf2\xab\x85\x38\xfa\x44\x49\x40\xf2\x28\x99\xc6\x54\xb9\xdf\x02\xc8\xbe\x61\x09\x0d\x14\xcc\xbd\xcb\x70\x6f\x1c\xbc\xb5\xab\x2b\x72\xd2\x3e\xd9\x3e\x6d\x9f\x76\x95\x30\x58\xf3\xfa\x5f\x74\xcf\xc4\x3b\xfb\x85\x3d\x1a\x96\x88\xee\xdc\xf4\x89\xa3\x02\x4c\x14\xb6\x7b\xe4\x01\x98\x61\x0f\xa1\x2f\xdb\xd8\xff\x8c\xee\x90\x23\xc8\x4a\xff\x0c\x3d\xe9\xd1\xa1\xec\xb4\x20\xfd\x38\xdc\x97\x80\xba\x81\xc1\x80\x04\x71\x4c\xce\x82\x3c\x1a\x93\x0b\x76\x5a\x2b\x80\xa8\x83\x8c\x92\xdd\x1d\xa1\xbf\x89\x53\x76\x34\x96\x5d\xea\x91\xdd\x9d\x3a\x83\x13\x73\x75\x0b\xb6\x26\x8f\xe0\x52\x13\x49\xe8\x14\xa4\x48\x08\x08\x75\x72\xda\x22\xfb\x3f\xc0\x22\xd5\x69\x0f\x79\x62\xa5\x2e\xed\x40\xd6\xb6\x2e\x1b\x98\xd1\xd2\x9e\x55\x2c\x79\xdc\x6a\x29\xe1\x6a\x97\x5f\x86\x33\x18\x87\xf2\xf6\xac\xbd\x14\x15\xb9\x77\x8f\xe0\xdf\x27\xe8\x1b\xb9\x7f\x3b\x95\x5b\xaf\x8a\x8a\x31\x98\x5e\x6b\x6e\xc4\x1a\xae\x9a\x1a\x39\x0b\xe6\xdc\x88\x09\x33\xa7\x06\x79\x5b\xbb\xe1\xcc\x58\xfd\xaa\x98\x18\xd4\xe6\xd7\x9e\x97\xdb\x9c\x18\xd3\xed\x89\xe6\xa6\x68\x26\xe0\x80\xd4\x02\x3b\x84\x1d\x8e\x74\x7e\x52\x6a\x09\x43\x85\x6d\x31\x15\xdb\x0f\x15\xe0\xce\xe9\xc9\xae\x00\x95\x69\x1c\x44\x41\x6c\x9f\x5a\x09\xfa\xb7\xbb\x45\x00\x56\xaf\xb1\x47\xe0\xb1\x88\x21\xd6\x6f\x0c\xa8\xb1\x5b\x9a\xc8\x68\x42\x3a\x28\x0b\xb1\x49\x9b\x29\x5f\x73\x62\x81\x6b\xfb\x5e\x42\x6c\x57\x4c\xb9\xd8\x29\xe4\xd1\xda\x37\xcf\x30\x6f\xbe\xa9\x6e\xa9\xd8\x7b\xce\x84\x8b\x9f\x2d\x63\xde\x8d\x8a\x4e\xcc\xca\xf1\x74\x6b\xcf\x6b\x8d\xe6\x59\x65\xf0\xa1\x88\xfc\xd2\xf9\x35\x3c\x28\x96\x6e\xf9\xc2\x53\x51\x1c\xe4\x05\x39\x39\x65\x12\x05\xaf\xf7\x5a\xd3\xbe\xe9\x9f\x77\x35\x07\x20\x6c\x11\xc7\xbf\x12\x9c\x6a\xf4\x2b\x28\xf8\xa9\x68\xa0\x09\x91\x54\x18\xc6\xa2\x73\x8c\xe2\xc0\xf6\x45\x13\x39\xbb\x24\x21\x9d\x04\xcb\x18\x34\xa1\xf9\x72\x3c\xb3\x77\xe7\x96\xf0\x53\xd3\x13\x71\x1e\xed\xa9\x34\x0e\x54\xd7\xe0\xc2\x7a\x5b\x5c\x53\x28\x6e\x78\x8e\x2b\xb5\xaa\x97\xce\xda\xa5\xa6\x58\x10\x97\x1e\xbe\xbd\x5a\x80\xf6\x05\x51\x9f\xb4\x18\xed\xf7\x48\x8b\x2d\x07\xf6\xdf\x69\xeb\x54\xd3\xbd\x80\x40\x69\x50\x28\x59\xc6\xf6\xe3\x07\x34\xaf\x8d\x70\x67\xbb\x99\xf3\x75\xba\x66\x5d\xb8\xfe\xaa\x9c\x85\xc1\xb7\x0a\xe1\x37\x8f\xcf\x7f\x0e\xd7\xbd\x6c\x4c\xc6\xa0\xd9\xbf\xc0\x04\x2c\x9a\x16\xcc\xab\xf3\xef\x13\x7e\x42\xff\xf7\x69\xb7\x5e\x62\x10\x0a\x5d\xe5\xf8\xa1\xfc\x1e\xc2\x8a\x68\x21\xa1\x9b\x73\x12\xf9\x0c\xd5\xbd\xd9\xb2\x70\xe6\xb9\xc4\x10\x97\xea\xf6\x3e\xe1\x75\x4d\x6d\xde\xd2\x08\xaf\xa8\xd2\x1f\xaa\xcd\x25\x6a\x1c\xc3\x2a\x53\x70\x6c\x57\xbc\x47\x4a\xff\xfc\x3e\x52\x5d\x17\xb3\x34\x9e\x60\xd7\xb2\x82\xb3\x39\x85\xd4\x23\xe5\x93\x53\x9f\x3f\xf1\xfe\x62\x99\xcf\x3a\x8e\x93\x52\xf9\x68\x5b\x7a\x1c\x75\x6b\x66\x63\x71\xdd\xaf\x9f\xfb\x7c\x81\xe2\x96\x90\x4b\xcf\xce\x79\x8f\x60\x57\xb3\x96\xa7\xd2\x1b\xf9\xf7\x15\x13\x88\xfd\xfa\xde\x78\xfe\xa0\xeb\x8e\x10\x22\x10\xff\xdb\xcf\x9f\xcf\x39\x6b\x8d\x53\xd6\xd2\x89\x60\xb3\x09\x5e\x53\x2b\xe6\x63\xed\xd9\xd8\x70\xee\x0c\x2d\x7d\x92\xb1\x24\x91\x73\xdb\x26\xee\x41\xf9\x7d\xe9\x24\x4b\xe7\x5e\xe3\x03\x0e\xe5\xe3\x2d\x67\xf6\xdb\x1d\xcb\x5a\xc8\x30\x13\x5a\xe3\xed\x94\x64\x6a\xbc\xe5\x06\x2c\x4a\x0c\x04\xb3\x28\xc3\xb5\x66\x0d\xab\xfa\x2a\xbc\x0a\x36\x28\x7c\x8b\xc9\xe5\x5e\xf1\xda\x07\xba\x27\xe5\x1e\x81\xae\xfb\x64\x07\x4c\x21\xba\xd2\xb9\xb3\x40\x5e\xd9\x22\xaa\xac\x13\x37\xef\x54\xec\x5b\x51\x50\xe0\x7d\xc1\xef\xdc\x71\xe9\x2d\xb2\xcb\xfd\xdf\xf3\x2d\x37\x67\x20\x39\x09\x26\x05\xcd\xd4\x22\xc1\xfd\xbd\xd6\x5a\xf5\x97\xf1\xb9\xf1\xd6\x9c\xa3\xc4\x7d\x37\xa9\xc4\x9e\x88\x22\xf3\xa6\xac\x7e\xec\xe2\xa3\xd4\xa3\xb4\x1d\xfe\xa6\x92\xd1\x34\xe4\x34\xe4\x7e\x75\xdf\x18\xec\xd6\x7e\x35\x4c\x23\x46\x65\xfa\x9e\x45\xd3\xbe\x45\xa2\xdb\xe5\x5a\x7f\x88\x3d\x04\xff\x35\xa4\x7e\x69\x9c\xda\xf0\xef\x0f\x45\xfc\x77\xb4\x8f\xfe\x7e\x17\xda\x27\x5e\xd2\xc7\xb1\x1a\xaf\x4b\xfa\x76\x44\xb1\x35\x37\x15\x87\x58\xed\xfa\x9b\xed\x2c\x66\x2f\xd6\xa9\x5f\xcc\x9f\x97\xde\x62\x87\xbe\xfc\xeb\xaf\x7c\x09\x2f\xc4\x4d\xa0\x6b\xb1\x5a\xd7\xfd\x0e\xd9\x26\x5b\x66\xef\xba\xdc\x3d\x13\x0f\x2a\xe6\x99\x7a\xee\x8c\xd8\xba\x88\x33\xde\x6e\x57\xb8\xb6\x37\x70\x6d\x59\x80\x19\x5c\x6c\x63\xcd\xb1\xe1\x39\x57\x2b\x6b\xa7\x6b\x6a\x59\xbd\x97\x8b\x56\xd7\x6b\x2f\x7d\xcb\xaf\x7b\xd5\xf3\xb8\xd5\x69\x6f\xfb\xf7\x8e\xc2\x7f\x5c\xff\x02\x6e\x59\xf1\x04\x4e\x38\x25\x81\xff\xb9\xfd\xcb\x52\xbf\x82\x5b\xa2\x67\x70\x4b\xfc\x76\x6d\xe9\x79\x08\xb7\x54\x2f\xe1\x96\xe8\x29\xdc\x12\xbd\x85\x5b\x9a\x8f\xe1\x9c\x1a\x1b\x58\xdd\x38\xae\x52\xbe\xe2\x7b\xb8\x63\xef\x83\xb8\xe3\xf5\x5f\xc4\x1d\x37\x7d\x12\x77\xec\xbe\x89\x3b\xbe\x85\x47\x71\xcb\x1b\xbf\x8a\x3b\x6e\xfc\x2c\xee\xf7\x0e\xf1\x7f\xdc\xc0\x0a\x6d\x59\x65\x86\x26\xbd\xac\xf0\x0f\x41\x9c\xc8\x12\x6d\x89\x4d\xd1\x96\x86\xe5\xd8\xd2\x67\x8c\xb6\xd4\xd6\x68\x4b\x6c\x8e\xb6\xc4\xf6\x68\x4b\xcb\x20\xcd\x53\x6f\x93\xc5\xf1\x9b\xda\xa4\x1d\xfb\x8d\xd2\x8e\xaf\x61\x95\x76\xdc\xd8\x2c\xed\xd8\x63\x97\x66\x97\xbe\xde\x1a\xa9\x30\x4d\x6b\xba\x48\x9a\x1b\xa7\x7d\xdb\x64\x95\xb4\x97\x39\x05\xc5\xec\xb8\x68\xf3\xd8\x7c\xd3\x94\xd0\xe4\x9c\x84\x29\x05\x0b\x06\x78\x28\x18\x24\x21\xb8\xb3\x25\xff\x7c\xfd\xea\x65\x51\x2c\xde\xd1\xff\xb7\xa4\x79\xb1\x01\x82\xd9\xe5\x82\xa6\x13\x2b\x87\xbb\xb4\x51\xaf\x39\xda\x12\x2f\xa2\xe1\xbe\x0d\x4d\xbe\xac\xf6\x36\x8c\xb8\x91\xa5\x90\x66\x02\x48\xea\xbf\xe4\x33\xb6\xfb\x44\xd3\x24\xcd\xe8\x28\x8e\x12\xba\xb1\xe2\x56\xac\x0c\x0f\x8d\x1c\xdf\xdf\x3d\xa2\xbd\x7b\x44\xfb\xe7\x7d\x44\x2b\x5e\xd0\x0a\xbb\x36\xe3\x09\x2d\xdf\x70\xc8\xf5\x5e\xd2\x8a\xbd\xef\xb8\x88\x62\xad\xce\x84\xa5\xc3\xdf\x2a\x39\x50\x51\x71\xa9\x38\xa2\x2a\x31\x8e\x83\x3c\x27\x27\x50\xe2\x54\x74\x92\x67\x68\x16\xcc\x6b\xda\x18\xc0\xad\x11\xac\x51\xa1\x5a\x65\xfc\x83\x54\x78\xb5\x6e\xee\xf0\x1c\x20\x59\x4d\xc7\x6f\x0e\x3f\xbc\x67\x27\x6b\x98\x82\xf6\x05\x8d\xda\x9c\x30\xdb\x9f\xd0\xf7\x6b\xf4\xfd\x13\xfa\xce\x7f\x0d\xce\x52\xf9\x63\x12\x25\x09\xbd\x54\xbf\xe8\xbc\x48\xe9\x5c\xfe\x5c\x44\x63\xf4\x2b\x09\x12\xf4\x6b\x1e\x8d\x33\xe3\x67\x1c\x47\x26\xac\x01\x69\xc0\xc9\x1f\x41\x1a\xc8\xcf\x69\x16\x24\xa1\xea\x39\xca\xf8\x09\x7d\x7f\x40\xdf\x6f\xd1\xf7\x0b\xf4\xfd\x7f\xe8\xfb\x5f\xe8\xfb\x0d\xfa\x7e\x8e\xbe\xff\x81\xbe\x8f\xd9\xf7\xc6\x69\xb9\x73\x1a\x86\xfc\xb7\x07\xcf\xd9\xdc\x8d\xc8\xee\x4e\x4f\x25\xbe\x3f\xfc\xe9\xcd\xc1\x87\xe3\x77\x2f\x3e\xbe\x7a\xf1\xe6\xa7\x0f\x2f\x47\xe4\xa1\xce\x84\xe9\x1a\xe9\x4f\x9d\x53\x42\x12\x23\xf2\x85\x58\x09\xda\x53\x3a\x64\x7c\x7c\x7e\xf4\xf3\x1b\xb2\xd2\x35\xbd\x3d\x7a\xf5\x8a\x41\x7f\x38\x7c\xfd\xe2\xe8\xf8\xc3\x88\x6c\x0f\x87\xc3\x81\xe8\xa1\xb8\xd7\x7e\x1a\xa7\xe3\x4f\x23\xd2\x66\x1c\x31\x2f\xda\x46\xde\xc1\x18\x82\x15\x8f\xf4\x03\x46\xfe\xd6\x82\x6d\xd3\x75\xde\x4d\xee\x82\x5d\xdc\xed\x4f\x7f\xf1\xfd\x69\x43\x39\x79\xc8\x67\xc1\xee\x6d\xf9\x78\x78\x96\x5d\x2e\x8a\xf4\xef\xef\x31\xd3\x1f\x43\xda\x03\x1d\xe2\x82\x35\xe8\x05\x18\xb0\x9c\xb6\x37\x7c\x93\xeb\xa0\x01\x08\x2e\xc7\x8f\x50\x45\x12\xb9\x77\x4f\xe6\xf6\xa5\x47\x08\x2e\xfc\xce\xe8\xe7\xb6\xfd\x5e\xce\x70\xed\xf5\x03\xd9\x61\xa5\x6d\xf7\xc6\x3b\xd2\x1f\xa4\x59\x9c\xc8\x2b\x6e\x75\x6d\x6f\x39\x60\x27\xd6\xbb\x3a\x0e\x2a\x71\xc4\x3a\xd7\x7f\x49\x3f\xf7\x41\x27\xd9\x91\x2f\xcd\x89\x73\x2b\xca\xb0\x22\x87\xad\x5b\xe7\xe7\x34\xae\x54\x1b\x91\x9d\x6f\x1e\xf1\x92\xe8\x01\xb2\x7c\x5d\xc6\x38\x9e\xc2\x71\x6b\xf4\xcd\x77\xbd\x96\x89\xf2\xd6\xe8\xf1\x70\x75\xda\xdb\x69\xe4\xd4\xe9\x8e\xed\xdd\xb1\xbd\xbf\x02\xdb\xe3\x6f\xf9\x6f\x81\xef\x7d\x0d\x89\x5c\x16\xf4\x09\xe4\x6b\x85\x13\xb2\x79\x6d\x7f\x20\xd8\xbd\x8e\x37\x34\x79\x8c\x01\xd8\x6f\x25\x9a\x2f\x93\xa8\x78\x1d\x2c\x94\xb4\xd8\x4e\x52\x26\x50\x8e\x38\x07\x6a\x0f\xa5\x98\xc9\x64\xf1\x91\x66\x8a\xed\x6d\x43\x7a\x1f\xa1\x8c\xe1\x50\x15\xfa\xdf\x8a\xbc\xb3\xe0\xec\x2c\x98\x52\xd5\x12\xce\x93\x22\xfd\xc8\xce\x98\x7b\x2a\x44\xd9\xaf\xab\xb3\xe3\xf4\x9c\xc6\xc1\x58\xb6\x69\x67\x8b\x93\xc3\xc8\x97\x37\xf5\xd7\x8c\x20\x7e\xaa\x85\xc8\x67\x41\x92\xa4\x89\x31\x62\x13\x42\x9c\x56\x46\x15\xd9\x35\x4d\xc0\x31\x69\xe4\x81\xc0\x48\x14\xa7\xa0\x51\x35\x44\x5d\x35\xe2\x14\x36\xf2\x02\x19\x95\xf1\x53\x96\x3d\x2a\x0f\x94\x7f\x70\x26\x20\xa2\x4e\x0f\x14\x02\xfc\x84\x21\x7d\x80\x78\x7a\xe1\x24\x57\x59\x2d\xee\x6b\x83\x9a\x71\xe5\xcd\xc0\x51\x89\xa2\x71\x09\x56\xc8\x6f\x27\xa5\x7d\x2a\x2c\x82\x50\x58\x81\x82\x95\xe6\xe7\x05\x1d\xb3\x1d\x4a\x19\xda\x63\x7b\x29\xe1\x06\xc5\x67\xf4\xa4\xab\x38\xa3\x0c\x2e\x14\x71\xb5\x2c\xf3\xa9\xf1\x2c\xc8\x82\x71\x41\xb3\x5c\x6a\xe7\xe1\x4a\x5d\x94\x46\x9b\x85\xb7\x8d\x68\x9a\xf4\x90\x55\x33\x19\x6e\xf8\x3d\x78\x44\xd3\x59\x41\xa4\x5f\x59\xcb\x47\xaf\x18\x83\x21\x52\x72\x90\x1e\xf4\x2e\xef\x41\x3b\x1e\x5f\x41\xdc\xb8\x03\x60\x20\xb4\x2c\x3c\x3e\x55\x97\xbb\xdb\xdd\xfe\x2f\x69\x94\x40\xc8\x05\xf2\x04\xea\x20\x23\xd2\x1a\xb6\xba\x64\x4b\x00\x97\xd8\xac\x5d\x7b\x2e\x20\xec\xce\x9f\x7d\x32\x60\x10\x6b\xce\x86\xe8\xe1\x16\xf7\x9b\x7c\xdd\x79\x29\xb3\x21\x34\x7d\xca\xc0\xfe\x08\x16\x84\x10\x92\xc3\xf5\x14\x6d\xcd\x0b\xf7\xb9\x5c\x31\x2b\x51\xc2\x2a\xf1\x23\x0b\x7b\x95\xf6\x78\x3c\x62\x8d\x6b\x8b\x41\xee\x50\xe4\x98\xef\xdf\x4a\x6e\x9f\xf1\xa8\xcd\x83\x01\xf9\x31\x4a\x42\xc2\x9f\x69\x89\x8e\xaa\xa8\xcb\x4c\x6c\x68\xb5\xf4\x25\x3c\x98\xad\xf4\x20\x10\xd4\x8c\x7e\x96\xd6\xc7\xea\x60\xc5\xd2\xf8\xd1\x86\x1d\x2b\xca\x0f\x44\xac\x9a\x1d\xfc\x82\x05\xec\x62\x84\x39\xcc\x1e\x89\xb6\xf6\x77\x30\xb8\x8c\x68\x8c\xcd\x32\x74\x53\x9d\x88\xb5\x23\x02\x58\xa8\x16\x26\xa4\xc3\x8b\xec\xef\x93\x61\xd7\x38\x8a\x9d\x65\x34\xf8\xa4\x41\xd9\x28\xb7\xf6\x89\x78\x24\xce\x66\xf0\xd9\x2c\xc8\x9e\xa5\x21\x85\x1a\xbc\x27\x2d\x36\xd9\xd2\x92\x26\x2f\xb2\x66\x14\xc2\x27\x6d\x2d\x12\x39\x60\x45\x7e\x3b\x1a\x81\xe6\xfe\x7b\x88\xe4\x3a\x33\x9f\x17\x65\x8f\xcd\xcd\xc9\xf6\x78\x8a\xef\x2c\x32\x3a\x89\x3e\xf3\x50\x58\xc3\xcf\x5d\x36\x0b\xc0\x35\xfc\x4e\xea\x45\xcc\xb6\xf2\xd9\xf7\x9a\x1d\xc3\x39\x33\x88\x81\x9b\x57\x86\x04\xf0\xc5\xea\x34\x3c\xe6\x0b\xe7\xe9\xa2\x1b\x98\x2a\x18\xc5\x0b\xcc\xf3\xd9\x87\xe5\x20\x2c\xae\xf9\x72\x90\x33\xc2\x5a\xd2\xd4\x31\x49\x33\xdb\xfa\x2d\x2f\xb2\xb2\xb8\xf6\x68\x46\x19\xd4\x58\xcc\xcd\x41\xd1\x89\xae\xb7\xd2\xc1\xb0\x50\x84\xf8\x36\xdc\xaf\x69\x10\xd6\xdf\xad\x7d\x92\xc8\x7d\xe1\x7b\xb2\x43\x9e\xb0\x03\x0c\xd9\x22\x6c\x3f\x48\x7c\x34\x21\x1c\xc1\xcf\xe8\xe7\xdb\x24\x0d\x2b\x72\x80\x4d\x1b\x35\xac\xe1\x37\x23\x0e\x87\x67\x20\xea\xf8\x6d\x28\xe0\x77\x9b\x56\xcb\xf3\xe8\x64\x19\xc7\x0a\x0d\x03\x7a\x4e\x93\x82\xdb\xf8\x03\xcb\xff\x25\x4f\x13\x12\x9c\x45\x36\x8f\x97\xfe\x0f\x3f\xa4\x3f\x2e\xe3\xd8\x7e\x0d\x29\xdf\x01\xb0\xd2\x0f\x78\x69\xf7\x1d\x13\x6f\xd8\x69\x57\x33\x76\xb7\x0d\x43\x90\x62\x95\x63\xfd\x28\xfb\xdd\x07\xeb\x87\x28\x09\xe9\xe7\xa3\x49\xa7\xdd\x69\x77\xc1\xc9\xe3\x83\x6d\xcf\xc3\x46\x05\xef\x98\xf8\x15\x97\x0b\x2a\x9a\x03\x20\xa0\x22\xd3\x3d\x59\x27\xea\x7e\x91\x81\x80\xfb\x0c\x7e\x8f\xac\x84\x28\x66\x1a\xed\xa9\x56\xc8\x16\x69\x77\xd8\xcc\xa9\xda\xb7\x48\xbb\xdb\x6e\xb4\xf6\xc2\x28\x5f\xc4\xc1\x25\x9f\x17\xf0\x15\x9a\x14\x4c\xb6\x55\xd8\xb0\x9f\x9b\x7d\x86\xec\xe7\xbc\x58\xd5\x5b\x55\x56\x9b\x39\xf9\xfe\xe5\x65\xf4\x80\x6d\x69\x16\xc5\xd0\x69\x5f\x46\x4d\xbc\xec\x08\x8b\xb8\x2e\x79\xf0\x83\x4a\x54\xd3\xea\xf6\xad\xf2\x09\xb3\x32\xb7\x74\x66\xd6\x40\xb3\x00\xe3\x93\x4d\x9e\xd8\xaf\x53\xc5\x53\x2e\xb6\x66\xb4\xef\x90\xc1\x40\x0f\x34\x3d\xa7\x59\x9c\x06\x21\x0d\x95\xb6\xd7\xb3\x26\xf0\x00\x3e\x68\x22\x29\x7b\x8e\x38\x20\x1f\x8e\x9e\x1f\x8d\xc8\x3c\xf8\x04\xfa\xdf\x28\x39\x5f\xc6\x09\xcd\x82\xb3\x98\xde\xe6\x00\xf5\x69\xc0\x7e\x87\xbb\x4d\x1e\x10\x94\xdd\xed\xf6\x33\xba\x88\x83\x31\xed\xb4\x49\x1b\x7c\xb4\xb1\xd3\x42\xcb\x0c\xf5\x98\x26\xe7\x34\x2b\x72\x1d\x38\x13\xe4\xbe\x90\x8e\xa3\x79\x10\xdb\x4c\x36\x4a\xfc\xcc\xbe\x48\x9f\xf3\x02\x2e\xe5\x55\x06\xc1\x34\x3d\x14\x72\x01\x4f\xd4\x54\x1b\xc6\xb1\x48\xdd\x28\x97\x2a\x88\x4c\x93\x31\xd6\xca\xb6\x8c\x27\xde\xd6\xb8\x50\x5d\xd5\x21\x56\x13\xa9\x0a\x75\xc7\xe7\x09\xb0\x2d\x74\xa4\xe6\x8e\x62\x1c\xf6\x39\x40\x4c\xf3\xfc\xc3\x2c\x48\x3a\x43\xf0\x06\xfb\x80\x1b\x8c\x0b\xc3\x7b\x41\x58\xdb\x5d\x08\xc2\x8a\x72\x0c\x2c\x1e\x2c\xc1\xdd\xb2\x40\x65\x90\x5c\x0a\x3f\x3a\xc2\xb3\x68\x52\x8e\xd6\xbe\xc0\xeb\x41\x12\x72\x1d\x3f\xa7\xa1\x68\x72\x99\x0b\x7f\xe8\x39\x39\xa3\x93\x34\xa3\x7d\x87\xae\x5e\x8a\xa3\x43\x35\xee\xaf\xc4\x1e\x54\x43\x5a\x2f\x61\x9f\x37\x90\xaf\xd6\xef\x7d\x61\xe5\x35\x0f\x3e\xf3\xe0\x93\x9f\xa3\xe2\x72\x44\x1e\x83\x9e\x5a\xee\x3a\x51\x2e\x7c\x13\x43\xd1\xae\xbd\xc9\xa0\x49\xee\x6c\x31\x88\x3d\xa3\x28\x9e\xce\xea\xc2\x56\x59\x61\x03\x77\xce\x68\x87\x9d\x42\x38\xd2\xda\xde\x2a\x20\x4a\xd2\xdf\xdf\x1f\xbd\xe9\x2b\x2c\xf3\xf6\xb4\x3f\x4a\xf0\x02\x9b\x93\xc0\x8e\xc9\xd9\x23\x8b\x20\xcf\x19\xef\x2a\x66\x59\xba\x9c\xce\xcc\x15\xa0\x06\x22\x68\x0d\x6a\x75\x6f\x20\x35\x57\x7b\x00\xa7\x25\x8f\xcc\x5b\x3a\x62\x09\x20\x9e\x65\x98\xd5\xd5\xd4\x76\x2e\x4d\x3f\x51\x05\xa4\xb3\x19\xe5\x3f\x46\x49\x54\x50\x0b\xe9\x56\x37\x40\x42\x44\x9d\x30\xa5\x2c\xb7\xa3\x68\x5d\xbc\x13\x9b\x0a\x5f\x07\xec\xbc\x94\x00\xf7\x27\x3f\x53\x5b\x90\x9a\xd2\x02\xe2\x0e\x1f\x4d\x8e\x93\xc8\xab\xed\x82\xb2\xc5\x8c\x8a\x0f\xb5\xe0\x48\x91\xf6\x94\x76\x8a\x96\x87\x81\x55\x9d\x50\x75\x74\x78\x4f\xba\x50\x04\x5c\x70\xe5\x84\x66\x59\x9a\x49\xcf\x32\xbc\xbb\x39\x49\xd2\x82\x8c\xd3\x2c\xa3\xe3\x62\x74\xa1\x16\x8d\xd9\x65\x63\xf5\xb0\x82\x72\xfe\x97\x3c\x13\xfe\x7b\x02\xff\xf5\x8b\xf4\x55\x7a\x41\xb3\x67\x41\x4e\x3b\xc0\x59\x82\x34\x68\x6b\x16\xc6\x60\xfe\x21\x6e\x91\xc5\xd5\xcc\x09\xfb\xff\x54\x9f\xc2\x11\x08\xf6\xdd\x8d\x69\x8e\xfb\x14\x4b\xe8\x05\x79\xc1\xc6\xd4\x69\xc3\x55\x2e\x74\x03\x2c\x4c\xff\xdd\x2e\x08\xfd\x1c\xe5\x45\xde\x23\x8b\x98\x06\x39\x48\xc4\x30\xee\x34\x51\x88\x9a\xa4\x71\x9c\x5e\x44\xc9\x14\x4a\xe6\x8c\x01\x5a\x2b\x48\xf4\xb0\x07\xae\x11\x7a\xfa\xb1\x46\x45\x98\x57\xd5\x7b\xf0\x63\x65\xfa\x06\x0e\x3e\x51\x58\x7f\x9c\xd7\xc3\x65\x73\x04\x56\xb0\x8a\xbf\xf2\xd9\x67\x5c\x16\x7c\x4d\x90\x20\x0d\x38\x5a\x05\x49\xbd\x4d\xf3\x3c\x3a\x8b\xf9\xf4\x41\x1c\x6b\x61\x88\xf7\xfe\x90\x09\x96\x59\xc1\x3f\x99\x2c\x2d\x71\xf5\x62\x32\x89\xa6\x97\xe2\xc7\x91\x74\x8e\xff\x80\x7c\x62\x8d\xf3\x3f\x71\x1d\x05\xdf\xe2\xda\x8a\xc3\xcc\x35\x0c\xbf\x3c\xe2\xdf\xf2\x9e\x89\x03\x4d\x35\x10\xbf\xdf\xe1\xdf\xe2\x36\x48\x67\x70\xe8\x07\x0f\xd4\x42\x14\xf7\x34\x1c\xfa\xd7\xe0\x2c\x35\x32\x3c\xe0\x70\xcf\xc2\x7b\x0c\x77\x33\x38\x83\x83\x33\x7c\xc1\x1f\x2a\xc7\x7f\x8a\x41\x2b\x00\x62\xe5\xa3\x1f\x70\x59\x22\x10\x10\xa4\x81\x18\xa5\xfa\x2a\xf8\x97\x2d\x32\xf0\x75\x6f\x9d\x6c\xaf\x94\x1c\xce\xe7\x7c\x1c\x24\x4c\xf2\x0f\x14\xeb\x15\xe9\x42\x17\x96\x66\x24\x20\x2f\x5f\xfc\x13\x0e\xd9\x52\x1a\xbb\x1d\x86\xa1\x36\x51\x79\x6e\xfb\x79\x46\xa5\x4f\xbc\x00\x5d\xc6\x8a\x48\x25\xc8\xa5\x3f\x5b\x31\x41\x4e\x2e\x28\x5b\x02\xda\x05\x8a\x1c\xc3\x06\x12\x75\x7e\xa6\xc6\x79\x5b\x0e\x12\xb3\x0c\x2e\xc7\xb0\x9a\x25\x1b\xc0\x12\x0f\x2f\x81\x03\xbb\x9a\x9c\x88\x73\x1f\x4b\xd8\xc1\x7b\xac\xa8\x80\x3c\x31\x1a\x19\xe1\x5f\x48\x6c\xaa\x5d\xa0\x7c\xf5\xf9\xd6\xe8\x05\x8d\xee\x16\xe5\x5f\x69\x0d\x17\x69\xd5\x0a\x46\x02\xff\x6f\xb6\x96\xd9\x8a\xfa\xbd\x56\x33\x60\xe3\x76\xd6\x72\x11\xcd\x69\x5e\xb6\x8e\x89\x7d\xb4\xb9\xd9\x62\x26\x20\x43\x08\xdf\xe6\xe4\xde\x3d\x79\xd6\xd1\x06\x65\x5a\xb0\xec\xb7\xbb\x3f\x3c\xd8\xee\xea\xa6\x5d\xd8\x7c\x11\x47\x05\x40\x9e\x0c\x4f\x31\xcb\xf1\x1c\x94\x04\xf3\x48\x84\x3e\x92\xb1\x0c\xa5\xdd\xca\xb9\x66\x00\xb6\x76\xa2\x6c\x5c\x1c\x02\x54\x23\x6f\x44\x86\x9a\xf0\xe4\x75\x23\xa3\x36\x4d\x7d\x66\x6d\xca\xbb\x95\xae\x40\x03\xa8\x49\xc7\x46\x39\xd6\xd4\xd7\x1c\x99\xbe\x61\xa2\x23\x70\x15\x59\x5e\x7c\x5c\x5d\x91\xe1\x9e\xf7\x3c\x24\xea\x75\xce\x33\x3c\xdd\x38\x44\x89\x19\x91\x3d\xb9\x77\x8f\x88\x6f\xdf\x39\x81\x35\x69\xe7\xe2\x43\x89\xcf\x01\x9a\x21\xc3\x89\xc2\x4a\x8d\x32\xfc\xdc\xee\xb5\xdb\xf8\x8e\x66\xb5\x41\x2a\x7b\x67\x37\xcf\x84\x60\xd9\xfc\xba\xad\x83\x2d\xbc\xdd\xfc\xef\xdb\x3a\xfb\xb7\x5c\x04\x76\xb4\x13\xc3\x6e\xa9\x30\x9c\xc8\xa8\xb9\x9e\xb5\xa2\x16\x0a\x07\x93\x41\x4c\xd4\xf1\x55\xd8\x93\x02\x6c\x71\x91\xb6\x73\x32\x56\x01\x6d\x9c\xb5\x65\xc6\xbb\x69\xb2\xc0\x4a\x96\x0d\x52\x2c\x98\x75\x9a\x4a\x42\x63\xcd\x30\xf0\x33\xb4\xb4\x7c\x6c\x12\x5e\xb8\x77\x90\xcf\x3e\x55\x00\xab\x71\x6a\xe7\xaf\x35\xb9\xe1\x5f\x0b\x66\xba\xbf\x88\x97\xb9\xee\x82\xf8\xed\x75\xee\xa8\x80\x4c\xed\xdb\x8c\x8e\x3f\xe5\xf2\xb4\xc9\x77\x26\x79\x35\x9c\x8b\xb7\x81\xf1\x25\xb8\x32\xf6\xc6\x61\xe6\x74\x3d\xf6\xc6\x60\x36\xa3\x29\xa3\x06\x18\x03\x0c\x34\x3c\xf6\xe8\x07\x91\xa5\xc4\xcc\x59\x75\x1b\x13\x27\x2a\xb5\xb4\x8e\x83\xff\x04\x69\x70\x32\x7c\xf0\x5d\xf0\x60\x72\xfa\xe5\xe1\x70\xf5\x3f\x83\xa8\x5f\xd0\xbc\xd0\xf0\x57\x57\x64\xf0\x9f\xe1\xe7\x4a\xa0\x35\x30\x54\x81\x98\xaf\x83\x92\x06\xc8\x00\xcd\xca\xe0\x3f\x9d\x20\x0d\xba\x4f\x6a\xb1\xc1\x20\x87\x9f\x6b\x00\x31\x3d\x0f\x06\x3a\xf0\x18\x0f\x2d\x06\xe3\xe0\x4e\x99\x85\x75\xe7\x1c\x9e\x41\x33\x91\x0a\xe1\xc7\x5c\x0f\xc2\xa3\x22\x31\x3d\x7d\x58\xdd\x3e\x78\xf0\xa3\xe8\x90\x6f\x1a\x71\xbf\xcb\x20\xad\x9e\x43\x38\xb4\x76\x0e\x6e\xa4\xf3\x39\xfb\x77\x1c\x2c\x72\x90\x02\xe3\x98\xc8\xdf\x3d\xec\xf6\xd2\xee\x38\x77\xe9\x8f\xfa\x6d\x34\x70\xa4\x64\x35\x8e\x24\x1a\x8c\x67\x64\x1c\xe4\x4e\x35\x51\xce\x29\x6b\x39\x17\x53\x8a\xc8\x8f\x2f\xde\xe6\x44\xc8\xdb\xca\x97\xf3\x39\x0d\x4b\xe9\xd1\x6a\xee\x96\xe9\xd2\xaa\xbd\x8a\x3e\x07\x03\x3e\x1e\x0b\x37\x81\x2a\x29\xbe\xdc\xdd\x6d\xaf\x0e\x50\x88\x00\x5a\x60\x15\x10\x2f\x83\x1c\x7c\xff\xcc\x82\x5d\xd9\x1b\x53\x05\x26\xcf\xaa\x3e\xff\x3a\x0f\x87\x70\xaf\x4c\x2c\x52\x02\xff\xe9\xc5\x8c\xc4\x14\x5e\xaf\xa3\xe8\x87\x8b\x05\xcd\xd8\xb0\xe4\x7c\x25\x10\x39\x72\x1a\xf1\xe0\x82\x41\x4e\xe7\xc1\x82\xcd\xdb\xb6\xa1\x9d\xed\x28\xab\x13\xd4\x69\xf0\x82\xb7\xfd\xa8\x4b\x7e\x20\xdf\x32\x99\x41\x64\x9d\x44\xa7\xfd\x22\x3d\x66\x0d\x09\x15\xde\xe6\xfe\x3e\xca\x84\x05\x52\x5d\xe1\xf7\xfb\x9e\x1a\xb1\x52\xd0\xaa\xb1\xc4\x5d\x3b\x5a\xc6\x7a\xcb\x31\x03\xae\xa9\x10\x18\x8a\x9c\x5f\x8b\x13\xbc\x24\xdb\x12\x9a\x2d\xd2\xdb\xa4\x59\x79\xe3\xa0\xe4\x82\x35\x48\x16\xe9\xf9\x15\xf5\xd9\x8f\xdc\x45\x3b\xed\xb6\x20\x25\x97\x4c\x0d\xfc\x96\x51\x77\x35\xd5\x22\xa0\xb1\xd3\x7d\xa1\xc4\x2d\x25\x64\xd9\x0f\x64\x2c\x61\x13\x35\xf0\xc7\x99\x36\xd8\x64\x64\xfa\x1d\x3b\xed\x4e\x78\x14\x03\x79\x7f\xaa\x68\xdc\x20\xe1\x3a\x0a\x36\x69\xc8\x1e\xc0\xd6\x7e\x29\x79\x23\x02\xb3\x79\x6e\x6d\x4d\x95\xc4\x69\x95\x34\x65\x5f\x2d\xeb\x1a\x1c\xb7\x48\x99\x4c\x1b\x85\x64\x67\xc8\x9d\x34\x3d\x10\xd7\xba\xbc\x4d\xfe\x74\x64\xf8\x99\x70\x93\x1c\xd7\x24\xaf\x96\x96\xfd\x36\x1a\xc2\xdd\x03\x66\xf0\xca\xec\xae\x19\x05\x5b\x02\x95\x77\x8f\x94\xfe\x8c\x25\x3e\x88\x79\x8e\x19\xfc\xc7\x94\x13\xca\x37\x5b\xb9\x1c\x18\x4d\x92\x2d\x6f\x85\x16\x88\x8c\x7d\x09\x57\x81\xaa\x4e\x79\x01\xba\xdb\xed\x91\x87\x43\xff\xed\x8e\xf4\x02\x2d\xf5\x28\xb9\x96\xb4\xeb\xa3\x9c\x7a\x8f\xf3\xbe\x2b\x4b\x23\xf2\x29\xdf\xf5\x7c\x07\x71\xd2\xe1\xdd\xb0\x64\x53\xd9\xb7\x24\x2f\x82\x64\xcc\x38\x89\x2e\x7c\x75\xa5\xd0\x26\x0a\xc3\xd3\x42\xf8\x32\x7c\x95\x78\x53\xb9\x4d\x0b\xf0\x23\x55\x65\xbb\x29\xa2\xa4\x52\xa2\x0e\x4b\xef\x1d\xa3\xb0\x86\x28\xf2\x44\xaa\xf2\xe2\x47\xb0\x57\xd1\x33\x18\x0d\xef\x5b\xfb\xf6\xd0\xc3\xfb\xd2\x18\x37\xb2\xc7\xf5\xd8\xf9\x51\x1b\xff\xac\x8b\x1f\x59\xf4\x5a\x18\x92\x25\xda\x0d\x47\xc4\xfa\x54\xd4\x0f\x87\x77\xfd\x1a\x83\x39\x12\x7d\x6b\xb8\x18\x98\x88\x91\x2c\xe3\x18\xe2\x54\x74\xdc\x15\x02\x06\xf7\xa0\x2b\xf1\x8c\x5d\xdc\xb3\x37\x1c\xf9\x19\xef\x6c\x03\x76\xc0\x01\xaf\xc3\x0c\x78\xd2\xb5\x26\x52\x74\xaf\xe9\x68\xc0\xeb\x62\xfd\x58\x9c\x98\x26\x0d\x47\xe2\xc6\x25\x69\xc8\xd2\xa0\x60\xe5\x18\xec\xb3\x0b\xdf\x49\xc1\xb6\x31\x95\x54\x67\x0e\xe2\xef\xb9\xb9\xae\xb4\xe5\x48\xe5\x18\x58\x31\xfb\xb5\x87\xf2\x56\x95\x5d\xba\x3b\xd5\xe6\x26\x5c\x2b\xf3\x37\xd2\xda\x08\x79\x45\xc6\x10\x7d\xab\x43\x3d\xbb\x1b\x3e\xc4\xae\x33\xea\x40\x8c\x3b\x58\x66\x69\x16\x8c\x67\x41\x94\x90\xb3\x38\x1d\x7f\x22\x33\x1a\x84\x34\x63\x3f\xd2\xb9\x6d\x70\x13\xe5\x4f\x59\xb2\x4f\x72\x98\xd1\xcf\xca\x1b\x3d\x94\x25\x93\x28\x2e\x6c\xa5\xb2\x87\x66\x01\xd6\x70\xfa\xcc\x52\x6c\x55\x82\xa1\x20\xf8\x66\x7b\x47\x6b\x08\x38\x78\x0d\x6a\x8c\xf3\xbd\xa7\x2e\x5c\x95\x75\xb4\x87\x73\xbd\x0b\xe5\x8b\xfa\x9c\x79\xe3\x3d\xbb\x91\x1b\x9a\x4e\x4e\x9c\x32\x59\x6f\x11\x8d\xdd\x79\xf8\xc0\x92\xeb\xe6\xa1\x50\x40\x15\x13\x00\x35\x19\x13\x00\xc5\x2a\x27\xe0\xd1\x43\x8d\x7f\x0e\x7d\x6d\xfc\x43\x55\xb8\x26\x1f\xfa\x1d\xa0\x6b\x61\xbf\xc4\x2f\x8c\x90\xfa\x46\xf2\xa3\x27\x53\xe1\xbd\xd6\x48\x7d\xf1\x74\x90\x0d\x47\xfc\x3f\x99\x22\x8c\x7f\x46\xfa\x93\xe7\x20\xc3\xa0\x11\xfe\x21\xcb\x1d\x17\x93\xc7\x23\xf1\xbf\x4c\x03\x53\xa3\x91\xfc\xd0\xf5\x70\x58\xf9\xa5\xd3\x05\xbc\xfa\x14\xf5\xb8\xf6\xd2\x23\x5f\x22\x87\x76\xcd\x70\x47\x9e\x34\x03\x56\x5a\xbc\x8e\xec\x04\x39\x8e\x9f\x29\x8c\xe2\x67\x8a\xc6\x00\x69\xe2\x43\xc2\x29\x81\x71\x84\x7f\xc8\x5c\x53\x73\x3e\x72\x52\x14\xd6\xb8\xac\x3e\xd2\x9f\x3c\x07\x09\xc8\x23\xfc\x43\xe6\x1a\xc7\x91\x91\x9d\x20\xa1\x50\xbe\x95\x63\x9d\xe0\x47\x6e\x92\xec\xa1\x03\xe9\x24\xc9\x3a\xa5\x3c\x36\x42\xdf\xb8\xbf\xc9\x74\xa4\xbe\x64\x3a\xdf\x56\x47\xea\x4b\x8d\x9e\xaf\xf7\x91\xfe\x54\x63\x62\x1b\xe5\x48\x7e\xc8\x54\xb6\x67\x8d\xc4\xff\xaa\x0e\xc6\xef\x46\xf2\x43\xa6\x02\xdb\x18\xc9\x8f\x1e\x2c\x30\xee\x16\x50\xbc\xba\x6f\x8d\xb6\xbf\xeb\x55\xba\x1f\xea\xb5\x96\xc5\xe4\x71\x6b\xf4\xf8\x9b\xd5\x69\x6f\x67\xbb\x89\x47\x0e\x73\x09\xef\xf3\x05\xdc\x12\x8e\x28\x5a\x23\xd2\x1a\xf6\x77\x86\xfd\xed\xd6\xc6\x4a\x3a\xe0\xdb\x69\x14\x33\xfa\xce\xd3\xc7\x9d\xa7\x8f\xbf\x82\xa7\x0f\x51\xcb\x86\xeb\x81\xef\xef\x74\x32\xc9\xe8\x25\xf9\x39\x8a\xc7\x9f\x28\xf9\xfe\x17\x3a\x99\xd8\xee\x3e\x1a\xfa\xe9\x03\xb0\x28\x48\xc8\x11\x13\xba\x03\x80\x8a\x82\xc4\x05\xfb\x31\x38\x63\x60\xff\x48\xa7\x34\xce\x0b\x1a\xc7\x34\x23\xdf\x4f\x20\xd1\x05\xfe\x29\x38\x27\x3f\xa7\x69\x48\xbe\x9f\x96\xba\x21\x79\xa8\xdd\x2f\x09\x0f\x9c\xaf\x83\x24\x98\x9a\xbe\x41\xfa\x03\x86\x85\x41\xc6\x01\xe6\x1c\x40\xfa\x00\x39\x3c\x83\xf3\x91\x0d\x1c\x9d\x05\x89\x04\x09\xd2\xc0\x03\xc1\x25\xaf\x7c\x00\x77\xe4\x1c\xf0\xf9\xd3\x0a\xb8\xf0\x4c\x79\xf9\x9d\xcd\x2a\xe0\xf2\xd9\x4c\x02\xbe\xa1\x45\x05\x60\x42\x0b\x09\xf8\x96\x66\x39\xbc\x82\x2b\x87\x5e\x08\x10\xd5\x89\x8b\x20\x9b\x57\x75\x83\xe5\x2b\x60\x5a\x14\x10\x2b\xcb\x85\xcf\x45\x96\x04\x95\x5c\xc5\x80\x94\xec\x82\x1d\xaa\xb4\xf3\x95\x28\xb6\x2a\x44\x01\xfe\xcb\x7d\xb8\x70\x20\xe9\x2d\x4b\xbc\xb9\xa1\x49\xe8\xe9\x1b\xcf\x90\x60\x4f\xe1\xd0\xe4\x42\x9d\xb1\x74\x85\xc9\x2c\x5d\xd0\xac\xb8\xf4\xc0\x2d\x44\x96\x04\x7d\x59\x14\x8b\xb7\x59\x7a\x1e\x85\x5e\x72\x63\x0b\x75\x21\xb2\x15\xb1\x2d\xc6\x15\x25\xa2\xc5\xd8\x2e\xd0\xcc\x93\xe4\xc6\x86\x92\xd5\x7f\xa6\x67\xbb\xa4\x23\xab\x31\x7d\x21\x67\xf6\x0a\x49\xe8\x85\xb5\x6c\x74\x49\xe4\x16\x59\x04\xbd\x45\x3d\x97\x50\x08\x88\x2f\x12\x56\x63\x90\x06\x10\x1e\x01\x57\x11\x9e\x89\xcc\xe7\x4f\x9d\xbc\x1c\x96\x03\x78\x4c\x9e\xcd\x9c\xdc\x04\xd6\x00\xcb\x7d\x43\x0b\x27\x77\xa1\x09\x9f\x81\xc8\x75\xe0\xc0\x9d\xfd\xfa\xab\x6c\x83\xd1\xb5\xdb\x07\x4d\xe0\x00\x24\x7e\x76\x30\x8c\xa6\x6c\x7d\xd4\x08\x16\xd1\x48\x6d\x86\xe2\x7f\x7e\xe4\xc0\x9d\x14\xd8\xca\x8d\xa2\x98\x7c\x46\xc6\xaf\x9e\x82\x41\xf4\x32\xc2\x3f\x9c\x26\x3e\xaa\x35\xc0\x3f\x9c\x01\x0a\x80\x8e\x6e\x5f\x90\x73\x44\xf3\x11\xfa\xee\x70\xa3\xaa\x55\x77\x8f\x49\x4c\x83\x01\x38\x3e\xce\x29\xd1\x63\x48\xf9\x4e\x0c\x3e\x9b\x36\x18\xb9\x79\xc6\x57\x37\xb6\xd2\x71\x31\xa1\x51\xd6\x29\x83\x9a\x52\x4c\x79\x38\x7a\x73\x3d\x8d\xe3\xc2\x6b\x93\xb6\xa7\x2f\x19\xe5\x21\x3a\x74\x2f\x3e\x51\xba\x38\xcc\xdf\x5f\x26\xe3\x28\x99\x56\x76\x05\xca\x5a\xf0\xcd\x28\xd0\xd3\x11\xcc\x17\x9e\x6a\x33\x1a\x0b\x4a\xbe\x60\xe2\xee\xbe\xe0\x97\x07\x46\xbe\x76\x96\x50\xf0\xdb\x03\x27\x9e\xcc\x4b\x30\xf6\xd3\x81\xc2\xaf\xa7\x39\xa0\x4a\xf1\xc2\x1a\x75\xca\x04\x4f\xdb\xfa\x29\x9c\x6c\x5e\xa4\x78\x6b\xb5\xa1\x51\x9a\xa7\x6e\x8c\x4b\x59\x7b\x15\x4e\xb9\x7d\xab\x84\xfc\x99\xfa\x47\x86\xa1\xc4\x6f\x07\x0e\xdb\xce\x70\x48\x95\xe2\x81\x75\x2f\x87\x65\x99\x67\xf6\x95\xa1\xd3\xe7\xb2\xb2\x4e\x8e\xa7\xdd\xc3\xa7\x07\x6f\x50\x63\xec\xa7\x03\xa5\x3d\x0b\x71\x30\xf1\xdb\x07\x27\x9d\xde\x28\x40\x48\x60\xbb\x98\xbd\xf0\xf9\xd6\x8f\x1f\xe1\xf3\x7b\x21\xd3\xfb\xa5\xbc\xb2\xe3\x0f\x11\xe1\x66\xda\x86\x2c\xbb\x43\x0d\xa3\x0c\xb4\xc5\xe3\x60\x01\xaf\x67\xd0\x2d\xa6\x67\x46\x0f\x9f\x1d\xbc\x35\xd6\x3e\x2b\x87\x0d\xf5\x22\x2e\x4a\xb2\xe5\xcb\xa4\x4a\x9e\x6f\xbc\xd3\x65\x10\x7d\xd1\x8c\x5c\xd9\xe0\x0b\x48\xf1\xdf\xaa\x88\xaf\x27\x8a\x77\xc3\x5e\x27\xc4\x91\x8e\x79\xf1\x9c\x80\x0e\xa6\x2d\xf7\xa4\x24\x0d\x69\xbb\x67\x40\x4c\xc1\x3a\x64\x44\xda\x4c\xe8\xf8\x38\x8e\x23\x9a\x14\xff\xe0\xe0\x6d\x7d\x31\xdd\xed\x5d\xa7\x35\x5a\x5c\xa4\xd9\xa7\xb2\x06\x13\x5a\x7c\x14\xa0\x16\x88\x19\xa6\x61\x64\xaf\xf2\x1b\x76\x8b\xce\xe9\x72\x5e\xd6\xa9\x20\x0d\x3e\xc2\x44\x8f\xd3\xf8\x1f\xbf\x43\xe7\x2e\x66\x51\xbe\xa0\x59\x59\xf7\xf2\xd9\xec\xc6\x38\x83\xcf\x53\xef\x46\x12\xe5\xcf\xd2\x24\xe1\xbe\xb6\xd0\x5a\xeb\x1a\x84\xd7\xf1\xee\x95\xf7\xee\x79\xf7\x50\x5c\x65\xa7\xeb\xdf\xbe\xb8\x77\x09\x29\x90\x97\x12\x3c\x18\xa8\x42\xb0\x0a\x21\xce\x78\x95\xb6\xac\x6e\xe9\xea\x15\x1f\x0f\xb8\xbe\xc9\x38\x55\xb4\x46\x3b\x43\x96\x84\xcf\x23\xad\xd1\xce\x36\x4b\xd3\x67\x81\xd6\x68\xe7\xa1\x4a\xe1\x72\x53\x6b\xb4\xf3\x58\x25\x61\xc9\xbe\x35\xda\xdd\x51\x19\x6c\x79\xb7\x46\xbb\xbb\x3a\x41\x4b\xf4\xad\xd1\xae\xae\x54\x9f\x09\x5b\xa3\xdd\x6f\x9d\xe4\x20\x0d\x5a\xa3\xdd\xc7\x4e\x7a\x42\x8b\xd6\x68\xf7\x3b\x27\x5d\x4a\xc1\xad\xd1\xc3\xa1\x93\x99\xcf\x66\xad\xd1\xc3\x6d\x37\x9d\x09\xc2\xad\xd1\x43\xdd\x7d\x79\xc0\x69\x8d\x1e\x7e\xa3\x12\xcd\x53\x73\x6b\xf4\xf0\x91\xca\x92\x22\x4b\x6b\xf4\xf0\xdb\x6a\xc5\xde\xea\xb4\xb7\xb3\x7b\xa7\x76\xbb\x53\xbb\xfd\xb7\xa8\xdd\x82\x38\x06\xc7\x20\x37\x73\xb2\x8b\xb4\x5b\x8e\x1e\xc4\xa7\x08\x91\x91\x79\x5e\x9c\xf3\x57\x05\x48\xc1\x00\xbd\x91\x70\x3a\x4e\x4f\x5d\x40\x2a\x57\x49\xe3\xd5\xd2\xfc\x08\x37\xbb\x56\x65\x90\x26\x21\x2e\x78\xb8\x29\x13\x44\xb2\x22\x91\xa9\x5c\xef\x1e\xc4\xb1\x31\x14\x53\x2a\x32\xcf\x55\x3d\xb8\xa9\xef\x11\xcb\xb8\xac\x44\xe3\x61\x26\xe0\xf3\xf1\x2f\xfc\x72\x9e\xfd\x87\x93\x1d\x93\x49\xbe\x09\x39\x3d\xac\x8e\xac\x6e\x89\xac\xd2\x59\xfb\xbe\xfa\xba\xba\x82\x90\x43\xc4\x7e\xd4\xc6\x12\x21\xf5\xa4\xcd\x44\x50\x88\xf9\xd0\xee\x91\x76\x91\xf2\xcf\xd3\x3e\x47\x33\x0a\x31\x39\xf1\x5c\x85\x8a\x66\x4e\x26\xa7\x60\xe0\xa2\x6c\x44\xc5\xf5\x68\xd7\x13\xa7\xdc\xaa\x86\xf5\x87\x15\xdf\x47\xc4\xc3\x5d\x1f\x41\x47\xf8\x61\x49\xc7\x1d\xd4\x0d\x4a\x9b\x05\xfd\xf4\x16\xde\xca\x69\xbc\x1a\x78\x36\xdf\xe7\xe2\xc7\x72\x55\x18\xf7\x04\x8a\x0e\x83\x22\x90\x23\x60\xdf\x7d\xf6\x0f\xd9\x47\xdf\x57\x57\x60\x18\xab\x00\xe0\x1e\x39\x97\x20\xe2\xd7\xd5\x95\x0e\x78\x0a\xaa\x46\xd6\xb4\xbc\x20\x47\x80\x27\xc3\xd3\x7e\xce\x18\x82\xf2\x7f\xcf\xa0\xe7\x42\xc0\xd1\x14\xe6\x4e\xd7\x2f\x9e\xe9\xc2\xad\xec\x0b\x6b\x5b\x21\xdd\xb9\x37\xb6\x9d\x5f\x94\x73\x81\xee\xc9\xf0\x14\xbd\x7e\xdb\x84\xf6\xbb\xe4\x0b\x3c\x90\x08\x92\x24\x2d\xc8\x24\x4a\x42\xde\xaf\x28\x99\xf2\x86\x9e\xa8\xe6\xc7\x69\x92\xa7\x31\xed\x5f\x04\x59\xd2\x69\xe3\x12\xdc\xcb\x11\x63\xc5\x71\x3a\x6d\x23\xe3\x57\xd1\x63\x86\x0a\xc7\x53\x16\x15\x6c\x08\x07\x43\x83\xb9\xeb\xf8\x56\x67\x8f\x77\xab\x67\x12\x84\x79\x7e\x82\x1a\xa5\x93\x4a\x98\xe2\x06\xcb\xf1\x33\x1d\x33\x09\xc0\xb3\x1e\x7b\xe0\x49\xeb\x2c\x18\x7f\x52\x61\x5b\xc1\x8f\x84\x38\xe9\xca\xbb\xd6\x4e\x90\x4d\x97\xf0\x7e\xe4\x44\x7d\x21\x2f\x4a\xa6\x21\xba\xac\x11\xc2\x6d\x57\x16\xc3\xfe\xfe\x3a\x0e\x04\x9b\xf8\x6d\xd3\xff\x88\x66\x1b\xc9\x32\x8e\x1d\x74\xa7\x92\xd2\x84\xd7\x42\x7d\xfa\x95\x10\x13\x14\xd8\x8e\xab\x65\x01\x93\xfd\xb3\xc8\xd4\x18\x22\xf1\x9b\x73\xf6\x4e\xda\x13\xf1\x7d\xbc\x8c\xb5\x27\xd9\x7b\x9f\xce\x3b\xdd\x9e\x6e\x07\x21\xb8\x7e\xa2\x82\xa2\x08\xc6\xb3\x0f\xe9\x33\xe9\xbf\x0c\xcf\x98\x74\x6a\x86\xcf\xdb\x7a\x66\xf9\xb0\xf9\x4f\x67\x34\xb2\x68\x3f\x88\x63\xb5\x9d\x08\xe0\x92\x23\x85\xd3\x4d\x75\xbe\xf0\x1c\x30\xbc\x27\x0c\xa0\xd4\xd6\x68\x07\x84\x7b\xbe\xe8\x5b\xa3\x1d\x10\xdd\x71\x94\xbc\x5d\x00\xb6\xf6\xc1\xd6\xe8\xe1\x2e\x93\x98\x1f\xde\x49\xcc\x77\x12\xf3\x7f\x8b\xc4\x0c\x27\xef\xdb\x0a\xc5\xf3\xf7\x3c\x4d\xb2\xc5\xd8\x94\x36\x7f\xe1\x89\xea\xd6\x30\xcb\x52\x5b\x00\xe6\x69\x4a\x0e\x75\x15\x14\x6c\xac\x86\x88\xe9\x48\x98\x80\x8d\x8f\xa5\x72\xa6\xc8\xc8\x45\x24\xf5\x1a\xf7\x8e\x41\x18\x4a\x4f\x9c\x8c\x19\x8b\xc2\xe0\xdc\x1c\xba\x26\x12\x2c\x63\xc0\x20\x0c\x3d\x16\xb6\x44\x8c\x9f\x17\x2a\xb4\x6d\xeb\x60\x03\xc6\x89\x39\x71\x18\xfa\x24\x6e\xdf\xc0\x73\x1e\x86\x5d\x42\xd4\x8e\x48\xf2\xec\xaa\xfe\x0b\x18\x6f\xd7\x7c\xbb\xb9\xe9\x55\x42\xe1\xd7\xe8\xa6\x3b\x05\xfa\x8a\x28\x09\xb9\x92\x49\xc2\xf6\x50\xdd\x34\xcb\x7a\x42\x0e\xcd\x5d\x89\x38\x57\xce\x1f\x94\xa8\xa8\x01\x04\x7e\xb0\x63\x50\x85\xca\x1e\x81\x37\xf6\x25\x2f\xd8\x44\x95\x27\x00\x73\x8a\x0f\x07\xa5\xe2\x3a\x2f\x52\x52\x2d\x13\x6a\x64\x7f\x44\xa5\x7d\x47\xf2\xb1\x0b\x6c\x8a\x45\xd4\x8f\xf2\x7f\x04\x71\x14\xbe\xa3\xf9\x22\x4d\x72\x2a\x9a\x72\x5e\xdf\x39\x63\xf0\xb7\xd7\xe1\x6b\xac\x7f\x98\x9c\x7b\x6b\xdd\x73\x2a\x5d\xb9\xfd\x2b\xad\x9c\xbb\xdb\x72\x06\xcb\xb7\x5c\x70\x07\xe2\xcb\x10\x8d\xf7\x45\x1f\xc0\x51\x08\x4e\xb0\x3a\xb5\x42\x53\xa1\x4f\x37\x5e\x41\x02\x08\x4b\x2b\x27\xf9\xd8\x5b\xa3\x1d\x50\xa2\x89\x05\xd9\x1a\xed\x82\xbd\x5b\xa3\xa8\xea\x77\xdb\xfd\xdd\x76\xff\x57\xd8\xee\x95\x50\x7e\x4b\xfa\xb1\x86\x8a\x2a\x76\xdc\xc9\x2c\xb0\x5c\x48\xfa\x03\xc8\x5c\x57\x97\x26\xe1\xd0\xa3\x29\xac\x04\x93\xaf\xa1\x04\xf4\x01\x3a\x82\x20\x30\xa5\x2e\x34\x42\xbc\xfb\xb6\x4f\xae\x5b\xc2\x2f\xcc\x60\x97\xb7\xdf\x29\x73\xb8\x67\x1a\xec\xad\x84\x52\x62\x01\x98\xf9\xae\x88\x74\xc0\x6d\xa6\x7a\x1b\x10\x2e\x9a\xfd\x55\x9b\x2f\x3d\xcf\xa2\x9e\x28\x67\x5d\xe9\x04\x67\x91\x47\x07\x82\x9c\x75\x13\xcb\x3b\x36\x0f\xa8\xbe\xbf\x4f\xda\xa8\x4f\x6d\x72\xef\x9e\xe1\x7d\x1b\x1d\x9a\x79\xb3\x46\x88\x86\x55\xd7\xda\x85\xab\x1a\xf4\xf8\xf3\x26\x1d\x48\x2c\xd9\xad\x21\x8f\xbb\xf9\xf6\x6c\x0c\x56\x45\x0c\x2c\xf7\x34\x0d\xb4\x27\x4e\xee\x1c\xa1\x1c\x54\xa1\x11\xa9\x78\xa4\xea\xaa\x81\xf0\xa8\x42\xad\x09\xf7\x60\xfc\x60\xed\x7d\xd6\x14\x84\xa1\xa4\xe1\x5c\x1f\xc2\x31\x6d\xc8\xb4\x95\xaa\xa9\x94\x9e\x38\xa9\xf8\xab\xac\x3c\xd7\xeb\xc3\xfa\xf5\x09\x05\x3d\x41\x5c\x67\xf6\xb1\x9a\x4a\xa9\x7e\x54\x7f\x3e\x06\x69\x20\x75\xcd\xba\x93\xa6\x7f\x8c\x5a\x3d\xea\xc4\x51\x72\x68\x04\x98\x92\x44\xcd\xbc\x72\x6e\xd1\x68\x52\x39\xbf\xb9\xbd\x19\xb5\xeb\xab\x57\xd3\x48\x86\x77\x1b\x73\xcb\x79\xaf\xa5\x53\x16\x9c\x55\xa8\x1a\x15\x8f\x35\x27\xcf\x55\x5a\xf1\x8e\x95\x4e\xe7\x41\x1c\x57\x4e\x17\x00\x89\x5b\x9e\xb5\x09\x8c\xeb\x41\x6b\x3a\xb8\x3e\xb5\x19\x2f\x02\x5d\x95\x5a\x19\xb5\x55\x91\x9b\xf2\xac\x03\x56\x7f\x72\xd2\xa7\xb4\xc8\x85\xd9\x4a\x7c\x49\x42\xba\x88\xd3\x4b\x1a\x4a\xe3\x40\x78\x38\x08\x6f\x08\xed\x87\x6a\x50\xdb\x8f\x69\x26\x7b\xe4\x71\x3d\x20\xcf\xab\x3e\x92\x94\xeb\x72\xa5\x14\x8b\x1b\x86\x6b\xe3\x63\x79\xb3\xa1\x1f\xb4\x95\xb4\x88\xad\x1d\x64\x4b\x48\x5d\xa9\xc9\x17\x22\x69\x43\xf8\x1f\x47\xc7\xfb\x23\xc4\x85\xdf\x97\x4f\xca\x20\x7f\x30\x20\x17\x41\xc4\x75\xe5\x20\x72\x2d\x0a\xad\x7f\x95\xd7\x64\xe6\xbc\x8b\xa5\xa0\x22\x79\xeb\x8e\xd1\xae\xe9\x2e\x7b\x93\xc2\xbb\x64\xa3\x7d\x7b\x57\x82\xfe\x6e\x6d\xed\x99\xa7\xa6\xc1\x80\xe4\x45\xba\xe0\x8a\xda\x28\x99\x92\x60\xc2\xba\xf2\xcd\x90\xcf\x55\x4e\x3a\x45\x34\xa7\xe9\xb2\xe8\x3a\x27\x47\x8e\x80\x1f\xc8\x37\x43\xef\x59\x91\xf7\xbe\xcf\x6a\xff\x59\x54\xae\x03\x61\x74\xc9\x97\x95\xe7\x48\x67\x23\x90\x3f\xd5\xf3\x1e\x43\xd5\x8c\x78\x0f\x9b\xfa\xe0\xa7\x5d\x3a\x2b\xc6\x04\x97\x25\x01\x5f\x19\x63\x46\xd8\xe0\xde\xf9\x8c\x49\xcc\xcb\x24\xb4\x31\xd0\xf6\x9d\x3d\x69\x8c\xfc\x89\xe0\x3f\xc7\x91\xf4\xb5\x5b\x65\xcb\x0f\xd7\xac\xdc\x89\xb8\x58\x33\xa8\x66\x4a\x8b\x0f\xba\xa9\x77\x9c\xd4\x34\x47\x41\xdd\x78\x19\xe4\x33\x4c\x54\x3d\x49\x98\x5d\xff\x09\x1e\xfc\x60\x02\x80\x9f\xda\xbc\x85\xbc\x1d\x84\xb8\x53\xa2\xae\xfe\xd8\x5c\x80\x66\x8f\x20\x38\x95\xbf\x3b\xf2\xaf\xcc\xe5\xfe\x23\xe5\x72\xbf\xec\x2f\x9a\x74\x4c\x8a\xbb\xba\x22\x9b\xd0\x62\x65\x31\xa2\x58\xb7\x87\x36\xf1\xdf\x75\x96\x00\xfe\x6b\xb8\x1c\xec\x21\xa5\x21\x0a\xde\xbd\x5b\x39\x33\xf2\x6f\x30\x50\x97\x7c\x71\x3a\x45\x54\x0b\xc7\x0a\xc9\xc6\x37\xdb\xdd\x9a\xe6\x89\x21\xaa\x29\x8e\x5a\x32\xd5\x0d\x2a\x1b\x0c\x08\xdf\xac\xa4\xb8\x10\x24\x21\x11\xf7\x22\x24\x98\x06\x51\x22\x56\xce\x05\x15\x61\x19\x6b\xfe\xfc\xb2\xa7\xbd\x01\xd6\xd4\x60\xcb\x3a\xce\xf6\x5f\x33\xa4\x31\x77\xdf\x26\x6e\x04\xd9\x96\xc0\x76\xc7\x9c\x8e\xd3\x24\x24\x8c\xe1\xd6\x56\x82\x48\xb7\x9e\x58\x89\xc1\x11\x41\x15\xd6\xb4\xc3\x5e\x27\x46\xb7\xdc\x21\xec\x75\x1f\x89\x12\xe2\x44\x8b\x38\x65\x5e\xa4\x19\x0d\x95\x07\x7e\x2e\x81\x80\xc6\x67\x1a\xe4\x24\x98\xb3\x0d\xa9\xef\xe5\xd7\xf6\x5f\x29\xff\xb6\xff\x3c\x81\x01\x6e\xa3\x8b\xd5\x3d\x5c\x95\xe6\x96\x71\x0c\xb7\x84\x0d\x89\x94\x93\x4d\x0f\x14\xe8\x86\x41\x12\xfa\x8f\x01\x3b\x66\x5f\x2a\x47\x1a\x96\x14\x67\x81\xd5\x1c\x1a\xec\x4a\xf1\x81\x81\xce\x7b\x0c\xd6\xb8\x5a\xa0\x73\xb2\x4f\xe8\x1c\x3f\x2e\x3a\x8b\xc8\x3e\x83\x93\x42\xd6\x7d\xae\x06\xad\x1f\x90\x3e\x1e\x25\x24\x40\x72\xa9\x28\x2e\x6b\x91\x03\x4b\xe8\x85\x4a\x92\x03\x4a\x2e\x57\x98\x12\x2c\xc5\xc8\xb6\xb4\x26\x08\xe2\xee\x9a\x45\x77\x2a\x8a\xda\x42\xb0\x21\x56\x08\x2f\x27\x52\x4b\x1c\x3a\xa5\x7d\x62\xb2\x80\x50\xa2\xb2\x3e\xfb\xc9\x24\xd5\x96\x9e\x75\x28\x34\xd0\x13\xc1\x50\xea\xbb\x7a\x21\x15\xfb\xf3\xb7\xc6\xfe\xcc\x45\x6a\x75\x74\xc4\x44\xd7\x11\xb3\xdf\x53\x93\x8e\xb9\xd6\x60\xc0\x83\x60\x6a\xb3\x0a\xa3\x4e\x6d\x1c\xf1\x65\xb5\xc7\x80\x25\x72\x36\xcd\xa6\x05\x3e\xf6\xcc\x1e\x05\x19\xbc\xbc\x01\xe2\xc5\x4f\x10\xe2\x68\x4c\xe1\x76\x41\x1b\x68\x58\x71\x1a\x7d\xc6\x22\x60\xf0\x51\x6e\x22\x42\x1c\x33\x91\xbc\xbf\x48\x17\x86\x4f\x39\xb3\x7b\x71\x90\x17\x02\xd2\xa9\xda\xdf\x1d\x4e\x3f\x1d\x56\x10\xbc\x15\x6d\xaa\xf7\x25\x10\x31\x18\xd2\xed\x3e\x69\x14\xd6\x74\x89\x35\x24\x80\xfb\x3c\x82\xcc\x0f\xa6\x83\x68\x34\xd1\x92\xe4\x0f\xe4\x12\xae\x3f\xf9\xcb\xbf\x1b\xa9\x01\x11\x9a\x2c\x06\x29\x55\x68\xca\xb6\x0e\x0f\x6b\xd3\xec\x72\x7f\x11\x5c\x06\x67\x31\xf5\x75\xcf\x3d\x02\x70\x83\xa9\x9c\x26\xa1\x0e\x1d\x96\xa4\xc9\x03\x51\x09\x46\x87\xbd\x35\xac\xca\xa6\x1e\xdc\xf9\x31\x7e\xe8\x57\xbb\x76\xe4\x52\xe9\xc1\x88\x51\xad\x72\x82\xc0\xd8\x6d\x6b\x9f\x57\xb4\x67\x4e\x62\xe9\x25\x20\x3e\xc5\x1a\xe7\x7e\x29\xeb\x05\x61\x62\x6b\x06\x42\x4a\x2e\x82\x5c\x09\x91\x1b\x26\xae\x60\x65\xc3\x65\x2b\x3a\xb5\x68\x43\x2c\xeb\xc6\x75\x16\xe4\x33\x1f\xce\x59\xa7\x69\x96\x95\xdd\x3d\xe2\x4b\x46\xdf\x4d\x62\x95\x90\xc3\x24\xd0\x30\xe4\x37\x5d\x88\xd7\xb2\x9e\xf8\xdb\x2a\x39\x69\x91\x7d\x28\x53\x22\x6f\x95\x0a\x85\x93\x28\xcb\x8b\x72\x99\x70\x4d\xb1\xae\x44\xe9\xe1\xd3\x74\xf8\x2e\x5c\x8d\x5f\x75\xae\x2e\x21\x22\x2a\x1f\x78\xcd\x34\x5b\x6d\x35\xc5\x78\x2d\xa6\xd7\x19\xb9\x9f\xa3\x29\x2d\x3c\x07\x12\xda\x31\x81\x5c\xb9\xf3\xb1\x13\xed\x73\x6e\x46\x0a\x49\xe2\xa7\x61\x70\x76\x6d\x51\xcb\xd6\x93\xd5\xe9\x63\x3d\x3b\xb9\xa8\x69\x65\x0a\x3e\x13\x55\xff\x60\x63\x30\xb0\xb6\x5f\xe3\xca\x46\x7b\x43\x46\x0a\x4b\xab\xf2\x0e\xdf\x95\x07\x03\xc3\x7b\x6e\x69\x78\xf0\xf1\x18\x1c\xe1\xa6\x3c\xa8\x56\x94\x4c\x2b\x04\x32\x53\x71\x6d\x8e\x9c\x4f\xe2\xca\xe5\x43\x58\x06\xaa\x92\x7f\xc8\x17\x24\x6a\x35\x15\x83\x26\x24\x49\x75\x0d\x8c\xb9\x2d\x82\x3c\xa7\x61\x8f\x55\xa1\x3d\xdd\x31\x88\x1c\xad\x68\x93\x95\x29\xc2\x83\x19\xb0\xd0\x69\x98\x3f\xfa\x9c\xa5\x9a\x26\xaa\x64\x5d\x01\x4a\xdb\xc5\x6b\xf5\x64\x31\x43\x8e\x24\x21\xea\x10\xc4\x72\x93\x56\x04\xaa\x47\x3d\x59\xe0\x8c\x8e\x83\x65\x4e\xd9\xd1\x3b\x4c\x93\x82\x5c\x04\x09\xd8\x20\xe5\x8b\x34\x8a\xf9\xf5\x77\x52\xd0\x6c\x12\x8c\x95\xdb\xec\x06\x47\xef\x26\xc7\x6b\x7b\x8f\xaa\xe7\x86\xc4\x71\xa7\xab\x96\x34\x5a\x9a\x3f\xd1\x82\xbb\x67\x66\x9b\x63\x8f\x5c\xcc\xa2\xf1\x0c\xac\x04\xd8\xea\x2e\x52\xb1\x87\x91\x45\xbc\xcc\xeb\xef\x5a\x05\x1b\xa8\x99\x5e\xcd\x3b\xfc\x86\x4b\x35\xf2\xeb\xfa\x42\xaa\x2a\x56\x2f\x3c\xde\x44\x70\x2c\x17\x1a\x91\x69\xf2\xb5\x84\x98\x2a\x01\xc6\x7c\xd7\xd0\xe7\xe6\xe7\xcd\x79\xaf\xe7\xa4\xe3\x3d\xdf\x36\xb8\x2f\x2f\xe3\x4c\xce\xe9\xd7\x5c\xdd\x69\x00\xe7\xde\x92\x77\x2b\xdc\x99\x46\x90\x06\xe8\x0c\xec\x6e\x7e\xda\x0a\x9c\xe3\xcf\x7d\xaa\x42\x3c\x07\x68\xaf\x9d\x92\x45\xb7\x7b\xca\xda\xd9\x34\x8e\x68\x8d\xbe\x2d\x33\x78\x56\x26\x0c\xad\xd1\xce\xae\x6b\x01\x2d\x46\xde\x1a\xed\x6e\xaf\x4e\x7b\x3b\x8f\xee\x6c\x9d\xee\x6c\x9d\xfe\x5b\x6c\x9d\x84\xc5\xe3\x2d\xd8\x36\x97\x38\xac\x14\xb6\x94\xfc\x11\xd6\xd1\x44\xde\x31\x1f\x64\xd3\x7c\x54\xa2\xac\x41\x12\x9e\x38\xbd\x8a\x4a\x70\x0c\x43\xb9\x9b\x30\xee\x29\x2b\x25\xd8\xa4\x09\xd8\x7c\xcf\xd7\x87\x77\x6f\x9f\x71\xde\x7e\x93\x0e\xf0\x00\x4f\xc0\x69\x29\xbc\x56\x2c\x52\xf2\xee\xed\x33\x71\x2f\xe0\xef\x80\x78\x7b\x0e\xee\x12\x75\xcb\xb3\x34\xc7\xb7\x5d\x6e\xe3\xcf\x8e\xde\xbc\x79\xf1\xec\xc3\xe1\xd1\x1b\xf2\xe2\xdd\xbb\xa3\x77\x23\xf2\x4c\xa9\x7b\xc7\xbc\x4a\x7e\x9a\x0f\x29\x69\x6f\x11\x56\x1f\xd9\x6a\xf7\xfd\x7d\xd0\xbe\x6d\x9a\x8e\x5d\x3d\xaa\xe7\x0a\x84\x82\x2d\x9e\x88\x57\xe6\x6f\x42\xda\xcd\x8e\x88\x6d\x03\x8c\x86\x09\x6f\xd0\x68\x9e\x07\x53\x4a\xf6\xc9\xe6\xa6\x8e\x6e\x27\xbf\xfb\x3c\xb4\xaf\x93\xd2\x97\xc5\x9e\x10\x6f\xf2\x88\xa8\xe9\xfa\xfb\xfb\xa3\x37\x30\x2b\x99\xea\x92\x27\x20\xae\xe8\x9b\xf3\x70\x4c\xe3\x40\x54\x6d\x8e\x56\xcf\xe6\x07\x7e\x3d\x8d\xc7\x3b\xcf\x9b\x4e\xe9\x87\xc3\xd7\x2f\x8e\x8e\x3f\x8c\x88\xb8\xe4\x66\xc4\xc5\x3a\x39\xcf\xc9\x16\x69\xb3\xff\x82\xf1\x8c\x31\x8c\xb6\x11\xea\x46\x38\x8c\xfc\xf6\x6e\xb3\xba\xdb\xac\xfe\x6b\x36\x2b\x78\x62\xf9\x47\xb5\xca\x6d\xfe\x72\xbd\xd1\x83\xf9\x5b\x7c\xb7\x2e\xbd\x0b\xb1\xf5\xaf\xce\x66\x38\x04\x53\x6e\x9c\x42\xc4\x83\x5a\x68\x4b\x9f\x15\x6c\xa3\xe3\xaf\xfd\xe8\x7d\x21\x4d\x77\x91\x12\x1d\xe7\xf3\x38\x15\xa4\xe2\xed\x71\x9e\x26\xdd\x9a\xf7\xf2\x28\x33\x49\x93\xcb\x79\xba\x54\x2d\xaa\x84\x92\xc3\x9b\x44\xda\x94\x4a\x5c\xd1\x90\xcb\x03\xe4\x82\x1d\x38\xc0\xc9\xb4\x75\x76\x33\x32\xd4\x39\xed\x69\x9a\xc6\x2b\x08\xa6\x1b\x82\xdb\x71\xbe\x5d\xb0\xda\x2f\x17\x34\x44\xf3\x04\x0f\x43\x68\x68\x38\x49\x97\x47\x3f\x88\x41\xc0\xf8\x39\x6e\x62\xb0\x61\xcc\x1a\x76\xbb\x75\xb9\xb0\x1c\x3a\x89\xca\xed\xe0\x0b\x68\x2e\xdc\xdb\x91\xc8\x23\x39\x44\x7d\x51\x13\x5c\x75\x88\xef\x12\x6b\x57\x7f\x79\x6d\xae\x5c\x7a\x41\x8c\x91\xcf\xc9\x35\xe4\xbe\xbf\xc1\x83\x91\x85\xf5\x50\xbb\x05\xf7\xc6\x0a\xf2\x56\x50\x8e\x3a\x54\x5d\x95\x83\x20\x4e\x98\xae\x67\xf2\x8e\x26\xdf\xe6\xa3\x83\x05\xeb\x19\x5a\x21\x1c\x99\x57\x8c\x0b\x17\xad\xa6\x87\xb5\x46\x24\x5d\xa8\x5f\x6b\x38\x79\x34\x4d\x82\x62\x99\xd9\xc3\xc1\xe9\x65\xe3\xc1\x30\xe5\xe3\x51\x50\x55\x03\x02\xe7\x05\xcd\xfb\x2f\x1e\x38\x48\xf2\x16\x0c\x2a\x48\x42\xa5\x68\x2a\x52\x08\x45\x3d\x89\x92\x20\xf6\x1b\x3d\xf3\x3a\x7c\x26\xa5\x78\x71\x5b\x59\xa2\x7a\x03\x29\x32\x8f\x9e\xd3\xec\xb2\x98\x71\xf5\xf5\xfc\x2c\x02\xbe\x91\x4e\x29\x48\x45\xac\x6f\x22\xbe\x42\x25\xb6\x3c\x6e\x41\x44\x77\x1c\x97\x76\x6a\x71\xab\x2f\xf4\x04\xf0\xce\x79\x88\x76\xd5\xa1\x7c\x73\xd4\x79\x15\x91\x5a\xce\x4d\x6b\x23\x72\xfb\x29\x2a\xe7\xcf\x5a\x85\x53\x41\xee\xa0\x53\x52\x7b\xa7\xeb\x2a\x38\xc5\x3c\x7d\x90\x1d\xbb\x29\x4b\x47\xb1\x2b\x2a\xf9\x39\x78\x5c\x16\x21\xd4\x45\xf9\x93\x08\xc2\x4b\x59\x76\x01\x02\x08\xcf\x1f\xa3\x1b\x9d\x9c\x2c\xe3\xb8\xe4\x81\x8b\xd6\x33\x12\xd7\x0e\xc0\x54\x0f\x43\x7d\x65\x81\x19\x21\xd3\x1a\xcd\x79\xc5\xc5\xbf\xc0\xbe\xf3\x34\xa6\xc3\xb7\xaf\x1e\x39\xb7\x2f\xd1\xbb\x76\x64\xbd\xb5\x6a\x83\xbe\xd7\x50\x9c\x49\x24\xe3\x34\x19\x07\x45\xc7\x98\xfd\x6e\xb9\x0f\x9b\x52\xae\x27\x1c\xd8\x94\x73\x3d\x7b\xb7\xa5\x65\x1c\x2e\xe4\x37\x11\x2e\x0f\x13\x5c\x41\x98\x0e\xc1\x81\x81\xd7\x12\xaa\x66\xef\xdd\x03\xf5\x83\xd9\x8b\xea\x6d\xba\xdc\xf1\x0e\xe0\xe0\x16\x3d\xef\x04\xd9\xd4\x5a\x5d\x5a\x9a\x7c\x62\x94\x1c\xe1\x5f\xc2\x2b\xcf\x36\xf2\x82\x22\xc6\x27\x6e\x63\x54\xbd\xf6\x43\x2d\x3e\x99\xe4\x8b\x92\xd2\x70\x7d\x3b\xdd\x3d\xb6\x32\x7f\x49\xa3\xa4\xd3\x6a\xb9\x95\xab\x37\x71\x9c\xdc\x38\x9e\xf0\x65\x07\xc8\x86\x1d\xb6\xcc\xbb\x3d\xdc\x23\x7c\x71\x93\xa4\xc5\xa1\xd1\x57\x85\x42\x8f\xaf\x21\x0d\xdc\xb0\x6d\x78\xb4\xd0\xed\x59\xad\xe0\xf6\xd5\x46\x82\xb8\x76\xba\x2c\x16\xcb\xe2\x55\x3a\xd5\xec\x5a\xf8\xe1\x41\xab\x45\x3a\xfe\xe1\x3e\x66\x90\x58\x66\x82\x69\x6e\x0d\x63\xb2\x5d\x40\x71\x18\x7e\xe7\x65\xf0\xd3\x8c\x86\xcb\x31\x45\x73\x15\x8c\xc7\x3d\x22\xdc\x50\x62\x7e\x12\x8c\xc7\x27\x22\x99\xf3\x44\x86\x14\xf1\x5b\x52\xf9\x13\x73\xca\xfa\xf9\x2c\x9a\x14\x9d\x2e\x19\x39\x18\x95\x59\x8e\x0e\x2b\x18\x8f\xa5\xd2\x8a\xdb\x7a\x73\xd2\xa6\x31\x2d\xa8\x1c\x87\x76\x90\x64\xa6\x73\xaa\xba\x06\xcb\x40\xb7\x59\xe2\x59\x89\x58\xda\x6c\xab\xe7\x62\x5c\xa9\x5b\x85\xdb\x92\x8b\x8c\x86\xab\x85\x1f\x8f\xdb\x06\x5b\xfa\xf9\xa3\x7b\x63\xda\xa9\xf7\xc6\x54\x55\x7c\xbb\xdc\xdc\xce\xac\x80\x18\x12\xa0\xe1\xf9\xc1\x16\x3b\x6c\x97\x4f\x8e\x40\xf9\x47\xf2\xfd\x54\x4a\xca\xd8\xf0\xbf\xc1\x93\x46\xeb\x4d\x9b\xf7\x3d\x63\x25\x31\x7e\x2d\x47\x53\x0c\xd4\x3c\xb8\x96\x31\x40\x69\x69\x08\x2d\x9d\x20\x80\x53\x83\x78\x7d\x00\xd8\x77\x95\xa6\x09\x2f\xe8\x89\xe2\xf6\xbc\xed\xd3\xd2\x01\x18\x26\x14\xde\x0b\x62\x03\x97\xc8\x1b\x56\xd5\xfd\x70\x9d\x8f\xac\x6b\xba\xc5\x7a\xdc\x44\x1d\x7f\x53\x37\x97\x03\xbf\xa6\xf2\x35\xa7\x41\x8f\x36\xb0\x3a\x80\x08\x8e\x1d\xb2\x31\x18\x90\x0f\x47\xcf\x8f\x46\x24\xa3\xdc\x38\xab\x47\xf2\x54\xd8\xd1\xa8\x0b\x2f\x6d\x98\x13\x70\xbd\x57\x9f\x95\x8b\x8a\x76\x4e\x12\x3a\xa6\x79\x1e\x64\x97\x6c\xb1\x40\xf8\xeb\x9c\x91\x5b\x1b\x5c\x15\x83\xa3\x68\x72\x91\x66\x9f\xb8\x90\x37\x5f\xc6\x45\xb4\x88\x51\x04\x07\x33\x66\x8a\xdf\xb7\xd1\xe0\x3e\xf1\x1a\x73\x7f\x23\xad\xc1\x79\x1d\xa6\x29\xbf\x6c\xde\xb0\x26\xd5\x8d\xd1\x90\xef\x1b\xe6\xc1\x44\x95\xea\x4b\x1c\xf9\xbc\xd7\x6c\xb2\xce\x9d\xb8\xb0\xa7\xbe\xe3\x43\x19\xac\xc5\x4d\x89\x63\xea\x68\xf6\x53\x38\x73\xf2\xd5\x54\x63\x11\xe9\xad\xa7\xf4\x04\xa5\xeb\x17\x04\x6f\x8f\xc9\x01\xf0\x9c\xb8\x79\x8e\x0f\x1b\x3c\x47\x31\x3d\x61\xdf\x63\x76\xd1\x63\x04\x2a\x8a\xe5\xb4\xb0\x22\xc4\xf8\x1c\x5c\x55\x9e\xc3\xaa\xa7\x3b\xa2\x15\xe3\xd5\x30\x9e\x21\x97\xd1\x0b\xd1\xc1\x4d\x2e\xd7\x1e\xb6\x2a\x78\x0b\x03\x27\xc8\x86\x94\x7e\xee\x1b\xec\x48\xff\xd8\x93\xf9\x92\x09\xc1\xff\x2a\x55\x71\x1c\xfe\xa1\xd2\x11\x9f\x91\x9f\xa6\x14\x49\x3f\x8b\xb7\xdd\x2e\x33\xe7\x58\xd0\x6e\x89\x4a\xd8\x73\x09\x43\x6e\x8d\x76\xc1\x7d\x11\x76\x17\xce\xf8\xf2\x77\x77\xd7\xa4\x77\xd7\xa4\x7f\xed\x6b\x52\x71\x47\x2a\xde\xfb\xfe\x57\x84\xd5\xbb\x55\x5f\xe1\x70\x06\xb8\x4f\x9e\xa5\xc9\x39\x65\xac\x28\x10\x91\x8e\xe1\x14\x0c\x47\x01\x08\x57\x2c\xe3\xb7\x30\x02\x0e\xe2\x3c\x25\x41\x1c\xa7\x17\x39\x0f\xcc\x0e\x6a\xba\xbc\xbf\xc1\x2a\x92\x72\xff\xeb\xe8\x33\x0d\x57\x3c\x6b\xc3\xbd\xd5\xd8\x10\xd7\xab\x45\x6a\xc7\x36\x16\x0a\x4b\x75\xdc\xec\x98\x0a\x51\x72\x75\x25\x43\xa3\xeb\x8c\xb6\xd2\xa0\xb6\xbb\xb6\x2a\x80\x9f\xe4\x84\x84\xc4\xd5\xb2\xbc\x0f\x1d\xa9\x5d\x34\x1a\xe2\x5a\x88\xa3\x09\x28\x9a\xbb\x50\xfb\xd0\xa9\x13\x20\x05\xdf\xc7\xcf\x59\x8d\x1b\x23\x19\x3b\x49\xb5\x03\x27\x2e\x26\x69\x32\x4e\x2b\xaf\x7e\x6c\xab\xd8\x54\x69\xf7\xc5\x59\xab\xc7\x26\xe1\x9c\x66\xd1\x04\x9c\x7a\x64\x74\x1c\x30\x8e\x83\xe2\xd3\xdc\xbb\x47\xe2\xe0\xd7\x4b\x12\xa7\x41\x48\xc2\xcb\x24\x98\x47\x63\x92\x26\x34\x87\xd6\xc4\x84\xe8\x86\x44\x0c\xeb\x54\x69\x09\x00\x4a\x9a\xda\xcb\xc6\x1d\x28\x36\x5b\x53\x5a\x1c\xa9\x23\xb2\xc7\x77\x33\x9b\x18\x2d\xaf\xd6\xf9\x06\xc0\xaa\x04\x31\x25\xf2\x90\x5c\x7e\xe7\x61\xe8\xf9\x4b\x2f\x5e\x78\x76\x7e\x11\x41\xa4\x12\xd4\x2b\x02\x1a\x88\x9c\xf2\xf3\xf3\xc8\x79\x62\xc5\x65\xf7\x65\x46\x85\x72\xb1\x07\xd7\x77\x63\xbe\x3a\xf8\xd9\x78\x46\x3f\xfb\x94\x06\x5a\x67\x6a\x25\x58\x5e\x28\x1b\x14\x31\xf4\x9e\x22\x9a\x76\xa9\x4a\xde\x52\xf7\xcb\xd8\xdb\x4f\x44\x54\x72\x56\x95\x58\x64\x5d\x32\x92\xeb\x4d\x80\xb9\xa2\x92\xef\x92\xc0\xf3\xce\x0e\xba\x39\xb2\xba\xdd\x73\xe0\xd8\x12\xd0\x50\xec\x97\x0b\x53\xa4\xb8\x1e\x37\x3f\x90\xc1\x98\x25\x50\x80\x43\x31\xdb\xad\xc1\xed\xd5\x68\xad\x4b\xad\xbe\x2a\xd7\xf5\xf5\xee\x3a\x35\x8a\x52\xa6\x76\x0a\x9d\x73\x38\x05\xe6\xb3\x59\x7b\xe4\x43\xb8\x45\xea\x52\x53\x73\x10\x86\xfc\x89\x84\x52\xa1\x05\x49\x48\x72\x5a\xe4\x64\xb9\x80\x0c\x71\x18\x01\x96\x11\x15\x34\x63\x7b\x47\x7a\x2e\x84\x2d\xe1\xc2\xb4\xbf\xb1\x81\x9e\x49\xbc\x4a\xa7\xf9\x41\xf1\xbe\x08\xb2\x62\xc3\xd6\x33\xe6\x34\x9e\xa8\xc4\x89\xfb\x7e\x59\xb0\x70\xb3\x16\x23\x3c\x18\x8d\x27\x8e\x03\x1f\xf9\xdc\x6e\x4a\x0b\xae\xcd\x62\x85\xad\x37\x77\xa0\x5e\xd0\xc3\xcc\xa1\x7b\x44\x1e\x3c\x2d\x9e\xc1\x5a\xe9\xfb\x18\x07\x64\x4c\x69\xd1\xb1\x9e\xff\x08\xb3\x46\xe7\x90\x33\x18\x90\x30\x4d\xda\xe2\xb9\x28\xeb\xa3\x40\x1b\xd8\x50\xc2\x35\xb7\x4c\x94\x36\x48\xe0\x06\xa3\xdf\xef\x93\x5f\x96\xdc\x09\x30\x6b\x93\xf1\x5e\xe7\xb8\x5c\xf2\x44\xb2\xe2\x79\xe4\xca\x7e\x0a\x6b\xad\x74\x35\x0c\xff\x11\x93\x67\x7a\xcf\xa5\xdc\xaa\xb3\xee\xc1\x26\x7f\xc8\x63\xda\x80\x1a\xfd\xab\xf7\xc1\xfa\xf5\x48\x77\x91\xc6\x31\x27\x1f\x3f\xd9\x0a\xda\xd4\x60\x36\x5d\x2a\x8d\x08\x28\x6d\x93\xd7\xca\x26\xd7\x20\x96\xb4\x84\x5c\xc4\x8c\xa6\xce\x9c\x4a\x13\x0b\x46\x7a\x72\xac\xbe\x49\xf0\x3d\xa1\xf2\xd1\x44\xda\xf8\x20\xdf\x94\x3a\xae\x47\x19\xda\x66\x19\x86\xa6\x55\xd7\x4f\xac\x04\x5d\xc9\x48\x16\x72\x49\xe7\x46\xe8\xb9\x19\x91\x96\xaa\x03\xa0\x4f\xb6\x23\x6a\xc6\x78\xde\xa6\x71\xcc\xf8\x8c\xee\x09\xa7\xc1\x11\x2f\xc2\xce\x69\x74\x4e\x93\x02\x8e\x9c\x7d\x46\x71\x30\x34\xbd\x97\x2c\x84\xd5\xfd\x09\xc7\x14\x90\xe3\x61\x78\xda\x93\x17\x54\x46\x72\x4f\x13\xa3\xc8\xc1\x3e\x8c\xb8\x7e\x18\xe8\x97\x6d\xd6\x32\x58\xa1\x43\xe2\x96\x4c\xd6\x23\x4e\x60\x0f\xb9\xdc\x3c\x97\x03\x3d\x71\x9a\x7a\x96\x51\x18\x13\x18\x6f\x3f\xf3\xbc\x79\x04\x66\xc7\x15\xd8\xe8\xba\xd5\xc0\x07\x52\xf0\xad\xa3\x29\x2b\x55\x75\x95\xea\x7a\xfc\x3a\x35\xb3\x33\xc8\x92\x80\x94\x7a\x8b\x2f\x35\xcd\xd4\xc2\xa6\x16\x83\x2d\xd1\x17\x41\x3b\x68\x30\x13\x10\xa4\x9c\x79\xf7\xf9\x98\x5a\x21\xc2\xae\x46\x65\x88\x2d\xf7\x59\x59\xbe\x66\x7b\x4e\x16\xbe\x74\x52\xdf\xa5\xfd\xee\x27\xf4\x42\xdc\x39\x61\x1c\x60\x47\x61\x9c\x49\x46\xa1\xe1\x17\xcf\xcf\x1c\x6b\x96\x7d\x67\x7c\xe6\x11\x73\xc7\x67\xb5\x7c\x90\x08\x8e\x2c\xce\x85\x15\xd4\x6b\x79\x23\x75\xd9\x4b\x45\x59\x7f\x37\xaa\xd5\xce\xc6\xd2\x66\x44\x10\xba\x4e\x00\xb1\xcf\x86\x8c\xc2\x1d\x03\x3b\x73\x2c\x68\x12\x82\x79\x9b\x9a\xe4\x20\x07\x45\x4b\x92\x33\x0a\x55\xbe\x60\x74\x45\xe9\x04\x80\x59\x21\x26\xf5\x74\xb9\x72\x45\xb5\xbe\x4c\x82\x3c\x8f\xa6\x09\x0d\xfb\x6e\x1f\x6d\x8a\xf2\xf1\x64\xdf\xec\x28\x19\x6b\x7c\x56\x33\x41\xde\x66\xb0\xc1\x18\x1a\x89\x36\x2e\x26\x31\x96\x0e\x83\x38\xa3\x41\x78\xa9\x9f\xae\x6b\x41\x31\xbf\x39\xa5\x99\x82\xac\x94\x5e\xeb\xc6\x15\x4d\x3a\x56\x6b\xca\x01\xdc\xd0\x75\xc7\xa5\x57\x26\xe7\xe2\x3e\x9f\x90\x4c\x8a\x2e\x52\x31\xb6\x68\x3e\xa7\x61\x14\x14\x34\xbe\xb4\x9b\x15\xe4\x3e\x6e\x4a\xdb\xa6\x74\x02\xd5\x77\x4a\x7c\x4e\xf8\x5c\x56\x61\x4d\x36\x67\xf9\x6c\xfb\xe1\x83\x41\x57\xb9\x17\x4e\x84\x0e\x7b\x33\x37\x79\x1b\x37\xeb\x43\xfd\x90\xea\x18\x83\x39\xe2\xd1\x58\xf3\x24\x6e\x4b\xdd\x81\x20\x5c\xa3\x2b\xe1\x55\xd3\x81\xe0\x7d\xb7\x7e\x3c\x8e\xe4\x90\x2e\xa4\xe0\x60\x0e\xa4\x86\xbf\xc3\x33\xf3\x79\x7a\x2e\x55\x9a\x24\xc8\x2f\x93\xb1\x3a\xfc\xf8\x04\x23\x1f\xdf\x5e\x26\xf0\x8e\xda\x40\x00\x92\x31\x2c\x6c\x39\xbc\x0b\x9b\xc1\xaf\x53\xb3\x21\xf8\x3b\x18\x9d\x5a\x91\xda\x7d\x7e\x14\x1c\x99\xc2\x6b\x70\xa2\x4a\xda\x42\xb9\xb5\xa3\x96\x58\x51\x0e\x06\xe4\x70\xa2\x39\x63\x94\xab\x47\x7e\x97\x54\x38\x62\x21\x51\x41\xb4\x97\x2e\x5d\xee\x62\x46\xc1\x14\x43\x8c\xbe\x4b\x38\x53\xcd\x49\x54\x98\x6c\xd5\xbb\x51\x3b\xc4\xae\x96\x99\x6f\xf7\xf0\xa1\x5f\xd4\x68\x4f\x28\xde\x8f\x21\x3a\x8a\x87\xbf\x7d\x45\x4f\x3d\x96\x3c\x9e\x51\xdb\x76\x2f\x4e\xa7\x65\xed\x12\x8b\x31\x55\x9c\x2d\xa0\x96\x11\xdb\x13\x4a\x7c\xf1\xf9\x83\x95\x98\x20\xce\x01\xc0\x1e\x58\x73\x3a\x72\xfc\x4d\x09\x41\xfc\xf0\x39\x4f\x18\x09\x1a\xeb\x74\xfb\x7c\x47\x1e\x07\xd2\x5b\x21\x78\x58\xa1\x21\x61\xab\x7b\x96\xa5\x49\xba\xcc\x95\xeb\x42\x61\x17\xc0\x76\x7b\xdb\x27\x11\xaf\x46\x08\xbb\x6d\xaf\x71\x2d\x38\x98\x48\xb5\x8d\x5e\x13\x02\x72\x2d\xe8\x58\x0d\xf5\x73\x78\x83\x79\x5b\xd5\xf0\x63\xe7\x8a\x94\xe3\xd6\x09\xfb\x56\x71\x41\xba\x3a\xed\xed\x0e\x9b\x5c\x81\xb6\x97\x39\xd7\x8b\x8f\x8b\xf6\xc6\xdd\x85\xe8\xdd\x85\xe8\x9f\xf8\x42\x54\xbf\x1b\x45\x2a\xeb\xeb\x3c\x1e\x15\xc0\x6b\xdc\x64\xfa\xe2\xbe\x35\x7e\x6f\x9a\x4c\xa2\xa9\x17\x8e\x67\x49\xc0\xc3\xb3\xc0\x0a\xe8\x12\x9d\x05\x89\x27\x48\x0b\x68\x93\x79\x90\x29\x6e\x21\xcd\x2f\x33\xcf\xa2\xa9\x70\x67\x60\x19\x31\x72\xa0\xa7\xd1\xd4\x52\xea\x63\x63\x46\xae\x71\xbe\xe2\x10\x57\x0a\x76\x65\xfa\xaf\xd2\xe9\xd8\x0e\x17\xf4\x8c\x25\x6d\x18\x52\x11\xef\x9d\xf7\x11\x5a\x91\xaa\xb2\x12\x6c\x4f\x29\x81\xa2\xfc\x6d\x46\xc5\x35\x28\xba\x9d\x30\xea\x3e\xd3\xe9\x56\x03\x67\xb8\x04\x3b\x48\x08\xd7\xf7\xe4\xea\xca\xcd\x13\x67\x53\x7f\x26\x0d\xb2\x38\x62\x45\x51\xd7\x92\xc5\xb2\x78\x4e\x27\xc1\x32\xf6\x5e\x9c\xd4\xf5\x91\xed\xc8\x76\x3b\xea\xca\xd7\x1b\xba\x85\x91\x4c\x3f\x44\x2d\x7a\xfc\x50\x95\xdf\xe3\xe0\x2e\x58\xa3\xf8\x2d\xba\x6f\xbf\xe7\xe2\x02\x0a\xab\xa5\x64\x8e\x8d\x46\x3d\x15\xa2\x6c\x0f\x1e\x24\x6d\xbd\xa4\x9f\x3d\x23\x17\xab\x8a\x0f\x36\x47\x36\x91\xe9\x84\x04\x86\x97\xc0\x20\x09\xe5\x4d\x69\x0e\x37\x25\xdc\x2e\x80\xad\xbb\x97\x2f\xfe\x69\x2d\x37\xa8\x83\xc9\xc5\xde\x85\x26\x75\xf9\x86\x8b\x5d\xc7\xec\x5d\x5e\x91\x4b\x6d\xbf\x5b\xa7\x37\xe4\xf7\x17\xe3\xf2\x18\xae\xff\xd0\x15\x2c\xfc\xbc\xba\xb2\x68\xe8\x60\x0c\x31\x17\x90\x13\x34\x0c\xef\xf1\xbe\x25\xab\x85\x3e\x09\x97\x54\xfe\xab\x47\x53\x0e\xc2\x55\x17\xa9\x88\x14\x1d\x15\x64\x1e\x4d\x67\x5c\x70\x54\xce\x8b\x85\x92\xca\x69\xb9\x48\x6b\xdb\x2d\x52\xb3\xd5\x93\xf6\x34\xc8\xdf\x66\xd1\x98\xb6\x7b\x84\x7d\xb3\xff\x60\xfa\xd8\x47\x92\x26\x63\xea\x7b\x9b\xf8\x89\x5e\x56\xbc\x4e\xfc\x44\x2f\x9b\xbe\x4f\x84\x9a\x1c\x1c\xf2\x1a\xf6\x91\x3d\xc5\x73\x3a\x8e\xe6\x41\xdc\xc1\x00\x96\x56\x17\x23\x30\xc8\x73\x5a\x1c\x26\x93\x94\xdc\xbb\x47\x9c\xc4\x7e\xbe\x5c\x2c\xe2\x4b\xdf\xe4\xd9\x30\x55\x9d\x70\x2b\xf4\x09\xb6\xea\x4e\xf7\x6b\xaf\x29\xe4\xe4\xf3\xb6\x97\x96\xaf\xea\x35\x56\x58\xa7\xd1\x12\xeb\xf6\x8b\xf4\x55\x7a\x41\xb3\x67\x41\xae\xac\xf3\xaf\xb3\xa8\x6e\xba\x9c\x54\xa4\x1a\x1d\x9e\x46\xd7\xe4\xeb\xa4\x6c\x0f\x17\xd2\xca\xc0\x6f\x1c\x77\xcc\x56\xe5\x46\xb1\x7c\x79\xc6\x2d\x70\x3a\xc3\x1e\x6e\x56\x3d\x8f\xd9\xdd\xb1\x8e\xba\xa0\x3c\x11\x37\xf0\xb8\x2a\x0f\xd4\xf2\xcc\x3b\x24\xdd\xa6\xbf\x41\x4f\x47\xbc\x93\x85\x71\xb1\x39\x38\x19\x3e\xf8\x2e\x78\xf0\xeb\xc1\x83\xff\x3b\xfd\xb2\xbb\xb3\xfa\x9f\x41\xd4\x67\x82\x45\x47\xf7\xc2\x7b\x81\xed\x1c\xad\x85\xef\x25\xd4\x7b\xee\xd5\x08\xd5\x53\x65\x48\x2e\xbb\x6e\x0c\x1e\xf0\xd5\xcf\xe8\x22\x0e\xc6\xb4\xd3\x1a\x7e\x6e\xf5\x48\x2b\x48\x83\x56\x97\x6c\x7d\x2d\xcc\xac\xd6\xe1\xd7\x78\x22\xcb\xf7\x89\xf3\xb4\xa0\x98\xc3\xb1\xdf\xfd\x71\x90\x84\x11\x13\xc8\x35\x76\xfd\xf9\xb5\xeb\xc9\x04\x37\xc7\xd5\xfd\x0b\xee\x22\x37\xdd\x46\x7e\xbf\x4d\x44\x1c\x74\x9c\x5d\x04\x6c\x2a\x64\x70\x30\xb1\x85\x78\x8f\x3f\x8d\x37\x8f\xcf\xe6\x91\x47\xa4\x6f\x18\xe7\x9c\xda\xed\xa2\xf8\xdc\xfd\xa2\xb4\xfd\x9f\xfb\x58\x44\xde\x94\x1a\x37\xad\xe5\x36\x01\x94\x0b\x9c\x54\x62\xd2\x04\x50\xd2\x00\x4b\x47\xb8\x80\xf7\xa3\xfe\xea\x1d\x28\x6f\x1b\x36\x94\x54\xe8\x7f\xee\x03\x81\xfb\x0b\x41\x96\x86\x9c\x06\xb9\x1f\x6e\x1a\xe4\x06\x14\x2c\x26\x04\xaa\x8f\x7f\x28\x5f\x97\x90\x06\xae\x5e\x70\xfc\x98\x5e\x6a\xdb\x3e\xaf\x4d\x4a\x32\x0c\xd5\x75\x48\x4a\x44\xc4\xaa\xa4\x2c\x15\xa0\x6d\x1d\xf2\xb2\x2b\xb6\xfc\x34\xe1\xc8\x5a\x3a\x92\x56\x0d\xbd\xf9\xa0\xdc\x39\xf3\x40\x69\xca\x93\x99\x0d\xc8\xaf\x14\xb4\xbc\xc9\x12\x42\x54\x01\x9f\x96\xf3\x65\x1c\x14\xd1\x39\xfd\x29\xc8\x8f\x73\x78\xb9\x5a\x56\x95\x03\x6b\xd5\x35\xad\xad\x61\xaa\xca\xc9\xc1\x9b\xe6\x48\x12\x2e\x4e\xa7\xb6\x75\xaf\xce\x40\xe1\xaa\x1c\xf5\x33\xe8\x90\xbd\xca\x67\xcf\xfb\x77\x06\x5b\xa7\x69\x16\x2d\x35\x5a\x00\x30\xbb\xcd\x49\x1e\x4e\xe6\x95\x54\x0e\x15\x36\xa0\x71\xb3\x26\x6c\xc3\x06\x35\x28\x23\xb6\xc1\x80\x28\x5f\x60\xe0\x14\x53\x68\xb8\x08\xe1\x4d\xb1\xf9\x79\x15\xcd\xa3\xc2\x33\x85\x26\x80\xc0\x95\x4a\x2c\x99\x77\x23\xdf\x28\x93\x47\xbf\xfa\x98\xa0\xce\x34\xa0\x8b\x68\x4e\xf3\x22\x98\x2f\x4a\x8b\x28\x08\xbd\xae\x78\x46\x52\xb6\x72\x8d\xec\xb2\x6a\x95\xfa\x4c\xe2\x8f\x27\x87\xd1\x64\x12\x8d\x97\x71\x71\xe9\x65\xa3\x36\x50\x77\xcf\x2c\x5e\xa4\x45\x10\x3f\x6f\x52\x87\x05\x89\xc5\x0e\x73\xe5\x08\x70\xbd\xd8\xcd\xf5\xe3\x66\xbb\x62\x51\x54\xd0\x79\xd7\x7e\xc1\xe9\xd8\xd1\x02\x94\x6b\xb1\x60\xac\x32\xdf\x96\xce\x0b\xd6\x2d\xb7\x33\xae\x84\x6b\xb0\xd8\xe2\x74\xea\x5d\x55\x78\x85\xfb\xd6\x54\x9c\x4e\xb5\xbe\xd5\x5d\x58\x50\xaf\xb1\xb8\x70\x85\x78\x69\xa1\x7b\xae\x68\xc2\x7e\x19\xdc\x5d\x8d\xd3\xca\xf0\x50\x9a\x5d\x74\x0f\xd7\xe9\xb0\x6f\xa3\xe2\x06\xfb\x80\xb7\x12\xa3\x89\x38\x9d\x7a\xaa\x96\xa9\x25\x55\xaa\x42\xa6\xc5\x07\xdc\xdd\xd5\xeb\x2e\x2e\x66\x51\xce\xb8\xe3\x22\xcd\x8b\x6b\x28\x2f\xde\xa6\x79\xb5\x78\xe0\xc6\xdc\xaa\xe4\xa2\x6e\xa5\x78\xa2\x59\x27\x31\x0b\x65\xbf\xfb\x8b\xe0\x12\x1e\xd2\xec\x1b\x7a\x51\x9c\x25\x90\x0c\x49\x45\x11\x7b\xc5\x7a\x99\x89\x61\x2f\xd2\xec\xd3\x87\xf4\x6d\x96\x9e\xd3\xf2\x32\x08\x08\x97\x5d\x64\x51\x9a\x45\x85\xff\x0c\x61\x40\x20\x96\x36\xc1\x91\xc6\x0c\xd3\x79\xce\x5a\x78\x27\xb9\x57\x1d\xcc\x53\x50\x3a\xd9\x37\x7e\x3d\x21\x27\xe8\xe7\x29\x19\x29\xbb\x95\x95\x6e\x95\x5f\xba\xf0\xfb\x97\x38\x4e\x2f\xe0\x1d\x91\xd4\x30\x55\x55\x5f\xfd\xee\x85\x07\xca\x64\xc4\x44\xd2\x24\xbe\xe4\xb1\x40\x0a\xe3\x39\x8e\x7c\x12\xc3\x9f\xbe\xf8\x5e\x72\xc9\x77\x31\x64\x64\xbf\xd2\xc2\x2f\x62\x6c\x73\x27\xd6\xc7\x46\xbc\x4b\x5d\x00\x02\xfd\x0b\xb3\x64\x2f\x37\xab\xa3\x74\x3f\x59\x9b\x17\x49\xd5\x84\x2d\xe8\x1a\xf0\x4b\x3f\x2f\xa2\xec\xd2\xb3\xe2\x51\x2e\x26\xb7\x9c\x7b\x0b\xf2\x42\xb3\xbc\xb2\x25\x60\x81\x7a\x16\x00\x50\xb6\x4f\xa2\xb0\x20\xf4\xce\x8a\x97\xde\xbb\xe0\x42\x92\x8c\x48\xf1\x82\xa1\xea\x0f\xf2\x71\x14\xd9\xcb\x57\x96\xc1\xbb\xed\xdf\x73\x81\x38\x05\xa7\x83\xef\xe8\x55\xa1\x1b\x00\x6f\xda\x10\xfe\xce\xc7\x1c\x06\x83\x75\x56\x04\xac\x4d\xbc\x1a\x4b\x17\xa3\x5e\x6e\x37\x58\x49\xd6\xbd\x0f\x47\x51\x33\xfa\x57\x4c\xd5\xd6\x16\xf9\x22\xe5\x60\xbb\xae\x88\xdf\xdb\x26\xf4\x02\xae\x70\x3b\x66\xe4\x74\xb8\xdb\x3a\x0b\x92\x7e\x94\xff\x23\x88\xa3\xb0\x03\x81\x4e\x44\xca\xf3\x28\xa3\xe3\xa2\xe3\xbb\xd8\x12\x1e\xe4\x00\x50\xd4\xd8\xe9\x3a\xb7\x66\x58\xd0\xd1\xe1\xa7\x02\x8f\xf6\x51\xba\x8f\xc2\xaa\x53\x4f\x45\x0d\xaa\x10\x3d\x33\x6b\xe2\xe7\xf4\x32\xcd\xa6\x80\x6d\xcb\x08\xf0\x7a\xa1\xbf\xbf\x4c\xc6\x51\xe2\x97\x56\x84\x1b\x77\x74\xd5\xb8\x69\x26\x11\xd7\x8d\x95\x21\x9e\x81\xd3\x2b\xd0\x84\x46\xc9\x14\x0e\x1c\xde\x73\x9e\x0b\x66\xba\x0e\x13\x5e\xbc\x6a\x2a\xc0\x50\x66\xf9\x59\x34\x9d\xd1\xbc\xae\x3c\x86\x42\xb4\x23\x72\x3f\x25\xe9\x45\xf2\xbe\x08\x0a\xea\x73\x23\x89\x72\xcb\x1b\xc0\x55\xec\xd9\x35\x2c\x96\x71\x4c\xc3\xba\x2a\x30\x54\xc9\xd1\x53\x7b\x13\x2b\x89\x1f\x51\x77\x63\x3e\xaa\x85\xe8\xe9\x7a\x2a\x2a\xa8\x29\x69\x5c\xa6\x8e\x3c\x69\x08\xd6\x77\x36\x18\x95\x67\xa1\x92\x36\x2f\x19\xf9\x93\x51\x09\x63\xe7\x1b\x79\xd2\x38\x6c\x99\xbd\xc5\xa8\x34\x07\x97\xf3\x0f\xa8\x3c\xaf\xa4\xac\xad\x9c\xf2\x54\x61\x83\x18\xbd\x37\x54\x00\x23\x6f\x2a\x86\xc7\xa7\x9a\x91\x27\x0d\xc3\x5a\x68\xf4\x24\x62\x68\x9b\x0b\x8d\x4a\xd2\x39\xf7\x32\x8c\xf4\xf8\x4d\x5f\x6b\xb4\xfd\xb8\xcc\xf3\x14\x63\xe1\xad\xd1\xee\xee\xea\xb4\xb7\xbb\x7d\xe7\xb6\xe4\xce\x4a\xef\xbf\xc6\x4a\x4f\x50\xfa\x6d\x04\x23\x5a\x2f\x72\x43\x43\xd3\x3c\x1e\x2b\xc9\xb4\xb9\xe3\x69\x5f\x21\x04\x44\xf3\xa0\x0d\x41\x1c\x0f\xac\xa0\xa6\xf0\x00\xdb\x0e\x8a\xe4\x86\x72\x90\xaf\x08\xdc\xf0\x6f\x15\x21\x1c\x7c\xf1\xdf\x3e\xf2\xcd\x4d\xc4\x14\xc0\x51\x8f\xd7\xf7\xf7\xaf\x2b\x15\xbb\x03\xae\x95\x27\xdd\xac\x5a\x08\x9a\x18\xc0\xb1\x0e\xea\x94\xbf\x31\x8c\x8c\x8a\x2c\x40\xc4\x4f\x0c\x71\x2b\xe1\x27\xd8\xfe\x60\x4f\x86\xe1\x99\x14\x2c\x32\xf4\x9b\x3d\x3c\x4d\xd9\x34\xc7\xb2\xee\x35\x62\x7d\x4b\x19\x5f\xc7\x46\x04\xc7\x1f\xc0\xeb\xf9\x2b\xb3\x6c\x9a\xf3\xc8\x12\x9b\x42\x78\x6b\xd6\x61\x2c\x8c\x55\x76\x1a\x77\xef\x07\x87\x94\x64\x0e\x8e\xf4\x28\x9e\xb3\xba\x83\xf3\x8f\xcd\x76\x56\x51\x21\x26\x76\x34\x1e\x1a\x22\xa2\x2a\x9e\x23\x0e\x3f\xed\x8b\x5a\x16\xe5\x64\x9c\x66\x99\xeb\x42\x14\x4e\x40\x41\x41\x0f\xb2\x69\xee\x0b\xf1\xa8\x03\xcc\xdf\x27\x7f\x83\x13\x54\x4e\xbe\xc0\xf9\x69\xc5\xda\x8b\x0a\xf1\x88\xc7\xf0\x32\xea\x99\x2a\xdc\x4e\xe9\x1c\xe9\x43\x2e\x87\x02\x14\x39\x66\x0b\x81\x46\x3c\x3b\xe3\xf3\x97\x1f\xa0\x10\x32\xfc\xf7\xc0\xe6\x09\x4e\x23\x75\xec\x36\xb6\xd5\x06\xf0\xd6\x33\x0b\x2e\xe5\xcb\x45\x31\x77\x9b\x1d\x27\xf2\x67\xd0\x55\x1e\xe8\xd9\xb9\xd8\x51\xd1\x5b\x37\x06\x02\x9c\x7b\x93\xae\x84\xd7\x97\x12\x46\x19\xab\x80\xc7\xc0\x4e\x61\x47\x12\xe2\xe6\xfe\x7e\x19\x21\x9b\x4f\xd5\xd8\xd9\x57\x44\xdf\xab\x88\x91\xd7\x71\x3c\x48\x54\x79\x5c\x96\x4a\x19\xb0\x0e\xc3\xa4\x62\xc4\x0c\x49\xdf\x72\x30\x0f\x79\x39\x9b\x86\xf6\xb4\x5e\xe2\x7d\x39\x88\x55\xab\xda\x78\xae\x92\xf2\x54\xfb\x95\x64\x67\xc4\x9c\x5d\x9f\x61\xac\xcb\x2f\xcc\x50\xb1\x25\xb1\x68\x57\x9a\x9b\xe3\xe5\xd3\xf1\x04\x86\x2d\x52\x7f\xa0\x07\x23\x70\xec\x3e\x29\x09\xe2\xe0\x8b\x05\x20\x1e\x2a\xa1\xe1\x1a\x11\x69\x2b\x0c\x63\x4a\x62\x16\x49\xd4\x5f\x2f\x18\x8b\xb7\x78\xe5\xbc\x5f\x2b\x24\x8b\xf0\x26\x3f\xec\x91\xc7\x52\x1b\x54\xd1\xc4\x32\x59\x04\xe3\x4f\x47\x5c\x0f\x6d\xd8\x63\x42\x92\xa1\x1b\x32\x93\x74\x17\x0c\x3d\x45\x2a\xab\xe2\x1f\x8a\xf4\xf6\xc9\x0e\x79\x22\x13\xa5\xc3\x7b\x22\xcf\x81\xda\x07\x84\x72\x53\x5f\xe6\xef\x1e\x0b\x39\x3d\x51\xdc\x9c\x51\xa1\x4b\xc1\xde\xba\x55\xe4\xc3\x93\xe1\x29\x19\xf9\x7c\xb2\x3f\x83\xb0\xdf\x01\x8a\xb4\x2e\x91\x65\xc7\x72\x0f\xe2\x18\x2f\xee\x7e\xbf\x2f\xd7\xf7\x33\xbb\xac\xb5\xf9\x38\xfe\x90\x0e\xf9\x76\x07\x31\x9d\x25\x28\xdb\x8d\x02\x55\x43\x8f\xbb\xbe\xb1\x2b\xe6\xce\xff\xe0\xb1\xa9\x3c\x74\x05\xc6\x73\xc0\x20\x09\x4d\xa7\x39\x12\x8c\xc7\x3c\xe7\x27\x23\x56\x07\x0f\x19\xc9\xc0\x05\xda\xbc\xb4\x2b\x66\x15\x82\x36\xd7\x51\x2d\xf4\xaa\x2c\x30\xf6\x3a\x51\xaf\xfd\xfb\xa6\x94\xc1\x2c\xc3\x3e\xb5\xc7\xc0\x41\x46\xcb\x7f\xc2\x45\xb6\x21\x16\x62\xf6\x03\x6e\xbe\x4d\xe9\x0b\x17\xc1\xe2\x8f\x5d\x4c\x2b\xf4\xb9\x6b\x6e\xc9\xa5\x25\x9c\xbe\x96\xdf\xf4\xbd\xbd\xd6\x3a\x5a\xcd\xf8\xd2\x80\x23\x41\x54\xdd\x33\xba\xe6\xbe\xb4\x84\x52\x78\x09\x77\x8c\xf5\x80\x7c\xcb\x3b\x6f\xa3\x9b\x34\xd8\x73\x3d\x1a\xb9\x3c\x00\xf9\x33\x92\x4f\x68\x0c\xaf\x17\x3d\x6e\x62\xb1\x67\x3a\x91\xe6\x9d\xa6\xa1\xe3\x2f\xbf\xc8\x2e\x2d\x93\x5e\x04\x0a\x6f\x43\xcb\xc7\x4b\x0c\x1b\xd9\x31\x78\x13\xe8\x38\x3e\x89\x38\xc5\xef\x13\x8a\x0b\xa1\x52\x66\xe7\x65\xeb\x48\x92\xa9\xdc\x28\x9a\x9c\x2b\xed\x6d\xc3\x2c\x52\xbb\x2b\x58\x2d\xfc\xa9\x96\x5a\xed\x9a\x91\x24\x25\x00\x85\x5d\xe2\x0f\x64\x08\x87\x1a\xe3\xac\xe9\x4a\x87\x38\x5e\x6c\x90\xf0\x87\xf9\x49\x28\x9c\x77\x42\xc0\xdf\xe4\x81\x3c\xa8\x3a\x91\x8f\x6b\x96\xab\x11\xde\x8f\xad\x1b\x6b\x1e\x3a\xe6\x2d\x9e\xa8\xae\x16\xbc\x79\x60\x05\x9a\x17\xd1\x3c\x28\xe8\x4f\x01\x28\x10\xeb\xa8\x0a\x81\xd7\x51\x14\xae\xf9\x36\xa8\xe9\xeb\x53\x47\xb3\x19\x42\xe3\xaa\x9b\x1d\x0f\x68\xd9\xcc\xbc\x93\xcd\x50\x19\x29\x0e\x22\xde\x48\x5d\xa0\x90\x0f\xf0\x54\x4c\x69\xf1\xdc\x8e\xe4\x24\x77\x56\xbb\x9a\xba\xb9\x12\x75\xdd\xf2\x3c\x35\x42\xbc\xbc\xd1\x15\x2b\x93\x07\xc1\x69\x2e\x35\xdf\x20\xde\x24\x2e\x2a\xf1\x8c\xc8\xbe\x12\x61\xbf\x6d\xf0\x49\x55\xff\xb5\xe2\x4f\xaa\x42\xeb\x0e\xf2\x6b\x06\xa3\xd4\x3a\x1a\x36\xc0\x6c\x31\x96\x7e\xce\x72\x7e\x6a\xae\x63\x44\x02\xba\xdc\x2a\xa5\x62\x5c\xa2\xec\x1f\x9b\x2b\x11\x23\x28\x8f\x04\xc3\x62\x8a\x11\x5c\x06\xcf\x89\xeb\x18\xd0\xd2\xb8\x3e\x01\x6f\xc3\x1f\x59\x8f\xdb\x64\xc4\x7f\x58\x3b\x49\xbb\xe7\x08\x2f\x23\xed\x90\x4f\xe5\x29\x57\x82\x62\x38\xa7\x3a\x8b\x77\x5c\x3a\xaa\xe5\x0c\xb2\x96\x18\x64\x18\x9d\xb2\xed\x47\xc5\xa7\xaa\xde\x7a\x3c\xa1\xa4\xf0\x04\x17\x86\xa0\xb3\x69\x62\x47\x5b\xe3\xc0\x36\x5f\x60\x19\x4a\x3a\x63\xd1\x69\x65\x5b\x85\x85\xce\x7e\xb0\x58\xc4\x97\xc2\x35\x54\x23\xc2\xea\xda\x66\x6c\x7c\x0b\xb0\x9a\x61\x89\xd7\xaa\xbb\x66\x1e\x44\xbc\x25\xcd\x78\x74\xc8\xa5\x1b\xc7\x5a\xf2\x4c\xd8\xd7\x0a\xb7\x24\xd3\xf5\x8a\xc7\xbe\xb1\x4a\xc1\xc5\x61\x53\x63\xb8\x0c\xd0\x95\x9a\xbd\x93\x5f\x56\xdc\x14\x91\xf8\x48\x74\x52\x69\x31\xbd\x5b\x4b\x9f\x4e\xec\xe7\x9f\x32\xd6\x94\x2c\x0b\x04\x1e\x65\xe3\x65\x1c\x64\x9b\x9b\x9b\x9b\xd5\x11\xa6\x24\x05\xed\xdd\x4a\x8c\x29\xae\xfd\x6d\x8d\x76\x1e\xf9\x3d\xf6\xec\xdc\xdd\xfe\xdf\xdd\xfe\xff\xb5\x6f\xff\xc5\xd5\x3f\x83\x95\x31\xc0\xfc\xa1\x4b\x7e\xb7\xa0\x24\x3e\xcb\x82\x6a\x43\x80\x8d\xc1\x00\x62\x9c\x05\x19\x23\x65\xb6\x83\x2d\x73\x73\x88\x8c\xe0\xc2\x68\x32\xa1\x19\x4d\x0a\x42\x93\xf3\x1c\x0a\x9d\x65\xe9\x45\x4e\xb3\x0d\xe4\xc1\xf5\x22\x4a\xc2\xf4\x02\x34\x16\x28\xb4\x07\xb9\x77\x4f\xe4\xf4\xff\xf9\xfa\xd5\xcb\xa2\x58\x08\xe7\xc0\x9c\x6b\x9a\x69\x64\xdf\x0f\x0b\xac\x4f\x44\xa6\x88\xa6\x49\xca\x18\x41\x1c\x25\x94\xf5\x24\x49\x43\xba\x81\xdc\xc1\x39\x35\xaa\x81\x7f\x9e\xc7\x6c\x64\x62\x63\x6b\x77\x9b\x36\xb2\xe2\x98\xfc\xe7\xcb\x77\x3b\x46\x75\xb3\x6c\xa7\xdd\x2d\x2d\x25\x25\x07\xd6\xc2\x5b\x89\x4c\xd7\x24\x02\xe4\x27\x26\xda\x83\x3f\x54\xee\x3d\x9d\xf5\x52\x19\x40\x18\xe5\xf1\x96\x3f\x4b\xf3\xa2\x47\x8a\x68\x4e\xd3\x65\xd1\x63\x15\x66\x3d\x50\x32\x5f\xa4\x99\x70\x50\x03\x9b\x09\x83\x23\xfb\x04\xfe\xbb\xba\x22\x6d\x41\xec\x71\x3a\x0e\x62\x96\x38\x7a\xfc\xcd\xc3\x6f\x20\x8e\x30\xdf\x7b\x78\x85\x6c\x27\x14\x5f\x57\x57\x64\xa8\xb2\x59\x33\x64\x1f\x5a\x53\x69\xb2\x51\xb2\xaf\xda\xaf\x15\x9e\x16\x19\x5d\x40\x64\x3e\x7a\x61\x4d\x99\x25\x3b\x09\xc0\x77\xe8\x2c\x23\x24\xa7\xa7\x69\x1a\xd3\x20\x59\xc1\x1d\x2b\xdb\x9f\xa5\x04\xa3\xb1\x2c\xfc\x70\xa2\x03\x9f\xd9\x96\xe1\xec\x09\x63\x1a\xc9\x5d\x66\x07\xcc\x8b\x40\x56\x3d\x47\x35\xbf\x41\xe1\x84\xc4\x78\x18\xdc\x00\xea\x6c\x42\xb4\x78\x05\x43\x7e\xf9\x6e\x47\x87\xf1\xe5\x92\x16\xc2\x3c\x9a\x08\x06\x63\x78\x3b\xb4\x2a\x32\xc6\xc3\xab\x04\x79\x58\xd6\x9a\x2e\x68\xd2\x69\xbf\x3d\x7a\xff\x41\x46\x1e\xe5\x84\xc3\x3b\xb7\xb7\x81\x5c\x27\xc2\xdc\xde\xbb\x67\x4e\xaa\x71\xe8\x5b\x16\x33\xb2\x4f\xda\x4f\x83\x3c\x1a\x83\x23\x07\xd6\x85\xa7\x4b\xc6\x1e\x50\x15\x5b\xa4\x3d\x52\x57\x85\xaa\x9e\x7e\x91\x8a\x47\x6b\xed\xb3\x20\xa7\x8f\x1e\xb6\xad\xf1\x6b\xc7\xe1\x2f\x69\x10\xd2\xac\xd3\x3e\x00\xbe\x1a\xfd\x1a\xf0\xd3\x16\xb4\xcf\x47\x58\x51\x88\xc9\xc7\x34\x29\x1e\xb0\x83\x76\xbb\x47\xda\x4c\xf2\x8f\xc6\x50\xc5\xe0\x97\x5c\xaa\x1d\xd5\x8d\x95\x98\xb2\x1a\x72\xe5\x21\x66\x2e\x93\x31\x3a\x54\xdb\x9a\x64\xdf\xc5\xf3\x02\x5d\x5f\xfb\x43\x89\x57\x91\x5e\x6e\xc7\x96\x94\xba\x34\x9b\xe4\x24\xcd\x98\xb4\x2a\x62\x53\x03\x3d\x6a\xed\xbe\xc6\x5c\x12\x76\xe0\x41\x04\x77\x93\x11\x4d\x2e\x55\xfd\x02\xc9\x52\x91\x8f\xfd\x82\xfb\xac\x01\x9e\xa5\x49\x42\xc5\xb3\x05\x49\x61\x9a\x12\x8d\xcb\x45\xd9\xba\x8c\xd0\xf1\x81\x7e\x2e\x9c\x0e\x0a\x58\xf4\x5a\x43\x58\xc7\x9b\xdd\xaa\xea\xd2\x3b\x51\x7f\xc7\xd7\x20\x5e\x25\xcd\x43\x45\x03\x0d\x04\x35\x44\x70\xa0\x38\x4e\x05\x25\x88\xac\x1f\x9d\xf0\x2c\xa4\xc8\xa2\xe9\x94\x66\x3c\xa6\x14\x9b\x7d\x10\x5b\x94\x83\x58\x86\x83\x3a\x82\x81\x1e\xf8\xa8\xc6\x0c\x0c\xdd\x84\x7e\xc0\x78\x65\xcf\xe0\x26\x09\x38\xf3\xce\x8b\xa0\xa0\xe3\x59\x90\x4c\xfd\x0a\x04\x6e\xde\x2f\x11\x1f\x84\x97\x60\x58\x0f\x37\xc2\x0f\x19\x87\xb1\x59\xde\xa6\x19\xd8\xb9\x01\xc5\x68\x40\x79\xab\x84\x62\x86\xd9\x97\x59\x35\x14\x05\x67\x32\xef\xad\x95\xba\xb1\x5a\x93\xb6\x08\xbe\xda\xb2\x2f\xb6\x8c\x96\xd9\x59\x70\x65\xa1\x58\x6f\x04\x2e\x66\xcd\xca\xf2\xbe\x5e\x7a\x1f\x78\xa9\x0e\xde\x3c\xc4\x42\xbe\x5d\x0e\x60\x77\xa1\x8a\x09\x88\x95\x86\xd7\x95\xbe\x2c\x8f\x2f\x19\xbd\xf3\xb7\xa5\xb0\xb8\x18\x55\x97\xac\xad\x28\x17\xf5\x53\x93\x99\x2a\x21\x40\x2a\x38\x6d\x61\x80\x9d\x1f\x92\x76\x41\x26\x41\x14\xd3\xb0\x4f\x8e\xd8\x39\xed\x22\x62\x67\x8f\x00\xc2\xc0\x95\xaf\x26\xd4\xa6\x67\x2e\x34\x3e\x95\x3e\x43\x85\x1b\x89\xc2\x11\xf9\x4e\xfd\x49\x7d\x1f\xdb\x7d\xb2\xc5\x78\x44\xda\x3b\xfd\xa1\x52\x1e\x4a\xfd\x63\x3b\xa1\xc5\xc7\x38\xca\x0b\x9a\x44\xc9\x54\x65\x2b\xed\xe1\xa9\x61\xd0\x25\x15\x5c\x19\x8f\x69\xe7\x92\xaf\xb4\x2a\x64\x83\xd4\x93\xe0\xa8\x0b\xf0\xd0\xa5\xaa\xc0\x38\xed\x33\x31\xb7\x35\x7a\xcc\xbe\x0c\xf9\xb9\x35\xda\xfe\x96\x9d\xfc\x77\xef\x4e\xfe\x77\x27\xff\xbf\xf6\xc9\x5f\xdb\xfd\xc3\x9b\xc5\x5b\xb2\xf9\x57\x76\x9c\xf8\x50\x79\x16\x4d\xb9\x09\x6e\xff\x17\x7e\x40\xe7\xd7\x20\xe1\x2b\x3a\x31\xf7\x03\x15\xdb\xf3\x12\xbd\x9b\x33\xf6\x4d\x0e\xc1\xb9\xc5\xc5\x8c\xf5\xbe\x63\xda\x67\x7d\xcf\x0b\x93\xfb\x64\xc7\x7d\x78\x07\x06\x7f\x4c\x88\x37\x9f\x1f\x12\xff\x83\x38\x81\xa4\xb7\xe2\x50\x17\x24\xe4\xf0\xe9\xc1\x1b\x31\xc7\x21\xf9\xee\x5b\x32\x4e\xe7\x8b\xa5\x88\xab\x73\x76\x49\xe6\xe9\x79\x94\x4c\x51\xf4\xb8\x87\x64\x3c\x0b\x32\xd8\x0a\xf8\xc5\x6c\xc8\x2d\xa9\xa4\xb5\xba\x84\x8e\x29\x7f\xb3\x50\xa4\xac\x41\x8e\xab\x9c\x74\x0e\xc8\x3e\xd9\x1e\xf6\xc8\x53\xf6\xff\x76\x8f\xf4\xfb\xfd\x1e\xf9\x3f\xb2\x4f\x76\xbf\xe9\xb2\xb3\x0e\xc9\x17\x74\x1c\x4d\x22\xbe\x8e\x0e\xdf\x1f\x6d\xef\x3e\xda\x7e\x64\x5b\x98\x45\x79\x0a\xe9\x62\x1c\xae\x17\xe1\x15\x7f\x12\xcb\x3a\xc2\x06\x68\xde\xac\xe1\x8b\x65\x21\x48\x85\x12\x4c\x78\x36\x30\xeb\x37\x26\x94\x55\x8c\xe7\x91\x8d\xa8\x7d\xd0\xee\x33\xb4\x3c\x4b\x43\x7a\x50\x74\x86\x48\x69\xcd\xc6\xd6\xfe\x3f\x27\x9b\xf3\x3f\xfe\x6c\x17\x88\xb5\x48\x8f\x17\x0b\xd3\xa1\x1d\xce\xe6\x4e\xf2\x3a\x0f\xbb\xf2\x41\xae\x48\x18\xf6\x1e\x5a\x17\x66\x3c\x77\x11\x47\x45\xa7\xdd\xee\x9a\x6f\x95\x93\xae\x69\x5c\x35\x4e\x43\x36\xb8\xc4\xd7\x79\x29\x1e\x02\xcc\x0f\xfb\xe4\x80\xc9\x83\xf0\xe3\xfb\x7d\xf2\x7f\x5d\x27\xe6\x83\x67\x66\xc5\xc4\x1a\x90\xca\x89\x70\x48\xc9\x03\x72\x40\xb6\xc8\xf6\x10\x99\x19\xf9\xe2\x20\xc8\x58\xb3\xb6\x09\xd3\xaa\xdb\xff\x25\x8d\x12\x36\x4c\xdb\x50\x71\xbc\x04\x0f\xcb\x30\xc5\xaf\x8f\x9e\x33\xc2\xde\x1e\x4a\x9e\x24\x0c\xfc\x80\xf2\x3d\x14\xf7\xed\xf0\xd1\x43\x9b\xe0\xe6\x69\xf8\xdd\xb7\xdb\xc3\x32\x42\x33\xe9\x4b\xfb\xad\xe6\xd4\x24\x0a\x57\x52\x51\x46\xe7\x41\x94\x70\xd5\x11\xcb\xd3\x57\x8f\xc2\xb7\x8b\xc9\x1e\x04\xb0\x36\x5b\xde\xe9\x5a\x5e\x6b\x80\x59\x49\x30\x65\xf0\xfa\x9d\x61\x21\xa7\x9b\x04\x51\xfb\x30\x29\xb8\x43\x9c\x1e\xd9\x1e\x76\xc9\xff\x9f\x61\x6d\xcb\xa9\x85\xfb\xc4\x11\xd6\xe7\xbe\x07\xb8\xaa\x2e\x55\x52\xd7\x67\xcc\x53\xfd\x33\x24\x6e\x81\x0e\xeb\x40\xd8\xfb\xc3\x7d\x3a\x24\x88\xa7\x0e\x82\x7d\xca\xf9\xf2\x4f\xce\x00\x7b\x37\xf7\x4f\x82\x30\x84\xd6\x4b\xce\xed\xaa\x13\x55\xb8\xae\x9f\x74\x0e\x81\x28\xe4\xdb\x1c\x8b\xa6\x18\xc8\x81\xca\x71\xba\x1e\xa0\x2c\x29\x44\xb3\x11\xac\x14\x57\xc3\x3a\x31\x96\xd3\x47\x35\xd6\x79\x0c\x08\xf2\xa7\xd2\x63\x00\x7a\xa7\x20\x82\xbf\x92\xed\x47\x88\x83\x9d\x05\x39\xdd\x7d\x44\xf6\xa1\x8c\x56\x0e\xed\x3e\x32\x0c\x00\xc2\x90\x72\xbd\x22\x6c\x81\x1d\x5e\xa8\x47\xb6\xbf\x31\xe5\x60\xd5\xcf\xa7\x67\x41\xd2\xe1\xc5\x4c\xde\x67\xad\x65\xe1\x94\x04\xad\xdb\xa7\x6c\xe8\x45\x6a\x6c\x5e\x6c\xf6\x08\x78\xb8\xcd\x2e\xe5\x82\xe6\xaa\x24\xb0\xd6\x7d\xcb\x43\x7f\x24\x69\x21\x44\xb2\xef\xa3\x1f\x5a\x53\x90\x47\xb8\xb3\x9b\x89\x46\x6a\x3e\x0b\xb8\xac\x06\xdb\xdb\xe7\x71\xbc\xcc\xa3\x73\x15\xa9\x34\x3a\x8b\xe2\xa8\x50\xe2\xcd\x59\x90\x7c\x1a\x9c\x65\x41\x32\x9e\x91\x9c\x66\xe7\xd1\x58\xee\x7f\x01\x77\xb8\xdb\xfa\x7e\x10\xfd\xd0\xb7\x49\x48\x45\x0d\xc9\xe5\x26\x34\xa1\x19\xdb\x85\x82\x78\x9a\x66\x51\x31\x9b\x93\x90\xe6\xe3\x2c\x3a\xe3\x5c\x49\x48\x3f\x34\xe9\x5f\x44\x9f\xa2\x05\x0d\xa3\x00\x44\x20\xf6\x6b\x70\x98\x14\x34\x4b\x02\xfe\x70\xe2\xe3\xd3\x20\xf9\xf4\x51\x78\xfb\xfd\xc8\xe7\xf5\xff\xf7\x93\x18\x69\x32\xfd\xc8\x86\xf8\x11\x5e\x12\x7d\x0c\xa3\x69\xe4\x3c\xe4\x90\x53\xe3\xa3\xc8\x33\xb9\xa5\xca\x19\x90\x1e\x63\x8a\xd4\xb3\xcb\x36\xa0\xd5\xa7\xf6\x82\x3c\xb3\xb8\xa2\x98\xd1\x67\x7c\x9b\x6a\xff\xf3\x45\x7b\x6f\xc3\xcb\x32\x05\x8b\xed\x58\x1b\x77\x07\x57\xb0\x45\xda\x43\x90\x94\xa0\x15\x6c\xec\xc2\xd0\xf1\x9c\x61\x83\xec\x93\x0e\x97\xa6\x3a\xdf\x3d\x26\x0f\x74\x13\x5d\xf9\x68\xe0\xc1\x8e\xb5\xdd\x2a\x9f\x1b\x66\x53\xa8\x4e\xd1\x60\x8d\xd2\x4a\xf0\x10\x84\x2b\x20\x6c\x1e\x2f\x3e\x4a\xf2\x22\x2a\x96\x85\x74\x5a\x1d\x85\x34\x29\xd8\x9e\x65\xc7\x59\xe0\xb5\x1c\x26\x61\x94\x51\xd3\x7c\xc1\x7c\x61\x93\xf7\xa4\x28\xab\x9e\xd8\xc0\x9b\xa9\x16\x6a\xa9\x05\x4d\xb5\x74\x5b\xad\x75\x78\x91\xd9\x13\xaf\x23\x6b\xf3\x00\x6c\x72\x86\xf6\xc1\xd1\x41\x1b\xf9\x09\xc6\x18\x40\xa9\xaa\x6f\x5d\x8b\x5d\xa7\x55\xec\x5a\x3e\xa4\xe3\xc8\x15\xc1\xd8\xa3\x9c\xbf\x93\xc3\x6c\xdc\x11\x3b\xc1\x7f\x4a\xa9\xb8\xa9\xb6\x22\x8f\xda\x43\xaa\x3b\xf8\x63\x3a\xde\x92\x92\x39\x0f\x91\x77\x96\x4a\x31\x21\xc2\x5e\x5e\x22\x4e\x56\x58\xf6\xd3\x2e\x5e\x6a\x35\xe5\x0a\x0b\xd0\xf5\xd2\xb7\x83\x78\xcc\x3a\x08\x87\x77\x54\x3d\x92\x7a\x74\x36\x30\x36\xac\xab\x71\x47\x69\x51\xc2\xe0\x3f\xff\x7c\x71\x32\x7c\xf0\xdd\xe9\x97\x9d\x55\xe7\xc5\x87\x97\xec\x1b\x3c\x5c\x6f\xef\xae\xae\xd4\x8f\xdd\x61\x6f\x77\x7b\xd5\xfd\x9f\x01\xf7\x78\xad\xf7\x6f\xe3\x55\x5e\x19\x63\x40\xe0\xfc\x71\xde\xf6\x9a\x08\x13\x0f\x30\xe1\xec\xef\x45\xdb\x73\xbd\x04\x6f\x07\x6f\xcf\xdd\x95\x64\x21\x4e\x0f\x0a\x3f\xed\xd9\x7d\x48\xae\xae\xca\xf2\xbe\xb9\xe6\xb0\x27\x24\x4a\x4a\x06\x6e\x70\x9f\xdb\x19\xba\x97\x8d\x34\x1a\xfc\xce\xb0\x91\xcd\x26\x97\x28\xd9\x48\xf3\xe5\x9c\x01\x1e\xe7\xe2\xf4\x30\x4f\xc3\x07\xdf\x7d\xfb\x60\x7b\xa8\xb2\xe1\x88\x0b\xbd\x1b\xa7\x31\xe9\x1c\xbe\x3f\x1a\x1c\xbe\x78\x46\xd8\xb1\x61\xb4\x33\x1c\xee\x76\x6d\x9e\x8c\xaa\x75\x0f\xa1\x28\xd7\x19\xb8\xcc\x6b\x38\x6c\x71\x24\xdc\xe9\x91\x9d\x66\x96\xaa\x98\xa9\x1a\x5b\x0a\xa1\xd3\x3e\xf9\xe7\xbb\x17\x3f\x39\x6e\x04\x55\x01\xff\x68\x4a\x6b\x74\x27\x15\x41\xd6\x0d\x4f\x13\x40\x07\x7c\xcc\x39\x43\xfe\xb6\x47\x1e\x76\xc9\x88\xb4\xdb\x8d\xc6\x3d\x8e\x23\x78\x46\xa6\x3a\x08\xaa\xa7\x28\xb1\xc7\xc7\xb0\xf0\xd3\xc1\x3f\x8e\x7e\xfc\xd7\xd1\xbb\xff\xb5\x67\x15\xea\x28\x99\x53\xbb\x7e\xef\xe4\x72\xa0\x1b\x8f\x7d\x7b\x7b\xfd\x91\x8b\xd5\xe4\x3f\x97\xb8\x07\x0f\x77\x68\x4e\x05\xce\xf0\x02\xcf\x39\x04\xdf\x3a\x89\xc1\xf9\xdc\x8f\x19\x87\x0e\x77\xc0\x0f\xd1\x19\xb6\xf4\x28\x23\xcf\x1f\xea\x94\x62\x1c\x50\xf9\x19\xc5\x3c\xcf\x6c\x3f\xea\xf6\xc8\xce\xd0\x7b\xa4\x95\xd8\xb5\xc6\x28\xcb\x36\x5b\x9f\x25\x2e\xa9\x0e\x21\x8b\x6b\xf4\xb1\x56\xb1\x35\x32\x7f\xae\x4e\x7b\xbb\x0f\xef\x74\xf8\x77\x3a\xfc\xbf\xb6\x0e\x5f\x2a\xf0\x17\xe3\x6a\xe3\xbd\x1b\x98\xdb\xb5\x74\x40\xca\xd6\xde\x5a\x81\xf4\x6a\x8c\xf4\xb8\x96\x69\x31\xf6\x9a\x81\x2d\x82\x62\xd6\x23\x09\x35\x4c\xbf\x3f\x82\xe2\xc2\x79\x75\x2a\xef\xa9\x71\x28\x6f\xe9\xb2\x40\x18\xeb\x80\x81\x0f\xfb\x6f\x0f\x3d\xd4\x1a\xab\xab\x5d\x60\x88\x85\x4c\xe8\x7c\x61\x90\x23\x5d\x56\xf9\xa0\xb4\x8a\xf5\xd3\xa4\xd3\x86\x11\xb5\x71\xd8\xdb\xae\x61\x38\x9d\xa7\x8c\x81\xf1\x47\x84\x87\x6f\x9f\x11\x7d\xa1\xcc\x9f\x16\xb6\x7b\x84\x22\xae\xfb\x91\xb3\x40\x71\xd3\xdd\xb1\xbd\x60\x7a\x7b\x90\x84\xb8\x7d\xd4\x7c\x45\x65\x1b\x42\x11\xfd\xea\xf0\xfd\x87\x17\x6f\x60\xf1\x3c\x3b\x7a\xf3\xe6\xc5\xb3\x0f\x87\x47\x6f\xc8\xbb\x17\xef\xdf\x1e\xbd\x79\xff\xe2\x7d\x69\xa3\x61\x50\x04\xb8\x55\xf6\x1b\x6f\x4b\x83\xfb\xc2\x7c\x70\x1e\x7c\x1e\xa7\xf3\x45\x4c\x3f\x47\xc5\xe5\x88\x3c\x02\xa2\xb2\x3a\x08\x4a\x50\x65\x7f\xc0\xaa\xd2\x3b\x4d\xd7\x13\x5c\x45\xd8\x1a\x7c\x31\x23\x96\xc3\x91\x2f\xb4\x6d\x26\x44\x77\x78\x24\x77\x60\x2d\x21\xb9\x98\x45\xe3\x19\x99\x07\xc5\x78\x26\x04\x57\xbe\xff\x30\x5e\x16\x1a\xe5\x3c\xd1\x03\xa0\x69\x7f\x48\x73\xb8\x87\x72\x7a\x0b\x96\x07\xfe\x80\xc3\xd1\xa4\xf3\xd1\x4f\xc3\x27\xf0\x26\x2e\x0a\x4f\x5d\x37\xe8\xaa\x30\x1b\xab\x00\xdb\x73\xa0\xec\xe0\xf0\xa5\x41\x93\xa1\x1a\xd1\x77\xbb\xa2\x95\x83\xc5\x49\x94\x51\xc3\x13\x80\x8d\xae\xb2\xf1\xb0\xa1\x78\x5a\xaf\x00\xd7\x11\x9c\xb1\x49\x8b\xfe\x0b\x69\x4c\x0b\x5a\x55\x83\x3d\x18\x1b\x37\xf8\xf5\xf5\xcf\x6c\xc3\x02\x42\x14\x04\xc1\xeb\x03\xb5\x0e\xb7\x91\x4a\xb8\x93\x1c\x92\x72\x17\xcb\x51\xd1\xdf\xd8\x90\x62\xa0\x49\xc2\x1b\xb6\xc2\x03\x5e\x62\x32\xb1\x4f\xb3\x3b\x24\x19\x99\x85\xb1\x47\x55\xbc\xaa\x6c\x0e\xd8\xb3\x24\xb5\x7f\x70\x8f\xe6\xdc\x31\xaf\x5c\xde\xcf\x5f\x3c\x78\xf6\xf2\xf8\xcd\xff\xbe\x78\xa7\xea\x08\xe9\x78\xb6\x4c\x3e\xd1\x50\xbc\x24\xe1\xaf\x44\xc5\x9f\x8a\x40\x35\xf8\xf7\xea\xe4\xdf\xc9\xbf\xb3\xd3\x27\xff\xfe\x32\x98\xf6\xda\xab\xab\x07\x0f\xae\xbe\xb4\xbb\xe0\x4e\xf8\x8b\x17\xfe\xdf\xa7\xb2\xc4\x89\x28\x73\xca\x0a\x9d\xc8\x52\xa7\x27\xfe\x72\x76\x29\xa3\x50\x49\x19\xdd\x16\x6a\x49\x35\x84\xca\x88\xbb\x3d\x96\xdd\x96\x4c\xd4\xc0\x80\xbb\x5e\x01\xe9\x88\xb7\x0c\x06\x70\xf1\x49\x85\x0b\x0c\xf0\xae\x01\x15\x6c\x38\x64\xcf\xf2\x9e\xb1\x2c\x73\xd5\x0a\x5f\x33\x16\x0c\xd9\x22\xfc\xcd\xab\x21\xa0\xab\x8b\x6a\x8b\x8b\xb9\x8e\x0c\x7c\xf6\x5f\xd0\x77\x54\x4a\x58\xd0\x70\x03\x9a\x0d\x77\xe1\xe9\xce\xec\xdb\x9d\x11\x43\xe7\x81\xdc\xa4\xf1\x16\xb8\xbb\x4b\xc6\x34\x06\x27\xfa\xf2\xe1\xa6\x51\x66\x1c\xd3\x20\x93\x66\x5b\x56\x2b\x22\xd9\x5a\xcc\x7e\x20\xf0\xcf\x50\xc8\x8a\x7c\xdb\x9b\x59\xde\xde\xe6\xf0\x5f\xa5\x2d\xa5\xc0\x19\x86\x5f\xf5\xc8\xf6\x70\x38\x24\xf7\xf9\x95\x8c\xe7\x82\xd5\xeb\xec\x01\xde\xea\x01\x76\x24\xbe\x18\xf7\xc8\xa9\xa0\x17\x1e\x0e\x45\xbc\xe5\x5b\x1f\x55\xee\x8c\x59\x24\x02\x71\x7e\x84\x35\xa5\xd3\x61\xce\x1e\x78\xd4\x7f\xd3\x56\xcf\xd2\xd1\x63\x70\xe1\xf0\x87\xf1\xc7\x9f\xc4\xf6\x19\x84\x61\x8e\xc3\xc1\x0b\xd3\x06\x57\x08\xe3\x4a\xe1\xde\x06\xdf\x6c\xe5\x79\x40\x9c\xb0\x23\xee\xa2\x5e\x70\x3c\xd8\x89\xe5\x5d\x90\x4a\x3d\x0c\x79\xa9\x20\xcb\xa2\x73\x8a\x99\x6d\x10\xaa\xd9\x93\xed\x55\x70\x57\x0f\xb4\xe1\xa1\xde\x6f\x47\x8a\xe4\x09\xf9\x42\x3d\x0a\xc9\xd5\x95\xfc\x75\x32\x3c\x55\xdb\x25\xdc\x5b\xf3\xbe\x69\x68\x91\x60\x96\xe0\x89\x58\x98\xf3\x6e\x5c\x64\x5f\xf5\xa6\x4a\xd0\x65\xa0\x7d\xd5\xb0\xac\x5b\xee\x68\x72\x1d\xe1\x95\x4a\x2e\x66\x54\xfa\x1a\x08\xb9\x34\x0e\x87\x2e\xd0\xb3\xb3\xbd\x3d\x44\x68\x16\x44\x5c\x81\x5a\xd7\xa6\x53\x9d\xe8\x27\x69\xd6\x61\x78\xf9\x44\x2f\xf9\x01\xd1\x37\x00\xd3\xf1\x4b\xc7\x0f\xd4\x9f\x05\xf9\xd1\x45\xf2\x16\x82\x1d\x15\x97\x10\x5a\xcf\xe2\x02\x25\xe8\xf9\x44\x2f\x4f\xcb\xed\x39\xdb\x69\x42\x0e\xdf\x3e\x6b\x77\xad\xc5\x2f\xe4\x8a\x8a\x3a\x1d\xdb\x0a\xbd\x4c\x9e\x61\xbf\x83\xc2\xc5\x38\x41\x27\x8d\x28\x27\x79\x11\xf1\x00\x24\x51\x88\x88\x1a\x9b\x82\x96\x22\xdc\x6f\xbb\xd9\x29\x3f\x24\x49\x39\x80\xed\x1e\x19\x15\xfd\xe8\x71\x2a\x30\x7b\x35\x4d\x13\x2a\xf4\x4d\x9d\xcd\x8f\xb6\xc8\x7f\x91\x45\x05\xf8\x48\xb1\xb8\x11\x02\xb1\x4e\x4f\x1f\xdd\xe3\x93\x74\x2b\xb8\x59\x56\xbb\xd0\x1b\x79\x87\x5e\xf5\x6a\x60\x03\xd3\x8f\x55\x2f\x7d\x8f\x9e\xab\x10\x63\x93\xdd\x33\x38\xf7\x1a\x28\x92\x68\xaa\xc7\x12\xf1\x1c\x9f\x6a\x8f\x99\xb2\x97\x21\x7a\xea\xeb\x1b\x55\x85\x95\xf3\xf5\xc4\x06\x45\xd5\x58\x6a\x30\x87\xd2\x7c\x1f\x45\xfb\xa2\x65\x5b\xc7\xd7\x8c\x7d\x1e\x71\x5c\xee\xfc\x2b\x5d\x82\x25\xb5\x7e\x51\xd6\x6a\x6f\xd9\xec\x75\xab\xdd\x22\x39\x36\xbe\xee\x63\x5b\x6c\xcd\xf7\xe0\x55\x56\x5a\x90\x7c\xb9\x58\xa4\x59\x01\x2a\x34\x7e\x1f\xfb\xf6\x19\x51\xca\x93\xb6\xe1\xec\xb1\x9c\x10\x1b\xbf\x46\xb8\xce\xe2\xab\xa7\xaa\xb5\x28\xca\x7b\x84\x07\x1a\xaa\xb4\x92\x47\x4e\x73\xed\xdd\xb3\xd4\xa3\x8d\xab\xae\xd5\x18\x74\x1c\xb1\x97\xbc\xc4\x5e\x9d\xf6\x76\xbf\xb9\xd3\xdc\xde\x69\x6e\xff\x2b\x34\xb7\xe2\xf1\xc4\x8d\x9e\x5c\x1f\x04\x59\x9a\x90\xff\x5d\xce\x83\xf3\x28\x27\xdf\x07\xec\xe7\xdf\x3e\xf1\x9f\xfd\x39\xf5\x6a\x75\x07\x03\x72\x98\x44\x45\x14\xc4\xd1\xaf\x94\xfc\x9d\xf7\x82\x11\x6a\x40\x72\xb0\xb7\x92\x66\x35\x30\x50\xb6\x54\x0d\x47\xe6\x7d\x50\xde\xca\x62\x32\x52\x88\x08\x32\x75\x18\x8e\xc8\xb0\xee\x7e\x8d\xdb\x74\xb0\xe1\xdb\xae\x73\xbd\xc6\x24\x5e\x97\xb9\xfa\xa5\x99\x8c\x67\x35\x91\x08\x85\x96\xb4\xd9\x8e\xc7\xd1\x2e\x7f\x81\xd2\x43\x6a\x9d\x89\xac\x46\x66\x49\xff\xba\x5e\x57\x43\x68\x04\xac\x3d\xa7\xf7\x83\x0d\x81\x9e\x12\x77\xbb\xbc\xad\x9e\x68\xcc\x70\x8c\xca\xb3\xba\x65\x5a\x64\xd9\xa4\x63\xb2\xa3\x6c\x73\x37\xdb\x28\xb2\x54\x10\x9e\xb3\x33\xa9\x9c\x1d\x72\xf8\x1c\x72\x64\xef\xd4\xa4\x6d\x6d\x95\xf9\x12\xf2\xbf\xf0\xe1\xef\x81\x9c\x6a\x74\xb6\x7c\x02\x24\x46\xaa\xd2\xe5\xdb\x1f\xfe\x3f\x3b\x20\xc9\x57\x40\xcd\xcd\x2b\x24\x0e\xd4\x61\x51\x9a\x09\xf9\x0d\x72\x94\x62\xae\x2e\xba\x99\xe7\xf5\xb5\xd5\x80\xdf\x08\x43\xa2\xc1\x6a\xcf\x8a\xfe\xcb\x13\xad\x3b\x4f\xee\xb7\x07\xe9\x97\x05\xd0\x13\xd5\x76\x9f\x9e\xd3\xec\xb2\x23\x3d\x1e\xbf\x8f\x92\x69\x4c\x5f\x73\x84\x77\xc9\x88\x78\x33\x74\x4d\x62\x5a\x55\x47\xfc\xe0\x62\x02\xd5\xc1\x4a\x09\xeb\x92\x6e\x90\x9d\x90\x4c\xe3\x14\x69\x58\x10\x89\x0c\x39\x3f\xfb\xfb\xfb\x9c\x6a\x30\x90\x70\xad\x20\x61\xd9\x19\x9b\x81\xf1\xdb\xdb\xb6\xaf\x3a\x21\xb3\x5a\x7e\x23\x07\x03\x1e\x7e\x4f\x25\x09\xcf\xeb\x98\xb9\xc8\xf5\xd8\xc8\x67\x3c\x67\x44\x67\xf0\xe6\xac\x86\x1d\x3d\x65\x40\xe5\x6e\xbc\x45\xc7\x2d\xfe\xc2\xeb\xca\x39\x53\x15\x55\x49\x01\x27\xec\x82\xb2\x48\x2c\x8a\x8e\xe4\x3d\x5d\x32\x89\x68\x1c\x5a\x06\x06\xa2\x15\xa3\xa7\x16\xcf\xc1\x1d\xb4\x18\x0f\xef\x9a\x45\x86\x32\xd9\x8a\xec\x20\xc9\xc2\x75\x76\xe5\xb0\x37\x09\xdb\x97\xac\x4d\xfe\x16\x2c\xce\xd4\xb9\x3b\xb2\xa2\xa8\x4f\xc8\x89\x4c\x0c\x7c\x74\x27\x06\xde\x89\x81\x7f\x6d\x31\x50\x3f\xc2\xe3\x8b\xe6\xb6\x9e\xe1\xdd\xce\x15\x3d\x03\x79\x2d\xd5\x8b\xa5\x26\xc9\x70\x4e\x14\xd1\x58\xa4\xad\x31\xfb\x89\x4e\x91\xc2\xad\x9a\xcc\x65\x3f\x8d\xfb\x79\xe0\x79\x3a\x5f\x4b\x06\x43\x04\x06\x7e\xf7\x71\xe0\x41\x6d\xee\x8c\x33\x50\x09\xee\xcd\xd9\x57\xc4\xca\x31\x94\xac\xa0\x31\x78\x1d\x24\xc1\x94\xea\x17\xf8\x8c\x65\x71\x54\x18\xaa\x00\xe9\xa6\x43\x83\xa3\xfd\x7e\x6e\x60\xc8\xa9\x38\x9b\xd7\x58\xb9\x87\x94\x71\x98\x28\x31\x7d\x78\x5a\xe2\xdf\x59\x90\x73\xbf\x0a\x65\xd1\x26\xa6\x14\x3c\x51\x7a\x36\x29\xd3\x9b\xbc\xed\x3c\x54\xb6\x69\xb6\x07\x24\xe6\x20\x42\xb4\x51\x1a\x4f\xc2\x70\x19\x8a\x42\xe4\x28\xe2\x50\xd6\x7a\xd2\x5f\x61\xce\x04\x1b\x95\x52\xe7\xca\x98\x3b\xdc\xd4\x97\x12\x22\xfc\x86\xd8\x76\xd5\x38\xfb\xe4\x35\x63\xe5\x11\xcd\x45\xa0\x68\xc0\x87\xe3\x69\xd2\xf0\xde\xd9\x18\x6f\x72\x50\x57\x6f\x96\x71\xac\x9d\x5f\xf4\x98\x14\x49\x3f\x47\x70\x4d\xe6\xc3\xdd\x1f\x33\xc6\xd0\x1f\x3f\xb4\x8e\x83\xc8\x46\x01\x75\xec\x18\x26\x2a\x5a\x8c\x79\x0e\x23\x35\x11\x62\xde\xbd\x7d\x26\x82\x48\x54\x87\x87\xd1\x58\x13\xde\x5c\x39\xdd\x01\xce\xd5\x81\xd3\x7e\x55\x04\x90\x87\x0c\xd0\x45\x92\x41\x72\x2a\xc9\x83\x0d\x54\x4b\x25\x32\x36\x3d\xbc\xb5\x84\x7e\x7c\xc6\x9e\x9e\xd2\x96\x04\xa9\xdc\x2a\xf6\x08\x84\xc1\xae\x0a\x12\x45\x9e\xe8\x6f\x4e\xdb\x50\xe4\x94\x31\x03\xf4\xb3\xc6\x77\xbe\x83\x74\xce\xed\x55\x7c\xda\x6a\xc4\x73\x17\xbb\xd5\x27\x45\xd3\xe1\x70\x09\xee\x3d\x51\x50\xcc\x70\xb8\x18\x85\xf6\x56\x05\xee\x64\xe0\xd0\xf3\xc4\x0b\xa0\xaa\xf2\x46\x1f\x11\xb8\xf0\x05\x25\x92\x6f\xa4\x24\x19\xae\x11\x9b\x28\x90\xcb\xb6\x11\x12\x9a\x45\x19\xc2\x0e\x57\xc5\x2e\x62\xfb\x41\x5e\xdb\xbd\xb2\x90\x26\x00\x18\x2d\x03\x0c\x08\x79\x46\x08\x21\x75\x48\xf1\xeb\x40\xa4\x32\x03\xf4\x4a\x25\xaa\x8c\x2a\xc7\xc9\x58\xc1\xe1\xa0\x4a\x3a\xc1\xe5\xf8\x34\x65\xad\xb3\x5f\x30\xba\x58\x86\x1c\xda\xd9\x32\x8a\x43\x40\x98\x18\x14\xcb\x74\x3c\xd8\x02\xbb\xff\x70\xf4\xfc\x68\x73\x73\x13\x84\xfb\x76\x4e\x96\xd3\xf8\xb2\x2f\xe2\x84\xb1\xe3\xc0\x32\x67\x3b\x62\xa1\x5a\x49\x90\xd3\x58\xf6\x2d\xad\x68\xd4\xfd\x08\x63\x1c\x90\xa1\x9e\x54\x6f\x1b\xb1\x9c\xce\x7e\x39\x61\xd9\x27\xc3\xd3\x53\x26\x74\xe1\x9f\x57\x57\xca\x38\xd3\x06\xe5\x1f\xdb\x50\x86\x8d\x65\xcf\x7f\x51\x64\xd5\x8e\x20\xfd\xd8\x55\x78\x2a\xbb\x32\x55\x37\xe8\xda\xb0\x94\xc7\x38\x49\xfc\x2f\xaf\x88\xe3\xc8\x5b\x08\xef\xfa\x28\xbc\x8e\xa3\x67\x62\x85\xa8\xf0\xc5\x22\x30\x0e\xea\xd0\x96\x29\x4e\xea\x5b\x29\x7d\x39\x63\x04\x5b\x51\x76\x74\x1e\x1b\x3c\xbb\x61\x0e\x2f\xda\xc1\xbc\x4c\x59\x3f\x5a\xd6\x32\xde\x78\x29\x66\x77\x8d\x6a\x4c\x83\x1a\xe3\x47\xf9\xe8\xec\xeb\x29\x41\x44\x2c\xab\xa9\x97\x67\x19\xe2\x8a\x26\xf5\xae\x1c\x25\xef\x5c\x66\x14\x6e\x4a\xdf\xbd\x7d\xa6\xbc\x2f\x71\x93\x96\x71\x90\x28\x21\x34\x4a\x84\x32\xc6\xef\xe7\x29\x73\xfd\x3c\xf6\xfb\xfd\x15\x8e\xed\x66\xfb\xd1\xd3\x1a\x4e\x59\xd4\xc3\x63\xeb\xfc\xd9\x97\xba\x9a\x5f\x87\x3e\x25\x79\x98\xfe\xe8\xf1\x84\x96\x21\x5a\x94\x2c\x51\xf8\xbc\x96\xb6\x30\x4d\xaf\x05\xbf\xbd\xd3\x07\xdd\xe9\x83\xfe\xda\xfa\x20\xa1\x0c\x0a\xcf\x6e\x70\x23\xe8\xd3\x07\x29\x2d\x0e\x56\x08\x71\xe6\xa4\x34\x3d\xcf\x9f\x1a\x7c\x84\x0d\xc3\xf4\xf7\xe1\x68\x30\x60\xa4\x56\xf2\x5e\x49\x90\xb0\x0d\xcd\xdd\xf3\x8e\xc7\xa6\x5f\x5c\x7a\x39\x22\x97\x25\x1c\x75\x58\xa5\x68\xbf\x11\x40\x8e\x26\xa6\xe3\xeb\x9b\xa5\x82\xd6\x7b\x7f\x79\x9c\xa2\xc5\xb2\x50\xcf\xd6\x12\x7a\x21\x10\xd9\xd1\xbd\x64\x92\xc8\x88\xb4\x15\x9c\x15\x3e\x63\x44\xda\xe1\xd9\x47\x5f\xae\x94\x1d\x77\x55\x9f\x54\xa3\x53\xda\xac\x51\x05\xe7\x6d\xd4\x97\x2b\x1b\xdd\x71\x1b\x5d\x2c\x8b\x97\xf4\x73\xfd\x30\x5f\xd2\xcf\x65\x63\x34\xb3\xaa\x07\x58\xdf\x16\x07\x2a\x1b\x9a\xbf\x2d\x6b\x5c\x62\x1f\x3a\xd1\x70\x72\x22\x7a\x1a\xc9\x3d\x31\xf4\x9e\xe8\x16\x00\x9f\x96\x6c\x5a\xcf\x9f\xea\x0d\x8b\xd3\x4e\x6b\xb4\x0b\xbb\xd3\xe3\xbb\xdd\xe9\x6e\x77\xfa\x6b\xef\x4e\xfa\xb6\x22\x48\x83\x6b\x5d\x55\x08\xe0\xdb\x7d\x8f\x58\x12\xf4\xdf\x17\xf5\xdf\x77\x2f\xe2\xbf\x1a\xa9\xd9\x31\x19\x88\xb4\x48\xb6\x80\x16\x22\x59\x82\x8d\xcb\xda\x1b\xa7\xc9\x24\x9a\x4a\x30\x14\x01\x07\x43\xcb\x80\x2a\x12\xec\x42\xbc\x5a\x33\xee\x6c\x44\xa2\x84\xf9\x91\x47\x00\xb7\x90\x01\x89\x12\xe4\x30\x7f\x7f\x99\x8c\xf9\x16\x83\xa1\x72\x9e\x2a\xc1\x18\x2b\xce\xa8\x0d\x24\x52\x55\x5d\xdc\x33\x11\x86\x88\xce\x82\x44\x66\x73\x6f\x87\x4e\x7f\x64\xb2\x92\x3f\xc0\x57\x5a\x93\x6b\x04\xa5\x06\x17\x4f\x04\x41\x33\x38\x3c\xed\x92\x7b\xf7\x88\xf8\xee\x83\xa2\xf0\x68\xd2\x69\x0f\x3f\xb7\xb9\xcf\x92\x61\x97\x3c\x21\xad\x20\x0d\xd8\xee\x01\xf1\x48\x9f\x5e\xbe\x0c\xf2\x59\x8b\x8c\xec\x64\xae\xe5\x6d\x69\x29\x01\x85\x7d\xfa\x31\x4b\xe7\x4f\x7f\x83\x9e\xb6\x45\x97\x50\x34\xa1\xa7\x97\xd0\x30\xeb\xf4\x41\x12\x1e\xb2\x72\x2a\x88\x97\x17\x92\x8f\x43\xc1\xea\xf1\xc8\xa1\xa2\x22\xcf\xd2\x65\xd2\xe8\x12\xe7\x16\xc6\xe4\x6d\x9b\xcf\x06\x1e\x50\x09\x18\x1f\x95\x18\x8d\x0e\x8d\x9c\x06\x7f\x76\x91\x15\xc2\xd1\x96\x8b\xac\xfc\x30\xce\x99\x4b\x44\xbd\x3d\x5a\xe0\xce\x2c\x6a\xfa\xb1\x58\xbf\x0b\xab\x8d\x0d\x5e\x57\x9f\x5b\xdc\xa8\x37\x19\x6c\x3f\x50\xda\x84\x1e\x69\xe3\xb0\xbf\xed\x9e\xe8\xd4\x94\x16\xa3\x12\x75\x9a\x74\x74\xd9\xc7\x05\x45\xbb\x3d\xa1\xe7\x31\x0a\x9f\x07\xb1\x11\xa1\xaa\x6f\x05\x5d\x3e\x0f\x62\xc7\x95\x85\x4a\x5b\x6d\x00\x3e\xd7\x1a\x8a\xf0\x12\x77\x9d\xc1\x88\xa2\xd7\x19\x8e\x28\xda\x70\x40\x4d\x0e\x34\x6c\x5d\x05\x31\x58\x04\xd6\x8a\xdf\x02\xd0\x15\xc1\xe5\x02\x75\xf2\x95\x1c\x8e\xac\x04\x8d\xcb\xa1\x11\x39\xd1\xbb\x32\xbf\x38\x12\x4e\x18\x7f\xd4\xf7\x44\x36\x04\x8e\x9a\xcd\x57\xbf\x02\x46\x61\x07\xad\x1b\x92\x11\xae\x86\xe7\x29\x8f\x27\x0a\xc8\x7b\x34\xf9\x47\x5a\xd0\x5c\x79\x31\x2e\xc5\x11\xe1\x48\x42\xd0\x16\xa2\x10\x2b\x63\x40\x0e\x8c\xb8\xa9\x18\x91\xed\xd2\x41\x90\x9b\x8d\xe2\x55\xa4\x42\xaa\xd4\x0e\x81\xc1\x56\x0f\xc0\x06\xb1\xfb\xef\x9e\x61\xd3\x2c\x98\xd2\x83\xca\x2e\xc8\x63\xac\x00\x2d\xa5\x35\x1f\x84\x3a\x61\x56\x50\x1b\x7f\x44\xce\xf7\xac\x22\x85\x63\xde\x3a\xe4\xe5\x1d\x98\x70\xb1\x58\x33\x26\x06\x55\x3a\x1c\x2b\xf3\xb7\x5f\x37\xde\x81\x49\xee\x59\xc7\x17\x38\x5b\xb7\x86\xa6\xe4\xb7\x9b\x0d\xcb\xd7\xdb\x9e\xc3\x2a\xb5\x7d\x2a\x63\x82\x64\xb5\x1e\x1f\x60\x4d\x54\xaf\x9e\x67\xe9\x7c\x11\xc5\xfc\x48\x51\x3b\xcf\x02\xb4\x62\xb2\x5d\x08\x89\x9a\x61\xf9\x44\xd8\xa2\x4f\xc3\x89\xb1\x8b\x39\xdd\xaa\x92\xfe\xdc\x0e\x6e\xdf\x70\xee\xaa\x66\x46\x2e\xcb\xe7\x74\x1c\xcd\x83\xd8\x8b\x0a\x33\xca\x66\x0d\x02\xca\xe3\xd8\x96\xc8\xc9\x20\x73\x56\x0e\xba\x9e\x9c\x50\x85\xd5\x44\xf5\xc1\x73\x8c\x58\x6b\x4c\xaa\x94\x33\xb8\xb2\x13\xca\xd7\x58\x8b\x88\x99\xae\xb5\xec\xae\x83\xa7\x77\x74\x4c\xa3\x45\x03\xd2\x77\xcb\x34\x21\x00\x17\xf4\xa6\x14\x20\x6a\x6c\x3c\xc0\x86\x2b\xbb\x76\x51\x7b\x06\x67\x03\x36\xa1\x00\xbe\x5d\xde\x92\x00\x56\xbb\xbc\x73\x9a\x84\xef\x82\x8b\xe6\x4b\xdc\x2d\xe0\x47\x44\x25\x5c\x13\xce\xc6\xf0\xe0\xd9\x23\xdd\xb0\xbf\x75\xbd\x6d\xd4\xd5\xeb\xf7\xd3\x9e\x29\xdf\x1a\xf3\x8d\x23\x9a\x26\x6b\x8c\xc3\x84\x2e\x19\x47\x29\xd0\x57\x1e\x47\x83\xce\x97\xf7\xf8\xd6\x65\xb0\x12\xc2\x11\x66\x39\x55\x1d\x85\xa0\xec\xde\x8e\x5a\x39\xd7\xe9\x28\xdb\x0b\x6e\x4d\x52\x34\xa3\x57\x57\x8d\x09\x41\xfa\x87\xe6\x07\xb8\x0e\xc5\x18\x23\xbc\x11\x57\x1a\x73\x71\x4d\xc6\x9c\xae\x9b\x36\x0e\xdd\x97\x81\xa8\x4b\xa6\xd0\xac\xd3\x37\xd6\xd2\x8e\xbc\x7a\xf5\xaa\x61\x1f\xe2\x52\x0a\x52\x35\xad\xd5\xf2\x7b\x9a\x2d\x68\xed\xf6\xa4\x30\xc0\xa1\xab\x11\xe0\xc0\x54\xf4\x62\x4a\x8b\x83\x3c\xa7\xc5\x61\x32\x49\xeb\xb7\x48\x05\x5a\xba\x37\xfa\x20\x4a\x9a\x77\x6e\x0e\xb5\xba\xa3\x87\xd3\xd0\xe9\xdf\x49\x67\xc7\x65\x23\x51\x9d\x61\x8d\x54\x76\x14\x34\x12\x60\x15\x5a\x20\xe2\x00\xe1\xc2\xd9\xfb\xbd\x01\x81\x32\xcb\xd2\x95\xb4\x58\x06\x20\x04\x9a\xb2\x6c\xab\x51\xd3\x3e\x12\xad\x6e\x9d\xe8\xee\xcd\x28\xcf\xdc\x44\xcc\x42\xa5\x25\x50\xf3\xe6\x32\x73\x32\x5e\xbd\x7a\xe5\x02\x73\x92\x34\x06\xa8\x28\x05\x12\xc5\x65\x30\x0f\x5b\xa5\x14\xb0\x55\x8e\x6c\x35\xdd\x30\xa2\x55\xba\x45\xd3\xf6\x4e\x6e\x50\xe2\xca\xa5\x67\x64\x4e\x29\xe7\x56\x7c\xa7\xf2\x82\xd4\xcb\xa5\xe2\x8e\xc7\x14\x46\x09\xd0\x79\x6f\x8d\x1e\x4e\x83\xfc\x6d\x16\x19\x8a\x3e\xa7\x8b\x25\x30\xd7\x56\xca\xad\xdf\x4b\x11\x44\x24\xaf\xea\xa5\x82\xb9\x66\x1b\x67\x7a\x83\xac\x6a\xa6\x1c\xec\xff\x63\xef\xdd\xd7\xdb\xb6\x95\xc5\xd1\xbf\x93\xa7\x40\xf3\x3b\xab\x91\x62\xda\xd6\xdd\x8e\x12\x77\x6f\x47\xb6\x63\xaf\xc4\xb1\x7f\xb6\xd3\x76\x6d\x7f\x6e\x3e\x4a\x84\x2c\x26\x12\xa9\x45\x52\xbe\xb4\xf1\x7e\x9f\xf3\x1c\xe7\xc5\xce\x87\xc1\x85\xb8\x52\x94\x2f\x69\xda\x65\xaf\xbd\x1b\x91\x04\x06\x03\x60\x30\x18\x0c\xe6\x52\x6a\x37\x5a\x1c\x2f\x1e\x61\xfd\x67\x7a\x81\x5e\x84\x9b\x5e\x54\x6a\x51\x26\x76\x45\x0d\xbe\x32\xc8\x2f\x27\xa5\xcb\xa1\x7e\xa8\xd8\x26\xfb\x83\x2c\x4e\xf8\x76\xc9\xaf\x33\xc1\x2c\xd0\x43\xa4\xac\x62\x1b\xc8\x4a\xdb\x1a\x1b\xf2\xeb\x4b\xc3\xe5\xcc\x93\xdc\x6a\x68\xa9\x5e\x82\xc1\xfc\x00\xbc\x44\x7a\x9a\xe5\xac\x94\x75\x84\x5e\x89\xe6\x70\xc8\x50\xf0\xd4\xd1\xda\x7d\x87\xc7\xef\x58\x57\xf0\xa4\x52\xf5\x4c\x8a\x7d\x1f\x9f\x4b\xa2\x54\x39\x8c\x6c\xfd\x0c\xa5\x3b\xd8\xbc\xab\xba\xf9\xaf\x9c\x3a\x85\x57\xa8\xd8\xf1\x9e\x17\x8e\xc5\x8f\xfd\xdc\x7a\x45\xbb\x86\x7e\xd6\xad\xaf\x7b\xcf\x8c\xeb\x70\x66\x89\x99\xdf\x43\x3f\xeb\x36\xda\xf0\x82\xf6\xf6\x59\xb7\xf1\x92\x3e\x8a\x51\x7a\xd6\x6d\xd2\x2a\x61\xdf\x8f\x9e\x75\x9b\x4d\x4f\x35\x96\x81\x47\x76\x85\xfc\xac\xdb\x6a\xc1\x33\xbf\x34\x7f\xd6\x6d\x51\xf0\x8c\xe5\x3d\xeb\xb6\x28\x5a\xfc\xba\xf8\x59\xb7\x45\x1a\xe4\x57\xde\xcf\xba\xad\xe6\xcd\x99\xd7\x7c\xf9\x68\x7d\xf3\x68\x7d\xf3\xf7\xb6\xbe\x61\xa6\x37\x78\x72\xcf\xb6\xa1\xe5\x6d\x62\x4a\x18\xbc\x40\xb9\x0f\x38\x7b\x70\x53\xd2\x07\xbe\x08\x2f\x61\x3e\xba\xba\xba\x9a\x87\x64\xb0\x85\x79\x60\x59\xc9\x08\x83\x07\x70\x78\x82\xfc\x69\x28\x61\xfe\x40\x02\xa5\x99\x6e\x59\x13\x03\xd4\x9c\xcc\xb7\x15\x39\x30\x4e\x74\x0d\xa3\xd1\x8a\xab\xd0\xea\xea\x22\x92\x90\x2c\x97\x18\x9b\xda\x07\x9c\x59\x36\x35\x75\xf3\x92\x77\x97\x9b\x33\xaf\x55\x7b\xdc\x2d\x1e\x77\x8b\xbf\xf7\x6e\x91\xdb\x6a\xea\x1b\xc6\x9f\x69\xaa\x79\x7f\x56\x95\x25\x8d\x3e\x73\x03\xb1\x43\x9c\xa4\x71\xe4\x8f\x1f\xad\xc4\xbe\x81\x95\x58\x19\x4b\xa4\x08\x5f\xe6\xe6\x4d\x45\xba\xbd\xbc\xa0\xa9\xd9\x9b\xb2\x59\xfd\x64\x2d\x54\xfa\xa6\xc7\x62\xc0\x12\x4e\xc8\x46\x70\xe4\x5f\xbe\xc3\xf3\x54\xc0\x72\xd1\xe7\xde\xd3\x27\x4f\x74\xe4\x8c\x02\x05\x7e\x18\xe5\xaf\x34\xcc\x76\xc4\x07\xc9\x32\xe6\xc9\x93\x92\x97\x7c\xa5\xef\x32\xf0\xe0\x08\x0f\xe2\x8b\x62\xeb\xa8\x2e\x7a\x2e\xca\x59\x71\x55\xbf\x16\x0c\xc8\x2c\x1a\xc7\x83\x2f\xe5\x48\x45\x29\x5b\x40\x2d\xae\x72\x65\x2c\x8a\xca\x8d\x9b\x73\xf4\xfc\x41\x16\x5e\xf8\x19\xde\xc2\x63\x7c\x4e\x18\x66\x71\x8f\xf4\xe2\x05\x9d\x2a\x28\xfa\xa0\x77\x5b\xf7\x7c\x29\x9a\x53\xf3\xdc\x9b\xd1\x45\xba\x63\xbb\x51\x74\x76\xa9\x3c\xc5\x95\xa3\xb7\x62\x6a\x5b\xe4\x9a\x4b\x9f\x15\x0d\x79\xdb\x71\x81\x35\x2c\x35\x22\xb1\xa3\xfc\xad\xb2\x26\xf2\xd7\x3a\x55\x49\xda\x7d\xbe\x82\x5d\xda\x78\xa7\xd2\x5e\x6a\x48\x96\xed\x1f\xee\x38\xb4\x39\x47\x37\x9c\x4f\x94\x5c\xd8\x71\x04\x31\xcf\x20\x5c\xb6\xc8\x0f\x22\x16\x75\x98\xa6\x01\xd3\xcf\x24\xf5\xc7\x33\xc9\xe3\x99\xe4\xef\x7d\x26\x61\x07\x92\x74\x34\xba\x45\x9a\xba\xc9\x60\xe4\x87\x11\x81\xfc\xd3\x1c\x85\xd7\x20\x4e\xf0\x4a\x18\xab\x67\x92\xb5\xd2\xce\xd0\x25\x3d\xa8\xe6\x39\x62\x41\xa1\xe3\xd1\xe8\xef\xe4\x54\x3d\x4f\xf0\x7f\xf5\xf4\xe9\xf1\x68\x24\x5d\x71\x44\xf8\x92\x05\x98\xdd\xf9\x16\x97\x3a\xe9\x68\x34\xf7\x52\x27\x1d\x8d\x2a\x55\x2e\xa3\x2d\x72\x79\x53\x74\xa6\x71\xef\x4d\x86\xdc\x20\xed\x4c\x17\xd6\xdb\x3a\x26\x3b\xa4\xa3\xd1\x27\x7b\x01\xd5\x74\x18\x59\x34\x77\x45\x8d\x86\xaa\xfd\x83\xd6\xa2\xe5\xeb\xdd\x9a\x4b\x71\xb6\xef\x5f\x31\x22\x38\x0e\x7f\xd7\x2f\x88\xa5\xb6\xe7\x15\x55\x4d\x35\x6e\x83\x48\x18\x1d\xc6\xbf\x14\x23\x60\x2b\x72\xb7\x86\x27\x7e\xf2\xe5\x24\x99\xa5\x19\x0e\x0e\xb1\x71\x21\x2c\x35\x5f\x5c\xf0\x6e\x48\x44\x98\x08\x7b\x87\x7e\x58\xd0\xbe\xb3\xcc\xdd\x28\xc0\x0f\x82\xc3\x04\x44\x48\x7a\xfa\x75\xb4\x5e\x54\xec\x6e\x7d\xa7\x09\x7d\xe6\x76\xbf\xa8\xd8\xdd\x10\x18\xf9\xe9\xdc\xd6\x9d\x65\xee\xd6\xf4\x39\xce\xe8\x7e\x5e\x38\xf6\x05\xa5\xee\xde\x7c\x89\xb9\x2f\x2a\x76\x67\xba\x3f\xbe\x9e\x14\x36\xee\x2a\x72\x67\xaa\x9f\xd7\xb0\xab\xc8\x5d\x87\x9c\x88\x71\x19\xa6\xa0\x77\x92\x78\x72\xe8\xa7\xe9\x65\x9c\x04\x45\xe3\x5f\xb2\xce\x9d\xd7\xc1\xbc\x31\x71\x15\xb9\x33\x19\xce\x6b\xd8\x55\xe4\x3e\x58\xcf\xbc\xb6\x0b\x4a\xd9\x9b\x17\x0f\xab\xab\x28\x9d\xf5\xe1\x9a\x91\xa6\x2a\x9d\x45\xf9\xf3\x24\x4c\xd3\x30\x3a\x7f\x5a\x1a\xdb\x69\x9c\xea\x17\x75\x12\x96\x96\xaf\x16\x05\x06\x2a\xb6\xa6\x47\xf3\x2f\xf4\x8e\x47\x23\x29\x59\x90\x66\x66\xa2\x1c\xa2\x35\x23\x90\x56\xe3\xf1\x08\xfd\x78\x84\xfe\x7b\x1f\xa1\xf3\x6b\xbd\xfe\xef\xbf\x6b\xf7\x7a\x9b\x63\x7c\x85\xde\xe0\x04\x9f\xa7\xbf\xfb\xe9\xef\x21\x7a\xed\x8f\xf1\xd5\x7f\x27\xd9\x30\x5d\x19\xcd\xd4\xe3\x70\x87\x45\x28\x3c\xc2\x43\x9c\xe0\x68\x80\xbb\x88\xb4\x9f\x76\x57\x57\xcf\xc3\x6c\x34\xeb\xaf\x0c\xe2\xc9\x2a\x3b\x74\xaf\x9e\xc7\xcb\xfc\x67\x7f\x1c\xf7\x57\xd3\x4b\x3f\x99\xac\x86\x51\x86\x93\xc8\x1f\xaf\x92\x0e\xe1\xab\x8c\xff\xbb\x72\x1e\xff\x9f\xf7\xcd\xe6\x03\x5f\x02\xe6\x37\x7b\xc7\x04\x9b\xbf\xf9\xd1\x1a\x7e\xfc\x25\xae\xf5\xa8\xed\x2b\xce\x2e\xe3\xe4\xcb\x11\x86\xe0\x93\x45\xfa\x73\xbd\xb8\xa9\x44\xef\xff\xfe\xfb\xa7\x82\x52\x77\xf1\xe6\xba\x8e\x06\xdb\x91\xdf\x1f\xe3\x79\x58\x4a\x25\xed\x08\xda\x0b\xdc\x05\xb7\x4b\x7f\x5a\x12\xb7\xbc\xa4\x03\x37\x6b\x81\x3b\xe0\x16\xc4\x97\x11\x8b\x2b\x5a\x84\x18\x2f\x66\xc7\xca\xf2\xb5\xbc\x83\xa2\x03\xb1\xd9\xb4\x04\x5a\xb4\x90\x1d\x29\xe3\xdb\x9d\x51\x4a\x70\x96\x84\xf8\x62\xde\x35\x1f\x2f\x66\x47\xcb\xf2\xf5\x2e\xa4\x95\x91\xbd\x6e\x0e\x51\x91\x32\x0e\x72\xd2\x3e\xdd\x79\x88\xce\x71\x09\x07\x58\x3b\x2e\xea\x87\x3b\x8c\x09\x8d\xd4\x3e\x27\xf4\xa1\x1d\x07\xf5\xc3\x9d\x47\x83\xa5\x66\x28\x46\x86\x16\xb2\xe3\x63\x7c\xe3\x28\xb5\x4a\xa1\x54\x70\x7d\x6d\x28\x38\x75\xb6\x2c\x5d\xfd\xe5\xfc\x50\x7a\x99\x33\xa2\xfc\x25\xe7\x03\xd2\x45\xe4\x54\x7d\xe6\xd4\x2f\x01\x22\x24\x98\x3f\x9e\x63\xe9\xbe\x72\x3a\x93\x1e\x24\x49\xfc\x41\xef\x18\x47\xe1\x85\xd3\x3b\x86\xcc\x09\x7c\x77\x9e\x20\x8b\x61\x5b\x54\xb2\x0a\x6c\xf8\xee\x38\x5c\x59\x4e\x57\x44\x58\xb2\xc5\xb0\xb4\x5e\x4a\x36\x1f\x4f\x54\x8f\x27\xaa\xbf\xf7\x89\x8a\x1d\xa7\xf8\xf5\xd0\xb7\x8d\xbb\xbc\xb0\x0d\xb9\x1f\xfb\xdc\x3f\xca\x9f\x86\x5c\x18\x87\xb6\xfc\xd8\x2f\xb2\xb5\xa3\x97\x65\x85\x11\xfe\x78\xe9\xec\x7a\x4a\xe4\x03\x16\xcd\x4f\xca\x26\x9e\x5e\x86\xd9\x60\x54\x21\xdf\xf5\x44\x02\x03\x3f\xc5\xe8\x39\xa1\xf8\x34\x7b\xde\x55\x3e\xc1\x64\x25\xe7\xe9\x4a\x3a\x0a\x87\x59\x45\xcb\x1e\x80\x8c\x34\x60\x35\xb3\x00\x63\xc9\xe0\xc0\x16\xe1\x4b\x1a\xc0\x87\x5e\xc7\xbe\xb2\xa0\x31\xc5\x51\x10\x46\xe7\x0f\x8e\xc7\x21\x6d\x47\x36\x2d\xb2\x21\xc5\x82\xb9\x99\xd8\x68\xe0\x8c\xca\x34\x9b\xc2\x8d\x24\x1d\x88\x52\xf3\x6d\x26\x19\x34\x5d\x46\x50\x48\xc1\x22\x3b\x59\xa4\xea\x28\x8c\xd2\xcc\x1f\x8f\x4b\xb5\xac\x95\xb6\x3b\x63\xbb\x0b\x15\xbb\x83\xbf\x8f\xcf\x4b\x44\x64\x22\xa5\x9c\x4e\xe0\xb4\x45\xad\x48\x41\xab\xd3\x78\x6e\xe4\x06\x52\x64\x4e\x7b\xbd\x91\x1f\x9d\xe3\x12\x4d\xda\x84\x0f\x0a\x42\xb6\xd4\x52\x46\x4f\x11\x84\x48\xc7\xa4\x46\xe2\xf1\x58\x96\x07\x16\xe6\x37\xe9\x68\xb4\x02\xac\xd1\x60\x37\xa9\x66\xda\x70\xfb\x6b\x70\x1b\xad\x22\xe3\x8e\x46\xb1\x23\xb8\x57\x25\xbd\x9b\x18\x91\x4d\x4f\xff\x70\x88\x98\xa4\x8b\x8c\x4b\x0a\xda\x2c\xc3\x41\x2f\x7a\xb7\xe6\x35\x32\xbe\x87\xb6\x65\xd2\x33\x24\x51\x8a\x83\x1f\xfb\x5d\xf2\x1f\x0a\x2c\x1d\x8d\xba\xe4\x3f\x54\x78\xb5\xc5\x59\x6f\xb5\x1e\x45\xd2\x47\x91\xf4\xef\x2d\x92\xe6\x4a\x7e\xe6\x26\x7d\x5f\x69\x61\xcf\xc7\x71\xdf\x1f\x1f\xe1\x73\x32\xcd\x7e\xb2\xd9\x0f\x1d\xd1\xc6\xd3\xd5\xb7\x6a\x51\xc8\xe8\xcd\xb5\xf3\xe1\xc0\x9f\xca\x40\x5c\x30\xf6\x7a\x9b\x87\x26\x04\x09\x93\x0f\xb4\x7b\xcc\xa6\x19\x6d\xa0\xe7\xb5\xab\x41\x27\x78\x19\x34\x06\x41\xab\xf5\xd2\x5f\x6b\xb7\x06\xad\x97\xad\x46\xa7\x85\xeb\xeb\xb5\x97\x83\x76\x0d\x37\x5b\x41\xa7\xd5\xee\x34\xfa\xcf\x73\x5c\x6c\x60\xfc\xba\x5f\xaf\xd7\xfb\x83\xda\x5a\x6b\xf0\x72\x30\xf4\xd7\xd6\xeb\xc3\xda\xa0\xb9\x8e\x3b\xcd\x7e\xd0\xae\x0f\x5e\xd6\xfb\xeb\xfe\xb0\x56\x7b\xee\x66\x4d\x14\xc7\xae\x24\xe8\xfa\xfd\xb0\x6b\x19\x44\xc9\x58\x9a\xa2\xd0\xb5\xf6\x8f\x6e\xf4\xb4\x30\x41\xdb\x80\xac\x8f\xab\x05\xae\xd9\x5d\x0a\x55\x61\x98\xc5\xb3\xf8\xac\x5b\xf7\x9e\xcd\x99\xa7\x67\xdd\x06\xe1\xb5\xed\x47\x5e\xfb\xc8\x6b\xff\x53\x78\x2d\xd7\x7d\x69\xcc\xb6\xc8\x28\x79\x98\xc4\xbf\xe3\x89\x1f\xad\x04\xf8\xa7\x6f\x95\xb7\x5b\xbf\x1f\xbd\x4b\x5e\x6e\xaa\x47\x94\xbe\xd3\x17\x52\x89\x54\x2f\x91\xea\x25\x6e\x93\x64\x7b\xf1\x54\xde\x05\x69\xb9\xf9\x58\x3c\x7c\x62\xee\x6f\x96\xcd\xd7\xd2\xa5\x82\x7c\xbe\xb6\x7c\xb2\xda\x08\xff\x97\xed\x2d\xad\x0b\x99\x75\xbf\x93\x4c\xba\xce\x7e\xdf\x53\x2e\xdd\x1f\x36\x28\xe1\x68\xaf\xc8\x86\x32\x0c\x23\x1c\xdc\x25\xd9\xee\x5f\x34\x1f\xb9\x65\xc8\xbf\xcf\x8c\xe4\xda\x70\x53\xf3\x86\x1c\xb3\x2c\xb6\x93\xa2\x6a\x06\xe1\x22\x46\xf6\x8a\xf7\xc2\x49\x8d\xe5\x33\xf5\x42\x1d\x21\x4a\x22\x9e\x31\x22\xcf\xdc\xfb\x16\x67\x15\xe9\x70\x8e\xa3\xd9\x04\x27\x7e\x7f\x8c\xbb\x28\x4b\x66\xd8\xd4\xfc\xf9\x13\x9c\x16\x66\xe5\x95\x52\xf7\x42\x61\xd0\xdd\x22\x29\x5d\x6f\x3a\x27\x5f\x6f\xaa\x25\xec\x4d\x1d\x19\x7b\xf5\x22\xaf\x14\x85\x98\x68\xbe\x7e\xa6\x64\x0c\xb7\xa7\xdb\x88\xfb\x9f\x3d\x28\xef\xd1\x21\x63\x7d\x21\xf0\xfd\xf4\x3a\x1a\xbc\x85\xfd\x86\x88\xbc\xd0\x85\xea\x99\x92\xfe\x78\x93\x15\xa9\x48\x3e\x1a\x5a\x35\x65\x92\x00\x84\xca\x32\xe0\x72\x19\x2d\x01\x0e\x2b\x83\x91\x9f\x6c\x66\x95\x5a\x75\x25\x8b\x3f\x4e\xa7\x38\xe9\xf9\x29\xae\x54\xf9\x67\x48\x93\x5a\xa9\x57\x9d\x1b\x0f\x9f\x59\x77\x3e\xc1\x7c\xe3\xce\xb3\x0d\xf3\x90\x68\xbc\xc6\x39\xe9\x90\xb9\x62\x84\x80\xa2\x24\xd3\x15\x6f\x6d\x39\x73\x55\x3d\x0f\x4f\x62\x2d\xaa\xd0\xed\x5e\x52\xd8\xe4\xb9\x75\x8b\x3a\xc8\x47\x7d\xb1\x5e\xe6\x57\xfd\xee\x60\x67\x28\xb7\x72\xb2\x76\x88\xa6\x38\x5e\xb0\x57\x25\x53\x21\xab\xe9\x8f\xed\x83\x6d\x26\x40\xbe\x51\xd3\x11\x9f\xe3\x6c\xc1\x6c\xc4\xe7\xd8\xb5\x9d\x7c\xdf\xc9\x88\x2d\xc4\x51\x3e\x1d\xb1\x6e\x35\xd7\x95\xe5\x51\x53\x47\x7e\x7a\xa6\x6a\xd7\x69\x6e\x75\x6b\x36\xf8\x72\x99\x8d\xe5\x29\x7b\xa8\xfc\xc6\x7c\x80\xa4\x83\xb9\x16\x9b\x88\x1c\xb1\x3b\x8f\x47\xec\xc7\x23\xf6\x7f\xca\x11\x9b\x31\x88\x09\xe3\xe8\xea\x41\xfb\x9f\x78\x38\x4c\xf0\x35\xfa\x25\x1c\x0f\xbe\x60\xf4\xfa\x33\x1e\x0e\x5d\x51\x89\x16\x0a\x61\xb4\xef\x27\xe4\x04\x7f\xe0\x47\x03\xec\x43\x59\x5b\xf0\xa2\x5b\xc4\x3b\x62\x55\xde\xfa\x17\xe8\x97\x38\x0e\xd0\xeb\x73\xe7\x19\xbf\x95\x9f\xf1\xff\xc9\x98\xa9\xe2\x38\xcc\x38\x6c\x51\x9e\x4a\x4b\x40\x3e\x3d\xb5\xa4\x2d\xaf\x24\xcd\xa1\xae\xb6\x46\xdf\x51\x0b\x04\x3a\x3f\x7b\xd9\xf3\x94\xec\x8b\xd3\x38\x4a\xc3\xfe\x98\xd2\xd7\xd4\x07\x07\x12\x34\x61\x37\x3e\x64\x2b\x9a\x26\xf1\x45\x18\xe0\x24\x15\xb5\xfc\x71\x1a\x9b\x55\xe3\xf1\x98\x54\x25\xc4\xc6\x4c\xc7\x51\x14\x07\xf4\x63\x18\x0d\xe2\x89\x0c\x98\xc0\x62\x21\xe8\xe9\x7d\x6b\x16\x4e\x30\x59\x6a\x61\x8a\xea\x28\xc5\x83\x38\x0a\x60\x6f\x0c\xa3\xf3\x31\xce\xe2\x08\x46\x93\xf4\xae\xe0\x98\xcf\x31\x55\x0e\xfb\xfc\x25\xda\x10\x3d\x91\xb4\x0c\xa4\x6d\xd0\xff\xde\x48\x2f\x39\x2e\xb2\xce\xc1\x79\xf4\x23\xf2\xc9\x28\x89\xa3\x78\x96\x8e\xaf\x21\x00\x86\x63\x17\x26\x9f\x2c\xa7\x11\x14\xf8\x99\xef\x3c\x1f\xab\xbd\x55\x14\x1e\x51\xa0\x74\x9e\x80\x91\xcf\x69\x3f\x28\xbd\x57\x72\xcd\xc5\x51\x1a\x93\x8d\x8b\xd0\x44\x85\x52\xc6\xca\x5e\x74\xe1\x8f\xc3\xe0\x90\x95\xaf\xc8\x12\x0f\x77\xc0\x86\xc1\x90\xe4\x7b\x75\x87\x67\x54\xbe\x92\xc5\x87\xf4\x1d\xa0\xb4\x42\x7b\xef\x41\x37\x99\xa5\x85\x74\x7a\x61\x67\xf2\x0d\x75\xae\xa8\x28\xcb\x40\xf3\x7b\x72\xe8\x14\x6f\x24\x4c\x7f\x26\xe8\x1e\x51\x22\xc4\x42\x4c\x93\xba\x99\x8d\x92\xf8\x12\xa9\xdd\xd3\xcb\x2b\xdd\x61\xdd\xa4\x9f\x56\x4a\x9d\xfb\xfd\x85\x66\x1f\x64\xd9\x42\x12\xd0\x4f\xa5\x42\xf6\x99\x4f\x0c\x00\xdc\xa0\x08\x29\x44\x70\x29\xda\xe0\x99\x0c\x25\xc9\xb8\x88\x3a\xee\x87\x10\xcc\xb9\xa7\x52\x3f\x03\x59\x42\x9a\x27\x9d\xc2\x49\xa2\x0b\xf8\x96\xde\xc8\x82\xfc\x53\xa5\xfe\x22\xb4\x35\x7f\xa8\x8c\xda\xd6\x76\x45\x65\x6a\x81\xab\x90\x9e\x1e\x7c\xd4\x7d\x58\xb0\x51\x61\xd1\x79\x80\x14\x78\x43\xbe\x5b\x54\x4b\xb4\xde\x7d\x10\x24\xb4\xf0\x9d\x11\x24\xe0\x24\x53\x25\x67\x2e\xb7\x23\xc1\xf4\xde\x69\x90\x6b\xd7\x60\x36\x2a\xbc\x95\x3b\x91\x5e\x3a\x8f\xf6\x94\x0e\xcd\x21\x3a\xb1\xeb\xe1\x4c\x6c\xa7\x44\xc4\xe4\x07\x41\x26\xe8\x19\x04\x97\xf1\x99\xb2\xeb\xe3\x0a\x49\x49\xd4\x55\x37\x7b\xe7\x2e\x3f\x0f\xf5\x8c\x9c\xa3\x98\xb8\xa2\x23\x4a\xbe\x1d\x8a\x4f\x73\x19\x35\x8d\xf8\x7f\x03\xd0\xf6\x82\xb9\x2b\xc6\xf2\x55\x98\x22\x71\x4c\xb2\x38\x88\xd1\x60\x8c\xfd\x68\x36\x45\x11\xcd\x49\x1a\x0f\xf3\xb3\x7a\xd1\x50\x49\xd8\x5b\x16\x1e\x45\x52\x0e\x81\x22\x1a\x57\xc7\x92\xc8\x44\xa7\xb4\xf4\x19\x91\x8d\x48\xf5\x2e\xa2\x40\xc2\xa0\x6b\x00\xea\xda\x40\x76\xf3\x9f\xa0\xcc\x45\xcc\x79\x59\x1d\x7d\x65\xfd\x33\xb9\x4b\xdd\xc4\x19\x42\x15\xb1\xc0\xe7\x4c\x6e\x3c\x15\xa2\x28\x91\x3c\x99\xe9\x2c\x1c\x69\xce\x43\x72\x8e\x0b\x75\x85\x31\xa9\x63\x99\x73\x63\x6e\x0b\x47\x5e\x80\x50\x89\x14\xea\xf2\x0e\x51\x6b\x32\xcb\x20\xbf\x92\x86\x27\xc7\x9f\x8d\x4e\x85\xa9\x51\xbf\xe0\xeb\xb4\x92\xd7\xad\x72\xd5\x2e\x24\xdb\x46\x3f\xfe\x88\x5c\x63\x48\x88\x29\x39\xa1\xef\x2b\x4a\xa1\x57\xea\x38\xeb\x72\x6f\xc1\x78\xe7\x9b\x4f\x82\x09\x2f\x20\x52\x3f\x1f\xf6\x09\x1e\x8c\xfc\x28\x4c\x27\xfc\xec\x59\xcc\x1c\x00\x40\xf1\xf0\xd2\x36\xe4\x81\xfd\x82\xf1\x54\x24\x47\xe0\x9d\x5d\x7d\xf1\x39\x1d\x85\x11\x69\xe8\x6a\x10\x4f\xa6\x63\x7c\x15\x66\xd7\xdd\x36\x1c\xc4\x48\x01\x42\x10\x15\xb2\x37\x7c\xc1\xd7\x54\x3d\x20\x46\x53\x1a\xaf\xd5\x55\x94\xe0\x49\x7c\x81\x91\x3f\x1e\x43\xaf\x52\x0f\xe1\xab\x01\x9e\x66\x20\xed\xb3\x57\x72\xf9\x6c\x84\xaf\x51\x84\xe9\x88\xf4\x31\xab\x1f\x90\x1e\xcf\xfc\xf1\xf8\x1a\xf5\xaf\x61\xc8\xc8\xf0\xb0\x3c\x07\x40\x33\xbf\x90\xfd\x28\x8c\xce\x2b\x55\x69\x1b\xa8\xfc\xa0\xf4\x0e\x7d\xfd\x4a\xf0\xcd\x53\xac\x13\x00\x84\xd8\x3e\xb1\x4c\xeb\xcb\x75\x7d\x7f\x90\x28\xec\x0b\xbe\x3e\x5b\x11\x2b\x51\x37\x81\x36\x29\x92\x94\x37\xcc\x91\xff\xc2\xe4\x09\x87\x4b\x32\xef\x03\x6a\x8f\x8b\xe2\xa8\x0c\x4f\xa0\x66\xb4\x45\x34\xc9\xac\x84\x4d\xbd\xa7\x83\x0a\x51\x87\x80\xb3\x74\x26\xc5\x99\xd2\x7b\x02\x58\xd2\x3f\x7a\x68\xb0\xe2\xc7\xfe\xa7\xc3\x83\xf7\xef\xf7\x3e\xbc\xfd\x74\xb2\xb7\xbf\x7d\xf0\xf1\x44\x3e\x15\x95\x99\x01\x53\xa6\x52\x04\xa6\x07\x39\x31\x9a\x22\x19\xc1\x6b\xcb\xcf\x7c\xb4\x81\x4e\xcf\x5e\xa9\xef\xf7\xc0\xc5\x98\xbf\x2e\xb7\x54\x05\xc0\x95\xe9\x2c\x1d\x55\x74\xba\x67\x12\x9e\x52\x7a\x2f\x48\x69\xe1\x2f\xf8\xba\x6a\x8c\x41\x0e\x70\x81\xc1\x2b\x25\x6d\x0a\xc8\xd5\x9c\xdb\x4f\xfc\xa9\xc2\x20\x43\x20\x59\x60\x26\x40\x5e\x84\x2c\xd5\x21\xda\xf7\xa7\x92\xb6\x42\x52\x64\xab\x9e\xe1\x54\x66\x05\x8e\x51\xfd\x43\xef\xff\xbe\x3f\x3d\x85\x6a\x21\x6c\xef\x7c\x54\x4e\xa1\xf8\x99\xe4\x81\x2e\x1a\x57\xfc\xe4\xd1\xc2\xe2\x72\xac\x0a\xcc\x4a\x30\x93\x93\x83\xad\x83\x2e\x27\x30\x34\x8e\xcf\xff\x4b\x17\xa8\x63\x87\x48\x7d\x57\x21\xba\x84\x7e\x20\xad\xea\xac\x90\x36\x0f\xdf\x56\x26\xfe\xb4\xe2\xb2\x4e\xe0\x7f\x60\xb0\x18\xe4\xa3\x4c\xc6\x9e\x9d\xf2\xc2\x40\x76\xb4\x11\x14\xf1\x05\xa3\x74\x96\x80\x62\x98\x33\xaa\x30\x45\x69\x16\x12\x7a\xa0\x5c\x1c\x07\xc8\x1f\x82\x43\x50\x92\x84\x17\xfe\x58\xdb\x67\x15\x98\x64\x40\xc0\xcd\x9f\x2e\x8b\x30\x38\xd3\x51\xcc\xbb\xb4\x32\xc8\x0d\x00\xd4\x3a\xe2\x8b\xd3\x41\x86\xab\x4b\xe4\x4f\x37\x08\x8f\x99\x62\xd9\x52\x63\xe8\x8f\x53\x2c\x5f\xab\x31\x37\xa7\xb9\x63\x2a\x12\x81\xeb\x6d\x96\x07\x41\xa6\x05\x26\x5c\x5a\xaf\x8e\x63\xff\x2b\x63\x38\x7f\x80\x9a\x25\x86\xb1\xbc\x4a\x00\x29\x04\x26\x75\x12\x2a\xaa\x3d\xd4\xd6\xba\x7b\x94\x54\x5c\xdc\x1a\x06\x24\x5f\x6a\xba\x32\x49\x1d\xe9\x31\x34\xd4\x1b\x2e\x2d\xa5\x94\x99\x92\x83\x69\xa0\x9f\x75\x1b\x10\xc7\x87\x69\xbf\x9f\x75\x9b\xe0\x75\xba\x56\xe6\x4e\x8c\x05\xd8\xc4\x59\x16\x46\xe7\x76\x47\x5e\xe0\x4b\x81\x94\xda\x14\x6d\x08\x17\xb5\x57\x46\x89\x3c\xde\xb3\xb0\x07\x72\x45\x28\x62\x8d\xb2\x7e\x13\x94\xd7\x1f\xaf\xf1\x1e\xaf\xf1\xfe\xde\xd7\x78\x3c\x7a\x2f\x3b\xb0\x2c\x1e\xc1\x77\xbe\xf9\xab\x23\xa9\x87\x96\xd3\x63\x11\x43\x59\xbe\xa4\xeb\xec\x5c\xb0\x19\x04\x29\x0c\x9d\xd8\xdc\xfc\x08\x34\x52\x29\x9a\x51\xe9\x8b\xf9\xb8\x79\x44\xf6\x0a\x33\x08\x8b\x87\x20\xd9\x01\xdd\x53\xe9\x66\xff\xf4\xa9\x7c\x34\x60\x47\xb3\xa7\xba\x7e\x88\xec\x9a\x4f\xd9\x45\x95\x54\x4e\xe2\x55\x34\x2c\x0f\xf7\x9c\x23\xe5\xe2\x88\xf9\x57\x29\x1c\x8d\x89\x4d\x64\xec\x2d\x4a\x46\x97\x4c\x44\xb7\x6d\xde\xd3\xd4\xb2\x59\xd8\xec\x6f\xf8\x9f\xba\x6f\xe9\xdb\x93\x4b\x6b\x29\x2c\x02\x79\xdc\x21\x40\xf9\xc7\x1f\x01\x77\xaa\x93\x0a\xa3\x73\xe0\xc6\x55\x05\x22\xbf\xb0\x98\x97\xc3\x94\x42\x94\x9d\x92\x6f\xdb\x49\x21\x0c\x8d\xfd\x14\x9a\x39\xce\xc8\x64\xff\xb0\xb1\x21\x06\xda\x18\x94\xd5\x55\x9a\xca\x5b\x21\x21\x58\x5a\x59\x32\x23\x22\x5a\x92\x66\x28\x8d\xa9\x1d\xe3\x74\x0a\xac\x1a\x8e\xc9\x7e\x74\x9d\x91\xb3\xbd\x87\xfa\x78\x48\x16\x3c\x5d\xd2\xfc\x8e\x14\x7a\x4f\xf5\x89\xda\x5f\x38\xac\xfc\x60\xc1\xf2\xc7\x1f\x91\x6d\xa4\xab\x46\x7d\x64\x5e\x2c\x10\x54\xab\xaf\xdc\x9d\x8b\x29\x5f\x8c\xf0\x55\x86\x7a\x87\x1f\xd1\xe0\x7a\x30\xc6\x9e\xe8\x16\x0c\xab\xd8\x4c\x00\x73\xe8\x22\xb3\x41\x9a\x26\xf1\x80\xf0\xa4\x94\x8e\x86\xd1\x8a\x74\xc2\x15\xcb\xc0\x46\x7f\x16\xc4\xd9\xd4\xeb\xa5\x6e\x3c\x54\xb3\xf5\xc7\x32\x6c\x74\x6a\x5d\xa2\x96\x64\xcc\xf5\x54\x00\xd0\xcd\xd0\x24\xb5\x6a\xc5\xb4\x63\x72\xe4\xeb\xd5\x2d\x99\x6e\xbc\x5c\xa6\xde\x0b\xbc\x7c\xd9\xb3\xf7\xb2\x3a\x88\x1e\xff\x81\x53\x90\x5e\x72\x3b\xc7\x3d\x6b\xfa\x50\x67\x4e\xd0\x62\x93\x77\x4d\x9e\x43\xb2\xda\x68\x8f\xc8\xc5\x92\x9e\x69\x89\x72\xd5\xa5\x25\xa9\x9c\x58\x7f\xd2\xa1\x1d\x3e\xf8\x41\x20\x6c\xb3\xa4\xfc\xa4\xe2\xbb\x3e\x3d\xd2\x39\x40\x62\x99\xdc\xf8\x0a\xde\x4b\xb6\xde\x54\x40\x13\x23\x21\x5b\xea\xe6\xed\x16\x5a\x1c\x86\xc3\xfc\x95\xaa\x60\xca\x59\x0a\x28\x08\x34\xe4\x4b\x21\x21\xcf\xa2\x5b\x42\x35\x08\x4c\x68\x8f\x2b\xd2\x1c\x54\x0b\x46\xdb\x2a\xa5\x0a\x84\xdc\x06\x68\x44\xf6\x56\x33\x55\x10\x59\xf6\x31\x97\xea\xa3\x2c\xfb\x37\x97\x65\x73\x93\x34\x9e\x57\xf8\xbe\x5c\x6c\xf7\xfa\x7e\xa4\x0a\xaf\x61\xdf\x17\x9e\xb3\xf8\x8a\x2a\x9e\x8b\x3c\x6f\x8f\x27\x7e\x92\x6d\xb3\x82\xb9\xd7\xac\xf3\x92\x0b\x94\x44\xd0\x2c\xef\x8b\xa6\xbd\x96\x5e\x8b\xeb\xac\xe3\x2c\x09\xa3\xf3\x1b\xf0\x4c\xb1\xbd\x27\xc2\x6f\xdf\x8f\xe4\x4f\x3f\xfb\xe3\x19\xbe\x41\x17\xe4\x1f\x76\xb1\x41\x20\x0f\x71\x82\xe7\xdc\x75\x7a\xaa\x9d\x00\x84\x98\x61\x38\xa9\x52\xee\xc4\x03\x84\x88\xec\xed\xd1\x86\xcc\x0d\x0c\x74\x68\x74\x90\x21\x27\x76\xdf\x8f\x2a\xc2\x6d\x00\x14\x32\xe4\x2b\xd7\xdf\x54\x2c\x46\x40\xa4\x1a\x24\x78\xa8\x3c\x0f\xa9\x2e\x86\x02\x64\x4e\xb6\xcf\x4d\x3d\x30\x83\xb8\x15\x26\x44\xd0\xb2\x79\xb3\xf0\xfe\x9c\xc4\xcc\x2d\x57\xea\x0c\xd4\x66\xc0\x2b\x55\xb3\x63\xa6\xea\xdd\x26\xb1\x82\x6b\x83\xf0\x70\xc6\x93\x15\xc9\x15\xb8\x52\x05\xf8\x0c\xcf\x28\xcd\xc2\x6c\x46\xe5\x2a\xd3\x6e\x2b\xc0\xd3\x38\x0d\x33\x09\x3f\x06\x55\x20\x06\x50\x06\xe3\x10\x47\x99\x6e\x49\x51\xb6\x59\xc3\x42\x82\xb5\x61\x19\xb9\x05\xf1\x31\xc7\x8d\xcb\x44\xce\x05\x22\x68\x6d\x38\x8b\x02\x30\x62\x1c\xe0\x24\xf3\x43\x31\xe3\x8e\xf5\x22\xe6\x72\xb1\x85\xf3\xe0\x6b\x46\xe0\xb5\xf8\xe2\x61\x63\x8e\x27\x2b\x5a\x6e\x2e\x49\x92\x15\xbe\xe6\x59\x9c\xcb\xaf\x04\x72\x97\xc2\x97\x28\x72\x3c\xc3\x5d\xfa\x0f\x17\x6a\xb5\x14\xf4\xce\x39\x61\xb3\x9e\x4f\x09\x84\xa0\x0f\x07\x88\x33\x3e\xc4\x19\x22\xaa\x4c\x66\x69\x06\x1b\x1b\x9e\xe0\x28\x13\x04\xd3\xbf\xce\x70\xda\x6c\x54\x99\xe8\xfd\x43\x55\x9b\x46\x56\xee\xde\x27\x2f\x35\x66\x8f\x57\xa7\x34\x8a\x66\x51\xf8\xef\x19\x46\x61\x80\xa3\x2c\x1c\x86\x2a\xe3\x2d\x35\xd3\x7c\x74\xe6\xcf\x2f\xb4\x68\x67\x92\x3e\xec\x31\xd2\x8e\xf3\x4a\x23\x01\x3e\xc2\x15\xbf\x1f\x56\x57\xfc\x8c\xb0\xd1\x15\x3e\xba\x1c\xf2\x1f\x77\x25\x01\x43\x2e\xe5\x63\x68\x0d\x58\x60\x6e\x7e\xcf\xba\x4d\x22\xa6\x12\x26\xf0\xac\xdb\x6c\xde\x9c\x79\xed\x52\xe9\x9b\x99\x66\xb6\x5d\x2a\xaf\xda\x2b\x59\x81\x1e\x13\x59\x62\xe8\x0f\xb2\x38\xb9\xf6\xa8\x32\x98\x8c\xeb\x13\xc2\x9a\x89\x58\x1f\x0f\x91\xe8\xcd\xc6\x06\x7a\x46\x63\x27\x3d\x83\x32\x4f\x56\x57\x51\x2f\x9e\x4c\xe2\xe8\x9f\xc7\x4f\x9f\x3c\x31\x3a\x9f\xff\x62\x0d\x70\x9c\x2a\xcf\xc8\x30\x24\xf8\x59\xd5\x43\xd2\x2b\x1c\x0d\x96\xfb\x7e\x8a\x3b\x2d\xed\xc3\x24\x68\xeb\x45\x2f\xa6\x5f\x82\xa1\xf6\x72\x10\x4e\x47\x38\x59\xa6\x90\xab\xaf\x9e\x3e\xb9\x79\xfa\x04\x8f\x53\x8c\xa4\xce\x50\x65\x37\xed\x0b\x1f\x86\x67\xe8\xc7\x1f\xd9\x87\x15\x7f\x12\x88\xbe\x6d\xee\x6f\x3d\x7d\xf2\x84\x7e\xa8\x9c\x72\x9c\x3d\xa4\xa2\x0a\xcf\x04\x43\xfa\x81\x22\x06\xbf\x65\x7c\xce\xc4\x28\xcb\x88\xb1\x86\x68\xe4\x0a\x54\xe9\x27\xf1\x65\x8a\x93\xea\xd3\x27\x4f\xc4\x88\xc5\x71\xb6\xd2\x4b\xae\xa7\x59\xfc\xcf\x63\x5a\xf5\x06\x4e\x4a\xf2\xae\x23\xbe\xa3\x3f\x9e\x3e\x7d\x52\x51\x8f\x5e\x4f\x10\xd5\x76\x1c\x8f\xe2\x24\x1b\xcc\xb2\x94\xbe\x21\xab\xa6\x87\x36\x10\xaf\xfb\x4a\x7a\xfd\x69\x1c\xf6\xc9\xa7\x95\x71\xd8\x97\xde\x83\x22\xab\x07\x9d\x22\x5f\x49\xa9\x15\xe9\x9d\x02\xc1\x1f\x9f\xc7\x00\x82\xfc\x78\xf5\x54\x60\xf1\x3e\x8e\xbf\xcc\xa6\x28\xf3\xfb\x63\x2c\x61\x72\xfc\xe6\xe0\x57\x76\xbe\x13\xef\xf6\x3e\xfc\xfc\xc9\xf6\xfe\xf8\xe3\x9b\x4f\xfb\x7b\xbf\x7e\xaa\xb9\x3e\xd4\x5d\x1f\x1a\xae\x0f\x4d\x6b\xdb\xae\x76\xe4\x8f\x46\x5b\xf2\x47\xa3\x3d\xf9\x23\x6f\x53\x0c\x4d\x2f\x9e\x4c\xc9\xa1\x70\x6c\x0e\x91\x6d\x4a\xb5\x5a\x41\x3c\xeb\x13\x11\x9f\xd4\xca\x0b\x00\x83\x95\xb1\x40\xb2\x81\x41\x08\x81\xff\x50\x88\x5e\xa3\x46\xbb\xf3\x0a\x85\x4b\x4b\x0a\x78\x21\x12\xa2\xd7\xa8\xde\x58\x37\xbe\x91\xbf\xe0\x34\x3c\x43\x1b\x04\xc6\x6b\x54\x7f\xa5\x7e\xa7\xb7\xa0\x05\xb5\x2a\xb4\x5a\x15\xfd\x86\x6a\x57\xf5\x7a\x5f\xaf\x9f\x3f\xde\x3c\x55\x7a\xfd\x8b\x3f\xfe\x82\xde\xee\x54\x1a\xbf\xad\x57\xd5\xde\x5e\xd1\x60\x86\xea\xbb\x50\x7b\xb9\xd0\x08\x48\x83\x9c\xf6\xe3\x2b\xf5\x23\xd8\x08\x90\x36\xaf\x42\xf4\x1b\xaa\x5c\xe5\x1d\x62\xbf\x1b\xd2\xef\xa6\xf4\xbb\x55\xd5\x3a\x0b\x50\x2a\xe9\x15\xfa\xe9\xa7\x9f\xd0\x3a\x94\x4c\xaf\xd0\x8f\xa8\x76\x35\x1c\xd2\x01\xea\x34\xb5\x2a\x64\x75\x9c\x5e\x91\x81\x4c\xaf\xb4\x4f\x7c\xf1\x9c\xa6\xf0\xfd\xea\xd5\x53\x67\xa7\x26\xb3\x71\x16\x4e\xc7\xe1\x00\x34\x02\x66\xf7\xae\x08\x19\x07\xa7\x57\x67\xaf\x2c\xdf\x5a\xf4\x5b\xc3\xfa\x71\x9d\x7e\x6c\x9d\x15\xb4\x9e\xce\xfa\x08\xa4\x1b\x0f\x4d\xc2\x2b\x34\x88\xc7\xb3\x49\x94\x2a\xd4\x2f\xc3\x24\x72\x42\x25\x80\x5e\xbd\x20\x34\x53\xab\xf3\x91\x62\x8f\xb5\x7a\xad\xa6\x0f\xad\x58\xc9\x74\xb0\x2a\x19\x4c\x4c\xab\x8a\xbe\x92\xdf\x74\xbc\x1d\x55\xea\x72\x95\x7a\x47\xaa\x52\xef\xb8\xea\x34\xe4\x3a\xeb\x55\x94\xd7\x69\x18\xb3\x2e\xb8\x01\xad\x93\x15\x8c\x54\x18\x5d\xc8\xa3\x45\x1e\x4b\x8f\xd8\xd5\xba\x34\x3e\x8c\x3c\x5b\xec\x55\x8d\xbf\x68\x28\x43\x5a\x38\xa2\x0a\x7f\x64\x34\x56\x66\x58\x15\xd6\xa9\xd4\x9b\x33\xb6\x0a\x5b\x55\x2a\xce\x19\x60\x85\xe5\xb2\x8a\x45\xa3\x0c\x17\x01\xa0\xf3\xc5\x89\xc9\x09\x7f\xb8\xb2\x32\x41\xc6\x00\x36\x16\xe0\x80\x50\xa5\x81\x7e\x43\xc1\x29\xf9\xdf\xd5\x3a\xfa\x0d\x5d\x35\xce\xce\xf4\x85\x04\x65\x43\xf4\xdb\x06\x14\xbc\x0a\x8d\x02\x0a\x93\x84\x9f\x37\x70\x92\x15\xfb\xca\x61\x82\x07\xb4\x73\x01\x3a\x1a\xc4\x11\xdb\x60\xf2\x5d\xe9\xa8\x77\xf0\x81\xec\x11\xb5\xab\x5a\xcd\x43\xb5\xab\x5a\x1d\xfe\xdb\x80\xff\xb6\xe0\xbf\xeb\x1e\xd0\x02\xf9\x6f\x03\xfe\xdb\x82\xff\xae\xc3\x7f\xeb\x7d\xf2\xdf\x66\x27\xdf\xcc\x5e\xbc\x60\x48\xbd\x40\x9b\xdb\xc7\x34\x74\x3a\xa2\xe2\x10\x22\x02\x41\x12\x66\xa3\xc9\x0a\x2f\xb3\x9a\xa3\x42\x4a\x6f\x30\xf1\x61\x85\x3e\x48\x12\xc6\x0a\xbe\xca\xa8\xa7\xbf\xe8\xf2\xa7\x20\x3e\xc2\x29\xce\xba\xc8\xb1\x45\xb2\x41\x38\xfe\x12\x4e\x99\xc1\x6e\x3c\x44\xd1\x51\x0c\x67\xb1\x91\x9f\xa2\x3e\xc6\x11\x18\xf5\xb3\xbb\x2a\x3f\x0a\xc0\xf2\x2e\x08\x03\x14\xc5\x19\xb3\x9e\x34\x49\x81\xe6\x5d\xe1\x90\xb8\x95\xe7\xa7\x2f\xf8\xfa\x30\x09\xe3\xe4\x88\x1a\xee\x6e\x6c\xe4\xef\xad\xa4\xc3\x2d\xba\xb4\x39\x35\x3b\xa0\x8a\x6f\xfc\x8f\xdb\x09\x6e\xd8\x9b\xcf\xdf\x5a\xf8\xf3\x17\x7c\xfd\x4b\x9c\x80\xed\xe1\x17\x7c\xbd\x72\x49\x7e\xdb\x8b\x1d\x87\xbf\x63\x56\x2a\x0d\xcf\xdf\x10\x06\x84\x56\x51\xab\x68\x19\x09\xf3\xfd\x04\x06\xc8\x04\xcb\x47\x8e\xe3\x98\x3f\xf3\x06\x97\x50\xa7\x54\x0b\xa4\xff\xe9\x60\x84\xc9\xf1\x03\x11\x11\xda\xd2\x87\xf4\x28\xbe\x24\xb0\x2b\xbc\x99\x25\xb2\x4b\xbf\x28\xec\x83\x0c\xd7\x3e\x2c\xbc\x51\x69\x9c\xa5\x77\xa7\xfa\x52\xcd\x2d\x3b\x09\x3a\x54\xf4\xa0\x3f\x5f\x33\x0c\xd9\xb3\x45\x0a\x41\x8c\xec\x44\x79\x3a\x48\xd6\x72\xe4\x4f\x42\xe5\x14\xea\x9c\xd1\x91\x85\x19\x67\x6f\x2c\xac\xc6\xcd\xb0\x90\xb4\x9f\x18\xc0\x21\xf2\x8d\x3e\x94\x32\xda\x3f\x30\xc4\xff\x21\x10\x77\x62\xce\x66\xe1\x28\xce\x10\x21\x49\x77\xa1\x4c\xde\x03\xd4\x2d\xa0\x10\xf2\xf1\xac\x5f\x06\x32\x88\x4f\x1c\xe6\x99\xb4\xb7\xc1\x87\x7c\xa7\x62\x32\xda\x99\xb4\x8b\xc9\x25\xd6\x95\x02\x80\x29\x83\xcc\x5e\xcf\xc1\x76\x3f\xbc\x02\xb6\x5d\x84\xed\x6f\x1b\xc0\xc4\x4f\xd9\x20\xaf\xe6\xd4\xf1\x15\xd5\x18\xea\x96\xc9\x46\xf9\x84\x03\x69\xb1\x75\xf7\x13\xea\x10\x7e\xa6\x4d\x18\xda\xd8\x40\xad\x79\x93\xf6\xdd\x0d\xad\xbd\xcf\x8e\x11\x77\xad\x19\x83\xd6\xd9\x90\x9c\xa1\xdf\x88\x2c\x61\x2e\xa2\xb9\xdc\x5c\x96\xe9\x8a\xd9\x4c\x18\x5d\xbc\xb3\x70\x1a\xe3\xb5\x9b\xd9\x90\xa2\x39\xbf\x11\x4f\x39\xcb\xe1\xaf\x1c\x5c\x47\x66\x58\x8c\x8f\x2e\x8b\x3a\x36\xe2\x85\x23\x23\x6f\xe6\x1f\x05\x44\xe3\x64\x27\xf7\xcb\x99\x5a\x56\x70\xf3\x10\x7f\x8d\x5a\xe0\x7f\x42\x1f\x8a\x68\x5f\x9d\x8b\x53\x0e\x81\x49\x9a\x0b\x76\xa4\x00\x98\x2a\x74\xab\x6b\x88\x90\xa2\x2a\x5c\x3b\x96\xd2\x19\xfa\xcd\xbd\x38\x1d\x7f\xaa\xf0\x6d\x5f\x81\x3a\x02\xcd\x53\x75\x29\xda\xe7\xc0\x29\xc9\x7a\xd2\xf4\xe0\x68\x90\x5c\x4f\xa9\x55\xab\x2c\xe7\xed\x7b\x28\x1e\x0e\x53\x9c\x19\x33\x43\xd7\x48\x10\xf7\x44\xbd\xbc\xb0\x67\xee\xd5\x5e\x7e\x42\xcc\x7f\xd6\xf3\x9f\x8d\xfc\x67\xd3\x03\x16\x23\x9f\x32\x14\x5c\x03\xbc\x28\xae\x84\x6b\x5e\xfa\x53\xd4\x88\x02\x90\x3d\x5b\xd9\xc8\x21\xc4\x10\xfa\xde\x3f\xa5\x60\x88\xfc\xa2\x0f\xa9\xf2\x4d\x2d\xdb\x2c\x28\xdb\xb4\x1e\x89\xca\x0c\xa1\x4a\xab\x9e\x4a\xa0\xea\x63\x5d\x7d\x6c\xa8\x8f\x4d\x4f\x28\x2c\x8c\xcd\x7b\x75\x15\xed\x91\x93\xef\x77\x31\x46\xf6\x49\x57\x86\xc9\x3a\xeb\x1e\xba\x1b\xb9\xd9\x88\x86\x1d\x08\x4a\x4b\xd6\x96\x81\x7d\x8b\x59\x5c\x4f\xb8\x88\xa4\xa2\x3a\xc1\xd4\xa2\xe3\xaa\x49\x83\x75\x06\xaf\x7f\x53\x98\x6d\xcd\xa6\x01\x4a\xeb\xfa\x74\x68\xb5\x8c\xf9\x81\x5a\x0d\xb5\x56\x43\xaf\x65\xd5\x36\xa5\x4d\x7d\x3a\xb5\x5a\x4d\x9b\x1a\xea\x9d\x76\x76\xb0\x1f\xfd\xe5\x2d\xd0\x76\x62\x38\xb2\x9c\x71\xc4\xfe\x4b\x47\x75\x03\xd5\x5f\xb1\x9f\xaf\xf9\x0c\xb1\x17\x8e\x7d\x17\xe6\x38\x1c\x66\x40\xe9\x9e\x43\x51\x56\x38\x71\x1c\xf5\x8c\x4c\x9e\xa4\xae\xa9\x09\xc9\xeb\x37\x49\xd1\x55\x49\xeb\x86\xdc\xf5\x9b\xa4\xd4\xaa\xa4\x0d\x5d\xea\xfa\x4d\xd2\x5f\xa5\x4d\xe9\xb5\xb1\x0d\x2f\x2d\xd9\x36\x00\x40\xae\xae\x22\x57\x77\x20\xd7\x98\x83\x5c\xb3\x10\xb9\xda\x2d\x91\x6b\xa8\xc8\x35\x1c\xc8\x35\xe7\x20\x57\x2b\x44\xae\x7e\x4b\xe4\x9a\x2a\x72\x4d\x07\x72\xb5\x39\xc8\xd5\x0b\x91\x6b\xcc\x45\xce\x4a\xba\x1f\xa7\x60\x30\x94\x66\x7e\x86\xcd\x02\xc0\x4e\xb2\x9a\xa5\x63\xc0\x32\x32\x5d\x8f\x06\x5f\xc8\x5c\x64\x0d\xdb\x17\x32\x10\x99\xae\x1d\xb7\x2a\x51\xac\xeb\x69\x0e\xef\x83\xe5\x53\xa1\x27\x0f\x69\xed\xe8\xa7\x16\xcb\xf2\xd1\x8f\x2d\xe6\x0a\x52\xce\x2d\xf9\x12\xaa\x96\xa3\x04\xb1\x7e\x38\x76\x75\x37\x76\xe6\xfa\x31\xb0\x33\x96\x90\x8a\x5d\xed\x36\xd8\x35\x24\xec\x1a\x6e\xec\xcc\x05\x64\x60\x67\xac\x21\x15\xbb\xfa\x6d\xb0\x6b\x4a\xd8\x35\xdd\xd8\x99\x2b\xc8\xc0\xce\x58\x44\x2a\x76\x8d\xf9\xd8\x99\xd4\x8a\x79\x10\x6a\xbb\x5c\x42\xb7\x61\xcb\x3a\xd2\x85\x1c\x63\x39\xa9\x9b\xab\x65\x55\x19\xa2\x4f\xd3\x25\xfb\xb0\xa3\x70\x17\x35\xda\x9d\xd5\x66\x83\x69\xa0\xab\x36\x55\x30\x97\x58\x84\x80\x94\x32\x9f\x5f\xa6\x1a\x7e\x9e\xb2\xd4\x4c\x08\xb2\x6d\x0f\xfd\x01\x16\x3a\x62\x01\xe4\xbf\xf1\x95\x3f\x99\x8a\x93\x72\xfe\x81\xcf\x29\x85\x95\xe1\xab\x4c\xba\xdd\x5e\xd9\xdc\x3e\x5e\x61\xe7\x88\xca\x84\x5b\x9f\x7f\xc1\xd7\x1e\x1a\x0c\xcf\x85\x34\x9f\x43\x99\x8e\x7d\x82\xc4\x55\x86\x74\x28\x4c\xc2\xaf\xe4\xed\xd8\x00\x31\x9d\x76\xcf\xa2\xc4\xfe\x44\x23\x9c\xee\xe2\xf1\x14\x27\x95\xcd\x6d\x7a\xad\x4f\x75\xf6\x4f\x9f\x30\x93\x15\xb9\xc9\x57\x4f\x9f\x42\xb4\x5a\x30\x20\x51\xac\x0a\xba\xed\x86\xc7\xed\x12\xba\x6d\xb0\x1d\x91\x2c\x13\xba\xed\x96\x97\x9b\x24\x74\xdb\xe0\x7e\x38\x09\xda\xcf\xba\x9d\xfa\xcd\x99\xd7\x6e\xdc\xc9\x5a\xe4\x5b\x9a\x89\x3c\x98\x31\xc7\x37\x34\xcb\xa0\x2b\xe1\x05\x62\x06\x14\xa4\x79\x34\x88\x27\xd3\x38\x82\xf0\xe8\xe4\xdb\xea\xd3\x27\x62\xde\xc7\x61\x7f\x85\x15\xfd\xfa\x55\x36\x00\x10\x0e\x9b\xf7\x6c\xdc\xe1\xa7\x38\xb7\xea\xf0\x53\x2c\x7d\xfb\x25\x4e\x02\xf0\x28\x17\x05\xc4\x1b\x19\xc2\x6c\x08\xd6\x62\x40\xeb\x9b\xfc\x96\x27\x87\x69\xfd\xac\x60\x86\xc1\x2b\xaa\x47\x16\xaa\xf4\xfe\x63\x36\x5c\x07\x28\x38\x1a\xac\x90\x07\x0d\xeb\x4e\x4b\x7c\xa5\x8f\x45\x86\x28\xe2\xcb\xf6\xc5\xf4\xdd\xd6\x4e\x7e\xd9\x44\x9f\xad\x37\x58\xfd\x94\x1a\xe7\x91\x65\xc5\x6f\xb1\x32\x3c\x99\x8e\xfd\xcc\xc6\xa0\x44\x40\xe8\x3f\x22\x16\x47\x87\x6b\x50\xc1\x81\x40\xf0\x3a\xd0\xfb\x85\xbf\xe3\x15\x1e\x0e\xb2\x8b\x5a\xa8\x52\x6f\xac\xa3\x7e\x98\xa5\xd5\x22\x80\xe1\x85\x05\xde\xde\xcf\xb7\x05\xf7\x69\xfb\x43\xef\xd3\xaf\x3b\x07\x47\xfb\x9f\xf6\x0f\xb6\xb6\xd1\x26\x44\x25\xc8\xfc\x28\x43\x09\x9e\x26\x38\xc5\x51\x16\x46\xe7\x5c\x11\x43\xc8\x70\x12\x07\x79\xdf\xad\x30\xb7\xb6\x4b\xc1\x64\xec\xd4\x80\x29\x5d\x0a\x6a\x26\x47\xe2\xd1\x4e\x51\x96\x4b\xc2\x7c\x36\x29\xba\x3d\x70\xd9\x9e\x25\x60\xf0\x20\xf2\x71\xc8\x45\x94\xe2\x52\xef\x04\xdd\x93\x39\x40\x27\x23\x4c\x46\x3d\x8b\xd1\x8c\xb9\x04\x10\x16\x80\x48\x61\x00\xad\x80\x5c\xcd\x1f\x06\xc3\xf3\x2e\x90\x2e\xc7\xb5\x2a\xef\xa8\x06\xb6\xb0\x5d\xa4\x14\x36\x23\xbf\x30\x72\x4d\x86\x0d\x7d\x6a\x8d\x29\xe1\x4e\x48\x8f\x20\xff\x05\x5f\xaf\x58\xcb\x72\xaf\xce\xc1\xf0\x1c\x55\x0e\xa0\x15\x7f\x5c\x85\x3a\x03\xdb\xe0\x95\x1c\x03\xb5\x2d\x1e\xf5\x93\x4e\xe8\x0d\x21\x11\xde\x3b\x42\x28\x83\xa2\x3e\x91\x73\x45\x38\x70\x7f\x57\xa5\x04\xb3\x00\x52\xa4\x05\x79\x8f\xe7\x57\xcf\x2b\x74\x9b\xde\xa6\xc3\x1c\x27\x15\x76\x79\x06\x43\xe8\xa1\x3f\x50\x78\xd1\x45\xe1\x45\xce\x1b\x6f\x14\xd3\x03\x65\xbe\x55\x48\x5d\x25\x9a\x13\x93\x1c\x74\x0d\x80\x9c\xe4\x83\xd6\x67\x37\xce\xea\x5a\xb5\xc8\x1e\xba\x84\x56\x92\x9e\x1c\x0b\xf1\x91\x9e\xee\x97\x9e\xb6\xf0\x7d\xd1\x93\x80\x74\x37\x7a\x52\xf9\xf4\x2d\xe8\x69\x2f\x0a\xb3\xd0\x1f\x87\xbf\xe3\x14\xf9\x28\xc2\x97\xe3\x6b\x86\x61\xc0\x86\x63\x3e\x2d\xf1\x5d\xe3\x6a\x18\x27\x93\xfd\x38\xc0\x68\x9b\xfa\xa5\x41\x4c\xe5\x9c\xd3\xc5\x89\x4c\xa7\x60\x5d\x0d\x2e\x7d\x9c\x6a\xc5\x26\x63\x27\xc3\xef\x8e\x64\xef\x8d\xac\x2a\xe6\x07\x1b\xa7\xb8\x25\xc1\x85\x51\xa8\x58\xd8\x88\x69\x92\xc8\xc5\xa2\xa2\xde\x9c\x4e\x09\x2d\xc0\x68\xf1\xc4\xd0\xa9\xe5\x9a\x81\x0c\xf1\x86\xf8\xc9\x37\x45\x4a\x83\xe6\xa9\x38\x23\x92\x33\x35\xac\x8f\x93\x09\x9d\x76\xdf\xa6\xbb\xa1\xf4\x9d\x93\xd4\x46\x4e\x5e\xaf\x6c\x25\xa9\x1d\x0d\xd8\xca\x58\xcf\xe2\x21\x25\x74\xea\x01\x60\xeb\x07\xd8\x17\x55\x4a\x2f\x1c\xb0\xd1\x51\xf9\x30\xc4\x61\x48\x45\x4b\xa0\x3d\xbb\x23\xf9\xb0\x25\x68\xe2\xa6\xcc\x70\x52\xc6\x88\x8a\x1a\x15\x05\x7e\xe6\xa3\x3e\xc8\x5e\x6a\x09\x87\x3c\x06\xa0\x69\x56\x0a\xee\xd9\xac\x03\x3e\xc4\x09\xcc\xe5\x20\x8e\x06\x09\xce\xf0\x32\x1b\x8e\x71\x7c\xae\x30\x65\xe9\x5e\xea\x68\xb1\xb1\x86\x58\x18\x80\x39\x75\x6e\x61\x3c\x05\x07\x12\x4b\xc1\xc1\x02\x9b\xde\xd7\x94\x39\xc2\x10\xa0\x4c\xd9\x49\x78\x03\x6f\x83\x35\x20\x81\x2f\xb1\x73\x49\xfc\x49\xc0\xa2\xf1\xae\x58\xa0\x81\x30\x3a\xbf\x07\x6e\x92\x77\x7e\x83\x93\x07\x83\x5f\x79\x4e\xda\x7c\xae\x92\x49\x99\x7a\x97\x1c\x73\x27\x85\xb1\x92\x5d\x2d\x38\x2b\x1d\x3a\x07\xf7\xc0\x51\x60\x9b\x7d\x1f\xbe\xc8\xd5\x6d\x34\x45\xdb\x43\xfe\x85\x1f\x8e\xfd\xfe\x18\x53\x33\xc4\xd4\xbd\x2d\x7e\xe2\x9d\x29\x4d\x55\x3b\x61\xc4\x36\xbe\xc2\x7d\x8a\xc1\x55\xf7\x99\x0f\x71\xc6\x3c\xa1\x69\xbc\x33\x0a\x29\xdf\x35\x50\x98\x22\x3c\x1c\xe2\x41\x16\x5e\xe0\xf1\x35\xf2\x51\x80\xd3\x2c\x99\xc1\xb3\x87\x12\xec\x07\xcb\x71\x34\xc0\xa5\xf6\x99\xb2\xd4\x0b\x68\x3c\x14\x0d\x53\xe0\x0f\x4d\xc9\x7c\x24\x2b\xe5\x89\x58\x54\x59\x94\xfa\x45\xc5\xf9\xe4\xcf\x8b\x96\xa7\xff\x9d\x7c\x2e\x66\x50\x48\x2d\x11\x0e\x0b\x01\xa0\xc2\xd5\xa2\x14\xb5\x5c\x94\x2c\xc0\x90\x21\x96\x11\x41\x95\x2d\x38\x1c\xb0\x30\x97\x9c\x53\xef\x48\x13\x62\x5d\x7c\x66\xed\xb9\xca\xe6\x7a\x63\x7d\xb5\xd9\x90\x3f\x51\x95\x88\xed\x8b\x26\x07\x75\x51\x5d\xf9\xaa\xca\xbf\x5d\xd4\x28\x73\x76\x4a\xad\xaa\x6c\x7f\xbe\x22\x1b\x39\xd7\x26\x3f\xb5\xb0\x91\x3e\x19\x61\x49\x28\x60\x49\xb1\x7c\x34\x02\xad\x31\x11\x32\x4b\x2c\x45\x2e\xc2\x6e\x46\x1c\x1f\x08\x26\xc0\x97\x35\x11\x9a\xd8\xba\xb6\x74\xe8\x1b\x1c\x96\x98\xb5\xb7\xa9\xf2\xd4\x74\xe4\x86\x6c\xeb\x5c\x65\x4a\xbd\xae\xd3\x6f\x8a\xfc\x89\x4f\x29\x1e\xe3\x41\x46\x1b\x3e\xce\x12\x3f\xc3\xe7\xd7\x15\x97\xb9\xb6\xa4\x7d\x06\x71\x71\x03\x3d\xa7\xac\xf4\xb9\xd3\x3c\x8c\xcd\xc6\xa1\x9f\xa6\x84\x4d\xbc\xf1\x53\x1c\x28\x1e\x73\xf2\x5f\xb1\x71\x18\x03\x75\x8c\x13\x38\x70\x91\x5d\xcd\x0d\xa9\x78\x91\xeb\x79\xf8\xd8\x7d\x46\x81\x8d\xba\x0b\x29\x46\x4e\x32\x63\x33\x6f\x58\x8a\xec\x46\xf3\x00\x5e\xf6\x79\x10\x17\x37\x14\x45\x0f\xb9\x2f\x70\xf4\x31\xf0\x1c\x96\x9e\x8c\xec\xbb\x46\xff\xb5\xfb\x9c\x3b\xa1\xad\xde\x14\x79\xa8\xf0\xc6\x48\xc7\xdc\x32\xa1\x3a\xdb\x96\xb9\x64\xa5\xca\x34\xbc\xf6\xab\x37\x55\x87\x9d\x66\x09\xf6\x27\xb7\x52\x65\x83\x0c\xc5\x94\xcf\xb2\x0d\x7e\xb3\xb1\xdc\x0f\xa9\xc1\xb6\x7a\xa2\xa1\xd2\x09\x44\x9f\x96\x34\xd3\x75\x54\x69\x36\x54\xc5\xb4\xa4\xf0\x3d\x06\xfc\x34\xb5\xaf\xfe\xb2\xc0\x23\x64\xc7\xb2\xd7\xda\x76\x58\x2e\x22\x4e\xfd\x04\x8e\x5b\x36\x01\xd1\xdc\xde\xe0\x78\x93\x5b\x57\x71\xa1\xf1\x87\x1f\x9e\x0f\xc7\xb3\x74\xf4\xbc\xdc\x36\x47\xa1\xb8\x36\x3a\x31\xcc\x5d\x54\x2f\x9a\x57\x38\xd7\x42\x06\xd2\xa9\x7c\x5b\x2a\x2b\xcf\x3f\x4d\xe8\xd9\xb7\xb7\xc2\x7e\xfc\x71\x33\x9f\x42\x14\x8f\x1d\xa8\x67\x50\x89\xd4\x86\x74\xbb\xc9\x0e\xda\x86\x73\x30\x7b\x2f\x2b\xbd\x8b\x14\xf4\xb2\x8a\x72\xc2\x53\x69\xe5\xf2\xf5\xc2\xbb\xe9\xa6\xda\x23\xab\x42\x50\xcf\x07\x93\x2b\xf8\x81\xaa\xbf\xc1\x7e\xc8\x67\x8a\x6f\x77\xa0\x87\xed\xbd\xe9\x19\xaa\x68\xce\x51\xc2\x0b\xea\xb5\x73\x1b\xcd\x73\x0e\xa3\x50\x57\x28\xea\x72\x45\x93\x54\xef\x56\x1a\x67\x31\x9d\xf9\x01\xe9\x3f\x73\x3a\x73\x4d\xf0\x82\xd3\x69\x55\xfc\x96\x9c\x4e\x51\xf7\x0e\xd3\x59\xa4\xf0\x2d\x77\x75\xf0\x4d\xa7\xf3\xce\xd3\x55\xb0\x04\xe6\xcc\x97\xae\x37\x2d\x98\x24\xba\x99\x08\x3d\xef\xc0\x26\xd6\x31\xab\xeb\x0b\xb4\x81\xc2\x0b\x79\xb6\x8a\xb6\x08\xb6\x63\xd2\x98\xd0\xbd\x91\x1f\x46\x90\xa9\xc4\x75\xd7\xfa\x06\xec\x06\x3e\xf1\xce\xa3\x0d\x77\xf0\x01\x5d\xc5\xa6\xec\x20\xa4\xae\x41\x0c\xd2\xd0\xe4\x8d\x69\xbb\x84\xb8\x13\x7d\x55\xc4\x51\xde\xf4\xf8\x76\xa0\x9d\x84\xa4\x26\x94\xb9\x23\xbd\x7a\xd3\xb3\xec\x3d\x26\x78\xda\xc4\xa1\x08\xed\x99\x71\x35\x06\xa5\x52\x3f\x63\x46\xdd\x2b\x7a\x1d\x03\x86\x46\xb3\x54\x3a\x12\x5a\x11\x26\x2c\xc5\x5c\x46\x42\x2a\x27\x44\xd6\x1b\x12\x66\x97\x45\x80\xb0\x9f\x97\x23\xcc\x82\xe6\x53\xfc\x20\x68\x67\x5a\x02\x39\x73\x61\xd8\x0b\x92\x3f\x98\x4a\x26\xea\x50\x6f\x00\x48\x66\x07\x5d\x10\xae\x0d\xba\x2c\x2b\x4f\x06\xca\x55\x80\x86\x99\xbc\x0a\xc5\x69\x0b\x6d\x75\x80\x45\xfa\x0d\x89\xbc\x90\x1c\x86\xb3\xb9\x10\x2b\x34\x39\xe2\x95\xc3\x9c\xf5\xd7\x83\x23\x38\x2f\x33\xa2\x33\xcb\x5c\xc5\x09\xf4\x2b\x57\x74\x7b\x48\xe9\x97\x97\x37\x6b\x13\xfa\x19\x1e\xb2\xaf\x4b\x45\x1f\x5d\x2b\x66\x47\x78\x82\x41\x0a\x87\xdd\x95\x92\x00\xbb\x8a\x82\xd3\x3e\x38\xb4\xc3\x6b\xb3\x3a\x97\x60\xf1\x05\x0f\x19\x4f\x99\x29\xcd\xfd\xce\xf1\x16\xa6\x80\xce\x0e\xc8\x9e\x3b\x73\xd7\x6d\x80\x4b\xac\x5b\xb1\x4f\x3d\xae\xdb\xc7\x75\x8b\x6e\xbf\x6e\xef\xb2\x3a\xc0\x42\x78\x14\xa6\x0b\xaf\x0d\x2b\x26\x8c\xa2\x81\x8b\xfc\x7a\x70\xe4\xe4\x00\xb2\x07\x99\xc1\x01\xee\xca\x76\xac\x98\x9d\xe4\x43\xd3\xc7\x83\x78\xc2\x96\x0e\x61\x0b\x61\x3c\x4b\xcb\x33\x0f\x31\x58\x65\xd9\x83\x20\x25\xde\x8d\x8a\x13\xf7\x85\x3c\xa0\x40\x44\xe2\xd2\x92\xcd\xc3\x7f\x14\xc7\x29\x46\x93\xf0\x8a\xc8\x42\x96\xfe\x81\x27\xa8\x29\xa4\x21\x99\x10\x99\x14\xe6\x22\xbb\xf8\x02\xa4\x53\x72\xd2\x49\x67\xfd\x14\xff\x7b\x86\xa3\xcc\xaa\x62\x40\xaa\x68\x27\x65\xe4\x50\x47\xd1\xa9\x1a\x94\x51\xd2\x66\x65\xbe\xaa\x9f\xec\x6c\x36\xac\x6c\x31\x92\xf2\xd5\x66\x8d\x94\x44\xfe\x60\x02\x73\xeb\xf1\xf0\x0c\xfd\xb6\x41\xeb\x9d\x86\x85\xa1\x4b\xf2\xdf\xdc\x04\xfa\x4d\x8f\x95\x57\x02\x9a\x48\xa2\xed\xa1\x1f\x04\x64\x02\xe7\x28\x40\xa6\x90\x9c\xaa\xb7\x42\xff\xb5\xab\x3f\x0e\xdf\xf5\x8e\xd1\xff\x69\xaf\xae\xa1\x29\x03\x9a\x32\x5d\x9e\x0d\xe6\xe1\x97\x41\xba\x06\x72\xf2\xd4\x0f\x56\xf8\x53\x81\x6c\x7c\xe8\xf3\xeb\xe7\x59\xca\xc3\xe0\x8b\x40\x28\xcc\x5c\x19\x62\x24\x0b\x3c\x16\xb2\xbf\x02\xc8\xf2\xed\x33\x41\xcb\x5a\xc9\xae\xc7\x63\x21\xa0\xa4\xfb\x48\x00\x94\x8a\x50\x96\x64\x50\x20\x98\xe5\x03\x1f\x9b\xc5\xe1\x4b\x8c\x2b\xf9\x95\x5f\xaf\x79\x5a\xdc\x2c\xe5\x82\xd9\x0f\xf4\xcb\xb5\x5b\x33\x10\x51\x8d\xc6\x3a\xd9\x90\xc6\xcb\x15\x33\x64\x16\x65\x82\x76\xc0\xaf\xc8\x84\x1a\x31\x82\x35\x80\xd2\x17\xcb\x34\x41\xb4\x88\xb0\xf2\x0f\xad\x80\xad\x59\x7a\x2f\xc4\xdb\x35\x43\x2f\xd0\x04\x6d\xf0\x95\xd0\x0b\x44\x40\x51\xb0\xc8\x7d\x5d\x8c\xf7\xcc\xc1\xc5\x78\x0f\x6e\x2d\xca\xdb\xb9\x98\x15\x22\x95\x16\x87\x2f\xc8\xd9\x8f\xda\x26\x0a\xd1\x92\xcb\x2d\x5f\x86\x4e\x43\xda\x4b\x6f\x0a\xa4\x57\x0d\x3b\xb4\x91\xdb\xbe\xf3\xc3\xbf\x0c\xda\x53\x51\xb2\x99\x21\x6c\x06\x81\x7d\x10\x60\xae\x07\x71\x34\xf0\x33\x0e\xb3\xb4\x06\xe6\x63\x34\x15\x0c\x05\x96\xec\xc8\x0f\x68\x20\x23\xb6\x50\xbf\x0d\x97\x99\x45\x3a\x9f\xf9\x26\x1c\x01\x9a\x2d\x71\xe5\x0e\xe5\x74\x96\x60\xe3\x03\x6f\x71\xa6\xe4\x1b\x96\x16\x31\xc4\x80\x45\x63\x3f\xcd\xe0\x79\xfe\x9a\xce\xc5\xeb\xd3\x8a\xba\x9c\x97\x51\xbd\x4a\x5d\xcc\xce\x98\x33\x98\xcd\x93\x98\x0a\x0e\x6e\x8a\xc9\xc1\x6d\xe8\x6b\x50\xda\x4c\xe9\xb6\xb9\xa0\x9e\xff\xcf\xb8\x08\xb2\xb9\x28\xd8\x6f\x16\x6c\xb7\x0a\x45\xf7\x40\xf7\x67\xf4\xbf\x1f\x07\xf8\x86\xaa\x07\x4f\xc4\x69\x8d\x5e\x8a\xc0\x49\x42\xea\x4e\xef\x4d\xcf\x05\x85\xcd\xd5\x8d\xa0\x2f\x02\x4b\x17\x36\x4c\x88\x40\xf2\x0e\x02\x07\x3f\x02\x36\x00\x92\xe1\xa4\x46\xe0\x04\x53\xc0\xcc\xd3\x4e\x75\xb4\x6d\xa3\x89\x1b\xc5\x1b\x61\x01\xc3\x40\x3a\xd1\xea\xc7\x9e\x64\x7d\x58\x6c\x03\x58\x10\xe0\x4c\xb5\x0f\xb5\xf8\x71\x82\xdc\x4c\x46\x40\x51\x8b\x22\x55\xb1\x4b\xbe\x4f\xc0\xf6\xd3\x81\x7f\x3e\xb1\xe6\x61\xc0\xb0\x25\xe5\x92\xb6\x6a\x5c\xe2\x3c\x31\x10\xa8\xb0\x25\x82\x46\x03\x4e\xe5\xda\xdd\x8c\x5d\xda\x5f\x7d\x51\xdc\xbc\x6a\xbd\x52\x45\x2f\x56\x17\xc6\x40\xa8\x5a\x1c\x67\x99\x77\x18\x4f\x91\x9f\xa1\x31\x26\x5c\x30\x8e\xf8\x0a\x60\x19\x3d\xa8\x25\x28\xec\xd7\xc0\x70\x4d\xbe\x85\xc4\xf9\x66\x12\x46\xd4\x48\x94\x1d\xe2\x8d\x70\x89\xea\x23\xab\x44\xa7\x4f\xc2\x9f\x12\xd2\x04\xec\x8f\xe9\x91\x37\xbc\x40\x3f\xfe\x68\xd5\xc7\xeb\x81\x3a\x0e\x6f\xa5\xcb\xc8\x31\x51\x95\x29\xce\xf3\xb9\xde\x6c\xd9\x2b\x69\xb7\x48\x9a\x8b\x24\xc2\x50\x9a\xbd\xb2\x10\x34\x6f\xee\x7e\x09\x79\x75\x95\x1c\x64\x68\xea\x2e\x97\xc8\x05\xf2\x3a\x33\xfd\x02\x09\x1c\x7e\xcf\xd5\x41\xf0\xab\x78\x6a\x23\xe8\x3a\x25\xdf\xea\x32\xfe\xe1\x96\xd5\xc3\xe2\x6d\x6d\x0f\x24\xbf\x39\x33\x40\xe5\x23\x5b\x7b\xf3\x2c\xff\xee\x68\xa9\x00\xa6\x77\x4c\xf6\xb0\x9b\xa1\xa0\x41\x3c\x1e\x63\x4a\xff\xf1\x90\x8b\x06\x20\x6a\x62\xc8\x83\x57\x24\x7a\x48\xa2\xa8\xe4\xe4\x4d\xb6\xd1\xc4\xbf\x94\x5e\x59\xfd\x12\xed\xae\x1f\xd4\x01\x5d\x08\x29\x65\x6a\xe7\x17\x8f\x90\xdf\x81\x71\x41\x5a\x9f\xac\x4f\xc3\x1c\xd7\x05\x28\xf5\xc7\x14\x7b\xf8\x01\xc0\x40\x25\xe9\xd3\xf0\xa3\x38\x09\x2f\xa8\xac\xc2\x39\x86\x15\x20\xbf\x4a\xcd\xe5\x7c\xc9\x72\xd0\x8c\xb5\x5a\x4e\xae\xb9\x4d\xcf\x8a\xe5\x9b\xc1\x08\x4f\x6e\x07\xd7\x2e\x70\x32\x95\x39\x58\x4c\x0f\x25\x78\x56\x10\x34\x9f\xe2\x4d\x9e\x6f\x91\x9e\x62\xa8\x88\xc5\xdf\xea\x62\xd8\x20\x8e\x2e\x70\x92\x29\x32\x2c\xcd\x6c\xc7\x8d\x29\xc1\xe2\x93\x5a\xff\xb9\xdd\x56\x0f\x69\x15\xd5\x79\x55\xbc\x2c\x69\x0f\x33\xdf\xc5\x4a\x45\x6d\xfe\xb1\x4e\x78\x37\xc9\xf8\x68\x76\xa2\x7e\x24\x12\x56\x4d\xe3\x34\x0d\xfb\x63\xec\x5e\xb1\x96\xa6\x16\x73\x6e\xca\x07\xca\xb4\x07\xa5\xdf\xf8\x09\xfc\x0f\x03\x0a\x12\xea\x73\xb2\x82\xbb\xd2\xef\xdc\xe1\xc9\x5a\xe9\x0b\xbe\xee\xaa\x7e\x51\xd6\x62\x9a\xa7\x94\xbd\x10\x59\xc6\x5d\xf8\xef\x9c\x82\x62\x55\x76\x4d\x77\x2e\x7b\x0d\x26\xc2\xeb\x96\x09\xf6\xc2\x42\xae\x57\x8f\xce\xef\x7a\xc7\x6b\xf6\x0a\x12\x0b\x6f\xd9\x4b\x88\x85\x23\x01\xa5\xef\x56\x0e\xa6\x38\x3a\x3e\x7e\x6f\x54\x2b\xef\x4c\x26\x4f\xbf\x5d\xf0\x9a\x84\x57\x7b\x91\x5a\xae\xb4\xe9\x11\x5d\xc5\xe9\x62\xcb\x18\x39\xd7\x8d\xc9\x4a\x34\xdf\x40\x07\x37\x21\x87\x3a\x37\x70\x6e\x60\xcb\xbd\x32\x60\x57\x80\xdf\xe1\x30\xd4\xd7\x78\x01\x1c\xc8\xf9\x95\xd2\x84\x5f\x90\x29\x0e\xe7\x5e\x94\x39\xc6\x51\x4c\xdf\x68\x0c\x90\xa5\xdb\xc7\x45\xdc\xa3\xec\x92\xa6\xc8\x8b\x6b\x3a\xb6\xb6\x97\xd0\xf3\xe7\x76\xdf\x0a\x6b\xf9\x95\x2c\xa6\xd9\x86\x5c\xae\x1c\x73\x6a\x39\x48\xd5\x49\x98\xbc\xa2\x4c\x9c\x62\x6c\x5c\x56\x55\x79\x09\xf4\xf5\x2b\x25\xd7\xbc\xce\x0a\x9f\xc4\x6b\x7e\xec\x35\x74\x34\x56\x39\x89\x52\xd9\xbc\x7b\x0d\xda\x0e\x5c\x6d\x88\x9f\xf6\xdb\x0d\xd6\x73\x1b\x71\xda\x40\xb3\xe2\x22\x0d\x31\xec\x5e\xea\x20\x16\x5f\x77\x88\x55\xe7\xbb\x97\x5c\xc4\x9b\x59\x1e\xc4\x93\xa9\x9f\xc1\xf6\x52\x76\x19\xca\xdb\x82\xb6\x89\x49\xe2\x4f\xd9\x3d\xd1\xb6\xfc\x6e\x83\xdc\x7d\x19\x0e\xc6\xb4\xed\x63\x4e\xde\x0e\x42\x96\xa8\xcb\xc5\x1b\x15\xfa\x16\xc5\x4b\x73\xdf\x39\x6a\x19\x39\xd2\x92\xb2\x04\xf3\x2f\xb6\x40\x8d\x44\xdc\xd5\x2a\x90\x77\xb6\x63\x2c\xf4\xd7\x3c\xc4\x92\xe2\x4e\x55\xcb\xa5\x14\xad\xc6\xd0\xde\x9f\xd6\xae\xda\xcd\x4e\xbd\x33\x58\x83\xc4\x06\x9d\x76\xa7\xd5\x1e\xb6\x87\x67\x55\xae\x8a\x07\xd0\xfc\x21\xef\x87\xe3\x1c\x59\x02\x05\xe7\x58\x38\x0e\x5f\xa2\x6e\xce\xc8\x68\x58\x9b\xc5\xf7\xbc\xa2\x35\x26\xfb\x2b\x2d\x2a\x3c\xf2\x75\x92\xd3\xe9\xad\x97\x8c\x1a\xb3\x81\x2f\xe8\x5b\xac\xe1\xfb\x0d\xe0\x60\x0a\xa3\xda\xd2\x9b\xfa\x49\x8a\x2b\xca\x42\x2d\xb8\x98\x4c\x52\x45\xf1\x93\x57\xb3\x7a\x25\x90\xe2\x88\xc6\xf0\x9a\xb3\xe8\x28\x61\x18\xc8\x14\xa9\x57\x8b\x20\xf2\xcb\x38\xe9\x30\xcc\x92\x42\x18\xe0\x4e\x70\x9a\x51\xdb\x06\x7f\x6c\x59\xa0\x1a\xcc\xd3\xda\x19\xda\xd8\x40\xf9\xda\x43\x3f\xfe\xa8\xb7\x7b\x5a\x67\x65\xf8\x9a\x74\xa9\xa0\xb6\xaf\xe8\x05\x86\xd9\x32\x52\x39\x8c\xb1\xf8\xb5\x16\x99\x29\x4f\xc3\x43\xad\x6a\x81\x75\x5d\x7c\xc1\x8e\xe8\x70\x15\x94\xc3\x30\xcb\x1b\xf0\xa7\xd0\x40\x4d\xbf\xb5\x36\x8a\x2b\xb7\x3a\xf5\x4e\x39\x46\x61\x3d\x1a\x39\x8e\x41\x9e\x74\x3a\x51\x45\xf3\xc2\xbb\x22\xbe\x08\x2f\x13\x7f\x3a\x05\x39\xd2\xcf\x58\xf3\xb2\xca\x04\xf9\x64\xa7\x4f\x25\xaf\xb4\xc2\xd5\xab\xb8\xfa\x18\xae\x6c\xb9\xc3\x8f\xed\x53\x59\x07\x92\x5b\x5f\xf6\x08\xa1\x87\xcb\xf8\x45\x52\x3d\xd7\x11\xc8\xbd\x65\x9d\xa5\x0e\xa1\x51\x40\xa9\x46\x1c\x30\xf2\x8b\x1d\xcb\xc1\xa9\x28\x44\x94\xee\xbd\x08\x08\x75\x0d\x51\x4d\x9a\xd8\xc2\xa0\x52\xec\xda\x81\xcc\x1b\xf3\xa6\xbb\x8b\x87\x6a\xae\x7c\xb2\x1c\x75\x0a\xbc\xcf\x59\xd3\xd4\x06\x85\xfd\xce\xfd\xce\xff\x22\x31\x5c\xec\x5b\xd8\xe6\x9f\xbb\x81\x91\x65\x69\xd7\xa8\x98\xcb\x4a\xf8\x57\x9a\xda\x08\xc5\xd5\xd2\x71\x0a\x7b\xb8\x06\xf3\x20\x35\xba\x3a\xe1\x9b\x36\xee\x89\xd5\xe6\x90\x06\x0a\x94\x1d\x16\xe7\x58\xb7\x17\xeb\xed\x42\xe8\x2c\x14\x3d\x67\xdb\x66\xbf\x2e\x45\x37\x88\x73\xe7\x13\x5b\x00\x34\xab\xcf\xaa\x21\x96\xe4\x9e\x19\x22\x40\x02\xeb\xec\x6d\x24\x93\x1e\xf4\x2f\x87\x09\x57\xc0\x06\x14\x66\x6f\x44\x38\xae\x70\xcc\x75\xed\x47\xe5\xb7\xd3\xa2\x4d\x5b\xd9\x5f\xcd\x82\x5c\xb5\x68\xf9\x44\xc8\x4a\xf4\x6d\x25\xbc\xb0\x14\x91\x74\x84\x8c\x5e\xcc\x32\x54\x2b\x98\x03\x82\x0b\x51\xb3\x98\xd0\x07\xe6\x25\xd9\x2b\x4b\x61\x49\x17\xa8\x5b\x58\x5b\x4a\x4b\x7a\x41\x42\x7a\x43\xcb\x71\xed\xa6\xf4\xb1\x85\xdd\x43\xa7\x62\xe2\x84\xe2\x4b\xbe\x96\x41\x0f\xb6\x3d\xc9\x04\x20\x76\x28\xed\xa2\x49\x7a\x84\xc4\xde\x7f\xc5\x7d\x4a\x0b\xd0\x22\x22\x1d\x7f\x83\xbd\x29\x8f\xaa\x3c\x9f\x4d\x73\xef\x79\x0b\x9b\xe6\x64\xc7\xc2\x28\x48\x1e\xf5\xb7\x66\xd9\xf7\x8d\xa2\xbe\x2f\xdd\xe3\x96\xe2\x8c\x5d\xe0\x88\x30\xf0\x0d\x76\x15\xa6\x71\x90\x54\x0b\xf2\x62\xd2\x00\xcb\x3b\x05\xbb\xfd\x86\xf3\xab\x8c\x7c\xce\x4d\x6c\xcd\x31\x4e\x61\x6e\x18\xf2\xe4\x29\x9b\x98\x12\x75\x91\x0e\x4b\xbe\x37\x49\x4c\x46\x51\xf8\x58\xb7\x09\xd1\xc4\xc2\xda\x18\x2b\x5b\xd3\xc7\x4a\xbd\x7f\x01\x1d\x93\x9f\xa6\xb3\x09\x0e\xd4\xfb\x44\x7f\x9c\x60\x3f\xb8\x96\xf6\x3b\xe5\x40\x36\x8b\x68\xda\xca\x12\x11\xcd\x16\x63\x7b\x76\xfe\xb5\xd0\xa1\x89\x30\x2e\x30\x51\x4f\x52\xbc\x30\xaf\x77\xeb\x8b\x66\xd1\xa2\xb0\xfe\x44\x89\xdb\x20\x79\xaa\x42\x3a\xe0\x54\x80\x04\xf1\xdb\x79\xc0\x27\x43\xa7\x24\xaf\x1e\x56\xd9\x96\xca\x9b\xc5\xae\x91\x17\xe1\x9c\x10\x36\xdc\x26\x84\xb2\x27\x73\xa9\xea\x17\x1b\xa8\x50\x3b\xca\xa0\x15\x28\x45\x0d\xcd\x84\xf5\x86\xe4\x9d\xdd\x44\x62\xde\x95\xc9\x97\x60\x08\xf7\x25\xf4\xdf\xe2\xcb\x92\x79\x56\x18\xe6\x85\xc9\x3b\x0a\x9d\xb4\x52\xee\x9e\x64\x8b\x80\x87\x3b\x7d\xd2\x18\x59\xcb\x7b\x3f\x73\x85\xc1\x94\xc5\x0b\x2a\xaf\x8e\xe5\x35\x98\xe5\x05\x7b\x00\x39\x85\x34\x03\x80\x8b\xbd\x42\xf2\x40\xe5\x98\xda\x56\x84\x11\xb3\xe4\x65\x76\x00\xcc\x64\xe6\x1c\x47\x60\xcc\x5b\x0c\x4d\x44\x29\x77\x00\xa3\xa1\xb3\x8b\x61\x99\x3a\x03\x50\x61\x49\x42\xd2\x26\xea\xb4\xc0\xe4\x18\x3e\x70\xfb\xd9\xbd\x21\x8a\x27\x21\x91\x11\x3c\xe4\xd3\x4f\x97\xe1\x78\x8c\xfa\x58\x34\x18\xa0\xc4\x8f\x82\x78\x32\xbe\xbe\xa7\xc3\x3d\xb5\x9a\x60\xc3\xe4\xa1\xbd\x9f\x3d\x98\x52\xd2\xf8\x37\xe0\x42\x74\x92\x03\x93\x05\x49\xd4\xb8\x82\xaf\xf0\x60\x96\xe1\xca\x73\x1e\x8d\xea\xb9\xc7\x12\x77\x78\xcc\x7c\xcb\x21\x16\xdd\x13\x74\x0f\x3d\x27\xc3\x41\xfe\xff\xb9\xfb\xcc\x4c\xc1\xc8\xdc\x8d\x53\xb3\xc7\x49\xd4\x63\xd4\x45\x15\x9b\x76\xa3\x7e\x3a\xcd\x6c\x96\x1d\x8a\xea\x1f\x9c\x57\x49\x86\x12\x99\xc2\xa9\x74\x5a\xab\x46\x5a\x73\x8b\x5b\x1d\x5d\xda\xd2\xba\x36\xa5\x15\x1a\x6f\x96\x26\x1e\xc8\x15\xb8\x22\xc6\x5d\x9e\x06\x99\x2d\xa4\x9b\xea\x0a\x4b\xe4\x2d\x8d\x07\xe0\x6f\x0d\x58\x4b\x68\x33\x2b\xc6\x00\xec\xa6\x0d\x35\xb9\x48\x06\xcd\x14\xe4\x3c\x99\x2c\x1f\x73\xf4\xc2\xd4\x67\x2b\xa9\xa1\xf3\x14\xce\x76\x67\xa9\x23\x26\x4a\x2d\x78\x18\xcf\x8f\xd4\x42\x8a\xbe\x9d\x56\xdb\xa6\x19\x50\x54\xdc\x01\xe3\xcb\x9c\xe5\x69\x2c\xd9\x13\xb0\x1c\xe2\xd7\xed\xf5\xe1\x96\x28\x71\x42\x21\x6e\xff\x66\xd3\x70\x3d\xa0\x7e\xfc\xdd\xd6\xce\x0d\x22\xdb\x27\xb7\xa0\xb4\xed\xc2\xb9\x94\xc7\x99\x6d\xf1\x16\xb7\x90\x56\xdc\xd2\x61\xb7\xf3\xc3\x97\x60\xd8\x95\xb6\x67\x89\x42\x16\x54\x8f\x33\x97\xaa\x45\xf6\xe5\xef\x43\x5f\x5e\x28\x1d\x7c\x07\xea\x88\xbf\x88\xda\xdc\xb2\xf8\x4a\x69\x92\x9f\xf3\xa1\x76\x85\x95\x7d\xf8\x86\x3d\xf4\xc7\x03\x6b\xb0\xf3\xed\xe8\x1b\x29\x1c\xb4\xdd\x35\xce\x5c\xca\x5d\x9b\xec\x42\xc0\x13\xb1\x85\x8b\x2b\x12\xf6\x74\x78\x85\x8c\xc1\x9e\xe9\xb6\xe7\xf2\xee\xa4\x62\x2c\xed\x9b\xd1\xa5\x15\xd8\x62\x15\x0c\x56\xac\x21\x09\x9c\x8a\x79\x45\x5f\xe2\xbe\xce\x90\x03\x40\x18\xf3\xa3\xb6\x2f\xe9\xf1\x0d\x34\xf6\xc3\x2b\x9a\x0c\x04\x2a\x58\x87\x54\x3a\x5b\x53\xc3\x4c\x05\xba\x4b\x6f\x62\x3d\xf1\xdd\x41\x1f\xfc\x27\xf0\xe3\x7b\x56\x10\x7f\xef\x8c\xf9\x7b\xd4\x13\xdb\x98\xe1\xa2\x8a\xe2\x3b\x31\xc6\x7b\x47\xd1\x54\x14\xdf\x17\xe3\x2e\xa9\x27\xfe\xe6\xbc\xfb\x9b\x2b\x8b\xbf\xfd\x56\xe1\x29\xb6\x3d\x8e\x13\xda\xfd\xed\x1d\xa5\xf4\xe1\xee\xfb\x0b\xdb\xd6\x21\x8f\x6f\xc9\xdd\xa3\x48\x41\x9e\xab\xf2\x44\xa6\x4b\x39\xa5\x25\xcb\x5f\x79\x73\xe6\xb5\x9b\xdf\x6b\x52\xca\x7b\xcf\x41\xb9\x68\xee\x49\x25\xe7\xa4\x81\x98\x99\x7e\x52\x4b\x3b\xc9\x2b\x3a\x12\x4f\x82\x7e\x34\x07\x2e\x7e\xaa\xc9\x27\xf7\xfd\x6c\xe4\x21\x4b\x0a\xca\xfc\x78\xfd\x3e\x1e\xf8\x63\x34\x8d\xc7\xd7\xc3\x70\x8c\xe2\x21\xa2\x9b\x16\x3b\xc5\x5b\x8e\xbc\x2c\xb6\xfd\x86\x5a\x50\x6b\x58\x61\x4c\xe2\xf5\x0e\x79\x7f\xf3\xca\x8c\x1d\x24\xd9\x5a\xf6\x3f\x1b\x4c\x0d\x6c\x04\x67\x7d\x32\x83\x3a\x11\xef\xac\x4c\x93\x38\x8b\xc9\x27\xb4\x41\x4e\x1f\x7a\x01\x56\x0f\x6d\xa0\x08\x5f\x12\x04\x8a\x21\x44\xb3\xf1\xd8\xb1\x50\x04\x06\xf9\x32\x91\xe2\x1d\xd9\x22\x79\xf2\x39\x29\x56\x72\x3b\x15\xdb\xef\xc3\x7e\xe2\x27\xd7\xf3\x74\xe4\x52\x7e\x50\x27\x28\xc8\x16\xca\xb4\x9e\x44\xb8\xe0\x5d\xf6\xc7\x28\x8c\x46\x38\x09\x95\x00\xae\x4a\x44\x07\x3d\xcf\xa8\x19\x61\xd4\x9c\xce\x12\x61\xff\x78\x8c\x61\x70\x8f\x13\x7e\x06\x23\x3f\xe3\x08\xb1\x50\x1e\x54\x0c\x32\x4e\x95\x08\x15\xc5\x01\xe4\x72\x57\x7c\x81\x93\x24\x0c\x70\x8a\x0e\xa9\x42\x24\xc4\x29\x65\xe0\xd3\x6b\x14\x46\x2c\x9b\x71\x8e\x40\x89\x16\xf4\x5c\x0d\x27\x8b\x02\x30\x64\x2e\x47\xb9\x45\xa2\x06\x92\x89\xda\xbf\x3e\xa1\x24\xac\x48\x37\x05\x26\x89\xb2\xbf\x58\x88\xc7\x41\x17\x3d\x87\x4c\x59\xcf\x75\xc3\x11\x7b\x9b\xe4\x6f\x82\xb3\x51\x1c\x14\xfa\xc8\x4b\xa5\xf5\x18\xf9\x36\xc7\x33\x84\xcc\x70\x86\x14\x7d\xc5\x20\x9b\xcf\xab\x33\x88\xe1\xd4\xbf\x8c\xcc\x2f\x12\x23\x21\xc2\x42\x9e\x56\xcf\x65\x4e\xbc\x39\x3b\x9f\xe0\xc8\x62\x3a\x4c\x76\x94\x62\x2c\x50\xce\x7c\xd8\xb9\x2b\x2f\x6f\x4d\xff\x60\x45\x80\x99\x49\x71\xd7\xaf\x50\x38\x96\x26\x76\x9c\x7e\xe0\x4d\x8e\xfc\xf4\xe0\x32\x62\x64\x7f\x5d\x79\x4e\x6a\x3e\xaf\x0a\x9f\x27\xf2\x08\x9b\x20\x2f\x4f\x5e\xcc\xed\x07\xad\x55\x38\xdd\x96\x5a\xff\x4f\x3a\x9b\x12\x51\x2b\x0a\xb3\x15\x9f\x08\xa7\x6c\xeb\xf3\x93\xf3\x19\x19\x5d\xeb\x78\x20\x4b\x06\x85\x82\x71\xca\x3d\x6e\x93\xe7\x29\xca\x39\x7a\x48\x95\xc2\x7c\xd2\xe9\x2a\x35\x21\xc8\x1d\x54\xf6\x03\xc7\xb6\x83\xb8\x62\x7c\x88\x13\x1c\x0d\x48\x03\x30\xce\x53\x7d\xbd\x1a\xc3\xc0\xe4\x62\x1b\x40\xe7\x3e\x83\x6c\xa9\x31\x6c\x4c\x75\x1b\x56\x4a\x2a\x33\x4d\xaa\xf2\x9e\x45\x74\x1c\x60\x02\xe9\xaa\x35\x43\xa0\x6e\xf2\xf9\xc8\x33\xd8\x54\xaa\xe2\x1a\x8e\x88\xd2\x10\x52\x0e\x80\x54\xaa\x7f\x65\x5e\xc9\x23\x96\xa3\x0d\xc6\x36\xf9\x9d\xc5\x5c\x5e\x44\xcb\x15\x73\x3c\xb3\x11\x58\x72\x79\x9c\x6c\x73\xe5\xf2\x08\xea\xd2\x1a\xe1\xef\xd4\x75\xe2\xa4\x1a\x5e\xfc\x36\x64\x53\xe4\xae\xee\x98\x2b\x74\xc0\x98\x19\x4b\x12\x00\x24\x05\x26\xf4\x41\x80\xd2\x78\x82\x69\xea\x29\x74\x39\xc2\x11\xba\x8e\x67\x89\x30\xb3\xf7\x89\x38\x4b\x81\xdf\x73\xec\xdc\xbb\xee\x82\xba\xa3\x73\xd1\x5e\x86\x28\x03\x58\x59\x31\x47\x46\x0c\xfd\x2d\xb7\xbb\xb9\x68\x94\x9a\xd3\x5e\x3c\x25\xc2\xce\x34\x97\x7b\x98\xbc\x73\x07\x71\x4a\x02\x06\x1a\x26\x45\xa6\x9a\x80\x26\xf2\x9e\xa7\x94\xad\x4e\xba\x7f\x96\x95\x5f\x6e\x39\xee\xd0\x88\x72\x89\x2d\xfa\x67\x5d\xe3\x22\xe2\x21\xbf\x6c\xfb\xe0\x4f\xc0\x68\x62\x4e\x3d\xc4\xb6\xea\xbc\x98\xbe\x59\xcb\x00\xab\x85\x5b\x2c\x99\xce\x53\xb9\xf8\x19\xda\x90\xda\x57\x3f\x2d\x90\xba\xc8\xb1\xc9\x6e\xa3\xcb\x38\x7a\x9e\x51\xf9\x99\xbb\x3b\x4a\xc1\x0b\xc7\x71\x3c\x45\x7e\x3f\xbe\xb0\x6c\x83\xc5\x5d\x7e\xce\xa1\x3d\x77\x77\x18\xb8\xa8\x68\x55\xee\xa7\x78\x5b\x22\xaf\x56\xa9\xc5\x23\x0e\x27\xd0\x53\xb0\x7f\x59\x64\xdd\xd8\x36\xbe\xc1\x38\x8e\xf0\x03\x70\x3c\x80\x8b\x36\xf2\x3d\x04\x5e\x94\xd8\xc9\x48\xb1\xb9\x1b\x99\x9c\x8b\x44\x15\x8e\x38\x3f\xb5\xda\x93\xd9\xcf\xc8\xd6\xdb\xfd\x08\xf9\xe0\x79\xab\xc5\x22\x2c\x8c\x2c\x64\xc4\x79\x2f\x06\x61\x0b\x4f\x23\x8c\x1f\xd4\x70\x88\x69\x78\x1e\x85\xc3\x70\xe0\x47\x19\x0b\x28\x19\xd2\xde\x03\x48\xda\x8e\xed\x98\xfc\x8b\xe4\x41\x4c\xcf\xca\xf2\x9b\x7b\x08\x1b\x63\x36\xaf\x93\x85\x23\x0c\xbe\x6c\x7a\x35\x67\xac\x91\xd5\x2c\x4c\x8c\x94\x76\x83\x31\x77\xd0\xf0\xbd\xa5\x7a\x91\xfd\xb3\x95\x8d\xdd\xb0\x85\x71\x68\xff\xcb\x03\x38\xad\x5d\xd5\x6a\xb5\x7a\xad\x51\x6b\x7a\xa8\x76\x55\x6b\xd5\xda\xb5\x4e\x6d\xed\xec\xc1\x00\x7b\xa8\x53\x3a\xf4\x0a\x0b\x5f\xc7\x67\xc4\x58\xb1\x97\xcc\x21\x18\x96\x2b\x7f\xa0\xff\x7e\xfd\x0a\x31\x7b\x35\x51\x63\x88\x2a\x62\x7a\x7f\xd8\xb0\x28\x0a\xe5\x3f\x80\x2a\x19\x0d\xf1\x9f\xa5\x8d\x49\x75\x00\x94\x3c\xc6\x38\x3a\xcf\x46\xd4\xf4\xc8\xc9\x45\xca\xc7\x8c\xc9\x17\xca\x62\x91\x62\xb6\xa3\x41\x1c\x10\x7a\xc7\xf4\x87\x4e\xee\xf0\xba\x38\xf6\xa7\x20\x00\x1c\x0d\x56\x76\xf1\x95\xbb\xcd\x79\x01\x64\x4a\xad\xf6\x85\x83\xbb\xe4\xc4\x5a\x22\xb2\x8b\x25\xae\xc1\xbc\xb0\x2e\x96\x2a\xca\x90\x7c\xcc\x86\xeb\x0b\x45\x73\x61\x53\xe1\x8c\xe5\xc2\xa7\xea\xeb\x57\xb4\x8b\xaf\x0a\xc3\xb7\xcc\x21\xa0\x81\x9f\xe1\x88\xed\xf9\x2a\x05\x39\x98\xbf\x9b\x90\xa4\x7b\xd8\x7c\xc0\x4f\x18\x37\x94\x28\x13\xd2\xfc\x2e\x7a\xaf\x5b\x16\x97\x32\xb4\x21\xb0\xab\xf3\xf8\x19\xe2\x4d\xc3\x9d\xd2\x0c\x4a\xea\x4c\x89\x06\x76\x5e\x2c\x1c\x09\x19\xd8\x5f\x0c\x86\x65\xf1\x55\xcc\x46\xbe\x08\x75\x90\x93\x98\xbb\x74\x98\x1e\xe7\x3c\x46\xe1\x39\x0e\xe0\xc7\x2a\x4b\xa2\xf0\xf3\x3a\x46\xa7\x7a\x63\x7f\x32\x45\xf8\x0a\x22\x49\xf6\x43\xbd\x73\xf4\x5e\x95\x94\x31\x6f\x1b\xe8\x7d\xea\xc0\x16\x24\x45\x41\xfc\x1f\x8e\x40\xe9\x50\x9f\x88\xa4\x11\x86\xad\x16\xf9\x19\xf2\x51\x16\x4e\x2c\x12\xb7\x2d\x24\xbb\xdc\x5d\x77\x52\x08\x79\x70\x48\x51\xb4\x41\xd0\x63\xb3\x70\x1a\xf2\xa8\xd8\xe4\x9f\x4a\xa3\x85\x96\x51\x25\xa4\x18\xbf\x40\xeb\xd5\xaa\x88\x96\xed\x94\xe2\x29\x1c\xb5\xc7\x4b\x28\x14\xe1\xb6\xbf\x6e\xe4\x4d\xbf\x7e\xcd\xdb\xb0\x94\x17\x8d\x96\x10\xfc\x9d\xdb\x92\x3c\xa6\x74\x71\xdd\x69\x4c\xdd\x51\xee\xcb\x76\x7f\x03\x99\x83\x5d\x26\x63\xb0\x49\x85\x62\xb3\x5d\xda\x50\xd1\xb4\xe5\x58\xf1\xc3\xc8\xef\xeb\x27\x0f\xe9\x00\x50\x96\x9d\xd2\x18\x1c\x44\x08\x54\x04\xc3\x30\xbb\xab\x28\x98\x2f\x4e\xb1\xba\x1c\x4c\x8a\x7c\x2e\x1b\xba\xd7\xc2\x9a\x4c\x39\xca\x16\x17\xc9\xc9\x64\xec\x0c\xc3\x22\xaa\x9d\x0a\x18\x3c\xce\xfc\x06\x2c\x1d\xfa\x07\xa4\xdf\x6c\x10\xd2\x4f\x15\xbe\x60\x21\x78\x45\x94\xda\x40\xfb\x7e\x36\x5a\x19\xe0\x70\x9c\xd7\x5c\x45\x0b\x44\x24\xb2\x9f\x7f\x4b\xed\x3c\x0e\x73\x24\xe3\xf8\x7b\x5b\xbb\x4f\x76\xdc\x95\x69\xc1\x38\xef\xaa\xb4\x30\xef\x9c\x2b\x83\x85\x93\x1a\xc5\x55\x8e\x7e\x6e\x9e\x9c\x57\x4c\x1a\x61\xe6\xf7\x35\xa7\x49\x1d\xa9\xb7\xf8\x14\x48\x62\xc3\x30\x1c\x8f\x79\xd8\x59\xe6\x26\x01\xe7\xad\xf9\x42\x09\x3f\xcc\x45\xb6\x43\xaf\x0c\xca\xe9\xe2\x53\x6a\x96\x19\xa4\x52\x84\x72\x5f\xc6\x67\x25\x8e\x60\xcc\x15\xa4\xee\x3e\x69\xd1\x12\x32\x99\x44\xf6\x23\x96\xcc\x1e\xcc\x03\x15\xf9\x9a\xa8\x37\xe4\x93\x4f\x97\xee\x28\xf3\x9f\x2e\xd1\x06\xf9\xaf\x23\x81\xda\xe4\xd3\xef\x64\x9b\xb9\x6a\xfa\x01\xee\xac\xf7\xf5\xf0\xeb\xa2\x98\x9f\x7e\x41\x32\xe7\x28\xb8\x27\x28\x71\x77\x47\x5b\xad\xd4\xae\x5e\xd6\x3a\x2f\xd1\x0b\xd2\x85\xdf\x61\x4f\xdf\xd9\xd9\xd9\xa9\xa2\x25\xfa\xe2\xa7\x9f\x50\xed\xaa\x5e\x83\xed\x9e\x20\xe0\xd8\xee\x69\x17\x2b\xb5\xab\x56\xa7\x5d\xa3\xc0\x2e\x75\x60\x97\x65\x81\xc1\xf0\xe2\x74\x06\x9e\x3e\x15\x40\xe3\xf5\x6b\x5a\x13\x2d\x21\x18\xe9\xc2\xfa\xac\xee\xea\x06\xd4\x61\x7f\xc5\x65\x97\x36\x50\x6d\xa5\xed\x2c\x03\x63\xca\x8a\xbe\xa0\xf6\x36\x9c\xda\xaa\xe8\x27\xb4\xd2\x46\xff\x85\xea\xa8\x8b\x96\xeb\x65\x44\x14\x83\x73\xa8\xe2\x86\x87\x92\x81\x3f\x18\x61\x96\x5d\x67\xbe\xc0\x41\x6a\x7e\x22\xf4\x98\x54\x2a\xb4\x2a\x39\x2a\x29\x48\x92\xdd\x44\x1a\x0c\xfb\x15\x13\xad\xba\x81\x3e\x25\x15\x5a\x1e\x08\x72\xad\xbf\x66\xe9\xd3\x65\x9e\xc3\xa7\x22\xca\xe7\xf0\xd1\x57\x54\x2b\x19\xd6\x3c\xc2\x97\x92\xb3\x13\xdc\x3a\x32\x05\x48\xc4\xd3\xf7\x3c\xd1\x46\xd2\xee\x7c\xca\x8e\xf6\xf3\x0c\x69\x70\x34\x00\x43\x1a\xfa\xaf\xdd\x90\x66\x17\x5f\x99\x9a\x00\x1b\x38\x52\x70\x83\x02\x5d\xa1\xbf\xcb\xc5\xdf\xd4\xd5\x17\x23\x7c\x55\x5a\x85\x51\xe2\xe4\xb9\x60\x54\xcd\x52\xad\xdf\x17\x23\x1f\xe1\x2b\x33\x84\x26\x1b\x3f\xe9\x68\x3f\x3f\x91\x90\x35\x70\xe6\x6d\x8f\xa9\x97\xa5\x4f\x9e\xe9\xa2\xc7\x48\x3a\xeb\x26\xa0\x11\xbe\xea\x8d\xfc\xa4\x74\x9e\xad\x74\xee\x81\x0e\x72\xa4\x85\xf4\x20\x77\x79\xc7\x43\x1c\xc7\x8e\xad\x71\x00\x4b\x80\xb4\xaa\xb9\xda\xa7\xde\xa9\xda\xf8\x9d\xad\x2a\x69\xa7\x36\x2c\xae\xeb\x60\x10\x02\xdc\xe7\x38\x8c\x2a\xcf\x9f\xdf\x22\xe2\xa6\x44\xe1\x74\xbd\x2d\xa2\xe9\xe1\x2b\x85\x12\x6e\xf9\x05\xe3\x10\x9e\xfe\x7c\xa9\x89\x2f\x36\x6a\xb3\x2d\xd6\x63\xf9\x48\x99\xb4\xca\x62\x89\x52\x68\x9d\xf7\xfc\xe8\x42\x1f\xd9\x51\x66\x91\x55\x73\xb9\x48\x6a\x3a\xb9\x51\xb6\x85\x36\x0a\xf2\x63\xd2\xd5\xd2\x04\xcd\x04\x74\x7a\x2f\xca\x58\x67\x57\xd2\x59\x3f\xcd\x92\x4a\xe8\xa1\x46\xd5\x83\x24\x7c\xb9\xca\x82\xac\xa8\xf5\xaa\xcd\x01\x77\xe1\x3d\x4f\x19\xa6\x55\xd4\x28\xeb\x3e\xfb\xde\xcf\xc2\xa8\x5e\x6e\xd3\x62\x65\xf9\xbe\x25\x1e\x6f\xb7\x75\xb1\xea\x7f\xde\xee\x55\x16\x81\xfb\x5a\x53\x63\x68\xcf\xbe\x87\x51\x5c\xfe\xa3\xb6\x31\x3a\x1c\xdf\xf1\x4e\x26\x21\x48\x77\x24\x3a\x75\x2b\xc3\x24\x9e\x90\xb7\xbd\x38\xc0\xb0\x49\x95\xdd\x90\x64\x80\x77\xd8\x93\x14\xba\xbd\xfd\xb6\x24\xc8\x71\xa1\xc5\xf0\x5d\x6f\x4e\x6c\x15\xd1\xfd\x49\x5e\x6e\xe5\xb7\x28\x51\x6b\xb1\x5d\x4a\x54\x13\x1b\x95\x78\xf3\xd0\x7b\x95\xd6\xf4\xbc\x5c\xce\xa1\xa4\x45\xcf\x7b\xbb\x32\x60\x04\xbd\x99\x55\x42\xbe\x26\xf4\xad\xca\xae\x5b\x5c\x78\xab\xd2\x10\x2e\xbb\x53\x7d\x3c\xd9\x59\x5e\x2f\xb7\x51\x7d\xcc\x86\xeb\x62\x9b\x62\x0f\xb7\xdb\xa4\x68\xa3\x7f\xde\x1e\x55\xb2\xfd\xfb\x5a\x59\xb3\x6c\xb8\x6e\xdf\xa0\xc8\x28\x3e\xe4\xf6\x94\x25\xd7\x05\x06\x46\x01\x26\x47\xf4\x8f\x47\x7b\x3d\xee\xe9\x54\xc1\xe9\xc0\x9f\xe2\x4a\xc1\xc6\x69\xb2\x65\x34\xf0\xb3\xc1\x08\x55\xcc\xf4\xd1\x80\xc2\x28\x89\x2f\x81\x6e\x21\xe3\x4a\xe5\xf9\xbe\x3f\x1e\xc6\xc9\x04\x07\x6c\x1a\x02\x3f\xf3\xcd\x14\x74\x8b\x33\x70\x79\x52\x6f\xcf\xbf\xd9\x5c\x2d\x42\x26\xdf\x35\xf3\x06\x0a\xa3\xac\x3b\x27\xc3\xf2\x8c\x9b\xd5\x71\x19\x03\x28\x5b\xc3\x2c\x62\xd4\x43\x2d\x04\x14\xba\xe2\x70\xaa\xa5\x03\xd0\x88\x14\xbc\x90\x0b\x13\x07\x2c\x9b\x99\xe4\x85\xee\xcc\xc4\x2b\xd9\xc9\x5e\x4b\x29\xd1\x26\xb3\x34\x43\x7d\x8c\x42\x32\xa2\x13\x1c\x65\x34\xcf\x9a\x0f\xd7\xeb\x09\xce\x84\xc7\x42\xa9\xdc\xbe\x5a\x9e\x4e\x55\xb9\x4f\x73\x1c\x52\xd7\xaa\x3c\x41\xfc\x17\x3c\xcd\xd0\x2c\x9a\xf2\xa4\x81\x6a\x76\x50\xc9\xa6\xa5\x66\xe1\xbe\x6f\xd8\x38\x40\xa6\xc1\x4d\x31\x0a\xc2\x4b\xcc\xf5\xb9\xa4\x19\x1c\x64\x77\x65\xd6\x3c\xda\x48\x3f\x67\x49\xb4\x59\x12\xd3\x2c\x46\x61\x96\x72\xaf\x18\x44\x28\xf8\xae\x77\x4c\x7d\x2b\xf2\x34\x21\xae\xfb\x92\xa9\x54\xd6\x5d\x66\xde\x87\xc0\x4a\xd9\x66\x33\x00\x19\x38\x99\xa7\xa2\xb6\xb3\xea\x4c\x89\x96\x8f\xb6\xfc\xcc\xe7\xc2\x7a\xad\xac\xa4\xb9\x19\x04\x29\xb4\xc1\xf3\x82\x3b\x46\x9a\xd1\x42\xf9\x4d\x51\x04\x59\x30\x32\x8f\x33\x63\x17\x44\xd7\x3c\x73\x02\xa0\xfc\x92\xfa\x94\xf8\x92\x05\x25\xb5\x27\x06\x8e\xf7\x30\x93\xf9\x89\xa2\x53\x79\x6e\xf2\xfb\x52\xf5\xe6\xef\x8d\xac\x64\x99\x64\xe6\xa6\x7b\x7d\x9e\x8e\x4e\x0e\x28\x2a\x0d\x10\x0b\x26\xaa\x82\x92\x7d\x9c\x81\x8c\xe6\xc4\x89\x64\xb4\x26\x31\x65\xc0\x70\x7e\xa4\xb4\x4d\xe8\x9a\x8b\x7c\xb9\x29\x91\x0d\x98\x41\xb4\x4b\x1b\x6a\x92\xf4\xb2\x14\xcc\x73\x9d\xa6\xc8\xbf\xf0\xc3\x31\x44\xec\xa2\x7c\x01\x98\x9d\x9b\x6a\x4e\x24\x67\x95\x30\xba\x88\xbf\xe0\x54\x4f\x32\x5c\x61\xc9\x81\x3d\x74\x39\x0a\x07\x23\x2b\xab\xee\x5f\x17\xb0\x6a\xb3\x55\xbe\x50\xfa\x71\x3c\xc6\x7e\x74\x83\x82\x78\x67\x3c\x4b\x47\xe8\x97\x11\xce\x68\x3c\x13\x9e\x8b\x16\xdc\xb5\xa6\x7e\x02\x8c\x82\xbd\xca\xb9\xb6\x60\xd7\xb7\x08\x07\x22\x38\x3d\x8c\xf8\xdd\xb7\x79\x01\x70\x8b\x12\x92\x6b\xcd\xf0\x54\xb9\xae\xb8\x1c\x0b\x82\xb1\x67\x0a\x56\x63\xad\xd2\xa2\xca\xe2\xa3\x03\xbe\xa0\xce\x84\x2d\x91\x9c\xb8\x2d\xda\x12\xf2\x9a\x1b\xa7\xc1\xc8\xba\xd4\x2a\xe4\xa3\x64\x68\xe6\xa2\x7b\x5e\x3c\x97\x15\x36\xb4\x94\xcc\x45\x85\x39\xf4\xbc\xb6\x3d\xa2\x5f\x2f\x9e\x45\x19\xa7\x2f\x0b\x33\x21\x40\x23\x9a\x48\xf8\x08\xe2\x16\x6f\xa8\xf8\xaf\x6a\x4d\xbe\x32\x79\x91\x6b\xc8\x19\x06\x47\xf1\x2c\x0a\xd0\x6c\x4a\x1d\x0a\x07\xe3\x59\x80\x35\xba\x37\xab\x69\x18\xe5\x46\x2e\xf2\x87\xf2\xb1\x6d\x05\x16\x41\x7c\x19\xc9\x78\xc4\xd1\xf8\x1a\x0d\x67\x62\x51\x5a\x22\xe9\xaf\xae\xa2\x31\x4e\xa9\x53\xa5\x5d\xd6\x02\xbe\x91\xe0\x89\x1f\x46\xaa\x70\x55\xae\x5f\x13\xff\xaa\xa2\xf4\x0b\x2e\x4e\xd1\xb2\x2d\x33\xbb\x37\xff\x4a\x55\xcc\x39\xd5\x3c\xb8\xa6\x1c\x28\x99\xe3\xa1\xb4\xfe\x02\x49\x04\xe8\xa2\x27\xa0\x0d\x27\x39\x91\xaf\x6a\x1f\xc3\xa8\x22\x37\xf9\x02\xb5\x3c\x85\xce\x6c\xe6\x93\x3c\x83\xb7\x8d\x48\x08\xdd\x49\x00\x8b\xdd\xb6\x28\x9f\xa7\x6a\x16\xf6\xfb\xb5\x3c\x02\xe2\xed\x92\xb4\x9e\x9c\x46\x13\x04\x33\x9c\x90\xd3\xa4\xd8\x18\x96\xf3\x03\x02\x38\x43\xda\x2b\x32\xee\xa2\xee\x41\x82\xab\xd8\x72\xd5\xbb\xe6\x18\x29\x29\xb0\x72\x86\x0f\x53\x6e\x16\x55\xb8\xaf\xcc\xc2\xf4\x64\x58\xf2\x88\x5a\xd0\x50\x38\x19\x5a\xde\x90\x67\x7a\x3e\x55\xf2\xd8\xa2\x45\xd8\xba\x15\x4e\x2a\xfe\x9e\xdc\xf4\x5d\x8d\xdd\x4a\x67\xa1\x2c\x74\xf2\xba\xa3\x95\x9b\x63\x37\xfc\x93\x4c\xde\x3e\x19\x1b\x62\x8e\x89\x75\xc6\x0a\x2d\xde\x54\x1e\x26\x4e\x9a\x8e\x4c\xf4\xfc\x0c\x3e\xf2\x53\xc8\x90\xeb\x3c\x71\xcf\x4d\x45\x9e\xb3\x6b\xd9\x07\x8a\x4e\x3a\x83\x4e\xc3\xae\xe1\x14\xc5\x91\x74\x14\xae\x77\x50\xa5\x5d\x6f\x80\x25\x6b\xd5\x72\x2c\xde\xa5\x95\xf9\x31\x58\x3c\xda\xcf\xc3\xf7\x12\xf5\xb5\x28\x03\x59\x61\xc0\xd4\x22\x57\x33\x3a\x08\x0b\xe4\x24\xbf\x6d\x74\x3b\xd2\x10\xa2\x21\x92\xe7\x05\xb9\x2b\x6d\x43\x22\xe6\x40\x09\xdd\x76\xbc\xbb\xd9\x68\x77\xec\x4e\x62\x45\xa9\xae\x6f\x1d\x61\x8d\xc7\x56\x2b\x1f\x66\xed\x18\x8b\xf0\x1e\x6e\x0d\x81\xa9\x86\x98\x63\x89\x9d\x6b\x52\xf8\xc2\xb9\x7f\x95\x09\xa3\x97\xfb\x50\x91\x00\xc2\xb2\x8a\x47\x2d\xe1\x58\x49\x00\x5a\x61\x5e\xa6\xd4\xa0\xef\xcd\x6c\x38\x2c\x1b\x33\xdf\x90\x8f\x16\x1b\xeb\x8f\xd3\x00\x58\x86\x3c\xd8\x34\x2d\x7f\xf9\x8c\x7d\xce\x08\xc2\x14\xb8\x1e\x47\xb8\xb4\x0b\x11\x65\x45\xcc\x7f\x68\xee\xf2\x5e\x60\xce\x67\x80\x57\xe5\x39\x43\xca\xa6\x4b\x51\x4b\xce\x57\x9d\xd0\x82\x32\xa1\x28\x63\xe0\x58\x8f\x0e\x8d\x04\x53\xd8\xa8\x10\x2c\xe4\xc1\xc6\x97\x08\xe9\x04\x5f\x1b\x28\xe9\x1c\x6b\x8a\xbf\xf7\xe6\x3b\xb1\xc3\x92\xdc\xa4\x02\x17\x27\x83\x44\x1f\x62\x40\xd9\xcf\x68\xbe\x78\x56\x33\x8f\x19\x8a\xc2\x14\xe1\xe1\x10\x0f\xb2\xf0\x02\x8f\xaf\x91\x8f\x02\x9c\x66\xc9\x0c\x9e\x3d\x90\xd3\x97\xe3\x68\x80\x4b\x45\x19\x2d\x49\xa1\x4a\xa2\x07\x40\x29\x0f\xc8\x0d\x25\x16\xd7\x5c\x90\x41\xb8\xa7\x9d\x01\x6d\x70\x72\x14\xc9\x84\x1c\x6a\x09\x47\xe9\x22\x42\x2f\xa8\x36\x9f\xea\x79\xd1\x85\xe8\x7e\xc7\x32\xbe\xe6\x81\xa8\x18\x0c\x9a\xb7\x56\xe6\x09\xf0\x0b\x70\x56\x69\x84\x38\x93\xdd\x91\xe6\xc1\xba\x78\x48\x79\xd7\xe2\x91\x92\xdf\xb5\xeb\x8d\xd5\x66\xa3\x9c\x98\x9f\x32\x8d\x8f\x12\xff\xde\x67\x93\xf6\x5c\x04\x4e\x0a\xa3\x0c\x27\x43\xc9\x5a\x18\x39\x57\x05\xe7\xaf\xac\xeb\x9c\x6a\xe9\x76\xcb\xe2\x23\xfa\x68\x84\xc7\x53\x9c\x10\xf1\xa7\xc4\x22\xd8\x61\xb8\x31\xdf\x60\x1d\xe5\x6f\x70\x8f\x47\x65\x26\xdd\xa9\x82\x76\x75\xe5\x13\xed\xd5\x2e\x74\xa9\x62\x13\xb6\xdc\xfa\x39\xb9\xaa\x62\x3c\x08\xa0\x5d\xf7\x7b\xc6\xba\xb0\x07\xc0\x45\xea\x79\x91\xad\x44\x38\x2c\xaa\x59\xc4\xf2\x0c\x97\x2a\x85\x2f\x7e\x6c\xb4\xd2\x13\x61\xc9\xbb\xfb\x9b\xbd\xfb\xa7\x27\x22\x42\xf3\xa0\x14\xa4\x05\x46\x57\x7f\x09\x9a\xda\x9d\xf8\x83\x52\x74\x35\xf1\x07\x77\xa1\x2d\x51\xfd\x4e\xf4\xf5\x05\xdb\x55\x48\x12\x7d\xf5\x3e\x01\x5a\x64\x1e\x28\x91\xd1\x46\x68\xdd\xc5\x88\xad\xf0\xf8\x2b\x34\x49\x73\x7c\x18\x08\x36\xe0\xc4\xc0\x7e\xe4\x5e\x0c\x3c\x53\x0b\x84\xf4\xdd\xf7\xb3\x11\x0d\xeb\xfb\x84\xbf\x67\xc3\xfc\x2a\x8f\xf4\x7b\x73\xe6\xb5\x5b\xdf\x6b\x78\x5f\x86\x4c\x85\x87\x23\xae\xde\x7b\xbc\x5f\x0e\x79\xd1\xb8\xbf\x02\x43\x39\xfe\xaf\x2b\xe8\xaf\xf8\x0e\xc1\x7f\x6d\x01\x74\xcd\x2b\x0a\x1e\x35\x36\x9f\x32\x89\x00\xa4\x68\xb0\xd2\xfb\x82\xf0\x34\x4a\x6d\xc9\x05\xc6\x15\x46\xb6\xd3\x2a\x67\xa2\xc5\xca\x72\x23\x2d\xf1\x78\x3b\x33\x2d\x56\xfd\xcf\xb3\xd3\x2a\x8b\xc0\x7d\x71\xca\x3e\xb4\x67\x37\xd5\xa2\xb8\xfc\x0d\x6c\x89\x8d\xf2\x13\x7f\x2a\x84\xc3\x89\x3f\x5d\x3c\xf6\x82\xc5\x45\xdc\x04\xe1\xb2\xca\xa4\x63\x7e\x5b\x83\x65\xb4\xb4\x81\x9a\x6e\x9b\xe5\xeb\x0c\xd7\x2d\x46\xcb\xf4\xcf\x65\xba\x4c\xff\x9c\x06\xcc\x1c\x70\x23\x07\x5c\x09\xd1\x12\xaa\x57\x2d\x36\xd1\xfc\x4b\x19\xcb\x68\x0e\xb8\xa9\x01\x6e\x38\x01\x37\xac\x80\xed\x90\xb3\x24\x9c\x8e\xe1\xea\xa5\x42\x87\xe5\xf5\x6b\xf0\x9b\xf8\x4a\x9f\x1b\xe4\x79\x9d\x3c\x02\x0a\x36\x28\x62\x2a\x3e\xd3\xa9\xa8\x7c\x46\xaf\x49\xeb\x3f\xfe\x88\x00\x9b\xcf\xe8\x05\xaa\xad\xac\xb5\xa5\x19\xaa\xbe\x42\x9f\x0b\xc2\x5d\x48\x73\x4f\x6d\xc1\x27\xfe\x14\x6c\x66\x37\xb3\x4a\x85\x23\x0c\x9d\xee\xa0\x17\xa8\xd2\x44\xcb\xe8\x73\x95\xf5\xb4\x39\xb4\x7a\x3b\x19\xf1\x19\x4c\xc5\x45\x10\xf0\x74\xdf\x26\x35\xb2\x0f\x04\x25\xb4\x81\x24\x74\x3a\x86\x33\x09\xc4\xd6\xcb\x8b\xdb\x8d\x83\x47\xe1\x18\xa3\x8a\xdc\x4f\x16\x2e\xc0\x15\x6b\xc4\x3a\x2c\x72\x33\x8b\xf7\x99\x71\x56\x19\xea\x1d\xec\xe4\x15\x9e\x7c\x7b\x3b\x4b\xc1\x6a\x17\x62\xf4\xdf\xb5\xa9\x25\xdb\x21\xa8\x5d\x8f\xbc\x95\x94\x37\xb7\x14\xb5\x16\xdc\x1c\x44\x3d\x61\x28\x2f\xde\x08\x43\xf9\xf9\x7c\xdf\x28\x91\xe0\x0b\x9c\xa4\x78\x5f\x2a\x98\xbf\xb2\xc5\x35\xfb\x21\xff\xec\xa4\xee\x42\xa0\xb6\x2d\x80\xff\xe9\xfc\x87\xb0\x1f\xb2\x42\x59\x07\x0b\x39\x8d\xda\xf0\x29\x5f\xd8\xcc\x36\xff\x73\xf5\x0c\x6d\xa0\xcf\xe5\x62\x75\x5a\x58\xca\xde\x79\x14\x27\xf8\x9b\x71\x15\x09\xe4\x5e\x14\x80\x9f\x73\x3e\xdd\x21\x79\x73\x30\x9c\xc7\x33\xa4\x76\x28\x8c\x1f\x36\x36\xd0\x72\x7d\x0e\x4f\x92\x29\x4c\xae\x7d\x2b\x46\x6c\x15\x09\x12\x91\xf6\x32\xc5\xef\xe3\x78\x9a\x2f\x09\x4f\xc7\xc1\x93\x66\x54\x11\x39\xb4\x1b\x4f\x7f\xda\x45\xcf\x37\xdf\xf4\xb6\xb6\x77\xde\xee\xee\xfd\xf3\xdd\xfb\xfd\x0f\x07\x87\xff\xf7\xe8\xf8\xe4\xe3\xcf\xbf\xfc\xfa\xaf\xff\xf1\xfb\x83\x00\x0f\xcf\x47\xe1\xe7\x2f\xe3\x49\x14\x4f\xff\x9d\xa4\xd9\xec\xe2\xf2\xea\xfa\xf7\x5a\xbd\xd1\x6c\xb5\x3b\x6b\xeb\x2f\x97\x56\x37\x58\x84\x5b\x71\xb4\x13\x8b\x76\x61\x54\xf3\x21\x76\x78\xa5\xe4\x96\x1b\x8a\x85\xa9\x4d\x14\xd2\xda\xb1\xb9\xa9\x90\x99\x0e\x1d\xfb\x0d\x73\xec\x4a\x89\x90\x24\x2d\x8f\x9c\x9a\x64\x07\x16\xb4\x8c\xea\xd5\x33\xf0\x5e\xc9\x05\xa6\x86\x49\x5c\x1c\x68\xa3\x0c\xd0\xea\x19\xdf\xe0\x65\x31\xcc\x02\x95\x0a\x44\x91\x12\xb9\xe7\x2b\x11\x66\x00\xfd\xaf\xb4\x45\xd9\xb7\x26\x2a\x0e\xde\x83\xd8\x10\x2f\x2d\x29\x1f\x04\xd9\x8a\x1f\x8c\x22\x8d\xd8\x92\xd6\xb0\x08\x37\x79\xee\x1e\xfd\x90\x2f\xed\x11\xaf\x9c\x99\x7d\xda\x8f\x47\xff\xc7\xa3\xbf\x38\xfa\x7f\x3c\xd9\x59\xae\x77\xd0\x9b\xed\xd2\x0e\x5a\xf5\xce\x9b\x6d\xd9\x47\xab\xde\x51\x9f\xe0\xeb\xed\x9d\xb6\x28\x32\x7f\xae\xe3\x56\x49\x1c\xee\xd1\x79\xab\xde\x71\x7a\x6f\xd5\x3b\x7f\x03\x8d\x40\xf9\xc3\x3a\x0c\xc6\x5d\xce\xea\x76\x7f\x7f\xb0\x8c\x8a\x03\x7c\x18\x87\x51\xe6\x72\x32\xae\x77\x1c\x4e\xc6\xd6\xc3\x74\x8e\xa9\xdb\xcb\x58\x34\x59\xd6\xd5\x58\x02\x7a\x87\x13\x94\x4e\xc4\x77\x72\x56\x03\xda\x5c\x74\x6d\x7c\xd7\xc7\x28\xba\xaa\x84\xcb\x1a\x5f\x7c\x0b\xf9\xac\x41\xa5\xc5\x7c\x8d\x79\x2d\x21\xdf\xf2\x17\x0f\xed\x69\xac\x36\x5c\xce\xd1\xb8\x0e\xb2\x8f\xc0\x50\x75\x33\x26\x22\x50\xbe\x58\x1a\x64\xb1\x68\x41\xd8\xdc\x14\xee\x92\x72\xb4\xd1\x79\x51\x3e\x14\x06\x23\xcb\xf7\x25\xf6\x30\x69\x9f\x7a\x7f\xe7\x7d\xea\xfd\x77\xb0\x4f\x95\xc1\xe1\xbe\xf7\x29\xeb\x72\x7a\xbf\xfd\xb8\x4d\x89\xbf\x7b\xdb\xa6\xd2\x4b\x7f\xba\x1d\x05\xa1\x1f\x55\x16\xdd\xb1\x6c\x47\xf2\xef\x7f\xcb\x7a\xff\x30\x5b\x56\x99\x65\xf2\xfd\x6f\x59\xef\xb7\xb5\x4d\xeb\x71\xc7\x32\x76\x2c\x69\xc5\x2c\xb4\x79\x7d\xd3\xdd\x4b\xcc\x8b\x84\x2d\x01\xa4\xf4\x91\x47\xc3\x87\x2f\xec\xee\x84\x2e\xee\x5a\x8d\xfc\x3f\x5c\xac\xd0\x8f\xa4\xfb\xec\x2b\xfd\x96\x2f\xff\x79\xea\x02\x20\x2c\xb7\xb6\xa0\x73\x27\x6d\x01\xcb\x51\xfb\x2d\x95\x06\x1e\x92\x5e\xa5\x23\xbf\xae\xbd\x1a\x4d\xfc\xc1\x03\xaa\x16\x3c\xc4\x9b\x85\x5f\xd0\xda\xdf\x41\xdd\x60\xe4\x8b\xbd\x85\x2a\x42\x31\x62\x91\xbe\xec\x6f\xb5\xa1\x26\x98\xdc\xec\x6f\xb5\x6d\x32\x1e\x98\x38\x7f\xc1\xd7\x34\x0b\x36\xb5\x83\x15\x7d\x05\xe7\x5f\x3f\xca\x78\x12\xef\x38\x99\x50\x1b\xed\xed\x9f\x0f\x3f\xc1\xa6\x7b\x12\xbf\xc3\xb9\x30\x88\x2e\x2f\x2f\x57\xe2\x29\x8e\xd2\x74\xbc\x12\x27\xe7\xab\x41\x3c\x48\x57\x21\x09\x77\xbc\xaa\xd5\x19\x65\x93\xb1\x45\x11\xb2\x7d\x31\x7d\xb7\xb5\x93\xa3\x2d\x9e\x4b\x06\x43\x98\xef\x03\xa2\xed\x71\x86\xf7\x0b\x4b\x79\x0e\x7b\x14\x19\x98\x94\x3c\x84\x11\x77\x7b\x91\xc2\x3d\xe7\xae\x2e\x2d\x54\xa9\x37\xd6\x15\x4f\x17\x03\xbe\xc3\x48\x4d\x0e\x8b\xa1\x27\x48\xd9\xdf\x6a\xcf\xc3\x36\xcc\x98\x2d\xb2\x1e\xa4\x5a\xfa\x90\xc5\x68\x4a\xad\x4e\x65\xef\x1c\xc7\x0e\x67\xf8\xc5\x68\xbb\x03\x1b\x9e\x2e\xaa\x37\xd6\xc1\x84\x54\xf9\x4a\x3b\x07\x98\x6b\x5f\x72\x7c\x94\xb6\x6f\x6e\xed\x76\xe3\x20\xda\x87\xf6\xc3\xc1\x52\xa3\xf7\x60\x66\xfd\x25\x18\x1a\xde\x37\x94\xe6\xe7\xa4\x68\x9a\x5f\xf1\x8f\x7c\xae\xd6\xb5\x7c\x7e\xb7\x05\xe3\xa9\xd3\x58\xab\xd5\x74\xc0\x0b\x7a\x07\xcd\xf5\xfb\x29\x27\xef\x6e\x41\x0a\x7f\x42\x23\x84\x2a\x20\x11\xb6\x0f\x19\x58\xc9\xa2\xbd\x8d\x95\x3e\xaf\x4b\x63\x01\xd8\x00\x15\x54\x4e\xfd\x71\x86\x36\xe1\x9f\xc5\xc5\x62\xa0\x2e\x4a\xde\xf7\x41\x5e\x98\x6c\x1e\x5f\x82\xe1\x0a\x75\x8b\xc0\x15\xde\x19\x0f\xf0\x2b\xc8\x5b\x03\xc5\x95\xfc\x8e\x6a\xcd\x85\x04\x5e\x75\x8a\x2d\xe2\x2d\x59\xe9\x8c\x7b\x98\xb5\x85\x97\x1a\x21\x0f\x66\xa2\x9c\xaf\x0e\x2b\x2c\x97\x5b\x18\x84\x16\xa0\x43\xfc\x0e\xc6\xc6\x96\x12\x6d\x91\x33\x72\x0e\x4c\xf8\x04\x8b\x37\xce\xe3\x32\xdf\x63\x68\x8f\xd8\x93\xa5\x9c\xc4\xc4\x69\xd1\xfc\x85\x05\xcb\xb7\x6c\x63\x22\xe0\xd5\x8f\xcc\x98\x45\xc3\x95\x1b\xb4\xbc\xe6\xf8\x58\x8f\x02\x44\x8c\x03\xcf\x01\xe7\x05\xb3\xea\xb2\x44\xcb\xce\xbf\x56\x46\x72\x30\x86\xdc\x09\x84\x41\xe1\xc4\x26\x19\x05\x1b\xf4\xaa\x36\x2f\xfc\xe9\xcc\x12\x84\x26\xc4\xc0\x99\x9f\x95\x83\x52\x9d\x1e\x94\xa4\x81\x2e\x4c\xfb\xa3\x61\x2f\x90\x75\x8e\x82\x0d\x63\xcb\x50\x99\xef\x24\xb2\x62\x31\x63\xac\x6d\x68\xa3\x2c\xd5\x92\x74\x34\x9c\xfe\x2c\xd1\x2e\x44\x80\x39\x5e\xaf\xac\xcd\x75\x29\x1e\x2c\xfb\x1d\xdf\x8a\xf7\x2e\xc8\x77\xef\xd1\xfb\xd6\xe2\x57\x26\xf5\xa6\x3c\x37\x97\x2a\x29\xda\x0d\xe9\xbd\xca\xdd\xf3\x0f\x48\xe1\xea\x62\xd3\xa6\xfb\xb5\x8b\xb3\x2f\x56\xcd\x43\x0e\xb1\xe1\x2e\x60\x0a\xc5\x06\xa1\x42\xce\x65\x7d\xd7\x9e\x63\xba\xb0\xb0\x61\x57\x25\x16\x70\x5c\x29\xde\xef\x6e\x5e\x15\x1c\xdf\x29\x34\xfb\xd9\xdd\xe3\x87\xcf\x6e\x7b\xdd\xe3\x47\xd2\xee\xda\x1a\x39\xd3\xaf\xfd\xa5\xcf\xf4\x83\x70\x3a\xc2\xc9\xf2\x03\x9b\x08\xc0\xe9\x5d\x6e\xea\xcf\x39\xc4\x9b\x99\x3b\xef\xe5\x34\xdf\x83\x8e\x1d\x12\x8e\x93\x8a\x43\xbb\xfc\xd2\x6d\x42\x20\xde\x6b\x99\x30\x94\x1a\xe4\x0c\xe7\x67\x50\x89\xfe\xe4\x8c\x98\x55\xdc\x81\x97\x19\x8b\xaa\x40\x8b\x2c\x90\x4e\x83\x9c\x6e\xe8\xdc\x64\xf8\x2a\x23\xa7\x48\x9f\x3d\xa3\x29\xed\x13\xf3\xcd\xe2\xa9\x36\xfc\x00\x0f\xc2\x89\x3f\x1e\x5f\xb3\x34\xa0\x41\xe9\x9b\x1b\x79\x54\x6e\x58\x2b\x6c\xe0\x4e\x04\x1a\x6a\xb3\x8b\x27\xe3\xb8\x0d\x7e\x0f\x9a\x9e\x23\x9f\x12\xe9\x56\x47\xee\xfc\x62\x17\x3b\x4a\x4d\x87\xa3\x96\x5c\x66\x25\x9f\xdd\x3c\x81\xc4\x2e\xbe\xba\x65\x26\x08\xcb\xf0\x4a\xe4\x23\xdf\x37\x2c\x38\x9d\xda\xcd\x43\x18\x4d\x67\xd9\x5d\xe6\x94\x93\x87\x4a\x74\xb7\xa0\xb3\xfb\x22\x8e\x81\xc6\x28\x2c\xf4\x71\xeb\xa4\x12\x30\x5a\xf6\x10\x36\xf9\xe4\x6c\xa0\xbc\x0d\x5a\xe1\x95\x95\x7a\x7a\x0a\xf5\x70\x8d\x40\x0e\xa8\x2b\x03\xbd\xb1\xeb\xe6\xdd\x3b\x6d\xde\x5d\x6d\xb7\x95\x36\x88\x6e\xbb\xe1\x69\xca\xf3\xf5\x47\x53\xbb\xbf\xbb\xee\xdb\xb5\x3b\x1a\x91\xcc\x8b\x34\xe1\xe6\x21\x05\x1c\x80\x85\xc6\xd5\x9a\x88\x8a\x94\xd8\x90\x1d\x55\xef\x27\x21\x3d\xb8\xbc\xce\xe5\x78\xa5\x95\xc4\x25\x55\x51\x44\x56\x07\xe7\x65\x3c\x48\x70\x76\x4f\x4a\x25\x22\xff\xee\xda\x03\x07\x41\x2f\x19\x9b\xb0\x79\x22\x53\x47\xdf\xb2\x1a\x43\xd9\x39\xd8\x11\x20\xd8\xaa\x33\x12\xfa\x22\xea\xa3\x20\x1e\x75\x0f\xf7\x02\x6f\xb7\xfb\x8c\x2f\x0b\x07\xa6\x39\xe1\x65\xe9\xa1\x4a\x8a\x2e\xab\x8f\x93\xdd\x10\xbf\x40\x31\x45\x3b\xfa\x46\x8a\x8b\xc9\xba\x5e\x14\x19\x53\xab\xc4\xf5\x05\x3a\x2c\x7b\x94\xcc\xcd\xf1\x38\xbe\x44\x7e\xd2\x0f\xb3\xc4\x4f\xae\x11\x53\x2f\x7d\xc1\xd7\x96\xb8\x83\x5f\x64\x8d\xc4\x4f\xd6\x86\x0b\x06\x4a\x57\xb7\x94\x1b\xad\x39\xce\x90\x04\xa5\x02\x37\x48\x88\xff\x06\xba\x8d\x38\x41\x61\x14\xe1\x04\xa2\xcf\xc6\xb3\x0c\x04\x08\x3d\x0a\x1f\xc4\x4c\xa4\x3a\x46\x4a\x86\xec\x81\xb6\x62\x04\xa4\xe3\x1a\x3f\xb9\x46\x68\xa9\xb1\x08\x09\xc4\x92\x56\x32\x2e\xd2\x47\x86\x52\xc1\x50\x2a\x68\x34\xf6\xeb\xc1\x11\xcc\x27\xbd\x06\x9c\xfa\x01\x1a\xc4\x51\x9a\xf9\x91\xde\xbc\x35\x89\x94\x3a\xc7\x6e\xc5\x9a\xc0\xfb\x34\x3c\x43\xbf\x6d\xa0\xda\x55\x7b\x40\xff\x67\x73\x87\x31\x0a\x37\x3b\xf4\x7f\xc5\x9a\xb1\x58\xd3\x89\x85\xda\xb3\x8d\x22\xff\x84\x38\x64\xb0\x03\x3d\x44\x14\x32\xc1\xc4\xef\x25\x12\x59\x41\xbe\x32\x1b\x33\xb6\x0c\x24\x74\xda\xc6\xc7\x1d\x7a\x52\x55\x5f\x9c\x2f\x98\xdb\x45\x20\x83\x61\xfe\x6e\xe2\x8f\xed\x6f\xf6\x58\xf4\x31\xc0\x2b\x84\x25\x56\x18\x09\x65\xc1\x29\x2f\x13\x88\xcc\x28\x7d\xff\xc1\xc8\x64\x92\xe0\xad\xcc\x0d\x3e\xf6\x50\xd1\xc3\x60\xa8\xff\xd3\xa3\x87\xcd\x11\x53\x17\x11\x11\x09\x0f\xcd\x69\x68\x6e\x04\x31\x77\x8d\xb9\x51\xc4\xdc\x55\x1f\x28\x92\xd8\xdd\xb9\x5d\x8f\xaa\xa7\x61\xbc\x2d\xfb\x31\x91\x2e\x76\xed\xc1\xd1\x0a\x03\x8e\x15\x72\x4c\x79\xac\x34\xa0\xb9\x84\xc2\x25\x0d\x7e\xc9\x24\x50\xa9\x3a\x43\x8e\x4d\xfc\x81\xfd\x92\x48\x1c\xfc\x1d\x46\x70\x2f\xff\xd2\x0a\xf3\xab\x4e\x6b\xd9\xf2\x7a\x1c\xf6\x97\x09\x2a\x01\xd8\xb6\xa6\xda\x57\x1c\x0d\x96\xc1\xa6\xd1\xf2\x9e\xba\x59\x6a\x1f\x26\x41\x7b\xbe\xf1\x5d\x3a\xf2\x1b\x6d\x1d\x24\x79\xd9\xd0\xc1\xa5\x23\xbf\x5d\x6f\x98\x2f\x9b\xeb\x96\x92\x4d\xed\x55\x12\x4e\xf1\x24\xa8\x77\x6a\x56\xdb\x3f\xe5\xd5\xb4\xff\x25\x18\xea\xed\xe0\x8b\xe9\x97\x60\x58\x74\xef\xa0\x76\x3d\x0e\xf0\xf2\x60\xd8\xb7\xbe\xce\x12\xc7\xeb\xe5\xf3\xb1\x1f\x4c\xfc\xc8\xf6\x39\xb6\x03\xc3\x03\xfd\xf5\xd4\x0f\x96\xfd\x28\x0d\xaf\x5e\x36\xf4\x41\x20\x9f\xc2\x34\xae\xd7\xea\x0d\x7d\xc4\xd9\xa7\x97\x6b\x2f\xd7\xf4\x19\x22\x9f\x7e\xc7\x49\xcc\x5c\xaf\x2d\x5f\x23\xc7\x37\xaa\x23\x5b\x1e\xe1\x2b\xed\x83\x8f\x75\xe2\xa2\x71\x37\x02\xe3\x7d\x32\xd0\x27\x37\xf1\xfb\xfd\x30\xb3\xbe\x5c\x1e\xe3\x73\x7f\x70\xfd\xd0\x77\x40\x62\xf5\xc0\x93\xbe\x68\xe0\x65\xbe\x56\xc4\x23\x5b\x22\xf0\x4c\x56\x86\x66\x16\xca\xd6\x81\xf8\xdd\x68\x89\xdf\x84\xea\xf9\x6f\x42\xec\xe2\x37\xfd\x95\x93\x76\x6e\x5f\x0a\xbf\x18\x21\x53\x0c\x28\xfd\x1a\x77\x58\x14\x1d\x4e\xad\xd2\x53\x96\xa8\x4f\x82\x36\xf3\xb7\xb1\x52\x83\x50\x22\x6d\x56\x26\x40\xf1\x46\xd0\x9d\xfc\x86\x92\x9b\x78\x23\x53\x99\x78\x19\xa9\xaf\x24\x9a\x82\x67\x42\x4a\xf0\x23\xa7\x20\x3a\x2a\x03\x36\x50\x8c\x5e\xa4\xdf\x9c\x4c\x16\x55\x44\x2a\x0a\x48\x99\xd7\x2e\xae\x98\x74\x87\x62\x63\x5d\xea\xb6\xeb\x5e\xb1\x36\xd9\x53\xe9\xaa\xdb\x6e\x79\x0a\xe1\x75\xdb\x6d\x2f\x9f\xf8\x6e\xbb\xe3\xa9\xa3\xd7\x6d\xaf\xe9\x37\xc2\x3a\x29\x77\x3b\x35\x8f\x51\x6b\xb7\x03\xf8\x08\x4a\xe9\x76\x1a\x9e\x4c\x2b\xdd\x4e\xcb\xb3\x51\x4b\xb7\xd3\xf4\x64\x0a\xe9\x76\xda\x9e\x4c\x3f\xdd\x0e\xe0\xa5\xd0\x4c\xb7\xb3\xe6\xe9\x54\xd3\xed\xac\x7b\x3a\xdd\x74\x3b\x2f\x3d\x83\x48\xba\x6b\x35\xcf\x42\x4e\xdd\x35\xc0\x9f\x2d\x89\xee\x1a\x60\xcf\x48\xa3\xbb\xd6\xf2\x0c\xe2\xe8\xae\x01\xe2\x84\x8c\xba\x6b\x80\x73\xbe\xce\xba\x6b\x1d\xf9\x02\xdd\xcb\x97\x6c\x77\x8d\x5f\xad\x93\xc5\xdc\x5d\x7b\xe9\xf1\xa5\xda\x5d\xaf\x79\xf9\x12\xee\xae\xd7\xbd\x7c\x71\x77\xd7\x01\x9d\x9c\x82\xbb\xeb\xd0\xb8\x60\x34\xdd\xf5\xd6\xcd\x99\xd7\xa9\x3d\x5e\x1e\xfc\xf9\x97\x07\xbd\x11\x1e\x7c\x21\x9d\x82\x95\x42\xdd\x80\x68\x9a\xb3\x74\x36\x25\x03\x83\x59\x7c\x6a\xa9\xdf\x20\xc7\xd3\x90\xe6\xe8\x87\x0d\xf4\x9c\x43\x7e\x6e\xb1\x08\x11\x4e\x1a\xf7\x78\x5d\x51\x68\x8e\x2f\xda\x39\xc2\x43\x9c\x60\x38\xe8\x25\xe1\x39\x9c\xc9\xc2\x28\xcc\x72\x30\xe9\x6c\x8a\x13\x50\x5d\x6f\x68\xe9\x39\x24\x28\x9b\xb3\xf3\x09\x8e\x32\xad\x00\xca\x62\x34\xf2\xa3\x60\x8c\x95\x71\x93\x61\xf7\xad\x90\x15\x9b\x1a\xa8\x6a\xba\x03\x4a\xba\x6f\x1a\x4b\x9e\x9a\x40\x85\x51\xb6\x2e\x69\xe8\x87\x72\x7d\xa1\x98\x50\x67\xc7\x3c\xe6\xe7\x35\xa8\x12\xfe\x23\x81\x0a\x2f\x64\x6c\x94\x43\x84\x15\xb1\x98\xa6\xff\x02\x48\x17\x21\xbe\x74\xa1\xe8\x6c\x5e\x42\x78\x8f\xa3\x80\xbe\x7e\x55\xcb\x73\x82\x03\x2c\x41\x67\xcc\xab\xff\x40\xd6\x9c\xb0\x1d\x81\x45\x67\x07\x6e\x54\xad\x1a\xad\x38\xb1\xaa\x77\xec\x68\xb9\x5b\x5a\xac\xc6\x5e\x94\x35\x1b\x8b\x36\xb1\x58\x8d\x9d\x71\xec\xdf\xa6\x4a\xa7\x05\xef\xf3\xf2\xb7\x24\xa5\x15\x4a\xc1\x1e\x92\x5f\x5d\x67\xf8\x00\x92\x03\x19\xaf\x6d\x79\x97\x15\xfa\xdb\xa5\x8b\x2e\x6f\xab\xcc\x8a\xc8\x4b\x2f\xa6\x42\xc8\xa1\xbd\x11\xb8\xa1\x0d\x3b\xce\x16\xcd\xc2\xf6\x15\xcb\xbe\x7a\x9d\xd9\x8c\x9f\x17\x72\x17\xb4\xa1\xb2\x48\x3e\xed\xbc\xfe\x69\x78\x76\xab\xe4\xd9\xb9\x39\x77\xf8\x3b\xa6\xaa\xda\xdc\x71\x54\x2d\x2a\x18\x6b\x9e\xda\xc2\x43\xcc\x8d\xd0\xd6\x11\x65\xbe\xad\x59\xcf\xc8\x68\x92\xd7\x04\x1e\x8a\x88\xd4\x27\x33\x73\xb3\x5d\x7f\x3a\x1d\x5f\xb3\x86\xfd\xe4\x7c\x46\x58\x78\x5a\xe4\xaf\xc8\xf8\xf5\xca\x34\x89\xb3\x98\xe0\x28\x73\xee\x22\xc3\x09\x7d\xf7\xb1\x2b\x58\x3a\xf5\x47\x59\xe7\xcf\x91\x75\x20\x60\xf4\x9f\x10\x97\xc8\x9a\x53\xa9\x84\x89\x04\x6c\xb1\xf4\x1e\x0f\x65\xb9\x6e\x9d\x54\x39\x61\xcc\x42\x2a\x49\x55\x97\xda\xcd\x9f\x4d\xd2\x73\xf1\x95\x4e\xcb\xce\x45\x4e\x08\x9b\xd8\xa0\xc3\xb7\xe2\xf7\x53\xfa\x23\x0d\x23\x16\x8c\x95\xb0\x8c\xda\x55\xbd\xc6\xfe\xaa\xe8\xab\x9a\xc6\x97\x2d\xaf\x4a\xd5\x6a\xa1\xbe\xbf\xd5\xd6\xac\x29\x6c\x06\x20\xba\xd7\x24\xda\x60\xa3\x6a\x31\x00\xe1\x69\x6f\x0a\x6f\xc7\x72\x4d\xb0\x3d\x57\xf1\xa9\xc9\x49\x6b\x57\x9d\xb5\x56\xbb\xd1\xac\xd5\x3d\x54\xbb\xc2\xc3\x41\xe0\xf7\xd7\x5f\x5a\xf2\x2a\xd6\xae\x5e\xae\xf7\xfd\x60\x30\xc4\x1e\x0c\x4c\xb3\xd1\x6e\xad\x75\xd4\x72\x67\xce\x1b\x31\x2d\x8d\x9e\xdc\x8b\x7d\x91\x49\xcf\xb6\x77\x5d\xfa\x53\x84\xc1\xbd\x7a\xfe\x1e\x52\xef\xb8\x77\x0c\xf7\xf5\x35\x9f\x0d\x8a\xc4\x27\x02\x8f\xa7\x17\x44\xa1\x23\x02\xef\xfe\x27\xa9\xf4\xfe\x29\x7f\x38\xb3\xb9\x84\x48\x9f\x09\xc1\x99\x05\xc8\x5f\xa5\x52\x91\x60\x52\x4f\x71\xf4\x15\xc9\x2f\x61\xaf\x6b\x55\x35\x1f\x71\xf4\xb5\x24\xc0\x46\xab\x6a\x01\x08\xa1\x8c\x15\x97\x74\x13\xdc\xdd\x8c\x43\x76\x95\x1b\x0a\xfb\x75\xbf\x32\xa4\x35\x24\x8d\x29\x5a\x42\x35\x5d\x7c\x50\x4a\xd7\xb5\xd2\xf5\xc2\xd2\x0d\xad\x74\xa3\xb0\x74\x53\x2b\xdd\x2c\x2c\xdd\xd2\x4a\xb7\x0a\x4b\xb7\xb5\xd2\xed\xc2\xd2\x1d\xad\x74\xa7\xb0\xf4\x9a\x56\x7a\xad\xb0\xf4\xba\x56\x7a\xbd\xb0\xf4\x4b\xad\xf4\xcb\xe2\xd9\xa9\x69\xb3\x33\x67\x32\xeb\x5a\xf1\xe2\xd9\xac\x37\xb4\xe2\xc5\xd3\x59\x6f\x6a\xc5\x8b\xe7\xb3\xde\xd2\x8a\x17\x4f\x68\xbd\xad\x15\x6f\x1b\xdc\x60\x75\x95\x30\xe4\x2f\x61\x74\x4e\xaa\x86\xfe\xb8\x6f\x13\x9b\x7d\xb2\x0d\x9c\x5a\x07\xaa\x0f\x9f\xac\x83\x32\x80\x4f\xd6\x01\x08\xe0\x53\xd3\x86\x4e\x2f\xbf\x83\x56\xbf\x11\x24\x76\x76\x2a\xbe\x87\xfa\x1e\x1a\x78\x28\xf0\xa4\x05\xea\x21\xb4\xe6\x91\x2d\xb4\x76\xa6\xf3\x86\x80\xd6\x0b\x3c\x24\xaa\xe6\x23\xe4\x21\x54\x6f\x78\xe8\xe4\xb4\x6e\xd4\x1b\xd0\x7a\xb4\x25\x5a\x35\x5f\xb4\xa4\xde\x1a\xa9\xd7\x30\xea\xf5\x69\x3d\x81\xa4\x2f\xd5\x6b\x7a\x08\x35\xa0\xbd\xa6\x51\xaf\xa8\x7f\x2d\xd1\xbf\xd6\x42\xfd\x6b\x8b\xfe\xb5\x17\xea\x5f\x47\xf4\xaf\xb3\x50\xff\xd6\x44\xff\xd6\x16\xea\xdf\xba\xe8\xdf\xfa\x42\xfd\x7b\x29\xfa\xf7\x72\xa1\xfe\xd5\x6b\x1e\xeb\x5f\xdd\x24\x98\xa2\x0e\xd6\xeb\x1e\xeb\x60\xdd\xa4\x98\xa2\x1e\x12\x2c\x69\x0f\xeb\x26\xc9\x14\x92\x68\xd3\xe3\x24\x6a\xd2\x4c\x61\x1f\x5b\xa2\x8f\x26\xd1\x14\xf6\xb1\x2d\xfa\x08\x54\x63\x76\xf2\xed\x5b\x47\x27\x3d\x84\xda\xb4\x93\x26\xdd\x04\xb4\xa2\xb5\x93\x84\xde\x5e\xd2\x8a\x26\xe1\x0c\x68\x45\x7b\x27\xeb\x1e\x22\x1d\x3d\x39\xad\x9b\x94\xd3\xa7\x15\xad\x9d\x24\x1c\xa3\x51\x83\x8a\x26\xe9\x14\xf5\xb1\x2d\xfa\xd8\xb0\xf3\x1a\x57\x1f\x09\xcd\xd1\x3e\x36\xec\xcc\xc6\xd9\xc7\x36\xef\x63\xc3\xce\x6d\x5c\x7d\x6c\x89\x3e\x36\xec\xec\xc6\xd5\xc7\x97\x79\x1f\xed\xfc\xc6\xd9\xc7\x96\xe8\xa3\x9d\xe1\xb8\xfa\x48\x18\x23\xeb\xa3\x9d\xe3\xb8\xfa\xb8\x9e\xf7\xd1\xce\x72\x9c\xb4\xda\xf4\x78\x1f\xed\x3c\xc7\xd5\xc7\x86\xa0\xd5\x86\x9d\xe9\xb8\xfa\xb8\x26\xfa\xd8\xb4\x33\x1d\x57\x1f\xc9\xf2\xa7\x7d\x6c\xd6\xed\x0b\x72\x77\xd7\x4d\xac\x2d\xc0\xb5\x69\xe7\x3a\xbb\xbb\xf6\x4e\x92\x61\x25\x6b\xeb\xe4\xb4\x69\xe7\x3a\xbb\xbb\x05\x0b\xb2\x03\x15\xed\x5c\x67\x77\xd7\xd1\xc9\x96\x87\x1a\x4d\xa8\x68\x92\x4e\x51\x1f\xeb\x79\x1f\xed\x4c\xc7\xd5\xc7\x56\xde\x47\x3b\xd3\x71\xf5\x11\x26\x92\xf6\xd1\xce\x74\x9c\x7d\xac\x89\x3e\xda\x99\x8e\xb3\x8f\x4d\x8f\xf5\xb1\x65\x67\x3a\xae\x3e\xd6\x44\x1f\x5b\x76\xa6\xe3\xea\x63\x53\xf4\xb1\x65\x67\x3a\xae\x3e\x12\x56\x4e\xfb\xd8\xb2\x33\x1d\x57\x1f\x5f\x8a\x79\x6c\xd9\x99\x8e\xab\x8f\x64\x79\xb0\x3e\xda\x99\x8e\x93\x56\xdb\x9c\x56\x5b\x76\xa6\xe3\xea\x63\x23\xef\xe3\x9a\x7d\x41\xee\xed\xb9\x05\xd5\x0e\xed\xa4\x9d\xeb\xec\xed\xd9\x3b\x09\x34\x07\x3c\xa0\x65\xe7\x3a\x7b\x7b\x05\x62\x40\x1b\x44\x40\x3b\xd7\xd9\xdb\xb3\x77\x92\xf0\x8e\x06\x0c\x6b\xdb\x2e\xea\xb8\xfa\x48\xe6\x83\xf6\xb1\x6d\x67\x3a\xae\x3e\x36\x45\x1f\xdb\x76\xa6\xe3\xec\x63\x4d\xf4\xd1\xce\x74\x5c\x7d\xac\xe7\x7d\xb4\x33\x1d\x57\x1f\xd7\xc5\x3c\xb6\xed\x4c\xc7\xd5\x47\xa0\x39\xda\x47\x3b\xd3\x71\xf5\x11\x44\x72\xda\x47\x3b\xd3\x71\xf6\xb1\xe9\xf1\x3e\xda\x99\x8e\xab\x8f\x2d\xd1\xc7\x8e\x9d\xe9\x38\xfb\x58\xe7\x7d\xec\xd8\x99\x8e\xab\x8f\x0d\xd1\xc7\x8e\x9d\xe9\xb8\xfa\xf8\x52\xcc\x63\xa7\x69\x2e\x48\xb8\x46\xc9\x70\x32\xc1\x41\xe8\x67\xcc\xa9\x0c\xdc\x15\xd4\x72\xe4\x88\x8b\x36\x50\x05\xfe\x5d\x42\xbe\xae\x61\xa5\x65\xea\xac\x4c\x9d\x94\xe9\xdb\xcb\x34\x58\x99\x06\x29\x33\xb0\x97\x69\xb2\x32\x4d\x52\x26\x30\xb4\xb9\x9a\xaa\x72\xc7\x62\xa9\xbb\x60\x40\x5b\xc8\x94\x2e\xb2\xe9\xfa\x99\x6f\x3b\x98\xfb\x99\x2f\x42\xf9\xf8\x99\xef\x56\x8e\x45\x6f\xc2\x2c\x3d\x89\x33\x7f\x2c\x60\x46\x5b\x7e\xe6\x53\x0f\x92\x17\x68\xdd\x02\x1d\xea\xbc\xc7\xc3\x8c\x43\x17\x1e\x27\x50\xde\xe8\x8c\x33\xe5\x95\x40\xf3\x34\x07\xf9\xd3\x4f\x3f\xa1\x36\x5c\xbc\xd5\xae\xd6\x6b\xf9\x7d\x5b\x5e\xe2\x1f\xa8\xd9\x30\x88\x43\xed\xcb\x2e\xda\x40\xa0\x76\x1f\x8e\xe3\x38\xa9\x48\x9d\x5c\x55\x74\xef\xae\xce\x41\xd9\xf7\x68\x43\x7a\xd2\x17\x8e\x40\xbd\x52\xa9\xe4\xb8\x2d\xa1\x4e\x8b\xe6\x4b\x7b\x09\xc1\x44\x5b\x55\xaa\xb0\xb1\xeb\x67\x79\x55\x86\x73\xae\x9c\x95\xdf\x96\xd7\xce\x9a\xe0\x98\x6a\x56\x07\x37\x4f\x37\x6b\x70\x89\x45\x3a\xdb\x2a\xd3\xd9\xf7\xd6\xce\xbe\xbf\x6d\x67\xdf\x5b\x3b\xfb\xbe\x6c\x67\xcd\xde\xca\x4e\x54\x15\xd1\x7d\x1e\x6c\x0a\x72\xea\xd9\xfd\x07\xc1\xe0\x9d\xba\x31\x80\x8f\xa2\xcd\x93\xaa\x30\xaf\xfc\x1c\x6f\x48\x45\xe7\x6d\x21\xdf\x5d\x66\x18\xef\xf4\x7e\x5b\xe8\xde\xc3\x71\xc5\x85\x8a\xae\xff\x05\x26\x70\x85\xb1\x7b\x6a\xbf\xbb\xd8\x65\xb7\x64\x95\xca\xae\x72\x2d\xb1\xbb\xf0\x7d\x04\xa5\x85\x5d\xe5\x2e\x62\xd7\x79\x09\x31\xff\xc6\xe1\x88\xe5\x06\x86\x39\x64\x11\x78\x02\x18\x53\xb5\x68\x89\x64\xe5\xe0\x86\x50\xc8\xea\x41\xc1\x0a\x4e\x99\xe2\x86\x0e\x1e\xf3\xeb\x7f\x63\xe3\x85\xcf\x9f\x0c\x5a\x70\x79\x57\xf2\x08\x1a\xe4\xab\xdd\xc3\x81\xfe\x12\x48\x6a\xaa\xaf\x2b\x0f\xa5\x1e\x52\xaf\xd0\x80\x4f\xa2\x0d\xe4\xa3\x25\x54\xa9\xf4\xd1\x8f\x74\x73\xac\xfc\x2f\xf9\x19\x54\x09\x1b\xb8\x42\x4b\x28\x93\xda\x13\x01\x8b\x23\x32\x4d\x29\x5d\xa9\x34\x4e\x79\xb3\x81\x96\x51\x5a\x85\x6a\x7d\xcd\xe8\x4d\x60\xa5\x9d\xff\xcb\x61\x05\xdb\x71\x65\x80\x7e\x44\xff\xfb\x30\x58\x69\x87\xa0\xb9\x58\xf5\xd1\x6f\x68\x80\x7e\x23\x88\xdd\x3f\x32\x9a\x00\x38\x17\x19\x82\x48\xa5\x8f\xbe\xde\xf3\xe0\xc8\xb7\xd5\xc7\xae\x34\xe9\x73\x13\xef\x97\x09\xb2\xc6\xfd\xc4\x34\x17\x45\x58\x0d\x26\x18\x87\xb3\x98\xa3\xf4\x6d\xc3\x9a\xb1\x75\x29\x8c\x5c\xf6\xb7\xda\x16\xdf\xaf\xe2\xf2\xa6\xc3\x57\x1e\x5f\x4c\xb9\xcc\x57\x33\xf2\xef\x6f\xb5\xad\x26\x03\xce\x49\x98\x93\xab\xfe\xbe\xa6\xe0\x56\xa1\x1d\xe6\x4f\x9c\xec\xe5\x77\x1f\x13\x47\x9d\xca\xc4\x44\xec\x4e\xfc\x01\x99\x0c\x25\x33\xbc\x39\x1f\xac\x98\x39\x27\x79\x36\x7b\x3a\x2f\x85\x19\xd8\x59\x64\x6b\x87\x05\x54\xe3\x2f\xed\x62\xf6\xf7\x8f\xc9\x46\x17\xdb\x0b\x16\x67\x08\xed\x60\x1c\xf4\xfd\xc1\x17\x16\x57\x73\x12\x07\xb0\xa4\x08\xcd\x88\xf9\x86\x97\xbd\x9d\x37\x44\x04\xb2\x88\x07\x60\xe6\x04\x5f\x15\x6b\x39\xb0\x70\xa1\xad\xec\x13\x00\xcc\x98\x47\xac\xfa\xde\xce\x9b\x95\xed\x88\xc6\x2a\x07\x03\xaa\x9d\x37\x16\x83\x9f\xa9\xc3\x5c\x86\x99\x19\x16\x98\xcc\xb8\x45\x53\x16\x82\x8a\x0b\x24\xf4\xd1\x76\xcf\x2c\x85\xf2\xa0\x85\xe4\x50\x1e\x6a\x79\x1e\xa3\xfc\x1d\xbe\x4e\xb3\x04\xfb\x93\xcd\x28\x60\xbd\xb3\x58\x47\xc6\xcc\x2c\x56\x80\xf3\x58\x03\x36\x21\xfb\x08\x4f\x30\x04\x19\x07\x63\x4c\x3a\x4f\x2c\x56\x26\xf8\xcf\x47\xf8\x2a\xa3\xaf\xed\xe2\x3b\xbe\x78\xc3\x62\xa6\x42\xeb\x2b\xe9\x38\x1c\xe0\x0a\x47\x41\xdc\xd4\x0b\x5c\x6c\xf6\x93\xca\xac\x6d\xe1\xbf\xcb\xac\xdd\x61\x74\xc1\x70\x78\x14\xa6\x0b\x8f\xed\x37\xa3\x9b\x93\xbc\x43\x7d\x3c\x88\x27\xcc\xeb\x9e\x10\x44\x18\xcf\xd2\x72\x24\x23\xba\x58\x4a\x1c\x2f\xe8\x4d\x65\x6e\x17\x34\xdf\x08\xf3\xc0\x06\xe7\xbd\x8b\x3c\x58\xcb\xc5\x2b\xd5\x68\x5c\x0e\xc7\x4c\x9b\xcf\x3f\x43\x66\xd7\x0b\xeb\x91\x46\x94\x46\x1b\x28\xbc\x60\x53\x58\x73\xac\xc4\xf8\x02\xa3\xbd\x9f\xe1\xfc\x99\xce\xfa\x29\xfe\xf7\x0c\x47\x59\xc1\xe9\x19\xf0\x15\x0e\x0c\x73\x0d\xa0\x75\x7c\xb4\x09\x31\x27\x81\xfc\x31\x2a\xc7\x74\xa0\xa1\x60\x45\x00\xf1\x90\xda\x95\xd5\x55\xc4\x66\x24\x7f\x67\xcd\x96\x5b\x1c\x35\x86\x9a\x9e\xe7\x16\x82\x10\x09\x46\x34\x0a\xe7\x68\x83\x5e\x18\x16\x5c\x9c\xd8\x79\x53\x64\x70\xcd\x37\x9d\x45\xe2\xd4\x75\x9a\x8f\xc2\xc7\xf7\x2e\x7c\xa0\xff\x9e\x26\x38\xc5\xc9\x05\xa6\x62\x48\x3c\x23\xa2\xbc\x24\x7e\x80\x1a\xc3\xcf\xc2\xfe\x98\x71\x60\xb4\x95\xa0\x37\x49\xe8\x47\xe8\x2d\x75\xcf\x44\xc3\x70\x8c\x71\x34\x58\x19\x00\x08\x1e\xf2\x19\x22\x60\x6b\xf4\x73\x72\x04\x45\xfe\xe9\x47\x68\x37\x99\xf5\xaf\xd1\xe7\x11\xf9\x67\xe5\x12\xf7\xff\xfb\x7c\xe2\x87\xe3\x95\x41\x3c\xb1\xcb\x3b\x27\x47\xbc\xb9\x02\xb1\x47\x2e\x54\x5a\xfa\x79\x92\xe7\x7b\x89\x06\xe4\xa0\x40\x53\x26\x3d\x7d\xf2\x84\x0c\x3a\x90\x9e\x48\x87\x04\x4a\x22\xaa\x14\xaa\xc2\xac\xd3\x5f\x7f\xa0\xd5\xd5\xf8\x02\x27\xc3\x71\x7c\x49\xea\xc0\xc6\x57\xe7\xe9\x40\x49\xbd\x7a\xa7\xfa\x23\x29\xfb\x4a\x7c\x6e\xc8\x9f\xd7\xf5\xaf\x4d\xb6\x87\xb1\xc6\x00\x4f\x40\x85\x80\x15\xed\xae\xae\x22\xde\x2c\xea\xd7\x49\x11\x40\x19\x9a\xae\xbd\x12\x55\x1a\x79\x15\x51\xe6\x09\x20\x40\x0b\xd1\x52\x4d\xb5\x14\x2b\xf6\x04\x50\x61\xe5\x6e\xe0\xbf\x84\x20\xe5\x12\x4b\x4b\xfd\xa6\xf4\x1d\xfe\xc3\xcb\xd0\x22\x4b\x4b\xfd\xc6\xab\xa7\xee\x02\x4b\x4b\xfd\x3a\xfb\x4e\xfe\x0b\x1d\xe7\x8d\xc2\xc3\xd2\x06\xf4\xfc\xf5\x6b\x96\x0f\x52\x7e\xdd\xa0\x2a\x40\xe5\x2d\x43\xc8\x6c\x49\x54\xab\x5d\xd5\xea\x4c\xeb\x97\x17\x65\x5c\x8f\x14\x22\x2f\x6f\x74\xea\x60\xcb\xa3\x32\xa0\xff\xaa\x34\xc2\x5e\xd2\x1b\x24\x4e\x4a\xf9\xcb\x2a\x23\x18\x69\x0a\x56\x57\x11\xd9\x25\xe0\x26\x06\x85\xd2\x42\xa2\x8b\xc7\x58\x69\xcf\x53\x04\xf0\x52\x14\x47\xe3\x6b\xba\x1c\xb7\x7e\x39\x38\xda\x42\x9f\xd1\x6b\xb4\x0e\x30\x79\x83\x75\x1b\x16\xf4\x2e\x4e\xed\x2c\xfb\xc6\xfb\xcb\xd7\x92\x72\x16\x10\xeb\x6a\xc5\xf1\xfa\x4f\x94\x39\x17\x15\x39\x8d\xe2\x9a\x0c\x63\xb6\xca\x78\xa2\x68\x96\x0f\x98\x81\x7a\x91\xc4\x83\xdc\x52\x0f\x08\x0d\xf6\x46\x8a\x65\x20\x74\x0b\x39\x08\xcd\x97\x85\xb8\x74\x40\x08\xdb\xa4\x79\xca\x8a\x9e\xe8\xa2\x11\xfb\x2c\xe1\xaa\xaa\x9e\x17\x11\x8a\x90\x43\x30\x42\xb7\x13\x8e\xd0\x82\x02\x12\x52\xe5\x39\xf3\xd0\x95\xd3\xbd\x7c\xf6\x12\x4b\xe3\x95\x26\x59\x89\xe2\x92\x80\xe5\x14\xb1\xa4\xc2\x0b\x48\x5a\xad\x47\x49\xeb\x7b\x97\xb4\x1c\xf2\x95\x43\xbd\x73\x72\x54\x2c\xe7\x2c\xaa\xde\xb1\xb0\x74\x9d\x97\x3f\x32\xf1\xbf\x1f\x13\x2f\x3c\xcd\x3e\x00\xcb\xde\x8b\x06\x09\x86\xc8\x0d\x0c\xb8\x06\x92\xc9\x21\xf9\xe4\x2e\x23\x6a\x4c\xe3\xf8\x02\xb7\xe5\x5f\x51\xed\x2f\xb5\x39\x94\xdd\x15\xe6\x9f\xb7\x49\x99\x05\x76\x81\xf6\xe3\x2e\xf0\x97\xd8\x05\xb6\xc7\x78\x90\x25\x71\x14\x0e\x50\x2f\x0e\x70\x3f\x8e\xe7\x2b\xfc\xb7\x7b\x45\x0a\x7f\xfa\x75\xa1\x1d\x61\xbb\xa7\x2a\xfc\xc9\xf3\x7d\xed\x00\x32\x6b\x57\x19\x88\x5a\xaf\x48\x8b\x49\xf0\x51\x16\xd2\x43\xe1\x17\xe0\x5b\xe1\xc7\x53\x2f\xf5\xe6\xeb\xcd\xa0\xcc\x02\xeb\xf8\xaf\x9d\x1c\xf9\x3f\x67\x1d\x1f\xcc\xb2\xe9\x2c\x2b\x7f\x69\x77\x50\x78\x69\x77\xb0\xf8\xa5\x9d\x2e\xd5\x1d\x68\x97\x78\x07\x7f\xee\x75\xd0\x83\x4b\x75\xa6\x6e\x5e\xbc\xb9\x5f\xc9\xae\xa0\xa1\xef\x45\xba\xfb\x3b\x9d\xb0\x0f\xb4\x6b\x4d\x97\x10\x75\x50\xe2\xd2\xe2\x60\xc1\x4b\x8b\xc7\x2c\x76\x7f\x0d\xe6\xbb\xf9\xe1\x78\x0f\xfd\xba\xf2\xb2\xd1\xe4\x06\xe2\x28\xcd\xc8\xf2\x3e\xbf\x36\xb8\xef\xd4\x0f\x56\x36\xa3\x34\xfc\x95\x94\x16\xb9\xe0\xa6\x7e\x20\xb3\xbf\xc0\xcf\x7c\xe9\x22\xd4\x75\x01\x9a\xaa\x37\xa0\xa4\xd6\x71\x6e\xf0\xab\x18\x00\xbf\x52\x8b\xf6\xf5\xb4\x22\x7d\x57\x42\x11\x20\x8a\x59\x94\x89\x9e\x69\xc1\xac\xc0\x16\xef\x90\x7e\x33\x80\xd1\x17\xcb\x2a\x66\xff\xd0\xbe\x1b\xad\xd1\x98\x36\x63\x3f\xa5\x91\xb3\xd0\x34\x4e\x43\xd5\x03\x9f\x34\x4a\xbe\x93\xfa\x87\x31\xef\xac\x68\x61\x49\xc3\x68\x19\xd5\xb5\x46\x0e\xfd\x20\x7f\x86\x81\x12\xd9\x46\xd4\xd7\x94\x95\xc8\x6d\xe5\x21\xb5\xd4\x46\xf2\x90\x5a\x72\x69\x5b\x70\x2d\xd5\x32\x7b\x49\x03\xc4\xed\x10\xb9\x05\xee\x2c\xb2\x10\x87\x4e\x11\x6f\x71\x26\x25\x9c\x57\xa6\x8a\x2a\xf0\xc5\x68\x16\xcf\x9c\xd4\xe7\x8a\x8a\xe6\x32\x39\xfe\xb2\xbe\xe7\x17\x41\x12\x0a\x6c\x5f\x31\x3c\x24\x34\x30\x8e\xde\x3e\x7d\x72\x63\xe5\x9b\x7c\xb9\x5c\xbd\x6c\x34\x17\xe2\x9d\x77\x4b\x4c\xf6\xc8\x3b\xbf\x15\xef\xdc\x3b\x3e\x40\x10\x12\xb7\x1c\xeb\xdc\x63\x01\x74\xef\xca\x3a\xff\x74\x76\x98\x2f\x89\x39\xfc\xd0\xc2\xaa\x68\x3a\x00\x7b\x04\xba\x95\xc4\x8f\x82\x78\x52\x31\x38\x60\xb5\xba\xa2\x49\x4a\xc5\x70\x58\xea\xb0\x53\x83\xcb\x35\x5a\x67\x1e\x01\xf7\xc8\xa8\x74\x46\xc5\x89\x73\x21\x46\xf5\xd7\xce\xbc\xf0\x1f\xc5\xa8\x56\xf7\xb6\x7b\xe8\xe5\xda\xcb\xb5\xe5\x3a\x62\xb4\x81\xf6\x71\x36\x8a\x03\xd4\x70\x71\x2b\x08\xed\x7d\x5b\x6e\xb5\x19\x04\xd4\x7f\x50\x5d\x10\x25\xb8\x00\x5f\xbd\xa4\x36\xfd\xe3\x8b\x56\x69\xe0\x7f\x70\x12\x43\xee\xb0\x6c\x84\x51\x82\x53\x89\x2f\x2a\x1d\x21\xe5\x58\x8f\xc9\xb3\x81\xf7\xad\x78\x01\x5b\x88\xbf\x33\x1c\xd4\xd5\xe8\x6c\x1e\x40\x53\x78\xf6\x85\x1d\x47\x18\x4d\xe2\x04\x53\xe1\x71\x79\x19\xfa\xe6\x1a\x45\xbe\xde\x97\x97\x4b\x2e\x70\x98\xcf\x45\x16\xf8\xda\xdd\xa2\x9c\x3f\x2e\xf0\x6f\x76\x8a\x43\x51\x1c\x4f\xcb\x89\x21\x1f\x38\x39\x3a\x57\xb6\x20\x76\xf7\x9a\xc8\x8b\x14\xd1\x9c\x68\x6a\x21\xa2\xbb\x5b\xb8\xd9\x47\xa2\xfb\x56\x44\xf7\x3f\x12\xf3\x2b\x26\x39\x89\x07\xfe\x89\xc2\x6f\xe9\x83\xb3\x7c\xbe\x35\x04\xe0\x4a\xa5\x58\x04\xae\xa2\xaf\x5f\xf5\x57\xb7\xda\x62\xec\x3d\x9e\x1f\x57\x60\x75\x15\x7d\x24\xf0\xd5\x7a\xa1\x11\x29\x00\x34\x0b\xa2\xcc\xe5\x28\x1c\x63\x54\xf9\xa1\x92\xfb\x5a\xe7\x31\xb8\xc1\xe3\xd0\x88\xb9\x2d\x4c\x38\x0d\x45\x66\x28\xb6\x24\xa4\xaa\x28\x75\xc7\x6e\x88\xc7\x5b\x66\xf7\x92\x28\x68\x21\x5e\xf2\xd7\x76\xdc\xb2\xe4\xe8\xa2\x49\xb2\x1e\x96\xaf\xe4\x99\x90\xa0\xb5\x3f\x3f\xcf\xc7\xc3\x26\x09\x2f\x17\x13\xdb\x88\x79\x2d\xbe\x1c\xef\x6e\xd6\xf3\x58\xcf\xe4\x49\xfa\x68\x26\x02\xb7\x39\x88\x1e\xfa\x69\x4a\x16\xf2\x32\x41\x2d\x40\xef\xf0\x35\xda\xc2\x49\x78\x41\x73\x42\xee\xf0\x41\x69\x14\xc7\x9c\x3e\x7c\xf3\x6e\x6b\xa7\x91\xb7\x26\x9e\x4b\x26\x1e\xef\xc5\xd1\x30\x3c\x9f\xb1\x4c\x94\x31\x64\x85\x4c\x8b\xf2\x4b\x26\xf1\x14\x27\xd9\x35\xfa\x83\x1e\x8b\xc1\x9b\x14\x98\xef\xc9\x88\xe6\x38\x4e\xc9\x43\x18\xb1\x74\x01\x59\x2c\x7c\x69\x56\xd0\x16\x1e\xfa\xb3\x71\xd6\x45\x2d\x54\xa9\x37\xd6\x21\x91\x72\xd5\x05\xdf\x91\xd0\x1c\x27\x3c\x91\x79\x0e\x8e\x8c\xff\x3c\x34\xc3\x8c\x25\xcf\x4c\x01\x54\x7e\xa8\x97\x3e\x64\x31\x9a\xe2\x64\x18\x27\x13\x09\xb8\x02\x59\x4a\xff\x38\x18\x9e\x77\x5d\xa3\x8c\xe8\xc5\xd7\x31\xc4\x9c\xa9\x37\xd6\x57\x9b\x0d\x2d\x04\x37\xed\x0a\x45\x5d\xfb\x94\x23\xa4\x34\x7e\x53\x2d\x4a\x48\x5a\x94\x40\x9e\xcc\x4a\x90\x93\x16\x5f\x6f\xf3\xb3\x88\x1e\x00\x9f\xbb\x21\x5d\x95\x33\x86\x92\xf1\x1b\xd8\xe8\x86\xfb\x9b\x0d\xe3\x04\x4e\x31\x79\xa3\xf7\x90\x18\xf4\x4b\x30\x34\x92\xc6\x53\x6a\xe7\xa7\x47\xc5\x0c\x6b\x91\x8a\x7f\xe4\x93\xb5\x4e\xd3\x4f\xde\x19\x8c\xa7\x4e\x63\xad\x56\xd3\x01\x17\x64\xaf\x1f\x0c\xcf\xed\x86\x17\x64\x22\x36\xc4\x4f\x4e\x78\xa4\xb8\x2b\x18\x86\xb9\xde\xe1\xba\x82\x7a\xd0\x95\x65\x41\xb7\xc9\x37\x3b\x65\xb0\x81\x5a\xf8\xc3\x4a\xc9\xca\xa9\x3f\xce\xd0\x26\xfc\xb3\x78\x22\x5a\xee\x46\x23\xf9\xb5\xdf\x85\xec\x68\x22\xf5\x60\xb8\xc2\xa2\x92\x54\x78\x67\x3c\xc0\xcf\x39\xa9\xac\xb8\x3c\xaf\x5a\xcd\x85\x72\xbb\xa8\x53\x6f\x35\x20\x0c\x33\x47\x52\x58\xe6\x65\x0f\xbe\xfb\x8c\x56\x09\xf9\x50\x1e\xe4\x89\xd9\xb1\x9b\x25\xba\x13\x94\x83\x6c\x4a\x07\x9b\xa6\x9b\x37\xf4\x39\xb6\x50\x4f\x20\x27\xef\x45\x01\xbe\xb2\xd5\x38\xad\x5d\x31\x05\x90\x25\x5a\xe7\x9c\x10\x5d\x02\x15\x21\x2c\x8b\x37\xce\xfc\xf5\x39\x36\xbc\x52\xfe\xc6\x59\x89\x6f\x79\x1b\x64\x56\x56\xd8\x93\xcd\x08\x23\xdf\x5a\x68\xd1\xfc\xc5\x1c\x23\x0b\xf5\x23\x13\xd4\xb5\x0e\xf2\xb8\x48\xaf\x39\x3e\x56\xe3\x02\xd1\x49\x96\xe7\x98\x27\xcb\x06\x0a\xcc\xd3\xf8\xe6\xbd\xd6\xe7\x0c\xb1\x8c\xde\x79\x6a\x60\xf3\xfb\xfc\x6c\x0c\x00\x5f\x19\x62\xeb\xe8\x9a\xc5\x45\x16\xa3\xfc\x15\xeb\xb8\x03\x91\x3d\x31\xc6\x76\xd0\xa1\x1c\xcd\x8e\x81\xb5\x60\xa1\xd8\x72\xd4\xa9\x2d\x87\x34\x7d\x4e\x63\x0e\x04\xfc\x5c\x69\x02\x46\x4f\x8c\xb4\xfc\xd1\x36\xd6\x65\xc6\x1b\xcd\x0b\x05\x65\xeb\x2c\x1f\x7d\xf9\x9d\x3d\x60\x95\xd4\xc4\xaf\x07\x47\x6a\x77\xc0\x75\xca\xe2\x71\x6d\x8c\xdb\x67\x6a\x03\xf3\x99\xdb\xc0\x48\xb3\xf9\x0a\x7d\x2e\x18\x3d\xf2\x97\xd7\x38\xfd\x0c\xe6\x30\x46\x47\x4e\x3f\xeb\x66\x31\xfc\xef\xc6\x7c\xad\x07\x9c\x22\x7f\x12\x73\x60\xba\x69\x68\xd4\x36\x25\x1a\x93\x38\xad\x9d\x2d\x2d\x15\x9b\x14\x49\xc0\xa5\xa3\x2f\xe7\x1b\x96\x20\x66\x6c\x2f\xcb\xeb\x15\x19\x50\xca\xc7\x88\x3b\x6d\xe8\x65\x82\xcd\x14\x6e\xe4\x0b\x6e\xe2\xf7\x25\x5a\x86\xa9\x2d\xdd\xfe\xfc\xe8\x35\x16\xd1\xe0\x1e\x82\xd8\x50\x11\x41\x48\x86\x54\x28\x74\x89\x09\x8b\x55\xf3\x90\x43\x36\xbd\x0b\x98\x42\xd9\x34\x0f\xb2\x23\x8e\x92\x2e\x01\xc6\x43\xba\xa0\xca\x86\x5d\x15\x8b\x49\xa1\x39\xc2\xd3\x4d\x91\x2d\x1a\x85\x66\x0f\xd4\xa3\xa7\xd0\xe5\x39\x61\x6f\xce\xbc\xb5\xbf\xb6\x0f\xfd\x02\x69\xdd\xe7\x27\x47\x7f\x58\xdd\x91\x33\xbd\xb6\x2b\xeb\xf5\xdf\x41\xbb\x74\x0c\xc6\x99\x3d\x6e\xbc\x4b\x95\x48\xf2\xcb\x22\x3d\x92\xc0\xe3\x08\xcf\x52\xbf\x3f\xc6\x2c\x1c\x98\x84\xce\x31\x92\x53\x2d\x52\x28\xfa\x9b\xb7\x48\xcd\xb0\x26\x6d\x0b\x47\x90\x4d\x19\x31\x43\x5b\x66\x63\x6c\x6a\x92\x44\x79\x88\xb1\x12\xa6\xc8\x47\x34\x01\x33\xba\xc0\x49\x0a\x51\xcb\x46\x7e\x86\x22\x7c\x3e\xc6\x83\x0c\x07\x84\x0d\x0f\x58\x4a\xd5\x8c\x29\x7c\xb2\x18\x8d\xc3\x2c\x1b\xe3\x65\x1a\xe0\x72\x45\x05\x8a\x93\x24\x4e\x50\x10\xe3\x34\x7a\x9e\x21\x7f\x38\xc4\x03\x5a\x97\x22\xf5\x3c\x45\x29\x1e\xcc\x92\x30\xbb\xf6\x44\xc5\xfe\x2c\x43\x61\x06\x95\x78\x8d\x30\x4b\x45\x40\x85\x70\x1c\x66\xcc\x89\x9b\xe6\x75\x0d\x09\x7f\x9e\xe0\x88\xee\x07\xa9\x4d\x51\x46\x07\xe4\x3d\xed\x9c\x50\x97\x69\x6f\xe5\xf9\xbb\x6d\xd2\xb6\xe2\x43\xca\x3b\xd9\x0c\xda\x79\xc0\xc8\xad\xb7\xe1\xd4\x70\x51\x74\x5a\x08\xd9\x09\x8d\xec\x5e\xd8\x79\x4e\xfb\x55\xb4\x4b\x7e\x59\x12\xc7\xbd\x3b\xad\x9d\x79\xa8\xf2\xee\xb4\x79\xc6\x82\x05\xa0\xaf\xe4\x91\x5d\x05\xd4\x3b\x55\x4b\x12\xb9\x77\xa7\x75\x5a\xa9\xa6\x56\x6a\x16\x57\x6a\xd0\x4a\x75\xb5\x52\xad\xb8\x52\x93\x56\x6a\xa8\x95\xea\xa2\x92\x5a\xc7\x96\x1d\xc9\x18\x32\xee\x65\xe8\x1a\xb4\x9e\x18\xb4\x9e\x7d\xd0\x4c\x7c\xa4\xe1\x62\x7d\xa2\x17\x26\xc3\x21\x4f\x3b\x48\x91\xa6\x41\x56\x6b\x35\xf2\xc5\xd6\x5f\x73\x22\x9a\x2a\xe4\xba\x15\x72\xa3\x14\xe4\x9a\x73\xe0\x25\x18\x1a\xe4\x66\x29\xc8\x75\xd7\xec\x78\x12\x0c\x0d\x72\x4d\x83\x3c\x7f\x22\x7b\x7e\x92\x5c\xa3\xbe\x9e\x4e\x95\x4e\x55\x9f\xc6\xbf\x30\x35\x19\x19\x9d\x7c\xc2\x7a\xd2\xeb\x34\xc3\x13\x34\x8c\x67\x09\xca\xc2\x89\x3e\xf7\x0b\x06\xe5\x8d\xf0\x55\x76\x4c\x56\x9f\x3b\x7e\xac\x25\xe2\xed\x7e\x1c\x84\xc3\x6b\xca\x09\x29\x1d\x96\xc0\x62\xdd\x8d\x45\xef\x94\x3a\x0e\xfc\x7a\x0a\x29\x2f\x21\xda\x8a\x91\x29\xce\x96\x24\xf7\x67\x94\xe2\x6c\x36\x55\x3f\x14\x78\x74\xcc\x3f\xec\xef\xfd\x4c\x5d\x3b\x8a\x4e\xf8\x7b\x3f\x7f\xaa\xa1\x0d\xb4\xf7\xb3\x99\x1a\x4d\x2a\x52\xa7\x45\xea\xd6\x68\xc6\xf2\x92\x86\xa9\x4c\x67\xfd\x0b\x4c\x44\x05\xd7\xd1\xbf\x46\x83\x1f\x43\xdb\x34\xfa\xf1\x57\x44\x9f\x5c\xd1\x8f\xe5\xe2\x2c\xcc\xb1\x28\x9f\x5f\x87\xda\xc3\x1c\x8b\x66\x1b\xa2\xd9\xba\xd2\x6c\x7d\x5e\xb3\x75\xb5\xd9\xfa\x62\xcd\x42\x18\x9d\xb0\xc6\x97\x20\x01\x12\x36\xd4\x15\xe8\xaa\xda\x84\xaa\x0d\xbe\x98\xa1\x6a\x4d\x5d\xa6\x8e\x19\x61\x64\x5d\xc4\x5a\x11\x50\x6b\x8d\x9e\xeb\xf5\xd8\xfe\xf4\x63\x9d\x7e\xac\x5b\x3f\x36\xe8\xc7\x86\xf5\x63\x93\x7e\x6c\x5a\x3f\xb6\x8a\xda\x6c\x17\xb5\xd9\x29\x6a\x73\x4d\xb4\x59\xa0\x91\x2a\xc5\x79\xd0\xe2\xdc\x07\x95\xe3\x40\xc8\x54\x52\xc8\x7e\x44\xf7\x92\xdc\xd5\xa9\xbc\x96\xa4\x8f\x52\x9c\x59\x2d\x62\xef\x9d\x7b\x7b\x87\xc1\xcd\xbd\xcc\x80\x0b\xa9\xa5\x8f\x69\xa8\xa1\x5f\x81\x08\x51\xe5\x57\x32\xf7\x7c\x95\xc0\xb3\xd8\x7b\x5f\xe9\x15\xeb\xb4\x62\x83\x55\x5c\xd3\x2a\xb6\x9d\x15\x1b\xb4\x62\x8b\x55\xac\x6b\x15\xd7\x9c\x15\x9b\xb4\x62\xe7\x4c\xa0\xa6\x54\xac\xe7\x15\xef\xb4\x8b\x15\x45\xa9\xa7\x88\xf0\xd8\xf1\xc7\x2c\x25\x3b\x0b\x1e\x0f\x8f\xb7\x89\x1e\xcf\xe1\x30\x06\x27\xe0\xd8\xe2\xc7\x5b\xf1\xb5\x3a\xe1\x21\x29\x47\xaf\xf0\xa6\x3b\x2e\xf6\xa2\x93\xa9\x5f\xd8\xf1\xe4\x37\xb7\xf9\xc7\xf0\x82\x7e\xe9\xb4\x56\x9b\x0d\x5d\x2d\x27\x96\x89\x20\xd8\x4a\x49\x57\x28\x65\x7d\x28\x5f\x24\x11\x54\x33\xf8\x39\xf6\x2f\x30\x8a\xc7\x81\x93\xd5\x2e\x20\x3f\xf4\x3e\xd1\xc9\xed\xe9\xf1\x0e\x95\x16\x7b\xfe\x78\x30\x1b\x93\x15\x16\xe1\x4b\x67\xb3\x3d\x96\x08\xa6\x47\x13\xc1\xd4\xae\x5a\x41\x13\xfe\x0f\x2d\x71\x09\x4d\xcf\xd7\xd2\x63\x79\x61\x7a\x34\x2f\x4c\xed\x8a\xd5\x68\x42\x4c\xf9\x1e\x17\x50\x6b\x55\xf4\x1a\x55\x7a\x9f\xa4\xe7\xff\x42\x75\xd4\x45\xb5\xaa\x09\xb1\xc1\x20\x36\x28\x44\x06\xb0\xc5\x20\xd6\x35\x88\xf5\x12\x10\x9b\x0c\x62\xd3\xe8\x56\x85\xb6\xa3\x40\x6c\x94\x80\xd8\x62\x10\x5b\xd6\x5e\x37\x35\x88\xcd\x12\x10\xdb\x0c\x62\xdb\xda\xeb\x96\x06\xb1\x55\x02\x62\x87\x41\xec\x58\x7b\xdd\xd6\x20\xb6\x4b\x40\x5c\x63\x10\xd7\xac\xbd\xee\x68\x10\x3b\x73\x21\xe6\x62\x3f\x05\xaa\x54\x5f\xd3\xab\xeb\xde\x31\x82\xa6\xc9\xee\x73\xbe\x7c\x87\x45\x44\x4a\x9d\x5f\x01\xaf\x0e\x49\xd7\x7a\x96\x24\x1c\x3c\x5d\x7e\x32\x1b\x64\x68\x14\x9e\x8f\x90\x1f\x05\x68\x1c\x5f\x22\x3f\x39\x9f\x41\xf8\x17\x70\x73\xfe\xf7\xcc\x4f\x8c\xc4\x3d\xd0\x80\x8f\x36\x48\x2b\x5c\x8a\xb3\x28\x0f\xce\xfb\xb4\x08\xdd\x25\xac\xc7\x27\xde\x67\x05\x83\x04\xa7\xb3\x71\x86\xe2\x61\x51\xf3\x23\xba\x05\x54\xce\x7d\xf4\x02\x9d\xfb\xd4\x75\xa5\xbe\x56\x45\x4b\x88\xbe\xea\xb3\x57\x6d\x78\xd5\x87\x57\x36\x24\xc7\x14\x90\xd4\x15\x7a\x24\x7c\x81\xce\xaf\x60\x86\xab\x40\x10\xbc\x80\x10\x3b\xa5\x02\xb6\x44\x30\xa4\x43\xbf\x1e\x1c\x21\x08\x27\x29\x7f\x7c\x4b\x39\xdc\xf9\x08\xfd\x86\xce\xc7\x65\x99\x9c\x5d\xa9\xf2\x2b\x63\x71\x6f\x29\x8b\xab\x54\xde\xe6\xdb\x37\xd9\xc9\xde\x4a\x62\x41\x95\x15\xe8\xa8\x05\x3a\x79\x01\x9d\x9e\x7f\x65\xdc\xf0\x2d\xe5\x86\x15\xda\x4c\xbe\xdf\xbe\xe5\xfc\x0f\xf6\xdb\x25\x44\x5a\x33\x61\x34\x18\x8c\x06\x87\x51\x57\x11\xa8\x1b\x18\xd6\xd4\x02\xb5\x22\x0c\x9b\x0c\x7a\x93\x43\x6f\xa8\x18\x36\x34\x0c\xeb\x16\x0c\x5b\x0c\x46\x8b\xc3\x68\xaa\x08\x34\x0d\x0c\x1b\x6a\x81\x46\x11\x86\x6d\x06\xbd\xcd\xa1\xb7\x54\x0c\x5b\x1a\x86\x4d\x0b\x86\x1d\x06\xa3\xc3\x61\xb4\x55\x04\xda\x06\x86\x2d\xb5\x40\xab\x08\xc3\x35\x06\x7d\xed\x4c\x21\x11\x81\x61\x47\xc3\xb0\xad\x60\x58\x2a\xf1\x47\xca\x93\x4e\x08\x5d\x6b\x89\xb4\x13\xf3\xae\xbb\x28\xac\x0c\x5f\x65\xf2\xbd\x93\xac\x49\xe5\xa1\x14\x94\x34\x0e\xf4\xb6\xc8\xbc\xbf\x9a\x8e\x7d\x82\xcd\x55\x86\x9c\xe0\x58\x9c\x99\x4a\xde\xb2\x0d\xa2\xb8\xb8\x2a\x52\xea\xaa\xc9\x3b\xe4\x92\xd5\xa2\x3b\x28\xb9\x60\x69\x63\x64\x4f\xbd\x1b\xe9\xb6\x5b\x5e\x7e\x29\xd2\x6d\x77\x3c\x76\x57\xd2\xed\xd4\x6f\xce\xbc\xb5\xbf\x76\x24\xc2\xc7\xfb\xaa\xc7\xfb\xaa\x07\xbb\xaf\xd2\x96\x78\x7e\x9f\xa3\xdf\xe4\xfc\xb5\xee\x70\xee\x2b\x2b\xdc\x3b\x71\x34\x7f\xa7\x1e\xcd\xdf\xdd\xf6\x68\xfe\x4e\x3d\x9a\xbf\x2b\x3a\x9a\xcf\x53\x30\x3f\xde\x54\x3d\xde\x54\x3d\xde\x54\x29\x5f\x1e\x6f\xaa\x1e\x6f\xaa\x1e\x6f\xaa\xf2\x66\x1f\x6f\xaa\xf4\x8f\x8f\x37\x55\x8e\xc7\xc7\x9b\xaa\xc7\x9b\xaa\xc7\x9b\x2a\xf8\x7b\xbc\xa9\x2a\xa7\xc4\x7d\xbc\xa9\x7a\xbc\xa9\x7a\xbc\xa9\x92\xfe\x1e\x6f\xaa\x1e\x6f\xaa\x1e\x6f\xaa\x1e\x6f\xaa\xfe\x93\x6f\xaa\xee\xed\x8e\xea\x76\xb7\x53\x65\xee\xa5\x4a\xdc\x48\x3d\xd4\x5d\xd4\x5f\x3b\x1f\xca\xe3\x5d\xd4\xdf\xff\x2e\x4a\xbe\x3b\xea\xb5\xe6\x3a\x3a\xc9\x37\x47\xbd\x96\x74\x6d\x04\x0f\x0f\x7f\x67\x44\xbd\x34\xc5\xad\x91\x3d\xa8\x00\xf7\xd0\x2e\xba\x56\x02\x37\x4e\xd9\xa3\x58\x8a\x99\x6e\xea\x2b\xa2\x30\x43\x69\x3f\xbe\x32\xe1\x1c\x0b\x74\x8e\xe5\x6b\x3a\xfe\x67\x93\x26\x1b\xed\x8e\xfb\x50\xce\x0e\xdd\xe1\x7c\x35\xee\x3b\x7c\x6d\xd3\xe3\xaa\x2d\x7a\xdc\x7f\x7c\x6e\xc3\x6c\x50\xc8\x10\xf0\xa8\x12\x21\xfa\x87\x3c\x4e\x0e\xd5\x21\xab\x44\xb6\x36\x3e\xf6\xa7\x0a\x20\x33\x12\x9a\xf2\xd9\x08\x8a\x66\x3b\xfb\x93\x5e\x54\x3e\xa3\x25\x3a\x3e\x4b\xbc\xd1\x2a\xfa\x07\xf4\xca\x11\x4b\xe1\xd2\x9f\xda\x71\x86\x7d\xc3\xd4\x10\x48\x13\x70\x6c\x77\x8c\x27\xaf\xc9\x8c\xcf\x9f\x9e\x9e\x55\xc5\xcf\xb2\x6a\x08\xa2\xf9\xcc\xb2\xcc\x0a\x40\x77\x56\xcb\x71\x4d\x08\x68\x41\x8c\xfc\xeb\x64\x7a\xec\x2a\x43\xa5\x65\xe1\xe4\xdc\x68\x77\x1c\x0a\x91\x9a\x53\x19\x62\x6d\xb4\xac\x62\x44\x5a\x4f\x9a\x62\x24\x1f\xb4\x50\xfb\xf2\x39\x1f\xce\xb9\x19\xe0\x41\x39\xa8\x56\xff\x22\xe3\xa9\xcd\x87\x58\x4d\x11\x5d\x46\x11\x55\xa9\x45\x96\x45\x14\x82\x06\x9d\x26\x8c\x63\x54\xa9\x7c\x57\x48\xd8\x41\xb8\x56\xa2\x2d\x20\x58\x37\xb1\xe6\x84\xaa\xbe\x57\x3b\xfb\x95\xd4\xad\xb0\x35\x45\xaa\x30\xbc\xce\xf2\xbc\x06\x91\x9e\xc7\x40\x3b\x3e\x7d\x84\x38\x28\x96\x1b\xad\x9c\xd4\x43\xe3\xec\x4e\xc6\x42\x99\x2b\x26\x96\x29\xd8\x7d\xaf\x72\x6f\xaf\x75\x1f\x42\x6f\xaf\xb5\xb0\xc4\x6b\xee\xb1\x9a\xb8\xdb\x6b\x59\x63\x5b\xc0\x0d\x4d\x88\x83\x5b\xec\xf0\x5b\x49\x3c\x55\x76\x79\xf6\x02\x06\xe1\x1b\x44\xc5\x0b\x48\x73\x6a\xa0\x39\x4d\xcf\x4f\x26\x9e\x94\x12\xa1\xe6\x50\xfd\x65\x43\x06\xab\xc7\x9a\x23\xa8\x4b\x51\xbf\xb4\x55\x4c\x40\x75\x55\x10\x6a\xc4\xb8\x52\x42\x0c\x69\x83\x17\x2c\xbe\xc3\x20\xe3\x59\xb0\x81\x0b\xc3\x17\x82\x17\xd9\xc5\x7f\x82\xcd\x7c\x79\xd9\xba\x87\x2f\xc0\xee\xd1\x9c\x04\x48\xdf\xd1\x6a\x23\x43\x74\x3f\x2b\x0e\x20\x2d\xbe\xea\x18\xcd\x17\xaf\x3c\x52\xa8\xf8\xa4\xd9\x6b\x3d\xd4\x31\xf3\x6e\xe9\xfa\xbe\xe5\xf9\xf2\xc1\x4e\x81\xdf\x36\x88\x33\x61\x55\x38\xc5\xc9\x05\x7e\xfa\xa4\x32\xa8\xa2\x46\xad\xde\x40\xfd\x6b\xd4\xfb\xff\xfe\xdf\x20\x09\x07\x68\x1f\xa7\x51\x38\x5e\x41\x9b\xe3\x31\x4a\xc2\xf3\x51\x96\x22\x56\x3e\x58\x79\xfa\xf4\xc9\x11\x0e\xc2\x34\x4b\xc2\xfe\x0c\xe0\xfb\x51\x00\x41\x79\xc2\x08\xa5\xf1\x2c\x19\x60\x78\xd3\x0f\x23\x3f\xb9\x26\xec\x60\x92\x7a\x2c\x4a\x43\x02\xff\xc6\xb3\x0c\x4d\x80\xa7\x0f\x80\xb3\x7a\xc8\x4f\x30\x9a\xe2\x64\x12\x66\x19\x0e\xd0\x34\x89\x2f\xc2\x00\x07\x34\xe8\x04\x59\xa7\xc3\x78\x3c\x8e\x2f\xc3\xe8\x1c\x0d\xe2\x28\x08\xe9\x1a\x26\x95\x26\x38\xeb\xb2\x15\xbf\x8c\x54\xb4\x52\x50\x0c\x53\x7c\x06\x71\x80\xd1\x64\x96\x66\x64\xa3\xf6\xc3\x08\x80\xfa\xfd\xf8\x82\x7c\x9a\x5e\x43\x17\x51\x14\x67\xe1\x00\x7b\x34\xae\xd0\x38\x4c\x41\xb3\x2c\xb7\x17\x05\x1a\x32\x41\x98\x0e\xc6\x7e\x38\xc1\xc9\x8a\x0b\x87\x30\x92\x07\x82\xe3\x30\x4d\xe2\x60\x36\xc0\xf7\x8e\x06\x62\x5d\x0b\xe2\xc1\x4c\xc4\xc1\x20\x35\x56\xe3\x84\xc5\xc8\x98\xf8\x19\x4e\x42\x7f\x9c\xe6\xc3\x0c\x73\x03\xd5\x24\xd4\xc9\x3c\x9f\xec\xee\x1d\xa3\xe3\x83\x9d\x93\x5f\x36\x8f\xb6\xd1\xde\x31\x3a\x3c\x3a\xf8\x79\x6f\x6b\x7b\x0b\xbd\xf9\x17\x3a\xd9\xdd\x46\xbd\x83\xc3\x7f\x1d\xed\xbd\xdd\x3d\x41\xbb\x07\xef\xb7\xb6\x8f\x8e\xd1\xe6\x87\x2d\xd4\x3b\xf8\x70\x72\xb4\xf7\xe6\xe3\xc9\xc1\xd1\x31\x7a\xb6\x79\x8c\xf6\x8e\x9f\xc1\x87\xcd\x0f\xff\x42\xdb\xbf\x1e\x1e\x6d\x1f\x1f\xa3\x83\x23\xb4\xb7\x7f\xf8\x7e\x6f\x7b\x0b\xfd\xb2\x79\x74\xb4\xf9\xe1\x64\x6f\xfb\xd8\x43\x7b\x1f\x7a\xef\x3f\x6e\xed\x7d\x78\xeb\xa1\x37\x1f\x4f\xd0\x87\x83\x13\xf4\x7e\x6f\x7f\xef\x64\x7b\x0b\x9d\x1c\x78\xd0\xa8\x59\x0d\x1d\xec\xa0\xfd\xed\xa3\xde\xee\xe6\x87\x93\xcd\x37\x7b\xef\xf7\x4e\xfe\x05\xed\xed\xec\x9d\x7c\x20\x6d\xed\x1c\x1c\xa1\x4d\x74\xb8\x79\x74\xb2\xd7\xfb\xf8\x7e\xf3\x08\x1d\x7e\x3c\x3a\x3c\x38\xde\x46\xa4\x5b\x5b\x7b\xc7\xbd\xf7\x9b\x7b\xfb\xdb\x5b\x2b\x68\xef\x03\xfa\x70\x80\xb6\x7f\xde\xfe\x70\x82\x8e\x77\x37\xdf\xbf\xb7\xf6\x92\xe0\xae\xf4\xf1\xcd\x36\x7a\xbf\xb7\xf9\xe6\xfd\x36\x6d\xe9\xc3\xbf\xd0\xd6\xde\xd1\x76\xef\x84\x74\x27\xff\xd5\xdb\xdb\xda\xfe\x70\xb2\xf9\xde\x43\xc7\x87\xdb\xbd\x3d\xf2\x63\xfb\xd7\xed\xfd\xc3\xf7\x9b\x47\xff\xf2\x18\xcc\xe3\xed\xff\xfb\x71\xfb\xc3\xc9\xde\xe6\x7b\xb4\xb5\xb9\xbf\xf9\x76\xfb\x18\x55\xe6\x0c\xc9\xe1\xd1\x41\xef\xe3\xd1\xf6\x3e\xc1\xf9\x60\x07\x1d\x7f\x7c\x73\x7c\xb2\x77\xf2\xf1\x64\x1b\xbd\x3d\x38\xd8\x82\x81\x3e\xde\x3e\xfa\x79\xaf\xb7\x7d\xfc\x0a\xbd\x3f\x38\x86\xd1\xfa\x78\xbc\xed\xa1\xad\xcd\x93\x4d\x68\xf8\xf0\xe8\x60\x67\xef\xe4\xf8\x15\xf9\xfd\xe6\xe3\xf1\x1e\x0c\xda\xde\x87\x93\xed\xa3\xa3\x8f\x87\x27\x7b\x07\x1f\xaa\x68\xf7\xe0\x97\xed\x9f\xb7\x8f\x50\x6f\xf3\xe3\xf1\xf6\x16\x8c\xee\xc1\x07\xe8\xea\xc9\xee\xf6\xc1\xd1\xbf\x08\x50\x32\x06\x30\xf8\x1e\xfa\x65\x77\xfb\x64\x77\xfb\x88\x0c\x28\x8c\xd4\x26\x19\x82\xe3\x93\xa3\xbd\xde\x89\x5c\xec\xe0\x08\x9d\x1c\x1c\x9d\x48\x7d\x44\x1f\xb6\xdf\xbe\xdf\x7b\xbb\xfd\xa1\xb7\x4d\xbe\x1e\x10\x28\xbf\xec\x1d\x6f\x57\xd1\xe6\xd1\xde\x31\x29\xb0\x47\x9b\xfd\x65\xf3\x5f\xe8\xe0\x23\x74\x99\xcc\xd1\xc7\xe3\x6d\xfa\x53\xa2\x58\x0f\x66\x12\xed\xed\xa0\xcd\xad\x9f\xf7\x08\xda\xac\xf0\xe1\xc1\xf1\xf1\x1e\xa3\x13\x18\xb2\xde\x2e\x1b\xee\x95\xa7\x4f\x5e\xac\xaa\x3a\xaf\x7d\x3f\x1b\xdd\xaf\xde\xab\x5c\xd4\x69\x1a\xf8\x58\x14\xa1\x8f\xa5\xac\xb3\xe1\xc2\xce\x8f\xb2\x14\x65\x7e\x9f\x4b\x2c\xa4\xca\xa7\xdf\xc7\xd6\x60\x9b\xb9\x1c\x55\xf3\x10\xaa\x7b\x08\x35\x3c\x84\x9a\x1e\x42\x2d\x0f\xa1\xb6\x87\x50\xc7\x43\x68\xcd\x43\x68\xdd\x43\xe8\xa5\x87\xea\x35\x0f\xd5\xeb\x1e\xaa\x37\x3c\x54\x6f\x7a\xa8\xde\xf2\x50\xbd\x2d\x59\x58\xae\xd1\xba\xe4\x1b\x81\x47\xca\x13\x18\xf5\x36\x85\x4b\xea\x41\x5b\x2f\x19\xfc\x06\x83\x51\x87\x36\x72\x38\x4d\xd6\x56\x8b\xe1\xf2\x92\xc1\x58\x97\xf0\x5c\x63\xb0\x3a\x0c\x97\x3a\x85\x59\x97\x63\x2d\xd7\x59\x5d\x8e\x4b\x8d\xc2\x00\x3c\x38\x9e\x4d\x0a\x8b\xc0\xaf\xcb\xfd\x96\xe1\xb4\x58\xdd\x36\xc3\x7d\x8d\xc1\x68\x48\x78\xd6\x19\xac\x75\x86\x0b\xeb\x77\xbd\x79\x56\x7d\x25\xcf\x45\x32\x67\x2e\x38\x1e\x6b\xd2\x58\x35\x18\x4c\x8e\x73\x47\x1d\x0f\xe8\x5b\x53\xeb\x7b\x87\xd5\x69\xe6\xb0\xa0\x6e\x3b\xc7\x99\xc3\xe0\xe3\x01\x6d\xd5\xb5\xbe\x43\xa1\xb6\xd4\xc1\x35\x86\x60\x27\x1f\x5c\x01\xa4\x21\x0d\x34\x45\x36\x07\xb4\xce\xea\x48\x83\x05\x13\xd3\xce\x07\x57\xc0\x68\x4a\x03\x4d\x91\x95\x10\x6a\xb0\x91\xad\x49\xc0\xf8\x68\xac\x89\xd9\x13\x14\x8a\xd8\xe8\x50\x64\xd5\xd9\x48\xe7\xad\x0c\x8a\x22\x1b\x2b\x40\x4f\x6e\x89\xd3\x56\x53\x1a\xcf\x4e\xfe\x4d\xa1\xe9\x35\x0f\x3e\xc1\x50\x71\x7a\x7d\x99\xd3\x1e\xa7\xa9\x7a\x5b\x1a\xd6\x35\x56\x56\x99\x8f\x7a\x4e\x04\x62\x2e\x5e\xb2\x82\x9c\x78\xd6\xa5\x32\x1c\xf1\x35\xf8\x2d\x9f\xa5\xc4\x5a\x6e\xe5\x55\x79\xfb\x62\xcd\xcb\x6b\x62\x5d\x01\x99\x83\xe2\xeb\xb3\x9d\xd3\xbe\xe8\x67\x23\x47\x41\x8c\x13\x23\x19\x0a\x17\x69\x53\x32\x6f\x81\x30\xc4\x94\xc1\x6f\xe7\x08\x40\x3f\xd7\xf2\x85\x08\x0d\xb6\x18\x22\x1d\x0d\xe9\xa6\x3a\xf8\xa2\xd3\xf5\x1c\x8e\x18\x3b\xb1\xa0\xe1\xbb\x02\x47\x30\x90\xba\x34\x48\x9d\xbc\x5d\xb1\xf0\xd8\x02\xae\x37\x2d\xf3\x21\x3a\xa0\x21\xce\x01\x89\x05\xd7\x90\xfe\x6d\x8b\x55\xac\x0e\x50\xdb\x52\xae\xa5\xce\x8c\x98\xc9\xbc\x53\xa8\x5e\x47\x67\x4a\x96\xec\x4f\x23\xb2\x42\x2c\xf3\x81\x44\xa8\xe6\x9a\x87\x6a\x57\xed\xcd\xf5\xc6\xda\xcb\x97\x2f\xc9\xef\xce\xf6\xd6\xcb\xed\x37\x9b\x75\xf2\x7b\x7d\xa7\xfe\xe6\x4d\x6f\xab\x47\x7e\x6f\xbe\x6c\x37\x77\xb6\x5a\xdb\xea\x7c\x8f\x12\x67\x03\xed\xda\x66\x63\xfd\xcd\x76\x07\x1a\xe8\xb5\xb6\xb6\xea\x8d\x16\x34\xb0\xb5\x56\x6b\x6e\xef\x34\xc9\xef\xb5\xcd\xce\xd6\x5a\x67\x1b\x1a\xe6\x08\x9d\x59\xf5\x01\x47\x7b\x87\xdb\xfb\x5b\xf5\x4e\x0d\xc2\xef\xcf\xd1\x21\x89\xb2\xb9\x16\x49\x7a\x45\x77\xe5\xdb\xde\x15\x51\x65\x22\x20\xe1\x08\x82\xdd\x59\x6b\xb5\x1b\xcd\x1a\x8c\xe0\xf6\x4e\x6f\x6b\xf3\xcd\x3a\x74\xf0\xe5\xfa\x9b\xcd\xad\xde\xce\x36\xf9\x5d\xaf\x35\x1b\xed\xd6\x1a\x0c\x4e\xaf\xb9\xd5\xd8\xae\xef\xd4\xce\x9c\xaa\xf1\xb2\x4a\x79\xab\x62\xb7\xb4\x97\x52\xbd\xe0\xa6\x66\xbe\x39\x3e\xc5\x02\x74\xaf\xb9\x59\xa4\xe3\xfa\x66\xff\x93\x54\x9a\x5f\x1e\x7c\x32\x0d\x99\x50\xd1\x9d\x8a\x54\x0f\x6d\xa0\x8a\x59\x00\x51\x03\x50\xa9\xb1\xdc\xf0\x41\x7a\xb9\x98\x51\xa9\x01\x90\xd9\x95\x6a\x00\x4d\xeb\x52\x13\x5c\x81\x6a\x0c\xcd\xb3\x75\xde\x45\xe2\xfe\x81\x90\xa2\xf3\xca\x11\x18\xc0\xa7\xd1\xd8\x5d\x20\x81\x02\x89\xb3\x00\x88\x9f\x9f\x7e\x77\x43\x00\x99\xe8\xd3\xef\x6e\x08\xb0\x4d\x7f\x4a\xdd\x10\x60\xd3\xf8\x94\x26\xf6\x88\xd6\xab\xab\x64\x95\x7d\x21\x87\xe6\x0b\x3f\x09\x89\x74\x6c\xb9\xa4\xf5\xc7\x1e\xea\x8f\x3d\x34\x18\x7b\x28\x18\x7b\x08\x8f\x2d\x0d\xf9\x89\x87\xfa\x89\x87\x06\x89\x87\x82\xc4\x43\x38\xd1\x1b\xf3\x09\x2a\x3e\x41\x78\xd7\x74\x19\xe9\x27\x10\x74\x1c\x3e\xd6\xf5\x8f\x03\xf2\x71\x40\x3f\x36\xf4\x8f\x01\xf9\x18\xd0\x8f\x4d\xfd\x23\x1c\x18\x30\xfd\xd8\xd2\x3f\x8a\x34\xd5\xbe\x9a\x97\x9a\x77\x49\xbf\x15\xb4\x9a\x12\xc2\xbf\x4b\x1b\xa8\x6e\x5d\xdb\x19\x59\x3e\xfe\x18\x2d\xe5\x6b\x6a\xe9\xf7\xf1\x69\x78\x76\x56\xfd\x6a\x73\x62\x00\xaf\x9d\xd7\xf5\x4e\xf5\x8f\xa7\x4f\x54\xd6\x48\xda\x40\xc3\x7a\xa5\x3f\xf6\x06\x63\x2f\x18\x57\xd1\x12\x1a\x8d\xed\xbe\x37\x37\x48\x28\xe4\xc2\xd7\xcd\x06\x55\xb5\x59\xa0\x35\x74\x68\xc6\xc8\x1b\xd0\x5a\xeb\x4e\x68\x4d\x1d\x9a\x31\x55\x06\xb4\x4e\xcb\x09\xad\xa5\x43\x33\xe6\x56\x82\xf6\xc7\xea\x2a\x83\xb8\x5e\x73\x42\x6c\xeb\x10\x0d\x82\x40\xf6\x30\xe9\x64\x12\x33\xeb\x74\x91\x2f\x28\x89\xb3\x71\x25\xf3\x52\x32\xad\x36\xa7\x0d\xa0\x81\x6c\x09\x8f\xed\x53\x0e\x2b\xc2\x58\x52\xe4\x0f\xe8\x36\xb0\x7d\x01\x72\x87\x76\xc9\x9a\xac\x5b\xdd\x80\x60\xbd\xf4\x6d\xb5\x61\x99\x19\x37\x89\x02\x55\x3f\x41\x4b\x12\xb5\x26\xb7\xa7\xd6\x76\xa5\x9f\x78\x83\xc4\x0b\x12\x18\xf1\xe4\x6e\xd4\xda\xd2\xa1\xdd\x95\x5a\x55\x68\x77\xa2\xd6\x86\x0e\xed\xce\xd4\x5a\xd7\x21\xde\x33\xb5\x26\x70\x6b\x5d\x40\xae\x89\x83\x5c\x81\xa3\x26\x36\x72\x05\x46\x6c\xfb\x02\x2c\x9a\x92\x6b\xe2\x24\x57\xd8\x00\x6c\xb5\x61\x6b\x30\x2d\x34\x74\x56\xbe\x27\xa7\x63\x00\x19\x12\xac\x7e\x35\x09\x93\xfc\x67\x03\x55\x76\xa9\x69\xee\x80\x70\xe6\xc0\xd2\xd3\x5d\x66\xc2\xbb\x4b\xcd\x6f\x03\x52\xce\x36\x22\xbb\xcc\x4c\x77\x97\x1a\xd2\x62\x52\xce\xb7\x96\x6b\xb2\x72\x60\x2c\x0b\x3b\x42\xdf\x5a\xae\xc5\xca\x81\x61\x72\x9f\x94\x1b\x58\xcb\x81\x01\xb3\x32\x2c\xba\x58\xbb\xc3\x52\x6b\xdc\xc1\x3c\x2b\xf0\x33\x5f\x08\x43\xe4\xc1\xb2\xf1\xcf\x4f\xc3\xc8\x4b\x46\x6f\xc2\x2c\x3d\x89\x33\xe0\x78\x14\x66\xb4\xe5\x67\x3e\xb5\xda\x7a\x81\xd6\x2d\xd0\xa1\xce\x7b\x3c\xcc\x8c\xa4\x8d\x50\xde\xe8\xcc\x66\x10\x98\x59\x88\x11\xcb\xb7\x48\x8d\x99\x72\x90\x44\x9a\x6c\x9f\xa1\xaf\x1b\x34\xb1\x70\x6e\x23\x21\x4a\xfc\x03\x35\x1b\x3a\xb5\xe6\x90\x2a\x95\x4a\x5e\x74\x09\x11\xfe\x40\x40\xbe\xac\x12\x50\x2d\xb2\x6e\xeb\x2d\x87\x00\xcd\xab\xd2\xe1\xc8\x85\x67\xe9\x65\x79\xe1\xd9\x00\xc6\x04\x67\x0d\xd8\x3c\xc1\xd9\xd6\x51\x39\x4f\x47\x9e\x0f\x93\xe7\xd8\x01\xe3\x18\x4b\xda\x8e\xd5\x55\x38\x09\x22\xc8\xee\x42\x1d\xb2\xac\x86\x53\x53\x7a\xf2\x32\xb3\xb9\x14\x93\x25\xac\x6e\x59\x46\xb7\x10\xce\x2e\xda\x40\xb2\xf8\x7e\xb7\xf3\x5b\xbb\xd4\xf1\xcd\x7e\x22\xdb\x85\xa3\xd8\xae\xc5\x99\x04\x15\x9d\xc1\x76\x85\xbb\xde\xae\x72\xbc\xda\x5d\xf8\x5c\x45\x29\x64\x57\x39\x53\xed\x3a\x0f\x53\xf3\x4d\xe1\x8e\xe8\x4d\x38\x9d\x5c\x96\xc1\x22\xf8\xff\xd9\x7b\xfb\xed\xb8\x6d\x64\x41\xfc\xef\xf8\x29\x30\xf3\x3b\x63\xb7\xa2\x96\x44\x80\x5f\xa0\x6d\xe5\xb7\x89\x62\x5f\x67\x63\xc7\x3e\xb6\xb3\xf1\x3d\x3e\x76\x06\x24\x41\x35\xe3\x56\xb7\x6e\x37\x65\xb5\x32\x71\xce\xbe\xc6\xbe\xde\x3e\xc9\x1e\x14\x40\x12\x24\x01\xb0\x5b\x96\x73\x27\x33\xd6\x9c\x71\xba\x9b\x85\xaa\x42\x7d\xa1\x88\x8f\x02\x08\xbb\x0b\xaa\x56\xcc\x7b\x0a\xea\x84\xa9\x6c\xbe\x5c\xb8\x03\x14\x6c\x25\x10\x50\xed\xec\x02\x7c\xb5\x6f\x83\x90\x8f\x7f\x1e\x18\x89\x6c\x37\xb4\x35\xc5\x26\x3c\xed\xec\x8b\x82\x8f\x1f\xe4\xea\x3f\xd2\x77\xc4\x15\x78\xb2\x99\xa2\xab\x29\xfa\xd5\x74\xcd\xc7\x64\xb2\x81\x93\x9d\x57\xf0\xef\xaf\xed\x6d\xed\x1f\x06\x78\x88\x1b\xcf\x64\xb3\x77\x7b\x72\xb5\x27\x8f\x93\xff\x2e\xbe\xfc\xba\xb7\xb7\x77\xcf\x86\xcd\x1f\xc5\x26\x10\xfd\x2e\x30\xb6\xac\x59\x70\x05\xe3\xb8\x6e\x03\x06\xe0\xed\x6a\xef\xf6\xe4\x77\x60\xce\x8e\x31\xdc\x46\x66\x42\x68\xbf\xb5\xa8\x2c\xb8\x20\x95\xd8\x4c\x17\x46\x4c\x9b\xfb\xf7\x17\xc0\xd5\xe6\xab\xaf\xbe\x9a\xf8\xe4\x60\xa1\x33\x25\x3f\x38\x77\xc3\xd4\x9b\x61\xe4\x3d\x70\xdb\x6d\x86\xb1\xde\xf6\xa3\xf6\xb7\xc0\x9e\xa7\xfa\x73\xb5\x94\x91\x69\x88\xc6\x72\x3f\x8f\x05\xfa\xba\x17\xf3\x28\xcf\x68\x77\xb2\xd4\x13\x78\x93\x3b\x8a\xc5\x3b\x86\x5d\x38\xf6\x56\x97\x35\xb7\xa6\xed\x36\xc3\xc9\xc1\xde\x56\x9b\x1a\x60\xbb\xad\x4a\xb5\x72\x1e\x3d\xf9\xfa\xe4\x0f\x50\x8d\xa3\xf9\x3b\x7e\x05\x4d\xd7\x3c\x5b\xf1\xca\x72\x77\x92\x45\xa1\x70\xe5\xe0\x0d\x2a\x54\x5e\x64\xd8\xa8\xe6\xd1\x19\xcb\x5a\xf5\xe8\x5b\xac\x0c\x1a\xea\x00\x0f\xb5\x74\xc6\x32\x83\xa6\xbe\xf8\x20\xd7\x81\x2d\x5b\xa3\x6a\x48\xf3\xed\x44\x1f\xde\x4c\xe3\xf8\xf3\x16\xa7\x7f\x85\x23\x2b\x9f\x7a\xe9\xbe\x57\x58\x4d\x23\x6c\x2d\x99\xf6\xe2\xd1\xd7\x07\x78\x8b\x95\x8c\xe1\x5d\xd5\x37\xb9\x7e\x71\x0c\xa7\x4f\xdb\x25\x8c\x72\x51\x56\x13\x43\x01\xaa\xee\x92\x06\x2f\xb2\x9c\xa5\x34\x31\xd4\x66\xf2\x36\x09\x4d\x59\x9e\x15\xbc\xb3\xc6\x61\x02\xcc\xfc\x9c\x70\x5c\x78\xdd\x67\x1f\xbf\x04\x62\xcb\xd0\xcd\xc9\xf7\x70\x06\x7d\x80\x60\x9b\xb9\x67\xf3\x74\xb1\x78\x94\x9a\x27\x8b\x21\x61\x34\x4f\x15\xc3\xeb\xaa\x79\xa2\x58\x3c\xe2\xcd\x34\xf1\x80\x53\xeb\x3c\xb1\x75\x4e\xd8\xf2\xb6\x00\xf3\x3e\x48\x9e\x30\xb5\xd4\x82\xf9\x49\x26\xfe\xdd\x12\x18\xdd\xb3\xa7\xf5\x5f\x3d\xa1\x64\x46\x54\x9f\x73\xf8\xe9\x75\x89\x0e\x90\xff\x06\xbd\x55\x1f\x69\xfb\x11\x07\xda\xe7\xc8\x76\x77\xa4\x62\x69\xb2\x80\xc3\xb1\xf2\xdd\x12\x5e\x1f\x7c\x6c\x2e\x53\x63\x7e\x13\x82\xa9\xa5\x09\x13\x48\x42\x40\xc2\xe4\x9b\x4c\x0c\x07\x64\x39\xda\x07\x42\xb6\x89\x46\x74\x1f\x11\xcf\x2a\x35\x98\x36\x9b\x4c\x52\x74\x1b\x65\x32\xcf\x15\x1f\x73\xc0\xec\x6d\x42\x26\x57\x61\x47\xa6\xf8\xd0\x7d\x14\x8c\x91\x48\xd1\x5b\x94\xa1\xb7\x28\x97\x98\x23\x9e\x27\x3c\x65\xa6\xa2\x43\x3d\xcc\xd1\x0e\xcc\x4b\xde\xc5\xa7\x4c\xf5\xe2\x00\x79\x9b\xd8\xe3\x41\xe0\x93\xc0\x4e\xeb\xe8\xcb\x86\x1c\xf5\xf6\xd0\x97\x47\x5b\xf7\x45\xe0\xf7\xc3\x24\xf7\x39\xe9\xcf\xf2\x20\x8b\x4a\x85\xbf\xe4\xa6\xe9\x3e\x74\x8c\x32\xd3\x14\x1f\x02\x92\xf7\xef\x23\xdf\x53\xbd\x04\xf5\x1b\xef\x16\x45\xc7\xc8\xc4\x07\xdb\xee\xb4\xd6\x56\x93\x81\x6a\x12\xad\x9e\x6c\x63\xfd\x13\xde\xa8\x33\x11\x08\x13\x86\x83\xca\x27\xa8\x33\x09\x08\x93\x85\x99\x19\xc6\xd7\x27\x0a\x73\x33\x4c\xa0\x4f\x12\xf2\x3e\xcc\xe7\x09\xbe\x7f\xd6\x09\x3e\x91\x0b\x1f\x16\xf3\xe5\x72\xa5\xcf\xb9\x1d\xc1\x40\xad\xfe\x3e\x8a\x08\xd4\x42\x68\x31\x8f\xcc\xd3\x0d\xa6\xe9\x3e\xd1\x0c\xdd\x8e\xf3\x40\xc6\xe9\xba\x3f\xe3\x6c\xd0\xe7\x29\x84\xc1\x64\x80\x48\x9f\x77\x9a\x3d\x80\x06\xae\x89\x83\x6e\x42\xde\x9d\x33\x10\xcf\x3e\x4f\x17\xdc\xe8\x74\x01\xe8\x63\x8b\x99\x02\xb3\x5a\xda\x49\x02\xa5\x1a\xfb\xb1\x29\x01\x60\x9f\x16\xa0\x7f\xea\x02\x1b\xeb\x19\x23\x61\xf4\xa9\x6b\x63\x28\x2a\xff\x3e\xd3\x07\x83\xe9\x01\xfd\x1d\x9e\x84\x51\xe7\x2d\x5e\x3b\x85\xdd\x9f\x15\x20\x24\xd8\x6e\x5e\x40\x00\x76\x70\xc2\x77\x89\xfc\x0f\x9d\x1b\xc8\xb0\x17\x26\x3c\xa7\xe2\x95\xdf\x8f\xe2\x2c\x0f\xbd\x18\x3e\x7b\xb1\x97\xe7\x18\x3e\x17\xb1\xc7\xc3\xc4\x37\xcf\x19\x14\x45\xe6\x79\xa9\x0f\x93\x0b\x11\x0d\x29\x0e\xb1\xfc\x1c\x14\x09\x2d\x18\x20\x48\x79\xc1\x82\x82\x05\x3b\x4c\x17\x6c\x95\x79\x6a\x61\x5f\x89\x4e\x6b\xe9\x38\x45\x0b\x11\xb5\x49\x67\x0e\x8e\x87\xc9\x8b\x65\x61\xe9\xf3\x10\x3d\x32\xe2\x12\x12\xec\x3a\x48\x8b\x26\x23\xc3\x74\xc7\x3b\x06\x03\x35\x21\xe6\x43\xec\x9f\x87\xea\x8f\x18\xaa\x85\x56\xb6\x1b\xac\x8d\xca\xe9\x0c\xd7\x52\x41\xce\x01\x9b\x90\xfe\x51\x67\xed\x5c\xb3\x1a\x8e\xee\xc6\x89\x18\xc0\x93\xcf\xf3\xfa\xff\x3d\x03\xf3\x9f\xef\x58\xde\x77\xf2\x12\x87\xf2\xd7\xe6\x54\x2e\x5a\x2d\x2f\x16\x39\xca\xba\xe7\xf5\xb4\x1e\x3c\xea\x5f\x9d\xf2\x7d\x77\x19\xa0\x9e\xa8\xe5\x2d\x0e\xf9\xc4\x94\xc1\x20\x7d\x49\xb9\x5c\x3f\x5b\x95\x67\x7c\xb2\x30\x0e\x63\xeb\xff\x5a\x55\x3f\xd4\xef\xf9\xe2\xcb\x64\xd1\x7f\xcf\x6c\x26\x82\xa5\x3a\xd1\x31\x22\xf7\xea\xcf\xf7\x8f\x25\x86\xfa\x07\xc7\xdc\xf0\x5f\x26\x0b\xf4\x37\x05\xb6\x67\x9d\x2f\x54\x3e\x5a\xb0\xf9\x9a\x8f\xef\x0a\xec\xcf\x8f\xd5\xef\xe3\xab\x8b\xee\x1b\xae\x41\x2c\xa7\xbc\x7a\xb8\x62\xf0\x99\xcd\xbf\x29\xab\xb5\x41\x40\xcd\x12\xfe\x02\x1d\xa0\xc9\x02\x2a\x7b\xee\xa1\x2f\x3b\x93\x1f\xfd\x99\x2c\x8d\x56\x3d\x4b\xad\x57\x66\x87\xdf\x40\x21\xbd\xfa\x3d\x97\xb3\x72\xce\xd1\x44\x3d\xbb\x8f\xd4\x96\xcc\xbe\x14\x5b\x6d\x5a\x05\xdd\xa0\xa0\x56\x29\x3f\x7a\x2d\x81\xa0\xec\xe8\x40\x10\x60\x0b\xe7\xcb\xcb\xc9\x62\x8a\x30\x3a\x42\x64\x6f\x8b\x8a\xed\x08\x6e\x42\xd9\x05\xad\xbf\x67\x2c\x9e\x2d\x51\xec\xef\x8f\x4c\x85\x2e\x3a\x10\x75\x86\x34\x69\x71\x5e\x7f\x8d\x4d\x24\xde\xdb\x65\xd3\xc3\x0c\xfd\x93\xaf\xb4\x3d\x3a\x5c\xcf\xcb\x8c\x4f\xbc\xbd\xcf\xab\x5e\x5b\xaf\x7a\x0d\x1e\x15\xf0\x28\x34\x3d\x3a\x85\x47\x83\x05\x23\xc8\x59\xe0\x51\xfc\xd1\xcb\x68\x91\xa3\xd6\xfd\x1f\xbd\x8c\x76\xca\xce\xce\x98\xb7\x69\x16\xd3\xf0\x40\x28\x43\x68\xd8\x68\x3c\xa9\x5b\xde\xbf\x8f\x88\x5c\xf4\xaa\x7f\xf9\xea\xab\xaf\x50\xbc\xb7\x87\xd0\x5b\x33\xa6\xee\x5f\x07\x13\x0e\x06\x98\x30\xdd\xdb\xdb\x0e\x53\xb7\x9d\x6f\x0c\x2f\x9d\x9e\xe0\xb6\xdf\xc6\x4d\xf2\x5d\x60\xad\xdb\x58\x32\xab\x75\x1b\x6f\xea\x7a\xd3\x5b\x32\xdb\xc5\xe4\x0f\x31\x25\x3b\x76\xbb\x6e\x67\xbe\x93\x00\xb5\x86\xa3\x94\xb8\xaf\x7a\x0e\x45\x7e\x55\x0f\xf7\x9d\x0b\xa6\xb6\xd5\xcf\x0c\x4e\x35\x4e\x38\xba\x8d\x0a\xd8\xec\xf6\xbb\xf8\x78\x6a\xbb\xc2\xe5\x8c\x41\x85\x39\x86\x6e\xa3\x14\xc0\x99\x5c\x1d\x7c\x8b\xd4\x3a\xa1\x89\x7f\x48\x56\xca\x53\xc1\x78\xb3\xd4\xaa\x16\xdb\xd4\x5a\xab\xdc\xfa\x27\x9f\xe0\x44\x7b\x82\xfd\xce\xa3\x4e\x23\xf3\xd8\xd6\x10\x83\x7b\x6a\x26\x1c\x6c\x5c\x56\x4e\xe6\xd0\x2e\x52\x18\xe5\x13\xac\x3d\xc1\x58\x7f\x14\xcb\x9d\xad\xf2\x11\x09\xcd\x23\x1e\x2c\x20\x0b\x4a\x33\xb4\x5f\x93\xdd\x17\x42\xdd\x97\x17\xbd\x59\x17\x8f\xa1\x21\x41\xc7\xb5\x60\xf6\x85\x68\x4d\x14\x44\xe0\x3a\x35\x20\x10\xb1\xae\x5f\xa7\x5d\xfc\x89\xf0\x68\x4a\xbf\xa0\x76\x26\xdc\x96\x80\x4d\xcb\x7c\x68\x64\x89\xb4\x5f\x6d\x1d\x8d\x2c\x87\x4e\x2a\x21\x88\x8a\x98\x68\xfd\xbb\x2c\x8d\x4a\x98\x50\xc1\x40\xc9\xf0\xc2\x0c\x13\x29\x18\x28\x09\x7e\x6a\x86\x89\x15\x0c\xf8\xfc\xec\xf3\x32\xec\xe7\x65\xd8\xcf\xcb\xb0\xc3\x6c\xf3\xf3\x32\xec\x3f\xe5\x1c\x6f\x18\xed\x3c\xc7\x1b\x46\xa3\x73\xbc\xfa\x3b\xdb\x70\x8e\x37\x8c\x3e\xcf\xf1\xde\xf8\x1c\x6f\x18\x6d\x3b\xc7\x6b\x52\x4e\x77\x8e\x17\x14\xe4\xde\xb4\xdd\xac\x9d\x99\x97\x66\xa9\xf7\xa7\x5e\x9a\xdd\x44\xc1\x1f\x72\x71\x41\x43\xe7\xf3\x2c\x70\x77\x16\x78\x13\xc1\x9a\xea\xe1\x26\x0a\xb4\xdf\x5f\x45\x81\xaa\xd2\x0d\x10\x87\x5a\x9d\xe8\x9d\x6a\xba\x69\xfd\x7b\xfe\xe8\xe9\xcf\x4f\x1f\x3e\x7c\xf1\xe0\xe5\x8b\xfe\x6c\xf1\xb3\xef\x7e\xfe\xee\x87\x6f\x1f\xbc\x7a\x30\xbc\x95\xfb\xf9\xd3\x1f\x7f\xf8\xf6\xe7\x93\xa7\x3f\xbc\x78\xf9\xf5\x0f\x4d\x4b\x8d\x9c\x9c\x56\x3e\xd9\x6e\x5a\x59\x6b\xb1\x9a\x2d\xeb\xa2\x2d\xbd\x39\xe9\x9a\xb4\x78\xbb\xc6\x53\x74\x65\x2b\x55\x5e\xc9\x29\x91\x0a\xdd\x47\x24\xb8\x87\x2a\xc3\x94\x88\xd6\xe7\xd7\x1b\xb4\x8f\x42\xf4\x25\xba\x92\xa7\x07\xab\xfa\x90\x26\x7c\x22\x7b\x30\x53\x89\xfe\x86\xa2\x41\x2e\x02\x69\x20\xbf\x7c\x85\x8e\xd1\x15\xfa\x1b\x0a\x4d\x59\x22\xbf\xfc\x4f\x81\x95\xa0\x2f\x91\xa0\xe3\x0b\x3a\x7b\x06\xe0\x8d\x9c\x96\x7b\xd5\xfb\xf9\x4a\xfe\xfc\x9f\x96\xa9\x60\x4d\x6c\xe7\x25\x2a\xe1\x3a\x01\x83\xd0\x1a\xc9\x6c\xa4\x64\x36\xf2\x80\xe6\xc6\x20\x98\x06\x54\x4a\x17\x5d\x49\xd0\x2b\xcb\xb4\x52\x6b\x20\x5d\x31\x5e\xc1\x05\x3f\xc3\x5e\x0b\xb9\xf6\xbb\xfe\x61\xb4\x6f\xbd\x55\x8e\xae\x35\x3c\x7e\xf8\xe2\xb9\xe0\x75\xe3\x61\x93\x31\xe8\xf7\x4e\x58\xe6\xc7\x04\x18\x90\xa8\x8d\xf5\xc9\xfa\xb2\x67\x5b\x46\xb0\xc7\x35\x98\x45\x84\xea\xe6\x89\x5f\xd0\x7d\x14\xdf\x43\xbf\x38\x66\xe6\xa0\x0f\x70\x34\xd5\x5c\x15\xa5\x26\x9f\x96\xd5\xb3\xe5\x1a\xea\xb8\x0a\xab\x82\xcb\x72\x7f\xd9\x43\x07\xc8\xb4\x9b\xba\x46\xae\x37\xba\x8f\x54\xbd\x08\x13\xb0\xf8\x1b\x74\xf0\xed\x31\x02\x32\x1a\x16\x0b\xad\xee\x8e\x6a\x9d\xea\x57\xc7\x40\xd6\xbe\xb9\x7a\x40\xf9\x89\x46\xb9\x83\xea\xc0\xf0\xde\xd3\x30\xb0\xdd\xd4\x92\x66\x58\x0b\xbe\xa9\xc0\x80\x46\xd4\x42\xed\x3b\xd1\x8f\x8e\xd0\xb3\x55\x79\x56\x56\xe5\x7b\x8e\xce\x97\xf3\xab\xc5\xf2\xac\x64\x73\xb4\x7c\xcf\x57\xe8\x3f\x1e\x4e\xc8\xde\x5d\xb4\x79\x4b\xd1\x3e\xda\xbc\x8d\xe0\xdf\x10\xfe\x0d\x44\x98\x31\xa3\x54\x16\x2d\xc9\xcb\xf3\x03\x6f\x91\xb7\x89\x1d\x5b\xe6\x2d\xcc\x29\x0c\xc7\x46\xfb\x18\x59\xf4\xea\x05\x78\x39\xc7\xa7\x86\x9f\xba\xc0\x58\x5f\x67\xd3\x81\xfd\xec\xed\xba\x9a\xb2\x06\xff\xa9\xf8\xd9\xf9\x72\xc5\x56\x57\x9d\x9b\xe8\x84\x0b\xbc\xd4\x07\x22\xeb\x2a\xa5\xf1\xd6\x19\xb3\xf7\xbf\x34\xf6\x6c\x8c\xef\xde\xda\x8e\xbf\xdd\xca\x8e\xdf\x59\xd7\xf1\x5d\xab\x3a\x37\x7f\x95\xc0\xf2\xa2\x3a\xbf\xa8\x1e\xc3\xab\x75\x07\x16\x41\x92\x9e\xf3\x75\xb9\xe2\xb9\x76\xd1\x40\x5a\x56\xeb\xba\x20\xb4\x6c\xdc\x79\x5b\xa8\x1b\x3f\x5d\xcc\x6b\x35\x69\x35\xb8\xd9\x8a\xdf\x45\x84\x04\x53\x44\xc2\x68\x8a\x7c\x1a\x4c\x51\x88\x49\xbf\xb1\xba\xb3\xe0\xae\x78\xa6\x3f\xea\x5f\x5a\x50\xbf\x34\x5b\xef\x2d\xd0\x7b\xd7\xc3\x76\x8d\xfb\x0b\x60\xa6\x16\x6e\x42\xac\xdf\xbd\xeb\x6f\xaf\xdf\x58\xa2\xfd\x16\xa6\x26\xfe\x00\x8f\x34\xb9\x05\xbf\x6c\xcc\x0e\x16\xe1\xc6\x4a\x09\x00\x27\xcd\x6d\xbd\x30\x02\x44\x9e\x87\x0e\x90\x18\x68\x9b\x9b\x12\x74\x49\x88\xec\xc5\x27\x9f\x6a\x45\xcf\x30\x31\x67\x10\x9a\x71\xf2\xac\xee\xc4\x63\xb6\x80\xb9\x9f\x5e\xd7\x8e\x10\x31\xcd\xa1\xa5\xeb\xe5\x2a\x1d\x97\x7f\x0f\xfd\xc7\x54\x12\x7c\x42\x4a\xd4\x5d\x14\x13\xb2\xb6\x4e\x9b\x3f\x21\x70\x07\x7d\x1f\x5c\xc4\x7a\x57\x31\x0b\xeb\x15\xd4\x82\xbc\xb3\x9e\x20\xe9\x14\x12\x24\xd7\xa9\x20\x48\x3a\xa5\x03\xc9\xf5\x6b\x06\x2a\x86\xf1\x18\xc7\xb8\xcb\x32\xbe\x16\xcf\xb8\xcb\x34\xde\x85\x6b\xa3\x1e\xa4\x71\x35\x53\x23\xe5\xa2\x5a\x4a\x6b\x36\x6b\x7a\xce\x60\x32\xaf\x76\x67\x83\x28\x04\xc4\x21\xdc\x37\xfb\xf6\x18\xe4\x62\x83\x99\x2f\x2f\x91\x82\x19\x5f\x8d\x78\x2e\x06\xd8\xb5\xc5\x07\x64\xa2\x0c\x7e\x20\x3f\xca\xa4\x17\x3e\xdb\x5d\xe0\xe5\x8c\x57\x6c\xf8\x64\x87\xb7\x06\x0d\xd9\x93\x52\xbc\x82\xcc\x2f\xce\x16\xd0\x39\x83\x5b\xd5\x12\xac\xd3\xec\x29\x6a\x33\x69\x23\xf0\x8e\xef\x24\x3a\x8d\x8e\x96\xda\x37\x14\x0b\x21\xf1\x57\xa7\x9e\x8d\xf6\x5c\xb0\x8f\x35\xd8\xf9\xf2\xd2\x9a\x97\x5a\xa5\xf5\xd2\x98\xe7\x98\x7a\xf2\x52\x68\xe1\xe5\xeb\x8d\x8d\xf7\x97\x1b\x69\x6b\xc7\xd0\x03\x3b\x10\x18\xdb\x31\xb0\xbe\xdd\xee\x9b\xeb\x99\x81\x23\xac\xb6\x3d\x0a\xa0\x4b\x13\xa1\x97\x00\x5e\x0f\x5d\x8b\xe5\x2f\x37\xb8\x05\xc7\xdb\x80\x4b\xfb\x7a\xb9\xc1\x2e\x3d\x2a\xd8\xc7\x0d\x2c\xe8\xd1\x69\xde\xeb\x8b\x15\x78\x94\xbc\x4e\x44\x98\xfa\xb8\x95\xbf\xdc\x04\x2a\x16\xa0\xc9\x44\xf1\xd6\x1c\x0d\x56\xf4\xd5\xf9\x60\xdb\xeb\x0d\x60\x7b\xdc\x60\x93\x51\x43\x62\x7b\xdc\xc3\xf6\x64\x1c\xdb\x1f\xea\x54\x9d\x50\xe8\xb0\x4f\xd4\x0f\x89\x16\x33\x45\x3b\xbd\xed\x3d\x9f\x2d\xd1\xb3\xd2\x61\xd9\x82\x64\x7d\xe7\x23\xbe\xa7\x7d\x95\xa9\x5c\xf3\xfd\xa3\x4d\xbe\x23\xb9\x06\xad\xcb\x8c\x05\x90\xb4\xa0\xb1\x80\x54\x43\x3f\x6e\xa1\xed\x21\x09\x06\x8b\xd9\xf2\xa9\xcc\x52\x8e\x3b\xf3\x61\x3a\x5f\xd6\xce\x3e\x5f\x42\xa2\xe7\x08\xf1\xe2\x05\xba\x25\x31\x3a\xf1\xa0\xf9\xca\xa4\xee\xf4\xfd\xfb\x2d\x93\x60\xda\x75\xff\xe0\x2a\x4d\x9f\xa0\x03\xed\xb9\xcd\xd0\x51\xd7\x75\x1a\x1c\x46\xe4\x4f\x76\x44\xde\x9d\xf3\x68\xbb\xbb\xd5\x8c\x47\xbf\xcb\x8a\x2b\x0d\x0d\xcc\x76\x0c\x99\x8b\x82\x6b\xf7\xfc\xc9\x08\x8d\xc7\x3b\xd2\x70\x8d\x6d\x2b\xb6\x58\x9f\x2f\xd7\x4e\x2b\x81\xf0\xfb\xac\x7c\x2c\x1d\xe3\xe5\x6b\x6d\x42\xb1\xb5\x43\xeb\x98\x27\x1b\x6e\x33\xf0\x29\xc8\xb1\xd1\xcf\x1a\x3f\xce\x4b\xc4\x2a\x18\x02\x21\x5e\x9a\x73\xc2\x97\x1e\xf4\xc1\x58\xb4\xb5\x79\x39\xf2\x9a\x00\x60\x84\x7b\xe9\xd5\xdd\x91\xd0\x36\x97\x7f\xe9\xd5\x9d\x51\x70\x96\x71\xeb\xe8\x08\x9d\xcc\x5c\xc1\x6f\xfb\x61\xfd\x9a\x43\xc6\x78\x68\x44\x5a\xf8\xaa\xe3\x70\x33\xae\x8c\x18\xf7\x6e\x21\xb5\x6e\xf5\xb2\x31\xb8\xed\x9b\x6c\x70\xd3\x68\xa2\x25\x21\x7b\xdb\x0c\x80\x12\x01\xe9\x21\x20\x03\x04\x4e\x29\x8a\xdc\x63\xb5\xbc\x74\x08\x71\xae\x79\xc3\xcb\xd6\x35\xde\xa2\xc9\xef\x8a\x7d\xf9\xc3\xed\x9a\x19\xf8\xea\x8a\x1f\x73\xcd\x6b\x5e\xb6\x2e\xa4\x63\x84\x1f\x5a\x8c\xf3\xe5\xe5\xc7\x4f\xd0\x7e\xb7\x34\xbd\x91\x0c\xf4\x6d\xf5\xb4\xce\x34\xa4\x18\xdf\x7a\x93\x99\xf0\x7c\xf4\xa5\xad\x83\xc5\xe6\x88\x9d\x7c\xa5\xdb\x42\xb8\xa4\x63\xb1\xe3\x9f\x6b\x5b\x94\x61\x92\xe6\xc6\x77\x45\x0d\xe0\x9b\x19\x1f\xd1\x6e\x38\x0d\xf4\x25\x4c\x5e\x0d\xe7\x81\xae\xbb\x97\x0a\x5f\x67\x2b\x15\x6c\x92\xca\x78\x39\xef\xee\x77\xc2\x7b\xe8\xa8\xcb\xff\x1e\xfa\xb2\xff\x03\x10\x87\x05\x9a\x66\x37\xd7\x3f\xc9\x26\xa8\x8f\x9e\xc3\xd3\xa7\x19\x6b\xe6\x8d\x73\x90\xe8\xc8\xa8\x7a\x1d\xa4\x9e\x05\x1c\xe2\x3c\x32\x6e\xa6\x7b\xf1\x5f\x17\x9c\xff\xca\x87\x48\x67\x6c\x3d\xab\x8d\x7b\xab\xbb\xe8\x07\x5c\x7c\xcc\x64\xe1\xf8\x9c\xd0\xf6\x29\xbd\x2d\x9d\xdf\x7d\x0e\xb1\xa5\x67\x9f\x95\xd3\x52\x43\x35\x31\xa7\x27\x9c\x3b\xcd\xcd\x69\xa8\xd4\xf4\x9c\x8e\xea\xba\xf3\x8a\xad\x28\xdc\x9d\x78\x3c\xe8\xc4\xe3\xeb\x76\xe2\xf1\xa0\x13\x8f\x77\xeb\x84\x59\x55\xd2\x74\x95\x93\x55\x4b\xb4\xe2\xd5\xaa\xe4\xef\xb9\x61\x03\x22\x52\x87\xbb\x65\x3c\x38\xbf\x58\xcf\x6a\x36\x4c\x22\x32\x40\x3e\x19\x42\x7e\x7c\x79\x62\xc3\xe9\xa1\x86\xf4\x74\xe8\xc2\xd6\xf3\x44\x37\xb4\x6b\xd2\x1e\xbf\xd4\x16\x4a\x43\x38\x6b\x0e\x3b\x6d\x11\x21\xb6\x5c\xcc\xa9\x3f\xb6\xfb\x33\x9d\x62\xff\xbc\x5d\xf3\x9a\xdb\x35\xfd\x5d\x37\x6b\xfa\x63\x5b\x35\x7d\xc7\x46\x4d\xff\xf3\x36\xcd\x9b\xde\xa6\xe9\x6f\xb9\x49\xd3\xa0\x96\xce\x16\x4d\x7f\x9b\x0d\x9a\xbe\xfd\x18\x7e\xb3\xf1\xf0\x2e\x0d\x3e\xbc\x99\x52\xfc\x2f\xb2\x5d\xb3\x5f\x60\x27\xc4\xe4\x0f\xdb\xc3\x59\x97\xdb\x11\x34\xff\x5c\xe5\x76\xae\xb5\xdb\x52\x3d\x6e\x77\x7b\xd6\x30\x3b\x15\xe4\x09\x31\xe9\x6c\x0b\x09\x31\xb1\x6e\x33\xa1\x5b\x16\xe4\x11\x80\x9d\xad\x26\x54\x55\xb5\x08\x31\xb9\xb1\x23\xc4\x7a\xf7\xad\x35\x79\x06\x9b\x1c\xbc\x4d\x96\xa6\x69\x92\x87\xf9\x54\x2b\xd8\xb3\x37\x35\x41\x46\x24\x61\x24\x21\x4c\x2f\xe7\xb3\x67\xa8\xdb\x63\x68\x9a\xe0\x30\xf1\x70\xc8\xf4\xea\x3f\x66\x22\x38\x24\x05\xcf\x64\xcd\xa0\xba\x36\xd0\x96\x44\xa2\xd8\xf7\x49\x14\xc9\xb2\x42\xaa\x72\x90\x99\x08\xe5\x69\x10\x30\x1a\xeb\x75\x85\xb6\x24\x92\xa7\x5e\x46\xb8\x97\xeb\x65\x88\xcc\x44\x82\x38\x0d\x03\x8a\x73\xbd\x48\x51\x2f\x35\xbd\xe9\x2a\x45\xc2\x9e\xae\x59\xa5\x08\x47\x9f\xcb\x14\xdd\x50\x4e\x44\x77\x2e\x53\x24\x9a\x8c\xe5\x45\x7a\xcc\x18\x66\x46\xf4\x73\x99\xa2\x9b\xcf\x8d\xe8\xb6\x65\x8a\x8c\xca\xe9\xe6\x47\x74\xb4\x4c\x91\x4f\xdd\x65\x8a\xc4\x30\x7e\x97\x12\x53\xb6\x44\xfe\x45\xb2\xa5\x7f\xe9\xc3\x2d\x37\x7b\xb0\xe5\x13\x1d\x59\xb9\x7e\x12\x25\x1f\x35\xdd\x55\x88\x7e\xae\x77\xf0\x1a\xee\xba\xe9\x6e\xf2\x3d\x64\xe7\xe7\xf3\xab\x89\xfa\x71\x8a\xd8\xea\xf4\xe2\x8c\x2f\xaa\x75\xff\x4e\x1e\xfd\xf8\x4c\xcb\x0f\x94\x52\x6a\x49\xf4\xc8\x7b\x9b\x80\x50\x46\x8a\x04\xf2\x8a\x3c\x26\x94\x71\x42\xf6\xa6\x43\xb8\x18\xfb\x71\x10\x24\x50\x66\x90\xf8\xbc\x88\xc2\x2c\xd7\x53\x83\x41\x83\x34\xcc\xbc\x22\xcd\x0a\xb8\x00\x21\x0b\x72\x3f\x25\x85\x09\x31\x4f\xd2\x30\x4f\x59\x08\xb7\x67\x63\x9a\xe4\x69\x9a\x39\x11\xfb\x49\x18\x65\x24\x4c\x21\x9d\xf1\x03\x9a\x86\x3e\x35\x21\x0e\x93\x02\x63\x5c\x00\xc7\x69\xe4\x85\xb9\x87\x13\x27\xe2\x84\xf8\x05\x25\x0c\xae\xdc\x66\x05\x4e\x82\x22\x49\x4d\x88\x59\x8a\xb3\x90\xe7\xc0\x71\xce\xa2\x9c\x62\x4c\x9d\x88\x73\xea\xc5\x8c\x49\x19\x33\xdf\xf3\x3d\x12\x18\x65\x8c\x09\xf5\xc3\x54\xde\x19\x11\x84\xb1\x17\x15\x29\x77\x22\x26\x81\x8f\x69\x98\xc2\xdd\x11\x01\xe7\x41\x4a\x68\x66\x14\x45\xe8\x65\x71\x9e\xc1\x05\xe2\x79\x58\x14\x69\xc0\x89\x13\x71\x4c\x52\x1e\xe6\x31\x88\xa2\x20\x71\x4a\x93\xc8\xa8\x3c\xea\xe5\x3c\xc5\xf2\xf2\x0a\x3f\xc5\x51\x12\xa5\xd8\x2d\xe3\x34\xcf\xbc\x48\x56\xa8\x24\x61\x16\x63\xe2\x87\x26\xc4\x19\x4e\xd2\x02\x4b\x06\xb2\x22\x4a\x48\x94\x04\x4e\xc4\x3c\x48\xd2\x28\xc9\x40\x76\x09\x2f\x70\xc0\x72\xa3\x8c\x79\x91\xf2\x20\xa6\x70\x8d\xb8\x4f\x83\x82\x84\xdc\x77\x22\xf6\x8a\x0c\x27\x79\x06\x0d\x68\x4a\xb3\x3c\x4c\x8d\x1c\x93\xc0\xcb\x18\xce\x32\xb8\xa4\x3d\x66\x59\x92\x45\xa1\x5b\x79\x39\x4f\x48\x16\x81\x83\x84\x09\x49\x3d\x12\x1b\x11\x07\x2c\x0e\x68\xc0\xe0\x1d\x21\xe2\x2c\xe2\x01\x75\x73\x1c\x66\xa9\xc7\x92\x1c\x38\x49\xf3\x00\x17\x69\x1e\x18\x5d\x3a\x2a\x12\x4a\x73\x40\x4c\x7d\x8c\x43\x3f\x75\x73\x9c\x50\x9f\x87\x38\x24\xe0\xd2\x3c\x8a\xf2\x82\x99\x1d\x84\xfa\x38\x8b\x22\xc8\xf0\x49\x9e\x06\x3e\xc1\x9e\x3b\x56\x78\x9e\x4f\xe2\x8c\xca\x3b\xdf\x8b\x94\x60\xdf\x68\x6e\x69\x11\x26\x71\x91\xa9\xfa\xa6\xbc\xf0\x38\x77\x5b\x45\x16\x71\xcf\x4b\x0b\x30\x7c\x3f\x67\x94\x16\x99\xd1\x2a\xf2\x90\xc5\x09\x0e\x00\x71\xe2\x7b\x8c\xc5\xc4\x2d\x0a\x2f\xca\x58\xe4\x87\xf2\x7a\x17\xcf\xf3\x29\x31\x3b\x08\x0e\x48\x42\x12\xf9\xee\xe5\x31\x8f\x47\x3c\x76\x8b\x82\xc4\x69\xec\x31\x0a\xc1\x25\x88\x72\x42\x8a\xc2\xe8\xd2\x84\x63\x21\x26\x10\x59\x98\x91\x28\x4b\x48\xe4\x44\x1c\xe4\x24\x8b\xf2\x02\xac\x22\x64\x59\x40\x18\xcf\x8d\xb1\xc2\xf7\xa9\x97\x63\x10\x59\x92\x27\x61\xea\xe7\x85\x13\x71\x14\x7a\x2c\xf6\xc3\x40\x3a\x08\x2b\x22\x3f\xe7\x66\x73\x8b\x98\xc7\x52\x88\xdb\x7e\x16\xc7\x29\x61\xee\xb0\x49\x71\x46\xb2\x84\xc8\xe8\x16\xf3\x9c\x71\x1e\x99\x10\x27\x24\x26\x24\x93\x22\xc3\x01\x25\x7e\xe8\xa7\x4e\xc4\x8c\xa4\x05\xa7\x4c\xc6\xd9\xac\xc0\x9e\x1f\x19\x1d\x84\x51\xcc\xa2\x28\x00\x8e\xd3\x2c\x20\xbe\xe7\xb9\xa3\x5b\x46\x82\x94\xa6\xb1\x07\x71\xd6\x2b\x68\x12\x27\xd8\x18\xdd\xe2\x28\x0b\x31\x03\x19\x7b\x51\x18\xa4\xdc\x77\x5b\x45\x8e\x13\xc2\x29\x4e\x00\x71\xc4\x8b\x90\x60\xe3\x98\x97\x47\x49\xe2\x45\x04\x74\x11\x86\x51\xc8\x92\x11\xcf\x2b\x02\x8f\xfb\xa1\x94\x5d\x18\xc7\x98\x78\x84\x19\xed\xd8\x8b\x18\xf3\x64\xcf\x7c\x92\xa6\x39\x4e\xdd\xca\xc3\x09\x0b\x32\x8c\x21\x6c\xa6\x34\x27\xb9\x97\x19\x39\xc6\xdc\x8f\xa3\xcc\x93\x76\x8c\x03\xcc\xd2\xd0\x1d\xdd\x48\x1c\xd0\x38\x0e\xc0\x8e\xf3\x82\x72\x9e\x26\x89\x09\xb1\x1f\xa4\x5e\x9a\xa5\xd0\x33\x8e\x93\x34\xa0\x23\xe6\xe6\x27\x38\xf3\xb2\x14\x94\x92\x85\x59\x12\xb2\xc8\x37\xc6\x63\x9e\x53\xc6\x02\x08\x9b\xdc\x0f\x30\x65\x99\xdb\xdc\xc2\x34\xc9\x32\x16\x14\x72\x64\x88\x7c\xee\xc7\x46\xc4\x11\x25\x3c\x2a\x64\xb0\xca\xa3\x94\xa4\x94\xb9\x45\x11\x07\xb4\xa0\x84\x83\x83\x84\x39\x2f\x52\x62\x8e\x15\x31\x65\x61\xe4\xcb\x91\x26\xf0\x71\x4c\x8a\xc8\x6d\x15\x34\xc8\x68\x4c\xb1\xcc\x84\x70\xe1\xb1\x34\x36\x86\x4d\x9a\x65\xb1\x47\xa4\xf2\x30\x8b\x02\x3f\xe1\xee\xdc\x2d\xf1\x52\x5e\x14\x05\x93\x59\x64\xe4\x63\x4e\x8c\x56\xc1\x82\xd0\x8b\x32\x0e\x9e\x97\x73\x4a\xd2\x9c\xbb\x73\xb7\x94\x17\x09\xf3\x0b\x39\x32\x90\x2c\x8a\x13\x6c\xce\x2b\xa2\x18\xc7\xb4\x90\x43\x98\x1f\x93\xd0\x27\x6e\xe5\x65\x8c\xc4\x3e\xcf\x40\xc6\x9c\x91\x28\xc2\x89\x51\xc6\x39\xa6\x51\x4a\xe5\xd0\x44\x84\x21\x91\xee\x24\xe0\x30\x11\x61\x39\x8b\xf3\x1c\x1c\x24\xcb\xb9\xc7\x53\x6c\x0c\x9b\x45\x18\xe7\x41\x11\x17\x6a\xd0\xe5\x39\x8e\xdd\x76\xec\x45\x85\x17\xc5\x32\x5f\x88\x09\x8e\xa3\x22\x35\xba\xb4\xc7\x22\x3f\xce\x33\x70\x10\x46\x32\x9a\x50\xe6\x1e\x41\x30\xf6\x8b\x84\x7a\x81\x9a\xb8\x4b\xbc\x9c\x19\x39\xc6\x69\x8c\xbd\xd4\x97\xf1\xd8\xc7\x59\x10\x63\xb7\x8c\x09\xcd\xd3\x38\x2e\x42\x69\x15\x5e\x10\xe7\xd4\x18\x8f\x7d\x92\x31\x96\xc6\x60\x15\x81\x97\xc5\x24\x48\xdc\x0e\xe2\x67\x09\x4f\xb9\x07\xa2\xc0\x61\x96\xa4\x3c\x35\x2a\x2f\xf0\x71\x1e\xc5\x19\xf4\x2c\xc9\xb0\xe7\xe5\x81\xdb\x8e\x83\x2c\x0b\xf3\x40\x26\xde\x59\xea\xf3\x80\xa4\xc6\xa1\x49\xa4\x2b\x24\x49\x20\x58\x15\x59\x14\xc6\x5c\x84\x57\x57\xac\x28\xb2\x34\x2a\x98\x1c\x24\x59\x1e\x15\x8c\x1b\x39\x8e\xb2\x20\xc0\x09\x05\xc4\x01\x0b\xe2\x90\xe2\x58\x4d\xa2\xbe\x71\x1c\x5b\x6d\xdf\x0b\x7f\xba\xee\x09\x55\xdb\x35\x68\x3f\x75\x4e\xa8\xfe\x7c\xbd\x13\xaa\x21\x26\xdb\x2d\x1d\x18\x96\x23\x6e\xbe\xfa\xe8\x75\x97\x0e\x22\xe6\x25\xbc\x9e\x70\xf7\xd3\x2c\x4b\x3c\xcb\xd2\x41\x9a\x46\x31\xe3\x72\xf8\xa5\x41\xc6\x58\xdc\x4d\x5d\x1c\x44\xfc\x2c\xe2\x85\x1f\x43\x24\x2b\x78\x12\x14\x54\x44\x32\x13\x24\x0b\x83\xa2\x08\x7d\xf0\x82\xb0\xc0\xb9\x1f\x15\xdb\xce\xea\x87\xd8\xe3\x21\x91\xc1\x87\xe5\x3c\xa2\x24\xb7\x2c\x1d\x24\xa9\x17\x46\x54\x1a\x24\x49\x7d\x1e\x65\xb8\xd8\x92\x08\x2e\xa8\x9f\x27\xd2\xe6\x8b\x34\xc0\x69\x1e\x59\x7a\x12\xa6\xdc\xcb\x72\x99\x06\x61\x3f\xe6\x04\xc7\xc9\x2e\x4b\x07\x37\x7d\x8e\x74\x9b\xd2\xb0\x00\xe7\xd9\x2b\xbf\x3e\xc2\xf6\xd2\xaf\x8f\x88\xbd\xf6\xeb\x23\xdf\x5e\xfc\xf5\x51\x60\xaf\xfe\xfa\x28\xb4\x97\x7f\x7d\x14\xd9\xeb\xbf\x3e\x8a\x2d\x05\x60\x65\x07\xa1\x3c\xac\x71\x1f\xb8\x7c\x3e\x97\xcf\x87\x87\x3d\xa4\x0c\xa0\xb9\xf1\x08\x94\x7c\x3e\x97\xcf\x2d\xcd\x09\x34\x27\xd6\xe6\x64\x2e\x9f\x5b\x9a\xfb\xd0\xdc\xb7\x36\xf7\xe7\xf2\xb9\xa5\x79\x00\xcd\x03\x6b\xf3\x60\x2e\x9f\x5b\x9a\x87\xd0\x3c\xb4\x36\x0f\xe7\xf2\xb9\xa5\x79\x04\xcd\x23\x6b\xf3\x68\x2e\x9f\x5b\x9a\xc7\xd0\x3c\xb6\x36\x8f\xe7\xf2\xb9\x61\x5b\xdf\x96\x45\x8f\xa5\x65\x98\x90\x33\x69\x14\xfd\x8a\x7b\xb0\xe5\x56\x1a\x84\xa9\x55\x2a\x6d\xc1\xd4\x2a\x93\x76\x60\x6a\x95\x49\x13\x30\xb5\xca\xa5\xfa\x4d\xad\x72\xa9\x79\x53\x2b\x2e\xb5\x6e\x6a\xc5\xa5\xc2\x4d\xad\x0a\xa9\x6c\x53\xab\x42\xea\xd9\xd4\xea\x54\xea\xd8\xd4\xea\x54\xaa\xd7\xd4\x6a\x26\x55\x6b\x6a\x35\x93\x5a\x9d\x9b\xea\x0e\xba\x8e\xee\x6e\x79\x1d\xaa\xb5\x9e\x76\x4d\xff\xa7\x52\xd6\x1e\xb6\x1d\x37\x7f\x00\x23\x78\xbd\x7c\x36\x04\xd9\xa2\x50\xb4\x24\x23\x44\xf0\x53\x59\x9f\x36\xd0\xab\x46\xa3\x2f\x11\x79\x03\x90\xe6\x5a\xae\x2d\x8e\xb9\xc4\xa1\xce\x17\xf4\x71\xc0\xa9\xf9\x6b\x55\xa0\x3e\x3a\x42\xff\x01\xd5\x88\xed\xc4\xeb\x92\xce\x3b\x55\xa8\xde\xcc\x9a\x3a\xc7\x9b\xb1\xb3\x78\x0a\x6c\xae\xb5\x70\x9f\xc7\x93\x50\xb3\x4e\x15\xec\x99\x2c\xfe\xab\x17\xaf\x9e\x43\x89\xe2\xba\x1c\x70\x07\x8e\x0e\xe0\x60\xd3\xeb\x5b\xd4\x05\x8b\x5d\x27\x4c\x25\xe4\xbc\xc3\xc5\x7c\xc8\xc5\xcc\xc4\xc5\x7c\xc8\xc5\x4c\xe7\xa2\x0b\x17\x0f\xe1\x2c\x95\x8c\x75\x95\x5a\x6a\xe6\xbc\xd7\x6a\x6f\xef\x52\x7c\xbb\xd5\x28\xde\x4e\xa3\xb8\xd5\x28\xde\x4a\xa3\x78\xd6\x29\xf0\x3d\xab\xab\x70\x6b\x85\xb9\xe7\xaa\x56\xb7\x26\x24\xac\x24\xdc\x05\x83\x7d\xcc\x89\xa6\xd2\x1a\x5f\x34\xaa\x52\x3c\xef\xb0\x31\x37\xb0\x31\x33\xb1\x31\x1f\xb0\x31\xeb\xb0\xd1\x45\x18\x0d\xf0\x91\xc8\xa9\xd3\x9d\x6a\x87\xbb\x42\x49\xdc\xaa\x3d\x76\xa9\xfd\xa7\x32\x96\x91\xcb\x38\x30\xf7\x20\xe7\x0a\xd2\x71\x26\x5c\x42\xe2\x48\x0b\x24\xd6\x5b\xa1\x6b\x58\xc9\x00\x36\x66\x16\x7d\xd8\x79\x0d\x3b\xca\x43\x1b\x69\xe6\x42\x68\x65\xdc\x1f\xb9\xba\xe0\x6d\x28\x9b\x49\xf0\x19\xd4\x6c\x13\x78\x84\x26\xbd\x3d\x74\xbf\xf6\xce\xe6\x97\xff\x1f\x61\x74\x17\x0d\xb6\x4d\x0f\xf9\x10\xff\xd6\x1a\x1c\x67\x43\xfc\xbb\xdf\x78\x8b\x85\x0b\x7c\x5d\x2e\x40\x8a\x5b\xf2\x20\xb5\x33\xe4\x40\x6a\x62\x40\xdf\x8c\xb4\x1d\x15\x7f\x2a\x6d\xea\x6d\x47\xbd\x9f\x4a\x13\x73\xf6\x9a\xf8\xaa\x28\xfe\x0c\xdd\x46\xc5\x4c\x95\xc5\x17\x5f\xcc\xe7\xf8\x64\x1b\xe9\xfb\x7c\x2e\xda\xcc\x55\x1b\xf1\xe5\x74\xee\x28\xa6\x3f\x83\x6a\xfa\x02\x75\x2a\xe9\xc0\xe7\x4c\x7e\x4e\xd5\x67\x7b\xf3\x39\x34\x17\x54\x52\x49\x12\x3e\x67\xf2\x73\xaa\x3e\xbb\x4b\xf2\xcf\x64\x4d\x7e\x15\x70\xe4\xb8\xc2\xe6\xb2\xbc\xf4\x9e\x2c\x7e\xc0\x66\x75\xc5\x7e\xf5\xb0\x53\xb3\x7f\xa6\xdd\x22\xc1\xea\x51\xc7\x59\x99\x1f\xde\xa6\x26\x0d\x22\x45\x73\xd6\xa5\x39\xef\xd0\x9c\x75\x69\xce\x75\x9a\xb3\x6d\x68\x62\xd9\x4f\xae\x86\x06\x79\xde\x84\xcb\x41\x81\xd6\x65\xff\x67\xf5\xa5\x15\xda\xc3\xa0\x7d\x28\x68\xfa\xf5\x33\x59\x86\xdb\x4d\x53\xf6\x53\x01\xd7\x34\x67\x5d\x9a\xf3\x0e\xcd\x59\x97\xe6\x5c\xa7\x39\x6b\x69\x1a\xb3\xce\xf1\x7b\x08\xcc\xbc\x7e\x0f\xd5\x97\xbe\xb7\x1f\xa6\xfa\x1e\x9c\xf7\xfb\xd2\x75\x8c\xea\x7b\x08\x06\xdf\x97\xb6\x10\xfa\x1e\x2e\x4a\x10\x30\xb3\x79\xc3\xa2\xc9\x29\x25\xa0\x20\x38\x6b\xfb\x22\xc3\x45\x85\xf5\x70\x31\xdb\x26\x56\xb5\x64\xc5\xbf\x42\x22\x6e\x9a\x15\x90\xca\x66\x26\x82\xd9\xb5\x28\x7e\x6f\x0c\x3d\x7d\x8a\xdf\x97\x26\x8a\xdf\x97\xd7\xa1\x68\x0e\x76\x7d\x8a\x3f\x19\x29\xfe\x64\xa2\x68\xb6\xb6\xfe\xe5\x15\x16\x92\x30\x79\x51\xbb\x3d\x00\x5a\xb9\x83\x79\x90\x3a\x2a\xed\xcb\xf0\x08\x2c\x12\x9d\xc5\x1a\xd7\x76\x6c\xfe\x78\x9e\xb3\x8a\xa3\x4b\xf7\x9b\xbe\xf8\x83\xf7\x4d\xa3\x7d\xc3\xeb\xe6\xa9\x89\x6d\x18\x80\x0a\x53\x1b\x78\xb1\x2d\x4c\x6d\xe0\x1d\x9a\x9b\xda\xc0\x2b\x34\x37\xb5\x81\x57\xf2\x49\x3e\x87\xeb\x3b\xe6\xb6\xfb\x3b\xe0\x9d\x7e\x92\xcf\x00\x4a\x8a\x8e\xeb\x92\xcb\x07\x42\xb3\xde\x04\x22\x30\x65\x26\x1e\x61\x4a\x21\x33\xf1\x08\xb3\x17\xa9\xa9\x0d\x4c\x5e\xa4\xa6\x36\x30\x4f\xc2\x4c\x6d\x60\x9a\x64\x70\x9b\x81\xf8\x83\x69\x97\x89\x34\xf5\x8a\x58\x85\x01\x13\x37\x13\x29\x07\x61\x59\xfb\xed\x88\x23\xa5\x51\x0d\x93\x9d\x1b\xbd\xac\x44\x9b\x33\x84\xcc\xe0\x11\xd8\x3f\x1b\x64\x03\x8f\x9a\x62\x14\x93\x47\x60\xf7\x4c\x32\xfb\xc8\xd3\xb9\x65\x43\x66\xfb\x78\xb4\x59\x46\x49\x10\x44\x94\x0e\x09\xe2\x96\x20\x88\x27\x55\x04\x3b\x91\x20\x1d\x27\xa8\xcd\x4b\x4a\x82\x04\x42\xec\x90\x20\x69\x09\x92\x59\x3d\x2e\x4d\x00\x5e\x0b\xaf\xe3\x04\xb5\x99\x4c\x49\xd0\x17\x04\xf3\x21\x41\xbf\x25\xe8\x0b\x5a\xb9\x22\xe8\x8f\xb8\x43\x1f\x8f\x36\xf7\x29\x09\x06\x82\x20\x1f\x12\x0c\x5a\x82\x81\xa0\xc5\x15\xc1\x40\x27\xc8\xc7\x09\x6a\xb3\xa5\x92\x60\x28\x08\x16\x43\x82\x61\x4b\x30\x14\xb4\x0a\x45\x30\xd4\x09\x16\xe3\x04\xb5\xf9\x55\x49\x30\x82\x97\x8a\x21\xc1\xa8\x25\x08\xd9\xfb\xa9\x22\x18\x75\x5e\x22\xc6\x09\x6a\x33\xb2\x92\x60\x2c\x08\xce\x86\x04\xe3\x96\x20\xbc\x36\xa9\x31\x59\xc0\xbb\x92\x80\x8f\x3e\x7b\xf1\xf9\x52\x9c\x9b\xbb\x14\x07\x8b\xe4\x5e\xdd\x6c\x26\x90\x41\x1d\x16\xdf\xbb\xe9\x6b\x71\xcc\x64\xf0\x3f\xe5\xc5\x38\x27\xcb\xc5\x7b\xbe\x92\x55\x7e\x51\xb5\x44\x3e\x39\x48\xcb\x4a\x24\x28\x39\x62\xb0\x3f\x3b\xe5\xc5\x72\xc5\xd5\x76\xea\x81\xd6\xb4\xb3\x26\xda\xda\x5d\xb5\x7c\xe5\x93\x9b\xb8\x88\xe7\xcf\x7a\x05\x8f\xce\x67\x53\x1f\xe4\x2e\xc2\x1e\x09\x8e\x7c\x55\xa7\xf8\xf3\xe9\x26\xeb\x51\xa5\x10\x93\x5d\x4f\x37\x89\x26\x23\xa7\x9b\x3a\xdb\x1a\x06\xa7\x9b\x42\x4c\x3e\x9f\x6e\xba\xe9\xd3\x4d\x42\x2b\xdb\x9d\x6e\x32\x2a\xa7\x73\xba\x49\x2a\xc8\x79\xba\x49\x9e\xa3\xdd\xf2\xf4\xb7\xff\xa7\x3e\xcf\xc4\x17\xd9\x41\xca\xd6\x3c\x0a\x7a\x0f\xce\xf2\xb0\x0f\xfa\xfe\xfc\x5d\x5e\xf4\x7e\xcc\xca\xf3\x19\x5f\xfd\x21\x47\xa2\x34\x56\xe1\xbb\xe0\x50\x3e\x90\x8c\xc1\x67\x9d\x9f\x7f\x85\xa3\x53\x3f\x6d\x75\x27\x10\x6c\x9e\x39\x81\xae\x37\x70\xda\x6f\xe3\x47\xa1\x8e\x8e\xd0\x33\xbe\x3a\x83\x51\xf4\x64\xb6\x2c\x33\x8e\x70\xff\xda\x14\xd1\xfc\xd9\x09\xee\x9e\x5d\x0a\xe3\x29\x0a\x92\x29\x0a\xf0\x14\xf9\xfe\x14\x91\x70\x8a\x70\x3c\x45\xc9\x14\x21\xac\x6d\x35\x0a\xe9\x14\x85\xde\x14\x05\x64\x8a\xfc\x60\x8a\x48\x34\x45\x98\x4e\x11\xf6\xa6\x88\xe8\x70\xc9\x14\x85\x78\x8a\x02\x7f\x8a\xfc\x70\x8a\x48\x3c\x45\x38\x99\x22\x2c\xf0\x6b\x70\x91\x37\x45\x21\x99\xa2\x20\x98\x22\x3f\x9a\xa2\xc8\x9f\xa2\x30\x9c\xa2\x20\x9e\x22\x3f\xd1\x00\x7d\x3c\x45\xc4\x9f\x22\x1c\x4e\x51\x3c\x45\x28\x22\x53\x14\x06\x53\x14\xc0\xd5\x02\x3a\xa0\xe0\x84\x4c\x11\x0e\xa6\x28\x12\x80\x78\x8a\x42\x7f\x8a\x82\x70\x8a\xfc\x58\x03\x24\xc9\x14\x11\x3c\x45\x58\x90\x9c\x22\x44\xe8\x14\x11\x6f\x8a\xb0\x60\x47\x82\xbd\x71\xc8\x95\x98\xe5\x4a\xba\x72\x15\x5c\x08\x39\x8a\x7e\x13\xf1\x79\x8a\x50\xa8\x73\xab\x08\x8b\x6e\x09\x6e\x81\x21\x4f\xe7\xd2\x57\x82\x13\x5c\x09\x80\x68\x8a\xf4\xee\xe2\x48\xca\x43\x08\x18\xb8\xf7\xbb\x8a\x10\x0a\x15\x02\x16\xf2\xf3\x63\x29\xd8\x30\xec\xc9\x2b\xf0\x94\xb6\x42\xa9\xfd\x40\xa7\x20\x54\x23\x4c\xc3\x17\x2a\x8d\xa4\xda\x43\x5d\x87\x42\x05\xc2\x1e\x84\x5d\x08\x1d\x0a\xc1\xd6\x59\x4d\xe7\x46\xa8\x8b\xb3\x8b\x39\x83\x6b\x52\x44\x52\xb9\x9e\x95\xc5\xe0\x86\x27\xf0\x82\xef\x5e\xfe\xfc\xe2\xd1\x77\x0f\xe5\x9d\x52\x42\x62\x64\x8a\xa0\xf3\x42\x42\x54\x58\xa4\x52\x13\x48\x57\x59\x2a\x56\xea\x24\xca\x7a\x41\x20\x54\xa7\xff\xe2\x9b\xa7\xaf\xf8\x1a\xb1\x45\xae\x6a\xa3\x9f\x83\x4a\xe5\x7d\x1a\x06\x3e\x04\xfc\xcf\xcf\xba\xfa\xec\xa5\x94\xde\xc6\xbb\x0b\x2f\x23\x94\x78\xde\xb4\xff\xac\x7e\x57\x90\x20\x06\x00\xd2\x01\xa0\x9e\x47\x06\x20\xbe\x06\x32\x7c\x1a\xe8\x4f\x0d\x04\xc2\x2e\x01\x62\x20\x10\x75\x99\x34\x81\xc4\xbd\x7e\x18\x08\xd1\x0e\x23\x43\x14\x49\x9f\xca\x10\x05\xd3\x41\x4c\x00\x69\x5f\x5a\x43\x90\xac\x47\x66\x00\x90\xf7\xbb\x32\x04\xe1\x1a\xc8\x90\x42\xd1\xe5\x72\xd8\x9c\xba\x5a\x63\x3a\xaa\x0f\x42\x47\x08\xf8\x74\xc4\xaa\x82\x3e\x11\x83\x5d\x50\xb7\xdd\x44\x74\xd4\x30\x63\xea\x32\x4c\x4a\x47\xf5\x9d\xd0\x11\x7d\xb3\x3e\x13\x06\x93\xe8\x93\x19\x72\x92\xd1\x51\x8d\xe7\x74\xc4\x6a\x38\x75\x5b\x77\xd1\xa7\x61\xd0\xbc\x55\x5d\x2a\x4a\x60\xb3\x20\x89\xf6\xd4\xa2\x4c\xbf\x03\x62\xa4\x1e\x74\xb1\x98\xfa\x18\xea\x20\x46\x9b\xd0\xf9\x34\x3c\x8f\xbb\x6c\x38\x7c\x03\x3b\xcc\x3f\xe9\x73\x6a\x0d\x14\xd8\xa1\xd1\xb4\xdb\x19\x83\x55\x74\x3a\x63\x8d\x13\xd8\x61\xbf\xbc\x07\x62\x0b\x15\xd8\x1c\x0a\xe8\xa8\x28\x30\x1d\x15\x05\xa1\xa3\xaa\xf7\xa9\x5b\x6d\x41\x0f\x85\x2d\x56\xb8\xc4\x1d\x51\x97\x09\xc7\x74\x44\x19\x94\x8e\x48\x32\xa1\xa3\xa6\xc5\xa8\x5b\xa1\x69\x5f\xde\x86\xc1\xa3\x4f\x65\x08\x92\x53\x97\x4a\x39\x1d\x71\xa1\xa2\xaf\x51\xfd\x8e\xaa\xe9\x58\x96\x11\x78\x1e\x0d\x3c\x6c\x8d\x20\x0a\xc6\x9a\x66\x34\x0a\xb4\x45\x90\x9a\x88\x67\x22\x12\x74\x89\x18\x61\xc2\x2e\x1e\x23\x33\x51\x17\x8f\x11\x26\x6e\x61\x0c\x54\xf4\x60\x6b\x6c\x9e\xf4\x49\x18\x90\xb0\x7e\x77\xec\x09\x87\x22\x64\x40\x92\x75\x04\x6b\x00\xc8\x5b\x00\x6b\x00\x91\x2c\x18\x1a\x17\x7d\xad\x58\xf3\x2e\xa7\x30\x31\x1d\xe9\x05\xa1\x2e\x69\xfb\x7d\x12\x26\xdb\xa0\x3d\xbd\x9b\x6c\x83\x8e\x0b\x3c\xa2\x23\x86\x1a\xd3\x71\x43\xa5\x74\x44\x29\x09\x75\x28\x85\x51\xb7\x2f\xa5\x7d\x0e\xec\x81\xc4\xe9\x2a\x39\x1d\x31\x62\xde\x97\xa9\x3d\x9e\x58\x2d\x48\x7f\x01\x31\x3c\xc5\x5b\xb8\x3d\x26\x5b\x38\x13\xf6\xb7\x70\x7c\x1c\x6c\x61\xcf\x38\x74\xba\x3e\x8e\xc6\x5c\x12\xc7\x23\xc1\x50\x4f\xc1\xcd\x18\x92\xb1\x70\x89\xd9\x98\xdf\xe3\x74\x8b\x68\x89\xb3\xb1\x40\x86\xf3\x2d\x82\x25\xe6\x5b\x84\x32\x5c\xf4\x35\x64\x34\x97\xb1\x50\x81\xf1\x98\x87\x62\xb2\x85\x83\x60\x7f\xc4\xcb\x70\xb0\x4d\x60\x0b\xb7\x08\x3b\x38\x72\x46\x37\x1c\x6f\x11\x96\x30\xdd\xc2\x17\x71\xb2\x85\xd7\x63\xb6\x45\x34\xc5\xe9\x58\x04\xc3\x99\x2b\x84\xe1\x7c\x2c\x2c\xf0\x2d\xc2\x28\x2e\x7a\x11\x6a\x97\x54\x05\x7b\x81\x25\x18\x99\x59\x26\x1d\xa9\x60\x6b\x8a\x22\x71\x9b\xb0\x07\xda\x73\xcf\xf0\x3c\xec\x29\x67\x08\x11\x75\x84\x66\xa2\x11\x77\x20\xc6\x87\x63\x7b\x6e\xd2\x52\xb1\x65\x26\x75\x4f\x6d\x59\x49\xcb\xc5\x90\xcf\xac\x27\xcd\x21\x44\xde\x91\x96\x2d\x35\x01\x0c\x96\xb4\x44\xb5\x35\x4b\xc0\xd5\x3d\x4c\xc7\xd8\x27\xd4\x6e\x28\x3e\x1d\x33\x94\x80\x8e\x29\x3a\xa4\xee\xce\x47\xd4\x6d\x4a\xb1\xf6\x7c\xf8\x94\x52\xbb\xe8\x12\xea\x12\x1d\xa3\x63\xe6\x95\x52\xb7\x13\x64\xd4\x6d\x3a\x39\x1d\x33\x0c\x4e\xc7\x9c\xa0\xa0\x63\x26\xde\x49\x2b\x2c\x46\x80\x47\xdc\x15\x93\x11\x0b\xc5\xfe\x68\xc8\xc0\x81\xd3\x52\x71\x38\xea\xf0\x38\x1a\x8d\x1a\x38\x76\x45\x62\x3a\xea\x89\x38\x19\x0d\x19\x98\x39\xbc\x11\xa7\x23\xe1\x02\x67\xa3\x51\x0b\xeb\xe1\xc0\x40\x82\x8f\xc4\x5e\x5c\x8c\x86\x24\x95\x5a\x38\xbb\x89\x9d\x7e\x85\xc9\x78\x68\xf1\x1d\x91\x03\x07\x23\x6e\x8d\xc3\xd1\xd8\x82\x23\xa7\x03\xe3\x78\x34\xb6\x61\x3a\x12\x7c\x70\x32\xea\x81\x98\x8d\x84\x01\x9c\x8e\xc6\x40\x9c\x8d\x86\x02\x9c\x8f\xc6\x23\xcc\x1d\xc1\x0e\x17\xdd\x68\xb4\x4b\xfe\x40\x3d\x49\xd2\x1c\x5b\xea\xec\x13\x7b\x81\x25\x95\xa8\x99\x36\x3c\xf7\x5b\x0c\x81\xd9\x10\x03\xbb\x11\x85\x5d\x89\x98\x73\x88\x26\x39\x36\x91\x8f\xbd\x4e\xfa\x67\x1f\x3f\xeb\x15\x15\x73\x06\xd1\xea\xd6\x9c\x3f\xc8\xe7\xe6\xdc\xa1\x15\x9f\x6d\x05\xa5\x15\x8f\x01\x47\xae\x79\xa9\x25\x73\xa8\xcd\xdb\x9c\x3b\xb4\x0a\xb6\xf4\xdf\xa9\x5f\x4c\xed\xdd\x23\x74\x8c\x79\x9f\x8e\x09\x20\xa0\x6e\x15\x87\x74\xac\x0b\x11\xb5\xda\x4f\x4c\xc7\x8c\x8f\x52\x97\xfc\x92\x2e\x71\x5b\x12\xe1\xb0\x8e\x94\xba\xb4\x97\xd1\x31\xeb\xcb\xa9\xdb\x7e\x39\x75\xbb\x5f\x41\xc7\x3c\x04\x7b\x23\x2e\x82\xf1\x88\x17\x62\x32\xea\x86\xd8\x77\x8d\x14\x4e\x0b\xc7\xe1\xa8\x8b\xe0\xc8\x1b\xd3\x13\x8e\x47\x23\x19\xa6\xa3\xde\x82\x93\xd1\x70\x81\xd9\x68\xc0\xc3\xe9\x48\xcc\xc4\xd9\x68\xdc\xc0\xf9\x48\x58\xc2\xdc\x11\x97\x70\xe1\x0c\x1b\x32\x7b\x70\xf7\x01\x8f\xfa\x25\x26\x76\xc7\xc4\xfe\x88\xdb\xe3\x60\xc4\xf0\x71\x38\xea\x3b\x38\x1a\x8f\x6e\xb1\x23\xbc\x61\x3a\xee\x3c\x89\x33\x7e\x60\x36\x1a\xff\x70\x3a\x1a\x44\x71\xe6\x0c\x22\x38\x1f\x8d\x52\x98\x8f\x84\x29\x5c\x74\xe3\xc8\x6e\xc9\x83\x31\xa6\xd4\xfc\xda\x56\x48\x1a\x6e\x8c\x29\xc3\x5d\x6d\xbb\x86\x31\x63\x50\x00\x30\x9f\x62\xcc\x1b\x9a\x9c\xcf\xf0\x3c\xaa\x11\xd8\x00\xe2\x96\x41\xc3\x53\x5d\xe7\xb6\x94\xa1\xe5\xcf\x92\x33\xb4\x3d\x34\x50\x48\x5b\x06\xcd\x2c\x64\x1d\x00\xd3\xc0\x61\xf5\x3d\xae\x2b\xc7\x80\xba\xe8\x08\xc7\x3c\xe7\xe0\x6a\x8f\xe9\x88\x70\x09\xf5\x6c\x86\xe3\x53\xb7\xe1\x04\xd4\x65\x38\x21\x1d\xb1\x8b\x88\x8e\x48\x2d\xa6\x23\xa6\x47\xe9\x88\x6a\x13\x6a\x93\x3b\xa3\x23\x3a\x4d\xa9\xdb\x6a\x33\x3a\x62\x35\x39\x1d\xd1\x1c\xa7\x6e\xc3\x2d\xa8\xcb\xec\xb1\xe7\x74\x5b\x8c\x3d\xab\x5e\x31\x19\xf3\x69\xec\x8f\xf9\x24\x0e\x46\xbc\x1a\x87\x63\x4e\x81\xa3\xb1\xc8\x81\xe3\x11\xdf\x6e\xc6\x3d\xab\x1a\x71\x32\xe6\x40\x98\x8d\xc4\x47\x9c\x8e\x45\x10\x9c\x39\x23\x14\xce\xc7\x22\x0c\xe6\xf6\xc1\xb9\x18\x89\x10\x90\x1f\xb8\x75\x85\x47\x2c\x0d\x93\x11\x4f\xc7\xfe\x98\x33\xe3\x60\xcc\x59\x71\x38\x16\xaa\x22\x7b\x28\xc2\xf1\x58\xb0\xc0\xd4\xed\x2e\xc9\x98\xc3\x63\x66\x0d\x16\x38\x1d\xf3\x65\x9c\x8d\x84\x0b\x9c\x3b\x83\x25\xe6\x63\xa1\x0c\x17\xbd\x80\xb3\x4b\x56\xa0\xd8\xa6\xa6\x28\x52\xe3\x34\xe5\x05\xb2\x2d\x31\xf7\xd9\x6f\x9f\x13\x13\xee\xa0\x95\x88\x11\x7f\xa8\xf7\xc7\x94\x15\x34\x4f\x87\xb8\xe3\x8e\x41\x5b\x47\x45\x63\x36\xa0\x31\x35\x44\xcc\x6a\xb2\x46\x96\x53\x65\xa0\xa6\x0c\x40\x93\xd5\xf0\x79\xae\xa1\x1d\x3e\xe5\x4d\x5f\x87\xcf\x8a\x8e\x94\x4d\x3d\x75\x2a\x09\x53\xb7\x92\x08\xb5\xf4\xc8\xa7\x2e\xed\x04\xd4\xd5\x9f\x90\xba\xad\x2e\xa2\x6e\xcb\x88\xa9\x5d\x1e\x94\xba\xec\x22\xa1\x76\x7b\x66\xd4\xad\xfa\x94\xba\x75\x98\x51\x8b\x4d\xe5\xd4\xad\x22\x4e\x5d\x36\x55\x50\xb7\x29\x63\x6f\xc4\x8f\x30\x1e\x31\x3e\x4c\x46\x3c\x15\xfb\x0e\x03\xc4\x81\xd3\x4f\x71\x38\xe2\x8a\x38\xf2\x46\x62\x50\xec\xf4\xb9\x26\x83\xb5\xf0\x9e\x58\xa3\x36\xb3\x79\x2b\x4e\x47\x42\x1b\xce\x1c\x71\x11\xe7\x23\x31\x04\xf3\x11\x9f\xc5\x85\x33\xb8\x89\x11\xdd\xc2\x38\x76\x9a\x12\x26\x4e\xa7\xc5\xfe\x88\x5f\xe2\x60\xc4\x31\x71\xe8\xf0\x4c\x1c\x8d\xc4\x1a\x1c\x8f\x06\xab\x11\x4f\xc2\xc9\x88\x8f\x62\xe6\x08\x00\x38\x75\x46\x2d\x9c\x39\x43\x0b\xce\x6d\xfe\x8f\xf9\x98\x0b\x17\xdd\xd0\xb3\xfb\xd0\x6d\xb0\x91\x9a\xd5\xc0\xc3\x86\xa1\x5b\xa5\x1a\x86\x41\x5b\x21\x35\x35\x0b\x9a\x24\xc7\xf4\x34\xb4\x74\x3f\x92\x28\x0d\x63\x74\x9b\x32\x0d\x9f\x52\xad\x03\xa6\x61\xba\xe9\xfb\xb0\x29\xd3\x8c\x7c\xf8\x34\xd5\x3a\x61\x7a\x55\xd7\xf2\x38\xc3\x30\x2d\xe5\x36\xc4\xca\x5b\xb9\x99\x5e\xd2\xb5\xcc\x77\xd8\x53\x97\x18\x30\x35\x0b\x95\x50\x97\x7e\x7d\xea\xea\x63\x40\x1d\x86\x13\x52\x97\xf0\x22\xea\xea\x49\x4c\x6d\xe2\xa1\xd4\x61\x56\x09\x75\xa9\x9a\x51\x97\x46\x52\xea\x30\x84\x8c\xda\xcc\x3c\xa7\x2e\x4b\xe6\xd4\x6c\xb1\x05\x75\x28\x19\x7b\x4e\x2d\x63\xec\x74\x57\xe2\xf4\x57\xec\x3b\x7d\x05\x07\x2e\x77\xc0\xa1\xd3\x95\x70\xe4\x74\x08\x1c\xbb\x22\x82\x1a\x6f\x8c\x8f\x12\x67\xb4\xc0\xcc\xe5\x31\x38\xb5\x04\x0d\x9c\xd9\x82\x6c\xee\xf4\x5c\xcc\x9d\x41\x01\x17\xd6\x88\x88\x3d\xa7\xd6\xb1\xd3\x11\x31\x71\x7b\xb7\x6f\xb1\x34\x1c\x38\x1d\x0d\x87\x2e\x17\xc6\x91\xd5\x0f\x71\xec\x8c\x0c\x98\x3a\xbd\x1f\x27\x4e\x5f\xc4\xcc\x12\xac\x70\xea\x74\x37\x9c\xb9\xa2\x03\xce\xad\x5e\x8c\xb9\x33\x72\xe0\x42\x0b\x0e\xbb\x8c\xa9\x54\x0c\xf0\xc4\x80\xb0\x11\xce\x30\x1e\xdf\x6d\x17\x37\x86\xe1\x58\xb6\x1b\x06\x62\x85\xcf\xf0\x28\x94\xf8\x88\x91\x8f\xa8\x79\x68\x0a\xc2\x8a\x13\xf3\x38\x43\x3d\x33\xff\x49\xd3\x6f\x53\x08\x96\x7c\x9a\x1e\xa5\x0d\x52\x03\x9f\xd9\x5d\x79\xd8\x63\x18\x7e\xcd\x76\xc2\x1b\x21\x1a\xda\x14\x8a\x09\xc3\xa3\x7a\x51\xc9\xda\x73\xf9\x18\xbb\x64\xaa\x60\x88\x4b\xff\x0a\xc6\x77\xe9\x5a\xfd\x1e\xb8\x84\xad\x60\x42\xbb\x58\x15\x44\x34\xda\xe7\xd8\x62\x5a\xea\x31\x75\x49\x54\xc1\x24\x36\x2d\xa9\xe7\xcc\x6e\xa5\x0a\x22\x75\xd9\xa3\x82\xc9\xcc\x2a\x57\x4f\x73\x97\x19\x29\x18\xee\x32\x51\x05\x53\xd8\x3d\xb4\xce\x88\x8d\x8e\x8d\x5d\x3d\xc0\xc4\x22\x64\xec\xdb\x2c\x0e\x07\x2e\x66\x71\xe8\x52\x0b\x8e\x5c\xc2\xc0\xb1\xa3\x8b\xb6\xf8\x9b\xd8\x55\x88\x99\xcb\x52\x71\xea\x8c\x87\x99\xcb\xa3\x70\x6e\xb7\x6f\xcc\x6d\x46\x87\x8b\x71\xef\x6a\x5f\x6e\xac\x10\xd8\x1d\x0b\x30\x19\x37\x38\xec\x8f\x79\x1f\x0e\x9c\xde\x87\xc3\xf1\x20\x50\x2b\xdb\xd9\xdd\x78\x3c\x28\x61\x3a\x1e\xdc\x70\x32\x1e\x0d\x6a\x73\x70\x79\x99\x34\x0a\xeb\xd3\x6c\x2c\xac\x49\xc3\x70\xf0\xc9\xc7\x22\x4e\x6d\x24\x40\x45\x1b\xd9\xe5\x47\xbd\xae\xc1\x13\xb6\x7e\xb7\x46\xd5\x8c\x55\x68\xcd\xe7\x3c\xab\xa0\x1e\xd1\x8b\x6f\x9e\xbe\x42\xe5\xe2\xbc\xbe\x26\xa2\xa9\x68\xf0\xe4\xeb\x17\xbd\x8b\x8b\xdb\x83\x89\x53\xd4\x6e\xfc\x87\x0b\x14\xd5\x17\xf8\xac\xbe\x4c\xf5\x86\x9e\xfa\x55\x02\xc8\x2f\xf5\x67\xf1\x65\xaa\xf5\xa7\xcf\xb9\x56\x55\xe9\xdb\x07\x2f\x64\x61\x2c\x24\x0b\xbf\xb8\xef\xa8\x12\xd0\xcd\x05\x55\xf2\x8b\x56\x25\xe5\xba\x57\x54\xb9\x4b\xeb\xbd\xe3\x57\x4d\x09\xb0\x77\xfc\xca\x50\xfa\xee\x1d\xbf\xaa\xeb\xea\xbd\xe3\x57\xe6\xb2\x7a\x82\x86\x54\x51\x18\xa1\xb4\xac\xd6\x88\x65\xd9\x72\x95\x97\x8b\x53\x54\x2d\xd1\xb3\x13\x6c\xc4\xfb\x4d\x09\xa5\x80\x5e\xf7\x6b\x20\x9b\xee\x0e\x09\x23\xfb\xdd\x21\x2d\xba\x67\x4b\x81\xf0\xd9\x09\x7e\x5d\xbe\x41\x07\x08\x1b\x6a\x94\x2a\xba\xb2\x3c\xff\xa4\xee\xdd\xeb\xb6\xbd\x2a\xc7\x27\xfe\x33\xf1\x31\x3a\xd0\x50\x43\x1d\xbe\x3d\x74\x7b\x80\xd8\x50\xb0\xf4\xeb\xf5\x9a\x9f\xa5\x73\x8e\x70\x84\xd6\x17\xe9\x3b\x7e\x65\x10\xff\xfa\x22\xfd\x9e\x5f\xad\x1b\x15\xb4\xdf\xed\x42\x59\xbc\x00\x20\x29\x9a\xfa\xcb\x7d\x84\xa3\xe6\x9b\xfd\x8a\x95\x13\xa8\x38\xa5\xf8\x31\x0b\x72\x5d\x63\x57\xbc\xbc\x56\x48\xdf\x28\xa6\x8c\x78\xdd\x57\xb7\xa4\x65\xf5\x02\xaa\xa2\x1c\x6b\x45\x50\x1a\xbc\x36\x94\xd2\xa0\x02\x6a\x34\x28\x32\x6c\x63\xb2\x1a\x12\xd8\xad\xa6\x4b\xa7\x58\x2d\xcf\x20\xc0\xcc\x79\x51\x21\x42\xc1\x33\x04\x65\x73\x43\x29\x9c\xd7\x93\x12\x1d\xc9\xbb\x21\x3c\x28\xe0\x58\x1b\xd7\x64\xf2\xec\x84\x28\x1b\xdc\x43\xfb\x8d\x04\xf6\xd0\xdf\x10\xa1\x6f\xa0\xc6\x23\xd8\x56\x89\xfe\x06\x77\x5c\x6c\xcd\xde\xaa\x3c\x9d\x6d\xcf\x5f\x00\xe5\x3b\x5b\x26\xf7\x3a\x5c\x12\x0a\x8f\x25\xaf\x68\x1f\x91\xc0\xc2\xf0\x9e\x81\xe3\x01\x59\x53\x65\x7f\xd1\x81\x72\x91\x71\xc4\x59\x36\x53\x66\x87\xca\x35\x62\xe7\xe7\xf3\x92\xe7\x42\x97\x6c\x81\xf8\xe6\x9c\x2d\x72\x9e\xd7\x75\x19\x21\xbc\x4f\x8d\xd8\x84\x08\x14\x9a\x8c\x2d\x50\xca\x51\xba\x5a\xbe\xe3\x0b\x54\x2e\xaa\x25\xa2\xb2\x28\xf0\x1a\xad\x33\x36\x97\xe8\x25\xca\xb5\x19\xdb\xe5\xac\xcc\x66\x88\xcd\xe7\xcb\xcb\x35\xa0\x16\x78\xab\xa5\x40\x7b\xb1\xe6\x39\xba\x2c\xab\xd9\xf2\xa2\x92\x0c\xae\xcb\xe5\x62\x88\x45\x09\x1a\xca\x6b\x4e\xda\x2f\xf7\xef\xab\x6b\x65\xda\x9f\x44\x40\xf1\xb1\x49\x72\x1d\xcb\xc5\xd2\x72\x63\xb7\xe1\x2a\xb4\x10\xc4\xda\xcf\x10\xb3\x26\xa5\x54\xe2\x97\x48\x68\xdf\x37\xab\xca\xd6\x8f\x58\xef\x47\xfc\x46\x15\xf6\xfc\x4d\xff\x09\x2e\x05\x18\x5c\xb5\x63\x88\x80\x27\xb2\xf0\x25\x2a\x17\xef\xf9\x6a\xcd\xed\x51\xb0\x5c\xbc\x7f\xd1\x0b\x84\x9d\x9f\xb6\x1a\x20\xb0\x63\x80\x68\xb1\xe9\x12\x5b\xbf\xc6\xa1\x30\xe8\x3e\xf6\x0f\x9d\x09\x87\xf6\x0b\x5f\x64\xab\xab\xf3\x6a\x87\xab\x00\x55\xc5\xda\xe5\x49\xd3\xae\x05\x9e\x76\x43\xbe\xb5\x84\x6e\xce\x3f\x05\xd5\x56\x22\xae\xda\xbd\x27\x6e\xca\xd3\x5a\x90\xa6\xa4\xe3\x3f\x78\xa5\xe7\x69\x5d\xe6\xe6\x80\x54\xbb\x1a\xab\xaf\x03\x09\xb6\xea\x83\xc1\xcd\x59\x86\xec\xe3\xbb\x45\x59\x95\x6c\xae\x97\xbe\xea\xc2\xf0\x4d\x36\x63\x8b\x53\xfe\xf8\x79\x5b\x16\x55\x56\x1e\xf3\x36\x5e\x21\xff\xd7\x37\x69\x73\x1b\x79\x3f\x35\xbc\xb1\x16\x85\xb5\xcd\xf3\xc7\x7a\x1b\x02\x74\x7c\xf5\xb7\x5d\x1b\x2a\x79\xf3\x8a\x42\xfc\x7f\x4b\xde\xa0\x4d\xa8\xfe\x8c\x95\x69\x5d\x57\xb5\xc9\xf2\x61\xe0\x51\xf2\xa3\xf4\x2a\xf8\x3c\x7e\x6d\x9b\x61\x24\x32\xe6\x13\x80\xce\x76\xed\x45\x63\x18\xba\x9d\x58\x60\x57\x5d\xd8\x95\x82\x35\x32\xf9\x90\x97\xeb\x8a\xcf\x1b\x2b\x36\x63\x2c\xa0\xf3\xdb\xa5\x16\xd4\x1d\xa0\x0b\x31\xd0\xca\x52\x6b\xaf\xcb\x37\xaf\x27\x13\xc5\xed\xdb\x36\x5c\x8b\x44\xb2\x79\x75\x81\xef\x50\x56\xdb\x24\x1a\x43\xc0\xee\x39\xd2\xca\x26\xa9\x9e\x27\xcd\x6b\x36\x8a\xf1\x00\xfe\xe3\x22\x5f\xa2\xf5\x25\x3b\x97\xe9\xc7\x9c\xad\x2b\x69\x0c\xc3\x10\x5e\xb9\x55\xd6\x63\xb6\xab\x30\x97\xe3\x57\x06\x1b\x86\x8a\xe2\xbb\xba\xfa\xc0\x35\x6e\xcc\x05\xaf\xe3\xea\xd7\x09\x29\x23\xa1\xcb\xf0\x46\x56\xa1\xe5\x45\x35\x88\xc0\x4d\xc8\x75\xab\xac\x13\x72\xed\x3a\xeb\x0c\x19\xef\xf8\x95\x2c\x01\x1d\x05\x47\x3e\xd1\x9f\x94\xef\x2d\x0f\xb4\xba\xd1\x91\xb1\x6a\xf4\x11\x7a\x21\x2c\x50\xbd\x04\xac\x96\xeb\x75\x9b\xa6\x43\xcd\x43\x48\x88\xe1\xb5\x54\xb6\x68\x06\xaa\x56\x70\x93\x7a\xbc\x3a\x63\xeb\x77\x1d\x97\xad\x6d\x77\x32\xe9\x98\xa8\x70\xc4\x7a\x74\x7d\xdb\xe9\xba\x70\x5a\x81\x45\x13\x41\xc7\x64\xdf\x82\xcd\x7e\x61\x34\x7c\xf1\x4c\x64\x54\x12\xb3\x82\xaa\xfd\x6e\xc0\xf6\xf3\xc7\xdb\xb3\xbd\xb2\xb3\x3d\x77\xb3\x3d\x77\xb0\xbd\xda\x82\x6d\x67\x11\xe9\x75\x5d\x45\x5a\x4e\x7f\x6c\x57\x47\x7a\xac\x08\xb3\xc4\x55\xf1\x4d\xa5\x97\x62\xfe\xf6\xc1\x8b\x43\x95\xa0\x75\x6a\x31\x4f\x51\x56\x9c\x1a\x8a\x6b\x9f\xcf\x99\x60\x62\x53\xa1\x3e\x16\x95\x70\x4d\x5a\x3a\x26\x44\x4d\x65\xe7\xe1\x44\x4d\xb7\xe8\xf6\xb7\x0f\x5e\x18\x2b\x6e\xbf\x5c\x95\xe7\x73\x7e\xb0\xdb\x14\x91\x6c\xd4\x99\x28\xd2\x7f\xfa\xf3\x4c\x17\xa9\x89\x08\xc1\x76\x09\x15\x4a\xb3\xfe\xf5\x40\x2a\x8b\xe5\x6b\x8c\x8e\x05\xdc\xa1\x94\xea\x03\xa9\xe3\xe5\x6a\xd2\xde\xb3\xae\x2e\x8e\xaf\x49\x1f\xae\xe7\x65\xc6\x27\xde\x14\x91\xbd\xc1\x5d\x18\x0d\x5a\x72\x4d\xb4\x64\x8a\x02\x07\x5a\xff\x9a\x68\x83\x29\x8a\xf6\xec\x17\x69\x5c\xfb\xdd\x83\xaf\xf1\xa1\xde\x58\x6b\x61\x95\xcc\xa1\xfe\xce\xb1\x45\x03\x7f\x0b\x0a\x37\xf3\x4e\x23\x68\xed\xc8\x1c\xd9\xb5\xfb\x78\x0b\x0a\xe6\x51\x0f\x27\xe4\xc6\x86\xbd\x7f\x92\xb0\xda\x44\x97\x1b\x08\xae\x2d\xae\x1d\x43\xac\x2d\xc4\x75\x03\x6d\x03\xe5\xac\x9f\xdf\x40\xf5\x4a\xe8\x6b\x85\xd9\xef\x86\x64\xda\xab\xaa\xaf\x15\x77\xbf\x1b\x06\xd3\xb6\xaa\xfb\xdd\x30\x9a\xaa\x62\xef\x77\x23\xfc\xe1\xcd\x94\x06\x1f\x55\x70\xff\x8f\xac\xb4\xff\xc9\xea\xe1\xff\xf7\x54\xb6\x87\x9b\x0a\xca\x05\xcf\x6f\xb6\xc4\xfd\x37\x6c\xcd\xdb\xaa\xf5\x6c\xcd\xb5\x67\xaf\x7c\xe2\xac\x80\x3f\xf4\xe5\x4d\x14\xa0\x05\x3b\xe3\xeb\x73\xdd\x4b\x8f\x74\x36\x04\x88\x60\x43\xfe\xf7\x1f\x1f\x4c\x68\xbe\x46\x51\xd0\x5c\x61\x63\x42\xf3\x2a\x0a\x04\x1f\xc0\xd4\x26\x0a\x0e\xd5\x17\xc1\xbf\x21\x33\x68\x51\x4b\xf4\x6a\x3a\xa5\xfc\x95\xaf\x11\x43\x0b\x7e\x39\xbf\x42\xd2\xd7\x72\x13\x61\x3d\xa0\xa0\xce\x6d\x1e\x8b\x8b\xb3\x94\xaf\x3e\x20\xb8\x55\x0a\x6e\x55\x11\x1f\x7c\x02\xe9\xfc\xa1\xb3\xc9\x7c\x79\x09\x2d\xc4\x7f\x4d\x0d\xba\x8d\xbb\xd1\x6d\x08\x50\xcb\x65\xd3\xca\xa5\x8e\x08\xb5\x78\xea\x81\x59\xae\xfe\x79\xc4\xf3\xe1\xad\x2c\xf0\x42\x2f\xf2\xba\xf3\x9d\xb5\xa4\x21\xc4\x2f\xca\x4e\x46\x25\x7a\x38\x15\x5c\x9b\xc7\x30\x75\xbf\x96\xe1\x56\x4f\x78\x2c\x7a\x7b\x8c\xba\xb7\x6f\xeb\x6f\xe6\x7d\x4d\x7d\x53\x56\x97\xe5\x9a\xa3\x1f\x9e\xbe\x5c\x03\x86\x31\xc5\xd4\x17\xa5\x28\x03\xf9\x80\xbe\x16\xfa\x15\x72\x39\x00\xc1\xa8\x91\x84\x15\x15\x5f\xa1\x05\x3f\x65\x55\xb9\x38\xbd\x01\xc1\x03\x2a\x2e\x04\xaf\x54\x70\xb8\x58\x56\x13\xab\x54\x8f\x8e\xd0\x62\x39\x9a\xa9\xc2\x9d\x2c\x52\xa0\xbf\x37\xd2\xbd\x67\x04\x93\x82\xfd\xbd\x16\xb2\x21\x25\x55\x92\x51\x82\xa9\xad\xa1\x55\xe7\xbd\x0e\x77\x9d\x0c\xc0\xa6\x95\xaf\x7f\xf8\x56\xd3\x0a\x2c\x27\xc0\xb8\x7d\xce\xd6\xb0\xbc\xb0\x95\x0f\x35\x9a\x02\x1c\xc2\x25\x1a\x65\x55\x4b\x41\xa2\xc6\x7b\xc3\xca\xff\xfa\x87\x6f\x6f\x46\xf5\x72\x6d\xa7\x55\x3c\x5b\xe4\x13\xb6\x58\x56\x33\xbe\x52\x8c\xb8\xcc\x80\x2d\x72\xdd\x0c\x44\x0f\x47\x4c\xa1\xf5\xb3\xdb\x52\x20\x63\x56\xd1\x78\x9e\x82\xff\xc3\xec\xe3\xe9\xf3\x4f\x6d\x1e\x4f\x9f\x7f\x22\xeb\x78\xfa\xfc\x66\x8c\x63\xb9\xea\xd8\xc6\x72\xb5\x83\x69\x2c\x57\xd7\xb6\x8c\xdf\x76\xb4\x8c\xdf\xfe\x60\xcb\x78\xf5\xe9\x4d\xe3\xd5\x27\xb3\x8d\x57\x37\x65\x1c\x9b\x9e\x75\x6c\x76\x32\x8f\xcd\x47\xd8\xc7\xdb\x1d\xed\xe3\xed\x1f\x64\x1f\xb0\x28\xaf\x5b\xc6\x42\xce\x8c\xaa\x17\xc2\x39\x2f\xaa\xed\xb3\xb2\x05\xd8\x84\xfc\x86\x96\x45\x83\x09\xae\xb0\xb9\x29\x63\x00\x64\x37\x63\x0e\x80\xaa\x63\x10\xf0\xcb\xe3\x09\x09\x5d\x76\x20\x81\x74\x53\x58\x98\xec\x40\xbc\x02\x2d\xd0\x7d\xe4\x13\xdb\x4a\x97\x66\x29\x93\xd6\x54\xee\xdf\x47\x0b\x58\x22\x6f\x8c\x41\x6e\x1d\x22\xe8\x00\x2d\x8c\x97\xd5\x9b\x4d\x48\xe0\x19\xda\xda\x07\x54\xbf\x3c\xb9\x19\xd2\xd1\x4c\x16\xe8\xc0\x70\x63\xe8\x80\x74\x7f\xa9\x4b\x90\xfb\xef\xb4\x5e\x98\xca\xff\xb7\x33\xdf\xe7\x13\xfb\xcb\x45\x6d\xbd\xcf\x6f\xc8\x7a\xa5\xde\xbb\x96\xaa\x19\x6f\x6d\xcf\x5b\x18\xef\x20\x62\x02\xaa\x6b\xd8\xaf\xe6\x05\x0d\x9e\x71\x03\x56\xe4\xff\x70\x0b\x7e\xbe\xac\x58\xc5\x3f\x75\x00\x5e\x01\x95\x9b\x32\x61\xc0\x76\x33\x26\x2c\x19\xd3\x4d\x78\xb5\x1c\x8d\xbf\x02\x64\xd4\x7e\x55\x8f\xc0\x0e\x54\x54\x5f\xec\x89\x74\xb0\xfd\xe5\xf9\x24\x0a\x06\x66\xf9\xb1\x0a\xbb\xa1\x98\xf3\xe7\xd2\xd8\x48\xc8\x11\x10\xbb\x2b\xec\xf9\x40\x61\x8f\xaf\xa3\xb0\xaf\xf3\xfc\x53\x67\xbe\x2c\xcf\x3f\x51\xe6\x2b\xaf\xfc\xbe\x89\x77\xe6\xbc\xf7\xce\x9c\xef\xf4\xce\x9c\x6f\xfd\xce\xdc\x1f\x11\xf6\x9b\x44\x16\x36\x8c\x9a\x93\xdf\x8c\xad\x56\x57\xa2\x59\x3d\x86\xc8\x8b\xe1\x3b\xc3\x4a\x7b\x3d\xbc\x19\xc7\x30\x91\xda\x6f\x73\x6e\xb4\x2f\x69\x28\x1e\x3e\x36\xa2\xcb\x6f\xe6\xd5\x95\xaf\x17\xea\x0a\xf0\x65\xa1\xcf\x6d\xae\x4d\x37\x1c\xaf\x96\xe7\x7c\x55\x5d\xa1\x7f\xa8\x2b\x86\x01\x10\xcc\xab\x41\x31\x98\x56\x54\x06\xb2\x3e\x34\xe1\xa9\xc3\x4a\x73\x27\x7a\x37\xba\xac\xcb\xd3\x45\x59\x94\x19\x5b\x54\x28\x85\xe7\xe5\x42\xf3\x0d\x20\xea\x98\xfd\x6d\xe7\xa5\x6b\x66\xea\x5f\x6e\x60\x1e\x78\xc8\x81\xdd\x1d\x3b\xe2\x9a\x3c\x3d\x17\x66\xc9\xe6\x7b\x1d\xd9\x8f\x0a\x0e\x19\x03\x72\x23\x39\x0d\xed\x56\x42\xe4\x5d\x35\x7f\x84\xaf\x5e\xea\xa2\xee\xf7\xa2\xb3\xe6\xdb\xf5\xd9\x8f\x44\xf6\x7a\xd0\x5e\xfc\xed\x3a\xad\x3d\xdd\x15\x0b\xa6\x38\xc1\x0c\xa7\x70\xa6\x26\xc3\x39\xe6\xb8\xd8\x1b\x20\x79\xf3\x6f\xd4\xd5\x29\xc2\xde\xd6\xcb\x03\x60\x74\xd3\xc6\x6c\x07\x61\xf9\x52\x6d\x9e\x80\xb0\x58\x7f\x91\xff\xfd\xed\x37\xc3\x01\x0c\x91\xf7\x37\x3e\xf0\x97\x63\x34\x5c\x05\xd3\xff\xe4\xd8\x5c\x83\x1f\x37\x6c\xf4\xf7\x02\x5a\x93\xf6\x3e\x02\xe9\x43\x73\xbe\x38\xad\x66\xe8\x4b\x44\xb7\xdc\x4a\xdd\x0f\x34\x27\xcb\xc5\x7b\xbe\xaa\x5f\x0d\xb5\x30\xac\xe2\x83\x18\xb4\xeb\xd3\x01\x5b\x05\x9e\x7a\xd4\x6e\xb4\xdb\x59\x99\xfb\x80\x5e\x76\x83\xe8\x9d\x35\xca\x59\xc5\x10\x5b\xef\x48\x67\xeb\x99\xac\xee\x4a\xe1\x46\x0b\xd0\x87\xd5\xf2\x95\x4f\xec\x4b\x21\xf0\xf8\x23\xf6\xec\x28\x5a\x5d\xa3\x32\xec\xdc\xa9\xe1\x1e\x4b\x65\x36\x4c\xd6\xea\x35\xed\xe2\x91\x6a\x33\xe0\x92\xdd\xdd\x7a\xf3\x7e\x97\xb6\xfb\xa4\x57\xbb\x84\x57\xb7\x7a\x3d\xd8\xc2\x2f\xfe\x6a\x1e\x0e\xcf\x2f\xd6\xb3\x49\x9d\x48\x89\x1c\xc1\xf4\x5e\x69\x86\xee\xe5\x12\xc8\xb0\x4f\xb6\x4e\x45\x34\x05\xd7\x11\xa4\xc6\x39\xed\xba\x8d\x75\x23\xc9\xc0\x2b\x00\x8d\x30\xc9\x6c\x79\x0e\x83\xa4\x65\xec\x47\xa3\x69\x6b\x63\xf6\x1c\x65\xf3\xe5\xc2\xf5\xa6\xb2\xad\x49\x03\x9e\xbe\x2d\xc3\x8f\x76\x5b\x86\xc7\x4e\x5b\xd6\x31\x43\x96\x22\xd9\x6d\x76\xbe\x9a\x76\xba\x9e\x00\xfc\x5f\xc1\xb0\xff\x2a\x25\x33\x44\x5a\xc7\x52\x89\x6f\x18\x66\xeb\x5d\x63\x76\x02\x70\x86\xa9\x5e\x58\x97\xc9\x89\x85\x4c\xe3\x42\x97\x1d\xff\x19\x75\x83\xcb\x6d\x7c\xe0\x52\x99\x7c\x8d\xfe\x75\xf9\xc6\x24\x76\xbb\xa9\x02\x70\x67\x7d\xb9\x49\x8f\xad\xfb\x66\x7a\xbb\x65\xd4\xd6\x98\x0f\x6f\xa6\x34\xdc\x66\xbf\xcb\xd1\x97\x7f\x41\xb3\xaa\x3a\x5f\xdf\x3d\x3a\x3a\xab\x66\xeb\xc3\x94\x1f\x5d\x54\x05\xfd\x65\x8d\xde\x93\x43\x7c\x48\x50\x7a\x85\xfe\xc7\x19\xab\x66\x25\x5b\x0b\x8b\x69\x37\xc8\xc0\xae\x10\xb9\xd9\xe3\xe8\x08\x7d\xcb\x2b\x79\x1c\x8e\x73\x21\xee\x92\xa5\x73\xbe\x46\x7f\x57\x94\xfe\x7e\xeb\x0b\xd8\xc6\xbf\xe2\xfc\x41\xb3\xff\x65\xb0\x93\x06\xdd\x91\xca\xbb\x83\x6e\xdf\xae\x7f\xbe\x67\x47\x8f\xfe\x2e\xbb\xa3\x21\x7f\x02\x3f\xb4\xb8\xcf\xd4\xf7\x2e\x6a\xf5\xeb\xed\xdb\x86\xfd\x39\xc7\x1d\x26\x1b\x60\x27\x1b\xa7\xb0\x73\xe6\xef\x53\xb9\x1b\xff\x87\x65\xce\x0f\x7f\x59\xa3\xe5\x0a\x7d\x23\xb7\xd2\x94\x45\xc9\x73\x94\x2d\x73\x3e\x05\x2c\x6c\x91\xa3\x8b\x35\x47\x65\x25\xc6\xb5\xbf\x0b\x39\x6a\x7d\x50\xfb\x70\x9a\x3e\x9c\xaa\xef\xdd\x3e\xc8\x5f\xef\xc9\x3d\x49\x6d\xb3\xc3\x06\xfa\x58\x47\xf6\xdb\x6f\xda\xb7\xc3\xcb\x72\x91\x8b\xb7\xcb\x0e\x8c\xdc\x3a\x24\x78\x41\xfa\xcf\xb0\xd9\xe7\xd6\x17\x47\x5f\x1e\xdc\xd8\xdf\x97\x47\xb7\x64\x6f\xd7\xd5\xaa\x5c\x9c\x3e\x5c\x2d\xcf\x4e\x66\x6c\x75\xb2\xcc\x85\xe6\x5e\xc0\x8f\x87\x85\xf6\xab\x12\xfe\x4b\xf6\x8e\x2f\xa4\x8c\xfb\x26\x7b\x7e\xb1\xb8\x12\xf2\xbd\xf5\x45\x13\xc1\x2e\xb2\x35\xc9\xb9\xf8\x71\x22\xe9\xc8\x0e\xc2\xd2\x26\x6c\xbe\xaf\x87\x40\xf8\x29\x5b\x5e\x2c\x2a\xbe\x52\x33\x97\xf0\xd3\xbc\x8e\x15\xb2\x79\x1b\x2c\xe0\x29\x9c\x67\xac\xbf\xf0\x4d\xb5\x62\xe2\xcb\xe5\xac\x9c\x73\x34\xa9\xb1\xdd\x57\x48\x24\xe9\x2f\xa0\x4d\x8b\x30\x53\xdd\xfb\xba\xaa\x1b\xec\xef\x0b\x57\xff\x02\x74\x2a\x81\xbf\x3a\x46\xde\xe6\x5b\xea\x79\x42\xe7\xf2\xa7\xfb\xf0\xd3\x37\x0f\x1f\x8a\x9f\x2c\x94\x84\xb8\xe0\x75\x7d\x7d\xb1\x5a\x2d\x4f\x59\xc5\xa7\x60\x75\xd5\x8c\xaf\x38\x9c\xf3\x44\x0b\xbe\xa9\x90\x60\x81\x65\x15\x5f\x41\x23\xe8\xc6\x36\xfc\x01\x83\x13\x09\x7e\x1b\x79\x9b\x87\x27\x9e\xb7\x27\x2c\xd4\xdb\x7c\x0b\x1f\xff\x21\x82\xf3\x7c\x79\xd9\xd2\x87\x66\x5f\x48\xc9\xcb\xa1\x7c\xa2\xba\x28\x10\xf8\x0f\x1f\xee\xc1\xd1\x4c\x6f\x0f\xed\x23\x0d\x33\x3c\xd8\xaf\x2b\x0e\x29\xea\x6d\x16\xac\xba\x7a\xb1\x38\x63\x55\x36\xe3\x79\x4b\xef\x1e\x5a\x2e\xe6\x57\x88\x9d\x9f\x73\xe8\x77\xb9\x06\x07\x44\x17\x8b\xb2\x9a\x8a\x17\xcd\x8c\xad\x39\xbc\x6d\x0a\x41\x34\x98\x1a\x18\x21\xa4\xaa\xde\x17\xd5\x60\x15\x43\x3d\xd3\xbe\x9e\xb3\x72\x35\xec\x19\xf4\x4b\xf1\xfa\x85\x12\xdd\xc1\x81\xe2\xfd\x56\xbf\x03\x96\x96\x02\x50\xfc\x5f\xc5\x7b\x09\x55\x7b\xe3\x75\x9c\x81\x2f\xc0\x19\x60\x14\x6e\x7d\xa1\xb1\x72\x99\xb7\x74\x8d\xbc\x5c\xe4\x7c\x83\x8e\xd1\x01\x36\x9a\x7d\xe3\x47\x77\xee\x68\xc6\xbf\xbf\x2f\x9b\x59\x8c\x1f\xe8\xbc\x06\x90\x37\x7d\x63\x17\xa6\xf4\x50\x68\x5c\x4a\x46\xfe\x7a\x70\x5c\xab\xff\x9e\x26\x2f\xb4\x7f\x6c\x88\x1f\x35\xa2\xaf\xbe\x42\xd8\xab\x0d\x08\xfd\xa6\x7c\x48\xa9\xa4\xe6\x44\x1a\x2b\xfa\x0d\x75\xec\xb0\x11\xfe\x16\x84\x00\xa1\x4d\x49\x8d\xf0\xb3\x19\xcf\xde\xbd\xc8\xd8\x9c\xad\xfe\x97\x68\x35\x11\x7a\x78\xb6\x2c\x17\x72\x37\x35\x08\xa0\xf9\xa9\xeb\xf1\xed\xcf\xd2\xeb\x5b\xe1\x54\xb3\xd5\xf2\x12\x3d\x58\xad\x96\xab\x09\xf4\xea\xce\x63\x91\x0a\xb5\xa6\xf9\xe3\xfe\x1d\xb4\xdf\x22\x38\xac\x96\x32\xb2\x4e\x70\xb4\x77\x58\x2d\x7f\x3c\x3f\xe7\xab\x13\xb6\xe6\x93\x3d\xb4\x2f\x11\x08\x93\x5f\x2c\x2b\x61\xe0\xc0\xac\x94\xcb\x1d\xf1\xb0\xee\xe8\x87\x4f\x30\x12\xb4\x72\x82\xac\x5a\x64\xe2\xad\x38\xa6\x72\x99\x4d\x0d\x4e\x52\xca\x06\x6d\x4c\x74\x01\x7e\x55\xb7\x91\x1a\x85\xa9\xca\x0d\xf5\xf6\xfa\x7a\x91\x0e\x71\x52\x37\x34\xa9\x45\x43\x7b\x5b\x19\xe7\xc3\x87\x54\xc5\x3a\x15\xe6\xf0\x41\x7a\x55\x71\xb4\xe6\xff\x75\xc1\x17\x19\x04\x3a\x3b\xa3\x2d\x8d\xda\x74\x60\x20\xbc\x3a\x4b\x97\xf3\xc6\x91\x6c\x94\xa9\xd7\xa5\x4c\x86\x94\x1b\x4c\xe3\x42\x8a\xa4\x80\xb0\x12\xd0\x89\xd7\xb0\xd4\x6c\x3c\x36\x30\x01\x61\x58\x67\xc2\x1f\x32\xe1\x30\xf8\x7b\x3b\x32\x89\x89\xe4\xd2\x53\x5c\x3e\xf0\x3a\x28\xf6\x8f\x2d\x56\x13\x6d\xd1\x99\x07\xde\xa0\x33\xc1\x47\x49\x14\x53\xc5\x6c\x2c\x99\x7d\xb8\x25\xb3\x98\xec\xda\xa9\x16\xd2\xc4\x55\xb7\xa3\x5d\x0f\x68\x6c\x13\x30\xf4\x5d\x42\xa4\xfe\x6a\x9c\xe8\x27\x4d\x0d\x52\x91\xba\x0f\x93\xab\x41\xd6\xd4\xc2\x8f\x0e\x2a\x0d\x68\xfd\x83\x50\x82\x8c\x56\x5b\x0e\x2e\x6d\x8f\x75\xc2\xfa\x28\xa3\xa1\xdc\x3f\x76\xb8\x7e\x2f\xa2\xb7\xcd\x3e\x55\x22\xdc\xc8\x7e\xc5\x59\x7e\xb2\x5c\x54\xe5\xe2\x02\x0e\xcf\x82\xf6\xdb\x50\x24\x38\xf9\x0e\xfa\xfe\xd5\x31\xb0\x75\x22\x12\x0b\xc3\x68\x70\xe7\xbb\xc5\x7b\x36\x2f\x73\x00\x92\xd2\xbe\xa3\xba\xd5\xc8\xbb\x4b\x05\x49\x84\x30\x51\xf0\xba\xa1\xf3\x46\xb9\x89\x68\xda\xfc\xb8\xbf\x2f\x92\xf1\x3a\x42\xf5\xd0\xdc\x96\x61\x44\x26\x82\x22\x4a\xfe\x43\x0b\x86\x46\x68\xff\x61\xc3\xd8\xd1\x11\xfa\xae\x40\x97\x1c\x89\x7c\xed\xe2\x1c\x89\x4c\x75\x8a\xca\xea\xff\xfe\xef\xff\x53\x0f\x4b\x3a\x0a\xe0\xf8\x96\xa5\xe7\x03\xc0\x3b\x83\xe0\x2f\xad\xf7\x05\x78\xc1\xa4\xb5\x72\x01\x8c\x75\x33\x24\xfa\x17\x5f\xff\x12\x18\xcc\x77\xa8\xab\x8f\x50\x55\x17\xd3\xf1\x50\xeb\x4a\xb2\x05\x9b\xc3\xe1\x87\x46\x8e\xcf\x39\xcb\x51\x51\xae\xd6\x55\x2d\x25\xe8\xd6\xee\x6a\x1e\x8e\x6e\x68\xb2\x58\x0e\xc5\xbb\xde\xab\x6d\x42\x12\xba\xad\xf4\xaf\x22\xab\xc6\x6b\x23\xdf\x9a\xd7\xe1\x18\xd6\xc3\xf3\xa0\x36\xa8\x93\x1a\x15\xa8\x05\x1d\x5b\x1c\xe6\x5e\x3f\x1e\xe8\xc8\xb0\x7c\xcd\x80\x9a\x3b\x8d\x76\x4d\x09\x58\x63\xbd\xad\xf9\x6a\x31\xaa\x9b\xc0\xef\x60\x82\x75\x5a\x2f\xfb\xee\xf7\x65\x7b\xc6\xae\x50\xb9\xc8\xe6\x17\xf0\x12\x22\x5e\x2e\xf4\x57\x1a\x93\x94\x1f\xd6\xd2\x79\xb0\x83\x74\xc0\x94\xaf\x27\x40\x4f\xbd\xa7\x11\xd8\x9b\x24\x69\xe9\x02\xf5\x6d\x02\xf5\x20\x79\x91\x02\x1b\xcb\x0f\x3e\xa5\xcc\x87\x23\x7c\x5f\xa2\x54\x49\xf4\xe1\xcd\x4a\x14\x42\xc6\x35\x85\x1e\x83\xd0\xbd\x4d\x5f\xec\xde\xc6\x3b\xd9\x43\xbf\x81\x44\x26\x92\x07\xf9\x6b\xa3\x8f\xc0\xaa\x0f\x78\xa3\x32\xbc\x63\x60\x4f\x7f\x05\x33\x6b\xa2\x96\xa7\x51\x0b\x3f\xbe\x7c\x78\x40\x51\x0e\x33\x65\x3c\x6f\x22\x6f\x1d\x36\xd5\x09\xac\xe6\x3b\x04\x34\xed\x3b\xc4\x9f\x7b\xbd\x9c\x44\xe5\x1a\xed\x68\x2c\xf9\x6b\xf0\x75\x53\x12\x0d\xac\x8e\x6a\x40\x45\x0f\x80\x5a\x52\xa2\xc5\xd8\x76\xf6\xa7\x93\xee\xb4\xf3\x44\xd5\xd9\xb9\x96\x8d\x4c\xaa\xb3\x73\x74\xdc\x1b\x4b\xf6\xd0\x5f\x8e\x8f\x65\x50\xee\x67\x27\x6a\x11\xa3\x3a\x3b\xef\xe7\x19\xda\x0b\x7a\x0b\xbd\xf7\x29\x27\xdf\x84\x58\xd1\x31\x30\x78\xe7\x3d\x5f\xad\xcb\xe5\xe2\xce\x5d\x74\x07\x26\x7d\xef\x4c\xc5\xaf\x92\x9f\x3b\x77\xb5\xac\x10\x7e\x97\xdd\x55\xbf\xcb\x2f\xb7\xbe\xf8\xa0\x26\xe9\x5e\x2c\xcf\x38\xfa\xfa\xc9\xb7\x28\xbd\x28\xe7\x39\x5a\x9e\x57\xe5\x59\xf9\x2b\x5f\xad\xa7\x68\x5e\xbe\xe3\x68\x75\xf8\xcb\x7a\x2a\x5f\x89\x61\xa6\x7d\x7d\xce\xb3\xb2\x28\x33\xe1\xbc\x79\x09\x0a\x3f\x67\x55\xc5\x57\x8b\x35\xe0\x83\x46\xd5\x8c\xa3\x62\x39\x9f\x2f\x2f\xcb\xc5\xe9\x5d\x39\xe7\x29\xcc\xaf\x77\x2e\x12\xdd\xa9\x8d\xe6\x8e\x9c\xdc\xed\x00\x1c\xb2\xb3\xbc\x37\x8b\xda\x1c\x91\x14\xcf\x6e\x7d\x21\xd5\xa5\x0e\x4d\x36\xd3\xdc\xdd\x01\x4c\xf4\x19\x74\x07\xca\x69\xdf\x2e\x7a\xb3\xc6\x7f\xd1\xbe\x1f\x2e\x96\x39\x7f\x79\x75\xce\xdb\x64\xae\x9d\xab\x56\x2f\x1e\xe5\x42\x9f\x37\x7e\x5e\x2e\x4e\x97\xff\xf3\x05\x7a\xef\x1d\xd2\x43\x0f\x5e\xcf\xdb\x16\xda\x59\xd2\x86\x19\x15\x1a\x6b\x4c\x6c\x75\x39\x63\xf3\x1e\xa6\xf8\xd0\x3b\x90\x13\x31\xab\x7a\x6f\x94\x3c\xc5\xa8\x7e\x9b\xb1\xf5\xd3\xcb\xc5\xb3\x7a\x0b\xcc\xb1\x02\x3a\xec\xfe\x0e\xe0\xcd\x12\x09\x54\x8d\x93\x42\xa9\x23\x46\x17\x5c\xae\x0f\x89\xe7\x70\x90\x78\x4f\xc8\x46\x97\xd5\xeb\x77\xb2\x80\xa1\x80\x80\xcf\x9d\xc9\xaf\x5e\xbf\x9e\xcf\xca\xc5\x52\xf4\x8a\xa1\x4b\x9e\x22\x75\x50\x55\xcd\x5a\x1f\x2a\x83\x56\x32\xf9\x70\x4b\x1d\x51\x85\x65\x93\x0f\xd3\x7f\x7c\x78\x33\xa5\xd1\x36\x4b\x22\x83\x13\xbb\xaf\x9e\x3c\x7e\x54\x55\xe7\xcf\xc5\x90\xb1\xae\x1a\x6c\x7f\x4d\xcb\x53\xb9\x99\xe5\xf0\x97\xf5\x5f\xb7\xc1\x7c\xe7\x62\xcd\xe1\x85\x2d\xab\xee\xdc\xbb\x35\x24\xf4\x4d\x79\xfa\x03\x20\xbc\x27\x3a\xfc\xcb\x7a\x26\x82\x72\x79\xba\x58\xae\xf8\xdd\x79\xb9\xe0\xb7\x1a\xd2\x97\x3c\xf5\xb7\x22\x29\x94\xf4\x13\x4f\xe5\xd8\x24\x8f\x19\xdf\x39\x3c\x9a\x97\xe9\x91\x40\x21\x82\xf3\xad\xa3\x23\x94\x2f\x17\x15\x5a\xbe\xe7\xab\x55\x99\xf3\x7a\xc1\xa1\x5e\xdf\xb8\xa5\x1d\x41\x56\x2b\x07\x22\xc0\xdd\x69\x36\x34\xc0\x7a\x44\x07\xe0\x50\x92\xec\x42\x09\x03\x81\x65\x32\x1d\x04\x98\xbb\x77\xeb\x83\x41\x1a\xf2\x89\x5a\xd8\xaa\x39\xfe\xeb\x5d\x42\x3e\xbc\x11\x52\x98\xbe\x96\x52\x78\xb3\x77\xeb\xe8\xe8\xff\x43\xeb\xe5\xc5\x2a\xe3\x4f\xd8\xf9\x79\xb9\x38\xfd\xf1\xf9\xe3\x63\xf1\xf0\x60\x0e\x9b\x48\x7f\x59\x1f\x9e\xb1\xf3\x5b\xff\x2f\x00\x00\xff\xff\x82\x18\x06\x61\x6d\x20\x06\x00")
+var _web3Js = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\x7b\x77\xdb\x36\xb2\xf8\xff\xfe\x14\x13\x9d\x7b\x22\x29\x56\x24\xcb\x49\xb3\x59\xb9\x6a\x9a\x67\xeb\xbd\x49\xdc\x93\xd8\xdb\xbb\x3f\xaf\x9a\x43\x4b\x90\x84\x86\x26\x75\x49\xc8\x8f\xeb\xe8\xbb\xff\xce\x00\x03\x10\x20\x41\x8a\xb2\xdd\x24\xdd\xc6\xce\x89\x49\x60\x30\x18\xcc\x0c\x06\xaf\xc1\x30\x61\xff\xbb\xe4\x09\x1b\xb6\xa6\xcb\x68\x2c\x78\x1c\x01\x6b\x89\x4e\xd4\x49\xda\x57\x26\x25\x6d\xc5\x9d\x65\xfb\x8a\x4f\x5b\x77\xa2\xe3\x78\xa4\x9e\x84\x7c\x3a\x0b\x12\x08\x86\xe2\x72\xc1\xe2\x29\x68\x5c\xc3\x86\x2e\xda\xb8\x7b\x97\x12\xf7\xb0\xcc\xf2\xee\xdd\xa0\x9d\x30\xb1\x4c\x22\x08\x5a\x71\xe7\xce\x4e\x1b\xd3\xb9\x4e\xe3\x94\x86\x58\xa7\xc3\x88\x9d\xc3\xcb\x24\x89\x93\x56\xe3\x79\x10\x45\xb1\x80\x29\x8f\x26\x70\x1a\x4f\x96\x21\x83\x66\x63\x3b\xde\x6e\x34\x1b\xed\x3d\x31\x4f\xe2\x73\x98\x76\xc7\xf1\x84\x0d\x1b\x6f\x0e\x5e\x1c\xbd\x7e\xf9\xe1\xed\xc1\xe1\x87\x57\x07\x47\x6f\x5f\x34\x3a\xd3\x15\xe2\x0b\x87\x48\xfb\xf0\x8a\x5d\x2c\xe2\x44\xa4\x83\xab\xd5\x6a\x0f\xdb\x70\xbc\x33\xea\x8e\x83\x30\x6c\x85\x5d\xca\xea\x68\xea\x5b\xac\x7d\x85\x45\xa3\xa1\x04\xec\x8f\x8e\xd9\x68\x8f\x48\x4d\x5b\xd1\x93\x68\xc0\xda\xab\x4e\xd8\xc9\x4a\xb2\x8e\xe2\xdd\x8a\xa0\xb0\x4a\x9d\x29\xa9\xe0\xb5\x78\x35\x8d\x93\x16\x42\xc7\xc3\x9d\xbd\xf8\xfb\xa4\x1b\xb2\x68\x26\xe6\x7b\xf1\xf6\x76\x3b\x6d\x25\xc8\x78\x43\xc6\xaa\xdd\xba\xea\x0f\x8e\x0d\xc9\x84\xa2\xa3\xb8\xd4\xa1\xba\xdb\x57\x5b\x2a\x41\x13\x33\x3c\xde\x02\xb8\xda\x02\x00\x68\x8c\xe3\x28\x15\x41\x24\x1a\x03\x10\xc9\x92\x75\x54\x2a\x8f\x16\x4b\x91\x36\x06\x80\x90\xf8\x7b\x45\x7f\x01\x1a\x51\x70\xca\x1a\x03\x68\x7c\x88\xcf\x23\x96\x34\x3a\x59\x0e\xb6\x0e\x73\x82\xc9\x24\x61\x69\xda\xa0\x9c\x95\xfc\x3b\xea\x6c\x39\xc5\x25\x1a\x4a\x8b\x97\x62\x7d\x7d\xf1\x07\xab\x88\x53\xdf\xc9\xa5\x60\xe9\x83\x5d\x7f\x7d\x1a\xc8\x70\x7a\x0b\x60\xd5\xb9\x15\x06\x5c\x8b\x1e\xd3\x1c\x8b\x7b\xf5\x18\xb0\x31\xab\xbf\xd6\xa6\x8f\xe3\x48\xb0\x48\xdc\xb8\xf1\x95\x95\x7d\xad\x8d\x47\x89\xdd\xb8\xe5\xb7\x25\xf6\x69\x10\xa6\x9f\xaf\xe9\x09\x4b\x59\x72\xc6\x3c\xad\xff\xda\x85\x96\x2e\x4f\xde\xb1\x19\x4f\x45\x12\xfc\x05\x84\xd7\xa9\xc2\xc1\xce\x0f\x6e\x64\xf7\x45\x12\x44\xe9\xd4\x6b\xfa\xfe\x2c\x3c\x48\x72\xaa\xb0\x39\x13\x52\x26\xde\x57\xab\xd4\xad\xf1\x22\x5f\xf5\x67\xa9\xb4\x82\x79\xb7\x21\x80\xa0\x0e\xe3\xab\x10\x2c\x12\x7e\x1a\x24\x97\x5e\x3a\xe2\x38\x5c\x2b\xbc\xa7\x54\xd7\x9f\x97\x85\xee\x18\x5c\x89\xa6\x8c\x09\xcf\x4b\x87\xf1\xaf\x89\x09\x5e\xea\x27\x3c\x8d\xcf\xa3\x1b\x50\x1e\x44\x71\x74\x79\x1a\x2f\xd3\x0d\x48\xe7\xd1\x84\x5d\xb0\x89\x33\x76\x5d\xaf\x4d\x9d\x2a\xe4\x16\x39\x2e\xf6\x73\x1e\xdd\xc4\x70\x3f\x5d\x4a\x4e\xbc\x8c\x26\x6c\x42\x48\x0c\x02\x76\x86\xba\xf4\x1f\xc0\xa3\x13\x3e\x99\xd4\xe3\xd1\xf5\xf0\x9f\x05\xe1\xd2\x4b\xfe\x92\x47\x62\xf7\xbb\x47\xd5\x22\x78\xcb\xce\x9f\xf1\x2f\xc8\xfc\xba\xbc\xf7\x12\xff\x7c\x1e\x44\x33\xf6\x27\xa0\xbe\x73\x0d\xdc\xd6\xac\xbe\x52\x6b\xbc\x9c\xf9\x45\x8d\x46\x6b\x19\xb4\x35\xda\xda\x5a\x75\xae\x56\xa3\xce\xee\x17\x5b\xf4\xdf\x48\x05\xec\x1d\x03\xdb\xea\x96\xd7\xb7\x31\x4f\xd7\x19\xee\xcf\x31\xe4\x74\x2a\x70\x24\x6c\xba\x8c\x26\x3e\x2c\xb5\x54\xe5\xc6\x03\xd7\xb7\x65\xef\x9f\x7b\xd9\xfb\x45\x94\xf6\x2f\xbe\xe8\x93\x8b\xbe\xe0\xda\x8d\xa7\xf5\xc2\x0d\xda\xfe\x27\x1c\xcc\xb3\xb1\xea\xc1\xad\x8d\x55\x9b\xca\x7d\x9a\xc4\xa7\x75\x5a\xd8\x29\x47\x21\xe2\x3a\x62\xaf\x40\x70\xb3\x09\xdf\x97\xed\x37\x5f\x03\xff\x78\x34\xe1\x09\x1b\x8b\xfd\xc9\x0d\x29\xb9\x99\x20\xf8\x38\x58\x1c\x7e\x51\x61\x88\xb8\x0e\x03\xbc\xc4\x4f\xd8\x22\x4e\x79\xd5\x42\x7d\x11\x5c\x06\x27\x21\x73\x27\x05\x5f\xc4\x2a\x95\xe9\x5c\x0d\x95\xc9\x70\x5b\xd4\xdc\xa2\x0e\x3c\xd5\xed\x7d\xe1\xf2\xb3\x68\xfc\xfe\x14\x4c\x2a\xc1\x5d\x4f\xcf\x2a\x31\xff\x31\xec\xff\x6a\xb9\xbe\x11\x6f\x36\xe2\xfa\x57\xa0\xf4\x79\xa3\xf7\x8d\xed\x35\xd9\x7e\xe3\x81\xeb\x8f\x96\xec\xbe\x67\x48\x2b\x4a\x37\x9b\xc7\x3d\xac\x33\x8f\x43\xc7\x85\x29\x0c\xb5\x87\x43\xab\xd9\xed\x4d\xe3\xe4\x34\x10\x82\x25\x69\xb3\xbd\x27\x01\xde\xc7\x21\x9f\x70\x71\x79\x78\xb9\x60\x2e\x2c\xd6\x8f\x50\x5b\xbd\x7b\xf7\xb6\xe0\x9e\x03\x49\x7b\xee\xc0\x53\x08\x60\x91\xc4\x31\x02\x83\x98\x07\x02\x12\xb6\xc0\xb3\xc5\x48\xa4\x40\xb2\x03\xcc\x44\x0c\xfb\x02\x4e\x03\x31\x9e\xb3\x74\x80\xaf\x94\x6d\x3d\x1e\x8f\xec\x97\x87\xce\xdb\xc8\xcd\x7c\x90\x7b\x1f\x1d\x3f\x1a\x1d\x8f\x3a\xd0\xed\x76\xb7\xe0\x5e\xaf\xd0\x36\x4d\xf1\x10\x34\xe3\xa0\xd5\x26\x11\x8b\x39\x4f\xbb\x1f\xa4\x3d\x7a\xa5\x19\x04\x43\x98\x76\x15\xbb\xf6\x31\x63\x3f\x12\x7b\x16\xb0\x9a\xfc\xf9\xa0\x0f\x64\x0e\x55\xb7\xb7\xb5\xda\xdb\xda\xf2\xd0\xd1\x5d\x24\xb1\x50\x5c\x1b\x02\xfa\xd7\xd8\x30\xad\xab\x55\x7b\xaf\xba\x54\x57\xce\x5e\x92\xe5\x58\xc4\x58\xb9\x07\x76\x5d\xbd\x5d\x9e\x92\xcc\x33\x86\xa0\x3a\x6a\xa6\x90\x5f\xcb\x9d\x3b\x98\xd8\x95\x72\x6b\xf5\x88\xdb\xad\x7f\x1f\xb7\x8e\x77\xee\xff\x7d\x74\xaf\xfd\xef\x51\xfb\x49\xaf\xad\xda\xe9\x2e\x1c\x4a\xc9\x5a\x75\xae\x1a\xb6\x2a\x36\x06\x7f\xef\x34\x94\xbe\x35\x06\xfd\x87\xab\x51\xe7\xbb\x2f\xac\xde\xcf\xe2\x38\x5c\xa3\xdb\x27\x08\x52\xa2\xd8\x98\xa7\xff\x2a\x2d\x95\x4f\x0f\xb3\x47\x52\x5e\xf9\xac\x35\x99\x32\xaa\xd5\x58\x52\x76\x5d\x1d\xc6\xc2\x9b\x28\xb1\x82\xcf\x6b\x30\xa6\x6e\xa8\xbe\x6e\x91\x0a\xdd\x45\xc0\xca\xea\xae\xa5\xb5\xbf\x21\x6b\x5d\x9d\xbd\xf7\x5f\xb5\x94\x96\xe8\x59\xaf\xb1\x8f\xbe\xb4\xc6\xe2\xbc\xc7\xa8\xac\xf0\xeb\xac\x98\x33\x90\x83\x9d\xb4\xc8\x5d\x9f\xe6\x62\xae\x79\x20\xbd\xc4\x34\x32\xc6\x94\x6c\x67\x18\xf5\xa5\x2c\x5b\x7f\x81\x86\x56\xf3\xf8\xf8\xf8\x61\xb1\xa8\x47\xcb\x31\xfb\xfa\x6a\x8e\xa5\x37\xd2\x73\x55\xa0\xa0\xe8\x98\xbc\xa9\xa6\xbb\x65\xaa\x54\x1d\x21\xab\x6b\xbc\xa6\xb2\x23\x12\x65\x9e\xaf\xfa\x9d\x55\xfb\x7a\x8a\x4f\xd4\xad\xd7\xfc\xbf\xd5\xd1\xfc\xde\x3d\x29\x8e\xc3\x39\x4f\x61\xca\x43\x86\x9a\xba\x08\x12\x01\xf1\x14\xce\xd9\xc9\x83\xee\xef\x69\x77\x4b\x82\xd0\x1b\x02\x4c\x13\xc6\x20\x8d\xa7\xe2\x3c\x48\xd8\x00\x2e\xe3\x25\x8c\x83\x08\x12\x36\x41\x8f\x0f\x7e\xb2\x14\x0c\xb8\x80\x20\x9a\xf4\xe2\x04\xbd\x51\xf9\xf4\x52\xe2\xe0\x02\x96\xd1\x84\x25\x52\xe1\x05\x4b\x4e\x53\xac\x07\x5f\x7e\x7a\x7b\x04\xaf\x59\x9a\xb2\x04\x7e\x62\x11\x4b\x82\x10\x7e\x59\x9e\x84\x7c\x0c\xaf\xf9\x98\x45\x29\x83\x20\x85\x05\xa6\xa4\x73\x36\x81\x93\x4b\xd2\x22\x06\xaf\x90\x98\xf7\x44\x0c\xbc\x8a\x97\xd1\x24\xc0\x36\x77\x80\x71\x31\x67\x09\x9c\xb1\x24\x45\x55\x7d\xa0\xeb\x22\x8c\x1d\x88\x13\x89\xa5\x15\x08\x6c\x43\x02\xf1\x02\x0b\xb6\x21\x88\x2e\x21\x0c\x44\x56\xb6\xc8\x82\xac\xa5\x13\xe0\x91\x6c\xcf\x3c\xd6\x3d\x9b\x0b\x38\xe7\x61\x08\x27\x0c\x96\x29\x9b\x2e\x43\x35\x71\x3c\x59\x0a\xf8\x75\xff\xf0\xe7\x83\xa3\x43\x78\xfa\xf6\x5f\xf0\xeb\xd3\x77\xef\x9e\xbe\x3d\xfc\xd7\x1e\x9c\x73\x31\x8f\x97\x02\x70\x46\x29\x71\xf1\xd3\x45\xc8\xd9\x04\xce\x83\x24\x09\x22\x71\x09\xf1\x54\xa2\x78\xf3\xf2\xdd\xf3\x9f\x9f\xbe\x3d\x7c\xfa\x6c\xff\xf5\xfe\xe1\xbf\x20\x4e\xe0\xd5\xfe\xe1\xdb\x97\xef\xdf\xc3\xab\x83\x77\xf0\x14\x7e\x79\xfa\xee\x70\xff\xf9\xd1\xeb\xa7\xef\xe0\x97\xa3\x77\xbf\x1c\xbc\x7f\xd9\x05\x78\xcf\x90\x30\x26\x31\xac\x67\xf4\x54\xca\x2c\x61\x30\x61\x22\xe0\xa1\x96\xff\xbf\xe2\x25\xa4\xf3\x78\x19\x4e\x60\x1e\x9c\x31\x48\xd8\x98\xf1\x33\x36\x81\x00\xc6\xf1\xe2\xb2\xb6\x20\x25\x15\x41\x18\x47\x33\xd9\x6c\xa3\x65\x00\xfb\x53\x88\x62\xd1\x81\x94\x31\xf8\x7e\x2e\xc4\x62\xd0\xeb\x9d\x9f\x9f\x77\x67\xd1\xb2\x1b\x27\xb3\x5e\xa8\x10\xa4\xbd\x1f\xba\x5b\xf7\x7a\xda\xd8\xfe\x28\xd5\x76\x1c\x4f\x58\xd2\xfd\x3d\x95\x29\xc1\x52\xcc\xe3\x04\xde\x04\x09\xfb\x08\xff\x1d\x0b\x76\xce\xc7\xff\x07\xdf\x9f\xe2\xfb\x8f\x4c\xcc\x27\xec\xac\x3b\x8e\x4f\x7f\x90\xc0\x93\x40\x30\xd8\xdd\xe9\x7f\x27\x67\xa7\xeb\x87\x82\x82\x4d\xa4\xf9\x93\x5b\x86\xe6\x63\xbe\xb1\x83\x66\x0a\x16\x30\x8e\x82\x3e\xc8\xfd\x48\xb8\x80\x3c\x12\x3e\xb8\xa3\x02\xe0\xb2\x04\xf2\xc5\x65\x14\x9c\xf2\xb1\x36\xe3\x56\x89\x89\xca\x91\x83\x81\xaf\xe4\x7b\x91\xf0\x68\xe6\x96\xc1\x2e\x10\xcd\x7c\xd0\xef\x58\x90\x6b\x63\xc2\x02\x6f\x1b\x8f\x8a\xa0\xcb\x32\x58\x0f\xd9\x86\x5e\x89\x98\xa7\xd4\x40\xc7\x32\xa7\x16\x8a\x8e\x1c\x61\xc9\x4e\x93\x99\xb6\xf3\xbb\x06\x05\xbe\xb5\x14\xf0\xa7\x4f\x66\xf5\x08\x25\xd0\x4f\x93\x24\xb8\x54\xe0\xca\x88\xe7\xa6\x02\xcf\x51\x3f\xad\x19\x00\xf5\x24\x65\x21\x26\x20\x62\x60\x11\xea\x70\x6f\xc2\xf0\x8f\xa9\x05\x16\x41\x12\x28\x33\x89\x56\x89\xe6\xb5\xee\xc0\xac\x70\xdb\x2d\x46\xb0\x54\x0f\x46\x6a\xa8\x95\x49\x30\x94\xcd\x4f\x1d\x12\xa5\xed\x3f\x65\x62\x1e\x4f\x3c\x64\xa9\xcd\xf5\x38\x39\x95\x25\x91\x50\x5d\x2d\x32\x60\x0b\x64\x2b\x7f\xa4\xe2\x1f\x48\x32\x94\x05\x3f\x4a\xea\xe1\x4a\x29\xcf\xca\x4c\xcb\x7f\x54\x9c\x4f\xe1\xca\x46\xb6\x92\x45\xe4\x5d\x85\x14\xae\xe4\xbd\x86\x15\xd0\x2b\x47\xdb\xa0\xe6\xf2\xa8\x86\x88\x08\xcd\xf0\x14\xcd\xbd\x64\x88\x46\x24\x99\x61\x8d\xd4\x36\x49\x05\x1e\x69\x16\x21\x37\x6d\xb9\xc2\xd0\xe6\x5a\x77\xca\x43\xc1\x92\xec\xfe\x47\x4b\xe8\x82\xd6\x60\x2f\x68\x52\xa0\x95\x00\xb3\x56\xed\xe3\x9d\xd1\x9e\xb2\x9f\x7c\x0a\xad\x3b\x76\x25\x36\x0e\xd9\x4a\xba\xca\xd1\xe4\xd1\x59\x10\xf2\x49\xa6\x03\x88\xf1\xce\x00\x9a\xb0\x0d\x36\xf2\x2d\x7b\xae\x61\x63\x76\x35\xb0\x44\xd3\x60\x11\x06\x3c\x52\xfa\x95\x13\xa3\x52\xc5\x5f\x28\xa7\x5c\x8a\x94\x7e\x70\xf2\x3b\x1b\x8b\x55\x0e\xa1\x16\x72\x56\x4e\xa1\x9d\xe4\xe0\xca\x45\x67\x91\x51\x90\x5c\x47\x95\xd7\x3c\xa4\xaa\xa4\xaa\x5b\xc5\xd2\xd6\x31\x32\x6c\xd4\x81\x63\x09\x3e\x6a\xd7\x63\x4d\xc8\x53\x39\x03\x92\x85\xd2\x72\xee\xa4\x36\x1b\xa4\x09\x50\x3a\xee\x4b\x37\xb8\xca\x19\x53\xa8\xb6\x16\x6f\xdc\xf9\xb8\xac\x9e\xb8\x93\x96\xe9\x77\xaa\x15\x7c\xc6\x84\xae\x01\x15\x27\x25\xcb\x41\x2a\x8b\xdd\x82\x68\xc3\x12\x0e\x86\xee\x69\xb0\xb0\x7a\x84\x9d\xd7\x01\xb9\x2b\xa7\x2b\x2f\x51\x52\x6a\x42\x4b\x51\x7a\x2c\x8b\x8c\x94\x79\xd6\x6f\xa6\x17\x59\xf4\xd0\x38\x75\x30\x9d\xa6\x4c\x14\x88\x4a\xd8\x64\x39\x66\x16\x5d\xc1\x78\xdc\x81\x35\xc4\x61\x33\x53\x11\x08\x3e\xfe\x25\x48\xc4\x6b\x79\x93\x28\x87\xb9\x9b\xcf\x6f\x79\xe8\xd4\xb8\x12\x34\x4a\x6c\xf2\xbe\x88\xf2\x4d\x20\xe6\xdd\x69\x18\xc7\x49\xab\x55\xa8\x71\x1b\x1e\xf4\xdb\xd0\x83\x07\xbb\x6d\xb8\x07\x0f\x76\xf7\xb6\xf2\xec\x0b\xc6\x63\xd8\x86\x96\x19\x74\x5a\x36\x89\x25\x2c\x84\x27\x06\x0b\xfe\x7b\xb0\x0b\x03\x27\xa1\x84\x58\xcd\xfa\x0e\xec\xd8\xdc\x4f\x58\xba\x0c\x85\xd6\x1e\x25\xc1\x37\xcb\x50\xf0\x5f\xb9\x98\x2b\x99\x68\x0d\x74\x68\xeb\x18\x3d\xea\xb8\x12\xd4\xc8\xa9\x85\x0a\xbf\xbb\xe2\xf3\xab\x7e\xae\x56\x5f\x1f\xa8\x49\x01\x5c\x15\x9b\xd7\x68\xec\x99\xc4\x94\x85\x53\x6a\x31\x11\x4b\xa3\x42\x9c\xbc\x0c\xc6\x73\x4b\xd9\x30\xbd\x03\xdc\xd6\x2d\x3e\xad\x90\x57\x26\xab\x51\xdb\x2e\x84\xbf\x44\xca\x76\x6e\x09\xbd\x1f\x89\x96\x4b\xbe\xee\x47\x96\x12\x6a\xca\x71\x81\x8a\xd4\x13\x48\x5e\x46\xc7\x7c\x94\x63\x92\x4c\xd1\x7c\x92\x2f\x6e\x5d\x6e\x15\x4e\x1e\x6c\x0f\x81\xd1\x35\x3c\xe8\xc1\x6e\x06\xba\x02\x16\xa6\x2c\xd7\xbc\x5e\x0f\x26\x71\xd4\x14\xb8\x4d\x0c\x54\x4a\xc4\x2e\xca\x2e\x70\xd1\x4c\x21\x08\x13\x16\x4c\x2e\x61\x1c\x2f\x23\xc1\x26\x25\x5c\xfa\x83\xda\xb9\xca\x3a\x61\xaf\x07\x87\x07\x2f\x0e\x06\x30\xe5\xb3\x65\xc2\x00\x17\x6c\x11\x4b\x71\x05\x88\xab\xb4\xcb\xd4\x35\x56\x9f\x43\x49\xbe\x1e\x21\xbb\xc2\x60\x59\xc6\xca\x70\xa5\xa4\x9b\x1b\xb6\x26\x6c\x1a\xc8\xed\x98\xf3\x79\x1c\x32\xd5\x5d\x79\x34\xbb\xb3\xc6\x10\x54\xd8\x00\xb7\xe1\xa6\xd1\x1d\x88\x0b\x3d\xdf\xe9\xe4\x5a\x26\x6b\xa7\xfa\xb6\x3c\xa8\x69\x96\xa4\xdb\x45\x9d\x57\x15\xc3\x79\x90\xa9\x75\xca\x84\x03\x85\x32\x55\x6a\xf5\x36\x38\x65\xf9\x71\x28\xcb\xb1\xe7\x99\xc5\xb2\x9e\xc1\xa7\x7a\x3c\xcb\x10\x7b\x70\x92\x64\x87\x9a\x83\xd9\xac\x56\xff\x54\x34\x5b\x23\x59\x24\xec\x8c\xc7\xcb\xd4\x10\xb4\xbb\x07\xbd\x1e\xee\x9e\xf0\xc8\x65\x41\x1d\xfe\x5b\xf4\xfa\x2a\xc4\x5f\xdc\xc8\x90\x97\x84\x39\x0c\xa1\xbf\x07\x1c\xbe\xd7\x0d\x20\x1d\xde\x03\xbe\xbd\x5d\x56\x1c\x7f\x73\x34\x6f\x0f\x61\xbb\xa5\x79\xc0\xe1\x3e\xf4\x47\x38\xc3\x87\x4f\x9f\x60\x67\x6f\xcb\x53\x7e\x9d\x29\x27\x7d\xd8\x06\x0e\xf7\xca\x24\xb7\x9d\xa7\x02\x27\x07\x65\x66\x5f\xff\xac\xb6\xaa\x53\x56\xed\x96\x36\x54\xfa\xb7\xd7\x83\x29\x4f\x52\x01\x2c\x64\xa7\x2c\x12\xb8\xbe\x52\x6c\xea\x40\xfa\x91\x2f\x80\x8b\x4d\x44\xee\x70\x7f\xc7\xc7\x7d\xe4\x5f\xa5\x04\x50\x76\xc1\x64\xc2\xd1\x70\x06\xa1\xe9\xe4\xc4\xcf\x82\xfd\x29\xf2\xdb\x6f\x17\x33\xd5\x29\x31\x10\xc7\x1c\xb6\xa1\x3f\xd2\x76\x02\xb6\xa1\x40\x46\x7b\x6f\x73\x06\xe7\x8c\x9f\x07\x92\x86\x4a\x8f\xee\xab\xfe\x7c\x6d\xd3\xf3\x55\x1b\x15\x9c\x6c\x6d\x6d\xdd\x62\xe7\x5f\xa3\x99\x9a\x80\x5c\xb7\x1a\xc2\x4e\x95\x41\xaa\xa7\xd3\x6b\x2d\x4a\xaf\x07\xe3\x20\x1c\x2f\x71\x7f\x59\x4f\x7c\xe2\xa9\xa1\x05\xb8\x60\xa7\xa5\x85\xd7\x9b\x23\x34\x45\xc7\x7f\x22\xa3\xd4\xce\xc3\xae\xb6\x6e\xd7\xc8\x7c\xa5\x06\xc6\x36\x2e\x7f\xb8\x6d\xb1\xf8\x4a\x70\x44\xc7\x9a\xdd\x10\xda\x8b\xa4\x93\xc9\xb8\x62\xc7\x68\xc2\x36\xda\x31\xd2\xe9\xe6\x54\xd3\x6c\x89\xf8\xf7\x92\xca\xf7\x41\xac\x8a\x3d\xd3\x3f\x89\x5f\x0b\x9f\xaa\xc0\x75\x9c\x5d\x2c\xdb\x23\x52\xd0\x72\x6e\x53\x87\x2d\x95\x9b\x44\x25\x0c\x29\xdb\x23\x2a\x67\x08\x95\x90\x2b\x0c\x59\x5b\xc6\x98\xea\x1d\x22\xbb\x89\x05\xd6\xa4\x39\xde\x6c\xbe\x41\xa4\x4b\xa9\x21\xd2\x86\x57\x4a\x4c\x90\x6e\x07\x30\x3b\x4b\xd4\xba\x5b\xde\x51\x52\x4d\x6e\xc9\x96\xe9\xfe\x95\x6d\x8b\x80\x70\x76\x49\x64\xb2\xee\x1c\xb4\x2f\x58\xc6\xcb\xac\x55\x3e\x4e\xda\x34\x38\x1c\x55\x16\x08\x79\x73\xdb\x2d\x2d\x8c\xb4\x76\xdb\xac\x46\x6d\x95\xcd\x83\x89\xb4\x52\x23\xd9\xeb\x41\xba\x3c\x55\x27\x74\x9e\x51\x8a\xa6\x88\x06\x9e\xd0\x1d\xf3\x11\x5a\x45\xf3\x86\x33\xbc\x91\xcf\xfe\x10\x44\x8e\x11\x7a\xb6\x59\x60\x41\xaf\x07\x09\x3b\x8d\xcf\xe4\x31\x26\x8c\x97\x49\x82\xf3\x53\x33\x39\x8d\x65\x32\x91\xc9\x53\x49\xb9\x87\xda\x74\x93\x1d\x3f\xcd\xcc\x5a\xac\x2e\xb4\x0c\xee\x17\xf0\xd7\xd6\xb4\xf7\xb9\x7e\x58\x72\xac\xe3\x5d\xb5\x12\x11\xc2\xa3\x65\xf6\x79\x87\x05\x8a\x28\x9c\x43\x12\x7b\x0a\xa8\xa9\xc5\xba\xc6\x74\xca\x64\xbb\x5a\x48\xea\x5b\xca\x5f\x35\xef\x82\x41\xc7\xa2\xad\x76\xc7\x9b\x8d\xa7\xa0\x65\x79\xb8\x32\x2a\xc9\x3a\xaa\xc8\xa3\x29\xa2\x3c\x25\x2c\x83\xa9\xcc\x54\x56\xb8\x2c\x17\x0f\x2a\xcb\xf2\xe4\x29\x66\xab\xbd\x35\x6a\xfb\xfc\x37\x24\xeb\x8c\xcf\x06\x1d\x0d\x37\x06\x0f\xd1\xb9\x0e\xcf\x7e\x1b\x83\xef\xe4\x23\xd2\xd6\x18\x3c\xc2\x67\xda\x76\x91\xa6\xac\x31\x78\xdc\xf1\xf9\x7a\x70\xbc\x22\xd1\xdf\xc1\x3c\x3c\x31\x6d\x0c\xfa\xbb\xf8\xac\x4e\x65\x1b\x83\xfe\x03\x7c\xc3\x93\xe0\xc6\xa0\x2f\x2b\x58\x12\xd8\xa3\xd5\xa8\xf3\xf8\x73\xfa\x45\xe5\xc1\x6c\x49\x39\x1a\xae\x15\xb4\x8e\x37\x91\x8d\x64\x13\xa7\x22\xb7\x9c\xdd\x19\xf3\xa4\x6d\xe8\x62\xe4\x2f\x5a\xe1\x69\x64\x17\xa8\x45\xc6\x4d\xfc\x8e\x4a\x9c\x8d\x6a\x55\x4a\x19\x85\xba\x73\xf5\xa2\xdb\x7e\x0d\x17\x26\xbb\x9a\x5a\x9e\x4c\x7f\xff\xe6\xc9\xf4\xcd\x93\xe9\xaf\xe2\xc9\x94\x75\x84\xdb\x72\x67\x7a\xc6\x67\x6f\x97\xa7\x27\x2c\xb1\xad\xf3\x09\x9f\x45\x32\xb1\xfb\xbb\xb1\xe4\x4b\xc1\xc3\xd4\x31\xe1\xdd\x9e\x4c\x53\xff\x6b\xb0\xb1\x17\x64\x1c\x47\x53\x5e\x70\x06\xd2\x2b\xb3\x0c\xbe\x27\x97\x42\x08\x67\x96\x58\xca\x56\xa7\x20\xed\x3d\xc8\x0b\x1b\x78\x18\x8f\x76\x2b\xf3\x92\x95\x4e\x7d\xa8\xf8\x72\x73\xe6\x1e\xec\x4f\x09\x92\xa7\x10\xb1\x59\x20\xf8\x19\xeb\x68\x4b\x24\x0f\x8e\xc4\x79\xdc\x4c\x61\x1c\x9f\x2e\xf4\x6c\x55\x96\xc2\x8e\x63\x4a\x4e\xc3\x38\x10\x3c\x9a\xc1\x22\xe6\x91\xe8\xa8\xe3\x50\x54\xfb\x49\x7c\x1e\xe5\xd6\x74\xee\x36\x49\x71\xf9\xf6\x49\x71\xf9\x93\xe1\xf7\x8a\xea\x91\x5d\x29\x62\x6c\x22\x57\xd1\x27\x99\x8c\x27\x7e\x67\x18\xc9\xb4\x95\xf1\xf3\x71\xeb\x75\x0c\xb0\xc4\xaf\xad\xb0\xa9\x17\x87\x9c\x29\x9f\xb5\xc6\xdd\x20\x0e\x3e\x3c\xdb\xff\xe9\xed\xd1\x9b\x67\x2f\xdf\x7d\x78\x87\xd1\x3e\xf7\xdf\xfe\xf4\xe1\xcd\xc1\x8b\x97\x34\xc5\x73\x76\xe2\xa4\x98\xbb\x8b\x60\xf2\x9a\x4d\x45\x4b\xbd\x89\xf8\xf0\x3c\x4e\x9f\x1b\x2e\x52\x9d\x5d\x11\xd3\x74\xa9\xff\xa8\xdd\x81\x47\x0f\xdb\x7b\xf6\x50\x60\x8f\x96\xb2\x39\x2d\x55\x09\x8d\x3a\x5e\xc1\xeb\xc5\x6f\x09\xcf\x9f\x5d\x0a\xef\xa2\x79\x53\x1e\x16\x67\x1d\x0e\x17\x3d\x0c\x11\xf1\xcf\xec\x42\xb7\x3b\x5d\x9e\xa4\x22\x69\xed\x5a\xfc\x0b\x73\x47\xfb\xaa\x38\xad\xad\x60\x1b\x1e\x3d\xc0\x73\x7d\x8b\x45\x79\x76\xbf\xe3\xb3\xb9\xa0\x62\x1d\x08\xe1\xde\x1f\xcc\x4f\x1a\x81\x6f\x95\xad\xf6\xa8\x7e\xbb\xdc\xd5\xcb\x33\x97\xad\x66\x77\xee\x8b\x49\xc0\x6a\x3c\xae\x4a\x94\xb8\xdb\x5d\xa9\x27\xb0\x0d\xeb\x05\xb4\xce\xd2\xe1\xc0\x1c\xcd\xca\x7b\xc4\x7b\x9d\x7f\x53\xd9\x19\xe7\xce\xfa\x52\xc3\x0b\x87\x47\x62\xfa\xf8\x9b\xe0\x3c\x82\xa3\x7b\x46\x25\x62\x7b\x46\xb7\x90\xb4\xd0\xf0\x9d\x05\xd1\xc6\x52\x2b\x5c\x39\x2a\x97\x59\x73\xe7\x66\x3f\xe8\x7a\xa8\x24\x0d\x4f\x00\x9a\xfd\x26\x0c\xa0\xb9\xd3\x5c\xc7\xe8\x9b\x73\x12\x57\xac\x58\xea\x9f\x58\x77\x0a\x38\x31\x3e\x45\xcf\x1f\xe5\x1e\x7f\x72\x09\xbb\xbf\x9d\x42\x10\x69\xaf\xbc\x09\x5e\x13\xe0\x91\x60\x33\x96\x54\x0c\x25\xef\x58\xe0\x48\xa0\x6c\xfc\xde\x54\x22\x88\xb7\x5c\x22\xc4\x26\x0b\x1e\xcd\x06\xaa\xa7\xa9\x51\xf7\x26\xc1\x4f\x59\x9a\xcb\xda\x6d\x77\x17\xf1\x79\xab\xbf\xfb\xb8\xdd\x76\x59\xfa\x7c\xce\xc6\x1f\xd1\x8b\xc4\xe6\xa9\x35\x2d\xca\x31\x22\xe5\xb3\x88\x4d\xf6\xd3\xb7\x59\x76\x8e\x13\x7a\xe2\xc2\x53\x98\xb3\x0b\x6a\xa1\xcb\x0c\xad\xb4\x72\xd1\x27\xeb\x16\x76\x95\x1d\x88\x71\xc9\x72\xce\x71\x1a\x8e\x97\x7f\x0d\xbb\xf2\xb5\xaf\xe5\x97\x8f\x43\xda\xdc\xec\x74\xa0\xdf\xee\x40\xff\x91\x35\x1f\xd9\x35\xc6\x48\xe6\xb6\x61\x38\x1c\xa2\xca\x7a\xb5\x30\x41\xbb\x7f\x3f\x08\x25\x51\xe8\xd2\x63\x06\x4e\xec\xde\x6a\xaa\x59\x54\x22\xb5\x91\x90\x9f\x04\x3a\xea\x61\xce\x2e\x6c\x9e\xe9\x46\xac\xaa\xea\x35\x93\x43\xa4\xe0\x84\xcf\x40\xcd\xd3\x73\xfa\x66\x48\x70\xf8\xe7\x38\xc5\xa2\xb9\x50\x72\x1c\x2a\x6a\xac\xbd\xcc\x16\x7a\xb5\x43\x63\xa7\x41\x7b\xc4\x78\x2c\x69\xb4\x48\x34\x33\x41\x9a\xda\xd5\x36\x2c\x97\xb7\x5b\x78\x6a\x66\xda\xc5\x49\xb6\x3e\xbf\xcd\xc9\x99\x64\xab\x89\xb3\x04\xec\x91\xaf\x12\xe9\x29\x8f\x96\xf9\x5e\xd0\x9c\xde\xf0\xa7\x29\x71\x6b\xe4\x7d\x32\x61\xab\xad\x1a\x04\x5d\x43\x83\x96\xd5\x2a\x74\x54\xa9\x43\x3e\xed\x61\x1b\xa9\x0f\x55\x5f\x54\x9c\xa3\xdb\xd0\x9c\x3f\x86\x65\x64\xf2\xcb\x58\x56\xb0\xdd\x6b\x59\x26\x39\x66\x4d\x89\x5d\x16\x51\x75\x45\x16\x15\x8c\xb9\xc3\x22\x6a\xb7\x5d\x00\xad\xb9\x82\xe9\x4e\xf8\x19\x9f\xb0\xc9\xb3\xcb\x0a\x1b\x7e\x1d\x6d\x5a\xc3\x9b\xa3\xdb\x66\xce\xb2\x94\x3b\x47\x1b\xb3\xe7\xe8\x26\xfc\x29\x9e\xc2\x2a\xd4\x86\x45\x65\x33\xae\xec\xc2\x74\x6d\xbe\xe8\x91\xcd\x95\x45\x29\x8f\x68\xb2\x57\x64\x51\x61\x7e\xe6\xe3\x90\xa7\x7b\xc9\xf1\x4a\xcf\xbc\xae\xf9\xd3\x6f\xc2\x13\x35\x34\x53\x64\x8c\xcd\xb8\x59\xba\x72\xa4\x96\x15\xd6\x8c\x0e\x3f\x21\x64\xd3\x8c\x71\x38\x83\xa8\x58\xdb\x14\xa6\x1e\xb8\xa1\x2d\xcf\x6a\xe5\x93\x2b\x1b\x0d\x83\x38\x0d\x06\x0f\xe7\x0b\x8b\x50\x59\x47\x07\xec\xcd\x72\x2c\x44\x57\xac\x71\x7b\xbf\xe4\xa6\xee\xbd\x76\x8f\x86\x08\x84\x4f\xf9\xff\x91\x4d\x4c\x19\xaa\x33\x95\x3f\xee\xeb\x93\x40\x92\x6a\x73\xe7\x02\xa7\xcc\x45\xe1\x76\x53\xdc\xfb\x6b\xed\x74\x60\x17\xee\x49\x74\xed\x5b\x95\x8c\xbd\x0a\xbf\x35\x01\x6d\xca\xfe\xd2\xad\x00\xa7\x03\x38\x4b\xc4\x9c\x1d\x90\x80\x5d\x3a\x04\xcb\x73\xee\xd1\xc3\x36\x8d\xe1\x22\x26\x78\xbc\x31\xb1\x5b\x2e\x81\x1c\x22\x35\x77\x7c\xf4\xb0\x43\xf5\x6f\x26\x02\xd3\xf0\x32\x19\x78\x96\xe5\xb7\xca\xfd\x20\x1d\x73\x5e\xc5\x7f\xcf\x72\xfe\x33\x72\x5e\xef\xea\xc8\xdd\x81\x7a\xfc\xdf\x4c\x00\x74\x80\x5a\x21\x01\x3a\x7b\xae\x10\x41\xa9\x6d\x2f\x61\xb9\x41\x58\xe4\x36\x55\x76\xdd\xd9\x94\xc3\xbb\xc6\xce\x45\x03\xb6\x81\xd6\x38\x92\xed\xea\x99\xc4\x75\x1f\x1e\xee\x74\x28\xdb\xd1\xdd\xc2\x81\xdb\x95\x76\xfd\xb0\xd6\x9a\x83\xdc\x7b\x27\x0f\x23\xb7\x46\x1d\x28\x99\x52\x80\xb3\x3b\xf8\xa0\x2c\xa3\x50\x4a\x31\xd3\x81\x57\x49\x05\x48\x1c\x39\x1d\x38\x4c\x28\x40\xe1\x0c\xc4\x81\xc2\x04\x1b\xca\x4c\xcb\x06\xf9\x84\x22\xd4\x51\x01\xec\xc8\x0b\x67\x57\x9a\xa5\x78\xf0\x15\x01\x8f\xfc\x90\x76\x63\xb3\x14\x0f\x9c\xcd\x6d\x2b\xa9\x08\xe9\x13\x4f\x31\xa7\x58\xce\x15\x90\x9d\x56\x84\x25\xad\x1f\xf8\x12\xa5\x46\xca\x63\x5d\xf7\x70\xa8\x31\xe8\x3f\xee\x64\x89\xf2\xff\xc6\x60\x57\x7a\x30\xc8\x8e\xd1\x18\xf4\xfb\x9d\x86\x7d\x34\xd5\x18\xb8\xaf\xab\x51\xa7\xbf\xf3\x39\x5d\x17\xb4\x4d\xb2\x20\x71\xe9\x54\x1d\x83\x88\x47\xa2\x2c\x04\x11\x9d\x5e\xf1\x48\xa8\xe8\x2c\xf8\xf0\xd0\x3c\x51\xc8\x16\x7c\xd4\xe1\x5b\xf0\x39\x17\xbc\x85\x47\x42\x85\x6e\xe1\x91\x78\xf4\xd0\x80\xe9\x18\x2e\x2a\xae\x59\x09\xae\x47\x0f\x73\xd8\x7a\x05\x4e\xe4\x97\x86\x9b\x38\x65\x6c\x18\x8d\x4b\x82\xe7\xdd\x10\xf6\x23\xb1\xa1\xe7\x85\x53\xa2\xc2\xe1\x42\x56\x57\x51\xf2\x5a\xee\x15\x3c\x12\x7a\xaa\xf8\xe4\x5a\x21\x5d\x14\x55\xeb\xdd\x20\xfa\xfd\x6f\x7e\x10\xdf\xfc\x20\xfe\x2a\x7e\x10\x72\x40\xb8\x2d\x17\x88\x1a\x9e\x0d\x79\x4b\x2f\x27\x8a\x10\x4b\x7f\xf4\x2c\x70\x47\xd7\x33\x41\x3d\x9f\x33\xf2\xa8\xe7\xd1\xac\xa3\xdc\xc3\xd1\xa5\x40\xbb\x93\x9a\x89\x65\xcf\xeb\x1a\x91\xd9\x1a\xda\x36\x73\x6f\x27\x4a\xab\xab\xa7\x8f\xea\xef\xa7\x4f\xd0\x6c\x5a\x66\x36\xd6\x17\x17\xd4\xc3\x9e\x75\xd3\x90\x47\x54\x7b\xed\x80\x1f\x33\xa6\x3d\x6b\x33\xe7\xf6\x66\xaa\xef\x81\x4a\x53\x92\x9b\x79\x5b\xb3\x7b\xe5\x4a\xeb\xce\xa1\xf5\x26\x53\x86\xd4\xc6\x05\x2d\x4d\x62\xdb\x71\x67\x97\xdc\xb1\x4c\x73\xa1\x12\x87\x73\x39\x0b\x2d\xb9\x62\x95\x68\xb5\xf5\x04\x5a\x1e\x3e\xd6\xe6\xc5\x38\x61\xa8\x4b\xba\x37\x18\x81\x4a\xae\xc8\x3e\x0e\x13\x3e\x9d\x32\xe9\x8e\xac\x98\x9f\xe3\xcd\xb9\xb9\x15\x62\x2f\x46\x34\x4b\x48\x48\x44\x1b\x8f\xbc\x4b\x10\x9b\x21\x2b\x39\x18\xfa\xe8\x08\x16\xca\xbe\x18\x2a\xca\x59\x99\xd1\xe4\xf0\xd0\x55\xbb\xd2\x33\xbd\x4c\x1f\x8d\xa6\x6e\xc2\xd1\xf8\xf4\x84\x47\xc5\xf8\x36\x22\x9e\x31\x3c\x30\xc2\xb6\xb3\x59\x57\xf5\xa9\x60\xb1\x60\x91\xec\x4a\x41\x04\xe8\x3f\x76\x99\xe3\x2e\x61\xab\x58\xe7\x59\x1c\x3a\x9f\xf3\x31\x3a\x69\x69\xaa\xd6\x17\xa6\xe3\xd3\x78\x4a\xb0\x72\x14\xaa\x64\x2d\xe1\x2c\x5f\x0b\xd6\x60\xab\xd9\xb5\x90\x6f\x75\x98\x8b\x1f\xe7\x25\xf6\xea\x33\x1c\xd5\xa0\x79\x90\x75\x5c\xdc\x6b\xea\x92\x0b\x14\x17\x30\x0f\xd2\x0e\x8e\xde\x5a\xcf\x70\xaf\xd0\x77\x68\x67\xb3\xdb\x38\x82\xfa\x8f\x02\x2b\x39\x63\xca\x3a\xbc\xc9\xb1\xc5\xb6\x66\x77\x86\x43\xe9\x62\x39\xe5\x11\x9b\x6c\xc4\x84\x2c\x64\x11\xa1\x12\xb1\x77\xdf\x4c\xe5\x3e\x4d\x9f\x95\xaf\xfb\xa5\xed\xf4\x6c\xcf\xd4\xe8\x65\x0e\xf6\xaa\x46\xdf\x91\xad\x36\xfc\x69\xb5\xe1\x09\x34\xf1\x80\xdd\xf8\x38\x54\x79\x63\x59\x2c\xf3\xfa\x64\x6d\xc0\x37\xb4\xfc\x6a\x67\xc2\x4c\x18\xf5\x21\xa6\xcd\xb6\x6c\xf3\xc2\xcf\x33\x62\xba\x39\x12\x0e\x6c\x7b\x1f\x9c\xda\x4a\x26\x35\xbc\x92\x8b\x59\x65\x5e\x16\xe2\x45\xd1\x02\xff\x74\x66\x5e\xb1\x64\x6d\x9e\xe3\x3e\x8b\x85\x24\xae\xd6\xe6\x8c\xb3\xda\x58\xca\x39\x82\x29\x67\x9d\x4e\x08\x68\xa0\xaf\xc3\x43\x76\xba\x10\x97\xf6\x6e\x60\x19\x2b\xad\xda\xab\xd4\xd1\xa3\x8d\x19\xf7\xd0\xed\xa3\xb9\x11\x6f\x74\xb0\x29\x0f\x3b\x54\x96\x97\x13\x95\x0d\x21\x94\xeb\xda\x90\xa9\x4e\xab\x0d\xdb\x9e\x39\xc1\x75\xda\x91\xdd\xc3\xf3\xdd\xfa\x53\x94\xbd\xe6\xa9\x33\xda\xcb\x2b\x7c\xc7\x4e\x6b\x46\x9e\x78\x50\x95\xad\xcf\x30\x3b\xb2\xcb\xc2\x3c\xe9\x03\xfa\xe5\x02\x9d\xc6\xa3\x19\x75\xc3\xd4\xec\x40\x8a\x58\x58\x57\x5c\x55\x41\x3d\x2b\xc2\xcb\xbb\xd9\x21\x87\x2a\x69\x6e\x0e\x12\xa8\x7b\x9b\xc8\x19\xd4\x4c\x4f\x94\xa9\x65\x5d\xd1\x92\x8f\x84\xb3\x6e\xa9\x9a\xa7\xac\x7a\x18\xda\x14\x67\xb0\x76\x33\xb6\x89\x38\x5b\xac\x74\x49\x8b\xb6\x55\xf3\x75\x5a\x73\x1f\xba\xe6\x9c\xbf\x2c\xd7\xeb\x69\x51\xb3\x33\x96\x5c\x8a\xb9\x0c\x41\x62\xe1\xb1\xb9\x53\x8c\x37\xa5\x1d\xd1\x0a\xfc\xa1\xd2\x34\xa5\xf0\x1e\x4c\x10\x25\x9d\xdb\xad\xc2\xee\x06\xa6\x86\x66\x53\xef\xb9\x57\xec\x4d\x48\x02\xf6\xb6\x72\x5b\x79\x66\xd7\x0e\xb7\xe2\x76\xbf\xf0\x56\x1c\xee\xa8\xae\xd9\x8b\xc3\x13\xec\xb2\xcd\x38\x3a\xdd\x96\x7f\xd5\x0e\x99\x7c\x7a\x98\x3d\xd2\xc6\x99\x7c\xd6\xbb\x68\x94\xe1\x6c\xc9\x61\x9a\xda\x93\xc3\x27\xbd\x29\x87\xcf\x7a\x57\x0e\x9f\xad\x6d\xb9\x02\xc6\x1a\x1b\x73\x85\x23\xf7\x4d\x76\xe6\xb0\xf0\x26\x5b\x73\x0a\x3e\xbf\x37\x87\xa9\x1b\x6e\xce\xb9\x45\x2a\x76\xe7\x10\xb0\xb2\xba\x6b\x5d\x7f\x42\x46\x97\xec\xcf\xd5\x8b\x8f\x4f\x64\xd5\xd8\x9f\x7b\xf0\x39\x7b\x43\x1e\xcc\x73\xe8\xb7\x89\x7a\xa8\xe2\x9b\x28\x88\x2e\x91\x57\x11\x95\xbe\xa1\x92\xe4\x0b\x55\xa8\x89\xae\xb7\xba\xfc\xb5\xb6\x72\xd5\x2c\xaa\x6c\x13\x77\x4d\x7d\x34\xe8\x15\xaa\xbd\xde\x25\x39\xd3\xca\x1a\x7a\x77\xfb\x1f\x1d\xa9\xbc\xb6\xe3\xb1\xc3\x56\x64\x5b\x9e\xd6\x0f\x69\x3b\x66\x89\xc0\x70\x06\x64\x9e\x8b\x16\xcf\x61\xa4\x3a\x44\x5a\xa3\xd4\x0a\xa8\xeb\xa6\x57\xab\x35\x15\xc9\x65\xac\x39\x92\x9e\x30\xdc\xed\xc6\xb5\x3e\xc7\x6d\x52\x9e\xba\x74\x4f\x28\x58\x2d\xcc\x38\xee\x00\x93\x27\x8b\x3d\x57\x54\x0a\xea\xec\x3e\xd0\x2a\x20\xe7\xf6\xa2\x16\xd8\x99\xa3\x2d\x32\x8b\x90\x17\xea\xf5\xad\xe0\xb3\xa9\x24\x56\x58\xbf\x8b\xa8\x98\xb4\x0d\xe1\x9d\x18\xc7\x67\x2c\x49\xce\x13\x2e\x04\x8b\xe4\xfe\x32\x62\x04\x3c\xbb\x46\x14\xb5\x79\x77\x8e\x83\x35\x4f\xed\xfb\xfb\xf1\xd4\x59\x84\xf2\x88\x58\x48\x3a\x62\xb3\x30\x9b\xda\x58\xfb\x8e\xeb\x98\x59\xdc\x8e\xcc\x55\xa7\xb7\x0a\x4a\xf9\x96\xaf\xb6\x94\x83\xbd\x1e\xec\x4f\x25\x05\xc0\x53\x19\xec\x90\xb6\xb1\xb0\xbd\x11\x08\xdc\x5d\xc4\x05\x5e\xc8\x3f\x32\x08\x20\xe5\xd1\x2c\x64\x26\xfc\x96\x84\xec\xda\xa6\x43\x6d\x12\xa9\xe8\x32\x87\x78\xc5\x9f\x6a\xc3\x98\x3f\xcd\xe3\xfe\xa8\x39\x6a\x9b\xc9\xe0\x9a\xdb\xff\x44\x9e\xcb\x77\x7c\xb3\x23\x1a\x3a\xd0\x44\x83\x71\x7d\x52\xac\x90\x2e\x0a\xfd\x0e\xdc\x97\x6e\xd8\x3b\xd2\xab\xb8\x9f\xcd\xbd\x57\x16\x41\x85\x89\xac\x8e\x26\xd1\xd1\x11\x1d\xf2\x04\xea\x2a\x75\x1c\x87\x7b\x1a\x30\xab\xa0\xd7\x83\x00\xef\xa7\x06\x29\x1f\xc3\x39\xae\xd6\x04\x72\x46\x5e\x14\x78\xb0\x4b\xee\x7a\x78\x45\xd3\x94\x58\x75\x30\x7c\xda\x46\xbd\x9b\xcc\x1a\x4d\xd6\xcd\x4e\x24\xb0\x99\xfc\xaa\x8c\xfc\xa2\xd4\xf1\xa8\x01\xc3\x1f\x64\x27\xcd\xd2\x1e\xaa\xc4\xca\xbd\xb4\xa7\x1a\xdb\xa6\x66\x60\xce\x4a\x29\xab\xe8\xf2\x76\xad\xa5\x8a\x9b\x85\xfc\x72\x82\xc1\x14\x34\xcf\xf1\x5d\xb9\x73\xc7\x2e\x72\xf7\x2e\xd8\xef\xc7\xd6\xb3\x5e\x75\x62\x70\x10\x3d\xf4\x9a\xaf\x62\xf4\x66\xd7\x92\x0d\xf5\xe1\x2a\xd1\x68\x29\xb8\xb2\x21\x81\xb9\xa2\xb1\xa2\xad\xdd\x50\x32\x39\xba\x2a\x04\x63\xd5\xf9\x47\xcb\xe5\x36\x05\x43\x95\x14\xac\xa9\x25\x09\xb9\x40\x6a\x48\x3f\x84\x5d\xc5\x74\xb5\x52\x6a\x90\xa3\x42\x9f\x44\xd1\x7f\x68\x00\x77\x47\xc7\x0f\x08\x54\xa7\xc9\xb7\xbe\x81\xe8\x8f\x72\x09\xd9\xbb\x2d\xcb\x34\xe3\xea\x35\xc6\x08\xbb\x2d\x64\x27\xab\x84\x58\xa8\xec\x96\x04\x89\x7b\x2b\x56\x96\x46\xe2\x31\xca\xd7\x14\xac\xb4\xda\x6d\xcf\xd6\x68\xbf\x42\xe4\x34\x52\xd0\xb8\xec\x95\x33\xbe\x34\x7c\xa2\xa6\x77\x93\x67\x09\x9c\x5e\x4d\x96\x49\xcc\x80\x33\xc0\x46\x4e\xdc\x59\xe4\xb5\x5a\x72\x36\x19\xb2\x98\xce\x2f\x95\x6f\x86\xbd\x54\xb0\x59\xa4\xa2\x30\x48\x05\x1c\x8f\xd0\x97\x40\xe1\xbd\x96\xd8\xef\xf8\xe5\x6e\x64\x20\x27\x5b\xc5\xf8\x4a\x88\x45\xfb\x59\xee\x28\xb7\xe7\x4c\x07\xea\x28\x89\xe3\x5c\xe8\x15\xbf\xb1\x76\x68\xe9\xf2\x07\x4d\x70\x72\x09\x13\x36\x0d\x96\xa1\xdc\x09\x4d\x97\xe3\x79\x7e\x74\x6e\x50\x9c\x9a\x0e\x7d\xe7\xb1\x51\x36\x40\x5e\x73\x9a\x9c\x0d\x8b\x1b\x4e\x8a\xad\x6a\x1d\x31\x6b\xf6\x53\x75\xa5\x5e\xf5\x94\x6f\x76\x8a\xa9\x9f\x64\xcd\xcf\xf7\x16\xa9\xfb\xa4\xd4\xc7\x0d\xd4\xfd\x0e\x34\xb0\x3b\xe0\x9f\x51\x63\x94\xe9\x3d\x41\x58\x69\xb2\x50\xb4\x0c\xf3\x97\x1f\x2c\xb9\xd6\xe2\x5d\x3e\xcc\x9c\x8f\xe8\x35\xfd\x42\xab\x74\x45\xc7\x90\x8c\xd1\x71\xf3\x94\xfc\x53\x34\x1c\xb2\x9d\x4e\xa3\xf1\x65\x77\xd4\x28\xe8\x34\x19\xaf\xd6\xbf\x8f\xe5\xc0\x74\xef\xdf\xa3\xf6\xfa\x19\x03\x6d\xe8\x9a\xc0\x0f\xe5\xe7\x10\xb9\x2f\x5a\x68\xe8\xf5\xfc\xd3\x19\xfa\x1a\xaa\x2e\x59\xca\x33\xa2\xc9\xe3\xfe\x61\x73\xad\x34\x34\xb5\x7b\x4a\x43\x51\x51\x75\x3c\xd4\xbc\x95\xb0\xe6\xdc\x1a\xb1\xfe\x71\x5c\xc1\x6d\xbf\xe2\x3d\x0d\x51\xfc\xf1\xc7\x48\x2d\x86\x98\xc5\xc8\x62\x59\x8a\xb6\x6c\x85\x42\xb4\x5f\x3d\x84\xe3\xd1\x9e\x93\x49\x77\xc1\x17\xcb\x74\xde\x9a\x76\x4b\x2e\x6d\xeb\x88\xa3\x45\xcc\xd8\x96\x62\xf8\xf5\xb3\x3c\x07\xf2\x35\x59\x21\x3d\x5b\x67\x1d\xab\x49\x6d\x32\xcb\xfa\xc7\x1c\x19\xe4\xb8\x5d\x2f\xbe\x2f\x09\xd0\x9a\xf5\xdd\x5c\x7e\x92\xf4\xc2\x24\x84\x18\xff\xf9\xe5\xe7\x0b\xce\x5a\x16\x6f\x70\x9d\x20\x50\x33\x65\xac\xfa\x0a\x79\x6c\x2c\x0d\x67\xc8\xf4\xed\x27\x39\x5d\x52\xd3\xd2\xae\x17\x1e\x54\x16\x05\x0c\x93\xe0\x75\x3e\x50\x50\x3e\xdb\xa2\xa1\x4d\x86\x9e\x8d\xea\x83\xf5\xa8\x08\x52\xe7\xee\x94\x36\x6a\x13\x56\xd3\x44\x4d\x58\xc1\x44\x39\xa1\x35\xd7\x98\xaa\x3f\xc4\x56\xc9\x51\xd5\x3e\xc5\x54\xf7\xb0\xe8\xb6\x8f\x24\x4f\xcf\x7b\x88\x5d\xf7\x60\x57\xba\x42\xb4\x75\x70\x67\x62\x5e\x0e\xb1\xe9\x44\x95\x38\xed\xea\x0b\x88\x7d\x3d\x4a\x16\x78\x2f\x70\x6b\x69\x08\x76\xe9\x6d\x3c\x72\xc5\x82\x32\x11\xb7\xbc\x70\x0f\x38\x98\xe2\x96\xa4\xee\x24\x36\xbd\x05\xcc\x75\xfa\xaa\xbf\x8c\x2f\x8c\x77\x66\x39\xb2\x74\x2b\x8e\x71\x7b\x0f\x2a\xb9\x47\x5f\x91\x79\x5b\x86\xdf\x0e\xf1\x51\x1a\x51\x3a\xff\xf9\x9b\x42\x25\xb6\xa1\xa9\x69\x69\xe0\x5e\x35\x6d\x68\x95\xb6\x87\xd5\x30\xb5\x0c\x95\x1b\x7b\xd6\x12\xfb\x36\xf0\xdb\xb5\x5a\x5f\xc5\x18\x02\x50\x67\x3c\x71\xb4\x5f\x3b\xa7\xd6\xfc\xf9\xaa\x94\xff\x9b\xee\x7f\x69\xdd\xf7\xab\x3e\x8d\x29\x38\x70\x5d\x5b\xf5\x69\x95\x78\xdd\x41\xa5\xa0\xac\x79\xfc\xf5\x46\x16\x97\x8a\x4d\xf0\x93\xfc\xbc\xfa\x16\x16\xf4\x6b\x0f\xec\xf2\xeb\xbb\xf0\x82\x4e\x02\xed\x23\x5c\x79\x3a\xd8\x5a\x47\x7e\x0b\xfa\xb0\xed\x52\x87\x96\x5d\xde\x21\xdd\x69\xef\xf9\x44\x2f\x7b\x62\xfe\x84\xae\x45\x2e\x6f\xb9\x6e\x5d\x08\x6d\xef\xf0\x3a\xe7\x01\xe6\x58\xb1\xad\x0d\xdb\x66\xcb\x9c\x2a\xb8\x07\xbb\x5a\x99\x89\x74\xef\xe1\x62\x8e\xf4\xb5\x87\xbe\xe5\xc7\xbd\xe6\x7a\x1c\x7a\xdd\x7c\xe9\xaf\xf0\x1f\xad\xbf\x01\xb7\xac\xb8\x02\x47\x41\x49\x60\x69\x2e\xc1\x2d\xb3\x5b\x70\x4b\xeb\x1a\xdc\xd2\xbe\xbb\x46\x19\x8e\xd7\xcd\xd2\xdc\x84\x5b\x5a\x57\xe1\x96\xd6\x5d\xb8\x25\x6d\x3c\x96\x61\xac\xe1\x75\x53\x08\x95\xb2\xe6\x04\x3a\xbf\x54\xdd\xc4\xa7\xe2\xc8\x7b\x21\xee\xc8\xb9\xa5\x56\xcb\x9f\xc2\x2d\x52\xe1\x4d\x71\x54\xbc\x13\x97\x2b\x7b\x2d\x4f\x8a\xe5\x8d\x6f\xc5\x11\x61\x35\xdc\x1f\xbe\xf4\x27\xfe\x8f\x6a\x78\xa1\x2d\xab\xdc\xd0\x74\x94\x15\x15\xdf\x85\x94\xd3\xf2\x44\xa3\x64\x3b\xc3\xe8\xb0\xcf\x19\x6d\x99\x79\xa3\x2d\x6d\x77\xb4\xa5\xed\x8f\xb6\xcc\x39\xa4\x79\xf0\xd6\xe9\x1c\x9f\xd5\x27\xed\xc8\xef\x94\x76\xe4\xba\x89\xd5\xeb\x20\x39\xd7\xb2\x8a\x1e\xe2\xf1\x4b\xcb\x97\xbe\x5e\x1f\xa9\x70\x4d\xab\xdb\x49\xea\x3b\xa7\xfd\xad\x4e\x2f\x69\x2e\x53\x26\x7d\xc9\xc7\xa2\x89\xca\xde\x83\x19\x6e\x9f\x9e\xc1\x24\x66\xd2\x83\x41\x5e\x14\xc4\x78\x7b\x18\xce\x16\xfe\xe7\xcd\xeb\x9f\x85\x58\xbc\x63\xff\xbb\x64\xa9\xd8\x92\x6b\x92\xcb\x05\x8b\xa7\xb9\x1c\x15\x82\xcd\xdc\xe6\x68\x6a\xbe\x50\xc5\xdd\x3c\x34\x5c\xad\xf6\xb6\x68\xb6\xb7\x06\xd2\x4d\x90\x6b\xe6\xdf\xd3\x39\x8e\x3e\x7c\x16\xc5\x09\x1b\x84\x78\xc7\x67\xa5\xbc\x58\x91\x0f\x8f\xbf\x5d\xa2\xfd\x76\x89\xf6\x3f\xfb\x12\x2d\xdd\xa0\x25\xbf\x36\xe7\x0a\xad\x9a\x81\xc1\xf5\x6e\xd2\xd2\xd8\x77\x84\x1e\xe0\x66\x3b\x53\x9a\x10\x58\x52\x9a\x0b\x85\xde\x7e\xba\xb3\x99\x12\xe3\x30\x48\x53\x38\x96\x25\x46\xe4\x7c\xa7\x32\x32\x13\xac\x30\x6d\xf5\xe4\xa9\x91\xec\xa3\xb4\xb5\x8a\xf6\x03\x2a\xa2\x5a\xd7\x0f\x78\x2e\x21\x11\xd3\xd1\xdb\xfd\xc3\xf7\x30\x04\xf5\x19\x93\xe6\x39\xe3\x4d\x15\xee\xa2\xf9\xd1\x7a\x7e\x63\x3d\xff\x64\x3d\xa7\xff\x17\x9c\xc4\xfa\x65\xca\xa3\x88\x5d\x9a\x37\x76\x2a\x62\x76\xaa\x5f\x17\x7c\x6c\xbd\x45\x41\x64\xbd\x9d\xf2\x71\xe2\xbc\x86\x21\xcf\x5e\x11\x36\xcb\x1a\x27\xd6\x4b\x18\x1a\x4a\x82\x38\xd0\x8f\xb3\x24\x88\x26\xfa\xe5\x8d\x95\xf1\x93\xf5\x7c\x68\x3d\xff\x62\x3d\xbf\xb4\x9e\xff\x9f\xf5\xfc\x2f\xeb\xf9\xad\xf5\xfc\xc2\x7a\xfe\xa7\xf5\x7c\x84\xcf\x5b\x23\xdf\x10\xa6\x6c\x3a\x32\xff\x97\xa7\x2f\x50\x76\x03\x78\xb0\xdb\x31\x89\xef\xf7\x7f\x7a\xfb\xf4\xf0\xe8\xdd\xcb\x0f\xaf\x5f\xbe\xfd\xe9\xf0\xe7\x01\x3c\xcc\x32\xa5\xb8\x06\xd9\x63\x96\x53\xa2\x12\x03\xb8\x82\x5c\x82\x51\x90\xae\xcc\xf8\xf0\xe2\xe0\xd7\xb7\xb0\xca\x30\xfd\x72\xf0\xfa\xf5\xfe\xdb\x9f\x3e\x1c\xee\xbf\x79\x79\x70\x74\x38\x80\xfe\xce\xce\x4e\x8f\x28\xa4\x73\xed\x67\x61\x3c\xfe\x38\x80\x26\x5a\xc4\x54\x34\x9d\xbc\xa7\x63\xf9\xb1\xe2\x41\x76\x81\x51\xce\x59\x70\x08\x5a\x1b\xdd\xe4\xdb\xc7\x2e\xbe\x7d\xec\xe2\x2f\xf3\xb1\x8b\x74\x1e\x3c\xb8\xad\x18\x0f\xcf\x93\xcb\x85\x88\xff\xf1\xde\x36\xfa\x63\x99\x76\x3f\xfb\xc4\x05\x56\xe8\x05\xe8\x61\x4e\xd3\xfb\xf9\xa6\xc2\x09\xbd\x52\x38\xe3\x8f\x83\xd3\x5e\x4a\x82\xbb\x77\x49\x1d\xe9\xeb\xf8\x78\x79\x5d\x4e\x7e\xe7\xec\xc2\x4c\x7b\x75\x21\xfb\xbc\x1d\x7e\x80\x5d\x2c\x9d\x0f\x6f\xbc\xdb\x56\x93\xe7\x1d\xb7\xb8\x39\xe2\x86\xa1\x5b\x64\xb7\xed\xbf\x57\xa7\x40\x35\x8f\x90\xb8\xee\xcf\xec\xa2\x2b\x4f\xbb\x28\x34\xaf\xb3\x9b\x46\x4b\x16\xe4\x8a\x6e\x76\x56\xbb\xda\xc6\x50\x9b\x6a\x03\xd8\xfd\xee\x11\xdd\xa1\xcb\x2e\x20\xd3\xe2\x45\x5a\x3c\xc3\xe3\xc6\xe0\xbb\xbf\x77\x1a\x2e\xcb\x1b\x83\xc7\x3b\xab\x51\x67\x77\xe7\x9b\xd9\xfb\x66\xf6\xfe\x2a\x66\x4f\xce\x7d\x6f\xc3\xee\xfd\x11\x33\x72\x5d\xd0\x37\x21\xdf\xe8\x73\x42\x79\x5b\xdb\xed\x91\xb9\xd7\x00\x4b\x31\x7d\x6c\x03\xe0\xbb\x99\x9a\x2f\x23\x2e\xde\x04\x0b\x33\x5b\x6c\x46\x31\x4e\x28\x07\x28\x10\xc0\x8f\x0f\xd0\x34\x13\xe7\xe2\x94\x88\xff\x9a\x7d\x9d\xf1\xd1\xcd\x69\xe2\x1c\x4e\xe7\xfd\x77\x45\xde\x49\x70\x72\x12\xcc\x58\x73\xe0\xc9\xd3\x53\xfa\x41\x3e\xe3\xd4\x83\xd0\xca\x7e\x53\x9d\x1d\xc6\x67\x2c\x0c\xc6\xba\xce\x7c\x36\xad\x1c\x06\xbe\xbc\x99\x1f\xb3\x05\xf1\xd3\x5a\x88\x74\x1e\x44\x51\x1c\x35\x07\xa5\x10\xb4\x5a\x19\x54\x64\xaf\xa9\x42\x2e\x93\x06\x1e\x08\x0b\x48\xaf\x82\x0c\x77\xfd\x10\xeb\xd0\xd0\x2a\x6c\xe0\x05\x72\x90\xa9\x55\xd6\x60\x3d\x94\xbf\x71\x2e\xa0\xa5\x9d\x1e\x28\x0b\xf0\xa3\x0d\xe9\x03\xb4\x60\xd5\x4a\x6e\x00\xf5\xa0\x4f\x6b\x60\xb6\x91\xd7\x03\xb7\x4a\x88\xda\x25\xb0\x90\xdf\x4f\x2a\x8b\xa9\xb0\x08\x26\xe4\x05\x8a\x6f\xec\x62\xc1\xc6\x82\x4d\x32\x47\x7b\xdb\x5f\x8a\xc2\xa0\xf8\x9c\x9e\x32\x14\x27\x0c\x16\xc1\x64\xc2\x26\x36\x98\x76\x9f\x1a\xcf\x83\x24\x18\xe3\x76\xbf\xde\x9d\xc7\x73\x66\x5d\xda\x1a\x2c\xbc\x75\xf0\x59\xd4\xb1\xbc\x9a\x61\xc7\x75\xae\xd2\x70\x32\xae\x2c\xe8\xb8\xb2\xb9\x18\xbd\xd4\x06\x67\x4a\xa9\x40\x3a\x92\xba\xb4\x23\xbf\x8e\x91\xdb\x35\xc6\x93\x57\xe9\xce\xd7\x92\x30\xf2\xd3\xb2\x58\x46\x4f\x1c\xb7\xa1\xdf\xee\xfe\x1e\xf3\x48\x7e\x72\x01\x9e\x48\x1c\x30\xc0\x8f\x3b\x60\xdc\x8b\x94\x2e\x92\xde\xae\x2c\xe4\x67\x77\xfe\xec\xc2\x90\x8d\xd8\x50\x1a\x44\xe1\x36\xb4\x6e\x22\x97\xf6\x5a\x79\x60\x30\x1e\x1c\x0f\x95\x07\xa1\xfc\x9e\x5d\x31\x52\x74\x4e\x2e\x2a\xe6\xb2\x97\x63\x8a\x6a\x1e\x61\xb8\xe9\xf5\x51\xa5\x73\xd5\xe0\x6c\x09\x2b\xcf\x3c\x06\x71\xf4\x56\xb5\x59\xec\x6b\xcd\xd5\x57\x9b\x7b\x3d\x78\xc5\xa3\x89\xfc\x2c\xa6\x09\x9a\x45\x47\xee\xa9\xc0\x69\x43\x83\x3e\x1e\x61\xdc\x56\xf0\xcb\x4d\x43\xa4\x8d\x98\x97\x39\x3a\x63\x9a\x5a\x0d\xe1\xb2\xa2\x7c\x41\x84\x68\xc8\x6f\x66\xa5\x83\xd5\x42\x8b\xdc\x61\xd0\xdf\x74\xb8\x6b\x83\xeb\x2f\x1a\xdb\x6e\x19\x59\x55\x2d\x8e\xf5\xc8\x20\xe3\xd9\x6a\x0a\xa9\x51\x45\x86\x43\xd8\xc9\x2e\x36\xe2\xef\x49\xc2\x82\x8f\x19\x28\xb6\x72\x7b\x08\x74\x49\x1c\x25\xf8\x7c\x1e\x24\xf8\xb5\x64\x89\xc1\xbb\xd2\x42\x61\x6b\x4f\x9a\x54\x24\xf5\x34\x44\x09\x6d\x23\x15\x79\x8a\x45\x3e\x9f\x8e\xc8\xea\xfe\x3a\x4a\x72\x1d\xc9\xa7\x22\xa9\x25\xec\xa2\x70\xa1\xb5\x48\xd8\x94\x5f\xc8\xef\xc8\xc2\xce\x45\x1b\xa5\x80\x8a\x44\x32\xca\x09\x5f\x7f\xb3\xad\x5c\xfa\x3e\x53\xad\xd6\x99\x41\x88\x16\x73\xa2\x61\x0a\xaa\xe1\x21\x2e\xd6\x1f\xa4\xca\x87\xaa\x27\x32\x6c\xad\x40\x8d\x27\xce\x23\x0b\xf1\x2b\x73\xd3\xc7\xda\xe3\x1a\x33\x33\x5d\xc0\x9a\x32\xed\x98\xc6\x49\xde\xfb\x2d\x15\x89\xd6\x90\xfc\x77\xed\x2d\x89\x22\xd4\x98\x64\xf3\x54\xb4\xf8\xf5\x7a\x3a\x22\x8c\xe8\x13\xdf\x4e\xf8\xb5\x0c\x04\xe9\xdd\x1e\x42\x44\x34\xc1\xf7\xb0\x8b\x11\xde\x76\xd0\xb9\x18\xc7\x83\xc8\xa7\x13\x14\x08\x7e\xce\x2e\x6e\x53\x35\xec\xfe\xeb\xd1\x8d\x35\xa6\xe1\xb3\x29\x47\xc1\x66\x58\xda\xf1\x79\x34\xe0\x8b\x89\x35\x17\x79\x74\xba\x0c\x43\xc3\x86\x1e\x3b\xc3\x38\xa3\xe8\x43\x20\xd9\x04\xbf\xa7\x71\x04\xc1\x09\xcf\x89\xd2\xc4\x3f\x3c\x8c\x5f\x2d\xc3\x30\x7f\x1b\x52\xdf\x03\xc0\xd2\xf7\x55\x69\x2d\xb2\x4c\x62\xaa\xe2\x42\xbd\x46\x4c\x9e\x3a\x2c\x81\x41\x0b\x91\x6b\xb6\xa3\x85\xc6\xf7\x2e\x52\xde\xe5\xd1\x84\x5d\x1c\x4c\x5b\xcd\x56\xb3\x0d\x77\x86\x43\x0c\x1d\x60\xc9\x87\x08\x31\xf0\x0e\x17\x51\x2c\xe8\xd3\x40\xd5\x49\x20\xa9\x45\x6e\x78\xb2\x16\x6f\x5f\x11\x1e\xde\x45\xf8\x3d\xdc\x9b\x94\x53\x64\x12\x60\xbe\x16\xd8\x86\x66\x0b\x3b\xa4\xc1\xbe\x0d\xcd\x76\xb3\x56\xdf\x9b\xf0\x74\x11\x06\x97\x92\x3f\xa8\xd6\xe3\x38\x12\x38\xb7\x35\xdc\xc8\x89\x87\x5d\xc8\xec\x17\xaa\x58\xd5\x5d\x55\xc4\xa6\x91\x28\x21\xf8\xbb\x97\x43\x01\xce\x7b\xdc\x42\xf2\x86\x1d\x7d\x35\xf1\xb2\x45\x1e\x71\x6d\xb8\xff\x83\xfe\x94\xe2\xa5\x11\x6b\x91\xb6\x52\x9f\x16\xc7\xdd\xb2\x20\x59\x87\xcd\x04\xa6\x84\x0d\x4f\xf2\xb7\x53\x43\xf2\x0d\x1d\xd8\xb1\x43\x7a\xbd\xac\xa1\x18\x6c\x24\x8c\x03\xbc\xb4\xa2\x77\x7b\x73\x2d\xc4\x82\x76\x03\x0e\x33\x25\xf1\x53\x8f\x27\xcb\x87\x07\x2f\x0e\x06\x70\x1a\x7c\x94\xfb\xbf\x3c\x3a\x5b\x86\xb8\x97\x7b\x12\xb2\xdb\x6c\x20\xe5\x6d\x43\x3f\x7f\x0f\xb7\x0f\xf7\x81\xee\xb1\xc9\xc5\x42\xbb\x9b\xb0\x05\x6e\x03\xb5\x9a\xd0\xec\x40\xb3\x89\x2c\x69\x34\x1c\x25\x7c\x1e\x47\x67\x0c\x8f\x25\xcc\x87\x33\xe5\xd2\x60\xc2\xc6\xfc\x34\x08\xf3\x46\x96\xeb\xf5\x4a\xde\x42\xc4\x2f\x54\x81\x0d\x3f\x82\x69\x54\x2e\xb3\x03\x1a\xd3\xda\xcf\x38\x8a\xd8\xa0\xa4\x7c\xeb\x23\x32\x75\xda\x58\x1c\x44\x72\xad\x42\x9b\x78\x5b\xed\xb2\x70\x95\xb7\x0c\x01\x23\xbd\x15\x5a\x6c\x5f\x36\x65\xa1\xd5\xed\x90\xa0\x73\x23\x8a\xcd\x25\x02\x08\x59\x9a\x1e\xce\x83\xa8\xb5\x23\xa3\xc1\xde\x57\x57\x91\xc8\xf1\x9e\x14\xab\x8f\xea\x41\xae\xe4\xda\x67\xde\xe2\xe2\xd3\xa5\x0c\xb7\x4c\xac\xc4\x43\x02\x15\x47\x87\x22\x8b\x46\xe5\x6c\xed\x12\x5f\x9f\xe2\x67\x55\xb1\x88\xd2\x21\x3e\xbd\x4c\x29\x1e\x7a\x0a\x27\x6c\x1a\x27\xac\x9b\x93\x80\xfc\x50\x76\x0d\xde\x7f\xa2\x31\x68\x8d\x6a\xfd\xcc\x2e\xf2\xcc\xd7\xac\xef\xdd\x23\x2f\xaf\xd3\xe0\x42\x7d\x7c\xf2\x82\x8b\xcb\x01\x3c\x46\x01\x9a\x51\x87\xa7\x14\x9b\x58\x16\xcd\x26\x72\x54\xa5\x25\xe4\xd6\x36\x42\xec\x39\x45\x6d\x71\x56\x17\xce\x95\xc5\x41\x24\x96\x9f\xa7\x97\x6b\xc5\xa6\x62\x5a\xd3\x8b\x02\xe7\xe3\xad\x7f\xbc\x3f\x78\xdb\x35\x5c\x56\xf5\x11\x3e\xf4\xcc\xc7\x28\xb0\xe8\x6d\x9d\xfb\x26\x27\x86\x80\x4c\x53\xb4\x5d\x18\xaa\x69\x39\x9b\xdb\x34\x59\x0d\x21\x5d\x93\x58\x89\x7d\x3a\xf3\x2c\x08\x33\xab\x86\x5a\xd6\xf6\xcd\x79\x4b\x5b\xac\x01\xe8\x5a\x86\x8b\x6e\x0d\xb6\xb3\x20\x2c\x20\x80\xd6\x1d\x9e\xbe\xe2\x11\x17\x2c\xc7\xf4\x1c\x19\x72\x86\x68\x11\xb1\xda\xda\xaa\x24\xd4\xea\x17\xef\x68\x50\x51\xfd\x00\xd7\x4b\x91\xb4\xfe\xf0\x2b\xcb\x4f\xa4\x66\x4c\xc8\xef\x0e\x1f\x4c\x8f\x22\xee\x84\x74\x25\x5d\x55\x65\xf1\x20\x49\x3d\x98\x0e\x07\x22\xee\x98\xdd\x29\x56\xfe\x19\x58\x43\x84\xc1\x81\xe1\xf0\x7f\x65\x1c\x83\xe1\xc3\x8f\x28\xd7\xf3\x14\x58\x92\xc4\x89\x8e\x2c\x23\xa1\xf0\x4b\xbb\xb1\x80\x71\x9c\x24\x6c\x2c\x06\xe7\xa6\xd3\xb8\x24\x3b\xbd\x07\x0b\xea\xee\x83\xcf\xf8\x15\x6d\xfc\xf3\x44\xfe\xe9\x8a\xf8\x75\x7c\xce\x92\xe7\x41\xca\x5a\xd2\xb2\xe0\x56\x6f\x66\xc2\x10\xe6\x9f\x74\x8a\x4c\x47\x33\xc7\xf8\x97\xee\xfc\xa2\x32\x59\x20\x76\xec\x6e\x5d\x67\x16\x53\x0c\x77\xce\x5e\x62\x9b\x5a\x4d\x79\x94\x8b\x05\x95\x87\xe9\xbf\x9b\x02\xd8\x05\x4f\x45\xda\x81\x45\xc8\x82\x54\x5e\xab\x95\xe7\xa0\x71\x64\x18\x35\x8d\xc3\x30\x3e\xc7\x3d\x08\x2c\x99\xa2\x69\xcc\xf5\x20\xa2\xb0\x03\xd1\x32\x0c\x3b\xd9\x65\x0d\x27\xb8\x89\xfb\xe1\x33\x43\xbd\x8c\x63\xe5\xa8\xcc\x61\xf0\x91\xc9\xfe\x27\x21\x91\x8a\x73\xc6\xf1\xc0\x38\xb3\xaf\x4a\xfa\x68\x65\x65\xac\x09\x74\x4f\x52\x6c\x25\x95\xfa\x25\x4e\x53\x7e\x82\xc7\x72\x48\x31\x86\xa7\x22\x47\xbc\xf7\xfb\x38\xfb\x4f\x84\x7a\xc4\xf9\xba\xe6\xd5\xcb\xe9\x94\xcf\x2e\xe9\xe5\x40\x07\xc7\xbf\x0f\x78\xae\x45\xa9\x74\x1c\x25\x9f\xe9\xd8\x4a\xc1\x9c\x66\x30\xea\xf0\x48\x3e\x82\x3e\x67\x52\x40\xb3\x0c\x08\x0f\x70\x34\x10\x9d\x06\x65\x19\x0a\xfa\xfe\x7d\x82\x05\xa0\x73\x1a\xf9\x2c\xfd\xdf\x9c\x0c\x0f\xb8\x3c\x67\x91\xcf\xea\x6c\xc6\xce\x50\xe0\xc8\x2f\x99\x66\x95\xc3\x7f\xf7\xef\x53\xa3\x0d\x80\xfb\xeb\x80\xcb\xc3\x12\x62\x40\x10\x07\xd4\x4a\xf3\x24\xd4\x93\xdd\xc3\xd1\x52\xa8\x7e\x6f\xba\x36\x8d\x50\xba\x87\xd3\xf8\x3e\x0e\x22\x9c\xf9\x6b\x1d\xe8\xe8\x74\xda\x0b\x8b\x13\x08\xe0\xe7\x97\xff\x83\xba\x11\xe8\xd9\xd8\xed\x18\x0c\x5d\x56\x8f\x99\xf0\x2b\xc6\x8e\x53\x63\x79\x60\x1d\xc6\xd2\x97\x4a\xac\x90\xfe\xd8\x63\x82\x14\xce\x59\x18\xda\x21\x50\x74\x1b\xb6\xc8\x64\x10\x0f\x2c\x5b\xd1\xd2\x8d\xb4\x4d\x06\x82\x2a\x8a\xb4\x19\xb0\x67\x3c\xaa\x84\xfd\x61\x57\xd7\x12\xb5\x24\x2a\x3d\xa8\x51\xcb\x78\x5a\x40\x00\x4f\x9c\x4a\x06\xf6\x9b\x35\x6d\x5a\xdb\x41\x03\xc5\x60\x4f\x1f\x3d\x67\xfc\x5b\xa7\xc4\x4e\xf9\x9f\xd2\x87\x45\x5c\xd5\x83\xad\x09\xff\x67\xeb\xcb\xd8\xa3\xbe\x54\x6f\x16\xf1\xad\xf5\x65\xc1\x4f\x59\x5a\xd6\x8f\x0b\x4b\x9b\x9b\x75\x66\x44\xc7\xa7\x2d\xc2\x76\xf7\xae\x5e\xeb\x18\xa8\x76\x36\xb1\xec\x36\xdb\x3f\xe0\xa6\x91\xa9\xba\x08\x9b\x2e\x42\x2e\x24\xe4\xf1\xce\xc8\x36\x39\x9e\x85\x12\x19\x8f\x88\xf6\x23\x83\xc8\xda\x41\x93\x03\xbb\x5c\x2b\xa1\xfd\xd7\x3e\x2e\x05\x05\x34\x2d\xaf\xa5\x86\x99\xe2\xe9\xe3\x46\xd4\xb6\x4c\xfb\x5c\x6c\x5a\x6f\x2c\x04\x19\x80\x11\xba\x49\x2a\x8a\x7e\xcd\x92\xe9\x3b\x9c\x3a\x62\x3e\xf5\x02\xbd\x48\xc5\x98\x70\x3b\xd9\xe4\xce\x23\xde\x6c\x76\x4e\x34\xaa\xf4\xbd\xad\xe2\xda\x43\x53\x72\xf7\x2e\xd0\xb3\x6f\x9d\x80\x55\xe6\x73\xed\x45\x89\x6e\x49\xe9\x1c\x8e\x0a\x9b\x6d\x94\x9d\x8b\x66\xa7\xd9\xb4\xcf\x68\x56\x5b\x50\x49\x5d\xbe\x7a\x9c\x04\xeb\xea\x37\xad\x1d\xcb\x16\xaa\xff\xb2\xb5\xe3\xff\x5b\xeb\x50\x98\x7e\xd4\xdf\x69\x97\x4e\x86\x23\xfd\xd5\x5c\x4f\x5f\x31\x1d\x45\x81\xe9\x8f\x98\x98\xe5\x2b\xf9\x93\x4a\x58\x71\x1e\x37\x53\x18\x9b\x0f\xda\x14\xfa\x96\xfb\xbd\x9b\x3a\x1d\xac\xa4\xdb\x58\x1b\x0b\x2e\x4e\xab\xcb\x68\xed\xd4\xdc\x46\x0b\x77\xa2\x31\x94\x98\x49\x79\xff\x5e\xdf\x45\xc7\xae\x62\x0a\xd8\xdb\x38\x6b\xe5\xd7\x98\xde\xf0\xa7\x21\xf5\xac\xbb\x08\x97\x69\x46\x02\xbd\xf7\x1d\x0d\xa0\xfa\x0d\x90\x23\xdf\xe7\xf8\x09\xa9\x54\xaf\x36\xd5\x3c\x93\x6c\x93\x8c\x67\x9a\xf0\xb1\x08\x2f\x21\x88\xac\x8f\x22\xdb\x12\x53\x7a\x3d\x36\xdf\x82\xf5\x0c\x9c\x54\xd0\xaa\x00\x0d\x60\x90\xc1\x13\x81\xd9\x97\xa5\x48\x72\x39\xdc\x8e\xe0\x08\xa9\xe6\x33\xa1\xe8\xfd\x16\xc4\x01\x86\x8a\x0b\xee\x4f\x47\x57\x0f\x77\x56\xff\xd5\xe3\x5d\xbc\xb0\x91\xc1\x7f\xfa\x04\xbd\xdf\x76\x2e\x2a\x81\x36\xe0\x50\x05\x63\xfe\x18\x96\xd4\x60\x06\x6a\xe5\x9d\xde\x6f\xad\x20\x0e\xda\x4f\xd6\x72\x03\x21\x77\x2e\xd6\x00\x6a\xd4\xb4\x47\x65\x3e\x3c\xa6\x3e\x2d\x26\xdb\xa1\x82\x32\x93\x77\x27\x76\x34\xe9\x3f\x6d\xf3\x87\x7e\xdc\x88\x8a\xb9\x48\x1f\x39\xb2\x9f\xde\x7f\x45\x04\x15\x09\x77\xe9\x2e\x83\xcc\x51\xbe\x8f\x34\x37\x53\x19\x46\x3a\x3d\xc5\xff\xc7\xc1\x22\xc5\x71\x18\x9f\xf5\x7b\x47\x2b\x14\x86\xbd\xcc\x13\x8e\x69\x0e\xdd\x4e\x05\x07\x66\xae\xa6\x98\xc4\x82\xf1\x1c\xc6\x41\x5a\x40\xc3\x53\xd5\xf7\x96\xa7\x24\x52\x43\x32\x21\xdf\x40\x09\x61\x4c\xa8\x4e\xd9\xa4\x54\x1f\x73\xd5\xdd\xb2\x5e\xe6\xb0\x57\xe9\x67\xaf\x07\xcf\x3d\xbc\xd1\x95\x0f\x35\x19\xc5\xd1\x6d\x6f\x1d\x20\x4d\x01\xb2\x09\x2b\x41\xfc\x1c\xa4\x18\x9c\x07\x3d\x92\x35\x35\xee\x16\x98\x5e\xab\xfa\xe2\xeb\x3c\xc4\x73\xe6\xed\x6d\xc8\xa9\x12\x32\x29\x12\x73\x08\x19\x5e\xc2\xd6\x5e\x6c\x78\xaa\xbb\x58\xb0\x04\x9b\xa5\xe5\x85\x50\x13\x3e\xe3\xf2\xe6\x02\x66\x9c\x06\x0b\x94\x5b\xdf\xa0\xc3\x2e\xdb\x32\x5e\x27\x16\xd1\x32\x0a\x5e\xff\x51\x1b\x7e\x80\xbf\xc1\xdd\xbb\xba\x3d\xc7\x7c\xd4\x15\xf1\xd1\x62\x61\xb6\xf0\xf0\x34\x2a\xcb\x94\x3d\xbb\x1a\xe1\xf7\x43\x0f\x46\x7b\x53\x30\x87\xd1\x6e\xbd\xbf\x1b\x67\x43\xce\xca\xff\x09\x0c\xa3\xce\x6f\x68\x05\xaf\xd5\xb6\x44\x67\x45\x7c\x9b\x3a\x4b\x05\xb4\xca\x8a\x78\x03\x95\xb5\xf6\xf9\x75\x7d\x85\x4b\xee\x54\x4f\xb3\x49\xaa\x64\x00\xc1\xab\x71\x65\xda\x5d\xad\xb5\x16\xd0\xb8\x40\xbe\x9c\x05\x56\x28\x32\xe1\xa0\x33\x49\xaf\x52\x4b\xfb\x38\xcf\x1c\x36\x51\x4d\xff\x8e\x9b\x9d\x52\x93\x23\x73\x7e\x6a\x74\xdc\x14\xe6\xd3\x35\x0a\xf7\x03\xfc\xcd\xae\x0c\x0a\x0d\xd8\x1e\x96\xaa\xb7\xa5\x60\x79\x9b\xbb\x16\x53\xa5\x72\xe6\x4a\x3a\x23\xff\x61\x36\xd7\x75\x2c\xae\x88\xf1\xb0\x87\x4f\x60\x77\x47\x45\x75\xba\x4f\xc7\xba\x8a\x7a\x79\x63\x06\x76\x2e\xf0\x4b\x06\x53\x7e\x51\xd0\xe9\xf5\xba\xec\x53\x5b\xfa\xf2\xbe\x70\x0c\xbc\xd6\xe4\x9a\x1a\x9c\x9b\x50\x19\x10\x5b\x2c\x54\x2d\x65\xe9\xa1\xc8\xa0\xe8\xfd\x46\xe3\xed\xda\xc1\x96\x10\x49\x9d\x84\x6d\x2f\xc2\x1c\x08\x39\x4c\xb7\xe4\xb9\xa2\xc1\xa9\x4f\xd6\x1f\xb4\x3b\xf0\x70\xc7\x7f\xba\xa3\xa3\x40\xeb\x7d\x94\x34\x9b\x69\xfb\xc2\x41\xdb\xf2\xb0\x56\xba\xb6\x44\x7c\x47\x96\x66\xd0\xd3\x8c\xb7\xca\x3a\xac\x57\x64\x68\x66\x50\x71\x4d\x5b\x94\x8a\x20\x1a\x63\xd4\x8c\xac\xf0\xa7\x4f\x86\x6d\x2d\x82\xc3\xab\x85\xf2\xc9\x89\xe6\xe3\x4d\x95\x9e\x33\x72\xdd\xd8\x34\x28\x9b\x75\x19\xa5\x37\x25\xd6\x71\xe9\xbd\xf6\x2c\xd8\x94\x45\x9e\x2f\x55\x79\xf9\x43\xe6\x95\x28\x93\xad\x51\xb4\x35\x6f\x8f\x3d\x8a\x96\xda\xbc\xd1\x14\xaf\xe7\xce\x2b\x82\xbc\x06\x7f\x74\xd1\x6b\x71\x48\x97\x68\xd6\x6c\x11\xd2\x24\xd6\x37\x07\xc1\xc6\xe2\x1a\x8d\x39\x20\xda\xd6\x35\x85\x28\xc2\x29\x46\x84\xfb\xed\xf8\x9d\x8a\x56\xb1\x87\x48\x87\x7b\xb9\x57\xe2\x69\x3b\x9d\xb3\xd7\x6c\xf9\x89\x22\x76\x7d\xdb\xa9\x55\xd7\x68\x3c\x25\x5d\x4b\x90\x44\x5e\x5d\x39\xca\xa8\x8b\xeb\xdb\xf2\x54\x7f\x96\x63\xc3\x96\x14\xbf\x4b\x52\xd3\xa4\xc9\x82\x95\x6d\xc8\xaf\x5d\xd4\x48\x8a\xbe\x7d\x84\xae\xd0\x88\x7f\xa4\x71\xe4\x19\x2c\xd7\xb5\x01\x8b\x39\x4d\xb0\xdc\x4a\x45\x72\x49\x4f\x56\x6b\xee\xdc\x91\xc7\xca\x72\x0e\x63\x39\x21\xaf\x60\x8c\x11\x9c\xa0\xc5\xda\xc5\x32\xf6\x22\x76\x93\x56\x07\xd4\xee\x60\x99\xc4\x49\x30\x9e\xe3\x67\xd1\x4e\x30\xc4\x04\xcc\x59\x80\x97\x90\x4f\xc2\x38\x3e\xed\x16\x78\xf1\x0c\x93\x3d\xcc\x40\x1f\x53\x13\x8d\x5e\x96\xc5\x1b\xd3\x82\x25\xeb\xb8\x24\x11\x3a\x6c\x92\xa5\xf3\x5b\x09\xce\x06\xc1\x77\xfd\xdd\x6c\x87\x40\x81\xaf\x61\x8d\xb3\xbe\xf7\xe0\xb2\x51\xe5\x96\xf6\x72\x5d\x5f\x84\xf2\x54\x68\xad\xcb\xb7\x8a\x74\x5c\x43\x38\x61\x8c\xb7\xa3\x16\x7c\x5c\x94\xc3\x61\xbc\xe0\xe3\x75\x72\x10\x06\xa8\x42\x00\x12\x93\x23\x00\x59\xac\x52\x00\x8f\x1e\x66\xfc\x57\xd0\xd7\xe6\xbf\x44\x65\x63\xf2\xb1\xbf\x00\x74\x2d\xee\x97\xc4\x85\xa1\x59\xdf\x40\xdf\xf9\xeb\xe8\x54\x79\x5f\x6b\x60\x9e\x54\xba\x9c\x1b\x0e\x94\xb7\x98\x4e\x21\xe7\x9f\x41\xf6\xa8\x72\x2c\xc7\xa0\x81\xfd\xa2\xcb\xa1\x33\xd6\x80\xfe\xea\x34\xe9\x6a\x34\xd0\x0f\x19\x1e\x84\x19\x98\xa7\x2c\x9d\xe0\xcd\x23\xe1\x29\xfa\x4b\x0f\x7c\x89\x0a\xba\xe8\x86\x3b\xf0\xa4\x39\xb0\xda\xe3\x75\x90\x4f\xd0\xed\xf8\x95\xc9\x56\xfc\xca\x78\x46\xab\x4c\xa3\x07\x0d\x67\x26\x8c\x03\xfb\x45\xe7\xba\x3b\xe7\x83\x42\x8a\x86\xa3\x05\xc5\x20\x7b\x54\x39\xd6\x04\x79\x60\xbf\xe8\x5c\x67\x39\x32\xc8\x27\x68\x28\x2b\x3f\x97\x93\x5b\xc1\x0f\x8a\x49\x9a\xc2\x02\x64\x21\x49\xe3\xd4\xf3\xb1\x81\xf5\xac\xf3\x90\xbc\x68\xa6\x09\x8d\x66\x3a\x5d\x0d\xab\x03\xf3\xa4\xd3\xa9\xbf\x0f\xb2\x47\x9d\x23\x07\xca\x81\x7e\xd0\xa9\x38\x66\x0d\xe8\xaf\x4e\x93\x16\x7a\xa0\x1f\x74\xaa\x34\x1b\x03\xfd\xd0\x91\x1d\x6c\xd5\xb9\x6a\x98\x5b\xf7\x8d\x41\xff\xef\x9d\xca\xf0\x43\x9d\x06\xde\xba\x69\x0c\x1e\x7f\x87\x11\x39\xfa\x75\x22\x72\xb8\x5d\x78\xa8\x8c\x40\x83\x02\x51\x34\xf0\xb2\x63\x77\x77\xa7\xdb\x6f\x6c\xad\x74\x00\xbe\xdd\xdd\x6f\x91\x3e\xbe\x45\xfa\xf8\xab\x44\xfa\x20\x2c\x9e\x08\x7c\xff\x60\xd3\x69\xc2\x2e\xe1\x57\x1e\x8e\x3f\x32\xf8\xfe\x77\x36\x9d\xe6\xc3\x7d\xd4\x8c\xd3\x27\xc1\x78\x10\xc1\x01\x4e\xba\x03\x09\xc5\x83\xa8\x08\xf6\x2a\x38\x41\xb0\x7f\xc6\x33\x16\xa6\x82\x85\x21\x4b\xe0\xfb\x69\x70\xe2\x05\xfe\x29\x38\x83\x5f\xe3\x78\x02\xdf\xcf\x4a\xc3\x90\x3c\x54\x61\x48\x70\xe6\x48\x11\x38\xdf\x04\x51\x30\x73\x63\x83\x74\x7b\xc8\x85\x1e\xf6\x75\x96\x8a\x53\x05\xa0\x63\x80\xec\x9f\x04\x91\x07\x98\x9f\x04\x91\x06\x41\xb7\xa1\x22\x84\x5a\x0d\xa4\x3d\xdc\x2a\x22\xc0\x17\xcf\x2a\xe0\x26\x27\x1a\xec\xfd\x7c\x5e\x01\x97\xce\xe7\x1a\xf0\x2d\x13\x30\xfc\xff\xec\x7d\x7f\x73\x1b\x37\xb2\xe0\xff\xfc\x14\x10\xef\x9d\x49\xae\xe9\xa1\x7e\xf8\x47\x42\xae\xe2\x92\x65\x67\xa3\xbb\xc4\x72\xd9\xce\x6e\x6e\x75\x3a\xd5\x88\x04\xa9\x49\xa8\x19\xd6\xcc\xc8\xb2\x62\xf3\xbb\x5f\x35\xd0\x0d\x34\x30\x98\x19\xca\x56\xde\x7b\xbb\x91\x98\x8a\x49\xa0\xd1\x00\x1a\x8d\x46\xa3\xd1\x68\xd4\x02\xa6\xb2\x24\xc0\x37\x32\x2f\xd4\x2d\xb8\x7a\xb4\x2b\x04\xa1\x22\xef\xae\xe3\xfc\xb2\x01\xbe\x80\x7c\x03\x2c\xcb\x52\xbd\x95\x55\x85\x2f\x30\x8b\x40\x49\xaa\x38\x90\x24\x2e\x60\x53\x45\x80\x2a\xcc\x8b\x0b\xc6\x1e\xf8\xaf\x8f\xe1\xa2\xb2\x4d\xb4\x2c\xbc\x73\x23\xd3\x99\x0b\xa5\x28\xa5\x33\x08\xec\x85\xda\x34\x55\xa1\xce\x21\x9d\x80\xde\xe4\xd9\x4a\xe6\xe5\x4d\x00\xdb\x0a\xb3\x08\x14\xe2\xcb\xbe\xc9\x33\xf0\x37\x0c\xb1\x1b\x4c\xd4\x15\x66\x53\x91\xa3\xd5\xb4\xa1\x44\xb2\x9a\xfa\x05\x8c\x7e\xc2\xc1\xf9\x9a\x09\x80\x9d\x4e\x87\x16\x34\xf1\x0f\x79\xbe\x07\xb7\x72\x35\x1a\xd2\x8a\x75\x74\xe3\xdc\x9f\x21\xe0\x7d\xe1\x4e\x1b\x5b\x92\x85\x45\xc6\x47\x6f\x59\xcb\x09\x8a\x01\xe9\x49\x02\x18\xe3\x2c\xee\x43\x12\x47\x31\x3b\xc7\xcc\x97\x2f\x2a\x79\x85\x9a\x0e\x50\xf2\xdd\xc5\x45\x25\x37\x95\x25\xe6\xbe\x96\x65\x25\x97\xb8\x1a\x41\x68\x1e\x54\xe0\xce\x7f\xff\x1d\x41\x14\xdf\x57\xf2\x89\x8b\x09\x08\x7f\x92\x99\x5f\xc1\x20\x13\x9b\x6d\x02\xfc\x17\xaf\x92\xb1\x59\x0c\xf1\x5f\x95\xb9\x66\x05\x89\x5a\x76\x87\x01\x1f\xce\x3e\x63\xe7\xd7\xd0\xc0\x30\x7e\x19\xf3\x1f\x95\x2a\xce\xcc\x1c\xd0\x5f\x2a\x1d\x44\x80\xbe\xad\x1f\xd9\x39\x91\xc5\x98\x7d\xef\x0f\x30\x94\xda\x04\x34\xa6\xd1\x08\x22\x71\x64\x85\x14\xb6\x0f\x99\x5e\x89\x55\xcc\xa6\x0e\xb0\x5b\xa0\x7f\x6d\x7d\xab\xed\x17\x28\x8d\x84\x13\x43\x5b\x17\xb2\xa4\x6c\x67\x53\xba\x09\x8f\xf3\xc2\xb7\x66\xed\x40\x5b\xe0\x6e\x9b\x6b\x4b\xfc\x4d\xca\xd5\x51\xf1\xee\x26\x9d\x26\xe9\xa2\xb1\x29\xaa\xac\x07\xbf\x19\x07\x06\x1a\xc2\xe5\x82\xf9\x3e\xf1\xa1\xe8\x06\x93\x12\x99\xfa\x57\x00\x86\x6e\x3b\x13\x94\xfa\x1d\x80\xc3\x2b\xf3\x04\x06\x3f\x2b\x50\x66\xd7\x69\x00\x4d\x4a\x10\xd6\xc1\x49\x09\x15\x48\xb3\x8b\x36\xa0\x26\x25\x88\xd5\x87\x66\x69\x01\xdc\x9c\x96\x84\xbd\x89\xa6\xda\xbf\x95\x20\xff\x21\x93\x0a\x0c\xee\x68\x0d\x14\xfe\xae\xc0\x99\xdd\xa3\x81\x34\x29\x01\x58\x6f\x77\xc8\xca\x78\x39\x95\xb2\x65\x56\x57\xb6\xcc\xda\xca\x26\xc5\xd1\x8b\x83\xd7\xac\x32\xf8\x59\x81\x42\x4b\x89\x01\xc3\xdf\x21\x38\x0a\x7a\x63\x00\x55\x02\xac\x62\x1e\x2c\x2e\xfd\x34\xd7\x4c\x6c\x1c\x8c\x6f\x49\x93\x0d\xcd\x3a\x78\x11\x11\x0a\x55\x20\xeb\xce\x50\x67\x09\xdc\x5e\x12\xc9\x34\x5e\xc1\x81\x32\x9e\xef\x29\x95\xd2\x6b\x0b\x8c\xe0\xd1\xe1\xc1\x1b\x67\xee\x43\x39\x6a\x05\x2c\xd6\xa0\x33\xe2\xf4\x05\xad\x52\xe7\x3b\xf7\x74\x01\x22\xc2\x6a\x68\x66\x43\x49\x2b\x7f\x9d\x0a\xbc\x2e\x9e\x18\xd9\x0d\x12\x82\x34\x15\x26\xd2\xe1\x3f\x38\x5a\x1a\x8b\x1e\xae\x45\x51\x9a\xcd\x64\x6f\xe8\x40\x2c\x94\x77\xc8\x58\xf4\x40\xe9\x38\x9b\x2e\x13\x99\x96\x7f\xd7\xe0\x3d\x03\xb8\x1e\x0c\xbf\xa4\x36\x59\x5e\x67\xf9\x6f\x75\x15\xa6\xb2\x3c\x43\x50\x0f\xc4\x7d\xa6\x61\xec\xcf\xf2\xaf\x6c\x96\xbc\x94\x57\x14\xcc\xba\xd2\x28\x88\xb7\xac\xd8\x7d\x9a\x2d\x89\x0c\xff\x99\x8d\xbb\xbe\x48\x8a\x95\xcc\xeb\x9a\x57\x5c\x5c\x7c\x35\xcd\xd4\xd7\xd3\xe0\x42\x92\x14\x87\x59\x9a\xea\xb8\x67\x96\xf5\xfa\x03\x87\xf1\xfa\xc1\xb5\xf2\xc1\x83\xe0\x1a\xca\x51\xf6\x07\x83\x60\xad\x3a\xba\x04\x29\xe4\xb5\x0c\x0f\x44\x54\x40\xa4\xce\x04\x8d\xb6\xd0\x23\x0a\xf5\xca\xb7\x07\xda\xde\x44\x29\xea\xff\xdd\xf1\xee\x36\x24\x11\xed\x61\x3f\xd2\x1d\xef\xee\x40\x9a\xdd\x0b\x74\xc7\xbb\x8f\x4d\x8a\xd6\x9b\xba\xe3\xdd\x6f\x86\xdd\x80\x66\xdf\x1d\xef\xed\x9a\x0c\x98\xde\xdd\xf1\xde\xde\xb0\x5b\xd5\xe8\xbb\xe3\x3d\x8b\x94\x36\x59\xb3\xf3\xee\x78\xef\x59\x25\x39\xce\xe2\xee\x78\xef\x9b\x4a\x7a\x2a\xcb\xee\x78\xef\xdb\x61\xb7\x66\x6f\xd7\x1d\x3f\xde\xae\x64\x16\x17\x17\xdd\xf1\xe3\x9d\x6a\x3a\x28\xc2\xdd\xf1\x63\xdb\x7c\x94\x42\x37\xdd\xf1\xe3\x27\x26\xd1\xdd\x35\x77\xc7\x8f\x9f\x9a\x2c\x52\x59\xba\xe3\xc7\xcf\x9a\x0d\x7b\xeb\xd3\xe1\xee\xde\xbd\xd9\xed\xde\xec\xf6\x67\x31\xbb\x41\x70\x24\x08\x0c\xf2\x75\x41\x76\x99\x75\xab\x62\x07\xe1\x92\x8e\xac\x05\xf4\x32\xcf\x2b\xa8\xda\x81\xd6\x61\x4a\x08\x8e\x1c\xb7\x72\xcf\xa0\x63\xd3\xeb\x8d\x34\x8e\x3c\x25\xb0\xef\xd5\xc9\xae\x87\x4c\xa5\x11\xc4\x35\x08\x56\xe9\xa2\x32\xa2\x08\x33\x4d\xe8\xdd\x83\xe5\xd2\xe9\x8a\xab\x15\xb9\xfb\xaa\xa1\x0a\xa2\x33\x24\xe5\xad\x69\x0b\x86\x95\xdb\x04\xb6\xfd\x3a\x03\x2c\x18\xd2\x87\x27\x57\x5c\x26\xf5\x22\x54\x69\x61\xf3\xcb\xea\x9e\xca\x8a\x3f\xc5\xbe\xf9\xf6\xf9\xb3\xf8\xb4\x0e\x5c\x6a\x83\x44\x55\xe4\xa4\x07\x2a\xe8\x0b\x38\x90\x87\xd0\x2c\x65\xa6\xbf\x9e\x46\xfa\x50\x9d\x3d\x31\x39\xa7\x5a\xd8\x42\x8a\xd5\x9c\xcc\x4f\xc5\x16\xbf\x1a\x8f\xc7\xa3\x83\xc0\x3b\xe5\x1e\x1a\x68\x0f\x14\xdf\x67\xcc\x13\x29\xc5\x48\x35\x44\x1f\xa2\x19\x65\x84\x3a\x7c\x32\x3f\xc5\xdd\xf6\xda\x5e\xbd\x05\x54\xa4\x04\x8b\x7d\x97\xce\x13\xc7\x0d\x8f\x5f\x96\x6b\xa2\x78\xe0\xa1\xe8\x59\x5c\xc6\xd4\x03\xf8\x1e\xc1\xff\xc4\x3e\xfb\xfe\xf9\xb3\xe8\x61\x7c\x01\xf8\x1d\xa9\xc3\xe6\x42\xec\x3b\xbf\x3e\x7f\xb6\x0f\x9e\xc2\xc0\xa8\x69\x44\x07\xe4\x0c\xf0\x64\xfb\x34\x2a\x40\x20\x98\xf8\xf7\x00\x7d\x89\x0a\x8e\xe5\xb0\xea\x70\xfd\xea\xd2\x59\x0d\x17\xaf\x65\x1f\xbd\x6d\x51\xbb\xab\x9e\xd8\xf6\x7f\x35\xc1\x05\x06\x27\xdb\xd4\x58\x75\x4a\xaf\xea\x1f\x88\x4f\xf0\x28\xd5\x14\x2e\xd5\x97\x70\x77\x76\xa6\xdf\x7e\x03\xd7\x02\x55\xd1\x73\x53\x3d\xb8\xa8\x65\x4b\x19\x5d\xc7\x79\xda\xef\xf1\x12\x0a\x10\x86\x5e\x2c\xb3\x05\xf9\x05\xb3\x16\x03\x29\x2a\x91\xb2\x74\x21\xb4\x67\xf0\xb1\xeb\x87\x66\xe7\x50\x37\x6b\xe8\x32\x04\x56\xc5\x09\x43\x41\x2a\xa1\xce\x41\x3b\x73\xc8\x8f\x72\x7a\x55\xba\xdc\x81\xec\x39\x54\x51\xec\xce\xe3\xe9\x6f\xe6\xd9\x56\xa0\x1b\xed\x74\xe9\xac\xb5\x1f\xe7\x8b\x2b\x38\x5c\x2e\x4e\xcc\x37\x16\x45\xc9\x75\x44\x27\x8c\x62\x5f\x18\xe0\x70\x31\x4b\xc4\x64\xde\xaf\x40\x80\x0f\xd6\x8e\x1b\x7f\x04\x5b\x0d\x5b\xcc\xab\xe5\xb2\x42\xee\x8c\x38\x0d\xa3\x16\x22\x38\x52\xd0\x91\xfb\x04\xa9\x29\x19\x9d\x27\xae\xc5\x10\xa9\x0d\xe3\xa6\x25\x7b\x3f\x1b\xe2\xfb\x3e\x22\x3c\x74\x28\xc1\x23\x79\xd9\x1f\x0c\x6d\x3d\x8c\xc0\xed\x03\x15\x97\x65\x3c\xbd\x78\x9f\x1d\x52\xfc\x32\x3e\x62\x14\xd4\x8c\x48\x0d\xdd\xb1\x23\xab\x1a\x85\x3f\x2b\xbd\xa1\xa2\x51\xbc\x5c\x9a\xe5\x04\x81\x6b\xb6\x14\x95\x66\x9a\xfd\x45\x60\x83\x11\xdc\x61\x28\xde\xef\x8e\x77\x9f\x81\xb6\xac\x27\x7d\x77\xbc\xab\x54\x77\x43\x9d\xa2\x3b\xde\x53\xc0\xde\x3a\xd8\x1d\x3f\xde\x5b\x9f\x0e\x77\x1f\xdf\x6b\xcc\xf7\x1a\xf3\x9f\x45\x63\x56\xa7\x70\x77\xf1\x24\x05\x48\x06\xf0\x56\xc9\x57\x53\x57\xdb\x84\xf5\x37\x5f\x4d\x49\x23\x55\x41\x8d\x3c\x85\x54\xa7\x19\x3d\xb4\x6a\xa0\x80\xbe\x92\x08\x02\x11\x13\xb9\x82\x50\xec\x6b\x16\x3b\xab\xd5\x33\x31\xa3\xc0\x97\xd4\x5b\xc2\x3b\xc6\xb3\x19\x45\xe2\x04\x61\x8c\x85\xc1\x56\xa9\xc8\x45\x09\x9e\x33\x60\x3c\x9b\x71\x47\x40\x8a\xf4\x80\xfd\xd7\x85\x4a\xeb\xdb\x3a\xea\xa8\x7e\x72\x49\x3c\x9b\x85\x34\xee\x50\xc7\x8b\x68\x75\x55\x5c\x18\x88\xd6\x1e\xa1\xdc\x6d\x6c\x3f\xc2\x04\x9b\x46\xe5\x79\xf3\xa8\x5d\x30\xaa\x88\xd1\x28\x96\xf4\x7b\x52\x37\x64\x51\x21\xd3\x99\x22\x00\x75\xa2\x18\x32\xdc\x32\xcf\x87\xa8\x87\x16\x55\x8d\xb8\x30\xc1\x1f\x8c\xaa\x68\x01\x90\x3e\x3c\x30\xa8\x21\xe5\x50\xa8\x3b\xf6\x1c\x23\x5b\x78\x11\xe5\x89\x82\x39\xe5\x9b\x83\x5a\x75\x5d\x17\xa9\x41\x9b\xcc\x4d\xc5\x84\x34\xaa\x68\x3e\xfc\x03\x05\xb6\x70\x12\x45\x49\xf1\x77\x70\x3e\x7d\x2b\x8b\x55\x96\x16\x12\x63\x64\x38\x4a\x4f\xb0\x0f\xe1\xfa\xfa\x7a\x8e\x45\x47\xe9\x87\x20\xd6\x49\xc7\xc3\x47\x2a\x0e\xff\xab\x45\x0e\xae\xfb\xc3\x6a\x67\xf5\x92\x2b\x9e\x57\x0a\xea\x0c\xac\x3c\xc2\x36\x88\xb1\x70\x13\xbc\x46\xd9\x67\xad\xd6\x98\xb3\xae\xb3\x4d\x2a\xc6\xb2\xc6\x49\xdd\xf7\xee\x78\x57\x19\xd1\x70\x42\x76\xc7\x7b\xca\xdf\xed\xc9\xfd\x72\x7f\xbf\xdc\xff\x59\x96\x7b\xa3\x94\xdf\x91\x7d\x6c\x43\x43\xd5\x34\xf3\x5d\x6f\xa2\x51\x81\x9a\xfe\x48\x65\x12\xa4\xa3\xff\x37\xd8\xd2\x08\x8e\x36\x8b\x2e\x28\x4d\x68\x82\x3e\x60\x5b\x10\x06\x66\xcc\x85\xbd\x41\xcb\xf2\xa9\xb6\x76\x82\xdf\x30\x53\xab\xbc\x7f\x4f\x59\x6f\x01\x0f\x2d\xd8\x1b\x82\x32\x6a\x81\x72\xf3\x5d\x63\xf8\x6e\x3f\x35\x58\x01\x86\x68\x0e\xa3\x76\x56\xe4\xf8\x3c\x19\x62\x39\x5a\x26\x70\x59\x88\xcf\x93\x80\x0d\x84\x05\xeb\x66\xb0\x20\x1f\x55\xf8\x6c\x65\x05\xe9\xb1\x36\xf5\xc4\x83\x07\x4e\xf4\x6d\xb6\x69\xd6\xd5\xe2\x36\x9a\xc4\xb3\x13\x9e\xbb\xb9\xc2\x40\x3c\x6f\xd1\x57\x89\xbc\x04\x2b\xa5\xf2\x54\x3b\x27\x81\x85\xc1\x43\x04\x60\x9e\x22\xa1\x90\x00\x4d\x73\xdc\xb9\x6b\x82\x6a\x50\x43\x46\xc2\x77\xb2\xad\x14\x82\x5e\xaf\x55\xd5\x02\xe5\x91\x2a\x2e\x30\x3c\x98\xde\x58\x07\xaf\x35\xc5\xb3\x19\xf1\x70\x61\x37\xe1\x9c\x37\x28\x6d\x2d\xa6\x81\x5c\x87\x9f\x34\xab\x84\x51\x3a\x9c\x42\x98\x88\x24\xf4\x3b\xfa\x0a\x46\x21\xf8\x5e\xcd\x18\xd4\x60\xe1\x66\x2a\x6a\xb6\xb1\x3b\x44\x67\x71\x16\x93\xad\xd9\x36\xd2\x31\x52\xb5\xdb\x51\xe7\x15\x23\x87\x35\x6c\x10\x8e\x8d\xc6\x55\x89\xa0\xcd\x06\x55\xcb\x9b\xbb\x1b\x51\x1f\x5f\xe3\x70\x1a\x33\x20\xc8\xe6\xbb\x18\x5b\x85\xcc\x0c\x2c\xb3\xcc\x1e\x2c\xc9\x09\xd0\xc8\x58\xd3\x1a\x35\x78\x55\xa3\x95\xc2\x55\xd4\x0e\xe7\xc1\x72\xd9\x38\x5c\x0a\x08\x4f\x79\x6e\xcd\x60\x8a\x8a\x6d\x0d\xbc\x3d\xb7\x39\x37\x02\x65\x63\xf3\x39\xb7\x35\xb1\x9b\x89\xac\x03\xda\x02\x95\x07\xff\x84\x02\xdd\x56\x96\x37\x62\x26\x57\xcb\xec\x46\xce\xc8\x39\x50\x5d\x1c\x54\x77\x08\xfd\x8b\x6a\x0a\xdb\xf7\x59\x4e\x2d\x42\xb7\xa3\xd0\x7e\x35\xc4\x92\x34\x2f\xd7\xc6\xb0\xc8\x6e\xb3\x15\xe2\xd3\xcf\x74\xb2\x61\x2f\xb4\xd5\xd4\xc8\xd8\xd6\x50\x85\x99\x2b\x2d\xfb\xaa\x97\xb4\xd5\x1b\x51\x86\xb0\x04\xf5\xbd\x7a\x17\x7e\x9f\x2e\xb6\x51\x28\x99\xeb\x38\x29\x95\x56\xa6\x2c\x2c\xab\xd2\xa0\x9a\xd3\x31\x19\x55\xa8\x19\x13\xa7\x82\x79\xc9\xdb\x36\x4c\xa2\x1f\x86\xd9\x93\x49\x58\xf8\xb6\x9c\xfa\xf9\x88\xc3\x47\xb5\xf7\xe1\xc3\x49\xc7\x49\x1d\x8d\x44\x51\x66\x2b\x71\x4d\xa6\xff\x78\x0e\xfa\xf2\x93\x6d\x3d\x56\x85\xe8\x43\xb4\xc5\xec\xaa\x74\x4d\xce\x50\xa9\x42\x28\xbe\x13\x4f\xb6\x83\x7b\x45\xdd\xfa\x08\xb0\xff\x03\x91\x1b\x86\xec\x0f\xc4\x27\x5a\x0a\xf9\x9f\x4f\x40\x7d\x55\xaf\x02\xa6\x6a\x47\x50\xb7\x5d\x3e\xa2\x3e\x0b\xe9\x4c\x03\xad\xaf\xb7\xc5\xaa\x21\x62\x0a\x8c\x9d\xfe\xdf\x5e\x09\xcc\x3d\x87\x4d\x87\x4f\x81\x5e\x68\xef\x09\xb7\x14\x83\x15\x57\x02\x49\x7f\x71\xad\xb0\x3b\x24\xac\xec\x6a\x64\x80\xd2\x2e\xd7\x2c\x64\xf9\xde\x56\xf5\x56\xb3\x9a\x61\xe5\x88\x35\x03\x62\x9e\x58\x9b\x46\x5f\x0e\x89\x31\x07\x9f\x2a\x75\x98\x38\x98\x0a\x20\xcc\x6d\xc1\x42\xc1\x06\xc2\xdb\x46\x84\x2b\x9a\xba\x13\xd0\x6d\x11\xe8\x3f\x35\xcd\xa1\x4f\x5d\xc8\xfd\xa7\x26\xe4\x7e\xdd\x27\x99\x1b\x36\xd2\x1c\x07\x41\xc6\x54\x8d\x8d\xc5\xac\xe8\x0e\xf0\xe6\xd7\x4e\x01\xfe\xb7\xe1\x74\xe0\x1f\xe8\x12\x3c\xf9\x84\x8a\xef\x77\x62\xaf\x71\x64\xe8\x33\x1a\x99\x43\xbe\x65\xb6\x60\x5c\x0b\xd4\x30\x62\x7c\xab\x47\xcb\x5b\xd3\xc7\x5f\x97\xd4\x96\x26\x38\xd4\x1b\x20\x1b\x8d\x84\x5e\xac\x48\x5d\x80\xc8\x8d\x78\x2e\x22\xe2\x05\xdc\x46\xd7\x33\xe7\x5a\x2a\xeb\x75\x2b\xc2\xb0\xee\x69\xe6\xc7\x60\xb2\x09\x06\x5f\xd7\xe1\xc5\x5b\xcb\x03\xad\x75\xf8\x36\x3c\x11\x84\x25\x01\x16\xd1\x42\x4e\x33\x08\xf2\x9a\x5c\xca\x4e\x43\x79\x9f\x75\x07\x9d\x06\x38\xf3\x21\x68\x34\x85\x6d\xda\x60\x23\x75\x28\xe1\x0f\x68\x90\x15\x96\xef\xb9\x2a\x81\x3b\x5a\x26\x29\x8b\x32\xcb\xe5\xcc\x44\xe0\x57\x2b\xb8\xb6\xf8\x2c\xe2\x42\xc4\x97\xb0\x20\x45\x41\x79\xbd\xb1\xfc\x6e\x95\xe7\x77\xd3\xc4\xe6\x16\xae\x3b\xc1\x64\x54\xcc\xe8\x7b\x73\x09\x1f\x72\xed\xc7\x96\x6a\xdf\x50\xb0\x13\x06\x62\xf4\xef\x63\xd8\xd9\xdf\x98\x40\x1a\x9e\x16\xe7\x81\xb5\x6c\x1a\x7c\xa4\x7c\xc3\x20\x2f\x87\x00\x4b\x4a\x8c\x32\xd0\x4b\xb8\x97\x26\x2f\x99\xc9\x3e\x3e\x07\x97\xfb\xf8\x3c\x41\x2e\x56\xea\x2a\xe4\xb6\x76\xc8\x0c\x21\xbc\x54\xc6\xf4\x52\x2c\x4e\x58\xa8\x63\xa9\xbc\x36\x49\xd4\xa1\xf4\xc6\xea\xa2\x55\xc3\xc8\x0e\x79\x13\xc4\xcb\xc1\x2d\x8b\xee\x36\x14\xf5\x95\x60\x47\xad\xc0\x28\x27\x64\x25\x9e\x55\x4a\x87\xd4\x64\x84\x30\xaa\x32\x8d\xca\x1a\xd7\xb8\xc2\xd6\x65\x47\x5d\x15\x1a\xd9\x81\x80\x09\xc2\x87\x8f\x2b\x9f\x75\xeb\xf3\x33\x67\x7d\xd6\x2a\x35\x56\xb4\xef\x30\x5d\x1f\x47\x1f\x5d\x40\x80\x2d\x98\xd4\x1a\x8d\x80\x6a\x60\x58\x26\x57\x09\x07\xa7\x75\x8e\xf8\xb4\x9e\x80\x16\x4e\xc4\xd9\x72\xc0\x88\x1e\x0c\x31\xb4\x28\xce\xd5\xdd\x2f\xc5\xbc\xec\xb4\x49\xb9\xf4\xa8\xa3\x12\xeb\xa0\xc1\xe6\x5b\x9d\xb3\x88\xf2\x13\xa9\x77\x11\xe1\x52\x11\xd8\x3a\x5f\x14\xd1\x2a\x5b\x39\x31\xe5\xdc\xe6\x2d\xe3\xa2\x44\xc8\x0a\xea\x70\x73\x34\xff\xf4\xa1\xa0\x8a\x56\xb4\x45\x19\xaa\x8b\x3a\xdd\x6f\x93\x25\x61\x4b\x93\xa0\x22\x04\x8e\x54\x2c\x65\xf1\x9d\x1b\x20\x9a\xda\xcd\x58\xfe\x80\xa6\x70\xfb\xce\xdf\xdb\x36\x7b\x16\x80\x0d\xcd\x80\x8c\x4c\xb6\x0f\x8e\x09\xcd\xf8\xd6\xd1\x07\xba\xb5\xe5\x36\x39\x5a\xc5\x37\xf1\xf9\xd2\xd9\x56\xd7\x2e\x19\x87\xda\x61\x0a\x0e\x14\xf1\x61\x9d\x32\x13\x69\x96\x3e\x42\x24\x9c\x1c\xbd\x06\xb1\x6d\xbe\x02\x09\x55\x38\x3f\xb1\x5f\x67\x76\xed\xd3\x54\x19\x2a\x3e\x62\x58\x69\x80\xc0\x4d\x0a\x1e\x8a\x54\x88\x58\x8f\x9d\xcd\x95\xaf\x3b\xf2\x5d\xac\xb3\xef\x27\x5d\x2f\x9e\xa5\xbe\x65\x60\x26\xc5\x75\x5c\x18\x25\xd2\xc1\x87\x33\x5b\x1d\xb6\xb2\x5d\x0b\x71\x51\xe5\xc4\xf5\x22\x2e\x2e\x42\x34\x87\x46\xcb\xdc\xdc\xf9\xf3\x3f\xd4\x1f\x38\x64\xf4\x08\xdc\xaa\xe4\x80\x06\x3a\x9b\xa9\xbe\x70\x59\x0b\x2d\x09\xc2\xd7\xed\xb4\xc4\x3e\x04\x20\xbe\x98\x74\x82\xa5\x6a\x95\xc2\x79\x92\x17\x65\xbd\x4e\x78\x4b\xb5\xae\xc6\xe8\x11\xb2\x74\x04\xc8\xd4\xa9\x53\x30\x82\x04\x04\x2e\x85\x1e\x1b\x3f\xac\xda\x61\xf6\xea\xda\x94\xe2\xad\x94\xbe\x4d\xcf\x79\x6f\x3a\x1d\x4f\xd4\x10\x10\x5a\xc7\x26\x1d\x67\xe5\x8b\x16\xb2\x7c\x09\xb3\x09\x3b\x8a\x3f\x1d\x87\xb3\x2f\x56\xb5\x7c\x3b\x59\xc0\xf8\xe5\xa8\x56\x81\x95\x1c\x31\xad\x5d\xc5\x67\x6e\xf0\x8f\x3a\xa3\x91\xb7\xfc\xda\x69\xc7\x94\xb0\xd1\x08\xc9\x11\x68\xa6\x82\x9b\x74\x46\x23\x27\x7a\x6e\xa8\xab\xf0\x34\x69\x3c\x9d\x82\xb0\x50\x7e\x1f\x49\x01\xf7\x5b\x1b\x14\x32\xa7\xff\xb1\xdb\x73\x2d\x75\xd6\x55\x39\xc4\x75\xa0\x26\xfd\x47\x7c\x62\xaa\xd6\xa6\x6a\x10\x9c\x6f\x5a\x0c\x20\xdc\xe0\xc1\x3b\x39\x1b\x42\x5d\x36\xd2\x1d\x40\x14\x16\xce\x13\x65\x84\x0e\xea\x1d\x75\x3c\x72\x32\x5d\x23\xf6\xc6\x83\xac\x24\x84\x97\x46\xc7\x1b\xc1\x66\x05\xca\xfa\xc5\x23\x2f\xab\x28\xd1\x2c\x90\x24\x04\xee\x93\xe0\xb5\x65\xbc\x08\x4c\x8b\x86\x54\xe0\x5c\x4e\x63\x78\x05\xed\x5a\x8a\x59\x96\x96\xe2\x3a\x4e\x4b\x18\xe1\x62\x95\x25\x4b\x35\x7d\x93\xb4\x94\xf9\x3c\x46\x8d\x71\xb3\xad\xf7\x26\xdb\x6b\x7f\x8d\xa2\xe9\x5a\x2f\x0d\xad\xfc\xaa\x4c\x69\x36\x35\xff\x06\xd6\x6b\x68\x38\x2c\x8e\x43\x71\x7d\x91\x4c\x2f\xc0\x51\x02\x7e\x42\xcf\xf4\x1a\x26\xe0\xe9\x02\xbe\x68\xe3\x59\x9c\xc7\xaa\x28\x06\x5a\x86\x17\xa1\x9c\x31\xe6\x43\xda\xa2\xbf\xde\x5e\x49\xed\x6c\xae\x3c\x7e\x8d\xe2\x58\xaf\x34\xa2\x78\xfd\x62\x25\xa6\x49\x81\xc1\xc1\xe5\x20\xb7\x91\xbd\xc4\x14\x6d\xfb\xdb\x06\xe9\xdb\x26\x99\x2a\xbb\x5f\x77\x76\xc3\x61\xa2\x33\x3f\x91\xa4\x8a\x12\x70\x2a\x00\xca\x72\x86\x3e\xf5\x2a\xb1\xba\xf8\x59\x2f\x70\x05\x10\xb8\xaa\x12\xda\x40\x07\xfd\x94\xa8\x91\x28\x96\x28\x74\x97\xef\x1c\xd1\x1d\x3f\xab\x73\x78\x36\x2e\x0c\xdd\xf1\xee\x5e\xd5\x03\x1a\x7b\xde\x1d\xef\xed\xac\x4f\x87\xbb\x4f\xef\x7d\x9d\xee\x7d\x9d\xfe\x2c\xbe\x4e\xe8\xf1\x78\x07\xbe\xcd\x95\x79\xab\x85\x06\xfa\x52\xea\x4b\x58\xc7\x73\x3a\x63\x3e\xc8\x17\xc5\x38\xb0\xdc\x30\x01\xca\x76\xaf\x88\x04\xdf\x80\x02\x7e\x34\xab\x09\x2c\x89\x84\xd4\xe0\xa3\x1d\xec\x7a\x18\x6a\xc3\xdb\x37\x87\x7a\x83\xfa\x35\x0d\x50\x9e\x35\x7a\xd1\x95\x70\x5f\x02\xda\xf1\xf6\xcd\x21\x9e\x0b\x84\x1b\x80\x77\xcf\x93\x2c\xe5\x35\x5f\x64\x45\x39\x68\xaa\xfc\xf0\xf8\xf5\xeb\x57\x87\xef\x8f\x8e\x5f\x8b\x57\x6f\xdf\x1e\xbf\x1d\x8b\x43\x63\xee\x9d\x6a\x94\x50\x3b\x84\x53\x10\xbd\x87\x02\xf0\x89\x87\xbd\x28\xdc\x06\xba\x0a\xbf\x79\xdf\xa9\x04\x30\x99\x80\x18\x33\x59\x2e\x12\xdd\xa1\x70\x15\xe4\x37\xcb\xab\x40\x6f\x55\x5b\x0f\x2c\xbd\x97\xb2\x28\xe2\x05\x38\x4c\x6f\x6d\xe1\xb5\xc2\x07\x0f\xcc\xf7\x48\xf1\x66\x35\x25\xa2\x62\xcf\x45\x30\x79\x2c\xcc\x70\x41\x88\x62\x35\x2a\xb9\x69\x52\xe0\x41\x5c\x6c\xdb\xa4\x9e\x06\x88\xda\xed\xad\x1d\xcd\xf7\xfa\x78\x9a\xf7\xf7\xb2\xd8\x74\x48\xdf\x1f\xfd\xf4\xea\xf8\xe7\xf7\x63\x81\x87\xdc\xc0\x5c\xd0\xc8\xcb\x42\x3c\x14\x3d\x71\x59\x88\x78\x7a\x01\x02\xc3\x10\x9b\xc2\x57\xc2\x62\xf5\xec\x7e\xb1\xba\x5f\xac\xfe\x34\x8b\x15\x28\x71\xff\x6d\xbd\x72\xed\xdd\x39\x07\x7c\x64\xd3\x09\x72\xa3\x0b\xf3\x77\x78\x6f\x1d\x89\xa8\x1c\xf3\xcd\xde\x8c\xc6\x59\x3b\x96\xf3\x5d\x08\x5e\xa8\x55\xf5\xdb\xbd\x82\xef\x74\x6c\x65\x5d\xd8\x35\x0c\x15\x7d\x5a\x5b\x42\x77\x33\xb1\xe5\x36\x81\xed\x0a\xce\x56\xe4\xba\xcb\x8c\xe8\x3c\x1f\xe2\xe8\x98\x50\x4d\x81\x68\xd1\xda\x21\x93\x97\x68\xda\x84\x9c\xc5\x69\x96\xde\x5c\x66\x57\xa6\x46\x93\x50\xb3\x79\x23\xa2\x81\x2d\x49\x8f\x8b\x9c\x69\x7d\x40\x5c\xc3\x86\x43\x05\x99\xf6\xf6\x6e\x4e\x86\xd9\xa7\x41\xb0\xe3\xb5\x7a\x4c\x77\xa6\x22\xac\x6b\x0d\x00\xb0\xdf\xac\xe4\x8c\x8d\x93\xba\x18\x22\x67\xcc\x48\x64\xb7\x7e\xb0\x03\x06\xd9\xe7\x54\x31\xea\x38\xa3\xc6\x36\xe6\xf0\x3f\xd7\x2f\x1a\x91\xd3\x78\x21\x7e\x3e\x16\xd5\xd3\x11\x63\x96\x63\x25\x92\x08\x31\xa9\xa3\x0e\xfc\x5e\xe3\xed\x1a\x2e\x6f\xdd\x95\xd7\x83\x0d\x88\xaf\xd9\x75\xa6\xe3\x81\xab\x08\x46\x1e\xd5\x31\x0b\x2e\xa4\x3b\xa4\xa3\x28\xf4\x41\x04\xf5\xa4\x63\xe8\x1c\x02\x52\x5f\x10\xbd\x92\x22\x11\x06\x1c\x67\x91\xc9\xf1\x82\x39\x54\xb3\x79\xef\x80\x24\xa1\xae\x95\x18\xc8\xbc\xa1\x5f\xbc\x68\x33\x3f\xdc\xaa\x47\x14\x42\xfd\x8b\xba\x53\x24\x8b\x34\x2e\xaf\x72\xbf\x3b\x3c\xbd\xae\x3f\x1c\xa6\xbe\x3f\x06\xaa\xa9\x43\x20\x87\x6f\xd1\x7e\x0c\x9e\x41\xec\x8d\x02\x0a\x9c\x81\xd0\xd6\x03\x63\x06\x0f\xcb\xcf\x93\x34\x5e\x86\x9d\x9e\x35\x8e\x4e\xe0\x34\x1d\xd1\x86\xb2\x10\xbd\x43\x14\xca\x93\x1f\x64\x7e\x53\x2a\xb7\xc9\x69\x76\x79\x0e\xfe\xa5\xa2\xcc\x16\x52\x69\x45\xd0\x36\x7c\x5f\xa1\x91\x5a\x81\xb0\x20\xd8\x9c\x4a\x48\x3b\x4c\x17\x66\x6a\xf3\x2b\x80\x7f\xf2\xe0\x21\x10\x3c\xc4\x84\xea\x38\x39\x75\x33\xec\xea\x83\x0b\x0e\x5f\x83\xc0\xca\xb9\xe5\x2d\x44\xd5\x76\x62\x40\x0f\x7d\xad\x15\x83\x0a\xaa\x42\x86\xdb\xfb\x83\xaa\x81\x13\xc7\xe9\x3d\x35\xec\x6b\x45\x3a\x7b\xbb\xa2\x51\x9e\x43\xdd\x1f\xf0\x09\x75\x2c\x7f\x92\xa8\xd7\xb7\xd8\x55\x54\xe8\x38\x02\xf1\xf1\x03\xa6\xb2\xc9\xe9\xd5\x72\xc9\x31\xb3\xa6\x59\x3b\x23\xeb\xb5\x6f\x37\x56\x6b\x64\x5f\xe1\x1b\xd4\xe0\x51\x99\x5e\x6f\x3e\xf8\xb0\xd5\x90\x8e\x95\xab\x31\x7d\xbd\x7c\x0d\xc5\x07\x1c\x08\xfa\xac\xd9\xef\x75\xe7\xd6\xd8\xa0\x79\x6d\x1c\xe7\xfc\x06\x97\xd6\x69\x5c\xd2\xb2\xae\x47\xdf\x96\x64\x77\x79\x5b\xa4\x1e\x06\xb0\xa9\x97\x7a\x9e\x84\x9b\xc9\x3a\x09\x07\x96\xf0\xa0\x0c\x43\xa9\x80\xae\x43\xb0\x4f\x02\x6d\x48\xbd\xce\x43\xd5\xc2\x03\xe0\x59\xe9\xb5\xa2\x51\xa6\x35\x04\xde\xb9\xeb\xc8\x3b\x71\xbe\xf0\x66\x97\x99\xc4\xe2\xb9\x53\x72\xcc\x7f\xe9\xd3\x11\xf3\xfe\x34\x9b\xab\x78\x1a\x63\xf0\x7a\x8c\xa9\x8b\x43\xf0\x1c\xa4\xa4\x83\x6f\x77\x30\x01\xc9\xf7\x6b\x96\xa4\xfd\x6e\xb7\x8a\xdc\xdc\x89\x03\xfa\xe4\x18\xb1\x86\x1f\x76\x00\xf9\x8a\x3e\x4c\xf3\x01\x5e\xda\xd6\x2d\x22\xee\x81\x86\xa6\x59\x79\xe4\xb4\xd5\x90\xd0\xb6\x22\x00\xbc\x61\xdd\xea\xd2\xc2\x60\xe8\xd5\xc2\xeb\x37\x0b\x09\x93\xda\xd9\x55\xb9\xba\x2a\x7f\xcc\x16\x56\x5c\x63\x1c\x1e\x36\x5b\x28\xf0\x8f\xaa\x8d\xa9\x72\x7d\x17\xcc\x4a\x6b\x40\x41\xc2\x7b\xd2\x71\x60\xf2\x45\x45\x9e\xe6\x72\x76\x35\x95\x6c\xac\xe2\xe9\x74\x28\x30\x0c\x25\x97\x27\xf1\x74\x7a\x82\xc9\x5a\x26\x02\x51\xf0\x37\xd2\x5c\x3c\x77\x87\x2c\x2a\x2e\x92\x79\xd9\x1f\x88\x71\x85\xa2\x94\x65\x65\x0c\x72\x46\x3c\x9d\xa2\xd4\x18\x6a\x5f\x6f\xf5\x63\x26\x97\xb2\x94\xd4\x0f\x1b\x20\xc9\x4d\xd7\x5c\xf5\x05\x22\x83\x9d\x66\xe1\xb5\x12\x9c\xda\x10\x27\x4c\xab\x80\xb5\x61\x15\x2a\x52\x03\x49\x10\xca\x6a\xd2\x8b\x9c\x8a\x9b\x95\x9f\x40\xd8\x06\x5f\xfb\x71\x8f\xba\x2b\x07\x93\x74\xa4\xdc\x6f\x09\xab\x34\x08\x1d\x14\xdf\x45\x34\xa6\x5d\x74\xb3\xc4\x4f\x28\x1a\x53\x53\xf1\x1d\xde\xae\x26\x04\xae\x06\xf8\x69\xed\xaf\x69\x0d\x21\x9f\x7c\x92\xfe\xb7\x8a\xfd\x54\xcb\xca\xdc\xf1\x7f\x83\x2b\x8d\xde\x9d\x36\xce\xb4\x94\xb6\x6e\x64\x46\xff\x56\x9c\xc3\x95\x54\xf9\x17\x04\x9a\x02\x50\x77\xe3\x5a\x27\x00\xc9\xd3\x50\x75\xe0\x84\x01\x9c\x72\x26\x09\x02\xf0\xd8\x55\x96\x27\x82\xa0\x27\x46\xda\xc3\xcf\xfe\xe0\xb4\xb6\x03\x8e\x0b\x45\xf0\x80\xd8\xa1\xe5\xa4\xb3\xd1\xf9\x70\x5b\x8c\xac\x2f\x0c\x8b\xf5\xcd\x26\xe6\xf8\xdb\x19\x0b\xab\x76\xca\x91\xdb\x64\x02\xfc\x49\xf3\xa0\x03\x69\x4f\xa3\xea\x1f\x10\xa1\xd0\xba\x00\xd5\x19\x8d\xc4\xfb\xe3\x97\xc7\x63\x91\x4b\xed\x9c\x35\x14\x45\x86\x7e\x34\xe6\xc0\xcb\x3a\xe6\xc4\x40\x25\x39\x8b\xa0\x5c\x52\xf6\x0a\x91\x4a\xf0\xaa\x8a\xf3\x1b\x98\x2c\xea\xf9\xeb\x02\xd8\xad\xa7\x42\x15\x83\x95\xbd\x27\x20\x0a\xb7\xb6\x0a\x5f\x5e\x2d\xcb\x64\xb5\x64\x2f\x38\xb8\x6f\xa6\x84\x63\x1b\x8d\xfe\x22\x82\xce\xdc\x4f\xc8\x1b\x5c\xe3\x70\xca\x9b\xea\x8d\xf8\xb6\x80\x10\x43\x59\xce\xf4\xba\x61\xe5\x59\x32\x67\xa5\x22\xa2\x11\x9f\x02\x66\xb2\x40\xe3\x4e\xaa\xb0\xce\x7c\xa1\x4f\x1d\xac\x27\x4d\xed\xec\xa1\x3f\xb7\x9d\x62\xbf\x16\x53\x8b\x47\x64\x10\x4f\xed\x0e\xca\xe2\x47\x86\xf7\xfb\x54\x01\x08\x5c\x33\xd7\x39\x21\x6a\xe8\x1c\x23\xf4\xd0\xbf\xc7\x6d\x22\x8a\xa5\x40\xb1\x42\x96\xde\x0b\x31\xa1\x00\x57\x8d\xfb\xb0\x9a\xce\x22\x31\x13\xd9\xd0\x5f\x0b\x13\xe8\x32\x66\xde\x84\x3a\x4d\x79\xb7\xee\xb6\x29\x78\x07\x1d\xe7\x3e\xa4\xf2\x63\xe4\x88\x23\xfb\x63\x42\xf9\x24\x84\xd4\xbf\x26\xd5\x48\x1c\xfd\xc5\xa4\x33\x39\x43\x5f\x5d\x2d\x52\x7e\xc4\xbb\xdd\x55\x61\xae\xa9\x60\xc3\x12\xd5\x88\xe7\x1a\x81\xdc\x1d\xef\xa9\xf0\x45\x44\x2a\x08\x17\xbe\x3e\x1d\xee\x7e\x7b\x7f\x4c\x7a\x7f\x4c\xfa\xef\x7d\x4c\x8a\x67\xa4\x78\xdf\xf7\x4f\xf1\xac\x9e\x23\xb5\x98\x46\x63\xd3\xeb\x95\xa8\x8a\x0a\x05\x34\xec\xfc\x05\x5c\x22\x3f\x48\x10\x45\x31\xbe\x74\xac\x76\xc1\xa0\xcd\xc4\xea\xd9\x68\x7a\xbf\x05\x18\x38\x5e\x16\x99\x88\x97\xcb\xec\xba\x50\x61\x6f\xf5\xcd\x9a\x22\xea\xfc\xa5\x63\xf5\xfe\x9f\x92\x8f\x72\xb6\xd6\x59\x9d\xea\xa9\x46\x07\x8f\x57\xcb\xcc\x7f\xdb\x18\x0d\x96\x66\xbb\xc9\xec\xa4\xaa\xb2\xcf\x9f\xe9\x69\x74\x9b\xd1\x33\x16\xd4\x9e\xdd\x11\x62\x8d\x50\x08\xc5\x30\x16\x10\xba\x0d\x58\xd1\xc4\xad\x48\x1f\xe3\x1d\xcf\x95\xa1\x79\xa0\xaa\xdd\xae\xe0\x54\x90\x93\x4e\xe5\x3a\x2b\x66\xdb\x27\x86\xe0\xed\x24\x53\x0f\x88\xfe\x11\x68\x9a\x20\x69\x71\xeb\x54\xf8\x5e\xb1\x99\xb1\xee\xe3\x5e\x6b\x08\x83\xf0\x41\xe6\xc9\x5c\x05\xf5\xc8\xe5\x34\x06\x89\x83\xa2\x3e\x91\x85\x78\xf0\x40\x2c\xe3\xdf\x6f\xc4\x32\x8b\x67\x62\x76\x93\xc6\x97\xc9\x54\x64\xa9\x2c\x54\x6d\x38\x20\xb6\x22\x7c\xc3\x3a\x33\x56\x02\x05\x45\xae\xf6\x54\x79\x05\x0a\x46\x6b\x21\xcb\x63\xcc\xe7\x6a\x26\x96\x19\xaa\x81\xa1\xb5\xbf\x3d\x36\x00\x37\x25\xe0\x90\x20\x26\xc7\x60\x80\x44\xc5\x2c\xda\x62\x35\x1f\xbc\xe8\x0a\x8a\xeb\x04\x82\x48\xf2\x56\xc1\x67\x0a\xd7\x83\xd5\xfe\x79\x6c\xd5\x3e\x74\xac\xd7\xba\x3b\x1c\xa0\xa9\x09\x50\x0c\xd5\xf1\xdd\x54\xcf\x0e\x75\xc6\x04\xd3\xc1\x29\x85\x55\xa3\xdd\x48\xec\xfb\x09\x5e\x14\xca\x0d\x8a\x38\x76\x4f\x7c\x4d\xdb\x36\xdf\xa3\x8b\x21\xa1\x36\xf7\xd3\xdb\xdb\xcf\xf1\x55\x72\x40\x85\x93\x0c\x2c\x68\xf8\x15\xc1\xdc\x56\x19\x3b\x99\x57\x43\xb5\x6a\x55\xba\x18\x7b\xcd\x1e\x56\xe0\x60\x0a\x58\x28\xf8\x55\x85\x29\x33\x8e\xa7\x9a\x8f\x36\x48\x0b\x84\x09\x55\x48\x73\xa2\x36\xbe\xd5\xa1\x56\x64\xca\x0d\xaa\x38\xcb\xec\x4b\x30\x62\x29\x2b\x37\x50\xef\xa4\xaf\x9a\x03\xe1\x59\xd1\x71\x88\xe0\x1e\xab\x93\xa5\xe6\x60\x36\xd3\x57\x24\x8c\x09\x0d\x0e\x3a\x0b\xb8\x38\x71\xb5\x52\x19\xb8\x19\x51\x22\x23\x29\x65\x0e\x6e\x2d\xd9\x07\x54\xb6\x30\x84\x69\xd4\xe9\x90\xdd\x66\x21\xc1\x6c\x5c\x1c\x94\xef\xca\x38\x2f\x3b\xbe\x9d\xb1\x90\xcb\xb9\x49\x9c\x57\xee\xef\x90\x08\x77\xb1\x70\x49\x0e\x08\x98\xcd\x09\xe5\x02\x5e\xb7\x5b\xc8\x52\x5b\xb3\xa0\x09\xde\x9d\x3b\x65\x5e\x30\x05\x45\x01\x88\x8d\xc8\xd8\xf2\x64\x06\xd4\x12\x85\x04\x87\xca\x58\xc8\x92\x6d\x50\xd4\xf5\x1f\x74\x6b\xac\x6c\x72\x46\x23\xb8\x4c\xd3\xc3\xeb\xa2\x70\x25\x10\xc9\xa6\x7c\x28\xd5\x9d\x29\x4a\x44\xfb\x87\x0e\x83\x11\x45\x91\xf8\xf5\x0a\xae\x0a\x66\xaa\x4e\x90\xbd\x9d\x0d\xaf\x48\x36\x5c\x8f\x64\xdb\x34\x5c\xa0\xdc\x99\x6e\xba\x11\xc2\x4b\x99\xc1\x7d\xa9\xca\x0a\x95\x72\x5a\x04\x0b\xa7\x21\x96\xd7\x36\x7f\x67\xe5\x6e\xdc\xd7\x83\x3f\x9c\x75\x57\xd9\x72\xa9\xd9\x27\xcc\xb6\xc8\x9b\x16\xcc\xe7\x4b\x63\x11\x01\x16\xce\xd2\x9f\xd0\x8b\xd6\x42\xa9\xbb\x62\x59\x0d\xbb\xe0\x88\x66\x95\x31\xc5\x19\x0c\x35\x98\x10\xb8\xa1\x41\xa0\xbc\x36\x9e\xc8\x9a\xf7\xb3\x9d\xdb\x73\xc7\x97\x71\x06\x66\x89\x7d\x45\x5e\xbb\x71\x16\xcf\xbd\x04\x8b\x64\x4c\x85\xdc\x0e\x98\x99\xf9\xa5\xe4\xf9\x3a\x26\xad\x35\x07\xa8\x36\xb9\xa6\x84\x48\x09\x9e\x37\xd9\x72\x09\x72\xc6\xb6\x44\xf3\xe0\x58\xf7\x1c\xe2\xcc\x4a\xf0\xc9\x57\x21\x73\x23\xe0\x38\x35\xf2\x76\x2d\x59\xa1\xd7\xfd\x89\x82\xd7\x3b\x95\xa3\xd9\xe9\x90\x0e\xa8\x9c\xe4\xa1\x65\x46\xcc\xe1\x31\x8c\xb4\x7d\x18\x92\xc1\xe3\xc1\x3c\x56\x58\x61\x71\x4f\x27\x1b\x7a\x0e\x87\x43\x3b\xdd\xcc\xc0\x59\x41\x3d\xc4\x53\xa4\x43\x38\xcf\x4a\xb2\x54\xf9\xe3\x1f\x7a\x23\x02\xd3\x06\xda\x81\x06\x6c\x6b\x6e\x77\xe9\xc1\x0c\x7c\x58\xe5\x46\x96\xb2\x5a\x53\x97\xdb\x8f\xc1\xc4\x2f\xe1\x19\x97\xdc\xc6\x20\xf8\x7a\x50\x1b\x7a\x1c\xf7\x4a\x36\x81\x41\x22\x45\xc5\x3e\x53\x83\x5d\x32\x73\xbc\x15\x32\xb8\x09\x0c\x92\x46\xde\x39\x75\x52\x39\x34\x20\xe4\x57\x63\x32\x70\xc9\x3d\xac\xcb\xb7\x62\xaf\x92\x65\xc6\x9b\x5b\xbc\x6a\xdb\x1d\xa5\xf2\x1a\xcf\x9c\x38\x0d\x78\xa0\x30\x60\x8e\xa1\x48\x66\xcc\xf1\x3f\x99\x87\x85\x63\xcb\xb4\xef\x4f\xcf\x19\x12\xfa\x4c\xcf\x5b\xe5\x20\x49\x64\xdc\x17\x36\x70\xaf\x17\x8d\xd4\x6f\xa0\x68\x2a\x1b\x6e\x46\xb3\xd9\xd9\x99\xda\xc0\x04\x33\x4f\xc5\x1e\x8d\x9c\x98\x0d\x39\xc4\x52\x91\xc0\x60\x62\x25\xd3\x99\x72\x6f\xc3\xea\x0b\x08\xdf\x0d\x81\xa2\xd3\x02\x38\xd4\xc4\x82\xc1\xcf\x68\x04\xb6\x2d\xe0\x18\x28\x04\x5a\xcf\x40\x1b\x57\x4c\xed\x57\x69\x5c\x80\xc7\x14\x9c\x5b\x54\xda\xe8\x73\x54\x60\x96\x06\x47\xc7\xe8\x58\xd3\x73\x9f\x32\x83\x49\x7b\x35\xc8\x9d\x0e\xe0\x68\xc4\x9c\x8b\xc5\x92\x6b\x87\xf1\x32\x97\xf1\xec\xc6\x5e\x5d\xb7\x8a\x62\xf1\xf5\x9c\xe6\x2a\xb2\xa4\xbd\xb6\xf5\x2b\x99\xf7\xbd\xda\xf0\x84\x19\x02\xb0\x38\x90\xf0\x9f\x9d\x99\xaa\x94\xbf\xe3\x52\x31\x21\xe1\xd6\x77\x99\x61\xdf\x92\xcb\x4b\x39\x4b\xe2\x52\x2e\x6f\x1c\xc8\x64\x4e\xec\x3e\xdd\x94\xb7\x5d\xed\x44\xa1\xb7\x0b\xad\xd7\x49\x7f\x91\xa4\x86\x22\x06\x10\x06\xda\x96\xad\x3b\xc3\x8e\x72\xaf\x2b\x2f\x74\x98\x3a\xc4\xa7\x80\x6c\xd3\x6e\x7d\x06\x06\xeb\x81\xee\x71\xe1\xc8\x7b\xe3\x8d\x13\x9e\x96\x5a\x04\x01\x5a\xb3\x23\xe1\xf5\xa6\x1d\xe1\xeb\x6e\x7b\x7f\xdc\x65\x43\x05\x1e\x24\xc5\xc1\xed\x08\x93\xb5\x53\x6f\x1a\x20\x07\xe4\xf2\x32\xfb\x20\x69\x02\xc4\xc5\x4d\x3a\x35\x9b\x1f\xbf\xee\x3a\xb9\x7d\x95\xaa\x7b\xd4\x0e\x01\x4c\x1b\x2a\xd4\xaa\xc8\x2e\x46\x9d\x5b\x61\x26\x7c\x61\x8a\x2e\xbc\x97\xda\xa7\x9b\xe8\x14\x41\x87\x13\x53\x92\xd3\x01\x20\x9d\xd6\xd4\x7a\x51\x8e\x46\xe2\x88\xd6\x89\xa3\x19\x18\xf3\xe9\x92\xdf\x8d\xc4\x40\x2c\x60\xc1\xb7\x51\xba\xf0\x0f\x62\xf0\x5c\x48\xe5\x8a\x81\xbd\x1f\x08\x2d\x54\x0b\x91\x94\xae\x58\x0d\x2e\xd4\x15\x66\x6f\x5c\x3d\x42\xe4\x47\x8c\x6e\x47\xdd\xf5\x18\xb4\x3d\x7e\x0f\xcf\xdb\xb4\xf8\xc4\xa0\xbf\x86\xad\x68\x6d\x0b\x2b\x65\xf5\xa6\x31\x97\x7e\xfc\xe5\x65\x66\x9e\xe2\x0f\x7d\x90\xdf\xda\xf6\x16\x0a\xcb\x18\xd6\x84\x6a\xfb\xe0\xb3\x0e\x05\x20\x5c\xd7\x8a\xef\x75\x27\xd8\xb1\xcd\xf9\xa8\x12\x6f\x0a\x15\xf1\xa3\x97\x3a\x61\x8c\x3c\xd6\x1f\x00\x27\xf4\x07\x62\x1a\x53\xb4\x42\x15\x61\x05\xae\xb9\xdc\xa4\xd3\x8b\x3c\x4b\xb3\xab\xc2\x84\x2e\x44\xbf\x00\x11\x1b\xba\x9a\x15\x50\xa3\x41\x65\xb7\x57\xe9\x0b\xcd\x22\xb5\x6a\xee\x6f\xce\x40\x83\x89\x3f\xf5\x01\x43\xfb\x18\x7e\xc5\xb8\xad\x5b\xe4\x71\xe5\x88\x54\xd3\x96\x39\xba\xb4\x1e\x90\xae\x4f\x87\x7b\xdb\x9b\x1c\x81\xf6\x20\x96\x0a\x58\xbc\xa7\x65\xaf\x73\x7f\x6f\xf4\xfe\xde\xe8\xbf\xc5\xbd\x51\x3b\x19\xbe\xe8\xf2\x28\x02\xdf\xe2\x24\xd3\x8f\x8d\xb0\xe1\x11\x24\x86\x4f\x9a\x27\x8b\x20\x9c\xce\x22\xc0\xa3\xf3\xd8\x7b\xd0\x05\x9e\x78\xef\x0d\x2a\xde\x8c\xc0\xd5\x9a\x02\x42\x7b\x48\x83\x65\x31\x16\xe7\xc9\x02\xc3\x19\x78\x4e\x8c\x1a\xe8\x45\xb2\xf0\x8c\xfa\xdc\x99\x51\x9f\x52\x7d\xd6\x10\x9f\x0d\xec\x9a\xb9\xe2\x16\xe2\x93\x4d\xa7\xb3\x33\xba\x4c\x5a\x57\x87\xa3\x15\xe9\xd6\x91\xa4\x45\xb9\x88\xf7\x3d\x33\x53\x96\xc0\x26\xc6\x08\x94\x14\x6f\x72\x89\xc7\xa0\xec\x74\xc2\xc1\x7d\x6e\xd3\xbd\x0a\x58\x8e\x52\x9b\x28\xf4\x3d\x9c\xa8\x55\xf2\x70\x6f\x1a\xce\x94\x71\xbe\x4c\x6a\x8b\xaa\x4b\x62\xc9\xef\x72\x86\xaf\xa5\xa8\x96\xc3\x2d\xd7\x97\x72\x1e\x5f\x2d\x83\xe7\x2a\x6d\x5d\x48\xe6\x4e\xb2\x7b\xa7\x86\x80\x58\x5f\x35\x47\x45\x33\x56\x23\xad\x45\x0c\xaa\xfe\x98\x87\x37\xc1\xeb\xc5\x7f\x46\xf3\x4d\x1e\x36\x5a\x2b\xef\x80\xa5\x86\x05\x9c\x4a\x03\x08\x59\x76\x80\x0e\xc4\x7a\x3f\xc8\x8f\x0e\x22\xc7\x85\x58\x13\xa7\x60\x2e\x93\x10\xad\xc4\x09\x22\x08\x16\xff\xa9\x71\x33\x30\x6e\x03\x30\x2d\x7f\x78\xf5\x8b\x37\x1b\x15\x0e\x50\x9b\x83\xf3\x90\x4c\xfd\x1c\x7d\xd5\x2b\xbe\xa0\xe9\x87\xb3\xaf\x8a\xd3\x19\x19\x44\x30\xc0\x73\x01\xfc\xa9\x4e\x07\xd9\x09\xad\xfa\xf9\xf9\xb3\xc7\x43\x07\x53\xf5\x24\xc3\xc4\x1e\x6c\x73\x78\x4e\x72\x07\x0f\xdc\x10\x5b\x5d\x51\xf8\x7d\xd3\x2c\xb2\x2a\xaa\xba\x5d\x35\x89\xa3\x2e\x33\x7c\x48\x3a\x29\xc5\x65\xb2\xb8\x50\x51\xb0\xc9\x6b\x58\xdf\x6a\x4f\xb2\xb4\x52\x73\x99\xb5\xd6\x5b\x66\x6e\xad\x27\xbd\x45\x5c\xbc\xc9\x93\xa9\xec\x0d\x05\x7c\x87\x7f\x94\x5b\x03\x7c\x49\xb3\x74\x2a\x43\x57\x17\x7f\x93\x37\x01\x6e\xc3\x4a\x4e\x7e\x93\x37\x9b\x5e\x5f\x54\x98\x2a\x34\xd4\x18\xe8\x2e\x3c\xd0\xea\xa5\x9c\x26\x97\xf1\x92\xfa\xa1\x00\xa8\x27\x83\x49\x85\x80\x10\x39\xb0\x3c\x4a\xe7\x99\x78\xf0\x80\x90\xda\xc4\xa8\xb8\x5a\xad\x96\x4e\x0f\xea\x60\x9a\x1a\x51\x45\x18\xd2\x7b\x11\xf8\x8f\x9f\x53\x2c\x06\xe8\x5d\x4f\xad\x10\xea\x5b\xcc\xb0\xfe\x46\x53\x6c\x10\x95\xd9\x8f\xd9\xb5\xcc\x0f\xe3\xc2\x38\xef\x7f\xc9\xa4\xfa\xda\xe9\x64\x1e\xb2\xc1\x8a\x1c\x2f\x8e\x50\x23\xa9\x3e\x5e\xc8\xda\x0a\x9f\x54\xa2\x35\x7b\xc8\x9d\x62\xc5\xd5\x39\xe8\xda\xe9\xa2\xbf\x6d\x6e\x96\x40\xb5\x88\xee\x91\xd8\xdb\x65\xb5\x52\x73\x0b\x3c\xa0\xe7\xa8\x02\x50\x57\xe7\xc1\x2e\xd9\x3a\xc3\x15\x06\x1a\x12\x1c\x2c\xfa\x00\x2d\xb6\x46\x27\xdb\x8f\xbe\x8d\x1f\xfd\x7e\xf0\xe8\x9f\xa7\x9f\xf6\x76\xd7\xff\x31\x4a\xa2\x52\x16\x65\xdf\xb6\x62\xb0\x51\xa4\x67\x0c\xcd\xc4\x5a\x3f\x16\x70\xb9\x94\xe1\x99\x74\xea\xcd\x02\xd4\x74\x0b\x0e\x47\x9d\x40\xaf\x28\x97\xab\x65\x3c\x95\xfd\xee\xf6\xc7\xee\x50\x74\xe3\x2c\xee\x0e\xc4\xc3\x3f\x8a\x32\xb6\x59\x1b\xc8\x6b\x3e\x90\xae\x58\xe1\x8c\xfd\x21\x2b\x25\x97\x70\xf0\x3b\x9a\xc6\xe9\x2c\x01\x7d\xdd\x52\x37\x9c\xdf\x3a\x9f\x5c\x70\xb7\x5f\x83\x7f\xc3\x55\xa4\x42\xdf\x5b\x2e\x23\xff\x75\x8b\x08\xee\x83\x2a\xab\x88\x72\xb9\xa0\xb7\xc3\x70\x09\xf1\xd6\x0e\x5d\x74\xe3\xc5\xe3\xa3\xb3\x56\x50\x3a\xad\x16\xf5\xc8\x9c\xe5\xa2\xfc\x88\xa3\x05\x87\x01\x1f\x23\xa6\x7b\xaa\x01\x57\x06\x39\x33\x9a\x1e\x00\xd1\xb2\xcc\x88\x92\x2e\x00\xd2\x4f\x63\x66\xb4\x50\xd7\x4b\xc3\xe8\x2b\x50\xc1\x3a\x7c\x28\xac\xa8\xfc\x18\x29\x35\x29\x5c\x48\x65\x59\x48\x78\x52\x25\x08\xb7\x88\x69\x9a\xeb\x1f\x6a\x32\x89\xfd\xc0\xee\x90\xe5\xdb\x12\xe4\xff\x1a\x04\x27\x2f\x55\xc6\x4f\xe5\xc7\x5b\xb3\x12\x3e\x37\xf4\x45\x2c\x85\x0f\x66\x35\x72\x16\xe2\xbf\x1d\x7b\xf9\x88\x1d\x2e\x43\x8c\x96\xd5\x30\xa1\x85\xdf\x42\x50\xd5\x31\x0b\x40\x0d\x26\x7e\x45\x1b\xb0\x5f\x2d\x68\x7d\x95\x3e\xa8\x19\x5a\x7c\x0f\xea\xea\xf2\x6a\x19\x97\xc9\x07\xf9\xb7\xb8\xf8\x19\xae\x7d\xd6\xa3\xaa\xc0\x7a\xb8\x16\xad\x18\x10\xc2\x4a\x4f\xcf\x5b\x89\xe0\xc0\xea\xec\xac\xf7\x3c\x83\xbd\x66\x55\xb1\x4e\x2b\x13\xb3\x2d\xc6\x85\x62\xf5\x7a\x3c\xc0\xb2\x15\x37\x28\x4b\xb1\xa6\x8d\x26\x80\x1a\xdd\xcd\x59\x5e\xed\xcc\x1b\xb9\x5c\x21\xdc\x80\xc7\x5d\x4c\x8c\xad\xf5\x46\xdd\xf8\xb8\x41\x88\x71\x0a\x15\x06\xed\x24\x03\x18\xbc\xa8\x0d\x80\x20\x2b\x7e\x4c\x2e\x93\x32\x30\x84\x2e\xc0\x60\xe2\x96\xaa\x19\x77\x27\xdf\x29\x53\x24\xbf\xcb\xda\x02\x90\xe9\x40\x43\x70\xc8\xa2\x8c\x2f\x57\xb5\x45\x0c\x04\x96\x4b\xe6\x98\x91\xd6\xcd\x5c\x27\xbb\x0e\xad\xb1\xae\x11\xfd\x74\xf2\x2c\x99\xcf\x93\xe9\xd5\xb2\xbc\x09\x8a\x51\x1f\x68\x30\x71\x8b\x97\x59\x19\x2f\x5f\x6e\x82\xc3\x83\x1c\x4c\xaa\x2e\xf3\xda\xcf\x0f\xc1\xed\x64\x77\xe7\x4f\x35\xbb\xaa\x16\x25\xa5\xbc\x44\xf1\x47\x9f\x64\x5e\x71\xb3\x55\x50\x96\x8a\xc1\x59\xc6\x64\xae\xe1\x4a\x5d\xb0\x6d\xba\xa9\x76\x6e\x34\xd9\x96\xd9\x22\x38\xab\xf8\x0c\x0f\xcd\xa9\x65\xb6\xa0\x4d\x6b\x68\x62\xc1\xa1\xa2\x3b\xb9\x38\x42\x3e\xb5\xd8\x31\x58\x32\x07\xb9\xe3\x48\x77\xd3\x4f\x2f\x23\xc0\x69\x7e\x51\xc3\xc0\x90\x51\x11\xdf\x0e\x62\x3f\xb7\x06\x7b\x05\x89\x53\xc5\x32\x5b\x04\x50\x53\x6a\x0d\x4a\x53\x68\xe2\x8c\x9f\x3a\xda\x6b\xb7\x5d\x5c\x5f\x24\xc5\x4a\xe6\x62\x05\xc1\x7a\x6f\x6f\xbc\x78\x93\x15\x65\xe3\x20\xb3\x0e\x37\x0d\x36\x0d\x74\x15\x29\x1f\x68\x68\x24\x17\xa1\xf0\x3b\x5a\xc5\x37\xea\x9e\x8d\xa5\x0e\xd8\x45\x79\x16\x8e\xa3\x4a\x2a\xcb\x65\x50\xad\xa7\x4c\x0e\x0b\x17\xc4\xdf\x67\x10\x76\x58\xd6\x97\x61\x40\xbc\xec\x2a\x4f\xb2\x3c\x29\x6f\xea\x0b\x12\x04\x13\x69\x73\xfe\x10\x99\xe3\x59\xaf\x45\x8b\xaa\x10\x83\xee\x70\x99\xc2\xd2\xc5\xbe\xf3\xeb\xb9\x38\x61\x3f\x4f\xc5\xd8\xb8\xb5\xe0\x6c\x87\x5a\x15\xa9\x15\x63\xcc\x33\xb8\x44\x06\x7e\x5c\xb8\x73\xe9\x34\xa2\x6f\xbe\x16\x03\x9e\x74\x9a\x99\x44\x96\x2e\x6f\x60\x87\x06\x4e\x1d\xfc\xb6\x0e\xf2\xaa\xbe\xfc\x12\xbc\xe8\x45\xd7\x66\xc4\x98\xd1\x51\x5d\xe2\xe2\x17\x66\x7c\x6f\x28\xe8\xf3\x46\xb2\xcb\x9c\x0f\x42\x09\xf2\x5a\x0e\x4a\xb3\x36\x4e\x0f\xb3\xb5\x7b\xce\xd4\xcc\xd8\xc8\xd7\xd0\x12\x38\x64\x4f\x72\xbe\x1c\x39\xac\xa3\x73\x39\xbb\x15\x3a\x98\x50\x10\x1a\xf2\xea\xa6\x40\x99\xb5\x4d\x00\xc5\xd9\x21\x8d\xc2\x83\x18\x4c\x42\xb3\xf2\x6d\x7c\x2d\xf6\x9d\x94\x20\x18\x43\x7f\x50\x4c\x93\xc4\x9f\xbe\x54\x86\xaf\xb6\xff\xab\xc8\x52\x17\x8e\xa6\xc4\x68\x64\x67\x05\xe6\x89\x7d\x1d\x6c\x5b\xbd\x8e\xe7\x63\xc7\x32\xb7\x98\x11\xd0\x8e\x2d\x36\x11\xea\x27\xa3\x9d\x6e\xd5\xbc\x8d\x67\x12\x72\xb5\x4b\xa2\xcd\xf8\xdf\x08\x55\xdf\x5a\x14\x7a\x48\x87\xba\xa1\x0a\xe9\x63\x5d\x70\xa5\x81\x13\x5e\x03\x42\x0b\x96\xe8\xc3\x31\x6f\x94\x14\x7f\x87\x08\xe7\x7d\xf5\x80\x1e\xa6\xbc\x4c\x72\x88\x23\xe0\xa8\x3d\x6e\x80\x39\x05\x88\x18\xc9\xd6\x86\x5e\x3f\x7c\x88\xe1\x74\xd7\xbe\x4e\x45\x2d\x08\xa0\xc5\xac\x5a\x44\x1b\xa0\xc0\x96\xb9\x98\x3a\x8d\x96\x4d\x84\xed\xe1\x91\x23\x9b\xe8\xef\x6e\xd2\x69\x92\x86\xb5\x15\x8c\xf2\x8e\x2d\x80\xee\x62\x54\xf9\x40\xa3\x28\xca\x95\xa3\x9e\x41\x92\xbe\x08\x91\xa4\x0b\xb5\xe1\x08\xcc\xce\x10\xd8\x60\xc2\x31\x60\x90\xaf\x16\x04\x1c\xca\x2d\x7f\x91\x2c\x2e\x64\xd1\x56\x9e\x43\x61\x79\xe8\x32\xe6\xfe\x96\x66\xd7\xe9\xbb\x32\x2e\xdd\xcb\x3c\xd5\xdc\xfa\x0a\x38\x8a\x89\x8f\x61\x75\x05\x57\x78\xdb\x50\x70\xa8\x81\x47\x6c\x67\x18\x82\x5e\x50\x38\x8e\x2d\x27\xe6\xe3\x56\x88\xa1\xc5\xd3\x80\xa0\xa5\xa4\x73\x98\x3a\x0e\xa4\x31\xd8\xd0\xde\x60\x5c\x9f\xc5\x4a\xe2\x74\xf2\x4b\xf9\xc9\xac\x84\xb3\xf2\x8d\x03\x69\x1a\x16\x37\xd1\xb4\xf5\x62\x25\xea\x72\x78\xb9\x70\x87\xea\xf3\x6a\xca\xfa\xc6\xa9\x71\x3b\x08\xc7\xe4\x9a\x00\xc6\xc1\x54\x0e\xcf\x77\x35\xe3\x40\x1a\x87\xf5\xc8\x18\x48\xe4\xd0\xbe\x14\x1a\xd7\xa4\x77\xd6\x15\x1f\x3e\x7d\x98\xde\x1d\xef\x7c\x53\x17\x98\x0a\x44\x78\x77\xbc\x07\xd1\xa6\xf6\x76\xee\x1f\x7f\xb8\x7f\xfc\xe1\xcf\xf2\xf8\x03\x71\xfa\x17\xb9\xf0\x05\xfc\xf1\x36\x7f\xd8\x61\x43\xcf\x3d\x75\x2b\xca\x85\x1b\xe9\x34\x82\xb0\x7e\x88\x2e\x94\x4d\xdf\xf8\x85\x88\xcd\xdf\x74\x88\x97\xcb\x91\xf7\xe6\x29\x58\x3e\xa9\xab\x86\xae\xd5\x97\x1e\xe8\x92\x81\xab\xb2\x66\x71\xe3\x0b\x0f\xa1\xe7\xe1\xce\xd4\xba\xf3\x1e\x9f\x1c\xe0\x8f\x22\x37\x84\x8f\xae\x79\x0e\xc0\x22\xc5\xd5\x81\x63\xd5\x49\x5f\x87\x16\xee\x95\x95\xf0\x8a\x24\xe2\xa4\xdf\x1c\x86\x1e\x4d\x46\x10\xfc\x79\xe7\xaf\x53\xc0\xfa\xe0\x0f\x06\xbb\x45\x82\x91\xf8\xe9\x4e\x85\x3b\x4c\xf0\xe0\x32\x76\x9b\x6b\xe4\xb7\x78\x0a\x1c\x29\xc5\x9e\x4e\x84\x3d\x9a\x96\xf5\x4a\x10\x40\x8e\x7e\x78\x62\x0b\x95\xb7\xcd\x1a\xcc\x95\xb1\xc6\x46\xf3\xe6\x7d\x57\x61\x25\xca\xe1\x0f\x41\xe2\x6d\xd7\x6a\xe7\xc2\x7d\xb3\x13\x2f\x6a\x53\x13\xfb\x96\x0e\x1b\x12\xc2\xf5\xc2\x75\x9f\x7b\x64\x37\x1d\x83\x8f\x9a\x25\xf0\xd4\x6b\x9e\x57\x23\x8c\xaa\x1d\x50\x5c\x4a\x78\x3f\x8d\x5b\x43\xcc\x33\x20\x88\x41\xe5\xa9\x5b\x19\x85\xf8\xa4\xae\x60\xac\xa1\xbe\xa4\xc4\x3b\x3e\x4e\x10\xd2\xc0\x50\xf1\x7a\x6a\xc7\xc8\x6e\x72\xf3\x85\x79\x65\xb3\xe2\xb6\x10\x5b\xc2\x83\xd5\x4b\x65\x2b\xb2\xe1\xce\x1e\xed\x93\x6a\xf1\x04\x43\x95\xa5\x82\x5a\x6a\x61\xcd\x57\xb4\x2d\x3a\xce\xd8\x6d\xf5\x45\xdf\x1f\xf6\x81\x09\x50\x0f\xfb\x62\x84\xb7\x1f\x03\xaf\xa8\x45\xe0\x3a\xd8\x74\x23\xbc\x3d\x94\x70\xca\x78\x05\x06\xae\x38\x81\x69\x67\xa8\x43\x8c\x08\x07\xea\x35\x8c\x6c\xc9\x44\x7b\x5f\x7c\x9c\xcf\x7b\xbe\x8e\x46\x0d\x86\xc6\x6c\xe2\xc3\x1c\xe7\x05\x64\x26\xa3\x0c\x18\x53\x1d\x56\xe1\x1c\x56\x66\x6f\x34\x58\x80\xbd\x2a\x8b\x06\x5e\xe9\x0f\xd8\xe5\x50\xd5\x8a\x97\xa6\x56\x63\xc8\x69\xe6\x3c\x53\x7f\x23\xdb\x11\xef\xd8\x5b\xf5\xb7\x12\x18\x3e\xe3\xb4\xc9\x0b\x5b\x5d\xc3\x53\xb5\x64\xb6\x48\x0a\x67\xfa\xf4\x03\xef\xc6\x96\x59\xf8\x1d\x08\xca\xc7\xb8\xf4\x35\x6f\x3c\x84\x9e\x0a\x28\xfa\x7e\x77\x9d\x07\x6b\x71\xd2\x20\xfe\x49\x33\xbb\xc0\xf5\x44\x43\x7a\x53\xaf\xc7\x27\x3c\xbd\x12\xd5\x2c\x5c\xbc\x71\xdc\x0d\xa0\x33\xee\x34\x08\x35\x2f\xb6\x60\xb0\xf9\xed\xa1\xf8\x86\xac\x41\x0d\x55\x5c\xa5\xab\x78\xfa\xdb\xb1\x52\x12\x9c\x5a\xb4\xde\x40\x75\x01\x27\x6d\xb9\x49\xb6\x09\x34\xd4\x6c\xbf\x07\x0e\x8f\xea\x0b\xb1\xc4\x77\xfb\x62\x57\x3c\xa7\x44\x8a\x87\x2f\x68\x1f\x38\xa9\x46\xb1\xaf\x0b\x87\xcf\x95\x9c\x21\x16\x77\x47\x14\x6d\x29\x58\x33\xc8\xb3\x1d\xf3\x30\xe2\xc9\x36\x9c\x3c\x70\x2b\x0a\x0e\x39\xa8\x0c\x78\x87\x1e\x1f\x62\x27\x62\x79\x83\x0c\xd7\x19\xf9\xe4\x8e\xa2\x88\xe6\xb7\x89\x50\x6d\xc8\xc8\x25\x8a\x29\x41\xb9\x6b\x71\xa4\x97\x3b\xf5\xe4\x33\x81\xc2\x6a\x14\x9b\xda\xe1\x6d\x70\xe6\x69\x4a\xc9\xd0\x00\x7d\xb3\xd2\x6c\xba\x62\xe7\xb6\x20\x9c\x9f\x39\x31\x75\x08\x6c\x05\xae\x65\x2a\x4f\xe3\x50\x02\x55\xbd\x5d\x81\x64\x0b\xf2\x2e\x8e\xaa\x7a\xd3\xb9\x8d\x6b\xa1\xca\x20\xc3\xde\xf6\x51\xec\xaa\xbb\x1f\xac\x9b\xd8\xb4\xd8\x73\xec\x33\x6b\x0c\xd4\x62\x7a\x8d\x12\xc5\x53\x0b\xb9\xf8\x01\xf0\x99\xab\x7d\xf1\x22\x5c\xfd\xf1\x8b\x91\x14\xc7\x12\x46\x4a\x13\x9c\x3d\x96\xdf\xa2\x06\xf1\xc9\x63\x6d\xb4\x84\x01\x1e\x92\xd6\x44\x40\xd4\x43\xa7\x69\x83\x89\x37\xf1\x74\x29\x3e\x85\xfb\xce\x7c\x58\x77\xea\xaf\x4e\x6f\x52\xa1\xbd\x3a\x2c\x28\x96\x07\xe2\x67\xdd\xc0\x9b\xc3\x10\xd1\x03\x5b\x45\x9d\xa5\x32\xca\x19\x6f\xd2\x71\x3a\xae\x1b\x2d\xdd\xc0\x26\xf0\x29\xf3\x1b\x86\x1c\xfe\x63\xa0\xea\xea\x68\x7d\x7f\xed\x8a\x03\x9f\xa9\x0a\x36\xd0\xaf\x84\x2c\x52\xad\x82\xb8\xec\xbc\x90\xf9\xea\x37\x9e\x6a\x27\x92\x0e\x5a\x16\x8a\x4d\xf6\x95\xfe\xb2\xe1\x16\x69\x5d\x15\xbc\x1a\xfe\xa5\xa6\x1a\xf2\x59\xfd\x9c\x21\x96\x42\x40\xf4\x4b\xfc\x4e\x6c\xab\x4d\x8d\xb3\xd7\xe4\x03\x5b\x39\x19\x39\x8c\x53\xd0\x9c\x61\x38\x30\x18\x28\xf8\x36\x65\xe9\x23\x2c\x6c\x88\x66\xdf\x90\x6d\x9e\xae\x48\x0c\x3b\x6f\xbc\x61\xa3\x16\xbb\xe8\x5a\xc1\x9d\x78\x0b\x8d\x9c\x25\x8b\x32\xb9\x8c\x4b\xf0\xb3\x03\xa3\x5e\x1b\x57\x31\xf0\x36\x8e\xe2\x98\xef\x82\x9b\xfe\x78\xee\xd8\x6c\x84\x58\xbf\xda\x46\x27\x00\x5a\x37\x32\x6f\xa9\x1a\x49\x0f\xc9\xa9\x17\x8c\xc8\x16\x88\xfa\x01\x1f\x8a\x85\x2c\xe1\x49\xa0\xe0\xca\xea\xa3\x69\x1b\x2b\xc4\x75\xc7\xe3\xb4\x11\xe1\xb1\xe9\x08\x8b\x6f\xe4\x6c\xae\x35\xe3\x5b\x17\xa1\x37\x1b\xdb\x9e\xa3\xe4\x45\x89\xce\x84\xb7\x8d\x60\x0c\x77\x90\x68\xa1\x97\x1c\xbf\xfc\x6d\x4a\x83\x9f\xbf\x31\xc9\xbb\x4a\xef\x7b\x84\x99\x81\x0a\xdd\xb6\x93\x84\xf5\x8f\x78\xab\xd2\xda\x68\xa0\x83\xf9\x6a\x4a\x61\xd0\x0a\xbd\x6b\x6e\x13\x44\x08\x5d\xef\x95\xd2\xd0\x2f\x2c\x7b\xc7\xdc\x4e\xf3\x9a\xd8\xfd\x2b\xa5\x12\xc1\x69\xd3\x99\xd8\xaf\xaa\x65\xce\xdb\x33\x7c\x4c\xaa\x71\x03\x3d\x8b\xeb\x73\x15\x8c\xf8\x0c\x5a\xdc\x13\x63\xfd\xc3\x5b\x49\x7a\x36\x9a\x20\x75\x6c\x6c\xbe\x05\x22\x0d\x62\x77\x30\xc6\x20\xfc\xa7\x1b\x4e\x71\x6c\x29\xf2\x61\x0b\x33\xe0\xab\x34\x75\xa3\x8e\xd9\x6d\x03\x4c\x58\xea\x06\x98\x3b\xed\xed\x8b\x2d\x97\x3a\x48\x4b\x08\x8c\x05\xcb\x3c\x83\x35\x0b\x05\x4b\x23\xb4\x8c\xfc\x0a\x9d\x47\xce\x28\x5e\xad\x96\x37\x6a\xb0\x86\x9b\x31\x96\xbb\xa6\x80\xaf\x99\x5a\x02\xbc\x6a\x60\x44\xbe\x08\x77\xcb\x38\xe0\x73\x4c\x86\x7c\xec\x45\x26\x6f\x48\xfc\x87\x94\xf8\x9e\x94\xd2\x5a\x67\xa4\x8f\xc4\x19\x39\xaa\x98\x8f\xa0\x1d\xe1\xf0\x63\x46\x48\x3d\x4a\xb7\x33\x9e\x87\xce\xaa\x05\xc7\xcd\xa6\xa5\x70\x1d\xa0\x37\xca\x62\x3f\x3c\xf8\x75\xc5\x99\x7e\x40\x45\x59\x52\x6d\x31\xbb\x5a\x53\xc8\x27\x50\x04\xfe\x25\x9f\xa2\xa2\xb2\x60\xe8\x9f\x26\xf9\xf4\x6a\x19\xe7\x5b\x5b\x5b\x5b\xe1\x80\x3c\x3e\x07\x4d\xcc\xa9\xfe\xd7\x3c\x41\xa5\xb6\x6e\x10\xd0\xe7\x69\x38\xa0\xcf\xee\xfd\xe9\xff\xfd\xe9\xff\xbf\xf7\xe9\x3f\x1e\xfd\x5f\x94\xe5\x8a\x9e\x08\x0b\xbf\x6c\xf2\x5f\xf6\x66\x49\x28\xd2\x4f\xb3\x23\x00\x3c\x95\x06\x0e\xbe\x71\x9e\x5d\xc1\x3a\x9e\xc1\x06\xc2\xe9\xa2\x48\x60\xff\x30\x9f\xcb\x1c\xbc\x8b\x65\xfa\xa1\x80\xe7\x32\xc4\x39\x1c\xe6\xc9\xbc\xc3\x02\xbc\x5e\x27\xe9\x2c\xbb\x16\x5b\xee\xcb\x1f\xe2\xc1\x03\xcc\x89\x7e\xf9\xe9\xc7\x1f\xca\x72\x85\xb1\x83\xf5\x4a\xe5\xa6\x89\xfd\x30\xac\x3a\xe3\xc4\x87\x2b\x92\x45\x9a\x81\x20\x58\x26\xa9\x84\x96\xa4\xd9\x4c\x76\xd0\xdd\x33\x88\xd1\x74\xfc\xe3\xe5\x12\x7a\x86\x0b\x5b\x6f\xb0\x69\x25\x6b\x4d\xc9\x5f\x7e\x78\xbb\xeb\xa0\xbb\xc8\x77\x7b\x83\xda\x52\xa4\x39\x40\x0d\x6f\x88\x98\x55\x97\x08\x58\x06\x95\x6a\x0f\xeb\x69\xa1\x83\xab\x43\x2b\x8d\x03\x84\x53\x9e\x2f\xf9\x17\x59\x51\x0e\x05\xdc\xb4\xca\xae\xca\x21\x0c\x5c\x3e\x54\x46\xe6\xeb\x2c\xc7\x00\x35\x6a\x31\x01\x38\xb1\x2f\xd4\x3f\x9f\x3f\x8b\x1e\x32\xfb\x32\x9b\xc6\x4b\x48\x1c\x7f\xf3\xe4\xf1\x93\xde\x84\xc0\x11\x21\xac\x84\xf8\xed\xf3\x67\xb1\x6d\xb2\xa1\x1a\xf0\x25\x28\x64\x6e\xd2\xa8\x52\xb1\x6f\xea\x6f\x55\x9e\x56\xb9\x5c\x81\x0c\x05\xc3\x91\x3b\x0e\x9e\xee\x84\x80\x36\xd3\x68\x4e\x2f\xb2\x6c\x29\xe3\x14\xee\x97\x5c\xa9\x48\x3b\x38\xb2\x24\x39\xc8\x34\xef\x6c\xf8\xdc\xba\x9c\x58\x50\x9c\xd2\x4c\xef\x72\x1b\xe0\x68\x5d\xca\xf2\xaf\x49\x0d\x1c\x82\xf5\x2b\xe5\x38\x99\x3b\xd9\x82\x32\xd1\x9d\x1a\x98\x89\x34\x0b\xcc\xe1\x94\x67\x03\x31\xe9\x18\x6f\xe6\x20\x22\xa7\x3f\x1a\xa5\x32\xa8\x11\xd6\x6c\x25\xd3\x7e\xef\xcd\xf1\xbb\xf7\xf4\x30\x29\x0c\xfa\x50\x93\x46\x81\x9b\xc8\x8a\x6a\x6c\x1f\x3c\x70\x07\x95\xda\x0f\x1d\x04\x39\x07\x27\x81\x2f\xe2\x22\x99\xaa\x40\x0e\xd0\x84\x17\x57\x20\x1e\x18\x8a\x87\xa2\x37\x36\x47\x85\x06\x4f\x54\x66\x78\x69\xad\x77\x1e\x17\xf2\xe9\x63\xb2\xff\x51\x4b\x6d\x5c\xf1\x1f\x64\x3c\x93\x79\xbf\x77\xa0\x82\x9d\x25\xbf\xab\x18\x1f\xbd\xa1\xaa\x5f\x15\x5a\x37\x14\x02\xfd\x58\xa6\xe5\xa3\xf7\x37\x2b\x15\xc2\x00\x34\xff\x64\xaa\x50\x8c\xc0\x43\x46\x57\x8b\xfc\x80\x68\x5a\xd9\x55\xbf\x40\x73\x93\x9a\x2d\xb8\xc7\xa5\x30\x8d\x39\x6f\xd2\xc1\x14\x6e\xf9\x1c\x16\xa4\x3c\x7d\xec\xd3\xc4\x7a\x85\xff\xf4\x24\xa2\xab\xb0\x1c\xf1\x8c\xcb\xab\x7d\xed\x4d\xa0\xf8\xd1\x5a\xf7\xb1\x88\x42\xde\x57\x17\x22\x40\xbb\x48\x17\xc9\xfc\xc6\xd8\xea\x90\xc8\x64\xc8\xe7\x61\xc3\x43\xde\x00\x87\x59\x9a\x4a\xbc\xb6\x40\x1c\x66\x39\xd1\x39\x5c\xa4\xda\xe9\x01\x8f\xf7\xf2\x63\x59\x69\x20\xc2\xb2\xdb\x1a\xe8\x1d\xef\x36\xab\xa9\x49\x6f\x11\x7f\x3f\x54\x21\x9f\x25\xec\xe0\xb2\xc5\xca\xa4\x78\x20\x6e\x61\x82\x03\x23\x71\x1a\x38\x01\xb3\xbe\xaf\xbc\xde\x22\xca\x3c\x59\x2c\xe0\x15\x53\x91\xa5\x02\x46\x1f\xb4\x35\x7c\x21\x05\x69\xd0\xc6\x30\xaa\x05\x21\xae\x61\x66\xd5\x0d\xf9\x07\x04\xab\x36\xfe\x22\x5c\x94\xa5\x2a\xd6\x77\x01\xde\xf4\xd3\x8b\x38\xf5\x1e\xe7\xa0\x11\x01\x91\x42\x45\x54\x01\xe5\x58\xaf\xbc\x55\x1e\x83\x46\xe0\x8b\xbc\x2d\xf7\xdd\xe7\x0d\x38\xc6\x02\xd2\xa9\x12\x7b\x52\x8c\x73\xd3\x06\x1c\x25\x44\x88\xab\x9c\x13\xab\x5b\xf2\x16\xb3\x4a\x54\x0f\xb6\x9c\x9a\x61\x2f\xb8\xf6\x48\x4c\x54\x09\x51\xd6\x45\x56\x44\x76\xea\xbd\xd7\xa5\xf0\xe9\x7a\xfd\x43\x47\xde\x5d\xdf\xad\x04\xf0\x9b\xd0\x24\x04\x70\xa6\xf1\x79\x65\x0f\xcb\x97\x37\xc0\xef\xb2\x30\x93\x0b\x56\xb2\x9a\xb9\x95\x14\x88\x5f\xba\xc2\xd4\x28\x01\x64\xe0\xf4\x95\x81\x8b\xf8\x83\x4c\x7b\xa5\x98\xc7\xc9\x52\xce\x22\x71\x0c\xfb\xb4\xeb\x04\xf6\x1e\x20\x1d\x9b\x66\x13\xab\x33\x30\x16\x96\x9e\xc6\x9e\x61\x5e\x23\x49\x66\x63\xf1\xad\xf9\x23\x7b\x1f\xac\x3e\xf9\x6a\x3a\x16\xbd\xdd\x68\xdb\x18\x0f\xc9\xfe\xd8\x4b\x65\x79\xb6\x4c\x8a\x52\xa6\x49\xba\x30\xd9\xc6\x7a\x78\xea\x38\x74\x61\xff\xa1\xb7\x61\xa1\x88\x00\x6a\x09\xb0\x83\x50\x31\x17\xf0\xae\x93\xa9\xc0\xd9\xed\x7f\xbc\xc8\x77\xbb\xe3\x6f\xe0\x9b\xa3\x3f\x77\xc7\x3b\xcf\xc0\xef\x7f\xef\xde\xef\xff\xde\xef\xff\xcf\xe2\xf7\x0f\x17\x5e\xee\xca\xe7\xdf\xf8\x71\xe2\x1a\xa7\xf6\xa8\xe7\xc9\x42\xbb\xe0\x46\xbf\x2a\x5f\x7e\xb5\x05\x5c\xc5\xb3\x1f\xe5\xdc\x5d\x0f\xcc\xd3\x9f\x37\xec\xde\x9c\xb3\x6e\x6a\x08\x98\xfa\x42\x5c\x5f\x40\xeb\xfb\xae\x7f\xd6\x5f\xb5\x57\x91\xf8\x8b\xd8\x1d\x84\x16\xcb\xde\x36\x28\xf1\xa4\x1a\x59\xc3\x65\xbd\xce\xf4\x06\x37\x75\x71\x2a\x8e\x5e\x1c\xbc\xc6\x31\x9e\x89\x6f\x9f\x09\x78\x6c\xf2\x0a\x9f\xdd\x39\xbf\x11\x97\xd9\x07\xb8\x61\x6e\x1f\x97\x7b\x2c\xa6\x17\x71\xae\x96\x02\x48\x04\xd5\x27\xa6\x93\x05\x38\x1d\x21\xe8\xa5\x54\x76\x47\x51\x66\x50\xa1\xa6\x55\x21\xfa\x07\x62\x5f\xec\x6c\x0f\xc5\x0b\xf8\x77\x67\x28\xa2\x28\x1a\x8a\x7f\x8a\x7d\xb1\xf7\x64\x00\x7b\x1d\x51\xac\xe4\x34\x99\x27\x7a\x1e\x1d\xbd\x3b\xde\xd9\x7b\xba\xf3\xd4\xf7\x30\x4b\x8a\x4c\xa5\x63\x3f\xb8\xae\x46\x07\x85\xc0\x01\x8a\x03\xa1\x83\x6c\x25\x2a\xd8\x59\xe2\x05\x3c\x22\xaf\x30\xcc\x08\x6c\x84\xc1\x80\x1d\xfc\xce\x80\x02\x62\x3e\x8e\xd0\xa3\xde\x41\x2f\x02\xb2\x1c\x66\x33\x79\x50\xf6\xb7\x51\xf6\x43\x36\xf4\xad\xf7\xcf\x4a\xb6\xca\x07\x54\x10\x20\x0e\x98\xb5\xcc\x7e\x5e\xad\xdc\x80\x76\x3c\x5b\x07\xc9\xeb\x3f\x1e\x88\x87\x4e\xc2\xf6\xf0\x31\x61\xc3\xe1\xd6\xb9\xab\x65\x52\xf6\x7b\xbd\x81\x73\xab\xa1\x9f\xb2\x7b\xca\x74\x9d\x05\x36\xba\xa1\xc6\x93\x7a\xa8\x60\xbe\xdb\x17\x07\xe2\xc1\x03\xe5\x41\x2a\xfe\xba\x2f\xfe\xc9\x10\xe1\x91\x4e\x60\x64\x71\x60\x1d\x48\x6c\xa5\x42\xf4\x48\x1c\x88\x87\x62\x67\xdb\x56\xe8\x6c\xa0\xbd\x22\xe8\x45\x69\xf9\x7b\x3d\x88\x7e\xcd\x92\x14\xba\xe9\x70\xf7\x61\xbc\x04\xdb\x3b\x4c\x19\x60\x80\x9f\x8e\x5f\x02\x63\xef\x6c\x93\x4c\x42\x07\x3f\x18\xf1\x10\xc7\x3d\xdb\x7e\xfa\xd8\x67\xb8\xcb\x6c\xf6\xed\xb3\x9d\xed\x3a\x46\x73\xf9\xcb\x86\xb5\xd6\xdc\x84\x85\x1b\xb9\x28\x97\x97\x31\x84\x54\xc8\x71\xc0\x87\x6e\x0c\x9a\x49\xc7\x15\x0f\x08\x6c\x7c\x47\xc5\x2e\x1b\x90\x73\xf4\xd2\xb3\x60\xc6\xe1\xf5\x5b\x36\xb8\x26\x5b\x99\x83\xf2\x42\x1e\xa5\xa5\x8e\xfe\x33\x14\x3b\xdb\x03\xf1\x3f\x81\x6a\x0f\x2b\x58\x14\x04\x56\xec\x9e\xeb\xe1\x38\x19\x5c\xa6\xa4\xc5\xe7\x8c\x53\xfb\x35\x24\x88\x1a\xaa\x85\x39\xf9\xfb\xab\xf3\x74\x95\x80\x57\x1d\xf0\xa9\x5d\x1a\xaf\xf0\xe0\x8c\x78\xf0\xf3\xf0\x20\xa0\x23\xb4\x9d\x72\xd5\xa6\x22\x2f\x6c\xde\x4e\x79\xa9\xde\xa9\xa0\xbb\x39\x1e\x4f\x41\x57\xf0\x16\x6e\xa8\xe9\xb6\x10\xb1\x96\xf8\x04\x3d\x58\x1b\xa9\x86\x75\xa9\x95\x0a\x72\x22\x86\xd1\xe9\x27\xa2\xe2\xfc\x16\x17\x2f\x28\x62\x00\xbb\xa7\xa0\xe1\x86\x62\xe7\x29\x8e\x2c\x80\x9e\xc7\x85\xdc\x7b\x2a\xf6\x55\x19\x6b\x1c\xda\xe3\x30\xab\x78\x06\xee\x39\xc0\x48\x6a\x09\xec\xeb\x42\x43\xb1\xf3\x64\x30\xe1\xcc\x61\xda\xf9\x02\xe2\x14\xe8\x62\xae\xec\xf3\xe6\x32\x06\x25\x61\xf3\xf6\x05\xcc\xdb\x32\x73\x16\x2f\x18\x3d\xa1\x82\x48\xe7\x37\x34\xa1\xb5\x29\x09\x66\xb2\x78\xa3\x5f\x06\x49\x21\x94\xa7\x52\xc9\xfe\x9a\x7c\xd7\x5d\x28\xc5\x12\x60\x48\x30\x28\x74\xc5\x05\x1c\x98\x9e\x03\xa0\x14\xf2\xe3\x74\x79\x55\x24\x1f\xcc\x43\xa6\xc9\x79\xb2\x04\x47\x45\x2c\x71\x1e\xa7\xbf\x8d\xce\xf3\x38\x9d\x5e\x88\x42\xe6\x1f\x92\x29\xad\x7f\xb1\x0e\xb8\xdb\xfd\xeb\x28\xf9\x2e\xf2\x59\xc8\x3c\x2a\x52\xd0\x22\x34\x97\x39\x18\x13\xe2\xe5\x02\x22\xd1\x5c\x5c\x8a\x99\x2c\xa6\x79\x72\xae\xd7\x41\xd4\x7e\x64\x1a\x5d\x27\xbf\x25\x2b\x78\xd2\x4a\xa9\x40\xf0\x6b\x74\x94\x96\x32\x4f\x55\x67\xe3\xe5\xd9\x8b\x38\xfd\xed\x0c\xa3\xfd\x9e\x69\x49\xf4\x3f\xfe\x86\x3d\x4d\x17\x67\xd0\xc5\x33\x15\xf8\xf4\x6c\x96\x2c\x92\xca\x45\x0e\x1a\x9a\x10\x47\x9e\xd3\x92\x4a\x23\x40\x11\x63\xca\x8c\x96\x4f\x62\xd5\x62\x23\x5e\x7d\xe1\x4f\xc8\x73\x36\x21\x81\xad\x70\x44\x61\xdd\x84\x65\xf4\x97\x57\xbd\x49\x27\x28\x32\x51\xc4\xf6\xbd\x85\xbb\xcf\x11\x3c\x14\xbd\x6d\xa5\x29\xa9\x5a\x18\xf3\x2a\x72\xbc\x04\x6a\x40\x1c\x65\xad\x4d\xf5\xbf\xfd\x46\x3c\xb2\x55\x0c\x06\x28\xfd\x1e\xed\x7a\xcb\xad\x89\xb9\xe1\x56\xc5\x70\x62\x85\x2d\x46\x2b\x94\x21\x8c\x56\x8a\xb1\xf5\x73\xf2\xf0\x66\x54\x52\x5e\x99\xa0\xd5\xc9\x4c\xa6\x25\xac\x59\xb9\x37\x7c\x1a\xcb\x51\x3a\x53\x51\x3d\x42\xe6\x2d\xbc\xcc\x31\x24\x55\xd6\x5c\xb1\x51\x4f\xe7\x75\x59\x4d\x5d\x55\x55\xd7\xd6\xd5\xbd\x8d\x2c\x72\x5b\xe2\x8c\x32\x56\x48\x03\x1d\x94\x0c\xbd\x83\xe3\x83\x1e\x8b\x13\xcc\x29\xc0\x52\x4d\xdb\x5c\xa9\xf1\xfe\x22\x6b\x12\xd7\x74\x91\x4e\x13\x57\x2f\x20\xb0\x8b\x53\xe6\x11\x2e\xc6\x3d\xea\x62\xfc\x94\xd0\xdc\x50\x85\xcc\x52\x14\x30\x7b\x90\xb9\x43\x5f\xa6\xd3\x35\x19\x9d\xf3\x88\x45\x67\xa9\x5d\xa1\x60\x42\x24\x28\xb3\xa1\x80\xce\x74\xa4\x6b\x62\x43\xbc\xb4\x5a\xca\x0d\x15\x00\x4d\xfd\xdd\x41\x44\xc8\x3a\x55\xd4\xf5\x6a\x28\xb2\x80\xcd\x06\x9a\xea\xd8\x6a\xaa\xbd\xf4\x38\x61\xf4\xff\x7e\x79\x05\x81\xad\x4f\x3f\xed\xae\xfb\xaf\xde\xff\x00\xdf\x55\x84\xeb\x9d\xbd\xf5\x67\xf3\x63\x6f\x7b\xb8\xb7\xb3\x1e\xfc\xc7\x48\x47\xbc\xb6\xeb\xb7\x73\x2b\xaf\x4e\x30\x30\xf0\x81\x32\x77\xee\xdc\x92\x60\x78\x01\x33\x29\x84\x99\x6b\x2e\xd9\x5e\xda\x29\x78\x37\x74\x7b\x59\x9d\x49\x1e\xe1\x6c\xa7\x48\x31\x84\x9e\xed\x3d\x86\xa7\x44\x6a\xf2\x9e\x7c\x61\xb7\xe7\x22\x49\x6b\x3a\xee\x48\x9f\xbb\xe9\x7a\x50\x8c\x6c\xd4\xf9\xdd\xed\xd6\x0e\x82\xcf\xa6\xea\x9b\x9a\x11\xc5\xd5\x25\x34\xfc\xe7\x02\x77\x0f\x97\xd9\xec\xd1\xb7\xcf\x1e\xed\x6c\x9b\xec\x4b\x58\xe2\x55\xeb\xa6\xd9\x52\xf4\x8f\xde\x1d\x8f\x8e\x5e\x1d\x0a\xd8\x36\x8c\x77\xb7\xb7\xf7\x06\x1e\x45\x38\x5a\x3b\x85\x48\x70\xb0\xdc\x4a\xc7\x29\x6f\xc3\x6e\xe3\x96\x70\x77\x28\x76\xdb\xa7\xbf\xea\x35\x13\xaa\xce\x92\x22\xe4\x22\x12\xbf\xbc\x7d\xf5\x37\x7f\x70\x6d\x81\x70\x6f\x6a\x31\x56\x07\x95\x41\xb6\x75\xcf\x32\x40\x7f\x20\x9e\x07\xba\xfc\x6c\x28\x1e\xc3\x13\x69\xbd\xde\x46\xfd\x9e\x2e\x13\x70\x92\xb0\x0d\x54\xa6\xa7\xc4\x59\x67\x89\x0a\x7f\x3b\xf8\xfb\xf1\xf7\xff\xe7\xf8\xed\xff\xf6\x47\x55\xe1\xa8\x19\x53\x1f\x7f\x70\x70\x35\xd0\x57\xf7\x7d\x67\xe7\xf6\x3d\xc7\xd9\x64\xb7\x18\xbc\x67\x36\xb5\xbe\x6b\x15\x04\x95\xee\x61\x56\xb0\x7f\xe6\x20\x9b\x84\x5a\xdf\xb9\x37\xeb\x6c\x3a\xaa\x1d\x7e\x3c\x98\x38\xa0\xc1\xad\x0c\xed\x3f\xcc\x2e\x85\xd1\x95\xf6\x28\xee\x7e\x66\xe7\xe9\x60\x28\x76\xb7\x83\x5b\x5a\xa2\xae\xd7\x47\x2a\xdb\x3a\x88\x67\x76\x43\x59\x31\xec\x03\x4e\x0a\xe9\xc3\xad\x8a\xdd\xb1\xfb\x13\x6c\xf8\x8f\xef\xbd\xf7\xee\xbd\xf7\xfe\x14\xde\x7b\xc9\x6a\xda\xec\xbc\xf7\x15\xee\x76\x5d\xfb\x5e\x65\x77\xd2\xb9\xbb\x68\x3d\x1a\xd5\xd1\x6a\x4a\xe7\x74\x8e\x5c\x58\xc5\xe5\xc5\x50\xa4\xd2\x71\xfd\x3e\x03\xf1\x50\xbd\x75\x4a\xe7\xd4\x74\xdf\x82\x87\x2c\x40\x67\x1d\xd0\x6f\x04\xe0\xc4\xed\xa8\x4a\x9e\x9a\xa3\x5d\x25\x10\x4b\x4a\xe8\x7f\x02\x48\xbc\x3a\x01\x5f\xd7\x83\x70\xb1\x28\x4b\xfb\x3d\xd5\xa3\x1e\x7f\x15\x97\x19\x17\xe1\x66\x41\x06\x02\x0c\x80\xfa\xbd\xa3\x37\x87\x02\x0f\x5f\xa1\xd6\x57\x58\x94\xe2\x90\xc3\x47\xf5\x31\x3a\xc3\xc3\x72\x32\x6e\x37\xb7\x20\x9d\xf1\xfa\x07\x9f\x36\x41\x46\x77\x0b\x7e\x3c\x7a\xf7\xfe\xd5\x6b\x35\x79\x0e\x8f\x5f\xbf\x7e\x75\xf8\xfe\xe8\xf8\xb5\x78\xfb\xea\xdd\x9b\xe3\xd7\xef\x5e\xbd\xab\xad\x14\x6e\x6b\xf1\x5a\xe1\x37\x8d\x15\x7c\x46\x7f\x41\xf7\xc1\xcb\xf8\x23\x9c\x92\x2c\xe5\xc7\xa4\xbc\x19\x8b\xa7\x8a\xa9\xbc\x06\x2a\x23\xa8\xf1\x3f\x00\x54\x76\xa5\x19\x04\x1e\x57\x41\x5f\x03\x0c\x2b\x4b\x1f\xe0\xa7\xc4\xde\x00\x0e\x3d\xf4\x0e\xd2\x2f\x99\x81\x65\x78\x7a\x21\x2e\xc1\x0b\x00\x15\x57\xbd\xfe\x80\xfd\x68\xe6\x94\x0b\xbc\x1e\xa0\xaa\xe6\x5d\xa5\x3f\x3c\x87\xaa\xb4\x16\xbc\x54\x06\x55\x70\x44\x7f\x16\xe6\xe1\x13\x28\x15\x25\xb3\xd3\x6a\x18\x74\xfa\x53\x7d\x45\xb0\x49\xc7\x07\xa1\x23\xf5\xc6\xc3\x02\x83\x06\xdb\xee\x23\x5a\x77\x9c\x9f\x2a\xb8\x4c\x2e\x9d\x48\x00\x9d\x0d\xfb\x03\x5d\x09\xd4\xde\x00\x6e\x1f\x78\xe6\x2e\x2d\xf6\x6f\x26\x97\xb2\x94\x4d\x18\xfc\xce\xf8\xb4\xe1\xb7\xaf\xff\x01\x01\x0d\x14\x23\x22\x43\xe8\x16\x29\xb3\x8e\xf6\x91\x4a\x75\x24\x26\x88\x11\x01\x2b\x29\x3c\xc2\xdd\x21\x35\xd0\x65\xe1\x8e\x6f\xf0\x00\x96\x86\x40\xe0\x4c\xdc\x31\xcd\xc8\x2d\xcc\xe4\x60\x7f\xf6\xff\xd9\xfb\xf6\xe6\xb6\x6d\xe5\xd1\xbf\xa3\x4f\x01\xfb\xce\xaf\x96\x1a\x55\x4f\x27\x4d\xe5\xb8\x3d\x89\x93\xd3\xfa\x34\x69\x3c\x76\x1f\x67\x46\x75\x3c\x94\x04\x49\x3c\x96\x48\x5d\x92\xb2\xe5\x24\xfe\xee\x77\x16\x58\x3c\x09\x90\xf4\x23\xbd\x3d\xbf\x38\xce\x24\x26\xb0\x00\x76\x17\x8b\xc5\x62\xb1\x00\xb4\x51\x65\x6b\x40\xb5\xe9\xc1\x85\xf7\x77\x38\x95\x2c\x2e\xe6\x15\xc3\xfb\xd5\xeb\x6f\x0e\x7e\xfa\xed\x97\x9f\x5f\x1f\xcb\x3a\x26\x74\x3c\x5f\x47\xe7\x74\x82\x27\x49\x18\x6e\xa2\x2a\xf9\x02\x55\xfb\xcf\xeb\xe1\x9f\xd1\x9f\xc9\xe9\x0f\x7f\x7e\x6c\xcf\x9a\x3b\xd7\x9f\xbe\xf9\xe6\xd3\xc7\x9d\x06\x54\x7a\xfd\xd1\x09\xff\xe7\xa9\x28\x31\xc4\x32\xa7\x50\x68\x28\x4a\x9d\x0e\x3f\x16\xb6\x23\x4a\x19\x85\x86\x65\x6d\x69\x2d\xc9\x86\xb4\x32\xb8\xb7\x07\x75\xee\x08\x25\x6a\x70\x20\xaf\x5d\x80\x21\xe2\xca\x6a\x64\x23\x6c\x7c\xc2\xe6\xad\xbc\x5d\x83\x55\x50\xcb\x89\x3d\xe4\x1d\x40\x96\x39\x6a\xf1\xae\x19\x0b\x86\x3c\x66\x07\x7d\x11\xa9\xdc\x4e\xb7\xa5\xc5\x54\x60\x4e\x6e\x4b\x5b\x8b\xff\x82\x0a\x05\x99\x5a\x00\x18\x0f\xa0\x31\x4a\xdb\xc8\xec\xdb\xc8\x20\xe9\xec\x3a\x5e\x19\x35\x0d\x2e\x8e\x20\x1a\xd3\x05\xbb\x44\x1f\x83\x66\xc4\xb5\x51\x38\xe7\x2d\x68\x90\x88\xb0\x2d\xab\x15\x4c\x6e\xec\x15\xa1\x82\x40\xb0\xd9\x4f\x33\xfc\xa8\xbb\xa6\x37\x6b\x16\xb1\xa6\x39\xfd\xa7\x30\x96\x12\x79\x26\x60\xe1\xe7\x1a\x36\xe1\x3a\x1d\xf2\x35\xdf\x92\x31\xea\xd3\x2f\xa9\xb1\x74\x24\x1c\x65\xe2\xdc\x11\xfc\x02\xed\x01\x6f\xfd\x33\x79\x81\x15\x35\xf4\xaa\x84\xbf\x19\xab\x2c\x80\xbc\x88\x84\x53\x31\x3d\x39\x10\xe6\xea\xa1\xb5\x5a\xa7\x73\x01\xa5\x99\x03\x0a\xce\x00\xc7\x0b\x7f\x40\x3f\xfe\x88\xd3\x67\x30\x99\xa4\xfa\x6b\xf1\x59\x6c\xe8\x4b\xa9\x7f\xd1\x29\xdc\xac\xf1\xc9\x56\xac\x07\x70\x85\x1d\xc2\x0b\x0d\x52\xc7\xb2\x99\x58\xec\x05\xc9\xd4\x43\x88\x13\x5d\x2c\x40\xeb\x86\x17\x54\x57\xb6\xc1\x64\x72\x6c\xb5\x57\xa0\x5d\x1d\xd0\xba\x8e\xf5\xc4\x91\x6a\xf6\x04\x42\xb4\xc2\x09\xf8\x06\xf1\x6b\xd8\x39\x95\xf3\x2e\x40\x22\x6e\x0a\x1a\x13\xcc\x12\x3c\x11\x19\xee\x9f\xb8\xc8\xbe\xc4\xa6\xc8\xd0\x05\x50\xd1\xce\x3e\x62\xa0\x66\x34\x94\x20\x63\xa4\x92\xcb\x39\xae\xb0\x68\x34\xe1\x11\x71\x6c\xd1\xc5\xfc\xec\x53\xd8\xe1\xd0\xd8\x8c\x42\x5c\xc0\x5a\x21\xe6\x1a\x3b\x05\xf7\xa6\x71\x52\x07\xbe\x9c\xd3\x2b\xd8\x94\x73\x13\x20\x80\x51\x7a\xdd\x40\xad\x79\x90\xbe\xbb\x8c\x8e\xd8\x63\x47\xd9\x15\x7b\xa0\xd5\xd2\x02\xee\x82\xec\x35\x3d\x7f\x3c\xe7\x4e\x1c\x91\xc3\xa3\x83\x1d\x71\xe0\xd6\xb2\x2b\x0a\xea\xcc\xc5\x56\xa8\x61\x72\x20\x1c\xc0\x20\xca\x78\xc5\x38\x51\xb6\x33\xb0\x39\xcd\x60\x2c\x30\x6c\x34\xa1\xd6\x43\x41\xbd\x0c\x77\xc7\x6e\xd6\x7d\x26\x02\xca\x19\x7b\x92\xe9\x0a\x1e\xb4\xe2\x78\x34\xb9\x14\x98\x58\xcd\xe2\x88\xa2\xbf\xa9\xbe\x75\x66\x9b\xfc\x97\x49\x98\xb1\x3b\x52\x24\xe5\x39\x10\x6b\xf5\x74\x96\x5f\x3e\xa1\x82\xd9\xda\xf2\xd5\x8e\x7e\xa3\xd5\xf8\x86\xa7\x06\x04\xde\xae\x7a\xe9\x89\x76\x5c\x45\x30\x49\x44\x79\xc9\xc4\x9b\xb1\x48\xb0\xa9\x9c\x4b\x52\x38\x35\x28\x8b\x51\x79\x3e\x09\x2c\xd1\x6c\x70\xb5\xc2\xa8\x2a\x88\x72\xbe\x9d\xd9\xe0\xb4\x1a\x6e\x3b\x8f\x6a\xf3\xa2\x9c\x59\x34\xae\xe7\xd6\x23\xb9\x2b\x77\xc0\xe1\x02\x91\xd4\xea\x44\xd9\xf6\xce\x63\x5b\xbd\x3e\xde\xd9\x26\xda\x4d\x65\x8b\xab\x96\x1e\x8b\xad\xf4\x1e\xf8\xc6\xd8\x05\x3e\xeb\x15\xb8\xfe\x98\x0b\x8d\xe9\x41\x58\x93\x4b\xe7\x89\xba\xbc\xa7\x58\x10\x2b\x9f\x46\xb8\xcd\xe0\x2b\x97\x2a\x1b\xa2\x50\xa2\x6a\xae\x12\x50\x27\x2d\x8c\x92\xc7\x32\xae\xb9\xd6\x41\xac\xcf\x5d\xab\x38\x98\xbb\x88\xdd\x73\x12\x1b\x3c\xb7\x4f\x1e\x3c\xb7\x0f\x9e\xdb\x2f\xc2\x73\x8b\x87\x27\xee\x74\xe4\xfa\x45\x90\xc4\x11\xf9\x79\xbd\x0c\x2e\xc2\x94\x3c\x0f\xe0\xf3\x1f\xe7\xfc\xb3\xb5\xa4\x4e\xaf\x6e\xbb\x4d\x0e\xa3\x30\x0b\x83\x05\xbc\x91\x08\x0f\x0e\xc1\x69\xdd\x00\x2c\xfb\x94\xc5\x5b\xa1\xf9\xce\x09\x85\xa1\xaa\xdf\xc8\x93\xb6\x98\xf3\x56\x14\x13\x2f\x85\xe0\x23\x53\x87\x93\x01\xe9\x94\xed\xaf\x31\xf3\x87\xdd\xed\x2d\x02\x02\x51\xa9\x60\xc3\xd6\x36\x9b\xbc\x24\xc7\x7d\xd2\x0c\xa1\xe2\xa9\x38\x8d\xc2\xd4\xb0\x0a\xdb\xd1\x4b\xb1\x3b\x58\xae\xf1\xfe\x9a\xa6\xe6\xd6\x99\x8a\x6a\x44\x96\xb8\x5f\xd7\xdc\xdc\x13\xb1\x41\x8a\x02\xe0\x41\x0e\xfb\x76\x0d\xd9\xa3\x2e\xf8\x31\x26\x0c\xde\x56\x13\x1b\x13\xb3\x05\x6c\xf6\x6d\xf1\xac\x86\xcf\x8b\x2c\x9a\x44\x6c\x51\xb2\x47\x54\xc5\xe6\x6e\x49\x57\x47\xbb\x4d\x82\xc9\x05\xac\x49\x45\xef\x90\xc3\x57\x2c\x47\x60\x27\x3b\xed\xf1\x63\xd3\x4c\x53\x33\xb3\xfb\x84\x0f\x78\x0e\x07\xf9\x6a\x9a\xb9\x2b\x88\x90\xd2\xdc\xcd\x41\x9c\x72\x58\x52\x89\x53\x40\x7b\x65\x52\x23\xc3\x2b\x04\x0f\x84\x7d\x2e\xc3\x84\xdc\x01\x39\x62\x06\x73\xc4\x78\x95\x04\x5e\xe4\x1a\x70\xc7\xed\x08\x36\x58\xed\x19\x3d\x2e\xaa\x12\x7d\x8d\x7c\x66\xf2\xa8\xfb\x97\x11\xe8\x07\x49\x5c\x8b\x5e\xd0\xe4\xaa\x2e\x6e\x3c\x3e\x09\xa3\xd9\x82\xbe\xe5\x0c\x87\xed\x6c\x67\x86\xaa\x09\xbb\x55\x22\xe2\x06\xc7\x0e\x94\x0b\x2b\xc4\x6e\x6b\x0b\x33\xf4\x38\x21\x91\xc6\xf7\x35\x8c\x08\x22\xcc\x10\xfd\xb3\x0f\xf7\x26\x80\xd4\xe8\x40\x78\xb5\x82\xa8\x04\xd6\xd8\x00\xc6\x77\x6f\x0d\x48\x01\x82\x36\xab\x75\x6f\x64\xbb\xcd\x9f\xdf\x93\x49\x78\xf3\xba\xae\x5c\xc4\x78\x94\x46\x5d\x91\x74\x71\x45\x34\x02\x6f\x44\x99\x3a\x7a\x09\x40\x0e\x9d\x84\xda\x05\x11\xb7\xf4\x0b\x47\x26\xe5\x4a\x15\xab\x12\x06\xce\xa4\xc1\x5c\xcd\x38\x28\xf0\x31\xf6\x60\xd1\x20\xd3\x90\x2e\x26\x56\x80\x01\xb6\x62\x60\x6a\xe9\x1c\x1d\x41\x4b\xf1\x70\xd4\x2c\x31\x14\xc9\xd6\xcb\x0e\x98\x2c\x80\xb5\x02\xaa\x29\x6c\x45\xd4\x8c\xa6\x79\x53\x0a\x03\xaa\x38\xe5\x6e\x72\xda\x8a\x58\x1f\xda\x89\x60\x06\x3e\x7d\x38\x84\xf7\x70\x08\xef\x4b\x39\x84\xc7\x07\xcd\x7d\x1d\xc3\xbb\x9f\x2d\x7a\xa8\xe9\xad\x70\x2f\x2a\x9d\x80\x01\xc5\x42\x27\xb0\x75\x22\xbe\xc6\x22\x62\x8d\xe1\x53\x5b\x45\xe2\xb5\x6a\x22\x17\x3e\xb5\x5c\xd4\x79\x2a\x1f\x13\xc4\x15\x2d\x08\xc6\xee\xdd\x97\xaf\x85\x68\xd5\x99\x19\x5a\x09\x7e\xbb\xb4\xab\x88\x95\xa3\x95\x41\x8f\xc1\xdb\x20\x0a\x66\x54\x9d\xc0\x07\x95\xc5\x59\x61\xb8\x02\xb2\x63\x1b\x5c\xb1\x29\x59\x1a\x1c\xca\x55\x9c\x2c\x4b\xa2\xdc\x27\x34\xa3\x70\x46\xd0\xbc\xc3\x53\x4c\xad\x38\x7f\x40\xec\xd6\x84\x58\x77\xc3\xeb\x73\xd5\x8c\xb2\x9b\x28\x1d\x93\x94\x5e\x42\x4c\x2e\x6a\xcf\x52\xb4\x69\xb6\xc7\x44\x2c\xc7\x08\x6c\xc3\xfb\x9e\x04\xd6\x2d\xb6\xd2\x85\xe1\x8e\x0e\xbb\x60\xb1\x90\xd1\x7a\xe2\xbe\xc2\x14\x0c\x1b\x99\x52\xc2\x28\xbc\x70\x53\xba\x23\xc4\xf3\x1b\x38\xed\x4a\x3a\x5b\xe4\x2d\xa8\xf2\x90\xa6\xf8\x50\x34\x13\x34\x8b\x5f\xd6\xed\x9d\x95\xf9\x26\x88\xfa\xf4\xcb\x7a\xb1\x50\x97\x5f\x34\xc1\x19\x4c\x37\x61\x9a\xa5\x6e\xde\x59\xed\xfd\x4d\xde\x18\x2a\x32\x93\x74\x17\xf7\xff\xbf\xa7\x75\x72\x8c\xd4\x6b\x2f\xe4\x62\xe0\x7a\x2d\xc6\x5c\x87\x95\xbd\x10\x73\x7c\x74\x80\x8f\x48\x34\xf6\xaa\x71\x6d\xca\xb4\x10\xca\x1d\x60\xa0\x16\x9c\x16\x97\x38\xe4\x21\x00\xe6\x99\x64\x88\x9c\x4c\x72\x70\x43\xab\xa5\x90\x19\x5b\x0e\xdd\xea\x91\x1f\x41\xa9\x3e\xa4\x1d\xa5\x2d\x0b\x52\x5e\xab\xd8\x84\x28\x78\xba\x71\x54\x2e\x41\xc8\x0f\x64\x6a\x3c\x07\x35\x64\x45\x4e\x41\x19\x68\x9f\xa6\x25\x59\xce\x74\xae\xed\xc5\xb6\x67\x09\xe3\xf9\x15\xbb\xc5\x2b\x45\x3d\xcd\xcb\x7b\xc7\x2b\x28\x02\x03\x43\x2b\x3a\xa7\x2a\x76\x9d\x0c\x00\x93\x1f\x9c\x00\x92\x18\xe7\xeb\x23\xc8\x0b\xcb\xb3\xc2\xb4\xa2\x38\x23\x25\xc4\xd0\xe2\x81\xb4\xe6\xf3\xa2\x27\xc0\x2b\x32\x41\xd6\xe4\x15\x3f\x71\x77\xb2\xd8\xe3\xc0\x59\xc4\xbe\x07\x59\x6a\x75\x04\xb3\x14\xa6\x0d\x2e\xad\x09\x06\xac\x0d\x03\x1d\x30\xff\x84\x90\x5c\xa4\xe8\x5d\xf3\x31\xe7\xcc\x00\x64\x7c\xae\x0c\x95\x2e\x50\x1e\xc8\xdf\x74\x07\x47\x8e\x55\xe2\x12\x5c\xce\x4f\x83\x5f\xf1\xe8\x3f\x3a\xbb\xc0\xba\x10\xa4\x8d\xd6\xe1\x62\x02\x53\xbb\x88\x78\x80\x4c\xf1\x5c\x8a\x9a\x3a\x41\xdd\xff\xfa\xee\xd5\xbb\xad\xad\x2d\x66\xdc\xef\xa4\x64\x3d\x83\x7d\x12\xfe\x1c\x0a\xac\x88\xd6\x70\x7e\x23\xcc\x64\x2b\x68\xcc\x49\xc3\x4e\x9c\x90\x97\xfb\x23\xe0\xa6\x02\x20\xa1\x45\xbf\xd7\xef\x39\x22\xb0\x66\x1e\x42\xf6\xb0\x73\x7a\x4a\xf6\xcd\xcf\x4f\x9f\x64\x70\xa6\x0d\xca\x81\xba\xac\x0c\xd0\xb2\xe7\xde\x28\x32\xaa\x33\x20\xdd\xdc\x95\x7c\xf2\x6d\x99\xa2\xe8\xeb\x81\xa5\x90\x9c\xdb\x71\xd4\x49\x34\x2f\xf2\x46\xe3\x5d\x8a\x7c\xfd\x26\x17\x3d\x0b\x86\x62\x75\x2d\x21\x32\x7a\x73\x9a\x4c\x62\x5b\xa6\x39\xa9\x76\xa5\xd4\xe6\x8c\x42\x5c\xbf\x68\xcb\xaa\x14\xfe\xda\x0d\xc3\x96\x79\x53\xd0\xa4\xeb\x32\xa1\x74\x34\xc4\x85\x22\x96\xbf\xd7\xdc\xe8\x1a\xd5\xa8\x9d\x41\xf8\x31\x3e\xfc\xd4\x09\xc2\x44\xeb\x28\x44\x90\x25\xc0\x85\xcc\xe2\x67\xee\x5e\x64\x44\x09\x8a\xec\x55\x74\x06\xad\xe0\xb9\x2c\xd8\x27\x3b\x3e\x3a\x10\xbb\x89\x18\x3f\x0a\x1e\x03\x61\x84\x86\x11\xba\x8d\x10\xc4\xd2\xaa\x2a\x55\xea\xd4\x56\xab\x25\x9c\xd0\x2e\x9d\x2a\xcd\x6e\x55\xd4\xa1\x63\x31\xd3\x29\xa5\x85\x57\xcd\xdf\x44\x3e\xb1\x12\x9c\xd5\x44\x5d\x7a\x87\xfa\x18\x8d\x25\x3d\x0e\x9f\xb7\x22\x16\xa6\xea\xb6\xe0\xb7\x0f\xfe\xa0\x07\x7f\xd0\xff\x6e\x7f\x10\x3a\x83\x26\xa3\x3b\xec\x08\xba\xfc\x41\xd2\x8b\xa3\x1c\x3d\xad\x36\x57\x4e\xd2\xd3\xf3\xea\xa5\xa1\x47\x80\x0c\xa1\x4b\x60\x68\xb7\xce\x4c\xa5\x0c\xd7\x1a\x83\xf8\x58\xc9\x7b\x9e\x47\xc2\x70\x87\x10\x5a\x4c\xeb\x8e\x98\x7e\xdc\xf4\xc2\x06\xb5\xa9\xc0\x34\x8e\xea\xf0\x66\x57\x63\xcf\x06\xca\x79\x62\xea\x2e\xdc\x1a\xe6\xc2\x41\xcd\xfd\xfe\x77\x8a\x56\xeb\x4c\x1e\x5b\x83\xd8\x14\xce\x48\x79\x25\x1e\x61\xae\x99\x01\xd9\x91\x70\xda\xfe\x18\xa8\xd3\x01\xd9\x99\x8c\xce\x5c\xb9\xc2\x76\xec\x4b\x9c\x64\xa3\x33\x5a\xad\xd1\x19\xcd\x57\xab\x1a\x9d\x51\x7f\xa3\xbd\x7c\xa3\xab\x75\xf6\x13\xdd\x94\x93\xf9\x13\xdd\x38\x9b\xcb\x65\x15\x13\x58\xde\xd6\x8c\x7a\xdb\x9a\x51\x4f\x5b\x16\x5d\x38\x0f\x0d\x15\x9c\xe8\x88\x26\x91\xec\x69\x22\xe9\x4d\x44\x8b\x01\x9f\x7a\x26\xad\x57\x2f\xd5\x84\xc5\x65\x67\x7b\xd0\x67\xb3\xd3\xb3\x87\xdd\x8a\x87\xdd\x8a\x2f\x65\xb7\x02\xde\x38\xbb\xcd\x56\x05\x02\xdf\xef\x79\x44\xe9\x32\xb2\x77\x3c\x54\x86\xd8\xf0\x70\xed\x8b\xb8\xb7\x46\x4a\x66\x4c\x00\x11\x11\xc9\x16\xd0\x0a\x93\x05\xd8\xd8\xd7\xde\x38\x8e\xa6\xe1\x4c\x80\x69\x2f\xe0\xe8\xd0\xe2\x41\x15\x01\x76\x89\xa7\xd6\x74\xa8\x36\x26\x0a\x98\x7f\xb2\x95\xbd\x55\x11\x5f\xee\x0b\x90\xc3\x14\xc2\x24\xf9\x14\xa3\x43\xc1\x12\x32\x8c\x24\x52\xa0\xf6\x13\x6a\x03\x61\xaa\xac\x0b\xee\x83\x30\x21\xe0\x08\xb7\xc8\x66\x6f\x26\x4d\x73\xf8\x88\x64\x69\x7f\xb0\xbb\xd2\xaa\x6c\x23\x48\x37\x38\x1e\x11\x04\xbf\xce\xb0\x73\x0a\x57\x99\x10\xfc\xbd\xc5\xfc\x86\xef\xa6\x75\x78\xdf\xb9\xc1\xe2\x0b\x3a\xb0\xc7\xb0\x0d\x0f\x5e\xcd\x28\x7f\x8f\xf4\xe5\xd5\x4f\x41\x3a\xdf\x26\x03\x3b\x99\x7b\x79\xb7\x95\x95\xa0\x3d\xfb\xf4\xcf\x24\x5e\xbe\xfc\x0b\x30\xdd\x41\x4c\xb5\xd7\x84\x5e\x5e\xb1\x86\x01\xe9\x17\xd1\xe4\x10\xca\xc9\x47\xbc\x9c\x90\x9c\x0e\x09\xab\xe8\x11\xa4\x6a\x45\x0e\xe0\x46\xa6\xbf\x88\x26\x67\xdb\xbc\x37\x74\x82\x3c\x60\x9c\x2a\xbc\x64\x40\xa2\x1a\xc4\xc1\x7f\xbb\xc9\x0a\x70\x05\x26\x2b\xfb\x05\x95\x4b\x48\x9d\x18\xad\x74\x64\x56\x25\x78\xac\x6e\x8e\xc2\x75\xad\xc6\xcd\xf0\x16\x8f\xb8\x91\x67\x32\x60\x3e\x90\xde\x84\x26\xd9\xd1\x9f\xfd\xdd\x69\x22\x52\x33\x9a\x0d\x34\xd9\xd2\x71\x45\xd9\x1a\xb7\xf4\x82\xd8\x2e\x9f\xcd\x53\xb3\xf0\x45\xb0\xd0\xcb\x9b\x05\xc9\x3e\x6c\x35\xed\xd9\xb5\xcb\xb4\xeb\x1a\x10\x73\x33\x52\xf0\x96\xb8\xdb\x10\x83\x45\x6f\x43\x0e\x16\xad\x48\x50\x95\x05\x0d\x0c\xbf\x60\xc1\x22\x02\x4b\xcd\x6f\x04\xcc\x9b\xe0\x62\x80\xe6\xf2\xa5\x1d\xae\x92\xcc\xcd\xa1\x01\x19\xaa\x59\x99\x6f\x1c\xe1\x25\x8c\x12\xa4\xa9\x4d\xe8\x1c\x42\x7f\x35\x9b\x8f\x7e\x09\xac\x3d\x3b\x68\xed\x90\x88\xf7\x07\x59\x43\x3c\x4f\xde\x78\x22\x81\xcc\xc1\x85\xfc\xf9\x3d\xce\x68\x2a\x6f\x31\xf6\xf2\x08\x34\x95\x09\x6d\x31\x4a\x53\x65\x50\x65\x0e\x06\x77\x2a\x06\xa4\xeb\x25\x82\xdc\x8d\x8a\x37\x61\x9a\x55\x25\x01\x60\x8b\x09\xb0\x41\x6c\xfc\x6d\x0c\x4e\xb2\x38\x09\x66\xf4\x45\x56\x65\x19\x8b\xa0\x16\x06\x0a\x01\x17\x84\x90\xb6\x7e\x81\xb4\x41\xf8\x46\x13\x1f\x2b\xcd\x62\xb6\xcc\xbb\x89\x78\x39\x09\xc3\x2b\x16\x4b\x68\x02\x28\x2f\x39\x56\xe6\x5f\x3f\x6e\x9c\x84\x09\xed\x59\x42\x19\xaa\x75\x99\xc3\x17\xe6\xd2\x7e\xbb\x1b\x59\x2e\x6c\xf5\xcd\x0c\xae\x2a\x85\x0a\xdc\xda\x02\xad\x4e\xae\x6f\xa6\x07\xa0\x09\x09\xe0\xe9\xe2\xe5\x2a\x5c\xd0\x24\x2d\xe7\x86\x04\x2d\xe8\xec\x3c\x84\x60\x4d\xc7\xdf\x11\xb6\xe9\x53\xb1\x63\xec\x62\x39\xb4\x7c\x80\xee\xbe\xeb\xde\xb1\xef\x8a\x7a\x46\x0c\xcb\x57\x74\x1c\x2e\x83\x85\x93\x15\x1a\x96\xe5\x0c\xd0\x80\xbd\xbd\xa1\xc1\xa0\xcd\x59\x48\x74\xb9\x38\x69\x15\x4a\xb0\x32\x52\xe4\x32\xe2\x46\x34\xc9\x52\x39\xe2\x7c\x2b\x94\x3c\x69\x77\x1f\x8b\x9a\x32\xbd\xd1\xb0\xd3\x09\xa9\xca\xa7\x63\x3a\xa6\xe1\xaa\x82\xe8\xe7\xcb\x54\x11\x80\x3c\xe8\x5d\x25\x00\x6b\xac\x4c\x60\xc5\x91\x6d\x97\xa8\x42\x9c\x0d\x58\x45\x02\xf8\x74\x79\x93\x89\xa4\x80\x4f\xa5\xc3\x1b\x76\x62\x8f\x83\x4b\x0d\xe7\x12\x4e\xe4\x0b\xb8\x19\x51\x08\xe7\xe8\x62\x27\x1f\x1c\x73\x64\xfe\xd9\xdf\x32\x6c\x2b\xa1\x7a\x7b\x3c\xed\x9e\xd2\x6a\x2a\x9a\xeb\xd3\x70\x16\xdd\x80\x0e\x13\xda\x43\x87\x17\xe8\x33\xd3\x51\x01\x79\x3f\xc6\x37\x1c\x1e\xe5\x36\x98\x47\x70\x30\x2c\xa7\x08\x51\x00\x71\x23\x6a\xe5\xdc\x06\x51\x98\x0b\x34\x2c\x6f\x32\xc0\xf3\xc4\x98\xaf\x57\x17\xd1\xa4\x41\xba\x49\x73\x03\xdc\x46\x62\x0c\x0a\xef\xa4\x95\xe0\xbe\xb2\x70\x41\xc5\x9b\xd3\x65\xdd\xc6\xa1\x5b\xe2\x21\x6a\x37\x9d\x56\x9d\x2e\x5a\xbd\x88\xbc\x79\xf3\xa6\x22\x0e\x0b\xaf\x04\xc9\x9a\x6e\xd4\xf2\x09\x4d\x56\x34\xca\x2a\xb6\x9e\x72\xe8\x42\x0c\x4e\x72\x30\x05\x58\xcc\x68\xf6\x22\x4d\x69\x76\x18\x4d\xe3\x12\x1c\x74\x50\x37\x02\x1e\x08\x4f\xf3\xb8\xbc\x50\x3b\x87\xca\xdd\xa1\xca\x8a\x75\x31\x1f\x31\xb9\x74\x58\x2e\x1b\x89\x72\x0d\x6b\xa4\xc2\x52\xd0\x48\x60\x56\x97\x05\x82\x0b\x88\x3c\x9c\xa6\x28\xd9\x7c\x6f\x40\x68\x99\xbe\x74\x69\x2d\xfa\x00\xd0\xa0\xf1\x65\x5b\x8d\x9a\xf1\x91\xda\xe8\x56\x89\xf9\xb9\x59\xcb\x33\x27\x11\xb3\x90\xb7\x84\xfa\xb2\x86\x59\x2e\xe3\xcd\x9b\x37\x79\x60\x2e\x92\x2a\x5d\x97\x14\x7d\x33\x98\xed\x52\x28\x07\xac\xd3\xc5\x96\x93\x1b\x18\x38\xd2\xb7\xa8\xc4\x56\x13\x5d\xb1\xe5\xa2\x10\x40\x24\x98\x9e\xe3\x36\x82\x13\x24\xa7\xd9\x34\x95\xc8\xf3\x70\x8f\x47\x82\xc8\xe2\xd7\x8d\xe6\x0d\x30\x9c\x05\xe9\x51\x12\x8e\x69\x11\x8a\x1e\x98\x5b\x3b\xe5\x6e\x8e\x25\x3e\x22\x92\x16\x61\x29\x61\x6e\xd9\xc6\x48\x4d\x90\x45\xcd\xf8\xc1\x2a\xcd\x46\x37\xc7\x4b\xdc\xb0\xfe\x3b\xdf\x40\x2f\xc2\xcd\x06\x95\x90\xd7\x0d\x5d\xd8\x0d\x37\x78\x4b\xec\x42\x1a\x32\x1f\x8c\x42\xdd\xb3\x3c\x0d\xc6\x59\x9c\x88\xe9\x52\x6c\x67\xb2\x33\x3e\x4d\x02\xb0\x46\x6c\x20\x42\xbb\x1a\x9b\x8a\xed\x4b\xd5\x14\x9e\xdb\x52\x37\x8e\x34\x31\x7c\xf9\x00\xc2\x34\xc3\x38\x62\xa7\x44\x44\x48\xb8\x35\x18\x01\x1d\xbe\x25\xaa\xea\x01\x69\x10\x4f\x47\x5b\xfb\x1d\x4d\xb1\xc7\xda\xa2\xcb\x7a\xc3\xb0\x95\x78\xef\xbd\x89\x67\xb2\x03\xab\x62\xe4\xa2\x33\xd4\xf6\x60\x15\xa9\x63\x3f\x11\x72\xd3\xb6\xee\xc6\x5b\x16\xf5\x04\xaf\x04\x71\xa0\xa2\x57\xac\x6d\xe8\xed\x41\xf7\x59\xd3\x48\xd7\x23\x31\xd5\x3e\xf4\xf6\xa0\xf7\x84\xc1\x71\x6a\xb7\x07\xbd\xef\xf8\xa7\xe4\xd2\xf6\xa0\xcf\x8b\xc0\x26\xf0\xf6\xa0\xdf\x6f\x9a\xc1\x32\xcd\x6d\xb5\x85\xbc\x3d\xd8\xdd\x65\xdf\xa8\x56\xaf\xb6\x07\xbb\xbc\x7a\x54\x79\xdb\x83\x5d\x8e\x96\xd8\x2e\xde\x1e\xec\x42\x83\x62\xcb\x7b\x7b\xb0\xdb\x87\xe8\x9b\xef\x1e\x62\x43\x1f\x62\x43\xbf\x88\xd8\x50\xba\xbc\xe7\xd8\xd0\xea\x31\x31\x62\x0e\xb2\x80\xc5\xd8\x95\x91\x1c\xbf\x50\xf3\x10\xcb\xe7\xd8\x97\xff\xcc\x1b\xe1\x2e\x7c\xac\xf0\xd1\x76\xbb\x2d\xc2\xf6\x53\xe7\x35\x0f\xf8\x2a\x19\x04\xd9\xb0\xea\xe8\x92\x04\xab\x50\xc3\xfc\x33\x19\x94\xf9\xe7\x96\x2d\x33\xc0\x7c\x93\xf9\xb6\x26\x07\xa5\x89\xed\x61\xcc\xb5\xe2\x03\x6a\xb7\x6f\x62\x09\x15\x46\x64\xfe\x42\x33\xc7\xa4\x66\x4e\x5e\xc8\x63\x36\xbb\x5c\x9f\x36\x77\x3b\x0f\xb1\x9a\x0f\xb1\x9a\x5f\x4a\xac\x26\x5d\xfe\x7d\x42\x35\xef\x2f\xaa\x52\xd9\x9b\x16\xa0\xca\x00\xd0\x9a\xd4\xa8\x47\x34\x49\xe1\x36\xaf\x87\x28\xb1\xbf\x20\x4a\xac\x4a\x24\x52\x44\x2f\x55\x78\x53\x91\x6f\x4f\x01\xe6\x3d\x7b\x2b\xec\xd5\x33\x27\x90\x74\xee\x15\xf8\x91\x99\xc3\xde\x11\xc0\x12\x2e\x61\x22\x38\x0e\x2e\x7f\xa6\x65\x2e\x60\x1d\x74\xa7\x59\x7b\xf4\xc8\x46\x2e\x07\xe0\x39\xaf\x70\xb3\x2d\x8d\x7c\x3b\x32\x43\xd4\xdf\x07\x28\x27\xc9\x4d\x72\xeb\xbd\x0c\x3a\x3e\xa6\xe3\xf8\xa2\x38\x3a\x6a\x40\x76\x24\x9c\x13\x57\x33\xb7\x80\x21\xeb\x08\xbc\x29\xd8\xb9\x25\x4d\x1a\xb0\x05\xd2\xe2\x83\xd3\xf8\xe6\x15\x98\x6a\x7c\xf3\x72\x0f\x3c\x9b\x17\x41\x46\x5f\xd1\x05\x9d\xc1\x49\xad\x62\x8a\x6c\xf0\x02\xa2\x0a\x40\x25\x7b\xef\x4c\x97\x83\x22\xcb\x37\x5b\x42\x90\x05\x5d\x40\x8f\x1f\xf2\x36\xe4\x68\x35\x95\x93\xa4\x49\x47\x09\x39\x6e\x39\xb2\x49\x71\x43\xdd\x66\x9b\xcb\xee\x15\x0b\x79\xd7\x72\x01\x1b\xd6\x1a\xd1\xd4\x91\x4a\x35\xc6\x84\x4a\xb6\xa5\x4a\xe5\xc8\x11\xec\xf3\xc6\x5b\x1d\xa8\x32\xb4\x86\x74\xdb\xfe\xf3\x2d\x87\x5e\x94\xf8\x86\x55\x47\xe9\xc0\x9e\x25\x48\x7e\x0d\x22\x6c\x0b\xb5\x10\x71\xb8\xc3\x2c\x0f\x98\xbd\x26\xe9\x3e\x78\xb0\x1e\x3c\x58\x5f\x84\x07\x2b\x9d\xcf\xdd\x2e\xac\xc2\xb5\xc6\x72\x3c\x0f\xc2\xa8\x15\x27\xb3\xef\x4b\x1c\x5e\xe3\x38\xa1\xad\x30\x36\xd7\x24\xdf\x2a\x87\x57\x85\x45\x48\x85\x13\x54\xe8\x80\xf6\x1e\xc4\x62\x40\x27\xf3\xf9\x67\xf7\x84\xfd\x7d\xd6\x1e\xa0\xc3\x4f\xe6\x73\x6d\x8b\x23\xa2\x97\x78\xc1\xac\xe4\xe8\xe7\xdc\xd4\x49\xe7\xf3\xd2\x4d\x9d\x74\x3e\xaf\x37\x84\x8d\x56\xad\x65\x35\x3b\xf9\xd6\x34\xfe\xb9\x29\x67\x37\x68\x33\x13\xaa\x2b\x6b\x52\x42\xdb\x21\x9d\xcf\xcf\xdc\x00\xc2\x6c\xe8\x78\x3d\x77\x45\x8d\x86\x66\xfc\x83\xd5\xa2\x23\xf7\x6e\xcd\xa5\x34\x7b\x1b\x6c\x50\x08\x4e\xc2\x0f\xd4\xdf\x76\x19\xa8\x40\xa4\x7b\x6b\x44\xc2\xe8\x28\xfe\xa3\x18\x01\x17\xc8\xdd\x1a\x5e\x06\xc9\xf9\xaf\xc9\x3a\xcd\xe8\xe4\x88\xd2\xc4\xdf\x7c\x31\xe0\xdd\x90\x88\x28\xac\x3d\x8f\x82\xb0\xa0\x7d\x2f\xcc\xdd\x24\x20\x98\x4c\x8e\x12\x66\x42\xfe\x4c\xaf\xfc\xad\x17\x81\xdd\x8d\x76\xfe\xa0\x4f\x29\xf9\x45\x60\x77\x43\x60\x1e\xa4\xa5\xad\x7b\x61\xee\xd6\xf4\x8c\x66\x7c\x3e\x2f\xe4\x7d\x01\xd4\xdd\x9b\xaf\xd0\xf7\x45\x60\x77\x43\x20\xa2\x97\x27\x57\xcb\xc2\xc6\x7d\x20\x77\x96\xfa\xb2\x86\x7d\x20\x77\xa3\x78\xc6\xec\xf1\x8c\xf2\xd6\x21\xbc\xeb\x28\x48\xd3\xcb\x38\x99\xf8\x31\xa9\x5c\xe6\x6e\xa8\xcd\x83\xb4\x8c\x27\x3e\x90\xbb\xf2\x24\x2b\x6b\xd8\x07\x72\xb7\x86\xb9\x4e\x29\x6b\xbb\x00\xca\xdd\xbc\xfc\x80\x07\x1a\xd7\x23\xb6\xcd\x48\xd9\xfd\xf1\xeb\x48\x7d\x2f\xc3\x34\x0d\xa3\x59\xad\x32\xb6\xab\x38\xcd\xfc\x58\x3a\x72\x25\x76\x66\x72\xce\x89\xa1\xbc\x2c\x15\x36\xf4\x4e\xe6\x73\xed\xb1\x20\x2b\xcc\xc4\x58\x44\x5b\x41\x20\xbb\xbd\x87\x6d\xbd\x87\x6d\xbd\x2f\x65\x5b\x6f\xf4\xe1\x83\xb5\xaf\xf7\x62\x41\x37\xe4\x25\x84\x55\xa5\x1f\x82\xf4\x43\x48\x9e\x07\x0b\xba\xf9\x47\x92\x4d\xd3\xd6\x7c\x6d\x2e\x87\x9f\xe2\x0d\x85\xc7\x74\x4a\x13\x1a\x8d\xe9\x80\xcc\xb3\x6c\x95\x0e\xda\xed\x59\x98\xcd\xd7\x23\xd8\xd5\x6b\xe3\xa2\xbb\x3d\x8b\xbf\x11\xbf\x8e\x16\xf1\xa8\x9d\x5e\x06\xc9\xb2\x1d\x46\x19\x4d\xa2\x60\xd1\x06\x82\xe8\x26\x13\xff\xb7\x66\xf1\xff\x79\xd3\xef\x7f\xe6\x4d\x40\xb5\xb3\x77\x02\xd8\x3c\x6c\xeb\xfd\x4d\xb6\xf5\x78\xec\x2b\xcd\x2e\xe3\xe4\xfc\x98\xb2\xc7\x47\x9c\xf3\x0e\xce\x39\x36\xb8\x36\xc3\xe0\xdc\x33\xfa\xf0\xe1\xac\x00\xaa\x8a\x13\xdd\xe3\xe5\x87\x18\xc3\xd7\x11\xbc\xad\x57\x86\xa5\x06\xe9\x46\xd0\x0d\x70\x17\xdc\x2e\x83\x55\x45\xdc\x14\xa4\x07\x37\x27\xc0\x1d\x70\x9b\xc4\x97\x11\xde\x2b\x5a\x84\x98\x00\x73\x63\xe5\xc8\xad\xb2\xad\x53\xb8\xb7\xb6\x5e\x55\x40\x6b\xbd\xb2\x9a\xd5\x90\x5a\xaf\xee\x1b\xa5\x84\x66\x49\x48\x2f\xca\xb6\xf9\x04\x98\x1b\x2d\x47\xee\x1d\xba\x2f\xcd\xe2\xa4\x0c\x1f\x06\xe3\x46\xc6\xce\xba\x33\x8b\x66\xb4\x2c\x02\x60\x46\x33\x37\x2e\x66\xc6\x1d\x78\xb2\x5a\x97\xe1\xb0\x5a\x7b\x70\x58\xad\x5d\x38\xdc\x9a\x1b\xf8\x34\x43\x31\x32\x1c\xc8\x8d\x4f\x2e\x4f\xa0\xb4\x5b\x09\xa5\x26\xf1\xa2\x97\x73\x70\xda\x6a\x59\x35\xa0\xe9\x43\x2d\x51\x29\x22\x95\x28\xf4\x80\x4a\x59\xaf\xcc\x6f\x21\xfd\x2a\x85\x89\xa0\xfa\x9c\x51\xed\xf4\xce\x6a\xad\x7d\x68\x96\xf8\x67\xdd\x63\x9c\x87\x17\xd4\xb7\xb7\x08\x7d\xc2\xf2\xbd\x2b\xc8\xe2\xba\x1d\x2e\x59\xa3\x6e\x96\xef\x59\x5c\x39\x56\x57\x60\x2c\xa9\x7d\xca\x92\x4d\xc9\xfe\xc3\xa6\xe4\xc3\xa6\xe4\x17\xb1\x29\x29\xb6\x87\x9c\x1b\x93\xd5\x22\x26\xad\xf0\xc7\x0a\x4b\x9d\x9b\xc7\x90\xc3\x09\x22\x3c\x1f\x05\xb1\xe4\x68\x8c\x33\xad\x16\xc4\x81\xd7\x28\x8f\xe8\x25\xdf\x80\x2b\xbc\xe1\x4f\x40\xc3\xd6\x1d\x1c\x17\xe2\x37\x14\xa2\xf2\x87\xbf\xe9\x65\x08\xef\x82\x43\xbe\x5e\x06\x7e\xc6\x41\x4a\xc9\xce\x22\xc8\x68\x9a\xed\x0c\x8c\x2c\xf8\x0b\x37\x03\xb6\xd2\x79\x38\xcd\xc4\x6b\x15\xfa\x1f\xf3\x19\x30\x7c\xf4\x4b\xff\x41\x95\x0c\xa7\xb6\x20\xd2\x8f\x1d\x58\xe5\x04\xed\x98\xc0\x1c\x8d\x15\x8d\x26\x61\x34\xfb\xec\x78\x1c\xf1\x76\xf4\xd0\x22\x17\x52\x78\x99\xdb\xa0\xac\xba\x5c\xe1\x6b\xf1\xd2\x41\xbe\x23\x4b\xac\x15\x55\x9b\x9a\x39\xb8\xcd\x22\x73\x3c\xd7\xfd\xe4\x2d\x92\x75\x14\x46\x69\x06\xc7\xeb\xab\xb4\x6c\x41\xe7\xda\x67\x7d\xe8\x07\x2a\xc0\x63\x46\xb3\x37\xf1\xac\xc2\x8d\x4c\x00\xe5\x6e\x77\x46\x33\xce\x3e\x0b\xa4\xa0\xd5\x55\x5c\x7a\x73\x03\x80\x94\xb4\x77\x30\x0f\xa2\x19\xad\xd0\x24\x8a\x84\xb1\x89\xcc\x51\x56\x45\x2d\xee\xa9\x0c\xa4\x5d\x25\x00\x66\xba\x3d\x70\x63\x7d\x03\xb1\x1a\x4c\x35\xe6\xd4\x4d\x3a\x9f\xdf\xd3\x36\xb8\xa4\x50\xe3\x4e\x7e\x8f\x06\xf7\x85\x9d\x90\x6e\x2f\x79\xd5\xf6\xfd\xc2\x68\x61\xc1\xfd\xf4\x9f\x0f\x91\xbc\xe8\x5a\x08\x48\x71\x42\x1c\xd2\x7b\x6d\xde\x12\xe3\x7b\x68\xbb\xd0\xcf\xcf\x65\x21\x88\x83\x01\x09\xe2\x00\xaf\xc5\x9c\xcf\x07\x24\x9d\xcf\x6b\xd7\xda\x16\x80\xb2\x51\xc1\xc9\xbf\xfb\xe0\xe4\x7f\x70\xf2\x7f\x29\x4e\x7e\x3c\x26\x6d\x39\xfa\x6f\x6d\x8e\xce\x16\xf1\x28\x58\x1c\xd3\x59\x98\x66\x49\x90\xbc\x18\x85\x96\x69\x2a\x4e\x79\xa7\xed\x1f\x4d\xd0\x16\xbc\x47\x24\xbc\xf3\xe1\x38\x58\xe9\x95\xf8\xea\x38\x3c\x78\x71\x94\xaf\x41\xc3\xe4\x17\x4e\x1e\xc6\x34\x93\x7d\xb2\xd3\xd9\x8c\x9f\x4e\xbe\x9b\xf4\xc6\x93\xdd\xdd\xef\x82\x6f\x9f\xec\x8e\x77\xbf\xdb\xed\x3d\xdd\xa5\xdd\x67\x9d\xef\xc6\x4f\x3a\xb4\xbf\x3b\x79\xba\xfb\xe4\x69\x6f\xb4\xa3\x70\x71\x55\x13\x74\x83\x6e\xb7\x3b\x1a\x77\xbe\xdd\x1d\x7f\x37\x9e\x06\xdf\x3e\xeb\x4e\x3b\xe3\xfe\x33\xfa\xb4\x3f\x9a\x3c\xe9\x8e\xbf\xeb\x8e\x9e\x05\xd3\x4e\x67\xc7\xaf\x9a\x38\xb7\x06\x9a\xa1\x1b\x8c\xc2\x01\x26\xeb\xf4\x2b\x45\x18\x70\x14\x06\x4e\xfa\xf4\xfb\x7f\x01\xed\x5c\xcd\x36\x5f\x1d\xf5\xe6\xc9\x15\x17\x00\x6b\x0a\xb3\xb8\x17\xb7\x07\xdd\xe6\x76\x49\x3f\x6d\x0f\x7a\xa0\x6b\x9f\x3c\xe8\xda\x07\x5d\xfb\xa5\xe8\x5a\xe1\xfb\xb2\x94\x6d\x51\x50\xf2\x34\x89\x3f\xd0\x65\x10\xb5\x26\xf4\xfb\x7b\x51\xd0\xae\xb3\xf8\xd6\x41\x7c\x7b\x7f\x34\x17\x4f\x2b\x56\xe6\xf2\xf9\x46\xff\xbb\xdc\x33\x6a\xbd\x8b\xcd\x13\x34\x88\xd4\x86\x48\x6d\x08\xeb\x1c\xb7\x06\x6a\xe5\x68\x65\xc2\xc8\x53\x24\x8c\x3c\x25\x0a\x9e\xe5\x16\xbc\xd0\xc2\x8e\x73\x7b\x9b\x06\x9f\x6e\xfd\x30\xf7\x5f\xf6\x9a\xaf\x83\x24\xad\x1e\x83\x98\x20\x99\x09\x6a\xfc\x4f\xf2\x92\x1f\x5c\xa9\xe0\xfb\xc1\x97\x75\xff\x26\x2f\xe9\x7a\xe9\xbe\xa7\xb7\x74\xe1\xb5\x67\xd8\xd7\xb0\x92\xd6\x11\x7f\x03\x60\x72\x97\xc7\x76\xff\x4b\xdf\x23\x77\xb0\xdc\x6a\xd1\xeb\x2e\xfc\x6b\x5f\x24\xb7\xd8\xcd\x4f\x30\x28\xcc\xb2\xd8\x2d\x8a\x66\x18\x84\x4f\x18\x31\x49\x50\xe1\x95\x46\xb3\xb6\xa2\x97\x7a\x99\x32\x92\xa6\x24\x3a\x67\xf0\x49\x7b\xf6\x22\xed\x8f\x34\xab\x6b\x8b\x73\x1a\xad\x97\x34\x81\x0d\xb3\x01\xc9\x92\x35\xcd\x7b\xfe\x82\x25\x4d\x0b\x5f\xe5\x15\x50\xa0\x1d\x01\x98\xf9\x6e\x45\x47\x41\x46\x5a\xf2\x5e\x6f\x6a\x3d\xd8\x9b\x7a\x5e\xec\xb5\x41\x54\xa6\xd1\x7c\xf7\xd4\x78\x31\xdc\xfd\xdc\x46\x3c\xfa\x4f\x93\xc1\x37\x61\x5b\x2e\x8b\x91\x16\x40\x21\x80\x4d\xc4\x1f\xd9\x7c\x03\x26\x6f\x1d\xa0\x1a\xa7\x82\x05\x8c\x89\x2f\x10\xa4\xae\x9d\xd1\xb0\x8a\x19\x9d\xc4\xaa\x40\xca\x51\x0e\xc1\x61\xb8\x43\x1e\x33\x1c\x5a\xe3\x79\x90\xbc\xc8\xea\x9d\x46\x2b\x8b\x7f\x5b\xad\xc0\x5f\x9e\xd2\x7a\x43\x64\xb3\x67\x52\xeb\x5d\x6c\xcc\x21\x1f\xa2\x67\x8d\x46\x2d\xb1\x10\x13\xb7\x7a\x6d\x18\x31\x91\x25\x66\x34\x93\x85\xb4\x7c\x69\xa0\xe8\x3a\xb1\x2e\x53\xcd\x79\x8c\xbd\x08\x6c\xf9\x79\xc4\x23\xd6\xb2\x08\x9f\xee\x25\xcc\xb5\xf6\xb6\x6e\x11\x81\x82\xeb\x37\xa3\x52\x6d\xf5\xab\x42\x42\x2b\xe9\xd4\x16\x11\xc4\x5a\xbe\x29\x55\x15\x9f\x42\x16\xb8\xf0\xe7\x8f\xdd\xcc\xc6\x92\xc8\x26\xe1\xb8\x45\x96\xe1\x20\x17\x78\x23\xf1\xe2\xd3\xf7\x48\xee\x8c\x66\xa5\xb3\xef\xdf\xf1\x31\x62\x87\x70\x60\x76\x85\xe7\x88\x3f\xd6\xec\xde\xd3\xec\xd1\x66\xcd\xf6\x29\x0e\xb5\xfb\x7c\x45\x37\x61\x11\x6b\xb2\xaa\xf8\xf2\xb6\xde\x65\x88\x15\x76\xb4\xe8\x35\xbd\xdb\x7d\x5d\x87\x25\x3d\xfe\x4d\xc1\x20\x6d\x61\x6e\xdd\x4d\x04\x4b\xec\xa7\x0f\x4b\xec\x87\x25\xf6\x97\xb2\xc4\x46\x05\xb1\x44\x8d\x6e\x2e\xb4\xff\x45\xa7\xd3\x84\x5e\x91\x3f\xc2\xc5\xf8\x9c\x92\xe7\xff\xa1\xd3\x69\x6e\xcd\x7c\xa3\x05\xb6\x02\x0e\x83\x88\xbc\x83\xcb\x9b\x03\xe6\x2d\x75\x5d\x5e\x54\x61\xb9\xef\x29\xf2\x63\x70\x41\xfe\x88\xe3\x09\x79\x3e\xf3\xae\xf1\x77\xd5\x1a\xff\x5f\xa8\x4c\xf5\x55\x7e\x1b\x35\xac\xf0\xb0\x56\xf0\x03\xb8\x9e\x96\x74\xbd\x2b\xc9\xdf\x50\x37\x00\xf1\x5d\xf5\x9d\x86\x9a\x75\x0e\xb3\x9d\x14\xe6\xc5\x55\x1c\xa5\xe1\x68\x41\xc9\x34\x4e\xc8\x2a\x60\x07\x48\xc8\x12\x77\x7c\x60\x2a\x5a\x25\xf1\x45\x38\xa1\x49\x2a\x4b\x05\x8b\x34\xce\x17\x8d\x17\x0b\x28\x0a\x23\x19\x43\xc7\x49\x04\xcf\x26\x41\xbd\x61\x34\x8e\x97\x7a\xc5\x50\x17\x5e\x41\xcf\x4a\x92\x2c\x5c\x52\x18\x6a\x61\x4a\xba\x24\xa5\xe3\x38\x02\xbb\x9e\x9c\x84\xd1\x6c\x41\xb3\x38\x62\xdc\x04\xea\x0a\x96\xf9\x02\x53\x31\x03\x81\xfe\x6e\x89\x44\xb2\x2f\x29\xd1\xbc\x0c\xd0\x36\xb0\x5d\xd8\xbb\x2c\x51\xe0\xa2\xfb\x1c\x72\x73\xb5\x58\xfa\x81\x7d\x32\x4f\xe2\x28\x5e\xa7\x8b\x2b\x02\x26\x8b\x67\x16\x86\x2c\xc7\x6a\x84\x4c\x82\x2c\x70\xcd\xc1\x8c\x62\x93\x5a\x7d\x96\x83\x86\x74\xe2\xa1\x1a\x41\x38\x98\xff\x5b\x06\xf5\x22\x07\x7e\xc6\x71\x94\xc6\x30\x71\x81\x4c\x80\xed\x13\x27\x69\xeb\x30\xba\x08\x16\xe1\xe4\x08\xe1\xeb\xc2\x30\xd4\x26\x3f\xce\x0c\xcd\xbe\x37\x67\x78\x94\xf2\x56\x16\x1f\xf1\x59\x92\xa1\xd4\xe2\xd4\x37\x19\x99\x18\x69\x81\x55\x43\x71\x5c\x93\xef\x13\x03\x5b\x46\x5d\x1d\xab\x16\xfb\xe4\x8c\x28\xd1\x48\x98\xfe\x0e\xe8\x1e\x73\x21\xa4\xd2\x4c\xd3\xc8\xcc\xe6\x49\x7c\x49\x4c\xf2\x6c\x78\x83\x1c\x24\x93\x57\xd5\xaa\xb4\xee\x0f\x6e\xd4\xfb\xcc\x96\x2d\x14\x01\x7b\x55\x2a\x17\xf1\xe5\xc2\xc0\x2a\xcf\x49\x84\x3a\xf0\x5e\x4d\x36\x90\x07\xa2\x50\x99\x74\xdc\x8f\x20\xe4\xfb\x9e\x11\x23\x04\xa0\x82\x35\x0f\x44\xd1\xc4\xa0\xc4\x43\x0d\x36\xa9\x21\x7f\x1b\xd9\x2a\x67\x55\xae\xb4\xb3\x5d\x59\x18\xc6\x56\xd3\x14\x3d\xeb\x9a\x85\x82\xc5\x82\x4b\x0a\x8b\xd6\x03\xc0\xe1\x97\x90\xef\x70\x2d\xf1\x72\xf7\x21\x90\xac\x85\xbf\x99\x40\x32\x9c\x74\xa9\x14\xca\xe5\x76\x22\x98\xde\xbb\x0c\x0a\xef\x1a\xeb\x0d\x94\x9d\xf4\x6e\xa2\x97\x96\xc9\x9e\x41\x50\x89\xd0\xc9\x59\x8f\x66\x72\x3a\x05\xfb\x1d\x05\x8d\xa0\xa1\x97\x13\xb8\x4c\xf4\x94\x43\xfd\x95\x89\x92\x2c\x6b\x08\x93\x3c\xfc\x64\x74\x1d\xcc\xf2\x65\xa8\x67\x41\x92\x09\x73\xc5\x46\x14\xf2\x8e\x64\x56\xa9\xa2\xe6\xef\x80\x5c\xb3\xda\x0e\x27\xa5\x23\xc6\x91\x2b\x43\x91\x04\x26\x59\x3c\x89\xc9\x78\x41\x83\x68\xbd\x22\x11\xab\x1f\x18\x2c\xd7\xea\x45\xac\xd2\xb0\x77\x0c\x3c\x8e\xa4\x1a\x80\x4d\x15\x56\x66\xf2\x12\x6c\xa2\x21\x87\x06\xe7\xdb\x47\x18\x28\x03\x46\x7b\x93\x84\x93\x41\xae\xa2\x81\xab\xca\x81\xfa\x15\xba\xa3\x26\x0e\x2f\x9b\xdc\x17\x72\xaf\xdb\x5d\x02\x19\x03\x21\x11\xbc\x79\x5d\xd6\xb9\xf1\x4a\xd4\xce\xcc\x52\x0c\x9d\x85\x95\x1c\x99\x85\x17\x34\x22\xa1\xed\x30\x4e\xb3\x78\xe5\xe8\xf3\x5c\xdf\x16\x72\x3e\x5e\xb9\x18\xcf\xcb\x0a\x82\x78\x34\x99\x83\xc9\xa8\x84\xe0\x6c\x77\x16\xaf\x2c\xee\xd4\xd1\x8d\x7a\x4e\xaf\xd2\xba\x2a\xdb\x10\xae\x5d\xf6\xd8\x36\xf9\xea\x2b\xe2\xe3\x21\x08\x53\xf2\x2b\xe7\x6d\xdd\x00\x52\x7a\xc1\x69\xf7\x16\xf0\x5b\x4d\x3e\x09\x4d\x69\xc6\x96\x98\x82\xed\x4b\x3a\x9e\x07\x51\x98\x2e\xc5\xda\xb3\x58\x39\xb0\x0a\x8a\xd9\xcb\x40\x0c\xc6\x9e\x53\xba\x92\x8f\x23\x08\x62\xdb\x5f\xff\x27\x9d\x87\x11\x34\xb4\x81\xa7\x5e\x16\x74\x13\x66\x57\x83\x27\x50\x37\xa3\x14\x04\xa2\x0e\x66\xe7\x39\xbd\x22\x21\x6e\x1e\x01\xda\x86\x22\x6f\xb7\x49\x42\x97\xf1\x05\x25\x10\x3a\xcd\xb2\x9b\x84\x6e\xc6\x74\x95\x11\x98\x69\x31\x49\x87\xcf\xe6\xf4\x8a\x44\x94\x73\x64\x44\xb1\xfc\x04\xd4\xe1\x3a\x58\x2c\xae\xc8\xe8\x8a\x8d\x11\x60\x0f\xbe\x73\xc0\x64\xe6\x0f\x98\x8f\xe0\x79\x87\x86\xac\x2d\x9c\xd6\xb7\x0c\xea\xc8\xa7\x4f\xe4\x9c\x5e\xa9\x27\xd6\xa1\x02\x10\xb6\x33\x7c\x69\xfd\x1b\xc3\xb5\x6f\x8c\x9a\x74\x78\x4e\xaf\x4e\x5b\x72\x24\xda\x21\xd0\x79\x89\x04\xf8\x5c\x38\xf2\x7f\xb1\x78\x02\x32\x4c\x11\x80\x4c\xc2\x8a\x36\x8e\xf0\xe6\xa7\x42\x9d\x00\xc5\x8a\x65\x12\xa3\x84\x95\x48\x96\x48\x21\x79\x0a\xd5\x39\x88\x49\x69\x66\x50\x0f\x15\x6b\xfe\xc7\x26\x19\xb7\x20\xb0\xf8\xe8\xdd\x9b\x37\x87\xbf\xfc\x78\xf6\xeb\xe1\xdb\xd7\xef\x7e\xfb\x55\x5f\x15\x55\xe9\x01\x9d\xe3\xdc\x7c\x10\x0c\xac\x60\x84\xdd\x72\xc5\x68\xb4\x20\x22\xab\xd3\x57\x41\x06\xc7\x16\x86\xa7\x7b\x66\xfa\xe1\x24\xd5\x92\xab\x0d\x55\x59\x61\x6b\xb5\x4e\xe7\x1a\xf1\x5c\xee\xd1\xc2\x33\xa0\x0f\x27\x29\x07\x3e\xa7\x57\x0d\x03\x43\x60\xa5\xaa\xf0\x06\xcc\xab\x64\x6d\xca\x9a\x45\xcf\xc1\x16\x68\xb0\x32\x14\x64\xc8\x44\x96\x29\x13\x00\x67\x53\x95\xc9\xa2\xb7\xc1\x4a\xf3\x56\x60\xa3\xf9\x93\xe1\xd0\x62\x93\x30\x8d\xd1\xb0\xb8\xc5\xea\x18\x02\x40\x2b\x64\xd3\x3b\xa6\xa6\x43\x06\x8e\xec\xbf\x6e\xec\xd5\x3c\xe7\xe4\x73\x36\x57\xa9\xb9\x1c\x9b\x06\xb3\x32\x41\xdb\x6d\xf2\xeb\xbb\x57\xef\x06\xc2\x25\x41\x16\xf1\xec\x07\xdb\xa0\x8e\x3d\x26\xb5\xea\xd7\xeb\x9a\x51\xa6\xa2\x11\x5d\xc1\x3f\x90\x36\x6c\x55\x08\x3f\x98\xd7\x5a\x06\xab\xba\x2f\x3a\x41\xfc\x80\x68\x84\x13\xc5\x65\xe0\x3d\x2f\x0f\xdc\xdf\xab\x19\xc0\x4c\x22\xce\x29\x49\x61\xd7\x07\xe4\x02\x15\x55\x98\x92\x34\x0b\x41\x1e\xb8\x3d\x45\x27\x24\x98\x82\x06\x0b\x12\xb8\x43\x69\x61\xcd\xb3\x46\x9d\x20\xd4\x70\x13\x00\x0e\x8b\x70\x72\x6a\xa3\xa8\x48\x6a\x09\x33\x8e\x69\x25\xbd\x8c\xcc\xd9\xab\x59\x25\xb1\x33\xb0\x06\x33\xfb\x9a\xd0\x45\x4a\x8d\x24\xad\xc4\x34\x58\xa4\x18\x9a\x04\x7f\xaf\x1b\x78\xcc\xa9\x94\xa7\x58\x7e\x6b\xcb\x6e\xb3\x7a\x15\xd0\x2d\x6c\xad\xa4\x8d\x57\xcf\xb2\x7f\x2f\xc7\x4e\x78\x80\x3c\x34\x2e\x82\xf0\xb0\xb1\x78\x5d\xa6\xeb\x4b\x53\xc0\x34\x22\x59\x41\x05\x77\x9d\xbf\x05\xc2\xcf\x25\x13\x17\xbf\x87\x41\x8c\x77\xf1\xbf\x73\x6f\xcb\x9c\x07\x73\x3b\x5c\xd6\x93\x52\xd6\xb6\x17\xdc\xdc\xc3\x79\xb1\x3d\xe8\x3d\x6d\x6e\x4b\xef\xf7\xf6\xa0\xcf\x4e\x9d\x7e\x5b\x65\x4f\x8c\x3b\xd3\x4f\x68\x96\x85\xd1\xcc\x7d\x90\x97\xe9\x25\x3c\x7c\x25\x1e\xdb\x16\x47\xd4\xf6\x72\x10\x78\xed\x2f\xd1\xe2\x81\x3c\xd4\x8b\x46\x91\x6e\x40\xf9\xd9\xc3\x36\xde\xc3\x36\xde\xff\xee\x6d\x3c\x3c\x28\x2b\x16\x2c\xce\x83\xb2\x37\xd9\x3d\x73\x84\xbf\xaa\x5b\xb3\xcd\xfd\x2a\x95\x7e\x83\x0d\x32\x56\xa3\x18\xd2\x5d\x5c\x17\xbc\x98\x4c\x52\xc6\x3a\xa1\x09\xd9\xad\x69\x29\xcd\x52\xb2\xe6\xd6\x17\x9e\x71\x6b\x82\xed\x15\x66\x34\x01\x2c\xd9\x63\x07\x90\x29\x26\xfb\x5a\x4d\x5f\x1a\xe0\xd2\xac\x66\xfb\x87\x60\xa6\xad\xe1\x46\x95\x06\xa7\xe9\x2a\x76\xe7\x8e\x3c\x39\x07\x08\xc7\x11\x9e\xaf\x22\xfb\x79\xb3\x09\x78\xef\x70\x32\xfa\x6c\x22\x3e\x6d\x0b\x4a\x53\xc7\x64\x21\xf2\xec\xf2\xb6\x47\x30\xd6\xbd\x94\xfa\x0c\x91\x33\xb8\x64\x44\xa0\xb8\x77\x88\xa1\xfc\xd5\x57\x6c\xad\xcc\x7d\x52\x61\x34\x63\xda\x58\xad\x72\xc5\x8d\x11\x64\x5f\x13\x01\x8c\xc2\x44\xa6\xa9\x60\x4c\x00\x14\x56\x33\xfc\xdc\x9a\x48\x69\x0c\x2d\x82\x94\x35\x73\x92\x41\x67\x43\x90\xa8\x60\xb4\x01\x8f\x46\x19\xf0\xc5\x14\x21\xd0\x28\x2c\x68\x8f\x4c\xc3\x24\xcd\x48\x1a\xb3\xfc\x60\xb5\x62\xaa\x1a\x96\xf6\xa0\xe6\xb2\x79\x18\xcd\x9a\x64\x44\xa7\x30\xe0\xf9\x90\x16\x7b\xa4\xd0\x20\xfa\x13\x45\x53\xf8\x07\x3c\x00\x0e\x2c\xbf\xfa\x8a\xb8\x38\x6d\xf2\x54\xfc\xb1\xa6\x7d\x40\x55\xe7\xa0\x4d\x5c\x0c\x0b\x2c\x4a\x22\xba\xc9\xc8\xc1\xd1\x6f\x64\x7c\x35\x5e\xd0\xa6\x24\x8b\xb1\x55\x4e\x26\xd0\x2c\xf7\x04\x60\x0c\xd2\x2a\x89\xc7\xa0\x93\x60\x8e\x4b\x2c\xfb\x13\xfe\x6a\x2b\x5c\xd1\x47\x72\xc6\x2e\x41\x1c\xbb\x5e\xe4\x8a\x3f\xd7\x4d\xd2\x71\xd1\xe3\x60\x1b\xef\x5a\x9f\xa9\x25\xed\x1e\xac\x8c\x55\x90\x18\xb6\x8e\xe1\x56\xad\xe7\xe3\x98\x3c\xef\xf5\xda\x91\x4c\xd7\x4d\x65\x53\x83\x17\x56\x0e\x7b\x4c\xd7\xdd\x41\x7c\xf9\x0f\xc9\x60\x24\xca\x38\xc7\xc3\x34\xaf\x4f\x48\xdd\xc4\xb6\x49\x6c\xe1\xf7\x84\xbc\x1b\x09\xfa\xaa\x8e\xe1\x07\x47\xaa\x94\x9f\xe9\x31\xd7\xaa\x8f\x1f\x6b\x70\xa2\x19\x7d\xd1\xce\x32\x82\xc9\x44\xc6\x66\x49\x5c\xb4\x7c\xbb\x7b\xb4\x75\x80\xa6\x32\x45\xf0\x95\xbe\x33\x0b\x49\xdc\x40\x93\x9c\xd0\x1c\x32\x5a\xbb\x06\x7f\x6c\x7e\x84\x53\xa5\x1f\x64\x6f\x99\x24\x71\x07\x81\x84\xda\xab\x8e\x84\xde\x8b\x06\x16\x05\xbd\xa1\x7b\x8f\x95\x0b\xe3\x70\xd2\x28\xe0\xb6\xd3\x4a\x95\x08\xf9\x03\xd0\x9a\xdb\xfa\xdc\x0a\x2f\x55\x80\x2d\xfb\xdd\x43\x48\xda\x43\x48\xda\x97\x12\x92\x26\xde\x15\xb6\x4e\x7d\xdd\xfa\x04\xd7\xe1\x28\x88\x0c\xbb\x94\x3d\x83\x2c\x83\xb1\x36\xdc\xf1\x5c\x74\xf2\xf6\x64\x19\x24\xd9\x6b\x04\x54\xa7\x66\xbd\x9b\x5c\xcb\xe0\x9c\xf2\x66\x05\x2d\x96\xf7\x5a\x4b\x96\xdb\x59\x27\x59\x12\x46\xb3\x6b\x76\x32\xc5\x95\x0e\xc6\xef\x28\x88\xf4\xac\xdf\x83\xc5\x9a\x5e\x93\x0b\xf8\x0f\x37\x36\xa0\x66\xb8\x3f\xb4\x64\xaf\x53\xcd\x42\x32\x6e\x4b\xe0\x64\x68\x45\xba\x6c\x32\x84\xc0\xf6\x6e\xf2\x86\xf2\x13\x18\xb0\x11\x58\x8a\x77\x72\x00\xe1\x75\x79\x6c\x00\x4c\xba\x2d\xc8\x15\x61\x1b\x75\xc3\xd1\xc7\x9d\x7c\x70\x0f\x02\x7b\xe0\xa1\xbe\x13\x72\x47\x1f\xa3\x55\x1c\xb2\x15\xc7\x29\xd0\xac\x85\x2a\xb1\xc6\x57\x61\x02\x86\x96\x51\xa5\x98\x07\x90\x9e\x5f\x63\x3c\x96\xab\x11\xc3\x4a\x63\xe5\xf5\x46\x9e\xb0\x5c\x73\x5b\x36\xcd\x82\x6e\xac\x84\xec\x13\xba\x6c\x69\x47\x81\xeb\x8d\x16\x64\x21\x9e\x51\x9a\x85\xd9\x1a\x34\x92\xc3\x0b\x4f\x26\x74\x15\xa7\x61\xa6\xe1\x87\xb5\x4a\xc4\x58\x2d\xe3\x45\x48\xa3\xac\xde\x30\xb1\xab\xda\x6c\x2e\x42\x02\xdb\x70\x70\xee\x86\xf8\xe4\xf9\x26\x6c\x22\xef\x00\x91\xb2\x36\x5d\x47\xb0\xfa\x8b\xc9\x98\x26\x19\x44\x24\x62\x4b\x9e\xf1\x22\xfb\xd2\x35\x40\xfc\x03\xe7\xb3\x8f\x19\x89\xd7\xcd\x07\x0f\xf2\x9c\x2e\x5b\xd6\xdb\x5c\x9a\x25\x8b\x4c\x19\x40\x3d\x32\x11\xc8\x1d\xf0\xfa\x65\x1a\x13\x97\x01\x6f\x4a\x18\xb5\xb2\xc1\x92\xb0\x0b\xec\x75\xd5\x25\xec\x0a\xfa\x70\x4c\x84\xe2\x23\x42\x21\x92\xfa\x72\x0d\x9b\x21\xb0\x75\x06\xd1\xfb\x52\x80\x47\x57\x19\x4d\xfb\xbd\x06\x9a\xde\x5b\x0d\xab\x1b\x11\xce\xd5\x49\x77\xea\xbc\x34\xd7\x7b\xa2\x38\x1f\x33\x10\xe9\xf0\x7f\xd7\x94\x84\x13\x1a\x65\x70\xba\x2f\xb9\x71\x4f\x23\xea\x15\xfa\x97\xb5\x98\xef\x67\xa8\x24\x60\x73\x0c\x45\x7e\xbe\x18\x85\x86\xe1\x4a\x97\x2d\xc1\xe1\x7a\x30\x0a\x1b\xad\x20\x03\x35\xda\xc2\xa6\xeb\xa2\xe6\x8f\x77\x15\x81\x9c\x5d\x2a\x78\xa8\x99\xa5\x45\x93\xdf\xf6\xa0\x0f\x66\x2a\x28\x81\xed\x41\x1f\x1e\xfb\x7f\xd2\xa9\x62\xa0\xa2\x67\xf6\x49\xa5\x77\xd5\xf6\x34\x77\x41\x12\x83\x5f\x6c\x1a\x8c\xb3\x38\xb9\x82\xa8\x98\x09\x9d\x02\x5f\x1f\xc1\x4c\x00\x66\x7d\x3c\x25\x92\x9a\xfd\x7d\xb2\x1d\xb3\xf5\xf7\x36\x83\x79\xd4\x6e\x93\x83\x78\xb9\x8c\xa3\x7f\x9d\xd4\x1e\x3d\xca\x11\xaf\x7e\xc3\x06\x04\x4e\xf5\x6d\xb0\x01\x12\xba\xdd\x68\x4a\xbb\x00\xfc\xe4\xd1\xf8\x9b\x51\x90\xd2\xa7\xbb\x56\xc6\x72\xf2\xc4\x06\xbd\x58\x9d\x4f\xa6\x56\xe2\x38\x5c\xcd\x69\xf2\x0d\xaf\xb9\xb1\x57\x7b\x74\x5d\x7b\x04\xdb\x30\x44\x23\x86\x3b\xbb\xd9\xde\xe6\xb6\x60\xc3\x36\xf9\xea\x2b\xcc\x68\x05\xcb\x89\xa4\xed\xc5\xdb\x57\xb5\x47\x8f\x78\x46\x7d\x28\x70\x6e\x12\x13\x55\xf6\x0d\x18\x36\x89\x42\xac\x49\x2c\x7c\x4e\x25\x97\x75\xc4\xb0\x21\x7e\x73\x05\xa9\x8f\x92\xf8\x32\xa5\x49\xa3\xf6\xe8\x91\xe4\x58\x1c\x67\xad\x83\xe4\x6a\x95\xc5\xff\x3a\xe1\x45\xaf\xd9\x4a\x49\x9f\x75\x64\x3e\x38\xd7\x1e\x69\xdd\xcb\x48\x41\x6f\xc7\xc9\x3c\x4e\xb2\xf1\x3a\x4b\x6b\x8f\xc4\xa8\x39\x20\xfb\x44\x94\xdd\xd3\x92\xcf\x16\xe1\x08\xb2\x5a\x8b\x70\xa4\xa5\x33\x47\xd6\x01\x23\x0a\x72\x01\xaa\xa5\xa5\x19\x35\x04\x8b\x59\x0c\x40\x2d\xf8\x65\xaf\x26\xb1\x78\x13\xc7\xe7\xe0\x7c\x84\xc3\x9f\x1a\x26\x27\x2f\xdf\xfd\x1b\xd7\x77\x32\xed\xf0\x97\xdf\xcf\x5c\xe9\x27\xbf\xbd\x3c\x7b\x7b\xf8\xef\xb3\x8e\x2f\xa3\xeb\xcb\xe8\xf9\x32\xfa\x76\x06\x6b\xdb\xd7\x8e\x9e\xd9\x2d\xca\xec\x15\x65\x8a\x36\x25\x6b\x0e\xe2\xe5\x0a\x16\x85\x8b\x3c\x8b\x5c\x5d\x6a\x95\x9a\xc4\xeb\x11\x98\xf8\xc0\x58\x05\x00\xe8\x4e\x74\x2c\x8c\x00\x03\xd0\x99\x9d\x3d\x12\x92\xe7\xa4\xf7\xe4\xe9\x1e\x09\x1f\x3f\x36\xaa\x97\x26\x21\x79\x4e\xba\xbd\x67\xb9\x3c\xf8\x3b\x19\x86\xb0\x95\x1e\x92\xe7\xcf\x49\x57\x6b\x44\xed\x82\x16\x94\xaa\xf3\x62\x0d\xf2\x9e\x74\x36\xdd\xae\x10\x36\xf1\xe7\x5a\x7d\x5e\xd7\x0c\xaa\xff\x08\x16\xe7\xe4\xc7\x7f\xd6\x7b\xef\x9f\x35\x54\x06\x50\xbb\x61\x34\x59\x69\xa1\x95\x78\x23\x0e\x68\x4c\x4e\x47\xf1\xc6\xcc\x84\xea\x53\x68\x73\x13\x92\xf7\xa4\xbe\x51\x04\xe1\xef\x3d\xed\xf7\xbe\xf6\xfb\x6e\xc3\x22\x96\xd5\x52\x4f\x37\xe4\xfb\xef\xbf\x27\xcf\x18\x64\xba\x21\x5f\x91\xce\x66\x3a\x85\xaf\xce\xe6\x69\xdf\x2a\x02\xa3\x63\xb8\x01\xf6\xa7\x1b\x2b\x4b\x0c\x9e\x61\xca\xf2\x37\x7b\x35\x33\x5f\x23\x6a\xb9\x5e\x64\xe1\x6a\x11\x8e\x99\x47\xc0\x04\x03\xf2\x36\x20\xc6\x93\xe1\xe6\x74\xcf\x91\xb7\xcb\xf3\x7a\xce\xcc\x67\x3c\x73\x57\x4a\xb9\xa3\xf5\x74\x3d\x22\xcc\xba\x69\x92\x65\xb8\x21\xe3\x78\xb1\x5e\x46\xa9\x21\xfd\xe2\x07\xea\x84\xfd\x8d\xfa\x84\x51\xf5\x35\xc8\x4c\xa7\x2b\x38\x85\x9f\x9d\x6e\xa7\x63\xb3\x56\x0c\xb9\x0e\x67\x56\x3d\x83\x4e\xea\xed\x36\xc8\x27\x52\xcf\x90\xdf\x9e\x22\x5d\xbd\x48\xf7\xa9\x56\xa4\xfb\xd4\xd7\x4c\x4f\x2f\xf3\xac\x41\x54\x99\xde\xae\xaf\x4c\x9f\x97\xc9\x0a\x38\x15\x46\x17\x3a\xb7\xe0\xb3\x32\xc7\x36\xcf\x34\xfe\xa0\x78\xee\x62\x52\x07\x79\xb8\xe9\x09\x18\xfe\x59\xc4\x51\x5d\x91\x75\x86\x69\x65\xb6\xea\xe5\xba\xc3\xb4\x3a\x6f\xf5\x82\xbd\x61\x5a\x9d\xc1\x7a\xc1\xfe\x30\x2d\xe5\x32\xdb\x08\x60\x3e\x5f\x9a\x98\x40\xa0\x09\xb7\x36\x4e\x25\x88\x0a\x60\xff\x06\x1a\x90\x15\xe9\x91\xf7\x64\x32\x84\x9f\xcd\x33\xf2\x9e\x6c\x7a\xa7\xa7\xf6\x40\x82\xbf\xa0\x5b\x60\x24\x4d\x86\x9b\xf0\xf4\xb4\x48\x49\xb2\x9c\x6b\xb6\x92\x95\xf3\xca\x51\x42\x21\x18\x8f\x79\xe1\x8e\xc7\x71\x84\x13\x8c\x9a\x95\x8e\x0f\xde\xfd\x02\x73\x44\x67\xd3\xe9\x34\x49\x67\xd3\xe9\xb2\x7f\x7b\xec\xdf\x5d\xf6\xef\x33\xf8\xb7\xcb\x72\x7b\xec\xdf\x5d\xf6\xef\x33\xf6\x6f\x77\x04\xff\xf6\x9f\xaa\xc9\xec\xeb\xaf\x11\xa9\xaf\xc9\x8b\xd7\x27\xfc\xea\x74\xc2\xcd\x33\x02\x06\x41\x12\x66\xf3\x65\x4b\xc0\xb4\x15\x2a\x00\xbd\x8f\xe6\x43\x8b\x7f\x68\x16\x46\x8b\x6e\x32\x38\x1e\xa5\xb1\xf3\x6c\x12\x1f\x43\x88\xec\x40\x33\x87\x5c\x1a\xfc\xe4\x3c\x5c\x61\xc0\x6e\x3c\x25\xd1\x31\x78\x40\x53\x32\x0f\x52\x32\xa2\x34\x82\x30\x7d\xb1\x57\x05\x7b\xa4\x10\x24\x3b\x09\x27\xb0\x6b\x4c\xf8\x92\xc2\xac\x10\x44\x01\x8c\xb0\xd6\x99\xa8\x49\x44\x79\x9e\x9d\xd3\xab\xa3\x24\x8c\x13\x86\x15\xb3\x33\x65\xba\x53\x74\x44\x44\x97\x91\x71\x5d\xcb\x13\x60\x9a\x6f\xe2\x8f\x88\x13\xdc\x77\x37\xaf\x52\xf7\x9c\xe5\xfe\x88\x13\x16\x7b\x08\x51\xb6\xf0\x04\x5a\xea\x06\x83\xb7\x2c\x11\x2a\x0d\x67\x2f\x41\x01\x91\x36\xd9\xdd\xab\x99\xd0\xfa\x30\x92\xe1\xfb\x09\x63\x50\xbe\x5a\xc1\xb9\x7d\x62\x72\x72\x5f\x36\xf8\x98\x3c\xad\xd4\x02\xd0\x9f\x8e\xe7\x14\x96\x1f\x04\x4c\xe8\x7c\x63\xe7\xe9\x71\x7c\x09\x75\xd7\x45\x33\x8f\x61\x96\xfe\xba\x90\x06\xbd\x5e\x47\x95\xf4\xea\x44\x34\xaa\x71\x5f\x4b\x33\x8c\x2e\xc3\xec\x60\xe8\x70\xd3\x83\xff\xfa\x1c\x31\xc4\x6f\x87\x15\x22\xc4\x4e\xc2\xf3\x5e\x71\xc2\xc1\x5f\x0d\x95\x21\x2b\x03\xd3\x8b\xe8\x71\x4c\xb1\xd0\x2b\x56\x58\x82\xee\x8c\xec\xe7\x2b\x67\x37\xdf\xec\xd5\xdc\xa5\x00\xed\x2d\x44\xfc\x7f\x24\xe2\x5e\xcc\xb1\x17\x8e\xe3\x8c\x80\x48\xfa\x81\x32\x7d\x0e\x30\xa7\x80\xc2\x9a\x4f\xd6\xa3\x2a\x35\x33\xf3\x49\xd4\x79\xaa\xcd\x6d\x2c\x03\x5b\x03\x93\x80\xdb\x68\xa7\xda\x2c\xa6\x43\x3c\x33\x00\x18\xa6\x58\x33\x26\x97\x60\xfb\x36\xdc\x30\xb5\x5d\x84\xed\xfb\x7d\xa6\xc4\x87\xc8\xe4\xb6\x64\x32\xf9\x44\x3a\x88\xba\xa3\xb3\xb5\x0e\x87\x3e\xc2\x42\xe4\x7b\xf2\x14\x56\xc4\x56\x87\x91\xfd\x7d\xb2\x5b\xd6\x69\x7f\x3b\xd6\x3a\xf1\xb8\xae\xb9\xd3\x7d\x63\x26\x27\xeb\xc8\x92\x53\xf2\x9e\x64\x8e\x36\xae\x4b\xb5\xb9\x50\x31\x60\xc4\x15\xab\x99\x30\xba\xf8\xd9\xa1\x69\x72\xc9\x7e\x65\x03\xa0\x4a\xdf\xc8\x2f\xa5\x72\x44\x92\x47\xeb\xe8\x0a\x0b\xf5\xe8\x37\xb2\x8c\x4b\x78\x41\x96\x44\x3e\xf9\x9f\x02\xa1\xf1\xaa\x93\xfb\xd5\x4c\xbb\xce\xea\xca\x10\x7f\x4e\x76\xd9\xf9\x13\x46\xc5\xf3\x22\xd9\x37\xfb\x62\x28\x6a\x40\x4b\xf3\x86\x84\x14\x54\xa6\x9b\xb2\x9d\xa1\x39\x86\x40\x14\xf5\xfc\xee\xd0\x33\x94\x4e\xc9\x7b\x77\xc3\x05\x7f\xf4\x8a\x7b\x43\xf7\x08\xb4\x11\xe8\x0b\x04\x45\xbe\xb3\x0f\x1e\x79\x3e\xaf\x9b\x5a\xf7\xd0\x68\x0c\x4e\x2a\x66\x08\xea\x76\xde\xdb\x26\x89\xa7\xd3\x94\x66\xb9\x9e\xe1\xb3\xf1\x24\x3e\x90\xe5\x14\xb0\x78\x33\x5d\x93\x94\xa6\x5c\x86\x75\xd4\xaf\x5d\xf5\x6b\x4f\xfd\xda\x6f\x32\x15\xd3\xd8\xf3\xe0\x3a\xa1\x37\xc5\x15\x26\xa4\xcb\x60\x45\x7a\xd1\x04\x82\x01\xc8\x6e\x36\xf7\x18\x31\x20\xdf\x6f\x87\x9c\x0a\xf2\x98\x4d\xb8\x06\x8c\x91\x67\xc2\xf6\x0b\x60\xfb\xce\x25\x51\x15\x16\x9a\xb2\xda\x34\xfa\xbf\x63\x7e\x76\xcd\xcf\x9e\xf9\xd9\xc7\x4f\xce\x59\x13\x91\x76\x9b\x1c\xc2\xca\xf7\x6f\xc1\x23\x77\xa7\x1b\x6c\x72\xf6\x7a\x53\x57\x4c\xb7\x10\x37\xf2\xd1\xbb\x20\xa8\x6c\x59\x3b\x18\xfb\x23\xc5\x7b\x3d\xd9\x46\x24\x37\xd5\x01\xd3\x7c\x9d\x69\x47\x63\xd6\x29\xa4\x92\xf7\x86\xb2\xed\xd8\xdc\x63\xa5\xba\x5a\x29\xce\x72\xb3\x54\xae\x7f\x58\xa9\x9e\x59\xaa\x67\x97\x72\x7a\x9b\xd2\xbe\x59\xaa\x6f\x97\xea\xe7\x0c\xd4\x76\x9b\xfc\xac\x4d\xbe\x30\xec\xdc\x4b\x7f\x7d\x0a\x74\xad\x18\x8e\x1d\x6b\x1c\x39\xff\x72\xae\x82\x6f\x00\x19\xfc\x5c\xf4\x10\x26\x78\xe6\x5d\xd6\xc7\xe1\x34\x03\xb4\xd2\xa6\xc7\x51\x56\xd8\x71\x02\xf5\x0c\x3a\x4f\x88\x54\x67\x98\x76\xa4\xe5\xf5\x5e\x26\x77\x87\xf5\xb4\x9b\xb3\xbb\x54\x7e\x6f\x58\x4f\x7b\xb6\xd5\xa5\xb2\xfb\xc3\xb4\xaf\x25\xeb\x7c\xc7\x15\xcd\xe9\x9e\x07\xb9\xae\x89\x5c\xd7\x83\x5c\xaf\x04\xb9\x7e\x21\x72\x9d\x5b\x22\xd7\x33\x91\xeb\x79\x90\xeb\x97\x20\xd7\x29\x44\xae\x7b\x4b\xe4\xfa\x26\x72\x7d\x0f\x72\x9d\x12\xe4\xba\x85\xc8\xf5\x4a\x91\xcb\x63\xd7\x6e\x93\xdf\x56\x2c\x60\x28\x85\x48\xd4\x3c\x00\x53\x27\x59\x67\xcf\x91\x03\xe2\x90\xd9\x7e\x34\xf8\x49\xa1\x2f\xb2\x9e\x2b\x07\x18\x91\xf5\xf7\x4a\xcd\x6e\xcf\x78\x2a\xd1\x7d\x6c\xf8\xd4\xf9\xa2\x25\xed\x78\x57\x2d\x8e\xe1\x63\x2f\x5b\xd2\x5e\xe1\xba\x45\x0d\xa1\x46\x35\x49\x90\xe3\x47\x60\xd7\xf5\x63\xd7\x2b\xc7\xae\x5f\x8c\x5d\xe7\x36\xd8\xf5\x34\xec\x7a\x7e\xec\xfa\xe5\xd8\x75\x8a\xb1\xeb\xde\x06\xbb\xbe\x86\x5d\xdf\x8f\x5d\xa7\x1c\xbb\x6e\x31\x76\xbd\x72\xec\x4c\xf4\x40\x5a\xa9\xb8\x84\xda\xcc\x12\x93\x1c\x9f\x86\x1d\xe3\x48\x9b\x05\xbb\xa7\xae\xe1\xa4\x01\xf4\x4e\x5d\xa3\x4a\x03\xe8\x9f\xda\x83\xcb\xb0\x7d\x70\x29\x3c\x80\x1d\xc4\x76\xbf\x87\x1e\xe8\x86\xcb\x15\x2c\x2c\x16\x69\x20\xa5\x78\xe6\x17\x5d\xc3\x3b\x29\x3e\xcd\x44\x42\x98\x82\xa7\xc1\x98\x4a\x1f\xb1\xac\xe4\x1f\x74\x13\x40\x78\x4c\x2e\x43\xf4\x29\xaf\x2b\x03\x37\xbe\xda\xdd\x06\x5f\x72\x0b\xd7\x11\x75\xbc\x89\x8d\xd9\x64\x4d\x32\x9e\xce\x1a\x7b\xb9\x5a\x56\x8b\x00\x90\xd8\x64\xc4\xae\x05\x2d\xfc\xba\x6a\xc7\x55\x11\xfa\xb4\x0f\x1c\x4e\xec\x33\x7e\xc3\xe9\x4f\x74\xb1\xa2\x49\xfd\xc5\x6b\xbe\xad\xcf\x7d\xf6\xb5\x47\x18\xb2\xa2\x37\x09\x31\x5f\x90\xcb\x02\x48\x8c\xa8\x82\xc1\x93\x5e\x53\xc4\x25\x0c\x9e\xf4\x9b\x66\x64\xc2\xe0\xc9\x6e\x53\x85\x24\x0c\x9e\xb0\xe3\x87\x10\xa9\x30\x78\xda\x85\x68\x91\xde\x9d\xa2\x45\xfe\xca\x30\x91\xcf\x16\xcc\xf1\x17\x86\x65\x40\x78\xd8\x23\xf2\x35\xc1\x00\x0a\x68\x9e\xc0\xbe\x4c\x1c\xb1\xeb\xd1\x6b\x8f\x98\xd0\x88\x32\x10\x7a\xd1\x42\xd0\x4f\x9f\x88\xd6\x09\xf2\xc0\xa6\x34\x1a\xef\x27\xb8\x03\x1e\x13\x94\x51\x1d\x41\x4a\xb5\x3c\xd8\x25\x60\xd7\x32\x49\x00\x99\xa2\xd7\xb0\x9e\xb2\x68\x31\xb6\x0a\x7a\x21\x76\x79\x54\x9d\xce\x6c\xad\xfc\xc1\x19\x65\xa7\xa2\x0e\x60\xa0\x6a\xe9\xbf\x65\x53\xd8\x48\x66\xd9\x2d\xf8\xd0\xf2\x5e\x32\x49\x97\xb9\xfc\xb3\x28\x10\x45\xe6\xbc\xbe\x58\xfd\xfc\xea\x9f\x6a\xb3\x89\x7f\xbb\xd4\xd6\x8b\x11\xbc\x27\x33\xce\x08\xc4\x26\xa1\x7a\x21\x19\x5d\xae\x20\xfa\xdd\xa1\xa0\xc4\x6d\xca\xe4\x63\x84\xf7\xe8\xa0\x8e\xe4\x87\x61\xa5\xae\x63\x7e\xbf\xf0\x03\x6d\x89\xeb\x20\x07\x64\x97\xd4\xbb\xbd\x67\x64\x14\x66\x69\xa3\xa8\xc2\xf0\xc2\x51\xdf\xe1\xef\xb7\xad\xee\xec\xf5\x2f\x07\x67\xff\xfe\xe7\xbb\xe3\xb7\x67\x6f\xdf\xbd\x7a\x4d\x5e\x40\x40\x62\x9a\x05\x51\x46\x12\xba\x82\x5d\xb3\x08\x0e\x1e\x13\x54\xa0\x20\x86\xcb\x78\x42\x5b\x85\x75\xbe\x7a\x5d\xa9\xce\x09\x75\xd7\xa9\x6d\x0a\xe2\x30\x10\x82\x24\x3f\xdd\x12\xe5\xd8\x24\x54\xbd\x09\x3f\x5f\x93\x03\x76\x64\x7b\x9d\xb0\x80\x07\x3c\xd0\x90\x8a\x96\xf5\xde\xcc\x51\x27\xe5\xfe\x9a\x84\x17\xe4\xd7\x39\x25\x87\xbf\xc3\x24\xb6\xc6\x23\x01\xb0\xe0\x26\xc0\x0a\x56\xb5\x51\x25\x12\x04\x7f\xc7\xd3\xd9\x80\x0d\x38\x81\x6b\xa3\x59\x2b\xc0\x96\x4d\x17\x70\x92\x53\xf6\x36\x9c\xa6\xf0\x74\x86\x0b\x7d\x1e\xb9\xa9\xe1\x0e\xa2\x07\xc8\xc3\xb6\x9f\x13\x56\x9c\xea\x1c\x4f\x67\xa4\xfe\x8e\x31\x28\x58\x34\x18\xc1\x63\x17\xf3\x2a\xf2\xc0\x6c\x0b\xa7\xba\x8f\xbc\x43\xaf\xc9\x0b\x45\x1d\x08\xca\x98\x16\x94\x85\x75\x45\x38\xf6\xe7\x9b\x56\x42\x1e\x40\xc8\x16\xb6\xa8\xcd\xf1\x62\xeb\xb9\xc5\xa7\xe9\xd7\x9c\xcd\x71\x52\xc7\xcd\x33\xc6\xc2\x26\xf9\x48\xc2\x8b\x01\x09\x2f\x64\x12\x0b\xce\xf6\xf4\xb7\x59\x93\xee\x21\x92\x96\x83\xed\x01\x40\xe6\x00\x33\x11\x13\xdc\x71\x36\xc7\xaa\xc3\xf6\xb0\x2d\xb4\x8a\xf2\xe4\x19\x88\x0f\xf2\x74\xbf\xf2\xf4\x8a\xde\x97\x3c\xbd\xa2\xf7\x22\x4f\xaf\x5e\xdf\x51\x9e\x0e\xa3\x30\x0b\x83\x45\xf8\x81\xa6\x24\x80\x73\x2a\x8b\x2b\xc4\x70\x82\xec\x28\xe0\xfb\x2a\x48\x82\x25\xf9\x18\xad\x97\xff\x8f\xbd\xef\xff\x6e\x22\x47\x1e\xfc\x39\xf9\x2b\x34\xef\xee\x33\xb1\x83\x71\xe2\x40\x02\x1b\x8f\xf7\x5e\x08\x64\xe1\x80\x85\x03\x66\x76\xee\x78\x5c\x5e\xc7\x96\x49\x83\xd3\xed\x73\xb7\xf3\x65\x99\xfc\xef\xf7\x4a\x2a\x49\xa5\x6f\xdd\x6d\x3b\x09\xec\xee\x0c\xec\xe2\xee\x96\x4a\x25\xa9\xbe\xa9\x54\x2a\x09\xc5\x76\x09\x27\xf4\x5e\x43\xee\xe4\x67\xf2\x5c\x1a\x9c\x96\x22\x92\x2e\x9f\x51\x3a\x15\xd1\xd5\xe2\xac\xb4\xa2\x5a\xad\x64\xc2\x64\xf8\xc3\x91\xec\x8d\x91\x55\xcb\xff\xe0\x68\xf5\x0e\x5b\x9e\xe0\xd2\x2c\xb5\x22\x6c\xf4\x34\x75\x58\x94\xe0\xc0\xe6\x9e\x4e\x81\x16\xc4\x68\xa9\x8b\xa1\x1d\x2f\x29\x8c\x54\x17\x86\x18\x9d\xd5\xc3\xf1\x67\xa5\x14\x81\x8a\xfb\xeb\x76\x71\x30\x75\x4b\xb0\x9c\xc5\xd4\x03\x16\x42\x58\xa9\xa0\x9d\x00\xec\x63\x43\x52\x03\xa6\x7f\xf7\x43\x25\x41\x2b\x8a\x1d\xc4\x50\xab\xc2\x53\x2e\x08\x5d\x9e\x00\x08\xf5\x03\x2c\x25\x71\xd7\x76\x33\xc6\x11\x31\x3a\xb6\x1c\x86\xa3\x68\x65\xa1\x5b\x02\x2d\xc7\x57\x24\x1f\x64\x41\x1f\x37\x6b\x86\x67\x4d\x82\xa8\x04\xc2\xe2\xe4\x3e\x3b\x11\xb6\x97\x5d\x22\x62\x8f\x09\xd0\xe2\x84\xb1\x3e\xd9\xec\x02\x7e\xcb\x67\x30\x33\x40\x2a\xc3\x19\x2f\xf9\x7d\x1c\x8e\x49\xfe\x39\x1d\x06\x06\x5a\x45\x7d\x35\x1f\x6b\x91\x0b\x43\x60\x2e\x4f\xf3\xa1\x4c\xe1\x23\x22\x52\xf8\xa8\x62\xa8\x5d\x09\xf2\x47\x81\x07\x61\x00\x28\x3a\x3b\xc1\x3c\x52\x6d\x60\x03\x04\x7c\x03\xcd\xa5\xa1\x5f\x1b\x58\x32\xdf\x15\x26\x1a\x48\xb3\xcf\x2b\x92\x03\x48\x13\xd3\xf9\x81\x22\x0f\x84\xdf\xda\x00\xfc\x37\xda\xfd\x45\xeb\x5d\x28\xcc\xa3\x14\x86\x25\x29\x8d\x99\xa1\x8b\x48\x0f\xc8\x1b\x6f\xbd\x96\xb3\x9f\x4c\xe1\xd2\x7b\x5a\x3d\x44\x53\xb2\x3d\x96\x9c\x27\xe9\x04\xa2\x89\x65\x18\x62\x11\x57\x8b\xc7\xaa\x33\x8d\xa9\xea\x28\xcd\x50\xf1\x55\xea\x29\x84\x6b\xcd\x1c\xfb\x7b\x5e\xe2\x49\x68\xa8\x3b\x46\x48\x46\x6b\xc0\x19\x72\x3e\x1e\xf3\x61\x99\x9e\xf3\xc9\x15\x4b\xe0\xbe\xf2\x72\x36\x17\xcf\x70\xee\x25\x19\xdd\xcf\xb3\x21\x6f\xa4\x67\x9a\x52\xaf\x40\xe3\xb6\x68\x58\x02\xbf\x6d\x4a\x56\x23\xd9\x6a\x4e\xc4\xba\xca\xa2\xd4\xaf\x2b\xd6\x93\xbf\x2a\xda\x9c\xfe\x8f\xcc\x5c\xcc\xc5\x0c\xd9\x25\xd2\x71\x25\x80\x6a\x6e\xa9\xdb\x28\x59\x40\x20\xc3\x98\x8a\xce\x21\xc3\xf1\x11\xa6\xb9\x54\x92\x5a\x31\x49\xcb\xe3\x52\x24\x19\xbf\x76\x8c\xff\xb4\xb3\xb9\xb7\xf3\x78\xeb\xc1\x0e\xfd\x94\x9e\xc7\xbe\x38\x76\xd0\x3e\xeb\x59\x5f\x6d\xfb\x77\x9f\xed\x54\x31\xbc\x5a\x3b\x15\xe8\x6f\xd3\x93\x29\x5c\xd9\x49\xbd\x23\x3b\xce\x9b\x6a\xd5\x82\x23\xfd\x41\xbb\xc5\xc9\xa5\x58\x09\x3b\x15\x5e\x63\xf0\x35\x34\x60\x45\x65\xc2\x1e\x64\x0a\x1f\x91\x4c\x00\x09\x59\x18\x4d\xc8\xd7\x81\x0e\xdd\xc1\x62\x09\xa3\xbd\x7d\x97\xa7\xe3\x23\xf7\x6c\xdb\x28\x97\x59\xf5\xf6\xa9\xdb\xd4\x63\x10\xfd\xa9\xe0\x13\x3e\x2c\x65\xc3\xef\x4b\xc8\x81\xf5\xf9\xaa\x15\x0b\xd7\x26\xde\x67\x61\x2e\x42\xb2\x18\x21\x4a\x37\x82\xc5\x09\x95\xbf\x4d\x8a\x02\xc4\x04\xf8\x7d\x46\xd6\x89\xb9\xe6\xc1\x61\x08\xea\x3d\x9f\x89\x05\x17\x68\xb5\x38\xa4\x6a\x26\x47\x50\x66\x80\x24\xb1\x05\x7b\x81\x65\x23\x48\x21\x39\x51\xc1\xe6\xef\xb0\xc4\x7a\x44\xc0\x47\xe7\x41\x6f\xdc\x48\x14\x3b\xcc\x07\x1f\xe8\xbf\x27\x3c\xe8\x9f\x11\xf7\x70\x0e\xee\xe7\xac\x84\xb6\xbd\x53\xd4\x61\xc1\x16\x62\x98\xfb\xaf\xaf\x9d\xa2\xf4\x19\x8e\x7c\x54\x6d\xbd\xd9\x3e\xec\xa2\x9c\xf1\xe4\x6c\x29\x57\xb6\xb0\xa1\xd0\xf9\x4c\x63\xf0\x1f\xec\xdc\x3f\x81\x8c\x2e\x10\x5d\x6e\xad\x68\xa4\x75\x22\xb2\x4f\x13\xcf\x74\x8f\xb5\x1e\xec\xd8\x8e\x69\xe4\x62\x50\x27\xef\x05\x7e\x8e\xdb\xd7\x7d\x59\x71\x22\x44\x69\x1c\x3a\xbd\x1e\x05\x12\x13\x11\xf2\x52\xc2\x72\x2b\x64\x20\xfa\xea\x4d\x2c\x6f\x4c\x74\x15\xda\x33\xad\x9f\x7e\xda\x18\x4f\xe6\xc5\xe9\x46\x33\x35\x27\xa1\xd0\x29\xa4\xb4\xaa\x87\x79\x9f\xf5\xaa\xe6\x55\xb8\x4a\xe0\x36\xcf\x62\x4a\x77\x4b\xc9\x58\x1e\x1e\x8b\x05\x32\xec\x80\xe0\x8f\x6f\xd7\xf5\x14\x62\x9d\xd8\x11\xf5\x3c\x2a\x21\x6d\x90\xdd\x4d\x5c\x68\xe3\x96\x8f\xf7\x9e\x3a\xbd\xc9\x7c\xc4\xd4\x2c\x8c\xb0\x5c\xe0\x83\x57\x05\xc5\x40\x9a\x67\x0b\x6b\xd3\x03\xfb\x0c\x52\xd0\x21\xa8\xeb\x7a\x0e\x7e\x10\xdb\x77\xa1\x0f\xd5\x4c\x29\x75\x07\xcf\xdd\xc3\x27\x87\x5d\xc7\x81\xac\x25\x4a\x7a\xde\x15\xb8\x45\xf5\xa1\x53\xd1\x97\x77\x1d\x96\x9e\x7b\xac\x81\x04\x0b\xa3\xdf\xd5\x75\x95\xa3\x89\xd4\x8b\x51\x6f\xb3\xe9\x1c\xf1\xff\xec\xe9\x34\x9e\xe0\x05\xa7\x33\xe8\xf8\x6d\x38\x9d\x4f\xf9\xea\xd3\x59\xe5\xf0\x6d\xb6\x75\x70\xa7\xd3\xb9\xf2\x74\x55\xb0\x40\xcd\x7c\xb9\x7e\xd3\x8a\x49\x12\xb3\x73\x8c\x23\xa0\x16\x98\xfd\x50\x99\xf4\x1c\xce\xdb\x9f\x93\x6f\xd7\x55\x2a\x02\x35\xa6\x90\xd1\xec\xf0\x34\x49\x33\xd8\xca\xa5\xf3\xa4\x30\x86\x41\x38\x7c\x22\xe2\x06\x8e\x55\xe7\x21\x3e\xca\xe0\x6f\x61\xed\x92\x85\xad\x41\xa0\xae\x47\x0c\x64\x68\x4c\x63\x8e\xf6\x50\xea\x41\x77\x26\xd4\x16\xd4\x44\x75\x90\xcf\x62\x4d\x58\x73\x07\xbd\x7a\x72\x18\xd0\x3d\x3e\x78\xf8\xb3\xa9\x8c\x03\xf4\xed\xc0\x4a\x1b\xa9\x34\x29\x31\xa8\x9b\xb6\xeb\x92\x55\x90\x66\x05\xb1\x18\xaf\x08\x1a\x4b\x39\x7a\x70\x38\xb3\x25\xa1\x0d\x42\x19\x60\x18\x97\x05\x40\xf0\xe7\xc5\x29\xc7\xa4\xf9\x12\x3f\x91\xb4\xb3\x68\x80\x9c\xcf\x18\xe1\x82\xf0\xff\x62\x2a\xd1\xd4\x11\x13\xd6\x82\x11\x91\xe4\xaf\x82\xdc\x2d\x36\x70\x27\x83\xb8\x00\xbd\x30\x79\x1b\x8a\xcb\x19\xe1\x10\x17\xfa\x9f\xb5\x43\x42\x19\xa9\x1f\x2e\x6b\x8c\x58\xed\xc9\xd1\xaf\xfa\xeb\x7e\x9d\xad\x2d\xf6\xfb\x9b\x77\x62\xbd\x8c\x44\xe7\x97\xb9\xcc\x67\xa2\x5f\xc6\xd1\xdd\x61\x56\xbf\x3a\x4c\xb7\xe1\x0d\x94\x71\xd3\x23\x7c\x01\xca\x19\x97\x08\x66\xef\xf8\x19\x07\xd2\x10\xd2\x01\x49\x14\xb7\xa2\xc4\x6a\x5f\x1c\x68\x17\xaf\xfd\xea\xca\x82\xe5\xe7\x2a\x65\xbc\x68\x12\xef\x7e\x57\x78\x23\x9d\xdd\x8b\x77\x80\xac\x56\xae\x6b\xf9\x76\xc4\x1b\xf0\xed\x53\xfe\x27\xdf\xfe\xc9\xb7\xab\xf3\xed\x2a\xdc\x01\x62\x05\xb0\x5a\x98\x37\x82\x98\x20\x45\x0b\x29\xf2\xfb\x9b\x77\x51\x09\x30\xe2\x15\x12\x60\x55\xb1\x13\xc4\xec\x83\x19\x9a\x13\x48\x15\x81\xac\x03\x62\x21\xcd\xe7\xf8\xa9\x89\xf0\xd0\x83\xd5\x54\x3c\x68\x52\x52\xdd\x68\x45\x71\x77\xa9\x8b\x50\x96\xfd\x01\x66\x4d\x98\x48\xca\x5a\x72\x7b\x0d\xe1\xa8\xa7\x79\x5e\x70\x48\x38\x03\xb6\x50\xa0\x7f\xe0\xe6\x0b\x18\x69\x0a\xfe\x09\x76\xd8\x07\x8e\x0d\xbc\x93\xb7\xa8\xbd\xf8\x0d\x3c\xb5\x70\x74\xa7\x80\xbc\xc7\x19\x92\x5a\x80\x15\x88\x69\x47\x6e\xe4\x58\x6b\xe6\x1a\xa4\x28\x39\xb3\xe2\xcd\xc4\xfa\x5a\x40\xb3\x85\xb0\x0a\xe5\x48\xd2\xd3\x11\xce\x94\x04\x7f\xc4\x04\x9a\xe8\xf1\xf4\x13\xa4\x2e\x11\xf5\x3e\xa6\x9f\x2a\xe8\x82\x8c\x23\xae\x57\x0e\x9f\x1c\xf6\x03\x09\x4d\xb4\xc8\xdf\x64\x6f\x93\xd1\x08\x26\xb0\xc6\x01\x32\x4d\x60\xaf\xe7\xb0\x2b\xff\x0d\xbb\x3f\xde\xbe\x3c\x7c\xcf\xfe\xdb\xee\xd6\x23\x36\x45\xa0\x05\xfa\xf2\x42\x30\xdf\x7e\x1d\x16\x8f\x00\xe6\xf1\x34\x19\x75\xd5\x13\x19\x0d\x03\x5a\x63\x8a\xdb\xcf\xf3\x42\xa5\xc1\xd7\x89\x50\x18\xce\x37\x44\xf5\x69\x3c\x54\xb3\xae\x68\x0f\x6c\xe6\xc9\x3d\x68\xa3\x9f\xca\x1c\x3a\xd1\x0d\x56\x52\xea\x48\xcf\xa4\xa8\x86\x29\xa0\xc8\x7e\xa4\x80\x88\x99\x8c\x21\xcf\x7d\x32\x1a\x89\x2b\xfe\x6e\x79\xd9\xac\x17\x5f\x7a\x5c\x61\xd6\xcc\xf6\x5a\x87\xd9\x09\x7e\xd4\xa4\xc0\x9f\x69\x32\xa2\xca\x09\x7a\xb0\xb4\x00\xd1\xd5\x64\xae\x13\xa4\x60\x10\xef\xd1\x9c\x21\x70\xf7\x86\xa2\x1d\x71\xae\xc8\x87\x9a\x21\xc1\x7a\x40\xe5\x8b\xfb\x62\xfa\x4c\x86\x95\xff\x72\x0a\x84\x9a\x15\x0e\x01\xdd\xae\x9f\x7a\x01\x9a\xc5\xaf\x40\x2f\xb0\x9c\xb3\xb1\x30\x67\x5d\xbc\xf7\xbd\xbd\xf0\x7b\x71\xac\xc5\x7a\x5b\x8b\x59\x25\x52\x2a\xb3\x7b\xad\xf8\xb1\xdb\x64\x29\xbb\x17\x3b\x96\x4f\xa1\xcb\x94\xf6\xe4\x4d\x3b\x2e\x86\x1c\xec\xd8\x80\x69\x2e\x53\x8b\x7f\x02\xa8\xe8\xd8\x53\xda\x0e\x8c\xc3\xc1\x68\xa4\xa0\xd9\xdf\xc4\x5c\xc3\xfe\x6a\x52\x2a\x98\x8d\x3d\x30\xbf\x66\x53\x2d\x50\x04\xcb\x9e\x26\x90\x71\x96\x67\x8a\x51\xef\x46\xca\xcc\x33\x57\xce\xdc\x89\x44\x98\x67\x96\x4c\x88\x0a\x84\x79\x16\x10\x09\x21\x39\x00\xc7\xa0\xcd\x56\x06\x4e\x86\x3c\x6c\x2b\xd2\xd0\xb2\x49\x52\x94\xe2\xb9\x9e\xa7\x8d\x79\xfd\xb1\x65\xb3\xf3\x7d\xc8\x38\x04\x87\xc4\x76\x3e\xe1\x61\xb0\x00\xb9\xa0\xe1\x80\x38\xd8\x9f\x1d\x70\x03\xbb\x6d\x4a\x3b\xf2\xe7\xa2\x7e\xfe\xef\xb1\x11\x14\x3a\xa2\x10\xde\x59\x08\xed\x2a\x54\xed\x03\xdd\x5c\xd0\x3f\xec\x5d\x5c\x4b\x6f\xee\x07\xbd\x5a\x13\x8f\x72\x25\x41\xba\x73\xf8\xe4\x30\x06\x05\xe7\xea\x5a\xd3\xd7\x87\x53\x3d\xcf\xda\xd8\xf0\x21\x0a\x25\x18\x21\x70\x71\x8e\x00\x07\x80\x04\x4e\x3a\x04\x0e\x98\xee\xc3\xa2\xba\x63\xbf\xc7\xb6\xbd\x26\xae\xad\xd3\x08\x0b\x04\x06\xca\x05\x8c\xfd\x11\xb1\x6b\x10\x03\xa8\x94\xb1\x63\x86\x02\x8f\xd9\xf1\xa1\x7d\xbf\x80\xb0\x9b\x61\x04\xd2\xf3\xc0\x57\x74\xec\xc2\x77\xf8\x19\x68\x5b\x06\x36\x9a\x89\x8d\x65\x7b\x23\xb1\xa4\xca\xd2\xb6\x83\x4b\xbc\xc1\xa1\x38\x88\x6d\x90\x1c\xd6\xba\xf0\x84\xfa\x44\x3b\x28\xc3\xd6\xfe\xd6\x66\x75\xf3\x76\xf4\x4a\x9b\x6d\x6e\x2d\x8c\x81\x76\xb5\xb8\x03\x83\x83\xf3\x92\xf3\x29\x38\x4f\x26\x1c\xa4\x60\x9e\x29\x0e\xc0\x1b\x3d\x64\x24\xa8\xd0\xd7\xf3\x2c\x28\xb7\xcc\xfa\xe6\x2c\xcd\x9e\x88\xe2\xb8\x88\xef\x55\x6b\x62\xac\x24\xc6\x9b\xe2\x2f\x7d\x6c\xf0\x42\x66\x22\x01\x87\x3a\xfb\xf9\xe7\xa0\x3f\xde\xd2\xa3\xc7\xa3\xfc\xed\x52\xbe\x0c\x83\x89\xed\x4c\xb1\x6b\x55\x34\xdb\x74\x4b\x3a\x6e\x92\xe2\xd0\x12\x46\xe8\xe2\xab\x00\x41\xab\xe6\x6e\x96\x90\x61\xcb\x3c\x19\x09\x3b\xc0\xff\x88\xc8\x00\x52\xd8\x08\x94\xc3\x54\x31\x5a\x5b\x78\x9c\xef\x6c\xc5\x8b\xad\xf6\xe8\x2a\x79\xa9\xcd\xf8\xdb\x63\xab\xdb\xc5\x3b\xd8\x9e\xb0\xfc\x6a\x66\x40\xb0\x61\x2b\xd4\x9e\x37\x1a\xeb\xc1\x9d\xc2\x50\xd5\x18\x6d\xeb\x99\x55\xa1\x77\x55\x7b\x51\x70\xb0\x70\x02\x51\x3e\xa0\x49\xf2\xb1\x32\x0d\x84\xab\x94\xc3\xb5\x4c\x95\xa6\x07\x31\x45\x4d\x28\x8d\x30\x3f\x66\xc9\x05\xc2\x82\x57\xdd\x9a\xca\xe4\xe8\x07\xe8\x5b\x62\xa4\x34\xa9\x6d\x36\x1e\xe7\x85\xb9\xd7\x48\xb6\x0e\xfc\xe9\x85\xe3\xc6\x00\x15\xc9\x44\xfa\x83\xc5\x0f\x01\x4c\x38\xec\x13\x08\x0e\x62\x23\x0e\x17\x1c\x0b\x41\xa1\x24\x46\x10\xa0\xda\x4a\x35\x76\x3e\x89\x1c\xd4\x2f\x83\x55\xe3\x76\xcd\x32\x3d\xab\xb6\x6f\x86\xa7\xfc\x6c\x39\xb8\x61\x83\x13\x5d\xe6\xf0\x88\x17\x56\xa1\xfb\x34\x04\xe2\x48\x9c\x11\xba\x36\xf7\x2d\x0a\xdc\xf0\x40\x8a\x7a\xeb\x9a\x61\xc3\x3c\x3b\xe7\xb3\xd2\xb2\x61\x05\xa1\xea\xe0\x4e\x11\xf1\x29\x8f\x01\x74\x03\xb6\xab\x9c\x99\xb7\xb2\x8a\x32\x5e\x9d\x97\x0d\xe3\x61\xea\x8f\x58\xd9\xa8\x55\x99\xb5\xce\xe9\x26\x8a\x8f\x13\x27\x0a\x37\x7c\xe1\xe0\x4e\xf3\xa2\x48\x21\x86\x3e\xca\xb1\x4b\x2e\xef\xcc\x16\x80\x1e\x93\x40\x3c\xa8\xfc\x86\xf6\x4a\xeb\x9b\x07\xc5\xb8\xcf\x81\x83\xf7\xc9\x6f\xcd\x6f\x9d\x60\xa5\xaf\xfc\x6a\xdf\x3e\x17\x15\x2c\xe6\x9c\x94\x0a\x17\x02\x36\xde\x17\xff\x5f\x53\x50\x73\xe5\x3e\xf3\x22\x5b\xc3\x35\xd0\x84\x77\x23\x13\xc2\x85\x91\xe7\x48\x79\x58\xa5\x83\x63\xf1\x51\xb8\x02\x11\xe1\x0f\xc3\x25\x34\xe3\x10\xa0\xf2\x5d\xf7\xcd\x94\x67\xef\xdf\xbf\xf2\xaa\x35\x3f\x4c\x46\xa7\xdf\x33\x3b\x80\xfb\xba\x67\xe9\xe5\x8b\xcc\x2e\x17\x53\x47\x2e\xe7\x1c\x4a\x2e\x2e\x16\x63\xe3\x38\xdf\xf8\xa2\xc4\x39\x1b\x18\x91\x26\xb0\xa8\x8b\x03\x47\xbd\xfb\x4d\x9d\xca\x00\xf1\x24\x7f\xc3\x9d\x3a\x0e\x8f\x57\xc0\x11\x77\x7e\x15\xf2\xc2\x2f\x71\xeb\x31\xde\xee\x57\x1a\xc4\xf8\x8c\x65\xe2\xa0\xba\x27\x00\xf1\xba\x7d\x5e\x56\x34\xd0\x94\xa5\x25\xf2\x6c\x60\xf3\xf6\x3d\xb6\xb1\xd1\x6f\x5e\xbe\x5b\xe6\xf2\xb6\xa1\x56\x7b\xa9\x5a\x11\x52\x8d\x12\xa6\xaa\x48\x89\x53\x8f\x8d\x47\x99\x38\x67\xa6\x04\x64\x0b\x05\x2a\xc3\xe6\xa0\x4e\x57\x4d\xe2\x95\x5a\xf6\xae\x39\x4b\x9d\xb0\x9d\x24\xa9\xac\x6e\x5f\x43\xb6\x03\x12\x13\x9b\x8c\xee\x6e\x60\xcf\x43\xc4\x19\x02\x8d\xc5\xf5\x35\xc4\x6c\xa0\x5b\x53\x83\x58\xbd\xdd\xa1\xb9\x2e\xb1\x49\x97\xb2\x5c\xa6\x9a\xb9\x0f\x59\x50\x92\x52\xa8\x97\xa6\x6c\x48\xd5\x82\xa3\xc4\x88\xf9\x63\x35\xba\x20\xfb\x2d\x83\xdc\x4d\xf8\x3b\x61\xfc\x73\xd9\xf6\x7b\x45\xde\x11\x42\x26\xd4\x45\x47\x20\x4a\xdf\xba\x78\x63\xe9\x5b\xe3\x96\xd1\x5a\xd6\x65\x41\xa3\x7f\xfb\x7e\x35\xd0\x90\x6e\x05\x78\xd7\x5f\xb7\xcb\xc2\x32\x56\xf4\xd7\x7e\x0d\x8b\x58\x28\xee\x21\xab\xe0\x6b\xc7\x70\xc8\x7b\xff\x71\xfb\x72\xf7\xc1\x5e\x6f\x6f\xf8\x48\x5c\x6c\xb0\xb7\xbb\xf7\x70\x77\xbc\x3b\xfe\xd4\x56\xae\x78\x01\x5a\x3d\x98\x7e\x58\x83\x6a\xd6\x91\x0d\x50\x88\x8e\xc5\xf5\x7a\x50\xa0\xe8\xba\x46\x90\x81\x95\xb8\xf7\x70\x71\x9d\x57\xc5\x63\xf4\xbc\x52\x63\x46\x41\xee\x53\x7c\x62\xe8\x74\x69\x96\x51\xac\x67\x33\xf4\x12\x3c\x7c\x53\xbc\x17\x33\x46\x1d\xd6\x9b\x26\xb3\x82\xb7\xcc\x00\x38\xbb\x37\x16\xdf\x89\xb2\x94\xe7\x4c\xb5\x10\xc7\xbd\x85\xe2\x22\x88\x72\xef\x61\x0d\xd3\x49\xc2\xf0\x90\xd1\x3a\x25\xc0\xc7\x55\x10\xd5\x66\x9c\x79\xa3\x2e\x85\xf0\xc0\x7d\xe0\x85\x30\x1c\x84\xbd\x6b\x7f\x05\x06\x75\x60\x7e\xdc\xfe\x04\xbe\x15\xc3\x7b\xe0\xad\x73\xcb\xf4\xb0\x8c\xe2\xc9\x20\x83\x6f\x6d\xb1\x67\x97\x72\x03\xc3\x6f\xd9\x91\x30\x1e\xf3\x3b\x2d\x62\x28\xcf\x0e\x6c\x2a\x7b\x43\x66\xef\xc7\x08\x88\x62\x2b\xc8\xc0\xf0\xcb\x7b\xf0\xe1\x56\x25\xde\xda\x76\x77\xad\xbd\xe2\xd6\xae\x4e\x6f\xaf\x99\xa0\xa0\x2c\xa3\x7a\xf8\x2d\xb2\x0c\xea\x90\xd5\x09\xbb\x0e\xd9\x21\xaa\xff\x46\x98\x6c\x9a\x04\x2a\x17\x33\x38\x44\x0a\x56\x63\x52\x62\xf3\xca\x98\x86\xde\xc2\xb5\xd3\x09\x2b\xc8\xa9\xb4\x4a\xee\x55\xbc\x01\x53\xe5\x1f\x65\xd3\x4b\xe5\xe0\xa7\x86\x0b\xe6\xe5\x37\x7b\xb4\xd1\xa3\x6c\xfc\x2a\xab\x5e\xf9\x08\x68\x6f\xb1\xb3\x22\x40\x10\x62\xbd\x04\xd5\xe8\x05\x86\xd9\xd8\x41\x49\x12\x91\x19\x5e\x8a\x28\x87\x17\x24\x6e\xfb\x9e\xa9\x16\xdb\xc6\x71\x07\x08\xb7\x1d\x60\xde\xf0\x34\x5d\x53\xa3\x4b\x99\x5b\x41\x3f\x53\x68\xa9\xa3\xeb\x6b\x7e\xd4\xa7\xcf\xb1\x69\x01\x4a\xfd\x36\xe7\xce\x6b\xa0\x58\x8e\xbc\x6e\xb5\x97\xe3\x36\x73\xb8\x84\x55\xd8\x41\x25\x0b\xdc\xba\x02\x03\xb6\xf4\x95\x58\x98\xad\xf4\xf9\x4a\xdf\x1b\x61\x1d\xb5\x6c\xf7\xef\xb8\x41\x93\xa4\x86\x5d\x7f\xc7\xc6\x3b\xc8\xfe\x51\xe7\x87\x8b\x1d\xe1\x63\x6c\xcc\xb7\xb8\x3b\xac\xfe\x90\x6c\xb3\x14\x3a\x0b\x65\xcf\x41\xbe\xb7\xdf\x93\xec\x06\xb9\x39\x7c\x82\x3a\x05\x6b\xc8\x84\x55\xc1\x33\xab\xf6\x3c\xb0\x81\x81\x65\x12\x24\x60\x67\x97\xb1\x4c\x0e\xc7\x9f\x2d\x98\xc3\xf1\xe7\x00\x14\x8c\x37\x02\x89\x8b\x0c\x19\xd7\x47\x76\xe5\x2a\x75\x5a\xa5\xb4\x2d\xfd\xea\x17\x54\xae\xc5\xc0\x27\xa0\x69\xdd\xb7\x6e\x7a\x1e\x28\x42\x7c\x84\x48\x2f\x7e\x19\x70\x03\x52\x40\xf0\x1c\x00\xa5\xfd\x81\xa6\x24\xbe\x0a\x14\x26\xbe\x40\xa4\x02\xfd\x26\x50\x1a\xd5\x22\x9f\xed\x03\x5d\x20\x57\xd8\xc5\xae\x1b\x2f\x5b\x70\x1f\xba\xd0\x86\x84\x76\x7c\xd1\x6d\x99\x80\x64\xbc\x21\xf5\x44\x09\x40\x6b\x28\x83\x00\x01\x28\x1e\xcb\x3c\x98\x14\xe5\xc7\xd7\x53\x04\x31\x68\x40\x67\x3a\xbe\x03\xdd\x64\xb2\x2a\xd7\x8b\xe9\x11\x8f\x8a\x69\x45\x76\xa3\x43\xf7\x44\xfd\xd2\x22\xfb\xa6\x51\x34\x84\x22\x09\xea\x06\x55\xca\x88\x47\x55\xca\xd0\x1d\x8f\x3b\xd2\x2a\xe8\x71\x40\x23\x17\xb8\x8c\x32\x93\x03\x58\xe3\xa8\xe0\x1f\xcb\xf5\x2b\x45\xde\x48\x93\x50\x73\x28\x29\xec\xf7\xee\xe4\x59\x4a\xec\x29\xf7\x94\x98\xd1\x4d\xa6\x61\xe2\xb8\xf2\xda\x45\xf6\xd1\x4d\x34\x15\x6b\xda\x1b\x13\x14\x6b\xee\x58\x51\x21\xbb\x29\x7d\x4c\x49\x51\xcc\xcf\xf8\xc8\x2a\xc6\x92\xc9\x8c\x27\xa3\x2b\xa2\xef\xac\x05\xd9\x3c\x93\xd7\x56\x56\x45\x67\xa2\xec\x59\x4c\xec\x75\x83\x30\x16\x5a\x34\x41\x92\x44\x11\xa2\x3e\x2b\xf8\xc2\xb2\x5e\x09\x31\x8a\xb5\xf4\x17\xcd\xb3\x45\x61\x7d\x47\x8b\xdb\x23\x79\xe9\xeb\x7d\x83\x3d\x52\xe2\x21\x2a\x03\x8e\x3d\x9f\x92\x01\xa5\x2b\xbb\x8c\x4f\x72\xd7\x98\xd2\xb5\x29\x6c\x70\xc4\x51\x3c\xf9\xac\xea\x6e\x6c\x90\x28\x9b\x38\x34\x03\xc0\xad\xb9\xbe\xe6\xfc\x0c\xef\x90\xbc\x0c\x87\x48\xd4\x6d\x99\x7c\x1d\x8d\x61\x76\xba\xf2\xdf\xea\xcd\x92\xba\x28\x0c\x02\x18\xab\xbc\x14\x50\x45\x2b\xcd\xf6\x49\x9e\x42\x90\x87\x48\x9b\x0a\x8d\x01\x2f\xc3\x89\x21\xe9\x30\x98\x62\xbe\xa0\x6e\x94\xfe\x14\xf7\x29\xa6\x55\x35\x30\xf2\x02\x1f\x84\x9d\x02\xcd\x08\xc0\xdd\x20\x04\x3f\x51\x39\x17\xb9\xc4\x21\x56\x03\x80\x14\x2a\x0e\x00\x43\x66\x3e\xf3\x0c\xec\x0d\x5e\x0d\x2d\x3d\xaf\x06\xf6\xe2\xb7\x7a\x58\xda\x58\xd1\xa2\x09\x1c\x59\xd4\x48\x3a\x60\x7b\x0f\x45\xee\x19\xf1\x01\x0d\x3c\xd8\xfc\xcc\xcf\xd2\xb2\xe4\xa3\x0e\xec\xee\xc2\xa7\x8b\x74\x32\x61\x27\x5c\x37\x38\x62\xb3\x24\x1b\xe5\x67\x13\xdb\x28\xb3\xd1\x08\xcb\x9b\xf0\xe2\x5e\x9e\x28\xc4\x61\xea\xb0\x17\xbf\x75\x84\x78\x06\xbc\xee\x40\x0a\x09\x52\xe5\x23\x5f\x04\x11\x6a\xec\xf2\x4b\x3e\x9c\x97\xbc\xb5\xa1\xb2\x51\x6d\x74\xf0\xe2\x8e\x0e\x86\x6f\xb5\xfb\xb7\x09\xbd\xc3\x36\x60\x38\xe0\x7f\x4e\x9a\x3d\xe4\x26\xf8\x8b\x60\xa8\x74\x53\xd4\xdc\x51\x24\xda\xc1\x84\x6f\xd2\xb1\x19\xb2\x71\xfe\x86\xd3\x8c\xb3\x1c\x71\x54\xff\x14\xdd\x4a\xf2\x9c\xc8\x12\x4e\x6b\xef\xe1\xd6\xe3\x76\x8d\x87\x56\x1c\xf7\x84\xe9\x20\x7c\x1d\xbc\x41\x98\x0d\x98\xbc\x78\x40\xaf\x38\x55\x07\xf7\xd5\x0f\x76\x0f\xbb\xca\xae\x61\x93\x4a\x5c\x4d\x4c\xc6\x03\xd0\xf4\x6c\x15\xf0\xf7\x73\xe0\xc6\xb2\x1a\x83\xf4\xdc\xea\x21\xe2\xa0\x2f\x83\x46\x07\x39\x22\xd2\x56\x63\x0e\x47\xa0\xdc\x11\xb0\xae\x86\x36\x57\x38\x87\x0f\x4b\xbd\x43\x53\x6a\xc1\xc5\xb8\x59\x52\x6b\x2b\x7a\x39\xaf\x76\xc8\x33\x60\xb9\xb8\x47\x28\x97\x95\xc8\x73\x44\xb2\x32\xd2\x36\x63\xe6\xd7\xf2\xfe\xf0\x40\x96\x38\xed\x10\x0f\x7f\x0b\x58\x17\x68\xac\x57\x28\x9e\xe5\xfd\xe3\x2f\x9f\x1e\x5d\x33\x50\x9f\xb8\xa0\x0d\x6a\x61\x63\xe5\x29\x61\x5b\xad\xe2\x16\xf2\x8a\x07\x3a\x4c\x56\x28\x0e\x27\x7f\x1d\x8d\x35\xd0\x97\xa3\xf1\xf2\xee\x71\x3c\x52\x45\x90\xa6\x15\x82\xba\xeb\xc7\xf0\x97\xab\xba\x41\xeb\xe0\x07\x70\x47\x34\xd1\xac\x77\xa0\x3e\x0d\xf7\xfa\x3a\x2e\xc2\x7c\x8d\x3c\xc9\x1b\x6a\xa8\x1d\x7d\x77\x87\x0d\x83\x5b\x64\x79\x77\x03\xb6\xe5\xaf\x35\x48\x5b\xaa\xa9\x3b\x73\x38\x38\xda\x35\x2f\x0d\xc9\xd8\x65\x43\xb6\x0b\x48\x0b\x30\x5b\x94\xb9\x42\xb0\x17\x73\xd1\x45\xed\xa5\x9f\xa5\xda\x0b\xe1\x01\xa7\x3b\xa5\x19\x2b\xfd\x74\x76\x01\x68\x48\xa8\x58\x0b\x83\x6e\x30\x25\x01\x4a\x1c\xfb\xbd\x4d\x1f\x11\x61\x8f\xcc\x4f\x12\x40\x78\xf3\x63\xb7\xaf\x5d\x20\x01\x34\xe0\xb6\x79\x71\x19\x88\xa8\x10\x1c\x52\x83\x10\x06\x66\x5a\xd0\xbd\x51\x42\x3e\x37\xb5\x9a\x3a\x4e\xaa\xfd\xc1\xdf\x41\x1e\x53\x49\xa5\x45\x32\xc1\x68\x41\x07\xf1\x8f\x2e\x98\xb5\xfa\x91\xfe\x15\xed\xf8\xba\x03\x61\xac\xdb\x6a\x22\x0c\x47\x3c\x2a\x0c\x83\x8e\xe2\x95\x04\xe3\x8d\xa3\x68\x08\x46\xb9\x7e\x6e\x4a\x70\x8f\x78\x54\x70\x9b\x46\xbf\x83\xec\xbe\x73\x67\xf1\xdd\xab\x0a\xf5\x08\x88\x77\x63\x2b\xb4\x9b\xd3\x1d\x28\x29\xed\xf7\x2e\x91\x06\x54\x07\x52\x48\x48\x75\xd0\xf1\x6d\xa8\x3d\x50\x6e\xe8\x36\x03\xcb\x31\x7a\xd3\x25\xbd\xd2\x12\xef\xaf\x84\x4b\x29\x1f\xfc\xa8\x97\x52\xde\xf8\x1d\x94\x8b\xde\x3d\x69\xdd\x39\xe9\x21\xe6\x5f\x3f\xe9\x5c\x3b\xa9\x2a\x46\x2e\x9e\x04\x72\x21\xc0\xf5\x4f\xfb\xf2\xc9\xd7\x49\x79\xda\x61\x81\x2b\x28\x95\x1c\xdf\x64\xaf\xf2\x61\x32\x61\xd3\x7c\x72\x35\x4e\x27\xe0\xea\x93\xab\x09\x5c\xc5\xaf\xaf\x59\x72\x0a\x5a\xc5\xdc\xf6\x03\xbb\xa0\xd3\xb0\x25\x98\xf4\xeb\xa3\x56\x9b\x38\x71\x09\x11\x9a\x8a\xf9\xc9\x17\xab\xae\x6a\xb5\x98\x9f\xc0\x0c\xba\x44\x7c\x04\xe7\x7b\xcb\x1c\x3e\xb1\x01\x84\x72\xb9\x05\xb0\x1e\x1b\xc0\x4d\x57\xec\xa8\xd5\xae\x86\x90\xcd\x27\x93\x08\xa3\x68\x0c\xd6\xdc\x8c\xde\xc0\x22\x58\xc7\x18\x45\x64\x0a\xab\x9d\xdc\x51\xc7\xf6\xab\xf4\x64\x96\xcc\xae\xea\x7c\xe4\xe4\x7e\xd0\x28\x28\x50\x6e\xb8\x36\x13\xc6\x85\xea\x72\x32\x61\x69\x76\xca\x67\xa9\x95\xc0\x75\x73\xab\xe2\x9e\x51\x3f\xc3\xa8\x3f\x9d\xce\xfc\x19\x4c\xd4\x7f\x9b\x98\x6f\x06\x8f\xc7\x29\xd4\x84\x33\x07\x11\xc2\x54\x1e\xc0\x25\xf8\xb9\xeb\x02\xf1\x80\xba\x76\x57\x7e\xce\x67\xb3\x74\xc4\x0b\x38\xf7\x0b\xa7\x28\x53\xc8\x95\x06\x02\x7c\x7a\x05\x77\x3d\xc0\x61\x52\x4e\x10\x68\xd0\x82\xea\xa1\x6a\xe2\xc3\xa2\x00\x3c\x9b\x2b\x52\xce\xb7\xbd\xc2\x05\xd5\x44\xbd\xbe\xfa\x20\x49\x58\x91\x5d\x57\xcd\x98\xd2\xef\xdf\x82\x75\xe1\xcf\x38\xe5\x93\xd1\x3e\xdb\x10\x37\x65\x6d\x74\x1a\xb5\x09\x7f\xce\x78\x79\x9a\x5b\x29\x5b\xda\x2c\xde\x0a\x6a\x95\xca\x83\x67\x94\xfc\xd4\x7f\x12\x7d\xda\x8a\x9e\x57\xbf\x39\xf4\xa6\x4e\x93\x8b\xcc\xff\x42\x04\x09\x18\x0b\xe6\x5a\x3d\x4f\x2a\x20\x9c\x83\xf9\xe7\x33\x9e\x39\x7e\x68\xe5\x8b\xae\xc6\x82\x08\x1f\x5c\x77\x99\xf2\x6e\x8f\x03\x2e\x69\x3b\x4c\x0a\xcd\x38\x75\xd1\x58\xfa\x4f\x3e\x0b\xe3\xf4\x93\x6a\xf2\x34\x29\xde\x5c\x64\x48\xf6\x57\xad\x0d\xa8\xb9\xd1\xd6\x67\x9e\xe0\x51\x68\x59\x55\x1e\x5e\xd4\xf6\x03\x0a\xb1\x01\x99\x88\x68\x0d\x5a\xeb\xbf\x17\xf3\x29\xac\xca\xb3\xb4\xec\x26\x60\x9c\xa2\xea\x4b\x66\x9f\xe7\x30\xba\xc1\xf1\xa0\xf2\xb5\xc1\x38\x99\x13\xb7\x70\x9b\x8b\x91\xe8\xa9\x74\x0a\x23\x2a\xc8\xa5\xeb\x6b\x31\x54\xa1\x83\x96\x3e\xc0\x0f\x11\xf2\x78\xc7\xe1\x8a\x64\xb8\xd4\x49\x74\x11\xea\xc4\x61\xcb\x61\x40\xbb\x38\x04\x10\x45\x8b\x6e\xd2\x2a\x60\x2d\xbd\x63\x42\xf5\x99\xe0\x14\x3c\x3c\x89\x32\x55\xb8\xbc\xe7\xe0\xf4\x3b\x85\x2d\xbd\xb4\x44\xae\xf5\x44\x15\x3b\x50\xf3\x61\x6e\xb0\x69\xb5\xf5\x36\x1c\xac\x3b\x44\x4a\x39\x01\xa4\xd5\xfe\x57\x96\x95\x2a\x63\x39\x1b\xa0\xd8\x44\x23\xa6\x55\x2b\x8b\x64\xb9\x6a\x89\xe7\x37\x02\xf3\xa1\xa4\x70\x88\xd8\x55\x51\xca\x23\xea\x9d\xcd\x27\x51\xaa\x51\xc5\x97\x21\x9b\xaa\xe3\xea\x91\xb9\x62\x6f\x50\x98\xe1\x25\x01\x82\xa4\x80\x38\x92\xd1\x88\x15\xf9\x19\x97\x57\x4f\x41\xd2\xdc\x8c\x5d\xe5\xf3\x19\x02\x2a\x58\x02\xe6\xac\x18\xc5\x51\xb7\x76\xc2\xee\x54\x0b\xba\x07\x9d\xdb\x2c\x5e\x16\x05\x40\xb7\xeb\x8f\xcc\xaa\xea\xae\x16\x8d\x46\x73\x7a\x98\x4f\xc1\xd8\xc1\x8d\x20\xf8\x89\xf6\xce\x0a\xe6\x14\x01\x06\x66\x0f\x79\x2c\x73\x48\xce\xca\xd2\xac\x01\xd0\x45\xa6\x14\xb9\x53\xea\xcf\xa6\xf6\xcb\x92\xe3\x2e\x1a\xa1\x03\x6f\xfa\xe7\x53\x02\xcd\x78\x88\xe5\xae\xfe\x9e\x9c\x81\x84\x65\x35\xf5\x94\xaa\x36\xc5\x5c\x65\x8d\x5f\x04\xc0\x76\x14\x86\x3a\x6e\xff\x91\x16\xff\xc4\x06\xa4\x7d\xfb\x93\x3b\x00\x11\x3a\xad\x50\xb2\xcf\xd8\x45\x9e\x6d\x94\xd2\x7e\x56\xc7\x1d\xd1\xb3\x0b\x6a\x76\x92\xe7\x53\x96\x9c\xe4\xe7\x3c\x6c\x9d\xc4\xbb\xbc\xa1\xa0\x6d\xc4\x3b\x0c\x9d\xd5\x87\x2c\xad\x7e\xea\xb7\x81\x2e\x5e\x2f\xc3\x3c\x7a\x71\x22\x7a\x9a\x8f\x17\xe4\x9b\x90\xe2\x1b\x4e\xf2\x8c\x77\xdd\xb2\xab\xb1\x07\x50\x9f\x80\xcb\x06\x5a\x01\x74\xc5\x8b\x06\x9a\x0c\x8a\x55\xca\x19\xa2\x5d\xb4\xcd\x68\x8c\x23\x25\x4f\x83\xf1\x64\xeb\x6b\xae\x0d\x17\xcb\x09\x7c\x90\xb1\x04\x82\x19\xdc\x5c\x84\x6a\xa4\x4c\x49\xb2\xa9\x8d\x6e\x6e\x93\xe7\xbd\x1a\x04\xad\xaa\x82\x9d\x74\xf0\x83\x9d\x0e\xb1\x48\x3f\x67\xe9\x38\x1d\x26\x90\xfb\x59\x7c\x17\x09\x83\xd2\x42\x60\x25\x51\xd5\x70\x71\x44\x61\x16\xb4\xf3\x5d\xaf\x95\xe9\x9b\x88\xfe\x59\x24\x6d\x8c\xdf\xbc\x4b\x16\x46\x5e\x5b\xc3\x43\xf6\x1d\xea\xc6\x3a\x1c\x16\xa6\x47\xca\xd9\xc1\xa8\x1d\x34\x1e\x80\xbe\xbe\x56\x4d\xeb\x76\x87\x42\xe7\xb3\x2d\xc5\xae\x07\x39\x68\xc0\x2d\x03\xe0\xe3\xf6\xe5\xf6\xf6\x76\x6f\x7b\x67\xfb\x01\x1c\x38\xdf\x7e\xb8\xbd\xbb\xbd\xb7\xfd\xe8\xd3\xad\x01\xee\xb0\x3d\x1b\x36\xe1\x53\xd7\x14\xc0\xf4\x75\x6a\x46\x3c\x8e\xbd\xc0\x03\xc1\xc0\x93\x5d\xf5\x20\xff\xfd\xe3\x0f\x91\xb3\xd7\x2e\x0f\x6a\x48\x4f\xef\x4f\x24\x95\x79\x50\x16\x08\xa8\xba\xf8\x40\x13\x46\xe3\x60\x52\x17\x80\xc0\xac\x3b\xe1\xd9\xe7\xf2\x14\x82\x94\x5c\x40\xeb\x6b\x41\xa9\x1d\x8d\xd8\x76\xf8\x74\xb1\x4c\x31\xcf\xb2\x61\x3e\x02\x7a\xe7\xf2\x87\xbb\x61\x27\x5e\x57\xe7\xfe\xd4\x04\xc0\xb3\x61\xf7\x39\xbf\x8c\xb7\xa9\x14\x44\x2c\x81\x4c\x23\x6e\x6f\xca\x3d\xb8\x71\x33\x20\xc4\xda\x20\xb3\x8b\x2e\xac\x95\x6b\xab\xbd\x78\x15\x6b\x48\x7e\x2d\xc7\x8f\xa3\xb4\xae\xaa\x50\x7a\xc7\xa9\xf0\xa8\x11\x87\x4f\x7d\x07\xaf\xc6\x73\x7e\x59\x99\xbe\xa5\x86\x80\x86\x49\xc9\x33\xd4\xf9\x36\x05\x45\x84\x7f\x9c\x90\x34\xe7\x5f\x93\x01\x87\x09\xb6\xe1\x42\xf8\x5a\x36\xaa\x00\xa7\x68\x84\xc0\xfb\xd0\x18\x97\x26\xb4\xa1\xb1\xeb\xa9\xfc\x19\xfa\xcd\x4e\x74\x9a\x64\x49\x57\x28\x89\x4a\xde\x34\x91\xc3\x82\x81\xbd\x3b\x18\xd8\x7f\x78\x02\xab\x1f\x2a\x98\xe8\x54\x07\xba\xb1\x8a\xd2\x69\xf1\xde\xc8\x18\x4b\xe6\x44\x80\x93\xe2\x06\xbe\xa9\x63\x57\x02\xa7\xdc\x24\x39\x9b\x32\x7e\x09\xf9\x16\x45\x2a\x64\xbb\x84\x68\x72\x08\x65\xfc\xdd\x06\xa8\x2d\x46\x30\x9c\xe9\x53\x63\xf2\x5f\x91\x44\xe9\xa2\x3e\x98\xa4\x90\xd6\xf5\x0a\xe2\xf4\x4a\x96\xb0\x32\x3d\xe3\x7e\xd9\x50\x4a\x76\xda\xdd\xf8\xa5\x10\x74\x70\x60\xe0\xc0\xe7\xaf\x67\xe1\x63\xaa\xb2\x62\xc3\x3f\xad\x9d\x87\xec\x3e\x6b\xa5\x12\xe3\x4d\xf6\xb8\xdd\xd6\xd9\xb2\x83\x70\xf5\xb4\x7f\xb4\x7b\x7c\x8f\xa5\x3a\xdd\xf6\x1f\x03\xd3\xf4\x2f\xbf\xa8\x36\x02\xe5\x75\xa3\x0d\x0c\xff\xa8\x5a\xa2\x63\x0a\xf3\xbf\xe2\x98\xc6\xb3\xdc\x37\xed\xfe\x80\xf9\x83\xdd\xa0\x83\xeb\x6b\x71\x65\x7b\x6f\x60\xa3\x19\x20\x4b\xb8\x6a\x0e\x36\x9b\x83\xa2\x16\xa0\xf5\x1b\xea\x63\x99\x13\x1d\x8c\x40\xcb\x30\x4c\xcb\x55\x4d\x41\xc3\x9c\x9a\xbb\x22\x42\x0a\x3e\x53\x19\xb5\xa0\x68\xf2\xed\xa8\xbe\x5f\xa8\x88\x0a\x99\xb0\xc0\xb0\xdf\x8a\x26\x3e\x6a\x18\x38\xf3\x3f\x43\x42\x95\x31\xfe\x27\x48\xff\xc1\x0e\x90\xbe\x2e\x17\x23\x78\xcb\x94\x1a\x30\xd8\xf7\xed\x0e\x79\x3a\x31\x35\xb7\xec\x20\xf0\x6a\x8b\x2a\xbc\xfe\x6d\xa4\x79\x82\x6a\x2b\xb0\xfc\x5d\x62\xfe\xe9\x72\x97\xd2\x82\xb7\xde\xb5\x69\xa1\x6e\x9d\x4b\xc1\x8a\x95\x9a\x78\xb0\xb2\x9f\x5b\xe5\xe5\x67\x9f\x46\x30\xfc\x7e\xdb\x13\xfa\x38\x24\xa2\xde\xe2\x53\x60\x46\x9d\x8d\xd3\xc9\x44\xa5\x9d\xc5\x63\x12\x62\xbd\x55\x6f\x94\xa8\xc5\x5c\x16\x5a\xf4\x52\x50\xd1\x23\x3e\x8d\x66\x19\x21\x35\x22\x14\xcf\xb9\xbf\x24\x45\x34\x58\x82\xe1\x51\x90\x5e\x7c\xa5\x25\x4b\x50\x32\xc9\xc2\x4b\x2c\xd5\xa2\xba\x04\xc5\xff\x3a\xb3\x77\xc8\xcf\x8e\x2f\x3c\x20\xaa\xe8\xd9\xf1\x05\x64\x97\x3f\xbe\xe8\xc7\xbe\xff\x13\xd4\xcc\xe5\x83\x64\xc4\xf7\x1e\x9f\xf4\x62\xc5\x92\xe2\x2b\xa3\x92\xc3\x45\x8b\x10\xa1\x41\x2c\x88\x15\xfc\x95\xad\xb6\xb6\x2f\xff\xb2\xbd\xf7\x17\xb6\xc9\x5a\xf0\x02\x74\xfa\xd1\xd1\xd1\x51\x9b\xdd\x93\x2f\xfe\xfa\x57\xb6\x7d\xd9\xdb\x16\xea\xfe\x2c\x29\xbe\x06\x70\x83\xbf\xb2\x8b\xad\xed\xcb\x87\x7b\xbb\xdb\x12\xd8\x85\x0b\xec\xa2\x29\x30\xe8\xec\x8c\x17\xb0\x13\x3a\x60\x2d\x81\xc6\x2f\xbf\x48\x34\xd8\x3d\x18\xc7\x9a\xfa\x58\x77\x0b\xc6\xaa\xb7\x8d\xff\x55\x97\xbd\x37\x60\xdb\xdd\xdd\x68\x19\x31\xa6\x58\x74\x53\xc6\xdb\x28\x6a\x6b\xb3\xbf\xb2\xee\x2e\xfb\x1f\xac\xc7\xf6\xd9\xfd\x5e\xbb\x81\x06\xf7\x24\x87\x6d\x6e\x74\xd8\x6c\x98\x0c\x4f\x39\xde\xae\x53\x6f\x70\xc0\x78\x1d\x03\x3d\xce\x5a\x2d\x59\x15\x96\x4a\x16\x92\x6d\xb6\x49\x07\xc3\xc3\x00\xfe\x62\xd5\x01\x3b\x9e\xb5\x64\x79\x41\x90\x8f\x4e\x1e\x05\xfa\x74\x61\xee\xf0\x69\xe9\xf2\x06\x3e\xfb\x83\x6d\xbb\x43\xe1\xba\x9b\x71\x58\x21\xac\xc2\xf0\x30\xf8\x42\x94\x03\x04\x79\x93\x80\xa9\x3c\x7c\x8a\x4b\xfb\xba\x40\x1a\x9e\x0d\xc1\x8b\x03\x4b\xd5\x68\x20\xcd\x73\x7e\xe9\x7b\x02\x42\xe0\xa0\x20\xb8\x02\xd1\x15\xd0\x38\xff\x26\x91\xf6\xc2\x7d\x71\xca\x2f\x71\x81\xdd\x8d\x4a\xc2\x05\x56\x9e\x15\x40\x42\x3e\x89\x46\xad\xdf\x94\x20\x3f\xe5\x97\xda\xb7\x6f\xf9\x0d\x9e\xf3\x4b\xb2\xb4\xd7\x5d\xb3\xc8\xa8\x36\x71\xa6\xa9\xb6\x9c\x2d\x58\xbf\xf2\xd4\xa6\x16\x2d\xac\x5e\xf6\xd7\xed\x0a\x26\xb0\xd6\x07\x74\xca\x2f\x0f\x4f\x93\x59\xe3\x7b\xb6\x8a\xda\x05\x1d\x54\x38\x49\xe5\x42\xee\x62\xc5\x45\x9c\xc2\x0e\x79\x5c\x80\x05\x58\x0f\xdb\xc6\xed\xd3\xdb\x6b\xb7\x1b\x56\x05\x5d\xb0\x3d\xae\xae\x1b\x11\x10\x1a\xdc\x97\x3c\xcd\x5a\x1b\x1b\xed\xc6\xc6\x95\xe1\x37\x43\xe1\x60\xf9\x24\xcd\x58\x05\xf9\x4d\x71\x8a\x24\xdc\xe6\x0c\xa3\x38\x8d\xf0\x6b\x63\x2e\xbd\x55\xab\x49\x31\x9b\xcc\x1a\xa0\xf9\x31\xca\x68\x5e\x56\x03\x59\xa5\x8a\xc3\x82\xd4\xfe\xbe\x9c\xbd\x52\x4b\x17\xf9\x88\x4b\x99\x45\xb8\x86\x5a\x65\xb5\x2c\x43\x1b\x45\x15\xba\x13\xe4\x1c\xca\x2d\x0f\x3e\xb1\x3f\x06\x10\x8b\x5e\xf0\x17\x59\x89\xe3\xd3\x85\xdb\x42\xcb\x59\x2b\xed\xb0\x9d\x76\x87\xf5\xf6\xda\xc4\x65\x01\x1c\xf5\xb8\x1d\x3a\x80\xbb\xb0\xce\xb3\x86\x69\x8b\xed\x84\xb4\x9f\x1a\x2e\x43\xef\x9b\xec\x55\x52\xa6\x59\xaf\x99\xd2\xc2\xb2\x4a\x6f\xe9\xc7\x6f\x8d\x58\xc9\xd0\xaf\x64\x25\xac\xfe\xfd\xb4\x57\x53\x04\x6e\x8a\xa7\x26\xa2\xbd\xb0\x0e\x93\xb8\xfc\x47\xa9\x31\x39\x1c\x3f\xb0\x26\x23\x08\x4a\x65\x26\xa7\xae\x0b\x81\xc7\xf0\xf6\x30\x1f\x71\xa1\xa4\x9a\x2a\x24\x0a\x70\x05\x9d\x64\xd1\xed\xf2\x6a\x49\x93\xe3\x42\xcc\xa0\x18\xe9\x87\x54\x4e\xc8\x45\x42\x04\xb7\x28\xbb\x45\x99\xc8\x53\x51\xba\x56\x15\x03\xc5\x88\xd9\x48\xe0\x81\x79\x73\xdb\xba\xca\x69\x3a\xce\x1b\x0e\x3f\xfc\x31\x20\xbd\xed\x0e\x91\xa0\x0f\xca\x56\xaa\x78\xc2\x55\x55\xc8\x32\xcd\x88\x3d\xae\xaa\x1c\x84\x9b\x6a\xaa\x5f\x3f\x1c\xdd\x7f\xdc\x4c\x51\xc1\x4e\xa2\x5e\x5e\xe1\xc3\xb7\x46\xbc\x65\xe8\x58\x32\x96\x6c\xf4\xfb\xe9\xa8\x86\xed\xdf\x14\x67\xcd\xcb\xf1\xe3\xb0\x82\x82\x51\xbc\x4d\xf5\x54\xce\xae\x42\x34\x8b\x63\x32\xe2\xb0\x44\xff\xf5\xdd\x8b\x43\x75\xd2\xa9\xc5\x8b\x61\x32\xe5\xad\x0a\xc5\xe9\x8b\x65\x36\x4c\xca\xe1\x29\x6b\xf9\xd7\x47\xc3\x1f\x71\xdd\x8a\x30\xb1\xc4\x8d\x2b\xad\x8d\xd7\xc9\x04\xce\x6b\xf2\x11\x4e\x03\xdc\xd3\x66\x49\x6c\x42\xb2\x8b\x08\x70\x3a\xa9\xcb\xcb\x6f\x9c\xab\x45\xc8\x44\x51\xd8\x0f\x29\xbc\x05\x85\x09\x79\xdc\x32\x64\x18\x25\x2f\x4f\x70\x63\x1d\x6f\x66\xb1\xcf\x96\x6a\x98\x67\x48\x3d\x3c\xf3\xe8\x4a\xc1\x69\x37\x95\x4b\xe6\x0a\x5e\x71\x17\x26\x1f\xe1\x6d\x66\xe4\x14\x7a\xf4\x26\x5e\x12\x27\x7b\xc5\x74\x6a\x56\x76\x36\x87\x0b\x8a\x39\x4b\xc1\xc3\x0d\xb1\xdd\xf2\x9e\xb5\x04\x8e\xed\x0e\x67\xbc\xd4\x27\x16\x2a\xc3\xe0\x94\x53\xdf\xb9\xa7\xd3\x76\xee\x8b\x46\x21\xfa\x3f\x29\xc9\x05\xf1\x5f\xf9\xb4\x64\xf3\x0c\xef\x31\x34\xb7\xbc\xc9\x2e\x92\x98\x96\xed\xf5\x35\x6b\x6a\x60\x86\x9f\xe0\x38\x88\x9b\x06\x0f\xf4\x28\xe8\x53\x62\xb1\xcf\x0d\xc3\xe0\xc4\xed\xae\x18\xcd\xe3\x8c\xf4\x06\x5e\xa2\x8d\x97\x98\x96\x39\x4b\xcb\x42\x9d\x8a\x61\x45\x49\xe6\x60\x49\x0a\x3e\x09\x22\x2f\x2f\xc4\x8d\x6f\x32\x35\xba\x75\x17\xc3\xfb\x98\x88\x52\x76\x4c\x74\x73\x03\x27\x9e\x54\xd4\x1c\x2c\x17\x81\xed\x7e\xa8\x7c\xf6\x34\x29\x13\x65\xac\x6f\xf7\x1b\x0a\xaa\x83\xd1\xa8\x10\x6d\xa8\x7b\xc1\x23\x23\x8d\xb4\x40\xeb\x06\xe5\x95\x9f\xf7\xc6\xbb\x79\x1c\x83\x5d\x98\xe4\x79\x3c\x04\x20\x8d\x23\x79\xa6\x24\x31\xa6\x04\xc6\x13\x0b\x19\x7a\x3b\x93\x79\x2c\xd1\x69\x6d\xf8\xf2\xbe\x51\x3d\xa3\x81\x62\xf4\x80\x25\x29\x45\x40\x5b\x21\xaa\x40\xb5\x41\xb4\x85\x1e\x89\x0e\x4d\x28\x4a\x06\x08\x93\x89\xda\xa0\xe8\x19\x67\x18\xf5\xba\x3c\x91\x48\x6b\x44\x28\xc3\x9b\x3a\xdb\x0f\xce\xc4\x89\xae\xc5\xc8\x57\x85\x12\x85\x80\x79\x44\x7b\x0f\xef\x5f\x37\xab\xcb\x66\x14\xac\xee\x3a\x2d\x58\x72\x9e\xa4\x13\x88\x5a\x40\xb9\x00\x13\x5d\x11\x66\xf0\x81\x1c\x56\x49\xb3\xf3\xfc\x2b\x2f\xdc\x4b\x86\x5b\x78\x39\x70\x87\x5d\x9c\xa6\xc3\xd3\xa0\xa8\x3e\xb9\xaa\x10\xd5\x71\x46\x39\xc9\xf3\x09\x4f\xb2\x6b\x36\xca\x8f\xe0\xd6\x58\xf6\x8f\x53\x2e\xd2\x1b\x24\x13\x75\x17\xad\xc8\x79\x30\x4d\x66\x65\xaa\xaf\xa7\x25\x52\x5b\x8b\xeb\x8a\xb6\x22\x26\x80\x91\xf4\x30\x50\x2b\xf2\x15\x9e\x7a\x80\x31\xe3\x23\x90\x41\x6c\x10\xe5\x19\x2c\xe7\xc8\xce\xa5\xc1\x84\x6f\x0a\xb6\x78\x0f\x8b\x5a\xcc\x27\x07\xbc\x6a\xc9\x17\xf0\x99\x20\x8b\x18\xe2\xee\x87\xcb\xa8\xe0\x34\x28\x12\x75\xab\xc0\x47\x1d\xed\x13\xa7\x7b\x55\xdc\xd8\x0a\x88\x80\x7e\x51\x55\x58\x41\xd7\x2f\x22\x19\xfd\x0e\xf3\x79\x86\x17\xa8\x17\x2c\x20\x4c\x00\x83\x4c\xb0\x43\xf1\x0e\xbe\xb2\x81\x8d\xff\x96\xd3\xa4\x83\x13\xc8\xa2\xd8\x90\x23\x06\xef\xf2\x79\x36\x62\xf3\x29\x08\xff\x34\x1b\x4e\xe6\x23\xee\xd0\xbd\x5f\xcd\xc1\xc8\x04\xb9\xd0\x0f\xed\xfe\x02\x71\x5f\x12\x8b\x51\x7e\x91\x51\x3c\xf2\x6c\x72\xc5\xc6\x73\xcd\x94\x9d\x60\xdd\x09\x44\xff\x95\x51\x5b\x4b\xc8\x8d\x19\x3f\x4b\xd2\xcc\x36\xae\x9a\xf5\xeb\x2c\xb9\x6c\x59\xfd\x12\x1b\xa7\xec\x7e\xe8\x66\xf6\x4e\xfd\x96\xaa\x9e\x73\x41\x9e\xd1\x29\xff\x07\x7c\x55\x78\x58\xad\x6f\x9a\x19\x8f\xd3\x93\xa0\xbe\x28\x39\xc1\x57\xbb\x8f\x69\xd6\xa2\x4d\x6e\xb2\x87\x1d\x8b\xce\xbc\xdd\x68\x72\x83\x77\x88\x48\x80\xee\x08\xc0\x20\xed\x69\x0f\x8b\x94\xf3\xd2\xcd\x82\xbf\x7f\xa1\x23\xa0\xdf\xde\x23\xfc\x14\x04\xa9\x30\xe3\x33\x58\x4d\x6a\xc5\x70\x5f\x1b\x53\xf2\x30\x64\xb8\x22\x4a\x17\x5b\x07\x69\xa9\x12\xba\xab\x3e\x36\xc7\xf6\x15\x58\x28\x05\x31\x98\x3c\xc0\x4d\x96\xf4\xa5\x22\xcc\xbd\x0c\x8b\x8e\x68\x00\x0d\x4b\x92\xb1\xfb\x03\x3a\xd3\xf5\x54\xa9\x72\x8b\x56\x61\x1b\x77\x38\xd9\xf8\xab\xc0\x00\x0f\xd5\x86\x91\x56\x35\x87\xbd\x22\x6b\xed\xca\x43\x5e\x4b\x2a\x57\x15\x8e\x16\xd1\x86\xdf\x29\xe4\xed\xd8\x53\x88\x26\xf8\x2e\x38\x63\x95\x11\x6f\xb6\x0c\xd3\x2b\xcd\xeb\x76\xe5\x1a\xfc\x34\x29\xc0\x62\x8a\xaf\xb8\x03\xeb\x63\xcd\xbd\x4e\xdc\x1b\x3d\x03\x25\xe4\xaa\x82\x0e\xeb\x75\x41\x13\x79\x46\x96\xc2\xbd\x3d\xd6\xda\xed\xed\xc0\xf6\x43\xd1\x5e\x5f\xb3\x86\x1d\x98\xe9\xb9\xac\xac\x96\xc1\xfa\x31\xbc\x5c\xbe\x91\xac\xaf\x55\x37\x90\x55\x26\x4c\xad\x3a\x6a\x26\x07\xc1\x6a\x27\x68\xce\xae\x9c\xdd\x0e\x1a\x12\xa9\x8d\xe6\x65\x5d\x92\xbb\xa6\x8c\x83\x13\x48\x3c\x4f\x20\x84\xbb\xef\x9f\x1f\xec\xec\xee\xa9\xa3\x58\x51\xc6\x71\xcf\x5b\x2d\x9f\x61\x4d\xe5\x56\x6b\x9e\x66\xed\x3d\x2f\x59\x5a\xe7\x21\xf0\xdd\x10\x95\xa2\x8d\x7a\x52\x70\x64\x6e\xc1\x65\x82\xf4\x72\x13\x2e\x12\x81\x30\x75\xf1\xd8\x25\x22\x9c\x24\x5a\xb6\x84\xd7\xfa\x5a\x9d\x6e\xc6\xe1\x08\x28\x66\xa5\x90\xdf\x2d\x36\xd6\xbf\x4e\x47\x42\x64\xd0\xc1\x16\x09\xe6\x17\xb8\xb1\xcf\xf7\xa4\x60\x55\x09\xdc\xcd\x23\xdc\xf8\x08\x91\x14\x45\x78\x7e\xa8\x96\xbd\x17\x98\xf3\xb9\xc0\xab\xb5\x81\x48\x85\x7c\x29\x76\xc9\x7a\xd7\x89\x04\x49\x09\x05\x81\xcb\x31\x88\xf0\x63\xc4\x23\x21\x47\xc8\x81\x10\x20\x0f\x1c\x5f\x30\xd2\x01\xdf\x10\x28\x34\x35\x5a\xed\x5b\x3d\x3b\x71\x84\x97\xdc\xc8\x95\x45\xa5\x80\x64\x7f\xcf\x05\xca\x09\xe4\xcd\xe2\x4c\x5d\x8f\x63\x72\x86\xc2\xf5\xf0\x7c\x3c\xe6\xc3\x32\x3d\xe7\x13\x70\x59\x8c\x78\x51\xce\xe6\xe2\xb9\x23\xd6\x00\xf7\xf3\x6c\x48\x6a\xac\x4e\xa1\x44\x0f\x1c\x48\x94\x34\xc1\xca\x89\x6d\x40\xad\xba\x0d\x8c\x41\x4c\x8a\xd3\x15\xa9\x55\x69\x06\x36\x50\xe4\xa8\x2f\x13\x6a\xf7\x17\x29\x5d\x45\xe8\x15\xd5\xea\xa9\x5e\x15\x5d\x88\xee\x8f\x02\xe3\x6b\x17\x4a\xc7\x75\x60\x6a\x79\xa5\xce\x80\x5f\x40\xb2\x92\x11\x52\x42\x56\xd1\x7b\xd4\x84\x84\xf2\x31\xe6\xd1\x76\xdd\x3e\xdb\xed\xed\xc0\xa5\x18\x15\x9c\x65\xcc\xfc\x02\x3d\x3e\x66\xa8\xc1\x43\x8d\x93\xb6\xa1\x2c\x7f\xc8\x0f\xc7\x67\x63\x12\x2d\x1c\xe7\x0a\x25\x5f\xb1\xeb\x8a\x6a\xa5\xba\xc5\xfc\x88\x10\x6a\x38\x81\x1b\x0b\xc6\xf9\xac\x01\x13\x1c\x21\x6e\x92\x07\x3c\x94\xef\x60\x1f\x4f\xda\x4c\x74\x13\xcf\x58\xb5\xdd\x63\xd9\xab\xe7\xa2\x4b\x4e\x9e\x5c\x59\x31\x4a\xea\x56\x55\x4a\xef\x72\xc8\x3c\x0a\xc5\x11\x31\xe5\x90\x42\xc3\x09\x70\x49\x0d\xd8\xe2\x40\x4e\x04\xc3\xaa\x65\xdf\x22\x86\x50\x3c\x0a\x8f\x11\xdb\x82\xf4\x04\x22\xf9\xf9\xeb\x83\xc3\x9b\xa7\x27\x30\xa1\x55\x52\x0a\x68\x01\xe9\xaa\x02\xea\x8f\x43\x53\xcf\xcf\x92\x61\x23\xba\x3a\x4b\x86\xab\xd0\x96\xae\xbe\x12\x7d\xc1\x1d\xba\x35\xf4\x75\x78\x2c\xd0\x82\x79\x90\x1e\x09\xd9\x88\xb8\xf7\x66\x41\x62\xab\x5c\xfe\x2a\x5b\xb7\xee\x0c\x03\x60\x03\x43\xdb\xc5\x1f\xe6\x14\x03\x22\x7d\x08\xd9\x67\xc5\x39\x15\xd8\xff\x5e\x5f\x5f\x53\xef\x71\x98\xfb\x26\xd3\x2f\xa4\xf7\x7d\xf8\xa3\xa6\xf7\x45\x64\x5a\x2a\x1d\x71\xfb\xc6\xf3\xfd\x2a\xc8\x8b\xe6\xfd\xd5\x18\xd2\xfc\xbf\xb1\xa4\xbf\xfa\xbb\x48\xfe\x4b\xc6\x51\x13\x9e\xbf\x45\xa1\xb2\xc6\xaa\xba\xfd\x48\x36\x58\xf2\xbe\x22\x3d\x4d\x3f\x72\x04\x26\x44\x86\xe0\x5e\xd8\x7b\xd8\x2c\x44\x0b\xcb\xaa\x20\x2d\xfd\x48\xf8\xc9\x13\xa9\xf1\x30\x2d\xac\x5e\x1b\x00\x83\x02\xf4\xe6\xe3\xb4\x9a\x22\x70\x53\x92\xf2\x44\xb4\x17\x0e\xd5\x92\xb8\xdc\x66\xb0\x56\xf5\xbe\xd8\x05\x3a\x8b\x57\x8e\x25\xf6\xca\x9f\x25\x53\x6d\x1c\x9e\x25\xd3\xfe\xba\x5d\xa4\x3e\xf7\x82\x69\xa5\x32\x01\x43\x38\x2a\x53\x8e\xf9\xb2\x01\xcb\x70\x0c\xef\x81\x37\x92\x1a\xf6\x55\xc9\x7b\x81\xa0\x65\xf8\xce\xa2\xa1\xcb\xf0\x8d\xc5\x03\x98\x15\xe0\x1d\x03\xb8\x95\xb2\x7b\xac\xd7\x0e\xc4\x44\xab\x2f\x4d\x22\xa3\x15\xe0\x07\x0e\xe0\x9d\x28\xe0\x9d\x20\xe0\x30\xe4\x72\x96\x4e\x27\x62\xeb\xa5\x05\x8d\xf4\x20\x0a\x15\xce\x4d\xfc\x21\x9f\x77\xe0\xf9\x31\x3c\xc2\xd3\x83\xfe\x7a\xc5\x26\xce\x17\xb9\x7f\xd3\xfa\xc2\x7e\x81\x6e\xfd\xfc\x33\x0c\xe0\x3d\xf6\x05\x4e\x1d\x76\x1f\xed\x92\x19\x6a\xf7\xd9\x97\x48\xdc\x2c\xfc\x25\x73\x2f\x63\xc1\xcf\x92\xa9\x88\x99\x3d\x28\x5b\x2d\x85\x30\xf4\xbd\xb5\x07\xa7\x58\x1f\xb0\xfb\xec\x4b\x1b\x7b\xfa\x60\xec\x45\x22\x12\x8d\x5e\xb1\x76\x82\x2b\x05\xf0\x6e\x6f\xfb\x1b\x8c\x12\x7e\x00\x94\xe0\x90\xb0\x41\x67\xcf\x3b\x4c\x02\x2a\x96\x14\x0f\x76\xf2\xe2\x34\x9d\x70\xd6\xa2\xfd\xc4\x74\x01\xb1\x5c\x23\xc1\x61\xa1\xcd\x2c\xde\x67\x94\xac\x14\xea\x0a\x71\xf2\x96\x4c\x5e\x3e\xce\x52\x8b\xda\x85\x04\xbd\x52\x12\x3f\x64\xa8\x25\x6a\x08\x11\x43\x89\x53\xbe\x68\xb8\xa5\xae\xb5\xa0\x72\xd0\xf5\x74\xa0\xbc\x7e\xa3\x03\xe5\xeb\xe5\xbe\x57\x62\xc6\xcf\xf9\xac\xe0\xaf\x49\x41\xf3\xca\x15\x11\xc0\x0f\x3f\x99\xcf\x51\xea\xae\x04\x1a\x52\x01\x31\xf9\x03\xe2\x07\x04\x06\x76\xb0\x52\xd2\xd8\x0d\x7f\x54\x8c\x0d\x87\x4d\x0e\xca\xd6\x97\x36\xe4\x7f\xf9\x12\x69\xb7\x5e\xa4\xbc\xf8\x9c\xc1\x85\x11\xc8\xa6\xb7\x2e\x55\x08\xc8\x17\xd9\x88\x5f\x5a\xd3\x9d\xc2\x9b\x37\x63\x0b\x48\xa0\x63\xa4\x1d\x09\xe3\xa7\xc1\x00\x0e\xcc\xc7\x06\xd0\xa7\x30\x5a\x7b\x71\xa1\x14\x33\x09\x90\xc3\x05\x13\xbd\xca\xf3\xa9\x61\x89\x8e\x8b\x43\x87\xcc\xa8\x65\x72\x58\xa2\x0c\x48\x7b\x9f\x6d\x1c\x3c\x39\x7c\xfa\xec\xe8\x6f\xcf\x5f\xfc\xcf\x97\xaf\x5e\xff\xfd\xcd\xdb\xff\xf5\xee\xfd\x87\x5f\x7f\xfb\xc7\xef\xff\xfb\xff\x24\x27\xc3\x11\x1f\x7f\x3e\x4d\xbf\x7c\x9d\x9c\x65\xf9\xf4\xff\xcd\x8a\x72\x7e\x7e\x71\x79\xf5\xcf\xed\xde\xce\x83\x87\xbb\x7b\x8f\x1e\xff\xe5\xde\xd6\x60\xc3\x09\x56\xd6\x4c\xbb\x30\xaa\x66\x88\xa9\x5d\x47\xc8\xde\x44\x6e\x58\x11\xa6\x21\x53\xc8\x69\x27\x74\x4c\x25\x1d\x6b\xfb\xc6\x7a\xaf\x5a\x02\x7b\x0e\x8c\x24\x83\xe1\x47\x0d\xd5\x3a\xc0\xc2\xee\xb3\x5e\xfb\x13\xd8\x09\x2d\x63\x30\xd9\x49\xcb\x34\xfa\x00\x74\xa7\x09\xd0\x36\x5a\x35\x7b\x96\x19\x16\x80\x2a\xc6\xe9\x23\x0e\x0b\xda\x43\x70\xd8\x06\x5a\xea\x81\xd9\x02\x2d\xd2\xb3\x35\x58\x34\x76\xc0\x86\xe1\x10\xdf\xbb\x67\x7d\xd0\x64\xab\x7f\x20\x45\x7a\xb9\x25\x83\x69\x11\xae\xcd\xdd\x3d\xee\x22\x9f\xe8\x08\xb2\xde\x77\x6e\xf6\xd9\xfd\x73\xe9\xff\xe7\xd2\x5f\x2f\xfd\x21\x1c\xbb\xb7\xc7\x9e\x3c\x6b\xb6\xfa\xff\xb5\x1c\xf7\xf6\x9e\x3c\xa3\x67\xb4\x7a\x7b\xf6\x93\xf8\xfa\xad\x91\xa1\x67\x8c\x2a\x73\x68\x4b\x22\xf3\xfd\x1c\x02\x0b\xe0\x70\x53\xe6\xde\x1c\x86\x2d\x7a\x7a\xab\xb7\xf7\x6f\xe0\x11\x68\xa6\x99\xf5\x60\xac\xb2\x56\x0f\x9f\xf7\x87\x3a\xc3\x7c\xc4\xdf\xe6\x69\x56\x06\xd6\xeb\x20\xee\x5b\x3d\x5b\x45\x98\x15\x6f\x70\x31\x6d\x30\x8d\x9f\x32\xd6\x4d\x7a\x2b\x49\xd7\x5a\x41\x5a\x24\x40\x57\x58\x41\xb9\x44\xbc\xd2\x61\xb5\xde\x1e\x3d\xad\xd6\x90\x37\x14\x6b\xfd\x90\xcb\x28\xc9\x55\xfa\xc8\x9a\x62\xbe\x28\x4f\x79\x8b\x28\x55\xa9\x8a\x9d\x22\xa4\xad\x0d\x29\x36\xd0\x2f\xf4\x02\xaa\x39\x9b\x04\x6c\xba\x38\x87\xd8\x0d\x37\x3b\x68\xdc\x13\x49\x31\x34\x86\x96\x41\x25\x4c\x20\xc3\x2c\x3b\xc0\x2c\xbd\xbd\x86\x14\x1e\xb3\x72\x6c\x24\x5d\x0b\xed\xda\xb1\x8d\x03\x3a\xec\x55\x03\x1d\x46\xf4\xd4\xab\x95\xf5\xd4\xab\x1f\x40\x4f\xbd\xfa\x0e\x7a\x2a\xc8\x4e\xaf\x9e\xfd\xa9\xa6\x6e\x5e\x4d\x15\x17\xc9\xf4\x59\x36\x4a\x93\xac\xb5\xa8\xc6\x6a\xf7\x7d\xf0\x3f\xbe\xca\x7a\x75\x3b\x2a\xab\x09\x9b\x28\x2e\xfb\x71\x55\xd6\xab\x67\x8e\xd2\x8a\xb2\xd7\x7f\xae\xc6\x22\x1c\xb3\x90\xf2\x6a\x48\xec\x37\xa3\xbd\xf4\xbc\x10\x6c\xa1\x2b\x56\x1f\xb1\x45\xc9\xf8\xb8\x77\x22\x99\x7b\x7b\x1b\xfe\x07\x3b\x29\xf8\x11\xba\x8f\x5f\xe5\xb7\xf1\xb8\xa9\xbb\x40\x08\xef\xb8\xb7\x60\x6f\x25\x6f\x01\xde\x51\x7b\x97\x4e\x83\x0e\x23\xaf\x8a\xd3\xa4\xe7\xbc\x3a\x3d\x4b\x86\xb7\xe8\x5a\xe8\x30\xd5\xac\xf8\x25\x5a\xfb\x77\x70\x37\x78\xf7\xc5\x92\x6f\x4d\x5d\x11\x56\x10\x0b\xf9\xf2\xfa\xe9\x2e\xbc\x96\x21\x37\xaf\x9f\xee\x2a\x2e\x31\xda\x02\x4f\xf8\x7e\xe5\x57\xf2\x1e\x6e\x11\xd5\x6a\x06\x41\xdc\x54\x07\xc9\xc8\xf1\x12\x6f\x88\x5b\x14\x31\xda\xcf\x7e\x7b\x7b\x2c\x94\xee\x87\xfc\x25\xd7\xf2\x7b\x93\x5d\x5c\x5c\x74\xf3\x29\xcf\x8a\x62\xd2\xcd\x67\x9f\xb7\x46\xf9\xb0\xd8\x12\x97\x70\xe7\x5b\x4e\x9d\xd3\xf2\x6c\xb2\xbe\x66\x09\x58\xe8\xcd\xb3\xf3\xe9\xcb\xa7\x47\x06\x6d\xfd\xdc\x30\x19\x42\xfd\x19\x10\xab\x78\xe0\xf4\x0b\x5e\x79\x2e\x74\x14\x0c\x4c\x01\x0f\x69\x86\x72\x96\xa6\x7b\x36\x47\x5d\x1e\xb2\x56\x6f\xe7\xb1\x75\xd2\xc5\x83\x1f\x09\x52\x33\x29\x04\x30\x58\x8d\x80\x7d\xfd\x74\xb7\x0e\xdb\xb4\xc4\x58\x64\x37\x49\x35\xf9\x50\xe6\x6c\x2a\xa3\x4e\x09\xec\x5e\x44\xc3\x79\xe7\x62\xc8\x60\xc3\x5f\x1c\x9e\x7d\xd6\xdb\x79\x2c\x42\x48\xad\xaf\xb2\x73\xfb\xec\xf5\xd3\x5d\xe7\x8b\xc1\xc7\x6a\xfb\x7a\xe9\x63\x37\x11\xa2\xbd\xed\x73\x38\x9c\x34\xda\x5d\xdd\x30\xf9\x3a\x1a\x53\x93\x84\xd0\xbc\x52\x84\xed\xfe\x92\x15\xbf\x99\xb9\x7a\xcc\xae\x6f\x04\x4c\x87\x90\xd5\x3e\xeb\x6d\x6f\x6f\xbb\x80\x17\x3c\x1d\x54\x7b\xee\xa7\x99\xbd\xfb\x14\x84\x97\xa0\x11\xa0\x0a\xc8\x47\xc7\x12\x71\x03\x2b\x30\x6d\x3d\x45\xf8\x51\xfa\xaa\xae\xa0\x8a\x20\xa0\x8a\xca\x45\x32\x29\xd9\x81\xf8\xa7\xa2\x6d\x34\x1a\x4c\x7d\xb9\xfe\x14\xd4\x25\xc9\xfb\x26\xc8\x8b\x83\xf2\xf8\x3a\x1a\x77\xe5\xb9\x31\xde\x52\x9d\xe9\x08\xfc\xa2\x93\x87\xc5\xe9\xfc\x39\x35\x17\x32\x78\xed\x29\xee\xaf\xdb\x25\x30\xd9\x0b\x8a\x46\xbf\x36\x8a\xcc\x01\x44\x30\x77\x31\x44\x19\xa9\xb3\x1d\x81\x15\x3b\x16\x06\xe0\x70\x88\x5f\x8a\xb1\xd1\xc3\x5f\x05\xd1\xd1\xf3\x61\x60\xfa\x4c\xb0\x7e\x13\x5d\x2e\x23\x47\x61\x8f\xf0\x29\x50\xce\x70\x1b\x16\x35\x2f\x02\x58\xfe\x0d\x15\x13\xcc\xfa\xfa\x5a\x20\x98\xc5\xc1\x15\x17\x1b\xec\x17\xc5\xe1\x1e\x7b\xaa\x4d\x48\x71\x72\x20\xf8\xd5\x3f\xb2\x24\xcb\x3a\x9d\x21\xc6\x7a\x30\x19\x03\x1b\x38\x50\x14\xb1\x91\xa0\x60\x8f\x5e\xed\xe6\xf5\x79\x3a\xbf\x04\xd0\x97\x1e\x38\xff\xb3\xb5\x50\xea\x49\xaf\x02\x19\xe8\xe8\x22\x49\x9f\xa9\x30\xd8\x6b\x64\xa3\xa3\x10\xc2\xd8\x2b\xe4\x2e\x92\xe0\x8f\x99\x3c\x95\x33\x26\xd8\x86\x33\xca\xa4\x96\x72\x94\xb0\x81\xa6\xbf\x40\xb6\x0b\x14\x4b\xa6\x1e\x81\x5f\x19\x73\xdd\x48\x06\x9b\xe2\x4b\xca\xde\x05\xe5\xee\x4d\x69\xfd\xb4\x08\x9d\x2b\x23\xbd\x69\x2e\xcd\x49\x25\xcb\xbb\x41\xde\xdb\xd2\xdd\x7c\x50\x2c\x03\x03\x4c\x94\xb6\xb4\x91\x63\x92\x7d\xb1\x6a\x1d\x16\x31\x1b\x56\x01\x53\x69\x36\x28\xad\x73\x88\x90\xac\x0b\xf3\x5d\xa8\xae\x0d\x81\xc3\x6e\x5b\x2c\x50\xa8\x46\xdf\x5d\xf7\x2b\x96\xef\x12\x5a\x78\xed\xde\x51\x8b\xcf\xfd\xdd\xc7\x1d\xb5\x24\xdd\x7f\xf4\x08\xd6\xf4\x8f\xfe\xa5\xd7\xf4\xc3\x74\x7a\xca\x67\xf7\x6f\x39\x44\xa0\xc3\x9c\xa6\xbe\xcf\x22\xde\xbf\xb9\xf3\x46\x56\xf3\x87\xa2\x63\x6f\x41\xe2\x14\x7a\xd1\x4e\x5f\xc6\x43\x08\x62\x37\x61\x58\x35\x60\x0d\x97\x80\xff\xfa\xb0\x2b\x7f\xf6\xd7\xad\x8a\x47\xe2\x65\x89\x59\x15\x64\x91\x05\xae\xd3\x80\xd5\x8d\x24\x83\x92\x5f\x96\xb0\x8a\x4c\xf0\x19\xe2\x1d\x93\x33\x7d\x36\x4b\x5d\xb5\x91\x8c\xf8\x30\x3d\x4b\x26\x93\x2b\xbc\x06\x74\x54\xef\x0e\x46\x79\x4c\x47\xe5\x9a\x0d\xe9\xc0\x7d\x38\xe5\xc1\x66\x2b\x80\x86\x76\x72\x96\xc4\xef\x56\xaf\xe7\x30\x53\x42\x76\x75\x68\xe7\xa3\x06\xb9\x2e\x6e\x2d\xa9\x68\x4d\xf6\x2d\xa8\xc9\x29\xf4\xae\x99\x5d\x73\x81\x04\x5c\x05\xda\x74\xa1\xa5\x49\x25\x36\xfd\x84\x7c\xe8\x7e\xc3\x82\xd3\x89\x34\xa2\x66\x33\xcd\xa6\xf3\x72\x95\x39\x55\xe4\x61\x13\xdd\x12\x74\x76\x53\xc4\x41\x27\x25\x42\x1f\x4b\x5f\x2a\x21\x46\xcb\xa3\x06\xd3\xaa\x98\x9c\x01\x33\x6d\xc8\x0a\xfd\x20\xf5\xd0\x11\x53\xfa\xf5\x1b\x01\xb4\x4f\x81\x5e\xb7\x03\xc6\x62\x95\xa6\x35\xdd\x75\xb4\x2d\x51\x10\xfb\xbb\x3b\x1d\xc7\x79\xfe\x78\x25\x45\x7b\x97\x1a\xf6\xd6\xf4\xe8\xbf\xbb\xef\x3b\xa6\x1d\xbd\x4c\xe6\x55\x9e\xf0\xf5\x35\x5b\x86\x6d\x32\x38\x78\x6a\x3c\xae\x8a\xd1\x15\x3b\x41\x03\xa2\x84\x76\x3f\xe3\x53\x43\xe7\x73\x95\xbb\x12\x20\xd5\x4b\xbc\xc6\x4e\xe2\x60\x6d\x7f\x49\x04\xb6\x3a\x88\xb9\x82\x43\x1a\x97\x1b\x72\x2a\xc1\xe6\x8b\x49\xde\x84\xf3\xdd\x35\x07\x7b\x51\x4c\xd8\x5f\xe4\x31\x66\x79\xd0\x37\x26\xca\x5c\x8f\x21\x3d\x1c\xcc\xbe\x05\xfd\x3c\x38\x52\xf6\x37\xed\x2f\x82\x85\x5b\xf7\x58\x3f\xba\x27\xdc\xf5\x3a\xd6\xdf\x67\x45\x9d\x72\x23\xf9\x65\x61\x12\xea\xd2\xcb\x8a\x32\x34\xbb\xac\x3b\x4e\x01\xaf\x80\xcf\x84\xbe\xd3\xec\x89\xce\xe5\xa5\x7d\x14\x3a\x0d\x44\xbf\xb6\x92\xf2\x17\xb8\xb0\xc2\x59\x32\x0f\x26\x93\xfc\x82\x25\xb3\x93\xb4\x9c\x25\xb3\x2b\x86\xee\xa5\xaf\xfc\xca\x41\x0f\xc6\xe6\x2b\xf5\x48\xfc\x35\xd8\x70\xc5\x40\xb9\xee\x96\x66\xa3\x55\x73\x18\xf2\x2b\xaf\x3c\x06\x09\xc9\xd9\x44\xf3\xe0\x12\x48\xb3\x0c\x12\xd1\x66\x23\x96\xcf\xc1\xde\x9e\x26\x6e\x16\x3e\x98\x03\xd8\xe9\xd2\x64\x88\x0f\xb2\x15\x2f\x21\x1d\xd6\x48\x69\x8d\x34\x50\x63\x11\x12\xc8\x89\x57\x32\xaf\xf2\x47\xa6\xa4\x60\x4a\x0a\xda\x25\xb7\xb6\xd8\xef\x6f\xde\x01\x3a\x85\xbc\x53\x75\x9a\x8c\xc0\x79\x52\x94\x49\x56\x16\xf5\xc1\x07\xce\x1c\xc7\xa3\x0f\x34\xde\x1f\xd3\x4f\xec\xff\xc2\xed\x93\xbb\x43\xf9\xc7\xc1\x1d\xfe\xa6\x5e\xe1\x07\x7b\xf2\x8f\x53\xf8\xda\x7e\xcc\x1d\x9f\x58\xea\x3c\x87\x28\x72\xf9\x3c\x64\xff\x9f\xbd\xaf\xdd\x6a\x23\x57\x16\xfd\x1d\x9e\xa2\xce\xac\xb5\x67\xda\xa1\x01\xf7\x87\xdb\x5f\xc3\xbe\xc7\x18\x83\x7d\x43\x70\xae\xed\x4c\x32\x97\xc5\x64\xb5\xed\x06\x3a\x31\x36\xc7\x36\x09\x64\x87\x79\x9f\xfb\x1c\xf7\xc5\xce\x2a\xb5\xa4\x96\xd4\xea\xb6\x9b\x90\xcc\x64\x1f\x07\x66\xb0\xa5\x52\xa9\x4a\x2a\xa9\xab\xab\xa4\x2a\xd5\xd2\xb7\x76\x1c\x32\xdc\x51\xbf\x49\x14\x32\xbe\x89\xeb\xec\x90\xb9\x23\x91\x31\x29\x4c\xdb\x52\xb8\x4c\x47\x5f\x35\x03\x49\xe2\x87\xc9\xa5\x57\xa9\xf4\x01\x28\xf6\xe2\x78\xc1\xac\x3d\xca\x52\x04\x32\x32\xcc\x7f\x9b\xf8\x63\x2f\x1b\x4d\x1a\x7d\x8c\xd0\x15\x92\x25\x36\x7a\xc2\x29\x5f\x27\x10\x59\x02\x9a\x1f\xa2\x4a\x15\x95\xbc\xc1\xc8\x44\x91\x60\xbd\xc8\x2d\xbe\x63\xf4\x30\x32\xd4\xff\xd3\xa3\x87\xad\x50\x53\xf3\xa8\x88\xb0\x2f\xca\x10\x7f\x54\x17\xea\x79\x5b\xac\x8c\x22\x96\xde\x74\xb5\xc8\x32\xd0\xf5\x85\xf6\x49\x76\x3b\xcc\xdb\x73\xbb\x8c\xc6\x3b\x89\x85\x68\x17\x6d\x7d\x70\x34\x99\x38\xfd\xa6\xa8\xdf\x31\xc5\xb1\x52\x90\xc6\x1a\x0a\xd3\x34\x98\x93\x89\x93\x52\x48\xac\x45\x2a\x42\x88\x52\xf3\xde\x5f\x10\x5e\xfc\x35\x86\x74\x7c\x8f\xaf\xfe\xd0\x06\xf3\x3b\xcf\xdd\xd1\x14\x4f\xc2\xe1\x0e\x2a\xfe\x63\x72\xb6\x75\xa1\xd4\x06\xd3\xd1\x0e\x39\xd3\xa8\x29\x8f\xee\x6e\x2a\x15\xd7\xe3\xd2\xea\xc3\x77\x8b\x2b\xdf\x2e\x79\x9a\x42\x5b\x45\xb7\xb8\xf2\x4b\x96\x9d\x2c\x74\x2a\x1a\x48\x47\x29\x9a\x87\x37\xc1\xf5\xd8\xf2\x8a\x4a\x39\x4a\x80\x52\x74\x33\xfc\x30\xbe\x50\xfb\x09\x3e\xde\x7c\x18\x5f\x64\xf9\x1d\xa4\x9a\xeb\xd9\x38\xd8\x19\x5d\x0c\xb5\xc5\xcb\x79\x4a\xf1\xce\xe5\xc4\x1f\x5f\xfb\x53\x5d\xf5\x4c\x8f\x2c\x18\xa9\xc5\x37\xfe\x78\xc7\x9f\x2e\xc2\xbb\xaa\xad\x0e\x02\x56\x85\x8b\x99\x55\xb4\x6c\x4f\x5f\x55\x2d\x57\xcb\xea\x0c\x61\xd5\xe7\x60\x3e\xa3\x57\xaf\x35\xb5\xd3\x94\xba\xc8\x46\xb6\x73\x15\xdc\x29\x15\x7e\xa0\x0a\x57\x14\x77\x63\x9c\x28\x9f\x8f\xd4\xc9\x9d\xfb\xc3\x61\xb8\xd4\x16\xee\x4c\x82\x4b\x7f\x74\xff\xad\x7d\x40\x7c\xf5\x98\xa0\x59\x34\x26\x48\x6b\xc5\x04\x79\x89\x90\xef\xb8\x32\x94\x63\xa1\x74\x1d\xf0\xcf\x36\x05\xa5\x52\xcf\x3e\x3b\x95\xb8\xdc\x89\x20\x62\xd1\x8e\xcf\x97\x9a\x10\x0b\xb2\x09\xb1\xfc\x26\x7c\x58\x26\x88\xd2\x2a\x7c\x5b\xce\xe5\x6f\x5c\x36\xe3\xd2\x99\xd4\x02\x25\xd1\x04\x55\x00\x4d\x50\xe5\x4e\x2c\x89\xc4\xcd\x04\x8d\x94\x99\x90\x10\x2e\x13\x64\x99\x22\xdf\x51\x94\x4c\x90\x24\x88\x7c\x45\xc1\x89\x3e\x10\xd1\x10\x3f\x33\x31\xc9\x6b\x88\x94\x0c\x90\xe2\x5e\x9b\xdf\x30\x49\x9f\x3f\xac\x50\x36\x21\x23\x4b\xb5\x92\x65\x66\x5b\x93\x4d\x59\xae\x6a\x25\x97\x95\x44\x9b\x74\xad\x54\x32\xe3\x89\xaf\x95\x3c\x53\x1e\xbd\x5a\xa9\xac\x7a\x84\x55\x51\xae\x79\x45\x93\x4a\x6b\xcd\xb3\x4c\x51\x52\x6a\x9e\x1d\x7f\x5f\xce\x7f\xaa\x79\xae\xa9\x93\x96\x9a\xe7\xf0\x72\x94\x90\x9a\x57\xe2\xdf\x51\x7e\x6a\x9e\x67\xaa\x32\x53\xf3\xca\xa6\x2a\x35\x35\xaf\x22\x94\x45\x72\x53\xf3\xaa\x66\x42\x48\x6a\xe5\xa2\xa9\x11\xa7\x5a\xd9\x32\xe3\x25\x51\x2b\xdb\x66\x2c\x1a\xb5\xb2\x6b\x26\x84\xa3\x56\x26\x84\xa3\x18\xd5\xca\x25\x53\x5a\x67\xb5\xb2\x27\x3a\xd0\xe9\x67\xdb\x46\x50\xe6\x5a\xc7\x87\x5a\xad\x5c\xa5\xdf\x9c\x9f\x6a\x95\x22\xfb\x5c\x71\x7f\xaa\x55\x2c\xfa\x0d\x1f\x69\xb5\x8a\x6d\x4a\x12\x5c\xab\x38\xa6\xb8\xd1\xd4\x2a\xee\xc3\xb9\xe9\x15\x37\xce\x83\xbf\xde\x79\xd0\xbc\x0a\x46\x1f\x90\x29\x1c\x46\x7a\x0d\x28\x4a\x73\xb6\xb8\xbd\xc1\x81\x09\x68\x7c\x6a\x81\x6f\xa2\xc7\x47\x21\xcd\xe1\x3f\xf6\xe1\x17\x86\x59\x36\xa8\x46\x9b\x02\xd5\x47\x99\x0d\x50\x50\xd9\x1f\xef\xae\xe0\x6f\x4a\xdc\x67\xc1\x4b\x98\x66\x4c\xcc\x18\x98\x8f\x89\xbc\xe8\xcd\xc3\x4b\x7c\xb9\x20\xe6\xa1\x18\xcd\xe2\xf6\x26\x98\xa3\xa7\x40\x3a\x7c\x88\x30\x02\x96\xc6\xed\x25\xe6\xce\x52\x00\xd0\x0e\x74\xe5\x4f\xc7\x93\x40\x1a\x37\x11\xf7\x50\x8b\x59\x3a\x53\x43\x9a\x26\xaf\x03\x0a\xb6\xef\x28\x96\xfc\x02\xbb\xbb\x0d\xa7\xcb\x4a\x0c\xc4\xa6\x83\x0e\x04\x33\x4c\xc8\xb3\x23\xa1\xc5\x5f\xa1\x45\x94\xad\xef\x35\x62\x25\x28\x04\x6c\xe2\xeb\xca\xc3\x96\x96\xb0\x59\x94\xfe\x0b\x1b\xc2\xc7\x30\xf8\x94\x46\x62\x6a\xf7\x02\xc1\x1d\x46\x02\x7c\xf9\x22\xc3\x33\x81\x23\x54\x12\x9b\x31\x6b\x8e\x91\x76\x7e\xe2\x67\x47\xc8\xa2\xd3\x23\x4f\x34\x2d\x24\x7a\x49\xa5\xca\xf2\xf4\x64\xa5\xf7\x94\xaf\x45\x67\xba\x74\xec\xbc\x5d\xe4\x6b\x71\x34\x99\xf9\x8f\x69\xe2\xb9\xa4\x3c\x86\x7f\xa4\x28\xed\x46\x12\x6c\x0a\xe0\xbb\x18\x1c\xaf\x4b\xf2\x16\x25\x8a\x75\x79\x97\xe3\xcf\x7b\x7b\xd0\x8e\x16\x5d\xdc\xd7\x3a\x2b\x22\x86\xce\x67\x42\x88\xb1\x1d\x70\xda\x60\x5f\x28\x16\x68\xae\x6f\xc9\xed\xf7\xf6\xa0\x75\x47\xb3\xaf\xde\x2f\x75\x87\x9f\x73\x5d\x17\xd4\x91\xb2\xde\xa5\xc1\x28\xc4\x4f\xdc\x1e\x2d\xf9\x71\x80\x1f\xe1\x62\xad\x42\xc5\x43\x92\xa1\xd8\xab\x4a\x8c\x7b\xc2\xc5\x51\x19\x94\x6f\xac\x71\x6a\x0b\x13\xe8\x35\x42\x1d\x23\xd2\x7c\x6b\xb3\x9e\xe1\x68\x62\x31\xe2\x83\x29\xea\xcc\xe2\x66\x9e\xec\xd7\xc7\x44\x27\xb4\x63\x7f\x7e\x79\x8b\x5b\xf8\xa2\xa0\xb1\x8d\xb0\x69\xa3\xfb\xf5\xee\xcd\x7c\xb6\x9c\x21\x8d\xe2\xce\x9d\x75\x70\x42\x7d\xfa\xe8\x0d\x2c\x9e\xb5\xd1\x75\xfe\x1a\x5d\x87\x04\x8c\x86\x7f\x7d\x2f\xed\x83\x43\x69\x73\x2a\xad\x71\x44\x02\xe7\x8f\xfa\xf1\x60\x19\xdb\xd6\x91\xce\x01\xdd\x2c\x04\xc8\xc8\x74\xa9\x78\xfe\x04\xee\xa5\xdd\x41\xb7\xaf\x78\xae\x7e\x17\x19\xe0\x36\xb1\x1f\x0d\xdf\xae\x3f\x5c\x44\x1f\x16\xe1\x94\x06\x63\xc5\x3b\xc5\xc5\x3b\x3c\x40\x4c\x7e\xf0\xa6\x6e\x51\xb3\xbc\x8c\xd8\x56\x19\x9b\xfc\x9f\xe3\xa5\x34\xe5\x34\x05\xb3\x74\x3f\xdf\x4b\xbf\x35\x09\xfb\x34\x53\x95\xe6\x00\x08\x4b\x7b\x53\x83\x14\xe6\x65\x27\x07\x7d\x64\xf1\x89\x23\xba\x99\x71\x26\x83\xe3\x4f\xf1\xce\x2b\xbb\x25\xdb\x29\x5a\x26\x14\xef\x82\x8b\xd1\xd8\x1f\x56\xaa\xca\x8d\x3a\xfc\x2d\xde\x55\x2b\x43\x7f\x3c\xba\x08\x10\xd0\x2a\x3a\x76\xc9\x2d\x7b\x32\xdc\x79\x21\xcd\x1f\xa2\xa4\xd1\x13\xb9\x78\xc9\x33\xe9\xa9\xec\xa0\x34\x7f\xf2\x6f\x20\x20\xe1\x13\x56\x3f\x43\x2c\x2f\xfd\x89\x91\x5c\x19\xe2\xbf\x38\xeb\xe0\x3b\x7c\x26\xb1\xf4\x82\x10\xd6\xf5\xb0\x2f\xdf\x09\xd0\x2f\xcf\xd8\x17\x2e\xbb\xe2\x8f\x50\x8d\x51\x8b\x92\x00\xf8\x63\x18\x86\x80\x33\xba\x29\x8e\x97\xc3\x85\x42\xf2\xac\x73\x0b\xca\x1d\x71\xf8\xb2\x26\x42\xdb\x2d\x68\x10\x62\xb8\x09\xf9\x4a\x7a\x12\xdd\x1a\x0f\xce\x94\xa1\xc5\x61\x6d\x4b\x1e\x0a\xbd\xbb\x5f\x1a\xd2\x22\x08\x63\x0a\xdb\x50\x3c\xaf\x67\x40\x5b\x0a\xb4\x95\x09\x6d\x2b\xd0\x76\x26\xb4\xa3\x40\x3b\x99\xd0\xae\x02\xed\x66\x42\x97\x14\xe8\x52\x26\xb4\xa7\x40\x7b\x99\xd0\x65\x05\xba\x9c\x09\x5d\x51\xa0\x2b\x99\xd0\x55\x05\xba\x9a\x09\x6d\x15\x65\x68\x6b\xc5\x64\x5a\xca\x64\x66\xcf\xa6\x65\xcb\xb3\x69\x65\x4f\xa7\xe5\xc8\xd3\x69\x65\xcf\xa7\xe5\x2a\xe0\xd9\x13\x6a\x95\xe4\x09\xb5\x4a\x89\xdd\x60\x6f\x0f\x9f\xad\x1f\xf0\xb0\xd7\x47\x7f\x1e\xfa\x93\xa1\x4e\x6d\xc6\x3c\x8e\xed\x33\xed\x40\xa1\xad\xa0\x7d\xa6\x1d\x14\x74\x4b\xb6\xcf\xb4\x03\x30\x26\x55\x8e\x8e\x9c\x66\xec\x83\x96\xeb\x90\x88\xa3\x23\xc3\x37\x61\x68\xc2\xc8\x84\xb1\x19\x73\x5a\x34\x01\xca\x26\x3e\x42\x8b\xd2\x86\x8f\xbf\xd8\xd7\xd1\x91\x31\x36\x81\x37\x8d\x47\xc8\x04\xb0\x6c\x13\x06\x67\x56\xa2\xdd\x28\x6a\x17\xf5\x14\x35\xe5\xed\x6c\x6c\x57\xc6\x76\x76\xa2\xdd\x30\x6a\xc7\x89\xf4\x85\x76\x8e\x09\x60\x93\xfe\x9c\x44\xbb\x2c\xfe\x5c\xce\x9f\x9b\x8b\xbf\x12\xe7\xaf\x94\x8b\x3f\x8f\xf3\xe7\xe5\xe2\xaf\xcc\xf9\x2b\xe7\xe2\xaf\xc2\xf9\xab\xe4\xe2\xaf\xca\xf9\xab\xe6\xe2\xcf\x2a\x9a\x94\x3f\xab\x98\x8b\x41\xcb\x32\x29\x83\x96\x95\x8b\x43\x94\x32\x1c\x9d\xc1\x99\x65\xe7\x62\xd1\x72\x4c\xca\xa2\xe5\xe4\xe3\xd1\xe5\x3c\xba\xf9\x78\x2c\x71\x1e\x89\xd4\xc8\x2d\x91\xc9\xe3\xe3\x14\x26\x4d\x80\x52\xc4\x64\x52\x6e\x90\xc9\xe3\x63\x3d\x93\x28\x6f\x38\x97\x83\x33\x2b\x29\x38\xc8\xe4\xf1\x71\x0a\x93\x96\x09\x96\x4b\x68\x4d\x4a\x0e\x32\x79\x7c\xac\x67\x12\x77\x0c\xbb\x48\x1a\x26\x45\x27\x8b\xc7\x12\xe7\xd1\x2e\xe6\xe2\x11\x65\x2e\xe2\xd1\xb6\xf2\xf1\x58\x62\x3c\xda\x76\x2e\x1e\x5d\xce\xa3\xed\xe4\xe2\xb1\x1a\xf3\xe8\xe6\xe3\xd1\xe5\x3c\x96\x72\xf1\x88\x1b\x23\xe5\xd1\xcb\xc5\x63\x25\xe6\xb1\x9c\x8b\x47\x5c\x57\x94\xc7\x4a\x2e\x1e\x6d\x2e\xab\x76\x35\x17\x8f\x65\xce\xa3\x53\xcc\xc5\x23\x2e\xff\x88\x47\xc7\xd2\x2f\xc8\x76\x5b\xcf\x24\x32\x88\x52\x30\x38\x73\xf4\xbb\x4e\xbb\xad\x67\x12\x87\x15\xf7\xba\xc1\x99\xa3\xdf\x75\xda\x6d\x3d\x93\xd8\xc8\xf2\x48\x43\xfd\xae\xd3\x6e\xa7\x30\xe9\x9a\x60\x3b\xa4\x61\x52\x74\xb2\x78\xb4\x62\x1e\xbd\x5c\x3c\xba\x31\x8f\xe5\x5c\x3c\x92\x89\x8c\x78\xac\xe4\xe3\xb1\xc8\x79\xac\xe6\xe3\xd1\x31\x29\x8f\x6e\x31\x17\x8f\x45\xce\xa3\x6b\xe5\xe2\xd1\xe1\x3c\xba\x76\x2e\x1e\x71\x2b\x8f\x78\x74\x9d\x5c\x3c\x56\xf9\x3c\xba\x6e\x2e\x1e\x71\x79\x50\x1e\x4b\xb9\x78\xb4\x4a\x4c\x56\x5d\x2f\x17\x8f\x76\xcc\x63\x59\xbf\x20\x3b\x1d\x3d\x93\x38\x1f\x38\x42\x83\x33\x57\xbf\xeb\x74\x3a\x7a\x26\x89\xcc\x91\x3d\xc0\xd5\xef\x3a\x9d\x4e\x0a\x93\xa8\x06\x94\xb0\x61\x49\xbf\xeb\x74\x3a\x7a\x26\x71\xef\xb0\xc9\xb0\x96\x92\xa2\x93\xc5\x23\xce\x47\xc4\x63\xc9\xce\xc5\xa3\xc3\x79\x2c\x39\xf9\x78\x2c\x72\x1e\xdd\x5c\x3c\x5a\x31\x8f\xa5\x5c\x3c\x56\xf8\x3c\x96\xbc\x5c\x3c\x12\x99\x23\xf3\x58\x2a\xe7\xe2\x11\x7b\xa3\x3c\x56\xf2\xf1\xe8\x98\x8c\xc7\x6a\x2e\x1e\x5d\xce\xa3\x57\xcc\xc7\xa3\xc5\x78\xf4\xac\x5c\x3c\xda\x9c\x47\xcf\xce\xc5\x63\x95\xcf\xa3\xe7\x24\x17\x24\x71\xa3\x2c\x83\xf9\x75\x30\x0e\xf1\x64\x3a\xb1\x46\x92\xf8\x38\x32\x1c\xbe\xe2\xa2\x3d\x8c\xfc\xdd\x06\x5f\xb5\xb0\xe2\x0f\xbe\xeb\x46\x30\xd6\x39\x6c\xc3\x50\x0f\x63\x53\x18\x1b\x61\x46\x7a\x18\x87\xc2\x38\x08\x33\x56\x61\x54\x53\x25\x3b\xad\x9d\x69\x6c\xcd\x36\x7b\x91\x4c\xe9\xcc\xf2\x85\x5f\xea\x7a\x18\x76\x17\x06\x41\xd2\x8d\x63\xd3\x83\x70\xb9\x18\xcc\x96\xfe\x84\x5b\xd3\xa6\x87\xfe\xd2\x47\x17\xd3\x02\x9d\x5b\x1a\xec\xa4\xcd\x49\x70\xb1\x64\xd8\xf9\x8d\x13\x02\xbf\x7e\xca\x2b\x4e\xe6\x59\x8c\x12\x6d\x87\x25\xe2\x78\x2b\xde\x55\x8a\xb1\xbf\x2d\x86\xf8\x07\x38\x76\x21\x9b\x17\x34\x0d\x12\xb3\xfb\xc5\x64\x36\x9b\x1b\x02\x93\x7b\x92\xed\x3d\x8d\x39\x32\x20\x27\xb0\x2f\x7c\xab\xa7\x91\x6e\x18\x46\x4c\xdb\x36\x78\x6e\x94\x2f\xad\x4a\x92\x81\xb8\x85\xc8\x60\xa3\xb7\xcf\xb2\xa6\x94\xe6\xd8\x38\x2b\x96\xae\x6f\x9d\x4d\xa2\xa3\xa6\x59\x15\xdd\x2a\xdb\x6c\xe1\x6b\x98\x75\xd7\x61\xf6\x44\xcb\xec\xc9\x63\x99\x3d\xd1\x32\x7b\xb2\x2e\xb3\x72\x81\x2c\xd2\xfb\x60\x70\xf6\x59\xb0\x29\x92\x6d\x4f\x7f\x7f\x10\xdd\x2c\xf4\x1a\x03\xb9\xa3\xb8\xc8\x9b\x57\x3e\x63\xe5\x4b\x79\xb4\x95\x24\xd9\x0c\xa6\x4d\x0f\xc6\xeb\x57\x7c\x5e\xbf\x47\x8a\x8b\x4b\x21\x35\x59\x49\x28\x21\x2e\x8c\xf6\x99\xde\x77\xd1\xa6\x5e\x32\xc3\x68\x4b\x6e\x89\x76\x6e\x7f\x04\x80\x80\x85\xca\x00\xc3\x92\x9c\xfb\xd5\x1e\x87\x1e\xcd\x0d\x4c\xe6\x90\x46\xe0\x19\x13\x9f\x9b\x0c\xba\x46\xb2\x72\x72\x0d\x21\x73\xab\xc7\x81\x22\x50\xb1\x87\x8e\x7c\x8d\xdd\xff\x2a\xc5\x51\xf5\xbb\x84\x2c\xa4\xdd\xae\xa4\x64\x92\x5a\x8d\x9b\x91\xc3\x73\x22\x15\xd3\xd7\x9d\x09\x0b\x13\x64\x17\x1a\x52\x3d\x85\x7d\xf0\x61\x1b\x0c\x63\x08\x3f\x47\x0f\x47\xe3\x4f\xfc\x38\x2e\xe0\x36\x70\x07\xdb\xb0\x14\xfa\xa3\x64\x18\xc6\x14\x27\x7b\x41\xc0\xa7\x64\x7d\x1a\x8e\x0d\x3b\xb0\xc0\xc4\x8a\xdb\xc0\xbc\xc5\x0f\x2a\x55\xc7\xc7\x8f\xa0\x8a\x3c\x8e\x8d\x11\xfc\x0c\x7f\x7e\x1b\xaa\xda\xed\x7c\x54\x0d\xe1\x0f\x18\xc1\x1f\x30\xfe\x16\xc4\x74\x3a\xf9\x88\x41\x42\x8c\x21\x7c\x79\xe2\xc1\x11\xbd\xd5\x6c\x87\x48\xa4\x49\x5f\x99\x78\x3f\xc6\xb1\xf2\x22\x99\x7a\x45\x11\x71\x6b\xd0\xa4\x5c\x16\x4b\x81\x96\x82\xa4\x08\xe5\xf2\xe5\xb1\xb8\x42\xd9\xa3\xd9\xa1\x08\x74\xbe\x6b\xee\x7e\x65\xc3\x7f\x62\x14\x16\xea\x8a\x6f\xbf\x29\x3b\xf3\x59\xea\x73\x92\xf6\xdc\x78\x79\x58\x2a\xd4\xf3\x4c\xc2\x8a\x5c\xf5\x4f\x35\x05\x8f\x0a\xed\xb0\x7a\xe2\xc4\x5b\x7e\x4f\x31\x71\xd1\x05\x3c\x3e\x11\x98\xb6\x1e\x27\x83\x72\x25\x07\x7d\x88\xe7\x83\x82\x25\xe7\x24\xce\x66\x1f\xcd\x4b\x66\x06\x76\x1a\xd9\x3a\xe5\x04\x94\xfd\x43\x5f\x31\x13\x6f\x2e\x7c\xbb\x23\x52\x89\xfb\x2c\xdf\xf1\xcc\x54\xb4\xe3\x3d\xa7\x71\x86\xe0\x28\x08\xc6\x43\x7f\xf4\x81\xc6\xd5\xc4\x1b\x0f\x28\xa7\x28\x33\xac\xdd\x2e\x29\x6c\x1e\x1d\xa0\x0a\x14\xa3\xe5\x7b\x35\xee\x0c\x51\x2d\x6f\x81\xa7\xe5\xc8\x09\x97\xa8\x97\x97\xb3\x31\x8f\xe6\xc2\x57\x7d\xf3\xe8\x60\xb7\x35\x8d\x62\x95\xe3\xfd\x4d\xfc\x9e\x3c\xf0\x43\xb5\xcf\xc4\x71\x19\x7a\xcc\x30\xe3\xc8\x4c\xba\x6a\x4a\x43\x50\x31\x85\x24\xfa\x5a\x4f\xc2\xf1\xb8\x1d\x18\x0b\x96\x00\x89\xa1\x3c\x64\x78\x16\xa3\xfc\x45\x70\xbf\x58\xce\x03\xff\xba\x31\x1d\x53\xee\x34\xa7\x23\x67\xf4\x58\x2c\x47\x67\xd2\x0e\x0a\x2a\x5e\x72\xc0\xfd\x3a\x20\x41\xc6\x11\x05\x9d\x27\x1a\x2b\x93\xdc\x9f\x9f\x62\x24\x28\x52\xac\x57\xdf\x83\x8f\x64\xf4\x68\xa6\x98\xc5\xee\x62\x12\x8e\x02\x83\x91\xc0\x3d\xf5\x9c\x96\x42\x96\xe6\x85\xb3\x74\x18\xfc\xbb\xcc\xda\x57\x8c\x2e\x76\x85\x4d\x72\x8f\xed\x77\x93\x9b\x41\xcc\xd0\x30\x18\xcd\xae\xe9\xad\x7b\x14\x88\x70\x76\x4b\xab\x56\x89\x0c\x67\x31\x4b\x28\xf8\x04\x67\x70\x63\xac\x64\x41\x14\x07\x41\x14\xe2\x42\x1c\xf2\xf0\x23\x97\x80\xf0\xa3\xc8\xb4\x12\x8e\x39\x5a\x84\x71\x35\x9e\xaa\x0d\x3f\x26\x44\x8e\x86\x57\x8d\xa0\x61\x1f\xc2\x8f\x74\x0a\x8b\xba\x11\xed\x05\xd7\xb3\x8f\x01\x74\x7e\x23\x67\xb7\x17\xb7\xc3\x45\xf0\x5f\xb7\x78\x6b\x24\xfd\xed\x99\xd0\xcb\x2f\x30\xac\x3c\x00\xad\xd2\xa3\x4c\x48\x72\x12\xf0\x87\x4a\x79\x10\x6d\x37\x04\xd0\xe0\x48\x4c\x90\x59\xc1\x23\xd6\x11\xe0\xd6\xb3\xac\xb7\x69\x3e\x3f\xfa\xb7\x6a\x32\x9b\xf1\x31\x9f\x28\x12\x0c\xef\x94\xbc\x47\xab\xa4\x32\x9d\x9b\xa9\x13\x47\x07\x59\x07\xae\xd9\x43\x47\xd1\x34\xd2\x6f\x16\xe2\x55\x33\x67\xa3\x7c\xfc\xdd\x95\x0f\xcc\xa9\x11\x2c\x82\xf9\x47\x7c\x2d\xc1\xf8\x96\xb7\x53\x0c\xe7\x14\xab\x1f\x24\xee\xb3\xbf\x0c\x87\x13\xba\x03\xc3\xe1\x1c\x0e\xe6\xa1\x3f\x85\xe3\xe8\x32\x2f\x5c\x84\x93\x00\x53\xfe\x8c\x08\x0a\x1a\x39\x3b\x8a\x80\xad\xc8\xcf\xa0\x47\x40\xfe\xb7\x3f\x85\xf6\xfc\x76\x78\x0f\xef\xaf\xf0\xcf\xee\xa7\x60\xf8\x9f\x97\xd7\x7e\x38\xc1\xb0\xc5\x7a\x7d\x67\xd0\x63\xdd\x65\xa8\x3d\x22\xd0\xda\xda\xcf\x33\x8e\x2d\x9c\x8e\xf0\x35\x85\xec\x8d\x38\xb4\x38\xe8\x44\xf4\x78\x3a\x24\x62\x28\x44\xfb\x22\x5a\x16\x71\xd6\xa3\x4f\xff\x82\xbd\xbd\xd9\xc7\x60\x7e\x31\x99\x7d\xc2\x36\x48\xcc\xd0\x62\xe9\x40\xb1\x9d\xe5\x15\x7e\x46\xd8\x3a\xaf\xb6\xc5\xea\x8a\x5a\xeb\xd0\x67\x18\xb5\x40\xe1\x96\x11\x91\x32\xb4\x84\x7e\xf7\xf6\x80\x75\x0b\x43\x0b\x41\x08\xc9\x08\x43\xb2\x6f\xd3\x26\x76\xdc\x84\xc3\x3c\x1b\xda\x1c\x28\x82\x72\x64\x28\x0a\xf6\x6c\xe8\xc4\x70\x0f\xe4\xff\xa8\x81\x8b\x10\xdb\xdb\x43\x47\xa8\x7f\xd8\x12\x60\x22\x24\xdb\xdb\x43\xbb\xbe\x95\x0e\xb0\xbd\x3d\xb4\x68\x3d\xfe\x9f\x30\xce\x3a\x25\x5f\xb6\x31\x5d\xb6\x85\xb6\x84\x28\x1f\xa4\x58\x6c\x63\x71\x45\x2e\xa5\x04\x25\x7b\xe2\xcd\x8a\x77\x45\x8b\x5a\xfd\x62\x50\xba\xeb\x21\x10\x16\x3e\xa8\xd2\x41\x97\x87\x31\x8a\xfe\xca\x32\x42\x0b\xd1\x73\xb4\xcf\x45\x29\x2e\x2c\x50\x81\x11\xa6\x60\x6f\x0f\x43\xb8\xce\xdf\xa1\x91\x18\x42\x61\x21\x45\x8b\x27\xb1\xd2\x7e\xc1\x28\xec\xb7\x78\x47\x61\x36\x9d\xdc\x47\xcb\xf1\xf0\x4d\xb7\x77\x08\xef\xe1\x57\xa8\x10\x9c\xac\x43\x4b\x47\x85\x75\x9e\x64\x96\xd6\x31\x7e\xd9\x5a\xa2\x4f\xa5\x48\xab\xe4\xeb\x6a\x37\xa5\xf8\x2f\xd4\x39\x93\x60\xd9\x2a\x67\x02\x5c\xd1\x61\x12\xf5\x74\x80\xe2\x6e\xd9\x80\xc9\xa8\x56\x68\x3c\x19\x5a\x0f\xfe\xa6\x74\x92\xad\x03\x3d\x46\x0f\x5a\x43\x17\x62\xda\x01\x0a\x76\x52\xe6\x91\x08\xba\x4b\x71\x36\xf1\x8d\x2c\x62\x40\xa0\x55\xc2\x97\x4b\x29\xa2\x7c\x51\x59\x5b\xed\x6a\x58\xa1\x1c\xe5\x55\x90\x14\x7d\x8e\xe9\x4b\x9c\x44\x41\xee\xc5\x77\x2f\xbe\x34\x18\x1c\x5d\x60\x31\xb8\xa0\x60\xa5\xaa\x58\x02\x70\x0e\x4d\xcb\xdd\x68\x5a\x7f\x77\x4d\x2b\x45\xbf\x4a\x31\xef\x0c\x7a\xd9\x7a\x4e\x5e\xf3\x8e\x66\x4b\x57\xf7\xf2\xcd\x26\xfe\xef\xb7\x89\x67\xbe\xcd\x7e\x83\x2d\xbb\x33\x1d\xcd\x03\xbc\xf6\xcb\xc6\x42\x41\x49\xf5\x90\x78\x72\x77\x80\x28\x2a\x46\x4a\x0d\xf1\x96\x7f\x81\x62\xb2\x27\x2a\xbc\x7f\xc7\x87\x83\x64\x91\xe3\x8b\x2c\xf9\x54\x58\xfd\xbe\x3d\xe8\xe5\x7a\x0a\x94\x36\x4f\x81\x1f\xe2\x29\xd0\x9a\x04\xa3\xe5\x7c\x36\x0d\x47\x80\xb9\xef\x87\xb3\xd9\x6a\x83\x7f\xab\x99\x65\xf0\x8f\x6a\x73\x3d\x11\x5a\x4d\xd9\xe0\x8f\xdf\x9f\xea\x09\x40\xb7\x3f\xcd\x06\x22\xb7\xab\x67\x2c\x24\xa4\xe7\x30\xf8\x0e\xf4\x8d\x83\x47\xd1\x47\x57\x6c\xab\xb9\xda\x6e\x46\x60\x72\xac\x63\x6f\xb3\x8e\x7f\x88\x75\xdc\xbd\x5d\x62\x62\x96\xb5\x9d\x76\xdd\x4c\xa7\x5d\xf7\xe8\xe0\x6b\xb5\xba\xae\xe2\xc4\xeb\xfe\xb5\x4e\xbc\x24\xd8\x13\x6b\x75\xfc\x19\xcd\xc1\x78\x49\x7d\x4b\x06\xff\x3a\xcd\x2e\xa3\xa3\xbf\x8b\x76\xa7\xdb\x6d\x57\xa9\x6b\x54\x56\xfe\x76\x4a\x54\x57\x71\x6b\x72\x99\x56\x36\xdf\xee\x1a\x4e\x8b\x6e\x4e\xa7\x45\x79\xb3\xf9\xfe\x10\x9b\x6f\xe3\xb4\xdf\x81\xb7\xbb\x55\xdb\x61\x07\xc4\x31\x67\x89\xbf\x0c\x2e\xef\x13\xbb\xef\x8d\x3f\xde\x6d\x4c\x17\xe1\x5b\x84\xe6\xb9\xe0\x6e\xfc\xb1\xb8\xfd\xa1\x15\x56\x70\x84\x4a\x42\xae\xdf\xfc\xd8\x71\xf9\x7e\x7c\xe0\x57\x3a\x00\x2c\x48\xbc\xb4\xf9\x31\xe0\x61\x5a\x42\x11\xa2\x59\xdf\x4e\x97\x9c\x33\x25\x98\x15\x62\x9b\xbe\x8a\xea\x12\xc8\xa2\x82\x1d\x99\xb2\x7f\x28\xf5\x89\xde\xa2\x98\x36\x13\x7f\x11\x45\xce\x82\x9b\xd9\x22\x94\x6f\xe0\x63\xa7\x58\x8f\xed\x5f\xcd\x18\xb3\xbc\x87\x6d\x85\xa2\x1d\xb0\x94\x4e\x5e\xf9\x34\xa8\x21\x3f\x29\xcd\xb3\x8d\xc8\xc5\xe4\x41\x74\x26\xf6\x15\x87\xd4\x92\x3b\x89\x43\x6a\x89\xd0\xba\xe0\x5a\xf2\xc9\xec\x6d\x05\x11\x05\xe4\x27\x70\x6f\xa7\x1a\xe1\x50\x25\xe2\x38\x58\x0a\x09\xe7\xa5\xa9\x8a\x0c\xf8\x7c\x34\xb3\x67\x4e\xe0\xd9\x90\xc9\xdc\xc1\xd7\x5f\xca\x7b\xec\x08\x4a\x3e\x57\x68\xdf\x69\xdc\xee\xa4\x70\xbb\xf5\xec\x41\xbb\x6f\xb2\xe5\x82\xd1\x9f\x73\xed\x9d\x95\xcd\xde\xf9\x43\xec\x9d\x9d\x7e\x17\x48\x48\xdc\xf5\xb6\xce\x0e\x0d\xa0\xfb\xb5\x5b\xe7\x5f\xbe\x1d\xee\x2e\xd6\xdc\x0f\x35\x5b\x55\x94\x0e\x40\x1f\x81\x6e\x77\xee\x4f\xc7\xb3\x6b\x43\x26\x02\x57\x6f\x81\x1d\x33\x65\xff\xb2\xf1\xd0\xd4\x61\x67\x32\x22\xe2\xa6\x3c\x37\x11\xdd\x66\xa3\x52\x37\x2a\x26\x9c\xb9\x36\xaa\xea\x66\xa3\xfa\x51\x36\xaa\xbd\x4e\xab\x09\x18\xab\x7b\xc7\x02\x2a\x1b\xf0\x32\x58\x5e\xcd\xc6\x60\xa7\xed\x56\x08\x6e\x3d\x76\xb7\x6a\x8c\xc7\xd1\xfd\x41\x79\x41\xac\xb1\x0b\xb0\xd5\x8b\xad\xf1\x8e\x60\xb1\xc8\x16\xad\xd4\xc1\xff\x0d\xe6\x33\xa4\x89\x9c\xff\x9b\x07\x0b\x61\x5f\x94\x18\x41\x38\xca\x31\x7e\x4f\xd0\xfd\xa8\xbd\x80\x2e\xc4\xcf\x94\x06\x79\x35\xa6\x76\x4f\x50\x47\xf8\xf4\x0b\x1b\xaf\x21\x5d\xcf\xe6\x01\xd9\x45\x60\x67\x87\xf0\x96\x36\x8a\x6c\xbd\xef\xec\xac\xb9\xc0\xc9\x7c\xe6\x59\xe0\xe5\xe2\x66\x81\xff\x10\x0b\xbc\x01\xd3\xd9\xec\x66\x3d\x35\xe4\x94\x89\x63\xea\xca\xe6\xc2\x9e\xbe\x26\x62\x90\x2c\x99\xe3\x5d\xe5\x12\x3a\x6b\x23\x74\x3f\x84\xd0\xb1\x0d\x78\xb5\xc8\x09\x7b\xe0\x5f\xa8\xfc\xae\xfd\xe2\xcc\x15\xa9\x6d\x8d\x02\x6c\x18\xd9\x2a\x70\x01\xbe\x7c\x51\x8b\xea\xab\x96\x93\xee\x11\xa3\xe7\x98\xdf\x84\x96\x14\x49\x85\xd3\xd7\xb8\x5c\xe5\x76\x21\xec\x2b\xcc\x11\xcb\x02\x87\xf9\x74\x15\x4e\x02\x30\xfe\xc3\x88\xef\x5a\xc7\x31\xb8\x51\x25\x4d\xc6\xdc\xe6\x47\x38\x25\xca\xf1\x37\xe4\x8f\x24\xba\x45\xb0\x8f\x32\x05\xfb\x40\xe2\xf1\xd6\xd7\xd8\x49\x04\x09\xca\xb5\x97\xfc\xd8\x17\xb7\x34\x39\xba\x48\x4a\xa1\x6f\xbc\xaf\xc4\x99\x90\x48\x6f\xdf\x71\x6b\x11\x26\x82\x0b\x95\xb0\x14\x1e\x1f\xf7\x3a\x2b\x49\x38\xd7\x3c\x39\x00\x2f\xc9\x8a\x79\xcd\x6b\xfa\xed\x86\x15\xc7\x7a\xc6\x6f\x42\x65\x32\x11\x38\x5b\xab\xe2\x05\xd1\x57\xfe\x62\x81\x0b\x79\x07\xe9\x1c\x03\x26\xec\x3d\xc4\x03\xe0\x24\x7a\x27\x1c\xb1\x41\xb1\xb3\x63\x4e\xbf\x3a\x78\x71\x78\x64\xc7\xbd\xf1\xef\x6b\x26\x1e\x6f\xce\xa6\x17\xe1\xe5\x2d\xcd\x44\x39\xbb\xc1\x3f\x0b\xf1\xcd\x5b\x06\xff\xcf\x9b\x39\x66\xa1\x5c\xde\xc3\xbf\x22\xfb\x1d\x49\x14\x8e\xbb\x1e\xb9\x51\xfa\x21\xb8\x87\x05\x7e\x09\xa7\x34\x5d\xc0\x72\xc6\x6f\x06\xed\xc2\x61\x70\xe1\xdf\x4e\x96\x35\x70\xc1\xb0\xec\x0a\x49\xa4\x5c\x48\xc3\x9f\x92\xd0\x1c\xaf\x8f\x91\x5b\x4d\x02\xba\x7e\xbb\x61\xad\x22\x33\x5c\xd2\xe4\x99\x0b\x82\x2a\x7e\xa9\x17\x2a\x96\x33\xb8\x09\xe6\x98\xa4\x49\x40\x2e\x61\xa6\x23\x8f\xbf\xa3\x8b\xcb\x5a\xda\x28\x53\xc7\x17\x8e\x4b\x0d\x2c\xbb\xb2\xe7\xd8\x4a\x08\xee\x88\xab\x88\x74\xa5\x2a\x26\x48\xea\xfc\xa1\x60\x6e\xa5\xcf\x63\x56\x02\x79\x9c\x95\x71\x2c\x5a\x6c\xbd\x65\xcd\x72\x74\xf3\xb8\x4b\xce\xac\x3c\xc0\xe8\xe2\x52\xcc\x18\x8a\xe3\x37\xd2\xc9\x0d\xbb\x6f\x86\x7e\x30\x7c\x8b\x89\x3b\xcd\xe8\x4b\xbe\x22\x9c\x04\x60\x92\xfe\x61\x7c\x21\x3a\x79\x05\x69\x67\xb6\x9f\x42\xfd\x91\x0d\xff\x15\x4f\x56\x05\x1e\x9e\x04\x8d\x29\xc8\x55\x0d\x30\xee\xbc\x8a\x58\x10\x25\x0c\xe6\x2e\xaa\x06\xa3\x8b\xcb\xc4\xf3\x15\xf5\xb3\x5d\x9c\x08\xea\x46\x1d\x5d\x5c\x32\xc1\x43\x70\x01\xf5\x43\x96\x9c\x50\x77\x45\x74\x83\x6e\xdd\x2d\x68\xb5\xa4\x24\x2f\x99\xdf\x50\xdc\x64\xb5\xb1\x2f\xbb\x6b\x36\x5e\xf8\x93\x25\x34\xc8\x9f\x8c\xbe\xa9\xd2\x10\xb7\x8f\xae\xa8\xb3\x6b\x34\xc2\xbd\xf6\xaf\x11\xbb\x28\x91\xfa\xf8\x62\x97\x46\x25\x31\x18\x33\x26\xa1\x2f\x75\x52\x29\xb8\x38\xaf\x4a\x4b\x75\x8a\xb5\x0a\x20\xa3\x43\x9e\xfa\xfa\x56\xa2\x29\xee\x00\x29\x49\x61\xe9\x2d\x7b\xac\x64\xb2\x8a\xe2\x13\xed\x41\x26\x9f\xaa\x42\x0a\xd6\xb4\x04\xe5\x48\x16\x1d\x6c\x7c\x7a\x89\xa9\xa3\x68\x2f\x85\x7a\xb2\x05\xd1\x93\x3b\xd3\x71\x70\xa7\x6b\x71\x86\x01\x88\xc8\x8f\x26\x5a\x67\x52\x2d\xd0\x93\xc2\x95\x65\x5e\xc2\x54\xe6\x0c\x6a\x58\xa3\xb8\x24\xb5\x11\x5d\xe6\x78\x10\xf4\xe2\x72\x97\x7e\xd3\xc0\xc5\x5b\x00\x05\x8d\x0b\x56\x1c\xb2\x90\x2b\xa9\xa2\xae\x30\xc8\xe2\x22\xfd\xca\xb6\x9d\xc4\x9e\xc1\xe8\x20\x2c\xd1\x3c\xc7\x2c\x59\x36\x91\xc0\x38\x8d\x6f\xcc\xb5\x3a\x67\x2c\xa3\x77\x9c\x1a\x38\x59\x9f\x3e\x31\x12\x09\xd2\x10\x6b\x47\x37\x09\xce\xb3\x18\xc5\x45\x94\xf1\x14\x42\x3a\x7c\x8c\xf5\xa8\x49\xc4\x0d\x16\xcd\x8e\xa2\xd5\x50\x21\x9d\xe5\xb0\xa2\xa4\x46\xc2\xf4\xa5\x1e\xe6\xc0\x5f\xa5\x0b\x32\x7a\x7c\xa4\xc5\x4a\xdd\x58\xaf\x33\xde\xca\x98\xeb\x01\x54\x66\xd9\xe8\x8b\x65\x6c\x12\x52\xbb\x78\xdb\xed\x49\x0d\xa2\xab\x53\x9a\x1b\xd7\x89\x71\x7b\x1f\x9d\x81\x79\x0f\xbf\x26\x66\xb3\x0e\xef\x33\x46\x0f\x7f\xe3\x16\x67\xef\xc9\x85\x13\x91\x06\x56\x9e\x32\x78\xc2\xbb\x27\xfb\x79\xd0\x30\x18\xaf\x26\x66\x9b\x26\x9d\xea\xa6\x24\x5e\x1c\x51\xd7\xc5\xf3\xed\x6d\x05\x4c\xe9\x54\x40\x2e\xbc\xfa\xd2\x75\xaa\xda\x29\x84\x63\x39\x71\x3b\x01\x7f\xe2\x90\x4f\xfc\x50\xff\xca\x07\x7a\x8c\xe6\x91\x0f\xf2\x9c\x0f\xf1\xa7\x52\x2d\xc3\x85\x2e\xdd\xbe\xc0\xcd\xfa\xaa\xc1\x13\x04\xb1\x41\xfd\x5a\xd0\x0c\xa3\x57\xb0\x34\x35\x21\x5f\x33\x13\x52\x74\xd3\xaf\x41\x93\xa9\x9b\x32\x15\xa6\x49\x31\x49\x09\x20\x55\xac\xaa\xa2\x4a\x87\x5d\x56\x8b\x11\x68\x85\xf2\xf4\x90\x75\x16\x2d\xc2\xa6\x98\x80\xa8\xbd\x47\x4d\xa1\xcb\x72\xc2\x3e\x9c\x9b\xe5\x1f\xfb\x0e\xbd\x90\x5b\x78\x55\x5a\x77\x9a\x63\x58\x2e\xfc\x8e\x36\x69\x81\x54\x39\xbd\x36\x25\xec\xaf\x34\x5c\x7f\x33\xeb\x52\x9f\x1c\xce\xa4\xc1\x87\x98\x11\x49\x2c\xcc\xb2\x23\x71\x3a\x7a\xc1\xed\x02\x93\xa6\xd1\x70\x60\x02\x39\x7d\x10\x53\x2d\x12\x02\xdf\xa9\x25\xc7\x0c\x26\xf9\x58\xe8\x91\x6c\xca\x40\x0f\xda\xd2\x33\xc6\x49\x4b\x12\x87\x27\x31\x56\x42\xb4\x1d\x44\x09\x98\xe1\x63\x30\x5f\xe0\xb0\x2d\xaf\xfc\x25\x4c\x83\x4b\xbc\x78\x11\x8c\x71\x1b\x1e\xd1\x94\xaa\x4b\x6a\xf0\x59\xce\x60\x12\x2e\x97\x93\x60\x27\x0a\x70\xc9\x91\x47\x48\x83\xf9\x7c\x36\x87\xf1\x2c\x58\x4c\x7f\x59\x82\x7f\x71\x81\x61\xcf\xb0\x6d\x44\xd4\x2f\x0b\x8c\x43\x76\x3b\x0f\x97\xf7\xcc\x0e\xf2\x1c\x86\xb7\x4b\x08\x97\xa4\x11\x6b\x11\x2e\x17\x3c\xa0\x42\x38\x09\x97\xf4\x12\x77\x94\xd7\x35\xc4\xfd\x19\x2f\x4f\xf9\x92\xfd\x8a\xed\x65\x38\x56\xd1\x80\x9c\x44\xcc\x71\x73\x99\x52\x2a\xce\xdf\x63\x93\xb6\x25\xa5\x8b\xfd\x43\x32\x5e\xb0\x97\xb8\x77\x1f\x32\xde\x4a\xe2\xd3\xdb\xf8\x9e\x26\xc7\x87\x51\xdf\x16\x42\xfa\x86\x86\xb1\xf3\x82\xd4\xf7\xb4\xb7\xbc\x5f\xfc\xa4\x49\x1c\xf7\xe2\xac\x78\x6e\x82\xf1\xe2\xcc\x39\xa7\xc1\x02\x30\xf2\xe0\x8b\x33\xea\x0a\xb0\xbc\x82\x62\xa6\xc2\xdf\x17\x67\x56\xd4\xa8\x28\x37\x72\xb2\x1b\xd9\x51\x23\x4b\x6e\x54\xcc\x6e\xe4\x44\x8d\x6c\xb9\x91\xc5\x1b\xc9\x6d\xce\xd7\x19\x32\x7a\x75\x2e\x75\xd0\x9a\x7c\xd0\x9a\xfa\x41\x4b\xd2\x23\x0c\x17\xe5\x29\x72\x98\x60\x4c\xdd\x62\x31\x26\x1a\x4b\xb1\x04\x6b\x74\xfc\x26\x27\x42\x18\x53\x01\x87\x82\xd9\x5e\x0b\x73\x31\x75\xe0\x05\x1c\x0a\x66\x67\x2d\xcc\x56\xda\xec\x98\x02\x0e\x05\x73\x51\xc1\xbc\x7a\x22\x9b\xfe\x7c\x7e\x8f\xd6\x63\xb9\x26\x9a\x2a\x74\x05\x14\x35\x8d\xa2\xf7\xc2\x80\xe8\xc9\x8b\xfb\xc5\x32\xb8\x86\x8b\xd9\xed\x1c\x96\xe1\xb5\x3a\xf7\xba\x73\xfc\x19\x41\x79\x31\x6e\x59\x1f\x57\x5f\x1c\xe8\x8a\xab\x53\x29\x2f\x20\x7b\x7b\xf0\x72\x36\x0e\x2f\xee\x09\x39\x54\x0e\xd7\xa0\xa2\x92\x4e\x45\x13\x63\xfb\xfe\xb1\x0f\x6f\xcf\x48\xca\x4b\x12\x6d\xa5\x7c\xbe\x9a\x8e\xce\x6f\xb0\x08\x96\xb7\x37\x6b\xdf\xe8\x48\xdf\xe0\xd8\xba\xe9\xfc\x16\x5d\xed\xd0\x6d\x6f\x31\xcc\x3b\x4c\x26\xd7\xf9\x2d\x99\x1a\x4d\x00\xb1\x22\x10\x2b\x21\x05\xea\x92\x26\x53\xb9\xb8\x1d\x7e\x0c\x50\xaf\x48\xa1\x2b\xc4\x1e\x0d\xc3\x20\x7d\x93\xd0\x27\xb8\xb4\xc8\xb7\xb4\xe8\xc7\x22\xb8\xed\xca\xf0\xb1\x3b\x54\x1f\xe6\x98\x77\x6b\xf3\x6e\x2d\xa9\x5b\x6b\x55\xb7\x96\xdc\xad\x95\xaf\x5b\x1c\x3c\x23\x2c\xb2\x25\x88\x48\x42\x5b\x5e\x81\x69\x4d\x1d\xd2\xd4\x66\x8b\x99\x34\x2d\xca\xcb\x34\x65\x46\xa8\x58\x67\x6d\xad\xf8\xd3\xc4\x5d\x07\xdf\xeb\xd5\xd8\xfe\xf8\xd3\xc4\x8d\x03\x2b\x2d\x6d\xa5\x1d\x55\xda\xda\x4a\x27\xaa\x74\xb4\x95\x6e\x56\x9f\xa5\xac\x3e\xbd\xac\x3e\xcb\xbc\xcf\x0c\x8b\xd4\x5a\x3b\xcf\x23\x76\x9f\x35\x77\x20\x8d\x91\x42\xf8\xfa\x34\xc9\x5d\xe9\xae\x90\xa9\x7d\xac\xb5\x33\x6f\x3d\x5b\xcd\xdd\xd6\xb3\xcc\xbd\x80\xdf\x9f\x8a\x1c\x91\x32\x74\x1f\xa5\x0f\xb7\x4a\x14\x42\x30\xde\x9e\x95\xf8\x83\x2a\xfa\xce\x9f\xbd\xca\x28\xf6\x51\x32\xb1\xa1\x4d\x1b\x96\x95\x86\xa5\xd4\x86\x76\xd4\xd0\xa5\x0d\xe3\x47\x23\x47\x94\xd2\xd0\x89\x1a\x7a\xb4\x61\xac\x07\x70\x44\xac\xe1\xd6\xb3\xaf\x90\xa3\xac\x28\xf5\xf8\xd3\xe7\xb1\xe3\xfb\x34\x25\x3b\x0d\x1e\x4f\xbe\xa6\x6d\x64\x49\x3c\xf4\x9f\x80\x87\x6e\x70\x1c\x8f\x2e\x7e\x7c\x12\x4f\xda\x25\x3c\x31\x47\x2f\xbf\x4d\xd7\xcf\xbe\x45\x27\x4a\x3f\x3f\xc7\x13\x7b\x6e\xe3\xca\xf0\x23\x1a\xda\x6b\xe0\xb9\x7b\x8e\x4d\x6d\x17\x9c\x3a\xbe\x4c\xb8\xc0\xca\x6f\x07\xfa\x07\x67\x62\x7d\x3c\x4b\x51\x41\xc5\x41\x40\x54\xfe\xc7\x00\x66\x93\x71\xea\x56\x9b\x43\x7f\x68\xbe\xc3\xb1\xdf\x87\xa6\x3c\x4c\xa2\xb6\x80\x37\x09\xfc\xc9\xe8\x76\x82\x2b\x6c\x1a\x7c\x4a\xed\xb6\x49\x13\xc1\x34\xa3\x44\x30\xc5\x3b\x77\xec\x90\x5f\x8c\x4d\x4f\x38\x49\xe4\x7d\x69\xd2\xbc\x30\xcd\x28\x2f\x4c\xf1\x8e\xb6\x70\x30\x60\xba\xd1\x64\x0a\x6a\xb1\x00\xbf\x82\xd1\x7c\x27\x7c\xff\x5f\x60\x41\x0d\x8a\x85\x24\x46\x9b\x62\xb4\x23\x8c\x14\xa1\x4b\x31\x5a\x0a\x46\x6b\x0d\x8c\x0e\xc5\xe8\x24\xd8\x32\x8c\xe6\x99\xad\x60\xb4\xd7\xc0\xe8\x52\x8c\xae\x96\x6b\x47\xc1\xe8\xac\x81\xb1\x44\x31\x96\xb4\x5c\xbb\x0a\x46\x77\x0d\x8c\x1e\xc5\xe8\x69\xb9\x2e\x29\x18\x4b\x6b\x60\x2c\x53\x8c\x65\x2d\xd7\x9e\x82\xd1\x5b\x89\x31\x56\xfb\x23\xa4\x52\xf3\xb2\xda\xbc\x9e\x26\xd3\xf8\x74\xbe\xdc\xf9\x8a\x45\x84\x0b\xf9\x12\x9d\x9a\x6f\x71\x31\x6d\xd3\xc5\x24\xc3\xb0\x74\xf9\xf3\xdb\xd1\x12\xae\xc2\xcb\x2b\xf0\xa7\x63\x98\xcc\x3e\x81\x3f\xbf\xbc\x45\x0b\x06\x51\xfb\x17\xff\x75\xeb\xcf\xa5\xf3\xe5\xbc\x03\x4c\x3a\x75\x79\xc7\xb5\xb8\xba\x06\x64\x18\x81\xe0\x38\x58\x9e\x8e\x02\xce\xb3\x44\xc1\x3c\x58\xdc\x4e\x96\x78\x24\x26\xa3\x7b\x4c\x7f\x61\x18\x86\x71\xe9\xc3\x73\xb8\xf4\xa3\xab\x2b\x56\x19\x33\x19\x44\x45\x43\x5a\x54\x22\x45\x43\x52\xa4\x23\x12\x33\x1f\x21\x9e\x98\x95\xe8\x95\xf0\x39\x5c\xde\x91\x19\xc6\xf6\x31\x00\x57\x3b\x05\x00\x0d\x6b\x6d\x64\x08\x7d\x56\x24\x9c\x24\xab\xc0\x9f\x63\x9c\x94\x7d\xb8\xbc\x82\x3f\xe0\x72\xb2\xee\x26\xa7\x37\xaa\xbc\xa5\x5b\xdc\x71\xb4\xc5\x19\xc6\x71\xfc\xf8\x46\x55\xf9\x98\x89\xa1\xe5\x15\x0a\x14\xc0\x93\x01\xa8\x58\x23\x80\x2a\xcf\x6f\xe9\x6e\x78\x1c\xed\x86\xd8\xb8\x28\x3e\x6f\x8f\xd9\xfe\x47\x9e\xb7\xdb\x80\xbd\x25\x71\xd8\x14\x87\xcd\x70\x48\xaf\xe6\xc7\x67\x56\x82\x42\xc9\x2a\xc0\x3b\xd1\x53\xe8\x50\x0a\x1d\x46\xa1\x2d\x53\x68\x2b\x14\x5a\x1a\x0a\x5d\x4a\xa1\xcb\x28\xe4\x4a\x57\x34\x44\x4e\x82\x42\xc9\xd6\xc2\x3b\xd1\x53\x58\xa2\x14\x96\x18\x85\xae\x4c\xa1\xab\x50\xe8\x68\x28\xf4\x28\x85\x1e\xa3\x90\x6b\x77\x11\x8e\x52\x82\x42\x57\xa6\xd0\xcd\xa2\xb0\x4c\x29\x2c\x33\x0a\x3d\x99\x42\x4f\xa1\xb0\x24\x51\xf8\xa0\x31\xfa\x32\xed\x82\x2b\xec\x0b\x58\xce\x64\x5b\xeb\x1a\x69\x27\x56\xb9\xbb\x22\x5c\x4b\x0c\x27\x2e\xf8\x9d\x44\x4b\x2a\x0b\xa5\x20\xa5\x71\x88\xbc\x45\xf5\x04\xba\x9b\x89\x8f\xce\xdd\xbb\x25\xa4\xa2\xa3\x71\x66\x8c\xb8\x67\x1d\x46\xee\xb8\xca\x32\xea\xca\xc9\x3b\x44\xc8\x42\x96\x0f\x4a\x04\x5c\xfb\x30\xb2\x29\xfb\x46\x6a\x25\xd7\x8c\x9d\x22\xb5\x92\x67\x52\x5f\x49\xcd\xb3\xd0\x5f\xf5\x63\x47\x22\x14\x18\xdd\xf8\xab\x36\xfe\xaa\xa7\xf5\x57\xa5\xfa\x73\x54\x4f\xce\x8f\xe5\xc3\x79\xaa\xac\x70\x2f\xf8\xab\xf9\x0b\xf9\xd5\xfc\xc5\x63\x5f\xcd\x5f\xc8\xaf\xe6\x2f\xb2\x5e\xcd\x25\x34\x0f\x49\x2e\x13\x6e\x97\x8d\xa7\x6a\xe3\xa9\xda\x78\xaa\x36\x9e\xaa\x8d\xa7\x6a\xe3\xa9\xda\x78\xaa\x36\x9e\xaa\x8d\xa7\x6a\xe3\xa9\xda\x78\xaa\x36\x9e\xaa\x8d\xa7\x6a\xe3\xa9\xda\x78\xaa\x36\x9e\xaa\x8d\xa7\x6a\xe3\xa9\xda\x78\xaa\x36\x9e\xaa\x6f\xea\xa9\x7a\x32\x1f\xd5\xe3\xbc\x53\xeb\xf8\xa5\xd6\xf0\x48\x7d\x2b\x5f\xd4\x8f\x9d\x0f\x45\x60\x74\xe3\x8b\xfa\x37\xf5\x45\x89\xbe\xa3\xa6\xbb\xf2\xa2\x93\xe8\x39\x6a\xba\x82\xdb\x88\x7c\xf9\xf6\x3e\x23\xbc\x28\xc5\xde\x8f\x3e\x48\xb7\x7b\x05\x08\x76\x43\x3b\xcb\xad\x44\xae\x71\x8a\x37\x8a\x79\x6c\x2d\x8d\x92\x81\xd1\x1b\x60\x31\x9c\xdd\x25\xf1\xf4\x39\x39\x7d\xd1\x4d\x97\xa5\x4d\xda\x25\x4f\xaf\x4f\x0a\x2f\xdd\xa1\x82\xe7\x21\x49\x14\xde\x4a\xd6\xd8\x71\xe5\x1e\x4d\x78\xbf\x66\xc7\x74\x50\x70\x08\x58\x54\x89\x10\xfe\x21\x8e\x53\x3d\xb3\x11\x6a\x77\x6c\xec\xcf\x24\x44\xc9\x48\x68\x52\x75\x22\x28\x9a\x3a\x01\xf8\x83\x5c\x18\xef\x61\x9b\xbc\xd7\xc3\x36\xeb\xb4\x00\xff\x20\x5c\x6d\xa5\x5a\x37\x92\x15\xc8\xe8\x12\xb4\x16\x02\x61\x02\xfa\xfa\x8b\xf1\x58\x8c\x33\xbe\x7a\x7a\x68\xa2\x3d\x45\x86\x69\x56\x0d\x2e\x34\xc8\x57\xb1\x9e\x62\x98\x78\x94\x59\x8e\x59\x42\x88\x15\x24\x91\x7f\x1d\x97\x86\xde\x64\x28\xf5\xcc\x2f\x39\xdb\x25\x2f\xc5\x20\x52\x4c\x35\x86\x68\x3b\x95\x17\xba\x7e\x91\x2b\xeb\x49\x20\x8e\xc9\x33\x1d\x3e\xa5\xe6\x3d\xaf\x79\x2f\xca\x8e\x68\x21\x94\x8d\x83\x72\x73\x5e\xf7\x26\xce\xfa\x9b\x58\x4d\x53\x52\x01\xd3\xc8\xa4\x36\xd5\x2c\x22\xa4\x8f\x38\x62\x2c\x2e\x95\xcf\x52\x45\x38\x45\x70\xb5\x42\x9b\x21\xb0\xe9\xc2\x1a\x0b\xaa\x5c\x2e\x33\xfb\x05\xb1\x1a\x74\x4d\x61\x13\x4a\xd7\x79\x9c\xd7\x60\xaa\xe6\x31\x10\x65\x1d\x03\x26\x92\x38\x28\x30\x4a\x88\x7b\x2c\xea\xe2\x66\x16\x8b\xbd\x34\x57\x54\x2d\x93\xa8\xa3\xad\xfe\x76\x7a\x6f\xd3\x7d\x0a\xa5\xb7\xe9\xe6\xd6\x78\x9b\xee\x0a\x75\xb7\xe9\x16\xea\x9a\xd1\x22\x8e\xc7\x30\x18\x3f\xe2\x09\x7f\x38\x9f\xdd\x48\x4f\x79\x5a\xd0\x6b\xba\x9a\x07\x7c\x32\x4a\x96\x26\x04\x05\xeb\x49\x1c\xf3\xd4\x70\x73\x63\xec\x4e\x0e\x34\xc7\x65\x24\x0e\x8d\x87\x50\x3c\xd4\x1c\x58\x55\x6a\xa8\xd5\xc7\x9a\x43\xd2\x85\xa8\x5f\xca\x2a\x46\x54\x35\x19\x85\x1c\x31\x6e\x2d\x25\x06\xfb\x60\x80\xd9\x3e\x0c\x1c\xcf\x8c\x07\x38\x3f\xf8\x82\x74\xa1\xfa\xf0\x4f\xdc\x85\xc2\x9d\x9d\x44\x97\xf8\x9b\x63\xbb\x57\x0d\xe2\xea\x6e\xfe\x37\x5a\x6d\x38\x44\x4f\xb3\xe2\x08\xa6\xfc\xab\x8e\xca\x7c\xf6\xca\x43\xa0\xec\x37\xcd\xa6\xfb\xad\x5e\x33\xbf\x2e\x5d\xdf\xf7\x7c\xbf\xfc\x66\x6f\x81\xdf\xf1\x7d\x6e\xef\x39\xd9\xaa\x82\x45\x30\xff\x18\x6c\x3d\x33\x46\x05\xb0\x8b\x96\x0d\xc3\x7b\x68\xfe\xff\xff\x37\x9e\x87\x23\x78\x19\x2c\xa6\xe1\x64\x17\x1a\x93\x09\xcc\xc3\xcb\xab\xe5\x02\x8d\xad\x08\x3f\xde\xdd\xda\x7a\xd6\x0b\xc6\x21\x86\xf7\x19\xde\x92\xfd\x03\xef\x2e\x60\x50\x9e\x70\x0a\x8b\xd9\xed\x7c\x14\x10\x0b\xed\x30\x9c\xfa\xf3\x7b\x74\xea\x5c\x2f\x4c\x1a\xa5\x61\x4e\xfe\xce\x6e\x97\x98\xe3\x2f\xbc\x08\x47\x64\x67\x35\xc1\x9f\x07\x18\x72\xf3\x3a\x5c\x62\xa0\xca\x9b\xf9\xec\x63\x38\xc6\x28\x13\x18\x74\x02\xd7\xe9\xc5\x6c\x32\x99\x7d\xc2\x18\xd2\xa3\xd9\x74\x4c\xd2\x2a\x2d\x48\xa3\xeb\x60\x59\xa3\x2b\x7e\x07\x64\xb2\x16\xe4\x0a\x43\x44\xcf\x68\x36\x0e\xe0\xfa\x76\xb1\x84\x79\xb0\xf4\x43\x0c\x67\x11\x80\x3f\xc4\xb8\xfb\xa3\xd9\xcd\x3d\x61\x11\xa6\xb3\x65\x38\x0a\x4c\xa2\xd9\xc2\x24\x5c\x10\xcb\xb2\xd8\xdf\x74\xac\x10\x33\x0e\x17\xa3\x89\x1f\x5e\x07\xf3\xdd\x34\x1a\xc2\xa9\x38\x10\x8c\x86\x9b\xf9\x6c\x7c\x3b\x0a\x9e\x9c\x0c\xa0\xac\x8d\x67\xa3\x5b\x1e\x07\x03\x09\xdf\x9b\xcd\x69\x8c\x8c\x6b\x7f\x19\xcc\x43\x7f\xb2\x88\x87\x19\xe7\x24\x6a\x26\x90\x8e\xf3\x3c\x68\x77\xfa\xd0\xef\x1e\x0d\xde\x34\x7a\x2d\xe8\xf4\xe1\x55\xaf\xfb\x5b\xe7\xb0\x75\x08\x07\xbf\xc3\xa0\xdd\x82\x66\xf7\xd5\xef\xbd\xce\x71\x7b\x00\xed\xee\xc9\x61\xab\xd7\x87\xc6\xe9\x21\x34\xbb\xa7\x83\x5e\xe7\xe0\xf5\xa0\xdb\xeb\xc3\x4f\x8d\x3e\x74\xfa\x3f\x91\x8a\xc6\xe9\xef\xd0\x7a\xfb\xaa\xd7\xea\xf7\xa1\xdb\x83\xce\xcb\x57\x27\x9d\xd6\x21\xbc\x69\xf4\x7a\x8d\xd3\x41\xa7\xd5\x37\xa1\x73\xda\x3c\x79\x7d\xd8\x39\x3d\x36\xe1\xe0\xf5\x00\x4e\xbb\x03\x38\xe9\xbc\xec\x0c\x5a\x87\x30\xe8\x9a\xa4\xd3\x64\x33\xe8\x1e\xc1\xcb\x56\xaf\xd9\x6e\x9c\x0e\x1a\x07\x9d\x93\xce\xe0\x77\xd2\xdf\x51\x67\x70\x8a\x7d\x1d\x75\x7b\xd0\x80\x57\x8d\xde\xa0\xd3\x7c\x7d\xd2\xe8\xc1\xab\xd7\xbd\x57\xdd\x7e\x0b\x90\xad\xc3\x4e\xbf\x79\xd2\xe8\xbc\x6c\x1d\xee\x42\xe7\x14\x4e\xbb\xd0\xfa\xad\x75\x3a\xc0\x90\xac\x27\x27\x5a\x2e\x91\x76\x89\xc7\x83\x16\x9c\x74\x1a\x07\x27\xad\xa8\xa7\xd3\xdf\xe1\xb0\xd3\x6b\x35\x07\xc8\x4e\xfc\xa9\xd9\x39\x6c\x9d\x0e\x1a\x27\x26\xf4\x5f\xb5\x9a\x1d\xfc\xd0\x7a\xdb\x7a\xf9\xea\xa4\xd1\xfb\xdd\xa4\x38\xfb\xad\xff\xf3\xba\x75\x3a\xe8\x34\x4e\xe0\xb0\xf1\xb2\x71\xdc\xea\x83\xb1\x62\x48\x5e\xf5\xba\xcd\xd7\xbd\xd6\x4b\xa4\xb9\x7b\x04\xfd\xd7\x07\xfd\x41\x67\xf0\x7a\xd0\x82\xe3\x6e\xf7\x90\x0c\x74\xbf\xd5\xfb\xad\xd3\x6c\xf5\xeb\x70\xd2\xc5\xa1\x3f\x82\xd7\xfd\x96\x09\x87\x8d\x41\x83\x74\xfc\xaa\xd7\x3d\xea\x0c\xfa\x75\xfc\x7c\xf0\xba\xdf\x21\x83\xd6\x39\x1d\xb4\x7a\xbd\xd7\xaf\x06\x9d\xee\x69\x01\xda\xdd\x37\xad\xdf\x5a\x3d\x68\x36\x5e\xf7\x5b\x87\x64\x74\xbb\xa7\x64\x42\x07\xed\x56\xb7\xf7\x3b\x22\xc5\x31\x20\x83\x6f\xc2\x9b\x76\x6b\xd0\x6e\xf5\x70\x40\xc9\x48\x35\x70\x08\xfa\x83\x5e\xa7\x39\x10\xc1\xba\x3d\x18\x74\x7b\x03\x81\x47\x38\x6d\x1d\x9f\x74\x8e\x5b\xa7\xcd\x16\x52\xd3\x45\x2c\x6f\x3a\xfd\x56\x01\x1a\xbd\x4e\x1f\x01\x3a\x51\xb7\x6f\x1a\xbf\x43\xf7\x35\x61\x19\xe7\xe8\x75\xbf\x15\x7d\x14\x24\xd6\x24\x33\x09\x9d\x23\x68\x1c\xfe\xd6\x41\xb2\x29\xf0\xab\x6e\xbf\xdf\xa1\x72\x42\x86\xac\xd9\xa6\xc3\xbd\xbb\xf5\xec\xf9\x9e\x6c\xf3\x7a\xe9\x2f\xaf\xb8\xb6\xf2\x24\x76\x2f\x1e\x8d\x8c\x1b\xbd\x78\x89\x00\x15\x05\x3e\xe6\x20\xd1\xd7\x35\x2c\x62\xd4\x61\xe7\x4f\x97\x0b\x58\x62\x50\xa1\xb8\xc9\xbb\xcf\x13\x6d\xb0\x4d\xaa\x34\x00\xa0\xc1\x05\x2c\x13\xc0\x36\x01\x1c\x13\xc0\x35\x01\x4a\x26\x80\x67\x02\x94\x4d\xc0\xa0\x61\x50\x35\xc1\x2a\x9a\x60\x59\x26\x58\xb6\x09\x96\x63\x82\xe5\x9a\x60\x95\x84\x13\x96\x08\x8b\x65\x88\x03\xe1\x10\xaf\x47\x60\x08\x5e\x6c\x47\xfa\xaa\x52\xfc\x36\xc5\x81\x7d\x57\x04\x3c\x0e\xed\xcb\xa5\xb4\x54\x29\x8e\x8a\x40\x67\x99\xe2\xf2\x28\x2d\x58\x5e\x22\xb4\xc5\x78\x2c\xda\x96\xd1\x82\xff\x55\x28\x1d\x8c\x4e\xfc\xaf\x1c\xe1\xb7\x44\xbe\x45\x3c\x2e\x6d\x5b\xa2\xb4\x97\x29\x0e\x5b\xa0\xd3\xa2\xb8\x2a\x94\x16\xca\xb7\xe5\x9c\x33\xf5\x2c\x9a\x8b\xf9\x8a\xb9\x60\x74\x30\xfe\xaa\xb4\x1f\x4b\xa0\xd9\x93\xc7\xc3\x2a\xf2\xf1\x8d\xf1\x78\xb4\x0d\xe5\x0f\xc7\x1d\xe7\x0c\x4a\x31\xcd\x56\x49\x1e\x0f\xa8\xf2\xf1\x8d\xf1\x10\xa0\x92\xc0\x60\x99\x12\xe8\xc5\x83\x0b\x15\x79\x40\xa0\xc8\x89\x8d\x11\x55\x68\x1b\x61\xb0\x88\x30\x95\x28\x7c\x49\xc0\xe1\x50\x62\xca\x9c\x58\x81\x20\x9b\x8e\x2c\xed\x04\xe8\xcc\x23\x11\x44\x5a\xbd\x18\x89\xc5\x66\x8e\x22\xb4\x2c\x79\x36\x16\xab\x56\x06\x61\x8f\x8d\x95\x65\x2b\x3d\x31\xd9\x8a\xd7\x42\xbc\x66\xaa\x8a\x4c\x97\x4d\x52\x05\x15\x41\x5e\x19\x8b\x25\xfa\x97\x72\xc6\x87\xb5\x4c\x61\xa5\xf9\xa0\x1d\xb2\x8e\x2c\xb6\x40\x1c\x4a\xa4\x4b\xe7\x83\xc1\x30\xc2\x91\x28\x71\xb1\x52\x54\x6c\x0d\xd2\xa6\xac\x7f\xbe\xe6\xc5\x35\xc1\xa6\x50\x5d\x67\x6c\x7d\x96\x28\xdd\x14\x96\xd2\xce\x49\xe0\xe3\x44\x45\x06\xcb\xc1\x03\x65\x4a\x56\x2d\x10\x4a\x18\x1b\x24\xce\x37\x93\xd3\x32\xfd\x8f\x2e\x44\xd2\xa1\x4b\x09\xf1\x14\xa2\x1d\x79\xf0\xa1\x22\x0f\x3e\x2f\xa7\x62\x45\xfa\xc1\x36\x62\xd4\x76\x61\x12\xd9\x5a\x20\x73\xe3\x45\x73\xc3\x18\x67\x0b\x1b\x3f\x5b\x8e\x66\x3e\xf8\x42\x53\x08\x07\x4f\x59\x70\xb6\xf0\x97\x31\x2e\x4a\x1a\x1d\xd9\x04\x9c\x2b\xcf\x0c\x9f\xc9\x98\x29\xb0\x2c\x88\x03\x2f\x93\x15\x72\x85\x2b\x44\x33\x1f\xf4\xac\x03\xfe\x9a\x50\xbc\x2b\x35\x2a\x76\xb9\x5a\xad\xe2\x67\xaf\x75\x58\x6d\x1d\x34\x2c\xfc\x5c\x39\xb2\x0e\x0e\x9a\x87\x4d\xfc\xdc\xa8\x96\x9c\xa3\x43\xb7\x25\xcf\xf7\xd5\x3c\xb5\x83\x52\xb1\x61\x57\x0e\x5a\x1e\x36\x2e\x35\xdd\xc3\x43\xcb\x76\xf1\xb3\x77\x58\x2e\x3a\xad\x23\x07\x3f\x97\x1b\xde\x61\xd9\x6b\x55\x4d\x81\xa0\x73\xad\x3d\xa0\xd7\x79\xd5\x7a\x79\x68\x79\x45\x92\x49\x60\x85\x0d\x89\xc3\xc6\x56\x24\xa1\x28\x7a\x2a\x6b\x6c\x31\x6b\x99\x59\x50\xc5\xdf\x7d\x47\x88\xd0\x8a\x7a\xf1\xce\x2b\xbb\x25\xdb\x29\x92\x11\x6c\x1d\x35\x0f\x1b\x07\x15\xc2\x60\xb5\x72\xd0\x38\x6c\x1e\xb5\xf0\xb3\x55\x74\xec\x92\x5b\x26\x83\xd3\x74\x0e\xed\x96\x75\x54\x3c\x4f\x35\x8d\xaf\x6b\x94\x8f\x5b\xac\x3a\x6b\xa9\xf3\x11\x59\x19\x9e\x1a\xbd\xf1\x9c\xfd\xc3\x41\x8f\xa8\x20\xb6\xd7\xf8\x58\x64\x5d\x0f\xfb\xf2\x9d\x00\xcd\x9c\x07\xef\x92\x07\x99\x04\x2e\x92\x15\x42\x3b\xb4\x72\x6f\x3d\x4b\x39\x00\x2a\x74\x16\x1f\x7c\x10\x0a\xf3\x1d\x2a\x4d\x20\xa4\xe7\x4a\x15\x84\xc9\xd3\xa5\x49\x74\x19\xa6\x31\x65\xc8\xe5\x0a\x1c\xed\x36\x30\x43\x1d\x11\xc5\x54\x97\x23\xd9\x00\xde\x5d\x4d\xd2\x01\xe6\x04\x60\x9e\x0a\x40\xd4\xcf\x77\x9f\xd3\x31\x7c\x26\x18\x3e\xa7\x63\x20\x8f\xe9\x77\x8b\x74\x0c\x0b\x82\x61\xc1\x31\xc8\x10\x7b\x7b\xb8\xca\x3e\xe0\x4b\xf3\x47\x7f\x1e\xa2\x76\xac\x88\x20\xe2\xf0\x27\x98\xdc\xd0\x84\xd1\xc4\x84\xf1\xc4\x84\x60\x52\xd7\x00\xcd\x4d\x18\xce\x4d\x18\xcd\x4d\x18\xcf\x4d\x40\xdd\x5c\x86\xf2\x91\x14\x1f\x09\x6e\x27\xaf\x8c\x0c\xb1\x72\x18\x55\x5a\x6a\xe5\x08\x2b\x47\x51\xa5\xad\x56\x8e\xb1\x72\x1c\x55\x3a\x6a\x25\x79\x61\x08\xa2\x4a\x57\xad\xe4\x69\xaa\x7d\x39\x2f\x35\x63\x69\x59\x5f\xbd\xb0\x2b\xe4\xef\xf6\x3e\x58\xda\xb5\x8d\x7e\x1e\xc3\x9f\xc0\x76\xbc\xa6\xb6\x3f\x4f\xce\xc2\xf3\xf3\xc2\x97\x62\x3d\x09\x8f\x36\xb4\xf0\x57\xcb\x2b\xa0\xa5\x8b\x15\xe2\xcf\x12\xb6\xf7\x01\x2e\x2c\x63\x38\x31\x47\x13\x73\x3c\x29\xc0\x36\x5c\x4d\xf4\x77\x6f\x1e\x80\x1b\xe4\xc2\x5f\x1d\xbb\x00\x29\xd8\x6c\x15\x9b\xb5\x12\x9b\x5b\x49\xc5\xe6\xa8\xd8\xec\x95\xd8\x3c\x37\x15\x9b\xab\x62\x73\x32\xb0\xfd\x6b\x6f\x8f\x62\xac\x14\x53\x31\x96\x54\x8c\x09\x81\xd0\xec\x14\x6c\x12\x97\xda\xe9\xc2\x1a\x98\xcf\x96\x13\x63\x69\x2e\x70\x5a\x0b\x29\x40\xc6\x72\x3b\x98\xe8\xa7\x9c\xac\x88\xc4\x92\xc2\x5f\x22\xb7\x63\x5d\x0d\x11\x77\xd2\x2f\xae\x49\x4b\x7b\x0d\x88\xac\x97\xa1\xae\xf5\x70\xa2\xf3\x24\x72\x52\xfd\x39\x6c\x0b\xd2\x3a\x7f\xbc\xb4\x96\x8c\xe1\xdc\x1c\xcd\xcd\xf1\x9c\x48\xeb\xfc\xeb\xa4\xd5\x55\xb1\x7d\xad\xb4\xca\xd8\xbe\x4a\x5a\x6d\x15\xdb\x57\x4b\xab\xa5\x62\x7c\x62\x69\xc5\x69\x2d\x40\x0a\x14\x8a\xeb\x3c\x45\x5c\xc9\x8e\xca\x0c\x2f\xe2\x3f\xb2\xd7\x8e\x75\x35\x64\x8b\x8e\xc4\x75\x9e\x2a\xae\x08\x33\xd4\xb5\x1e\xce\xb5\x27\x34\xa4\xaf\xe4\x50\x8f\x90\x8e\x01\x1f\xdc\xd1\xa9\x5f\xb9\xd9\x12\xff\xb7\x0f\x06\x3e\x64\x60\x1b\x46\xb8\x33\x8f\x35\x9c\xb6\xe9\x11\x5e\x7c\xde\x20\x08\xc2\xe9\x46\xa4\x4d\x8f\xe9\xe2\xa3\x07\x41\x10\xce\xd7\xc2\x39\x14\x8e\x84\x75\x21\x4f\x84\xa1\x16\xce\xa5\x70\xe4\xee\xc5\x10\xe1\x46\x5a\x38\x72\x47\x03\x96\x19\x6a\xed\x11\x4d\xad\x91\xa9\x72\xa7\x2b\x9f\x2c\xc5\x22\x57\x86\xf0\x4b\x5d\x0f\x93\x9d\x86\x91\x41\x4e\x0f\xc2\xe5\x62\x30\x5b\x92\x1d\x2f\x52\xb0\xa6\x87\xfe\xd2\xc7\x93\x3f\x0b\x3c\x96\xa0\xc1\x4e\xda\x9c\x04\x17\xcb\x44\xd2\x46\x02\x2f\x37\xa0\xb9\x96\x69\x22\x4e\xb9\x2e\xce\xe5\x18\xa3\x44\xf5\xb4\x74\x0e\x5f\xf6\xf1\x7d\xac\x28\x9c\x91\xe0\x10\xff\x00\xc7\x2e\xd4\xd3\x30\x19\x86\x11\x83\x6e\x03\xee\x0f\x88\xb2\x5a\x40\x54\x2e\xee\x79\x56\x34\x9d\x5b\xcf\x34\xba\xae\x30\x1c\xb1\xf2\x2c\x14\xae\xaf\x3c\x27\x90\x51\xc5\x59\x41\xb6\x4a\x71\xd6\x31\x1a\x8f\xf7\x3e\xc4\xf9\x30\x69\xaa\x19\x64\xb0\xa0\x4b\xdb\x81\x07\xf9\x71\x05\x92\xec\x2e\x51\x2e\x19\x45\xba\xa2\xe9\xbf\x89\xde\xbc\x92\xd9\x5c\xb2\xc5\x92\xac\x6e\x26\x42\xf8\x45\x21\x1c\x61\xda\x98\x61\x46\x50\xdf\xb7\x9e\x69\xde\x7c\xd6\x7e\x7f\x2b\xad\xf5\xfa\x96\xac\x44\x34\x6d\xf2\x2a\xd6\xd6\x5c\x26\x11\x28\x49\x56\xb4\xf9\x75\xbd\xb6\xf4\x7a\xd5\xce\xfd\x5e\x05\x20\x60\xa1\xa2\xd1\x4e\x7d\x99\x52\xc6\x52\x3c\x1e\x44\xe9\xed\x45\x9e\xf0\x68\x72\x69\x06\x8b\x31\x19\x6c\x19\x94\x7a\xcc\x95\x09\x92\xb6\xa9\xd1\x64\x36\xcd\xde\xa0\x70\x04\x09\x54\x6c\x5d\x20\x5f\xd3\x8f\x41\x44\xd5\xef\x12\x42\xb2\x4b\x2a\x92\xb2\x46\xc9\x24\xb5\x75\xfd\x11\x0a\xe5\x44\xdc\x85\x65\xdc\x99\x70\x6f\xc2\x67\x89\x5c\x8a\xc8\x30\xee\xc8\x95\xd0\x7b\xf2\xff\xcf\x71\xb6\xf6\x87\x04\x1e\x3b\x1b\x8f\x71\x57\xf8\xd9\xb8\x27\x37\x0f\x0c\xe3\x4f\xfc\xf2\xb9\x50\x28\xd4\xd3\xb0\x39\x2b\xb1\x21\xa2\x3f\x11\x63\x4c\x5a\x0a\x2e\x77\x35\xae\x9f\x09\x06\x44\x69\xdc\x17\x7e\x36\xfe\xc4\x6f\x19\x18\x4b\xd9\x18\xa3\x31\x33\xee\x0b\xf0\x25\x46\x95\x82\x8b\xa8\x12\x77\xe6\x54\x8b\xe9\xee\xd7\x5f\xa7\x64\xc4\xee\xfe\xf9\xcf\x7f\x1a\x8e\xbd\x33\x15\x89\x4a\x5a\xbf\xd8\xe2\x8d\xd1\xd3\xc3\x30\x28\x33\xeb\x1e\x86\x49\xcd\xf6\x43\xcf\xb7\x90\x34\x3b\xec\xf3\x72\x46\x16\x8b\x06\x4d\x4a\x7e\x9e\x14\xe8\xc7\x26\xe6\xa1\x2b\x83\x79\xe8\x62\x03\x9e\xf1\x0b\x25\xf1\x97\x42\x3d\x47\xab\x4f\x8c\xda\x42\x5d\xb1\x18\x36\x75\xc6\x41\xe5\xa8\x0d\x03\x28\xd4\xf3\x4c\x0e\x26\xed\xfb\x0e\x53\x93\xd1\x1c\x8f\xae\xe3\xac\x2e\x82\xd1\x3c\x58\xa6\xe4\x4e\x4a\x99\x50\xa4\xfe\x29\x27\x34\x4a\x64\xc8\xa7\xa6\x7d\xed\x8f\xe2\xe9\xa1\xbc\x91\x53\x51\x9a\x19\x92\x80\x93\xb3\x74\xed\x8f\x34\x33\xf5\xec\x21\xf2\x03\xa7\x1c\x8d\x62\x90\xca\x01\x29\x7a\x1a\xea\xe1\xdc\x2c\x97\x37\x47\x9c\xbe\xe3\x11\x27\x61\x1c\xf9\x9e\xf9\x43\xb8\xee\x95\xc0\x6a\x71\x93\x37\xa9\x21\xd3\xfa\xed\xc6\x8e\xb5\x86\x27\x23\x99\xab\xfa\x29\xfd\x17\xfb\xe4\x8a\x3d\x1f\x8c\x5d\x4c\x47\x23\xb9\x4f\xe9\x8f\xec\xd2\x08\x2e\x46\x63\x7f\x58\xa9\x0a\x3e\x2b\xf6\x53\xbc\xab\x56\x86\xfe\x78\x74\x11\x48\x3e\x0e\x1d\xe0\xc8\x19\xdb\x81\x75\x41\x4f\xf4\xb3\x9f\xaf\x77\x81\xf0\x06\x8a\xf8\xe8\x95\x6f\x41\xf1\xfa\x1a\xdb\xb3\xde\x5c\x8c\x9d\x0c\xf5\xc6\x62\xac\x1a\x91\xaa\x84\x45\x07\xab\xc6\x7a\x43\x31\x56\x05\xa4\xca\x4d\xa8\xe9\x59\x76\xe2\x54\x9b\x70\xca\xdb\x02\x6e\x5a\x08\x63\x79\xda\x6a\xfc\x7d\x13\x29\xfe\xcc\x02\x87\xbe\x9e\x73\xe5\xee\xa9\x6a\x50\xd2\x23\x62\xf7\x1c\xde\x9c\x85\xb0\x03\x98\x01\x82\x7e\xac\xc4\x1f\x2d\x57\xf8\xec\x9d\xd7\xf5\x98\x28\x49\xc6\x14\x5f\x6c\x2d\xa2\x5d\x4d\xc9\x2b\xa5\x63\x89\x52\xc5\xfe\x31\x85\x4d\x77\xeb\xc1\x30\x7c\x44\x52\x22\x48\x7c\x82\xc4\x2e\x93\x0b\xb2\x01\x6c\x23\x7d\xe7\xf5\xf4\x51\xb3\x8b\xa9\xa3\x46\xcc\x66\x86\x31\x84\x9f\x61\x44\x70\xff\x89\x1f\xc7\x04\x73\xf1\xae\xe4\x47\x5e\xd8\x15\x26\x3e\xbc\x0d\xb2\xaa\x8b\x21\xfc\x01\x23\xf8\x03\xc6\x11\x66\x2f\x18\x57\x83\xa1\x6f\xad\xc6\xec\xe5\x20\x3e\xa2\x1d\xd9\x18\x51\x2e\x76\xd0\xa5\x5b\x0c\x5c\xd7\xb1\xdd\xf4\xbe\xf6\x9e\xf3\xee\xd0\xb6\xf8\x7c\x6f\x6d\x5e\x10\xbf\x53\xaa\x8e\x9d\xc0\xf6\xd7\x9c\x52\x5c\x2f\x63\x0d\x2c\x2e\xb1\x91\xa6\x1c\x57\xa5\x31\xc4\xd9\x77\x8a\x94\x4b\x32\xfd\x3a\x11\xc2\xc5\xad\xa3\xc3\x5f\xef\xb6\xd6\x5a\xc6\x40\x6a\x44\x63\xc6\x36\x5f\xbd\xe1\x2d\x1b\x02\x89\xc1\x30\x11\xf9\x44\x36\x02\x12\x63\xe1\x48\x0f\xc3\x0c\x80\xc4\x50\x38\xd6\xc3\x30\xe3\x1f\x31\x12\x06\x2a\xcc\xc6\xc0\xf7\x77\x35\xf0\xa1\x2e\xbc\x7b\x31\x99\xcd\xe6\xa2\x21\x6e\x8f\x3c\xa8\xe9\xd1\x8b\xaf\xea\x84\x44\xed\x8e\x31\xaf\xb0\xd3\x25\xcc\x74\xdf\xc8\x42\x97\xd3\x0e\x14\x2b\x04\x69\x32\xfd\x83\x58\x83\x36\x26\x84\x84\x09\x01\xd5\xe7\x5c\xd6\x03\xd2\x20\xcb\x70\x20\x2b\xe4\xb2\xcd\x00\xeb\x36\xe6\x82\x27\x35\x17\xe0\x90\xae\x63\x29\xd0\x4f\x4b\x6c\x24\xa0\x53\x93\x7e\x6d\x0a\x01\xd2\xcd\x02\x95\x1f\x3a\xc0\xc6\xe2\xca\xb7\x4b\xde\x37\x34\x19\x98\x10\xf7\xf2\x3f\xc7\x7c\x90\x30\x0f\x3c\x03\xf8\x6f\xf6\xde\xbd\xd9\x89\x1b\x69\x18\xff\x7b\xf3\x29\xf4\xfc\xb1\x5b\x76\x62\x38\xba\xcc\x45\xc3\x81\xdf\xef\x65\x49\xf2\xb2\x15\x08\x14\x9c\xad\xe4\x29\x0a\x52\x73\xd1\x60\x6f\x8c\x7d\x1e\xdb\x84\xc3\xb3\xcb\x7e\xf6\xb7\x5a\x23\xcd\x68\x46\xad\x19\xfb\xe4\x40\x36\xbb\x60\x0a\xec\xe9\xab\xba\x5b\x2d\x8d\x46\xd3\x6a\xef\xe1\x79\x9c\xf4\xee\xe2\x9d\xb7\xb0\x7b\x99\xe1\xe1\xfd\x5b\x9c\x47\xc7\xad\x0b\x00\x62\x8f\xa7\xfe\xdd\x30\xff\xa4\x6b\x03\x25\xa3\x71\xa6\x2a\x09\xb7\xfc\x22\x49\xcb\x2a\xa6\xa9\xfe\x4e\x53\x5a\x55\x4c\x7f\xaf\x53\xaa\xe2\x4c\xe0\x6b\x06\x75\x5d\x52\x5a\x08\x06\x88\x89\x8c\x25\x8b\x61\x27\x2e\xbd\x4a\xa2\x3a\x93\x75\xae\x19\x14\xaa\xce\xa3\x3a\x8f\x4e\x58\x2e\x38\x6a\xe6\xe9\xa4\x7d\x63\x3a\x87\xb2\x37\x60\xf7\xe9\x80\xa6\x9b\xce\xdc\xba\xe7\x4f\x5e\x02\x0f\x96\x3e\x0f\xd1\x13\x43\x34\xe7\xd1\xa9\x83\x34\x90\x4c\x0c\xd3\xbd\xde\xe1\x0d\xd4\x9c\x47\xa7\x39\xe4\xf3\x50\x3d\x3d\x54\x83\x57\x8e\x1b\xac\x51\xe7\xf4\x86\xeb\xc6\x41\xa3\x03\x36\xe7\x11\x3e\x64\x2f\xba\xe1\xe8\x4e\x9a\xc1\x00\x9e\x7d\x5e\xd7\xff\x6d\xd6\xf5\x7f\x7f\xaf\xe5\x41\x15\xa6\x15\x0c\x06\xed\x5b\xb9\x64\xb7\x7d\xbb\x81\x93\x1b\x7b\xef\xeb\x39\x2d\x78\xe8\xd6\x64\xb2\x67\x8e\x38\x8f\x01\xec\x42\xad\xea\x78\x34\x90\x59\x60\x9c\x6a\x2f\xaf\xf6\x4f\x77\xab\x37\x6a\xd6\x7f\xa6\x6c\xc5\xec\xff\x67\x77\xf8\xde\xde\xe7\xc3\x8f\xd9\x66\x7e\xde\x47\x6b\x17\x82\x1b\x77\x92\x7b\x84\x9f\x9b\x38\x20\x77\xef\x11\x20\xfa\xde\x5e\x18\x59\x1b\xfe\xaf\xd9\x86\xfc\xd1\xa0\xcd\x51\x2c\x67\x00\xac\xf3\xf5\xde\xbd\x49\x1d\xdc\xac\x86\xd6\xc7\x0c\xf5\x61\xf7\xb6\x7f\x87\x8b\x98\xe5\xb5\x3a\x7c\xbb\xcb\xb5\xe5\xf2\x35\xac\x3f\x20\x06\x32\xec\x66\xb3\x0d\xd4\x82\xda\xc0\xa2\xd5\x1c\x76\x05\xb9\x8b\x1f\xc3\x95\x2c\x47\x96\x5d\xa5\x76\x2b\xb3\xeb\x6b\xda\x21\x83\xfa\x3d\xef\x96\xab\xb5\x22\x33\x03\xbb\x4b\xcc\x96\xcc\xa1\x15\x3b\x6f\x06\x0d\xdd\xb2\x90\x28\x0a\x7c\x1e\xbe\x68\x90\x60\xf1\xc5\x37\x04\x74\xb8\xdb\x97\xdb\x77\xb3\xcd\x82\x30\x72\x46\x78\xbb\xcd\x60\xc4\x15\xf0\xf7\xbb\x93\xd8\x8a\x6e\x1f\x84\xfb\xa7\x61\xf1\xd5\x57\x03\x91\xae\x61\xe1\xb3\xe9\x61\x18\x65\x4c\xaa\xb7\x5d\xe6\x9a\xcf\xd8\x78\x9c\x1c\x39\x9b\xf6\x67\xe8\x1f\xfd\x49\xdb\xc3\xdb\xfb\xf5\xaa\x54\x33\x3a\x1f\x9b\xc6\x7e\x7e\xea\xd5\x7b\xea\xe5\x81\x6a\x0d\x8a\x31\xd0\x6b\x0d\xf2\x1e\x18\x01\x68\xa9\x41\x69\x1b\x38\xd7\x7d\x8c\x96\x8c\xd4\xba\xff\xd4\x8f\xd1\x5e\xe7\x6f\xde\xe4\xf4\xaa\x7d\x98\xc6\x3c\xa3\xf8\xd8\x7a\xa3\xf1\xcc\x52\xc2\xd6\xbb\xe6\xa1\x97\xbd\x02\x8b\xd3\xf0\xe4\x8b\xbc\xc2\x39\xf5\xff\xf4\x38\xb1\xc8\xe3\xc4\x60\x27\xdf\x51\x9c\xfa\x74\x02\x4d\x2f\xbd\x96\xb0\xae\xdd\x5e\x70\xf9\xc8\x4e\xb3\x59\xa3\xac\xd3\x6c\x66\x94\x4d\xe7\xf3\x93\x9a\x6d\x38\x09\x9f\x53\x76\x62\xb3\x2d\x1d\x0d\xb6\xdb\x04\x8e\x71\xe2\x57\xa6\xe5\xfa\x38\x4a\xd3\x42\x7b\x0d\x7f\x60\x3a\xcc\xc1\xd6\x3c\xe5\x52\x9b\x46\x91\x3f\x91\x5a\x6f\x76\xfb\x27\x7c\x7d\x8d\x8d\x1a\x80\xfe\x26\x87\xaa\x6a\xb3\x9c\xfc\x89\x14\x1a\x1d\xbe\x95\xfa\x5b\xa1\xbf\x61\xfa\x03\xe1\x7e\xf5\xfa\x4d\x4e\xbb\x47\xad\x82\xba\xcf\x5a\xc1\x5c\x16\xc2\x32\x07\xc2\x44\x0f\xd4\x23\xc2\xc7\xb6\x56\x98\x3e\xa7\x66\xa6\x80\x8e\x37\x95\x93\x95\x66\x99\x18\x8e\x0d\x84\x39\x10\xc6\x5c\x50\xda\xec\x6c\x6d\x40\x3c\xc6\x47\x3c\x90\x76\x00\x49\x4b\xf2\x95\x15\xfb\x15\x29\xe1\x97\x3e\x87\x2d\xf8\xf0\x58\x13\x72\x72\xcf\x1a\xe6\x2b\xf2\x26\xef\x15\xab\xb3\x1f\x48\x5c\xaf\x11\x06\x90\xeb\x6a\xe4\x3a\xa4\x47\x6c\xfa\x05\x0f\x27\x66\xfa\xb4\x04\x36\x9c\xf8\x1c\xf3\x88\xd4\xce\xc1\x8f\x7d\x1c\x3a\x3b\x80\x21\x0e\x1c\x93\xf5\x9f\xf2\x68\x14\x3e\x0f\x4d\xc9\xf0\x87\x4d\xc9\xf0\x1a\xc7\x49\x0c\x8e\x3e\xbc\xf6\x35\x8e\x93\x1a\x1c\xdd\xe7\x97\x43\x9c\x6b\x2d\x86\xf9\x77\x64\xc3\x47\xac\x9f\x1f\xc3\x7e\x7e\x0c\xfb\xf9\x31\xec\xe7\xc7\xb0\xbf\xf2\x31\x2c\x8f\x93\x93\xd7\x78\xe3\x64\x72\x8d\xd7\xbd\x67\xf3\xd7\x78\xe3\xe4\x34\x87\x7c\x5e\xe3\x3d\x62\x8d\x37\x4e\x8e\x5d\xe3\x8d\x93\x89\x47\xb2\x3c\x4e\x26\x37\x6d\xb7\xcf\xce\xf0\x47\xb3\x92\xfe\xae\x1f\xcd\x5e\x25\xd1\x27\x39\xb8\xa0\x95\xf3\x79\x15\xb8\xbf\x0a\x7c\x95\xc0\x83\x88\x07\xb7\xaf\x12\xbb\x7d\x11\xae\xff\x98\x44\xa6\x4a\xb7\xc6\xb8\xed\xd4\x89\x3e\xa9\xa6\x9b\xd3\xbe\x67\x0f\x9f\xfc\xf4\xe4\xdb\x6f\x9f\x7f\x73\xd1\xab\xe0\x0f\xec\x9e\xfe\xe5\xa7\xbf\x7c\xff\xf5\x37\x3f\x7e\xe3\x9f\xca\xfd\xec\xc9\x5f\xbf\xff\xfa\x27\xa8\x38\x78\x71\xff\xfb\x96\xd2\x11\xa7\x77\x37\x91\x07\xc7\x2d\x2b\x3b\x14\xbb\xe5\xd6\x2c\x26\x0d\xd7\xa4\xad\x68\xb8\xbb\x66\x0b\xf2\x3e\x54\xaa\xfc\xd0\x94\x2a\x3f\x40\xc5\xff\xe8\x9c\x1c\x90\x25\x11\xa7\xcd\x2f\xae\xc8\x57\x24\x26\x5f\x92\xf7\x30\x59\x99\xcd\x0e\xf6\x25\x4d\xfd\x8d\xcf\xf5\x4a\x25\xf9\x23\x49\xbc\xb9\x08\xe8\xb2\x51\xef\xe0\x28\xbb\xf7\xe4\x8f\x24\x3e\x47\xc1\xff\x0d\x5c\x39\xf9\x92\x80\x1c\x01\x72\xe6\x08\x32\xb4\x09\x78\x0d\x2e\xbf\x6f\x2e\xff\xb7\x73\xf9\xc3\x17\xa8\xd9\x2e\x57\x64\xa5\x8f\x13\x40\x8c\xd6\x5a\xe6\xaa\xb1\xcc\x55\xf3\x82\xe6\x15\x62\x98\x16\xf5\x7d\x83\xfa\xbe\x41\x7d\x8f\xa0\xc2\xdf\x2e\x40\xfa\x66\x7c\xaf\x4f\x07\xf2\x5b\x0d\xcb\xdc\xc3\xa6\x7f\x98\x6c\xdb\xe0\x29\x47\x87\x03\x1e\x78\xf4\xed\xf3\x67\xa0\xeb\x15\x65\xe7\x48\x93\x9d\xf5\x31\x1e\x58\x1f\x03\x2e\x5a\x84\x0d\xd6\xc7\xfb\x77\x83\xd8\x42\xd1\x1e\x59\xb4\x80\x09\xcd\xc9\x13\x7f\x23\x77\x49\x7a\x4e\xfe\x16\x30\x21\xac\xcc\xe9\x36\xe8\x43\xbf\xf0\xaa\x28\x56\x7c\xb1\x3a\x3c\xdd\xee\x75\x39\x59\x88\x2a\x7d\x58\xee\xdf\xe6\xe4\x16\xc1\x76\x53\x5b\xe6\x2e\xd1\x5d\x62\xea\x45\x58\x84\xe1\x1f\xaf\x81\xaf\xee\x11\x2d\xc6\xe1\x12\x90\xd5\xdf\x51\xed\x4a\xfd\xff\xee\x69\xb1\x5f\x9e\x1d\x2d\xf9\xb1\x23\xb9\xc7\xea\x16\x72\xdf\x83\x04\x12\x16\x50\x48\x60\xc1\x39\xab\x04\x8c\x3f\xe1\x16\x53\x76\xc2\xc2\xdc\x3f\x67\x67\x04\x9e\x2c\xac\x0e\xab\x5f\x14\xb9\xdc\xae\xdf\x6f\xb6\x6f\x56\xf9\x9a\x6c\x7f\x51\x3b\xf2\x7f\xbf\x9d\xf1\xf9\x1d\x72\xf5\x4a\x92\xaf\xc8\xd5\xab\x44\xff\x1b\xeb\x7f\x23\x48\x33\x38\x4b\x13\xd1\x33\xfd\x3f\xac\x07\xc2\xca\x0f\xbd\x4a\xd9\xc9\x2b\xb1\x86\xc3\x3d\x34\x3e\x06\xe6\x1a\x9a\x6a\x90\xe0\x61\xc5\xe8\x9e\x1d\x7e\x6c\x81\xb1\xa1\xcf\x16\x5e\xfc\xb8\xbe\x3a\xea\x69\xca\x5e\xbf\x4e\x7e\x50\x6f\x2e\xb7\x3b\x28\xff\xec\x9e\x44\x07\x5d\xe0\xc2\x1d\x88\x42\xc3\x09\xda\xfb\x03\xaf\xa4\x5f\xa0\x2d\x9b\xd2\xdb\x9d\xb4\x3f\xbc\x7f\x4b\x1c\xf7\x64\x47\xf4\x9e\xeb\x88\xb1\xa7\x3a\x37\x7f\x94\xc0\xf6\xed\xe1\xf2\xed\xe1\x91\xbe\xb5\xee\xe1\x12\x7d\x4f\x50\xa9\xfd\x6a\xa7\x2a\xe7\xa0\x81\x62\x75\xd8\xdb\x82\xd0\x0d\x71\xef\x6e\xc1\x12\x3f\xd9\xac\xad\x9b\x9c\x1a\xdc\xf9\x0e\x4e\x4f\x81\x2a\x7b\x3c\x4e\x16\x44\xc8\x68\x41\x62\xc6\x87\xc4\xe6\xcc\x82\x3b\x00\x73\x41\xc3\x43\x0b\x8c\x9d\xc2\xe7\x16\xb8\xad\x1b\x70\xbb\xc6\xf9\x05\x10\x69\x70\x8f\xa9\xda\x7b\x6f\xfb\xeb\xc5\xcb\x40\xb6\x3f\x22\xd4\xe0\xaf\xe6\xd3\x84\x1c\x3c\x86\xb3\x61\xa7\x1f\xc2\xcd\xcf\xc7\xbb\xa4\xd6\xa4\x3d\xad\x17\x52\x04\x4b\x28\x25\xb7\x08\x0c\xb4\xed\x49\x09\xae\x25\x60\xf6\x22\x78\x68\xdd\xe2\xd7\x3e\xd1\xdb\x1f\x65\xb4\x41\xa3\xc0\xb4\x9b\x3f\xdb\x46\x3c\xca\x37\x7a\xed\x67\xd0\xb4\x33\xc2\xcf\xbf\xe8\xd3\xc1\x1e\xcf\x62\xbf\xdd\x15\xd3\xf6\x1f\xb0\x0f\x3b\x23\xdc\x16\xab\xe9\x63\xbe\x22\xfd\x87\x62\x60\xeb\xe0\xb2\xf9\x63\xbe\x62\x08\x3a\xe4\xfa\x76\x76\x8c\x14\xb3\x40\xcb\x6a\xc0\x5f\x10\x3f\x5a\x4f\x90\xf7\x0a\x09\xf2\xeb\x54\x10\xe4\xbd\xd2\x81\x3c\x54\xe6\xc2\x67\x30\x3f\x47\x15\x66\x53\x1a\xb3\xbe\xca\xec\x5a\x3a\xb3\xbe\xd2\xec\x14\xad\xfd\x8b\x6d\x70\xb5\x4b\x23\xab\xcd\x61\xdb\x44\xb3\x8f\x0d\x81\xb1\xce\xe1\xe6\xb7\xed\xce\x88\x29\x00\xe3\xf6\x72\x05\xe7\xaf\xde\x23\xa0\x62\x08\x67\xbd\x7d\x47\x0c\xce\x44\x02\x80\xc5\x4b\x18\x60\xf7\x81\x3e\xa0\x47\xdf\xa6\x1f\x34\x5f\xc1\xaf\xe6\x7b\xb8\x0b\x5c\x2c\xd5\x21\xf7\x21\x27\xdc\x35\xd8\xcf\xd9\x19\x79\xbc\xba\x22\xe5\x76\xfd\xf6\xcd\x46\x37\x0e\xe9\x56\xd6\x82\x76\x9a\xbd\x20\xdd\x4c\x1a\x45\x3e\xf1\x9e\x24\xe8\xa5\xee\x0e\x25\x20\x08\xfe\xda\xa9\x67\xeb\xbd\x31\xdc\x47\x0e\xee\x7a\xfb\x2e\x80\xfa\xe1\x0b\xfc\xfa\xd9\x19\xb9\x40\xe7\x39\xc3\x0f\xb4\xe4\x02\xee\xdd\x2e\x5e\x5c\x85\x74\xbf\xb8\xd2\xda\x42\x2a\x7d\xbc\x0f\x69\x72\x71\xd5\x04\xdb\x3d\xad\x3a\x82\x64\xa6\x3a\xa8\xf5\x4f\x0a\x83\x91\xb4\xda\xb5\x08\x16\x38\x2e\x5e\xcc\xc0\x2f\x91\xbe\x29\x0e\x35\xae\x41\x67\x1d\x3a\x3b\x06\x1d\x3c\x79\x8f\x5c\x5c\xb1\x31\x3f\x1a\xdc\x47\x2d\xae\xf6\xe3\x68\x78\xef\xdf\xee\x74\x8f\x82\x3d\x29\x4d\xa8\x4f\x47\xf9\xc5\x55\x64\x72\x01\x99\xcd\x8c\x6e\xed\xab\xc1\x46\xbe\x79\x3f\x78\x7e\x3e\xc2\xcd\xea\x19\x99\xac\xd1\x70\x7b\x34\xe0\xf6\x78\x9a\xdb\x27\xed\x54\xbd\x54\x38\x12\x9f\x5e\x4a\x0c\x84\x69\x20\x54\xb1\x8e\x06\x29\x73\xb9\x25\x4f\x57\x5f\xfc\x21\x68\x02\x10\x69\xcf\x7c\x64\xe7\xce\xcf\xe6\xae\xa1\xfd\xfd\xab\x43\xbe\x67\xb9\x96\xed\x58\x18\x03\x12\x78\x73\x3a\x21\x59\xec\x47\x1d\x76\x38\x25\x01\xf2\x6e\xb9\x7d\xd2\xac\xb3\xdd\xeb\xad\x87\xb9\x7a\xe1\xd4\x60\xd3\x2d\xb4\x61\x2c\xc5\xc3\x0d\x74\x27\x62\x72\xe1\xc1\xe9\x2b\x33\xdb\xe8\xbb\x77\x3b\x25\x75\x68\xdb\xf6\x41\x6c\xcf\x04\x27\xb7\x1c\xf8\xfc\x7c\x82\xf9\xa3\x8e\xf9\xa3\x10\xf3\xc7\x27\x32\xef\xaf\x79\xb4\xf8\xc7\xad\x78\x0c\x9b\x6c\xb4\x72\xd8\xe8\xd5\x0e\x5f\xb9\x24\xba\x76\xcb\x1f\x4f\xc8\x78\x74\xa2\x8c\xb1\xb1\x6d\x97\x6f\xf6\x97\xdb\xfd\x68\x94\x80\x7e\x17\x4f\x57\x30\x4f\xd7\xd9\xdd\x59\x50\xec\xe2\x30\xd4\x41\x0c\xe1\x31\x03\x9f\xc1\x9c\x1a\xfd\x82\xf9\xe3\x72\x45\xf2\x83\x9e\x09\xe9\x85\x51\x1f\x4f\x37\x04\x36\x11\x5d\xf8\xdb\x10\x2d\x5c\x67\x4c\xda\x26\x00\x14\xef\x82\xda\xe6\x68\x24\x1a\xea\xf2\x17\xd4\x0e\xe5\x06\x2f\x30\x6e\xc1\x6a\xd7\x72\x75\x23\xc3\xfa\x35\x87\x8c\xe9\xd4\x68\xed\xd3\x7a\x9c\xdc\x23\xed\xb8\x72\x3e\x4d\x63\x8d\xd0\x4b\x5d\xa3\x54\x17\x6d\xc0\x1d\x4f\x72\xc5\x5a\xa2\x99\x33\x09\x99\x1f\x33\x00\x42\xeb\x2e\xae\xf8\x80\x01\xf7\x18\x84\x39\x98\xb9\x07\x3c\xff\x3a\x66\x9c\xbd\x47\x2e\xba\xae\x01\x5b\xe7\x8c\xfa\x0d\xf4\x4f\x56\x19\xfd\x73\x7e\xd4\x68\x6c\x39\xda\x79\x47\xcb\x51\x5f\xe8\x38\xae\xb7\xef\xc2\xc9\xe2\xd8\x2e\xf7\x97\x2d\x76\x47\xe2\xf9\x3b\xd8\xd3\x7a\xcb\x90\xe4\x9e\xb7\x98\xa9\xe1\x18\x71\x67\xc1\x57\xf7\xfa\x8b\x99\xa1\x8e\xd8\x59\xc8\xa3\x80\x2e\x39\xa0\xf8\x10\x5e\x8a\xf9\x8d\xb7\x45\x79\x88\xce\x8e\xa0\x9b\xda\x15\xe5\xe1\xb7\x2b\x3e\x40\xe7\x2f\x03\x7d\x49\x04\xbf\xc9\xbd\x54\xec\x3a\x5b\xa9\xe0\x11\xf1\xed\x52\xad\xd6\xfd\xfd\x4e\x0c\xd6\xd6\x7a\xfa\xc3\xc3\xad\xc1\x05\x2d\x5c\x3f\xa0\x69\x77\x73\xfd\x8b\x6c\x82\xfa\xd5\x6b\x78\xee\x32\xa3\x55\x1e\x5d\x83\x24\x67\x44\x4e\xd0\xdb\x55\x40\xf7\x5a\xc3\xf3\x0c\xdd\x4c\xf7\xfc\x7f\xde\x2a\xf5\xbf\x83\x95\x19\xbb\x59\xc7\x06\x77\xfb\x94\xc0\x1b\xbf\x9c\xa5\x42\x4f\x8b\x5f\xb3\x58\x38\xbd\x26\x74\xfc\x94\x3e\x34\x9d\x3f\x7d\x0d\xb1\x93\x17\x5e\x95\xb3\x38\xdd\xc2\x9c\xbd\x72\xf2\xda\x9c\xc3\xca\x2c\xcf\xb9\xac\xae\xbb\xae\xd8\x99\x62\xbc\x11\x8f\xbc\x46\x3c\xba\x6e\x23\x1e\x79\x8d\x78\x74\x5a\x23\xfc\x8b\x5d\xe8\x9a\x4e\x76\xd8\xc2\x2e\xc3\xdd\x4a\xfd\xa2\x90\x0d\x88\xf6\xe5\x6e\x1d\xd1\xb7\x2f\xdf\xee\x97\x56\x8d\xf9\xf9\x51\x98\x8f\x7d\xcc\x0f\xbf\x7a\x3f\x24\xf2\xf6\x50\x2b\x7a\xe1\x77\xe1\xf9\x79\x60\xc8\xbb\xa1\x5d\x93\xe1\xfc\x65\xb6\x50\x22\xe9\xac\x7d\xd9\xe9\x88\x0c\x71\xe4\xc3\x1c\xfb\xb5\xdb\x9f\x39\x6a\xf6\xcf\xdb\x35\xaf\xb9\x5d\x53\x9c\xba\x59\x53\x4c\x6d\xd5\x14\xfe\x5e\xc0\x76\x1f\xa0\x38\xcd\x11\x9f\xb7\x69\x4e\x6f\xd3\x14\x47\x6e\xd2\x14\x13\x5b\x34\xc5\x31\x1b\x34\x45\xf8\x35\xfc\x76\xe3\xe1\x1d\x19\x7d\x78\xb9\x90\xec\xdf\x64\xbb\x66\xef\xf2\x7e\x99\xc7\x8c\x7f\xb2\x3d\x9c\xb6\xdc\x0e\xc8\xfc\x6d\xf6\x73\xb6\x69\xfa\xc4\xbd\x9c\xd7\xda\x6d\x69\xc0\xdd\x6e\x4f\x8b\x33\xdc\xed\xe9\x6d\xcb\x6c\x21\xcf\x1f\xde\x8f\x19\xef\x6d\x0b\x89\x19\x47\x93\x0e\x6c\x33\x91\x47\x16\xe4\x11\xb2\x5f\x90\xa7\xf9\xdd\x08\xbb\xb1\x57\x88\xdd\xe6\x07\x6b\xf2\x78\x9b\x1c\xe8\x55\x59\x14\x45\x56\xc5\xd5\xc2\x29\xd8\x33\x5f\xf8\xdb\x21\xe8\x55\xc2\xb3\x9c\x67\x3c\x77\xcb\xf9\xcc\x17\x47\x09\xc9\x58\x9c\x51\x16\xe7\x6e\xf5\x1f\x5c\x08\x8b\x79\xad\xca\x4a\xba\xb5\x81\x8e\x14\x92\xa4\x42\xf0\x24\x49\x17\x4e\xe5\x20\x5c\x88\x54\x45\x14\xe5\x32\x75\xeb\x0a\x1d\x29\xa4\x2a\x68\xc9\x15\xad\xdc\x32\x44\xb8\x90\x28\x2d\xe2\x48\xb2\xca\x2d\x52\x34\xff\xb8\x55\x8a\x62\xc6\x6f\x3b\x94\xa7\x54\x29\x62\xc9\x10\xe5\x73\x99\xa2\xeb\x95\x29\x12\xf2\xe4\x32\x45\x40\x32\x35\x2f\x72\x73\x86\x3f\x33\x92\x9f\xcb\x14\xdd\x78\x99\x22\xf0\xca\x91\xb3\x23\xcc\x39\xfd\xf9\x91\x9c\x2c\x53\x24\xe4\x78\x99\x22\x18\xc6\xef\x48\x8e\xcd\x96\xf8\xbf\xc9\x6c\xe9\xdf\xfa\xe5\x96\xeb\x4e\x86\xf0\x17\x5b\x3e\xd2\x2b\x2b\xd7\x9f\x44\x0d\x0e\xb9\x31\x8c\x4c\x67\xe8\x0f\x5c\x26\xf8\xfb\x9b\x7c\x6f\xe7\x97\x97\xeb\xf7\x33\x73\x71\x41\xf2\xdd\xeb\xb7\x6f\xd4\xe6\xd0\x2e\x59\x7c\x40\x5e\x9f\xe9\xf4\xd1\xa5\x94\x3a\x11\x03\xf1\xf4\x2a\xe2\x32\xe7\x75\xa6\xe7\x15\x55\xca\x65\xae\x38\x9f\x2f\x7c\xbc\x94\x89\x34\x8a\x32\x06\x78\x5c\xa8\x3a\x89\xcb\x6a\xbe\x18\x61\x5c\xc4\x25\xad\x8b\xb2\x06\x02\x55\x46\x95\x28\x78\x8d\x31\x56\x59\x11\x57\x45\x1e\x03\x9e\x64\x32\xab\x8a\xa2\x1c\x65\x2c\xb2\x38\x29\x79\x5c\x00\x41\x2d\x22\x59\xc4\x42\x62\x8c\xe3\xac\x66\x8c\xd5\x5a\xe3\x22\xa1\x71\x45\x59\x36\xca\x38\xe3\xa2\x96\x3c\xd7\x47\x6e\xe7\x35\xcb\xa2\x3a\x2b\x30\xc6\x79\xc1\xca\x58\x55\x5a\xe3\x2a\x4f\x2a\xc9\x98\x1c\x65\x5c\x49\x9a\xe6\x79\x63\xe3\x5c\x50\x41\x79\x84\xda\x98\x71\x29\xe2\xa2\x39\x33\x22\x8a\x53\x9a\xd4\x85\x1a\x65\xcc\x23\xc1\x64\x5c\xe8\xb3\x23\x22\xa5\xa2\x82\xcb\x12\x63\x1c\xc7\xb4\x4c\xab\x52\x00\x5e\x15\xd7\x75\x11\x29\x3e\xca\x38\xe5\x85\x8a\xab\x54\x9b\xa2\xe6\x69\x21\xb3\x04\x75\x9e\xa4\x95\x2a\x58\xad\x15\x10\x05\x4b\xb2\xa4\x60\xa3\x8c\xb3\xa2\x2a\x69\xd2\x54\xa8\xe4\x71\x99\x32\x2e\x62\x8c\x71\xc9\xb2\xa2\x66\x8d\x02\x65\x9d\x64\x3c\xc9\xa2\x51\xc6\x2a\xca\x8a\x24\x2b\xb5\xed\x32\x55\xb3\x28\xaf\x50\x1b\xab\xba\x50\x51\x2a\x13\xc0\x13\x32\xaa\x79\xac\xc4\x28\x63\x5a\x97\x2c\xab\x4a\x4d\x20\x0b\x59\x56\x71\x81\x6a\xcc\x23\x5a\xe6\xac\x2c\x01\x2f\x4d\xf3\x32\x2b\x93\x78\x94\x31\xaf\x54\xc6\xcb\x44\x77\x90\x38\xe3\x05\xe5\x29\xca\x38\xca\xd3\x48\x46\xb9\xbe\x47\x48\x54\x9e\xa8\x48\x8e\x6b\x1c\x97\x05\xcd\xb3\x4a\x6b\x52\x54\x11\xab\x8b\x2a\xc2\x18\xa7\x49\x9d\x49\x59\x69\xc6\x52\x30\x16\x8b\x62\x5c\xe3\x4c\x0a\x15\xb3\x98\x03\x81\x52\x49\x52\xd5\x39\xde\x41\xa4\x60\x65\x92\xe8\x19\x3e\xaf\x8a\x48\x70\x46\x47\x19\x17\x94\x0a\x9e\x96\xba\x83\x64\xb2\x2e\x38\x13\x68\xb8\x15\x75\x9c\xa5\x75\x69\xea\x9b\xaa\x9a\x2a\x35\x1e\x15\x65\xa2\x28\x2d\x6a\x1d\xf8\xa2\xca\xa5\xac\x4b\x34\x2a\xaa\x38\x4f\x33\x16\x69\xc6\x99\xa0\x79\x9e\xf2\x71\x53\xd0\xa4\xcc\x13\x11\xeb\x70\x53\x94\x0a\xc9\xf1\x0e\xc2\x22\x9e\xf1\xac\xb9\xf7\xa2\x39\x55\x89\x4a\xc7\x4d\xc1\xd3\x22\xa5\xb9\xd4\xc9\x25\x4a\x2a\xce\xeb\x1a\xed\xd2\x5c\x31\x30\x93\x36\x59\x5c\xf2\xa4\xcc\x78\x32\xca\x38\xaa\x78\x99\x54\xb5\x8e\x8a\x38\x2f\x23\x9e\xab\x0a\x63\x1c\x0b\x21\x69\xc5\xb4\xc9\xb2\x2a\x8b\x0b\x51\xd5\xa3\x8c\x93\x98\xe6\xa9\x88\x75\x57\x95\x45\x5e\x27\xa2\x52\x18\xe3\x34\x49\x72\x9a\x17\x3a\x6f\x8b\x32\x4d\x0b\x9e\x8f\xa7\x4d\xc9\x4a\x5e\x66\x5c\x27\x97\x28\x55\x55\xae\x54\x82\x31\xce\x78\xca\x79\xd9\x98\x8c\x45\x92\x8b\x58\x14\xa3\x8c\x73\x5e\xd4\x4a\xe6\xda\x79\x51\x59\x33\x2a\x12\xb4\x83\xe4\x92\xe5\x49\x12\x69\x8d\x8b\x32\xe2\x82\xd2\xf1\xec\x56\xf2\xa8\x90\x45\x4a\x81\xa0\xa2\xb5\xcc\xd2\x8c\x61\x8c\xcb\x34\x29\x63\x96\x6b\x1b\xd3\x24\x8e\x0a\x25\xc6\xa3\xa2\x62\x19\x57\x92\x65\x40\x50\x25\xaa\x8e\x39\x43\xc7\xbc\x2a\xc9\x32\x9a\xc0\xfb\x2c\x90\xf4\x93\x38\xcf\x26\x7a\x5e\x1d\x51\x25\xe2\xc6\x76\x71\x9a\x32\x4e\x79\x8e\x31\x66\x34\xc9\x73\xda\xb4\x4c\xf0\xa2\xa8\x58\x31\xee\x3c\x96\xe5\x51\xc9\x98\x4e\x9b\x85\xac\x78\x45\x4b\x54\x63\xa6\x44\x9a\x94\xb4\x89\x63\x16\xb1\xbc\x88\xc7\xb3\x1b\x4f\x23\x99\xa6\x91\x8e\xe3\xaa\x96\x4a\x15\x59\x86\x31\x16\x51\x41\x8b\xb2\xd0\x2d\x53\x2c\x2b\x22\x39\x11\x6e\x22\x63\x25\x2d\x0b\xed\x94\x32\x2e\xb3\x38\x4f\x04\xc6\x38\x52\x95\xcc\xf3\x48\xa7\x4d\x25\x22\x26\xf3\x72\x3c\xdc\xe2\x22\x2b\xcb\x3c\xd2\x89\x3e\x4d\x13\xa1\x44\x8a\x32\x4e\x24\x57\x49\xdd\x24\xab\x2a\x29\x78\x21\xf3\x71\x53\xa4\x91\xac\x25\x57\xba\x83\xc4\x95\xaa\x0b\x8e\xe7\x8a\x54\xe6\x71\x22\x9a\x91\x26\x12\x2c\xe5\x75\x32\x1e\x15\x32\x2a\x65\x2a\x99\x0e\xa3\x9c\xd5\x34\x2f\x52\x34\x6d\xca\xb2\x4c\x29\x6f\x9c\xc7\xf2\x24\x12\x99\x1a\x9f\xbb\x65\xb4\x50\x75\x5d\x6b\xdb\x71\x91\x08\xa6\x38\x1a\x15\x79\x14\xd3\xa4\x54\xba\xe7\x55\x4a\xf2\xa2\x52\xe3\x73\xb7\x42\xd5\x59\x2e\x6a\x9d\x67\x0b\x5e\x26\x69\xc6\xd0\xc1\xb4\x4c\x52\x96\xca\xba\x19\xc2\x44\xca\x63\xc1\xc7\x9d\x57\xe6\x3c\x15\xaa\xd4\x36\x56\x39\x4f\x12\x96\xa1\x36\xae\x98\x4c\x0a\xd9\x0c\x4d\x1c\x02\x89\xd3\x74\x94\xb1\xca\xab\x3c\xad\x2a\xdd\x41\xca\x4a\x51\x55\x30\x34\x6d\xd6\x71\x5a\x45\x75\x5a\x9b\x41\x57\x55\x2c\x1d\x8f\x63\x9a\xd4\x34\x49\x9b\xf9\x42\xca\x59\x9a\xd4\x05\xda\xa5\x69\x9e\x88\xb4\x2a\x75\x07\xc9\x79\x29\x33\x99\x8f\x8f\x20\x8c\x89\x3a\x93\x34\x32\x0b\x77\x19\xad\x72\x54\x63\x56\xa4\x8c\x16\x42\x33\x66\x82\x95\x51\xca\xc6\x6d\xcc\x65\x55\xa4\x69\xad\x09\xb8\xa0\x51\x5a\x49\x34\x1f\x0b\x5e\xe6\x79\x91\xea\xa8\x88\x68\x99\xf2\x28\x1b\xef\x20\xa2\xcc\x54\xa1\xa8\x36\x05\x8b\xcb\xac\x50\x05\xea\xbc\x48\xb0\x2a\x49\x4b\xdd\xb2\xac\x64\x94\x56\xd1\x78\x1c\x47\x65\x19\x57\x51\x33\xf1\x2e\x0b\xa1\x22\x5e\xa0\x43\x13\x4c\x57\x78\x96\xe9\x64\x55\x97\x49\x9c\x2a\x9e\x8f\x32\x8e\xeb\xb2\x48\xea\x5c\x37\x51\xe4\x55\x52\xe7\x0a\xd5\x38\x29\xa3\x88\x65\x52\x33\x8e\xf2\x28\x8d\x25\x4b\xcd\x22\xea\xcb\x91\xd7\x56\xfd\x2a\xa0\x27\xbf\xa1\x1a\x3a\x06\xcd\xd4\xd7\x1b\x68\x3a\x47\x96\x48\x47\xde\x50\x85\xa7\x0c\x47\x3d\x3a\x40\x1e\x47\x74\x4f\xe2\x7e\xeb\x47\x07\x49\x4e\x33\x65\x17\xdc\x45\x51\x96\x19\x0d\x3c\x3a\x28\x8a\x24\xcd\x55\x33\xfc\xca\xa8\xcc\xf3\xb4\x3f\x75\x19\x11\x22\xca\x44\xd5\x22\xd5\x99\xac\x56\x59\x54\x4b\x5e\xe0\x42\xf2\x38\xaa\xeb\x58\xe8\x5e\x10\xd7\xac\x12\x49\xcd\x8e\x14\x12\x33\xaa\x62\xde\x24\x9f\xbc\x52\x89\xe4\x15\xc3\x85\x64\x05\x8d\x13\xd9\x04\x24\x2f\x84\x4a\x4a\x56\x1f\x29\x84\xd5\x52\x54\x59\x13\xf3\x75\x11\xb1\xa2\x4a\x02\x2d\x89\x0b\x45\xcb\xaa\x99\x06\x31\x91\x2a\xce\xd2\xec\x94\x47\x07\x37\xfd\x1e\xe9\x31\xa5\x61\x35\x1e\x0d\x57\x7e\x7d\xc8\xc2\xa5\x5f\x1f\xf2\x70\xed\xd7\x87\x22\x5c\xfc\xf5\x61\x14\xae\xfe\xfa\x30\x0e\x97\x7f\x7d\x98\x84\xeb\xbf\x3e\x4c\x03\x05\x60\x35\x21\x85\x3e\xf3\x10\xdd\x07\xde\xc0\xd7\x0d\xdc\x7f\xd9\x43\x83\x99\x26\x47\x5f\x81\x6a\xe0\xeb\x06\x1e\x20\xe7\x9a\x9c\x07\xc9\xb9\x26\xe7\xc8\xdb\x6f\x1a\x2c\x34\xb9\x08\x92\x0b\x4d\x2e\x42\xe4\x91\x26\x8f\x82\xe4\x91\x26\x8f\x42\xe4\xb1\x26\x8f\x83\xe4\xb1\x26\x8f\x43\xe4\x89\x26\x4f\x82\xe4\x89\x26\x4f\x42\xe4\xa9\x26\x4f\x83\xe4\xa9\x26\x4f\xb1\x6d\x7d\x47\x16\x3d\xd6\x02\x28\xc6\x5c\xef\xd9\x7d\x48\x87\x15\xf7\x00\x54\x68\x2a\x86\x51\x15\x9a\x8a\x61\x54\xa5\xa6\xe2\x18\x55\xa9\xa9\x38\x46\x55\x69\x2a\x81\x51\x55\x9a\x4a\x60\x54\x4a\x53\x45\x18\x95\xd2\x54\x11\x46\x55\x6b\xaa\x18\xa3\xaa\x35\x55\x8c\x51\xbd\xd6\x54\x09\x46\xf5\x5a\x53\x25\x18\xd5\x52\x53\xa5\x18\xd5\x52\x53\xa5\xeb\xf3\x13\x5f\xdd\x3d\x62\x1e\x30\xc8\x9a\x3e\x10\xf8\xfc\x00\x8c\x60\xc6\x70\xfe\x05\x4a\xfd\x8d\x1e\xc1\xed\xe3\xb3\x6b\x15\x8a\x6e\xc4\x80\x09\x7e\x58\xd9\xb7\x0d\x9c\x37\xde\x57\xe4\x4b\xc2\x5f\x6a\x4c\xbf\x56\xa8\xfd\xd3\xf0\x58\x37\x3c\xcc\xfb\x05\x43\x1e\xf0\x8a\xc5\xf5\x2a\x50\xc3\x0e\x13\x5d\x8d\x78\xa2\xf6\x32\xbd\x22\x27\x55\xa8\xbe\x5a\xb6\x75\x8e\xaf\xb0\x7e\x8d\x50\xac\x1d\x0a\x3f\x51\xf8\x04\xcb\x5e\x15\xec\xa5\x7e\xe1\x8b\x75\x55\x9c\xe9\xd5\x1a\xf6\xb0\x0b\x5b\x0e\xb8\x87\x27\x3d\x3c\xbd\xe9\xf5\x55\x7b\x6d\x69\x6a\x68\x4f\x6a\xb1\xee\x69\xb1\xf6\xb5\x58\x62\x5a\xac\x7d\x2d\x96\xae\x16\x7d\xbc\xd4\xc7\x0b\x54\x32\x76\x5d\xca\x26\x54\x67\x57\xe4\x94\xe2\xdb\x9d\x47\xd9\x71\x1e\x65\x9d\x47\xd9\x51\x1e\x65\xae\x47\x99\xf5\x68\xd6\x35\x1e\x38\xde\xbd\xdb\x95\x93\x6e\x11\xc1\xc2\x7d\x34\x30\x1b\xcf\x1c\x97\x5a\x7e\xc9\xa4\x4b\x99\xeb\x52\xcb\xab\xaf\xc6\x12\x53\x63\xed\xa9\xb1\xec\xa9\xd1\xe1\xc1\xd5\xc4\xe3\xc7\x93\x51\x9f\x9e\x54\x3b\x3c\xdc\xc4\x1f\x56\x69\xe7\xf6\x74\xcc\xed\x3f\xac\xf4\xb8\xfc\xc3\x0a\x1d\x98\x07\x98\x6b\x83\x19\xd8\x79\xdf\x61\xb2\xa4\x13\x8f\x17\x39\x77\x71\x1b\x05\x18\x3a\xb3\x18\xe2\xae\x2d\xee\xa4\x0e\x5d\xa6\x59\xc3\xc1\xd0\xab\x74\x7d\x3e\x86\xde\xa5\x32\xa8\x08\xae\xcd\x02\x35\xdb\x20\x29\x83\x27\xe9\x9c\xdc\x35\x9e\xa4\xed\x95\xff\x9f\x30\x72\x87\xd0\xf9\x28\x63\xd0\x03\xb8\x58\x0f\x4e\xab\x01\xff\x5a\xec\xa0\x16\xec\xba\x5a\x80\xf5\x8e\xd5\x01\x70\x31\x0d\xe0\x3a\x22\x1f\x67\xda\x8d\x8a\x3f\xac\x42\xee\xed\x46\xbd\x1f\x56\x98\x72\xc3\xfd\xe2\x56\xd9\x72\x69\x8a\xe2\xc3\x4b\x7c\xf5\xd2\x94\xc5\x87\x1f\xf8\x7b\x7c\x0d\x4d\xd3\xf7\xd5\x1a\x68\xd6\x86\x06\x7e\xbc\x5e\x87\x68\xde\xe4\x7f\x83\x06\xcc\x72\x60\x5d\x34\x72\xf4\xf7\xb2\xf9\x5e\x98\xef\x61\xf2\xb5\x26\x07\x29\x45\x23\x52\x7f\x2f\x9b\xef\x85\xf9\x7e\xfe\xc5\x48\x95\x7c\x7d\xe7\x33\x03\x15\xc0\xf0\xbc\x19\x57\x72\x9d\x90\x60\x40\x01\x3e\x00\x34\x15\xfb\x0d\xb0\x57\xb3\xdf\x8e\x2a\x0e\x2c\x45\x5f\x65\xee\x64\xae\x1b\x99\xeb\x9e\xcc\x65\x5f\xe6\xba\x27\x73\xd9\x97\xb9\x76\x65\x2e\x8f\x91\xa9\x6f\xd1\x66\x33\x65\x86\x86\xe6\x7d\x13\xa5\xf9\x34\x87\x54\x38\x40\xe9\x02\xed\xb0\xaa\xb4\x7e\x5c\x58\x18\x60\x66\x13\x32\x9b\x76\x1a\x64\x73\x3c\x86\x5a\xf6\x65\xae\x7b\x32\x97\x7d\x99\x6b\x57\xe6\xb2\x93\xe9\x0b\x3d\x3b\x3b\xe2\x1c\x02\x5c\xd7\xef\x74\xf5\xa5\xef\xc2\x2f\x53\x7d\xb7\x02\xe3\x7d\xb7\x0a\xa5\xd2\x86\x09\x34\xf6\xbb\x55\x28\x8d\x03\xce\x81\x01\xce\x72\xdd\xaa\x88\x75\xca\x06\x11\x04\x2e\xbb\xb6\xc0\xb7\xd9\xec\xd0\xa5\xa7\xbb\x64\xb6\x44\x72\xc5\x88\x58\x20\x86\x93\x19\xc6\x65\xc2\xbf\x80\x85\x09\x2c\xaf\x25\xf1\xbb\xd5\x31\x12\xbf\x5b\x61\x12\xbf\x5b\x5d\x47\xe2\x0f\x47\x49\xfc\x01\x95\xf8\x03\x26\xd1\x67\x76\x76\xe6\x1f\x5e\x11\x10\xa9\x17\x2f\x6c\xb7\xd7\xa7\x5c\x04\xb5\xd3\xeb\x20\x36\x2b\x69\x54\xa3\x22\x77\x55\xb4\xbc\x8e\x53\xf3\xaf\x97\x15\xbc\x99\xf4\x6e\xfc\x4e\x1f\x3e\xfa\x7e\x13\x8d\x6f\x7d\xbb\xf9\x1a\x53\x5b\xdf\xd9\xd6\x18\x8d\xbe\xb1\xad\x31\x1a\x7d\x0f\xad\x30\x1a\x7d\x0b\xad\x30\x1a\x7d\x4b\x3e\xab\xc0\x80\x07\xb6\xc6\xce\x87\x80\x8f\xbe\xa7\x9f\x55\x60\xb4\x83\xe9\x32\x26\xbd\x34\x96\xab\x3c\xa3\x85\x38\xe9\x35\x85\x12\xd3\x51\x2f\x29\x94\x98\x8e\x7a\xf5\xa2\xc0\x68\xf4\xe2\x45\x81\xd1\xe8\x75\x92\x1c\xa3\xd1\xcb\x24\xde\x69\x06\xf0\x57\x2f\xbb\xe8\x5c\x00\xe7\x8b\x04\x8d\x91\x03\xeb\x59\x63\x87\x03\x87\x7f\xdb\x11\xa7\xb1\xc6\x81\x1d\x65\x8e\x6b\x1f\x56\xe2\xac\x19\x42\x7e\x9d\xc1\x22\xe2\x57\x24\xf7\x66\x03\x66\xd9\xb1\x41\x01\x3d\x73\xf8\x67\xa6\xf1\x3b\x6d\x73\x5f\xd9\x21\x1f\x67\x95\xb1\x11\xa8\x4d\x54\xf8\x02\x59\x27\x50\x9b\xa7\x30\x02\x7b\x99\xa0\x98\x16\xe8\xac\x4b\x36\x02\x39\x08\x2c\x7d\x81\xbc\x13\xa8\x3d\x51\x1a\x81\xbd\x7e\x5d\x4e\x0b\x74\x56\x32\x1b\x81\x02\x04\x56\xbe\x40\xd1\x09\x14\x20\xab\x32\x02\xc5\x44\x77\x18\xf2\x71\xd6\x3e\x1b\x81\x11\x08\x54\xbe\x40\x53\x78\x4a\xa3\x80\x2c\x65\x04\x46\xae\x40\x35\x2d\xd0\x59\x2d\x6d\x04\xc6\x20\xb0\xf6\x05\xc6\x9d\xc0\x18\x64\xd5\x46\x60\xec\x0a\xac\xa7\x05\x3a\xeb\xab\x8d\xc0\x04\x04\xfa\x73\xd6\x87\x49\x27\x30\x01\x59\xaf\x8d\xc0\x6e\xca\x0e\xb7\x32\xd3\x02\x9d\x15\xd9\x46\x60\x0a\x02\x97\xbe\xc0\xb4\x13\xa8\x6f\x9b\xcc\x98\xfc\x30\x1d\x9f\x04\x8c\x3d\x40\x39\xea\xdd\x8b\x8f\x57\xfd\xe1\x93\x94\x7f\xb8\xb9\x42\x0e\x37\x70\x28\x0e\x83\xc9\xbd\x39\xd9\x0c\x98\xe9\x3a\x2c\x82\xde\xf4\xb1\x38\xb8\x18\xf6\x2f\x79\x30\xce\x83\xed\xe6\x17\xb5\x3b\xe8\x17\x2a\xe0\x3d\x17\xc1\x6f\x15\xab\x03\x79\xb7\xdd\x55\x24\x87\x47\xb5\xa4\x50\xf5\x76\xa7\xcc\x76\x6a\xcf\x6b\xce\xbb\x26\xce\xb3\xbb\xc3\xf6\x47\xc1\x6f\xe2\x20\x9e\xdf\xeb\x11\x3c\xae\x9e\x6d\x7d\x90\x3b\x84\x51\x1e\x9d\x09\xfe\xf9\xed\xa6\x89\xb7\x9b\x62\xc6\x4f\x7d\xbb\x09\x48\x26\xde\x6e\xea\x6d\x6b\xf0\xde\x6e\x8a\x19\xff\xfc\x76\xd3\x4d\xbf\xdd\x04\x5e\x39\xee\xed\x26\xd4\x39\xbd\xb7\x9b\x1a\x07\x8d\xbe\xdd\xd4\xbc\x47\x7b\xe4\xdb\xdf\xe2\x77\xfd\x3e\x93\xda\x94\xb7\x8a\x7c\xaf\x92\x68\x00\x78\x53\xc5\x43\xd4\x5f\x2e\x7f\xae\xea\xc1\xc5\x72\x75\xb9\x54\xbb\x4f\xf2\x4a\x94\xa3\xaa\xfe\x0d\x1a\x2e\x48\xa7\xd8\x82\x0c\xf4\xf9\x77\x78\x75\xaa\xed\x6e\xed\xdb\x53\xed\x15\x07\x4b\x57\x2d\x7f\xb0\xba\x74\xdf\xb2\x72\xae\x4d\xbf\x0a\x05\x67\x51\xa8\xdd\x1b\x3d\x8a\x3e\x58\x6e\x57\xa5\x22\x6c\x78\x6c\x0a\xa8\xf3\xf4\x01\xeb\xbf\xbb\x14\xa7\x0b\x12\x65\x0b\x12\xb1\x05\x11\x02\x4a\xf6\x2f\x08\x4b\x17\x24\x5b\x10\xc2\x9c\xad\x46\xb1\x5c\x90\x98\x2e\x48\xc4\x17\x44\x40\x69\xff\x64\x41\x98\x5c\x10\x46\x17\x84\xbb\x78\x19\x14\xfb\x5f\x90\x48\x2c\x08\xec\x56\xe4\xe9\x82\xc0\xde\x22\x06\xfc\x1d\xbc\x84\x2e\x08\xbc\xf4\x10\x45\x0b\x22\x92\x05\x49\xc4\x82\xc4\xf1\x82\xc0\xeb\x02\x22\x73\x10\x05\x5b\x10\x2e\x16\x84\xc5\x0b\x92\x2e\x08\x49\xf8\x82\xc4\xd1\x82\x44\xfa\x68\x01\x17\x11\x34\xe1\x0b\xc2\xa2\x05\x49\x00\x91\x2d\x48\x2c\x16\x24\x8a\x17\x44\xa4\x0e\x22\xcf\x16\x84\xb3\x05\x81\xed\xf8\xf1\x82\x10\x2e\x17\x84\xd3\x05\x61\xa0\x8e\xbf\xdd\x6f\x68\x57\x8e\xdb\x95\xf7\xed\x0a\x5a\x80\x1d\xa1\xdd\xb0\x75\x9c\x2d\x08\x89\x5d\x6d\x8d\x60\x68\x16\x68\xab\x15\xa2\xae\x96\xc2\x18\x0e\xb4\x02\x84\x64\x41\xa4\x03\x87\x5d\xe0\x60\x0f\x30\xb0\xd6\x5e\xf4\x1d\x01\x0e\x05\x03\x83\xfd\x04\x38\x39\xd5\x06\xee\x10\x04\x78\x93\x1a\x6f\x81\x8d\xc0\x56\xae\x04\x70\x0d\x84\x86\x00\x97\x82\xb5\xe1\x1c\x07\xd7\x87\xe0\x02\x88\x07\x88\x0b\xf0\x21\x18\x56\x70\xdf\x82\x0f\xde\xbe\x79\xbb\xce\xf5\x31\x29\x30\xa9\xdc\x2f\x57\xf5\x01\x33\xe2\x9f\xff\x72\xf1\xd3\xf3\x87\x7f\xf9\xb6\x39\x53\x0a\x2c\xc6\x17\x44\x37\x1e\x2c\x24\x21\x22\x8d\x9b\xb4\x75\x4d\xa4\x32\xe3\x4e\x6e\xa2\x57\x1b\x44\xba\xf2\x9f\xff\xf9\xc9\x8f\x6a\x4f\xf2\x4d\x65\x6a\xa3\xc3\x59\x15\xe6\x6c\x6d\x4c\x0f\xc0\xff\xe9\x69\xdf\x9f\x83\x29\x25\xbd\xa2\x77\xe0\xd5\x0c\x2a\x39\x75\xbd\x06\x9f\xee\x5e\xa1\x41\x41\x10\x78\x0f\x41\x52\xea\x7a\x0e\x3e\xf4\x4a\x38\x28\x3e\x34\x72\xa1\x88\x80\xd8\x81\x6b\x25\x7d\x16\x49\x0f\x85\x62\x28\x69\x1f\x05\x6b\x89\x74\x5a\x82\xb1\xc8\xfa\x2c\x30\x5d\x73\x17\x05\x43\x28\x7a\x3c\x50\x83\x96\x3d\x14\x44\x8f\xaa\x8f\x80\x59\x5c\x39\x28\xbe\x84\xda\x65\x80\xb5\x54\x8e\x51\x33\x39\xe9\x0f\x2e\x27\x04\x88\x1e\x0f\xc4\x08\x51\x0f\x01\x35\x65\xec\xa0\x60\xf0\x44\x4e\x06\x66\xea\xa0\xf8\x50\x29\x27\xfd\x9d\xb9\x28\x18\x42\x2e\x27\xfd\x5d\xc8\xc9\xe0\x2d\xe5\xa4\xc7\xab\x01\x17\x0f\x41\x39\x08\x98\x8c\x5a\x4e\x76\x10\x66\x61\x3e\x44\x13\x50\x86\x1b\xd2\xa4\x08\x36\xe2\x4c\xd1\x43\x41\xa5\x47\x3d\x14\xb4\x8d\x26\x55\xb0\xa0\x3b\x4c\xa2\x60\xa1\x98\x49\x7b\x32\x50\x5f\xc8\x1e\x8a\x1c\x49\x14\x16\x85\x86\x13\x05\x1b\xf1\x68\xd1\x6f\x0c\x0f\x25\x0a\xd3\x98\x60\x9e\xb0\x32\xb0\xf6\xaa\x01\x4a\x28\x55\x30\x3c\x15\xc8\x49\x53\xb0\x3e\x0a\x66\x0a\xde\x43\x41\x5d\x2f\xa4\xdb\xd2\x60\xb6\x68\x59\x84\x72\x45\x27\x83\x87\xd2\x45\x20\x84\x53\x39\xe1\x0c\xd9\x97\xc0\x43\xa9\xa2\x45\xa0\x3c\x94\x2c\x82\x0e\x2d\x7a\x2c\xd0\x00\x2f\x7b\x28\xa8\xcf\x2b\x39\xe6\x52\xd5\x6f\x28\x0d\xe5\x09\xab\x04\xa5\xbc\xb7\x48\x34\x31\xcb\x88\x28\x95\x11\x65\xc1\x0c\x62\x70\x68\x28\x87\x98\x76\x85\x33\x88\x15\x42\x29\x0b\xa6\x10\x2b\x04\xc5\x89\x7b\x38\x32\xa2\xe1\x34\x62\xf9\x44\x34\x9c\x4a\x00\x87\xd1\x50\x16\x01\x28\x2e\x22\x1b\x8a\x60\xc1\x24\x62\x71\x28\x0d\x4f\x38\x8c\x20\x46\x43\x59\xc4\x18\x96\xd1\x50\x1a\x09\x84\x4b\x07\x44\xb9\xd7\x3d\x15\x71\xcf\xc9\x69\x63\x32\x39\xd1\x0a\x2e\xc7\xac\x2d\x86\x22\x58\x30\x87\x18\x1c\x5c\x4a\xdc\xc3\xc1\x0d\x9e\x48\xd7\x9e\x94\x86\x52\x89\x15\x84\xda\x5c\xf6\x98\x30\x1a\xca\x26\xb8\x53\xf2\x9e\x04\x1a\xca\x23\x9d\x06\xe1\x44\x62\x79\xa0\x4a\x54\x3d\xaf\xd0\x60\x32\xb1\x82\x22\x1a\xce\x27\xc1\x08\x62\x74\x2c\xfe\x18\xa3\xd3\xd6\x64\x9c\x4e\xfb\x9f\x09\x3a\xad\x2c\x8b\xfa\x48\xa8\xe9\x98\x93\x42\x30\x41\x09\x9d\x08\x66\xe6\xe4\x0e\x5c\x0d\x27\x7d\xe0\x1c\x32\x3a\x11\x85\x2c\xa7\x13\x21\xc6\x0a\x3a\x1d\x02\xcc\x4d\x1f\xb8\xae\x15\x3d\xa2\x7f\xab\x3e\x12\xae\xf2\x20\x99\xe0\x2d\xa7\x72\xca\xba\x4c\x4e\xd9\x86\xcb\x23\xbc\x3c\x48\x2a\x3e\x42\x24\x8f\x68\x78\x3f\xa5\x04\x34\x76\x72\x0a\x06\x4e\x8f\x48\x4b\x4c\xca\x23\xc2\x3b\x93\x47\xb8\x7c\x90\x5e\x70\x95\x0b\x39\x15\x5e\x4e\x86\x41\xa0\x4e\x6e\xc1\x05\x0c\x72\x0b\x2e\xc3\x49\x2e\xba\xc5\xa7\x4c\x55\x18\x8d\x7c\x86\x2d\x3b\x5f\x18\x6f\x61\x20\x89\x21\xe6\x15\x2d\x06\xa3\x18\xf7\xa8\xe5\x0e\x59\xcd\x87\xc7\x2d\xbc\xc1\xf4\x31\x12\x07\x03\x97\x91\xb6\x32\x00\x03\x0b\x82\x9e\x5d\x51\x8c\xcc\xc1\x60\x68\x4b\xf3\x5e\x4b\x7d\x78\xd1\xc2\x23\x8a\xb7\xb5\xec\xb5\x15\x6b\x49\xd5\xb6\x04\x6f\x87\xea\xda\xe1\xc1\xea\x16\x86\x69\x27\xa7\x9a\xc7\xe4\x94\xfa\xbc\xc5\xf0\xa9\x85\x9c\x0a\x94\xa8\x87\x81\xf1\x8f\xe5\x78\xe3\x93\x1e\xdc\xa7\x4f\x1d\x7a\x1f\x2a\x5b\xa8\x0f\xcb\x5a\x18\xa6\x79\xee\x68\x8e\x6b\x56\x38\x9a\x61\xb2\x4b\x39\x1e\x3a\x95\x9c\x0a\x0c\x25\xa7\x3a\x41\xdd\xe3\x41\x69\x70\xd6\xd1\x31\x41\x50\x18\x1d\xb7\x31\xe3\x3d\x04\x84\x83\xa0\x53\x91\xc0\x22\x97\x87\x0f\x8e\xe9\x54\x5b\x59\xd2\x13\x82\x2a\xda\x25\x05\x44\x44\x3f\x1f\xa0\x22\x32\x3a\x69\xcf\x2e\x23\x20\xf4\x05\x1d\xf7\x39\x2b\x7b\xcd\x44\x05\xb8\xe9\x00\x11\xd1\x65\x83\x80\x99\x6a\x3a\xd5\xa7\xcd\xd4\x62\xb4\x99\x4c\x8e\x6a\xc1\xe5\x64\x54\x75\xd9\x01\x01\x46\x72\x22\xe4\x62\x39\x19\x51\xbd\xd4\xe0\x83\x53\x39\x69\x07\x29\x27\xe2\x3a\x93\x93\x51\x99\xcb\x09\x7f\x14\x72\x32\x6c\x4b\x39\x19\x99\x95\x9c\x0c\x1c\x27\x5d\xf8\xc0\x2e\x53\x80\x9a\x27\xcd\x1f\xa4\xbe\xcf\xc0\x24\x5a\x96\xc0\x9b\xd1\xc8\xc7\xe0\x16\x23\xc2\xe1\xc2\xc2\x41\x06\xda\xaa\xc8\x62\xf8\xa0\xd8\x82\x02\xea\x25\xae\x7a\x14\x53\x2f\xed\xd4\x43\xe1\xad\xcd\xcc\x6d\x8a\x8f\x91\x59\x8c\x80\xfa\xb9\x55\x1f\xe1\x5e\xb8\xdc\xd1\x16\x94\x5d\x0b\x02\x1a\x56\x56\x43\xd4\xbc\xca\x9a\x17\xe5\x5e\xbb\xdc\x51\x7a\x39\xe5\x5f\x66\x31\x10\x18\x97\x53\xca\x0b\x39\x65\x80\x48\x8e\xbb\x38\x96\x53\x4d\x48\x2c\x86\x0f\x4a\xe5\x54\xf0\x49\x8b\x81\x0a\xcf\xfa\xc2\x11\x8c\x5c\x8e\x47\x47\x61\xe1\xa8\xea\xa5\x9c\x8a\xbe\xca\x62\x04\x0c\xac\xac\x81\x03\xc6\xa9\xe5\x54\x0f\xe9\xa6\x06\x01\x11\x8c\xd1\x71\x17\x31\x4e\x27\x85\xb4\x69\x00\x81\x45\x74\xcc\x46\x2c\xa6\x53\x51\xc6\x12\x3a\xe5\x27\x96\xd2\xa3\x22\xdd\xe1\x82\xa1\x64\x74\xca\x61\x2c\xa7\x53\x31\xc7\x0a\x3a\xee\x34\x56\xd2\xa9\x6e\xc3\x2a\x3a\x1e\x78\xac\xcd\x0c\x98\x80\x36\x31\xe0\xcc\xa9\x9c\x6c\x03\x93\x93\xc6\x6a\xd3\x03\x02\x13\x72\x22\xa6\x9c\xbc\x80\xfb\x3c\x96\x93\xae\x48\xe4\xa4\xcf\xdb\x0c\x81\x01\xa5\x9c\x8c\xeb\x4c\x8e\x1a\x32\x97\x93\x8e\x2c\xe4\x64\x78\xb7\x69\x02\x6f\x43\x25\x27\xa3\xdf\x49\x13\xb8\xa9\xda\x3c\xd1\x84\xe4\x69\x93\x07\x9f\x9d\x61\x06\xac\x30\x71\xbc\x15\x86\x41\x85\x81\x9a\xa5\x50\x1f\x21\xea\x10\x60\xad\xd6\x47\x88\x0d\x02\x44\x37\x06\x4f\x0c\x9c\x87\x10\x52\x83\x80\xb3\xb7\xb6\x32\x77\x5e\x1e\x3c\x33\xf0\x46\x3f\x04\x21\xef\x10\x70\xf9\x85\x91\xcf\x19\xc5\x55\x28\x8d\x0a\x3c\x64\xe2\xca\x98\xd8\x87\x28\x03\x09\xb1\xae\x3b\xd6\x78\xf3\xe4\x38\x3d\x93\x13\xc6\xe5\x06\x01\x01\x09\x39\x1e\x38\x91\x81\xe3\xd0\x58\x4e\xc4\x45\x22\x27\xac\x96\x76\x1c\x02\x6d\xef\x10\x70\x0e\x99\xe1\xe0\x43\x72\x39\xe1\xd3\xc2\x20\x84\xec\x56\xca\x89\xa8\xa9\xe4\x84\xe7\x94\x41\x08\xc1\x6b\x03\xc7\xf5\x73\xe6\x01\x18\x31\xb3\xbd\x1e\x23\xe5\x74\xc2\xb0\x4c\xd0\x89\xb0\x61\x11\x1d\xb7\x0f\x8b\xe9\x84\x7b\x59\x42\x27\x22\x84\xa5\x74\xdc\x44\x4c\x4e\x75\x4d\xd6\x76\xff\x20\x0f\xdb\xff\x83\x7a\x16\x74\x22\xcc\x98\x4d\x00\x01\x1d\x2a\x3a\x11\x2b\xcc\xa6\x01\x04\x64\x33\x40\xb0\x85\x54\x4e\xf9\x8a\xc9\x09\x33\x72\x39\x61\x02\x21\xa7\x5c\x15\xc9\xa9\x98\x8a\xe5\x44\x43\x6c\x42\xc0\x60\x6d\x2e\x08\xaa\x28\x8d\x8a\x01\xe6\x99\x9c\x8a\x14\x9b\x12\x10\x50\x31\xd5\x97\x59\x29\x27\xba\x83\x4d\x07\x01\xed\x95\x9c\x8a\xb1\x5a\xf6\x83\xe8\x94\x59\x81\x51\x5b\xfa\x5c\x3b\x9e\x1e\x8c\xdb\x16\x71\xbc\xcd\xa2\x83\x73\x8c\x77\x64\xe1\x94\xa2\xfc\x63\xb7\x3d\x1e\x34\x71\xa0\x3e\xef\xb4\xe3\x8d\x52\x1b\x5b\xf9\x80\xac\xa7\x94\xcf\x38\xb7\x62\x51\x95\x0b\x13\xa0\x1e\xa0\xb4\x6c\x03\xfa\x56\x0e\x5b\x1f\xaa\x2c\x14\xa1\xac\x3b\xce\xa8\x4a\xd2\x15\xec\x83\x59\x07\x47\xd9\x73\x19\x68\x91\x90\x56\x27\x8c\x6d\x64\xa1\x28\xd3\x58\x8e\x47\x5d\xd2\xc1\xd1\x36\xa5\xad\x6c\x9f\x56\xb6\x30\x0c\x9a\x75\x50\x0f\x96\xf7\xa4\xfa\xb4\x85\x1c\xf7\x61\x29\x03\x31\x55\x75\x84\xa8\x60\x65\x95\x42\xa1\xb5\x1c\x0f\x65\x46\x27\xfa\x11\x0c\xf5\xa3\x8a\x33\xa7\x27\xa3\x2d\x67\xa6\x2b\xe3\xc0\x88\x8e\x45\x02\x8b\xe9\x84\xfe\x49\x0f\x01\x11\x90\xd2\x31\xfb\xb4\x33\xd8\x80\xee\xa6\x47\x23\x84\xa6\x2f\x23\x34\x45\xcf\x62\x08\xa9\xe9\xcf\xb8\x42\x55\xcf\x21\x08\x7b\xd5\xb3\x37\xc2\xc1\x74\xea\x90\xbf\x68\xa8\x53\x32\x26\x47\x4d\xc5\xe5\xa8\xab\x84\x9c\xf0\x44\x24\xdd\x96\x21\x1c\x4c\xcf\xc6\x9b\x9d\xc8\x89\x30\x4b\xe5\x84\xd9\x65\x8f\x03\x82\x90\xf5\x38\x20\x22\x4c\x27\xc7\xa9\x4d\x0f\x0f\xa9\x57\xca\x51\xaf\x98\x6e\x8e\xf0\x55\x3d\xbb\x22\x08\x4e\x1f\x07\xd1\xa7\x0f\xdd\x3e\xcb\x56\xd5\x88\x32\x3f\xab\xdb\xa9\x86\x07\x11\x96\x29\x46\x16\xb5\x93\x1c\x0c\x1a\x07\x9a\x9f\xe8\xeb\x3e\x7e\xea\x4c\x99\x7c\x68\x67\x6b\x4c\xcd\xac\x6b\xbb\x4f\x9a\xb7\xa4\x18\xb4\x70\x1a\xe1\x33\x2e\x1d\xa5\x7c\x68\x65\xec\xe6\x73\x55\x9d\xdd\x7c\xb2\xda\x51\xc8\x87\xca\x4e\xa2\xcf\x97\x49\xdc\xa8\x5c\x5a\x96\x98\x36\x42\x8e\xb5\x31\x92\xad\xf1\x7c\x60\x2c\xc7\x8c\x97\x38\x50\x9f\x36\x95\x21\xf3\x48\x2b\x12\x03\x66\x2d\x53\x4c\xdb\xdc\xb1\x8f\x0f\x2d\xba\xb6\xf8\x8c\x4b\xa3\x8f\x4f\x56\x39\x06\xf2\xe9\x94\xc4\x23\xb6\xee\x5a\xe1\xb3\x64\x74\xd4\xcb\x8c\x75\x21\x8b\x40\x79\x4b\x8c\x29\xc4\x84\x13\x96\x08\x38\xea\x78\x23\xd0\xd8\xe1\x8d\x88\x4e\x5a\xde\x28\x38\xb5\xbc\x51\xc5\x64\xb0\x47\xb0\xcc\xe1\x8b\x80\xf3\x8e\x2f\x22\xb5\x08\x24\x0d\x56\x86\x92\x6c\xd5\x8a\x43\x0d\xac\x5a\x03\xa3\x36\xaa\x4d\x3b\x10\x4a\x3a\xea\x75\xe6\x04\x2f\xc2\x97\xcb\x51\xc7\x89\x40\xa4\xb1\xa8\x65\x8b\xb6\x26\xb6\x3a\xa1\xd0\x24\xd8\x0f\x59\xea\x04\x3e\x42\x29\x1d\xb1\x08\x75\x26\x47\x6d\x9c\x07\x92\x15\x2b\x1c\xa9\x08\xdb\xb2\xb3\x30\x02\xad\x82\xbd\x98\xa9\x56\x5d\x14\xdc\xf6\x57\x4a\xd9\x29\x63\x2a\xac\x1a\x49\x8e\x30\x6c\x8d\xe3\x81\xb8\xa5\xc3\x80\x02\x80\x08\xbf\xc8\xf0\x43\x40\x31\x80\x24\x9c\x71\x83\x00\x93\x16\x88\x08\x4b\x8d\x26\x1e\x40\x36\x00\x5c\xff\xcc\xea\x8f\x51\xe6\x00\xc4\xa5\x15\x2d\x53\x44\xcf\xd2\xbc\xec\xe1\x01\x2a\x3c\x4e\x94\x55\x82\x22\x34\xb5\x51\x02\x01\x19\xbb\x5b\xdf\x85\xe0\x6c\xcc\xa6\x06\xa7\xf5\xe3\x08\x8e\x18\xf3\xb5\xb9\x1e\xb5\x76\x09\xe3\xc4\x61\xb3\x1a\x8c\xc4\x60\x84\x75\x49\x03\xa1\x65\xc0\x72\xcc\xa2\x06\x27\x0b\x79\xc9\xc0\x8d\xf7\x47\x38\xb4\x41\x30\xd2\x94\x12\x77\xb9\x81\x56\xad\xb9\xc2\x52\xda\xe8\x18\x91\x62\x82\x64\x6c\x46\x8c\xb5\x94\x75\xa1\x81\x51\xf2\x86\xd2\x07\xd8\x48\xf0\x21\xd1\x98\xb2\x2c\x1e\x73\x0b\x4b\xc6\x8c\xc1\xd2\x91\x26\x86\xf2\x6f\x66\x68\x30\x7e\x79\x2b\x0d\xe3\x58\x8c\xf5\x07\x56\x5a\x5a\x14\x5a\x19\x83\x63\x7c\x55\x28\xe8\x58\xdd\xda\x06\xa1\x93\x66\xf3\x78\xd8\x06\x06\xc3\x38\x34\x08\xe7\x63\x36\x36\x38\x26\x6d\xcb\x30\x86\x49\xe0\x21\x70\x9b\xc4\x47\x14\x4d\x8e\x68\x6e\x3a\xe6\x04\x83\xd3\x66\xf7\x11\x9c\x36\xc9\x8f\x34\x3a\x1f\x0b\x5c\x83\x53\x8c\xf5\x65\x56\x86\xdd\x6e\x30\xaa\xf1\x8c\xc3\xd4\x54\xc6\xb1\x41\xa2\xa5\x78\x07\x3d\xb9\x75\x0d\x1e\xe7\xfb\x9f\xf7\xe4\xb0\xcc\x0f\x64\xaf\xd6\x50\x7d\x08\xea\x11\x41\xf5\x02\xb2\xda\x5c\xbe\x3d\x0c\x2a\x1a\x3c\xbe\xff\x7c\x70\x70\xb1\xdd\xd4\xdb\x9c\x8e\x6b\x37\xfe\xc3\x77\x6a\x7e\xe8\xef\xe6\x87\xa3\x2f\x5c\x6c\xae\x36\x08\xcd\x0f\xfb\x1d\x7e\x2c\x9c\xf6\x0c\x35\x77\xaa\x2a\x7d\xfd\xcd\xf3\xa6\x30\x16\x69\x0a\xd1\x8c\x9f\x51\x05\xd8\xed\x01\x55\xcd\x0f\xa7\x4a\xca\x75\x8f\xa8\x1a\x2f\xad\xf7\xb3\x7a\xdf\x96\x00\xfb\x59\xbd\x3f\x47\x31\x6c\x5d\x3d\x38\x58\x1e\x2d\xab\x07\x32\x1a\x17\xc5\x09\x29\x56\x87\x3d\xc9\xcb\x72\xbb\x83\xf2\x77\x50\xe9\xe9\xe9\x03\x86\xf2\x85\x32\x71\xee\x99\x62\x63\x67\x87\xc4\x49\xf8\xec\x90\x8e\xdd\xd3\x2d\xe8\xf9\xf4\x01\x83\xea\xfb\xb7\x08\x1b\x30\x86\xbf\x46\x2e\x20\xdc\x23\x33\xdb\xba\x17\x1d\xbd\x29\xc7\x07\xff\xcd\x04\x23\xb7\x1c\xd6\xba\x0e\xdf\x9c\xfc\x89\xb0\xe9\x82\xa5\xf7\xf7\x7b\xf5\xa6\x58\x2b\xc2\x12\xb2\x7f\x5b\xfc\xac\xde\x23\xe6\xdf\xbf\x2d\xbe\x53\xef\xf7\xad\x0b\xba\xdf\x61\xa3\x6c\x9e\x6b\xa4\xc6\x34\xf6\x07\x9c\x6b\xd2\xfe\x0a\x1f\xb1\xf2\x40\x97\x03\x33\xfa\xf8\x18\x9d\x4a\x50\x14\x58\xf3\xda\xbf\x30\x4c\x5f\x1a\xa5\x50\xbe\x36\xc2\x70\x96\xc5\xea\xf0\x5c\x57\x45\xb9\xe7\x14\x41\x69\xf9\x86\x58\x36\x01\x15\x49\x34\xa0\xb8\x4f\x83\x45\x0d\x8f\xc2\x51\xd3\x97\x53\xef\xb6\x6f\x74\x82\x59\x43\xc1\x45\x2e\xc1\xeb\x5a\x32\x4e\xd8\x58\xe9\xc5\x6c\x45\xce\x9a\xb3\x21\xa8\x2e\xe0\x68\x83\x6b\x36\x7b\xfa\x80\x9b\x18\x84\xf2\x8b\xd6\x02\x73\xf2\x47\xc2\xe5\x4b\xa8\x97\xd8\xc4\xd6\x8a\xfc\x51\x9f\x71\x71\xb4\x7a\xbb\xd5\xeb\xe5\xf1\xfa\x45\xba\x7c\x67\xa7\xe4\xbc\xa7\x25\x97\x1a\xdc\xe8\x4a\xbe\x22\x3c\x0a\x28\x3c\x47\x34\xf6\xc4\x0e\xfb\x80\x6d\xc0\x6a\x53\x2a\xa2\xf2\x72\x69\xc2\x8e\xac\xf6\x04\x0e\xa8\x5f\xa9\x0a\x92\x43\xbe\x81\xaa\x60\xf9\xa6\x52\x95\xad\xcb\xa8\xd3\xfb\x02\xe5\x06\x1e\x32\x6c\xca\x7c\x43\x0a\x45\x8a\xdd\xf6\x67\xb5\x21\xab\xcd\x61\x4b\x64\x53\x14\x78\x4f\xf6\x65\xbe\x6e\xd8\x37\x2c\xf7\x38\xb7\x77\xcb\x55\xb9\x24\xf9\x7a\xbd\x7d\x07\x23\x8d\x02\xcb\x00\x51\xa1\xc8\xdb\xbd\xaa\xc8\xbb\xd5\x61\xb9\x7d\x7b\x68\x14\xdc\xaf\xb6\x9b\x2f\xfe\x10\x30\xb4\x2e\xaf\x39\xeb\x7e\x40\x45\x79\x08\x0b\xe7\x12\x24\x14\xc1\xe6\xe7\x13\x91\xcb\x9a\xc8\x4d\xc7\x03\xd7\xb0\xd5\x49\xac\xfb\x0e\x22\x66\x33\x7d\x56\xc7\x1c\x6a\x69\x42\x45\x4e\xdc\x55\x7f\x08\x30\x4c\xdd\x76\xa4\x2f\x4d\x61\xcf\xae\x1d\x69\x23\x84\xa7\xf3\xe9\x0c\xf8\x60\xfb\xe6\xf2\xed\x41\x91\x15\x14\xdf\xdc\xab\x70\x16\x5c\x6d\x7e\x79\x3e\x48\x84\xbd\x4b\x47\x0d\x10\x6c\x64\x80\xe8\xb8\xb9\x16\xdb\xbf\x60\x31\x04\xf4\x90\xfb\x87\x40\x89\x4b\xb5\x29\xa1\x32\xda\x09\x47\x01\x9a\x8a\xb5\xdb\x07\x2d\x5d\x87\xbc\xe8\xa7\xfc\xf9\x79\x40\x6a\xa5\x3e\x86\xd4\xce\x22\x41\xc1\x3d\x0e\xa8\xe4\x85\x35\xa4\xa7\x02\x54\xb0\x53\xa6\x23\xf7\x21\x8d\xf8\xb5\x66\xea\x1c\x8d\x35\xf4\x41\x83\xb6\x1b\xa2\x41\xdd\xd9\x97\xc3\x6c\xa9\xab\x83\xaf\x0e\xab\x7c\xed\x96\xbe\xea\xe3\xa8\xab\x72\x99\x6f\x5e\xab\x47\xcf\xba\xb2\xa8\x4d\xe5\x31\x7a\x45\xeb\xe6\x33\x3f\x3f\x86\x06\x2a\x93\x35\x13\xc1\xba\xae\x83\x34\xcf\x1e\xb9\x34\x5c\xcb\x11\xe6\xcf\x71\x34\x52\xd3\x80\x14\x4a\xc3\x72\x06\xba\x69\x9a\xd8\xfc\x99\x9f\x7a\x54\x5b\x53\x3e\x4c\xf7\xa8\xe6\x6b\x33\xab\xd0\xdf\x03\x3d\x2b\x3c\xbb\x0c\xce\x27\x34\xbb\x97\xe7\x38\x76\x1b\x18\x6e\x9c\x04\x70\xdb\xe8\x70\x83\xe5\xfc\x0b\x54\xc9\x6f\xd5\x6a\x7f\x50\xeb\x36\x8a\x71\x8e\xb5\x9e\x39\xf8\x30\x2c\xdf\xc8\xf1\x04\x5d\xc3\x40\xab\x6f\x4c\x9e\xbe\x58\xbd\x7c\x31\x9b\x19\x6d\x5f\x19\x3b\xbc\x58\xbd\x84\x89\x64\x7b\xeb\xa2\x7f\x43\x6a\xa5\x2f\x8f\x4b\xd8\x83\x8e\x64\x5b\x1f\xc0\x6b\x6d\x65\x08\x5e\x91\x7a\x3a\x81\xff\x75\x53\x6d\xc9\xfe\x5d\x7e\xd9\x4c\x3f\xd6\xf9\xfe\xd0\x94\x98\xeb\x63\x82\xe5\x0e\xe3\x2e\x1b\x28\xdb\x77\xd8\x1f\x46\xd4\x3d\x20\x31\xac\x2b\x8a\x9f\xda\xd5\xbd\xae\x71\x63\x5d\xf0\x3a\x5d\xfd\x3a\x29\x65\x22\x75\xf5\x89\xa0\x5f\xaa\x03\xd9\xbe\x3d\x78\x19\xb8\x4d\xb9\xe3\x2e\xeb\xa5\xdc\xb0\xcf\x7a\x43\xc6\xcf\xea\x7d\x53\x02\x3a\x81\x02\xd0\x2e\x64\xf5\x4b\x00\xe0\xd4\x8d\x4e\xd0\xaa\xd1\x67\xe4\x39\x44\xa0\xb9\x09\xd8\x6d\xf7\xfb\x6e\x9a\xae\x6b\x1e\xea\x09\xb1\xbe\x2d\x6d\xc4\xb5\x03\x55\x67\xb8\x99\x1d\xaf\xde\xe4\xfb\x9f\x7b\x5d\xd6\xc6\xee\x6c\xd6\x0b\x51\xe8\x88\x76\x74\x7d\xd5\x6b\x3a\x74\x5a\xe0\xe2\x98\xc0\x05\x93\x57\x3a\x66\xff\x80\x06\x3e\xc0\xc8\xdd\xbb\x86\xb3\xc1\xfa\xf0\x45\x40\xed\x67\x8f\x8e\x57\x7b\x17\x56\x7b\x3d\xae\xb6\xa3\xda\x58\x93\x82\x6a\x8f\x16\x91\xde\xdb\x2a\xd2\xcd\xf2\xc7\x71\x75\xa4\xa7\x8a\x30\x37\xbc\x0e\xea\xea\xe0\x96\x62\xfe\xfa\x9b\xe7\xb7\xcd\x04\xad\x57\x8b\x79\x41\xca\xfa\xf5\xfc\xdc\xe3\x72\xb9\xce\x41\x89\xab\x03\x19\x72\x31\x13\xae\x59\x27\x07\x63\x64\xd6\x6d\x1e\x20\x0b\x35\xfd\xa2\xdb\x5f\x7f\xf3\x7c\x7e\x8e\x18\xeb\x62\xb7\xba\x5c\xab\x5b\xa7\x2d\x11\x35\x44\xbd\x85\x22\xf7\xd2\xef\x67\xb9\xc8\x2c\x44\x80\xda\x2b\x5d\xa1\xb4\x54\x7b\x74\x16\xab\xf6\x50\xd2\x17\xbc\xdb\x58\xf5\x9b\xc6\xc7\xdb\xdd\xac\xad\x34\x6c\x20\xed\x5a\xce\xed\xfd\x7a\x55\xaa\x19\x14\xcc\x9d\x7b\x67\x61\xb4\x6c\xf9\x35\xd9\x42\xc1\xda\x11\xb6\xe2\x9a\x6c\xa1\xac\xef\x7c\x1e\x9c\x8c\x5f\xfb\xde\x43\xed\x99\xed\x17\x83\x5b\x81\x60\x13\xf8\xed\x4a\x9d\x44\x20\x8e\x90\xd0\x6b\x4d\xa5\xae\xdd\x1a\x71\xaa\x72\xfc\xd4\xe6\xb3\x23\x24\xe0\xa3\x1e\xcb\xf8\x8d\x0d\x7b\xff\x22\x69\xb5\xcd\x2e\x37\x90\x5c\x3b\x5e\x27\xa6\xd8\x50\x8a\xeb\x27\xda\x16\x6b\xb4\x7e\x7e\x8b\x35\x28\xa1\xef\x14\x66\xbf\x13\xf3\xc5\xa0\xaa\xbe\x53\xdc\xfd\x4e\x1c\x2d\xba\xaa\xee\x77\xe2\x64\x61\x8a\xbd\xdf\x49\xd8\x87\x97\x0b\x19\xfd\xaa\x82\xfb\x9f\xb2\xd2\xfe\x47\xab\x87\xff\xdb\x54\xb6\xd7\x27\x15\xac\x36\xaa\x6a\x93\xc7\x8d\x94\xb8\xff\x73\xbe\x57\x5d\xd5\xfa\x7c\x6f\x4f\x40\x01\x56\x3f\x0a\xde\xe6\xf5\x16\xa7\xbd\x82\xf5\xe5\xab\x24\x22\x9b\xfc\x8d\xda\x5f\xba\xbd\xd4\x19\xe1\x1f\xfc\x04\x28\x50\x00\xbf\xf9\xff\xef\x1f\x30\x36\xf7\x49\x12\xb5\x47\xd8\x60\x6c\x7e\x4c\x22\xd0\x43\x2b\x75\x95\x44\xb7\xcd\x0f\x68\x0c\x32\x33\xe8\x58\xc3\xe7\x4b\xbb\x9c\xb2\xfa\x5f\xa8\x2b\x4e\x36\xea\xdd\xfa\x3d\x69\xfa\x5a\x85\x09\x76\x13\x4a\xff\x34\x8f\xcd\xdb\x37\x85\xda\x7d\x20\xfa\x54\x29\x38\x90\x43\x7f\x11\x1c\xd6\x97\xf7\xb7\x47\x49\xe0\xac\x2a\xa0\x80\xff\x31\x82\x3e\x71\x3f\xbb\xf9\x08\xd6\x2e\x57\x9d\x5d\x4c\x08\xdc\xb6\xe6\xb1\x03\xb3\xbe\x13\x83\x0d\xf5\x42\xdf\x95\x45\x34\xa6\x09\xed\xaf\x77\x5a\x4b\xc3\x67\xb5\x59\xf5\x66\x54\xd0\xc2\x05\x59\x6f\xdf\xe1\x63\x18\x80\xe1\x9c\x4d\xff\x54\x4f\x3d\xc4\x41\x6b\xef\x91\xfe\xe9\xdb\x1f\xbe\x08\x7b\xea\xcf\xab\xc3\xbb\xd5\x5e\x91\xef\x9f\x5c\xc0\x7d\xd1\x6a\x3f\xe5\x18\x93\x0f\xff\x6e\x02\xe4\x03\xb9\x0f\xfe\x25\x57\x49\x74\x0b\x8c\x60\x47\x92\xbc\x3e\xa8\x1d\xd9\xa8\xd7\xf9\x61\xb5\x79\x7d\x03\x86\xd7\xac\x14\xac\x2e\x19\x17\xdc\xde\x6c\x0f\xb3\xa0\x55\xcf\xce\xc8\x66\x3b\x39\x53\x05\x8f\x1a\x83\xfe\xb3\xb5\xee\x39\x8a\xd6\x18\xf6\x9f\xd6\xc8\xe7\x5f\x78\x58\xc6\x32\xc6\x30\x36\x1a\x3a\x77\x3a\x7c\xcf\xce\xfa\xb3\xb3\x90\x57\xee\x7f\xff\xb5\xe3\x15\xfd\x38\x41\x8f\xdb\x97\xf9\x5e\x3f\x5e\x38\xaa\x0f\xb5\x9e\x02\xcf\xea\x43\x74\x5a\x67\x1d\xb6\xe4\xfe\xf7\x5f\x5b\xbe\x37\xec\xfc\xfb\xdf\x7f\x7d\x33\xae\x6f\x9e\xed\x74\x8e\xcf\x37\xd5\x2c\xdf\x6c\x0f\x4b\xb5\x33\x8a\x8c\x85\x41\xbe\xa9\xdc\x30\x00\x23\x4c\x84\x42\xd7\xcf\xfe\xd4\x18\x64\x2a\x2a\xda\x9e\x67\xf0\x3f\x59\x7c\x3c\x79\xf6\xb1\xc3\xe3\xc9\xb3\x8f\x14\x1d\x4f\x9e\xdd\x4c\x70\x6c\x77\xbd\xd8\xd8\xee\x4e\x08\x8d\xed\xee\xda\x91\xf1\x8f\x13\x23\xe3\x1f\x9f\x38\x32\x7e\xfc\xf8\xa1\xf1\xe3\x47\x8b\x8d\x1f\x6f\x2a\x38\xae\x06\xd1\x71\x75\x52\x78\x5c\xfd\x8a\xf8\x78\x75\x62\x7c\xbc\xfa\x44\xf1\xa1\x1f\xca\xbb\x91\xb1\xd1\x33\x23\x7b\x43\xb8\x56\xf5\x61\x3a\x2e\xec\x14\x6b\xa3\x47\x93\xe6\x17\xd9\xd6\x2d\x27\x7d\x84\xcd\x4d\x05\x83\x66\x76\x33\xe1\xa0\x59\xf5\x02\x42\x5f\x79\x34\xe3\xf1\x58\x1c\x34\x48\x6e\x28\x6c\xb0\x38\x80\x5b\xa0\x0d\xb9\x0b\x5b\x7c\x86\x50\x3f\x52\x66\x5d\xa8\xdc\xbd\x4b\x36\xfa\x11\x79\x1b\x0c\xb0\x7a\x3c\x13\x9c\xdc\x22\x1b\x6f\xa1\x28\x1c\x42\xc0\x67\x80\x0c\xd1\x40\xec\xcd\x93\xbd\x38\xe0\xe2\x86\xae\x61\x33\xdb\x90\x5b\xc8\x89\xa1\x0e\x11\x20\xfa\x8f\xba\x40\xdc\x6f\x19\xbd\x7a\x6f\xcb\x7f\x5c\xf8\x3e\x9b\xa5\x93\xd1\xfb\xec\x86\xa2\xb7\xf1\xfb\xac\x0d\x16\x88\x54\x27\x78\x6d\x3c\x1f\x11\xbc\x6e\xd8\xe9\xef\x9a\xd5\x35\xe2\xd7\xe9\x05\x2d\x9f\xe9\x00\x36\xe2\x3f\x79\x04\x3f\xdb\x1e\xf2\x83\x0a\x87\xf0\xcd\x24\xe0\x9d\x96\x72\x53\x21\xac\xb9\xdd\x4c\x08\x6b\x56\xbd\x10\xde\x6d\x27\xf3\xef\x6e\x7b\x44\xf6\x35\x2d\x02\xbb\xda\xac\xbe\x99\xdf\xde\xee\x66\xdd\x95\x67\xb3\x24\xf2\xc2\xf2\xd7\x3a\xec\x86\x72\xce\xef\xcb\x63\x13\x29\x67\xb7\x3d\x3c\x3b\xdd\x61\xcf\x3c\x87\x3d\xba\x8e\xc3\xee\x57\xd5\xc7\x9e\xf9\xe6\x55\xcb\x77\xb5\x9f\xe2\x78\x92\xe3\x9a\x23\xbf\x6f\xc0\x6d\x79\x35\xb8\x67\xae\x4e\xba\x67\xae\x8e\xbe\x67\x1e\x8e\x08\x5f\xb5\x13\x59\x18\x16\x90\xfc\x0a\x69\xa0\xcc\x77\x3b\xd8\x37\x3e\xb3\x63\x08\x1c\x01\xee\x30\xe9\x1f\x0f\x8f\xf3\xf0\x27\x52\x46\xb4\xf9\xae\x65\x18\x1d\x42\xc1\x77\x6c\x46\x1f\x7b\xba\x72\x7f\x63\x8e\x00\xdf\xd6\xee\xda\x66\xbb\xd8\xd8\x61\xfe\x9f\xcb\xdd\xf6\x52\xed\x0e\xef\xc9\xdf\xf5\xfa\xee\x07\xad\xef\x5e\x67\x84\x96\x85\xb7\xac\x68\x02\x64\x7f\x1b\xe3\x63\xd3\x4a\x7b\x26\x7a\x3f\xbb\xec\x57\xaf\x37\xab\x7a\x55\xe6\x9b\x03\x29\x34\x7c\xb5\x71\xfa\x86\x16\xda\xf2\xf5\x57\x7f\xbb\x75\x69\xab\x8c\xbd\x72\x03\xeb\xc0\xbe\x06\xe1\xee\xd8\x33\xd7\xec\xc9\x25\xa4\x95\x7c\x3d\xef\xd9\x7e\xd2\x70\x78\x42\x6e\x2d\xe7\xb0\x3d\xca\x88\xaa\xef\xe6\x5f\xd1\x57\xdb\x03\xb9\xb1\x55\xe5\xde\x33\xdf\xf9\xf9\x0d\x32\x7b\xe1\xd1\xc3\xc7\xa3\x99\x58\xd6\x5e\x9c\xca\x85\x49\x96\xb1\x9c\x15\xc0\x85\x95\xac\x62\x8a\xd5\x73\x8f\xc9\xcb\xff\xa0\xa6\xc2\xd1\xb6\xc1\x6c\x3c\x7c\x3c\x00\xc1\xb2\x5f\xb4\x61\xeb\xa5\xe5\x77\x66\xf3\x04\xf4\xf2\xdb\xf6\x47\xf3\xff\x3f\xfe\x81\xbc\x80\x01\x77\xad\x6d\x1f\xf8\xaf\x7b\xc4\x7f\x0a\xe6\x7e\x34\xd7\x16\xfd\x5e\xab\x86\xab\x3d\x21\x23\x93\xf6\x21\x03\xad\xd9\xed\xb5\xda\xbc\x3e\x2c\xc9\x97\x44\x0e\x19\x75\x3f\x47\x07\xfd\x07\x5b\xd8\x23\x6e\x6f\x0d\x9d\x34\x6c\xf2\x03\xbc\x27\x60\xdf\x0e\xe8\x27\x9e\xff\x47\xdb\xd1\xee\xb6\x8d\x1c\x7f\x93\x4f\x31\x71\x01\x93\x8a\x64\x8a\x92\xdd\xc4\xb0\x22\xa3\x8d\x62\xa1\x57\x24\xd7\x43\x9c\xb4\x07\x18\x06\x4c\x89\x2b\x6b\x7d\x12\xc9\x92\x54\x24\xf7\x6c\xa0\xaf\xd1\xd7\xeb\x93\x1c\x66\xf6\x83\xcb\x2f\x47\xbe\x4b\x2e\x07\x5c\x76\x39\x3b\x33\x3b\x33\x3b\x33\xbb\x3b\xab\xd3\x38\x9a\xa3\xb6\xd6\x6e\xe9\x66\x0e\xff\xe7\xf0\x25\x27\xea\x64\x10\x06\x79\x00\x41\xf6\x4c\x3a\xfb\xfa\x88\x5d\xf9\xa6\x50\x86\x75\x6a\x7a\x79\xfc\xf3\xf1\xb0\xfd\x2a\x84\x3e\x9b\x26\xf4\xcc\x9a\x1d\x49\xab\x6c\x54\xa3\x76\xb8\xf7\x42\x99\x9a\x49\xa5\xde\xaa\xe5\xd1\x05\x34\xa9\xad\x01\x97\x98\xee\xde\xc5\xfb\x65\xda\xed\x95\xb5\x06\x9f\x06\x83\x57\xb5\x12\x7e\xfc\x57\x8a\x3c\xf3\x92\x4d\xb6\x74\x25\x2c\x65\x1a\x9d\xbd\xa1\x2b\xb9\x44\xf5\x36\x0e\xff\xa8\x54\xc4\x50\xb0\xf2\x20\x0a\x67\xaf\xbc\x6c\x3a\xa3\x7d\x57\x05\xa1\x41\x93\x9c\xc7\x09\x05\xc9\x96\xd8\xff\xc4\x02\x30\x53\x01\x34\x7b\x06\xf3\x55\x1c\xb1\x6f\x60\xd2\x84\xa7\xd0\x82\x9c\x39\x76\xb6\xdb\x32\x8d\x79\xd2\x96\x4d\xcc\x94\xa5\x50\xa3\xa8\x7c\xd5\x39\x9c\xfa\x83\x66\x88\x20\x70\x80\x8e\x21\x3b\x10\xae\xa1\x8e\x54\xf9\x52\x81\x6f\x5b\x5b\x11\xb2\x0e\xcb\x6f\x27\x40\x6f\x98\xa4\x3c\x65\x72\xd2\x42\x46\x2f\x21\xd3\x3d\xee\xb1\x0c\xb6\xfb\xac\x81\xad\x34\x79\x85\xfe\x8a\x5f\x37\x89\xbd\xdd\x54\x09\xd8\x00\x35\xd2\xe3\xd6\xba\x99\x4a\xb5\x8c\x2c\x8d\xc1\x7a\x97\x3f\xef\x53\xef\xd2\x7f\xf9\x02\x96\x79\x9e\x64\x67\xfd\xfe\x3a\x5f\x66\xde\x8c\xf5\x37\xf9\xe2\xf4\x2e\x83\x2f\x43\x6f\xe0\x0d\x61\x76\x0f\x7f\x59\x07\xf9\x92\x07\x19\xc6\xcf\xa2\x40\x86\xaa\x42\x50\x10\xb6\xd5\xef\xc3\x3b\x96\xe3\x36\x79\x91\x32\x86\x4e\x8b\x07\xb3\x15\xcb\xe0\x46\x52\xba\xb1\x2d\x94\x28\x7e\xbd\xd0\xf5\x2f\xb5\x4a\x1a\x70\x84\xf2\x1c\x38\x3c\x54\xdd\xa3\x76\xf4\x70\x23\x8a\x6b\x0c\xe4\x1f\xa8\xa3\xc0\xbd\x96\xed\x32\x6a\xd9\x7b\x78\xd8\x50\x9f\x33\x2e\x31\xa9\x81\x9f\x64\xe3\x96\x2a\x67\x6e\x7a\xa2\x1a\xff\xc7\x38\x64\xde\x5d\x06\x71\x0a\x6f\x45\x29\x0d\x5f\xe0\x93\xba\x79\x1c\xb2\x1e\x61\xc1\xea\xe8\x4d\xc6\x80\xe7\x18\xd7\x6e\x50\x8e\xc6\x1c\x64\x1d\x8e\x9e\xc3\xad\x6c\x97\xe7\x20\x7a\x47\xa2\x26\xa9\x18\xe6\x69\xe8\xb1\x89\xec\xe1\xc1\x68\x79\x5b\x1e\x85\xb8\xbb\x2c\xc1\xa0\x26\x2d\x0b\x79\x01\xb3\x9b\x8a\x7d\x6c\xab\xff\xf2\xe8\x9b\xfd\xf3\xb2\x6f\x8b\xd9\x66\x79\xca\xa3\xdb\x69\x1a\xaf\x27\xcb\x20\x9d\xc4\x21\xfa\x96\x4b\xea\xf4\x16\x46\xaf\x14\xfe\xa7\x00\xdf\x13\xe2\x87\x9a\xc9\x26\x9b\xe8\x1e\xe5\x6b\x5b\xca\x3a\x61\x33\xcf\x86\x21\xc3\x4e\x57\xd0\x11\x13\x44\xba\xa2\xf8\x5e\x85\x40\x62\x65\x1e\x6f\xb0\x44\x4f\x9e\x5c\x52\x97\xcc\x9d\xc6\x92\xcd\xc2\x59\xd0\x57\x7a\xcf\xa8\x1a\x6c\x97\xa7\x01\x36\xb6\x4b\xbe\x62\xe0\x2a\x6c\x6f\x40\x0c\x12\xa4\x2d\x1a\x53\x20\x9c\xcb\xe9\xfd\x35\x57\x03\xba\x5d\x5c\xea\x16\xe9\x54\x00\x9f\x8f\xc1\xdf\xbd\x3b\xf5\x7d\xd4\xb9\xe8\x7a\x43\x5d\x6f\xa7\x53\xec\x6a\xa1\x84\x56\x86\x01\x15\xb2\x4d\x9a\xc6\x58\xe0\xd1\x23\xab\xc3\x0b\x3a\x46\xef\x3c\x21\xc2\x82\x42\x64\x21\x98\xe7\x2c\x45\xaa\x16\x4d\x63\x1f\xfe\x88\x41\x57\x80\x1f\x82\xbf\x9b\x4e\x7c\xbf\x83\x16\xea\xef\xde\xd1\x5f\x7f\x45\xe7\x8c\x67\x10\x9a\x3e\x0d\xb3\x84\xe4\x45\x28\x77\x5d\x31\x1f\x44\x70\x3c\x9d\x76\xf0\x7c\x7b\xe0\xe3\xa3\x5c\x03\x33\x7d\xe8\xaa\x5f\x1c\x92\xd4\x8b\x2c\x58\x4e\x75\x13\xad\x83\x7c\xbe\x64\x61\x41\x6f\x04\x71\xb4\xba\xc7\xf7\xac\x8c\xe6\xcd\x33\x40\x5b\x80\x4d\xc4\xf3\x1e\xf0\x08\xe6\x58\x3e\x86\x07\x8d\x28\x08\x8d\x49\xc3\xa0\x90\x72\x55\x17\xa5\xb1\x62\xa8\x0f\x0a\x22\x90\x04\x3c\xad\xcf\x8c\xe6\x25\x79\xb5\xa4\xe8\x8e\x8e\x24\xef\x76\x75\x02\x2d\x23\x1f\x6d\x01\x2c\xfd\xbd\x80\x52\xab\xf1\xf7\x2c\x06\x16\xe1\xe4\x5c\x8a\xc2\xc5\x5a\xd0\x56\x4e\xfd\x15\x23\xe7\x51\xc8\x76\x30\x86\xa3\x41\xa3\xd9\xeb\x75\xe4\x38\x86\xf1\x77\xbb\x62\x58\x8b\xf1\x13\x9d\x2b\x02\xb9\xae\x1a\x3b\x9a\xd2\x14\x35\x4e\x23\xe4\x90\xa3\xb1\x52\xff\xc8\x90\x17\x74\xc7\x0d\xfe\x43\x21\x3a\x3f\x87\x81\xaf\x0c\x08\x8f\xaa\x68\x0d\x49\x95\x28\x4e\x84\xb1\xc2\x83\x5c\x57\x12\x5a\x0b\x7f\x0f\x42\x9d\xd1\x13\x4a\xd2\xc2\x9f\x2f\xd9\xfc\x97\xcb\x79\xb0\x0a\xd2\x7f\x22\x25\x17\xf5\xf0\x53\xcc\x23\x51\x4d\x4d\x8b\x49\x77\x95\x57\x7c\xd1\x2d\x56\x7d\x21\x9c\x7c\x99\xc6\x5b\xb8\x48\xd3\x38\x75\xb1\x6d\x39\xef\x31\x15\x2a\x4c\xf3\x73\xd7\x81\x6e\x81\xc0\xcb\x63\xe1\x59\xdd\xc1\xab\x8e\x97\xc7\x9f\x93\x84\xa5\x93\x20\x63\x6e\x07\xba\x02\x01\x9a\x7c\x14\xe7\x10\xd0\x2b\x6d\xa5\x6c\x07\x3f\xaa\x89\x3e\x7e\x87\x48\x50\xc8\x89\xb2\x6a\xcc\xc4\x0b\x09\xf5\xc4\x35\x9b\x0c\x4e\x42\xca\x0d\xda\x70\x8b\x11\x70\x7e\xae\xc6\x08\x8d\xd2\x51\xe5\xee\xd4\xef\x54\xf5\x22\x16\xc4\x44\x0d\x6c\x52\x8b\x81\xf6\x50\x1a\xe7\x74\x7a\x2a\x7d\x9d\x74\x73\x83\x23\x3c\xbc\x82\x8c\xfd\x7b\xc3\xa2\x39\xb3\x2d\x6d\x0e\x0d\x8c\x16\x34\x94\xe9\xe0\x42\xca\xee\xd7\xb3\x78\xa5\x17\x52\x1b\xe5\x53\xbf\x4c\x79\x58\xa7\xac\x31\x7d\x5d\x48\xaf\x84\x80\x06\x52\x40\x13\x5f\xb3\xa4\x0b\x8f\x1b\x98\xf0\xfd\x0a\x13\xc7\x75\x26\x9e\x30\xf8\xd1\x33\x99\x1c\x0c\x05\x97\xbe\xe4\xf2\xc2\x2f\xa1\xe8\x8e\x5b\xac\xe6\xd5\x1e\x93\xb9\xf0\x6b\x93\x39\xf9\x43\x12\x1d\x9c\x4a\x66\x5f\x0b\x66\xa7\x7b\x32\x3b\x18\x3e\x77\x52\x05\x64\x13\x57\x7a\x48\xc3\x0a\xd0\xb6\x49\x18\xaa\x4b\x02\x53\x7f\x16\x35\x26\x4d\x1a\x29\xee\xce\xea\xc9\xd5\xa8\x1a\x4f\x0a\xf8\xaf\x06\x15\x0d\xaa\x3a\x50\x09\xc2\x5b\xed\x19\x5c\x34\x86\x12\x61\x33\xca\x18\x28\xbb\xe3\x27\x96\xbe\x92\xb0\x14\x53\x31\xec\x7b\x25\xc2\x5a\xf6\x29\x0b\xc2\x49\x1c\xe5\x3c\xda\xd0\x3b\x79\x72\x84\x85\x2b\x42\x4e\x7e\xc0\xf9\x60\x84\xc0\xc6\x04\x13\x8b\x86\x68\xe0\xfc\x10\x7d\x09\x56\x3c\xa4\x8b\x09\x21\x6d\x47\x1a\x8e\x96\x77\x99\x0a\x08\x84\x74\x50\x70\xa5\xe9\x5c\xcb\x65\x82\x43\x75\x67\xb7\x3b\xb2\x0b\xdf\x58\x41\x83\xf0\x13\xb9\xa0\xd0\xdc\x04\x6f\x52\x92\x8d\xd0\xc7\x53\xcd\x18\xfe\x58\xc0\x02\xb6\x0c\x30\x5f\xdb\x24\x80\x99\x6a\x0f\x78\xfe\xff\xff\xfe\x4f\x85\x25\x13\x05\x71\x6c\xb7\xcc\xbc\x06\xe8\xd4\x9c\xbf\xb0\xde\x4b\x5a\x05\x6e\x61\xe5\x88\x55\xdb\x25\x36\x86\x66\xe3\xd8\x6c\x9c\xa8\x86\x36\x1e\x2d\x1a\x2d\x2e\x38\xff\x03\xaa\x2a\x63\x1a\xd7\xb5\x2e\x25\xbb\x08\x56\x19\x33\xe5\xf8\x91\x05\x21\x2c\x78\x9a\xe5\x4a\x4a\xf8\x9f\xc1\xf3\xd5\x5c\x8f\x6e\xe0\x46\x71\x5d\x0f\x59\x47\xb2\xeb\x62\x6b\x40\x18\x8d\x28\x69\xf0\xaa\xe5\xab\x78\xad\xc7\xb0\x0a\x9e\x0b\x65\x50\x13\x65\x50\x38\x60\x08\xe3\x96\x05\x33\xaa\xfa\x03\x13\x19\x06\xbb\x37\x6f\xc4\x0f\x03\x69\xed\x36\x25\x60\xda\x7a\x15\xe3\xfa\xfb\xa8\x96\xc0\x3f\xc3\x04\x55\x5a\x2f\xe6\x5e\x09\x9d\xe0\xae\x83\x7b\xe0\xd1\x7c\xb5\xa1\x4d\x08\x6e\x2e\xcc\x2d\x4d\x93\x94\xa7\x4a\x3a\x17\xcf\x90\x0e\x0e\x3f\xfe\x7d\x02\xf4\x85\x00\x31\x2c\x3f\x08\xeb\x1c\x96\x04\x7a\xdc\x26\x50\x9f\x92\x17\xe2\xf0\xab\xf9\xc1\xf7\x94\xf9\xc9\xd7\xec\x6d\x7a\x2a\x25\x3a\xfd\xb6\x12\x45\x90\x93\xa7\x41\x5a\x85\xfe\x9a\x84\xee\xef\xaa\x62\xf7\x77\xfe\xa4\x03\x0f\xc8\x81\x45\xbd\xc7\xb2\x57\xeb\xe3\xa4\x55\x1f\xb4\xa3\x6a\xd8\x63\x0c\x7c\x73\x0b\xd6\xac\x09\x25\xcf\x46\x2d\x7c\xfe\x34\x3d\x3a\x85\x90\x4e\xca\x58\xa8\x3d\xaf\x72\x9b\xf2\x05\x96\x76\xa3\xe4\xd0\x8c\x36\x39\xa5\x91\xe1\xa9\x31\x27\x91\xb9\x46\x11\x8d\x05\x7f\x1a\x5f\x39\x25\x31\xc0\x94\x57\x23\x2a\xa6\x03\x34\x92\x12\x4d\xd5\x3c\xfd\xd1\x33\xd6\x57\x25\xc4\x61\xbe\x4e\x8c\x6c\xc4\xcd\xd7\x09\x8c\x2b\xb1\xa4\x03\x2f\xf0\x60\x0d\x9d\xb2\x94\x62\x81\x4b\xec\xf2\xf3\x75\x22\xbd\xbc\x16\xb0\xb1\x41\x2f\xa0\x3b\xdf\x2b\xe7\xc0\x99\xa0\x58\x61\x4c\x0c\x3a\xf8\x33\x50\x3c\x8e\x9c\x33\x70\xe8\xd0\xd7\xe9\x61\xaf\xe0\xc7\x39\x33\xb2\x42\xea\x0f\x99\xd1\x2f\x1a\xb6\xf5\x28\x0f\xe9\x2e\xe3\x35\xc3\xd7\x87\x30\xdb\xf0\x55\x08\x71\x92\xf3\x35\xff\x0f\x4b\xb3\x1e\xac\xf8\x2f\x0c\x52\xef\x2e\xeb\x01\x79\x00\x3a\x69\xcf\x12\x36\xc7\x22\x00\x74\x98\x21\x27\x85\x27\x41\x9e\xb3\x34\xca\x08\x1f\x0d\xc2\x83\x98\x45\x8c\xbf\x06\xc6\xa3\xdb\x33\x71\xe6\x89\xb1\xbf\xf2\x2e\x12\x1c\x65\x34\x78\xcc\x5b\x05\xc0\xf7\x91\x95\x53\x54\xfd\x44\x12\xbf\xd9\x96\x50\x97\x7c\x34\xa9\x50\xc9\xe4\x40\x2b\x2a\x5f\xe0\x5d\xaa\x85\x35\x2b\xd6\x63\xb1\xbb\xa8\x9c\x1a\xbf\x30\xda\x5e\x14\x87\xec\xd3\x7d\x22\xcd\x41\x41\x7f\xa0\x83\x65\xb9\xf1\xe0\x91\x79\x6e\x8c\x55\xfa\xf1\xdf\x2f\xe1\x8b\xef\x9d\x7a\x3e\x6d\xcf\x8b\x11\xc6\x5b\x52\xcd\x8c\x74\x8d\x0a\x53\x90\x6e\x97\xc1\xaa\x82\xe9\xb5\xe7\x1f\x21\x26\x54\xbe\xac\x8d\x12\xaf\x18\x65\xdf\x32\xc8\xfe\xb1\x8d\x7e\x52\x25\x30\x63\x09\xe4\x95\xfb\x09\x5c\x5f\x91\xe0\xcf\xb2\x71\x21\x14\x29\x26\xab\x0c\x2e\xee\x87\xf0\x3b\x3d\x24\xee\xa0\xc3\x31\x65\x85\x3f\xef\x78\x2d\x67\x42\x7f\x37\x3d\x76\x75\x5e\x1f\x97\x3c\x8a\x71\x56\x01\x6c\xd9\x0c\xe4\x43\x55\x79\x6a\xed\x49\x83\x96\x32\x79\xb4\xe5\x13\x55\xbc\x1c\xb1\x1f\x7b\xbf\xe2\x95\xc8\xab\x7d\xae\x44\x6a\x2f\x76\x7f\xfe\xf0\xfe\x6f\x79\x9e\x7c\xc4\x90\x91\xe5\x1a\xdb\xc1\x8c\xdf\x8a\x62\x16\xef\x2e\x3b\xd8\x07\xb3\x83\x87\xfe\xb8\x61\x9b\xe7\xce\xc8\xae\x13\x7a\xcb\x6f\x7f\x24\x84\x23\x54\xe4\x5d\xb6\x44\xa7\xcc\x6f\xa3\x38\x65\x67\x2b\x1e\x31\x5b\x93\xde\xb2\xd9\xf1\x5e\x24\x51\xd9\xff\x62\x33\x11\x9b\x08\xc6\x75\xbc\xfe\x8a\xcf\xfa\x88\x02\x9d\xb3\xdd\xef\x43\x18\x47\x39\xc4\x5f\x58\x9a\xf2\x90\xc9\xab\x05\x7d\xbf\x61\x1b\x4f\x90\xe5\xcd\x01\x3a\x38\x47\x17\x34\xe0\x62\x53\x97\x15\x02\xc0\x13\x24\xcb\x50\x68\x20\x78\xad\x55\x02\x01\x84\x1c\xd9\x8f\x0d\xd2\x10\x5f\xe4\xc5\x96\xe2\xf8\xe0\x6c\x38\x7c\xbc\x46\x29\xf4\xae\x0e\xa8\xe3\xba\x63\xf7\xfb\x7f\x82\x2c\xde\xa4\x73\xf6\x21\x48\x12\x1e\xdd\x7e\xfe\xf8\x7e\x8c\x1f\x8f\x56\x54\x44\x7a\x97\x79\xeb\x20\xb1\x7f\x1b\x00\xeb\xf5\xea\xf7\x8c\x20\x06\x00")
 
 func web3JsBytes() ([]byte, error) {
 	return bindataRead(
@@ -345,6 +345,12 @@ web3._extend({
 			params: 2,
 			inputFormatter: [null, null]
 		}),
+		new web3._extend.Method({
+			name: 'traceCall',
+			call: 'debug_traceCall',
+			params: 4,
+			inputFormatter: [null, null, null, null]
+		}),
 		new web3._extend.Method({
 			name: 'preimage',
 			call: 'debug_preimage',
@@ -436,6 +442,25 @@ web3._extend({
 			params: 2,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
 		}),
+		new web3._extend.Method({
+			name: 'getPendingVoterRewards',
+			call: 'aoa_getPendingVoterRewards',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getFinalizedBlock',
+			call: 'aoa_getFinalizedBlock',
+			params: 1,
+			inputFormatter: [function (val) { return !!val; }]
+		}),
+		new web3._extend.Method({
+			name: 'getDelegateCommission',
+			call: 'aoa_getDelegateCommission',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter],
+			outputFormatter: web3._extend.utils.toDecimal
+		}),
 		new web3._extend.Method({
 			name: 'resend',
 			call: 'aoa_resend',
@@ -473,6 +498,24 @@ web3._extend({
 			params: 1,
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter],
 		}),
+		new web3._extend.Method({
+			name: 'getAssetBySymbol',
+			call: 'aoa_getAssetBySymbol',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getAssetHolders',
+			call: 'aoa_getAssetHolders',
+			params: 3,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.utils.fromDecimal, web3._extend.utils.fromDecimal]
+		}),
+		new web3._extend.Method({
+			name: 'getAssetHolderCount',
+			call: 'aoa_getAssetHolderCount',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter],
+			outputFormatter: web3._extend.utils.toDecimal
+		}),
 		new web3._extend.Method({
 			name: 'getAbi',
 			call: 'aoa_getAbi',
@@ -486,8 +529,19 @@ web3._extend({
 			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter],
 			outputFormatter: web3._extend.formatters.outputBigNumberFormatter
 		}),
+		new web3._extend.Method({
+			name: 'getAssetAllowance',
+			call: 'aoa_getAssetAllowance',
+			params: 4,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputDefaultBlockNumberFormatter],
+			outputFormatter: web3._extend.formatters.outputBigNumberFormatter
+		}),
 	],
 	properties: [
+		new web3._extend.Property({
+			name: 'chainConfig',
+			getter: 'aoa_chainConfig'
+		}),
 		new web3._extend.Property({
 			name: 'pendingTransactions',
 			getter: 'aoa_pendingTransactions',
@@ -502,6 +556,13 @@ web3._extend({
 		}),
 	]
 });
+
+// formatAssetBalance converts a raw asset balance into whole units of the
+// asset according to its decimals.
+web3.aoa.formatAssetBalance = function(balance, asset) {
+	var decimals = web3.aoa.getAssetInfo(asset).decimals;
+	return web3.toBigNumber(balance).dividedBy(web3.toBigNumber(10).pow(decimals)).toString(10);
+};
 `
 
 const Net_JS = `
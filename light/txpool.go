@@ -19,6 +19,7 @@ package light
 import (
 	"context"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -370,7 +371,7 @@ func (pool *TxPool) validateTx(ctx context.Context, tx *types.Transaction) error
 
 	// Transactor should have enough funds to cover the costs
 	// cost == V + GP * GL
-	if b := currentState.GetBalance(from); b.Cmp(tx.EmCost()) < 0 {
+	if b := currentState.GetBalance(from); b.Cmp(tx.EmCost(pool.config, new(big.Int).Add(header.Number, common.Big1))) < 0 {
 		return core.ErrInsufficientFunds
 	}
 
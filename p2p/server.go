@@ -669,7 +669,9 @@ running:
 		case pd := <-srv.delpeer:
 			// A peer disconnected.
 			d := common.PrettyDuration(mclock.Now() - pd.created)
-			srv.ntab.Delete(pd.ID())
+			if srv.ntab != nil {
+				srv.ntab.Delete(pd.ID())
+			}
 
 			pd.log.Debug("Removing p2p peer", "duration", d, "peers", len(peers)-1, "req", pd.requested, "err", pd.err)
 			delete(peers, pd.ID())
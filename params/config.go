@@ -48,14 +48,44 @@ var (
 		BlockInterval:        big.NewInt(10),
 	}
 
+	// DefaultRewardConfig is the mainnet emission: 500 AOA per block, growing by
+	// 10% every year of 3153600 blocks, in whole coins.
+	DefaultRewardConfig = &RewardConfig{
+		InitialReward: new(big.Int).Mul(big.NewInt(500), big.NewInt(Em)),
+		RatioNum:      big.NewInt(11),
+		RatioDenom:    big.NewInt(10),
+		Period:        big.NewInt(3153600),
+		Rounding:      big.NewInt(Em),
+	}
+
 	// chainId must between 1 ~ 255
 	AllDacchainProtocolChanges = &ChainConfig{
-		ChainId:              big.NewInt(60),
-		ByzantiumBlock:       big.NewInt(10000),
-		FrontierBlockReward:  big.NewInt(5e+18),
-		ByzantiumBlockReward: big.NewInt(1e+18),
-		MaxElectDelegate:     big.NewInt(1),
-		BlockInterval:        big.NewInt(10),
+		ChainId:                 big.NewInt(60),
+		ByzantiumBlock:          big.NewInt(10000),
+		FrontierBlockReward:     big.NewInt(5e+18),
+		ByzantiumBlockReward:    big.NewInt(1e+18),
+		MaxElectDelegate:        big.NewInt(1),
+		BlockInterval:           big.NewInt(10),
+		JailBlock:               big.NewInt(0),
+		MaxMissedSlots:          big.NewInt(DefaultMaxMissedSlots),
+		SlashBlock:              big.NewInt(0),
+		UnregisterBlock:         big.NewInt(0),
+		UnbondingPeriod:         big.NewInt(DefaultUnbondingPeriod),
+		RandaoBlock:             big.NewInt(0),
+		RewardShareBlock:        big.NewInt(0),
+		AssetSupplyBlock:        big.NewInt(0),
+		AssetRegistryBlock:      big.NewInt(0),
+		AssetMetadataBlock:      big.NewInt(0),
+		AssetControlBlock:       big.NewInt(0),
+		AssetFeePoolBlock:       big.NewInt(0),
+		BatchTransferBlock:      big.NewInt(0),
+		AssetAllowanceBlock:     big.NewInt(0),
+		AssetPrecompileBlock:    big.NewInt(0),
+		PetersburgBlock:         big.NewInt(0),
+		IstanbulBlock:           big.NewInt(0),
+		ShanghaiBlock:           big.NewInt(0),
+		RegisterCostBlock:       big.NewInt(0),
+		DelegatePrecompileBlock: big.NewInt(0),
 	}
 
 	TestChainConfig = &ChainConfig{
@@ -77,15 +107,132 @@ type ChainConfig struct {
 	ByzantiumBlockReward *big.Int // Block reward in wei for successfully produce a block upward from Byzantium
 	MaxElectDelegate     *big.Int // dpos max elect delegate number
 	BlockInterval        *big.Int
+
+	JailBlock          *big.Int `json:"jailBlock,omitempty"`          // Missed-slot jailing switch block (nil = no fork, 0 = already activated)
+	MaxMissedSlots     *big.Int `json:"maxMissedSlots,omitempty"`     // consecutive missed slots before a delegate is jailed (nil = DefaultMaxMissedSlots)
+	MinActiveDelegates *big.Int `json:"minActiveDelegates,omitempty"` // shuffled delegates jailing never goes below (nil = block confirmation quorum)
+
+	SlashBlock *big.Int `json:"slashBlock,omitempty"` // Double-sign slashing switch block (nil = no fork, 0 = already activated)
+
+	UnregisterBlock *big.Int `json:"unregisterBlock,omitempty"` // Delegate unregistration switch block (nil = no fork, 0 = already activated)
+	UnbondingPeriod *big.Int `json:"unbondingPeriod,omitempty"` // seconds a leaving delegate's deposit stays locked (nil = DefaultUnbondingPeriod)
+
+	RandaoBlock *big.Int `json:"randaoBlock,omitempty"` // Randao seeded shuffle switch block (nil = no fork, 0 = already activated)
+
+	RewardShareBlock *big.Int `json:"rewardShareBlock,omitempty"` // Voter reward sharing switch block (nil = no fork, 0 = already activated)
+
+	AssetSupplyBlock *big.Int `json:"assetSupplyBlock,omitempty"` // Asset mint and burn switch block (nil = no fork, 0 = already activated)
+
+	AssetRegistryBlock *big.Int `json:"assetRegistryBlock,omitempty"` // Unique asset symbol switch block (nil = no fork, 0 = already activated)
+
+	AssetMetadataBlock *big.Int `json:"assetMetadataBlock,omitempty"` // Asset decimals and metadata switch block (nil = no fork, 0 = already activated)
+
+	AssetControlBlock *big.Int `json:"assetControlBlock,omitempty"` // Asset freeze and whitelist switch block (nil = no fork, 0 = already activated)
+
+	AssetFeePoolBlock *big.Int `json:"assetFeePoolBlock,omitempty"` // Asset fee pool switch block (nil = no fork, 0 = already activated)
+
+	BatchTransferBlock *big.Int `json:"batchTransferBlock,omitempty"` // Batch transfer switch block (nil = no fork, 0 = already activated)
+
+	AssetAllowanceBlock *big.Int `json:"assetAllowanceBlock,omitempty"` // Asset allowance switch block (nil = no fork, 0 = already activated)
+
+	AssetPrecompileBlock *big.Int `json:"assetPrecompileBlock,omitempty"` // Native asset precompile switch block (nil = no fork, 0 = already activated)
+
+	PetersburgBlock *big.Int `json:"petersburgBlock,omitempty"` // CREATE2 and EXTCODEHASH switch block (nil = no fork, 0 = already activated)
+
+	IstanbulBlock *big.Int `json:"istanbulBlock,omitempty"` // CHAINID, SELFBALANCE and EIP-1884 repricing switch block (nil = no fork, 0 = already activated)
+
+	ShanghaiBlock *big.Int `json:"shanghaiBlock,omitempty"` // PUSH0 switch block (nil = no fork, 0 = already activated)
+
+	RegisterCostBlock *big.Int `json:"registerCostBlock,omitempty"` // Reduced delegate register cost switch block (nil = reduced cost from genesis)
+
+	DelegatePrecompileBlock *big.Int `json:"delegatePrecompileBlock,omitempty"` // DPoS precompile switch block (nil = no fork, 0 = already activated)
+
+	Reward *RewardConfig `json:"reward,omitempty"` // Block reward schedule (nil = DefaultRewardConfig)
+}
+
+// RewardConfig is the emission schedule of the block producers. The reward of
+// block n is InitialReward * (RatioNum/RatioDenom)^(n/Period), rounded down to
+// a multiple of Rounding and limited to MaxReward.
+type RewardConfig struct {
+	InitialReward *big.Int `json:"initialReward"`       // reward in wei of the blocks in the first period
+	RatioNum      *big.Int `json:"ratioNum"`            // the reward is multiplied by RatioNum/RatioDenom every period
+	RatioDenom    *big.Int `json:"ratioDenom"`          // (nil ratio = constant reward)
+	Period        *big.Int `json:"period"`              // period length in blocks (nil or 0 = constant reward)
+	Rounding      *big.Int `json:"rounding,omitempty"`  // reward is rounded down to a multiple of this (nil = exact)
+	MaxReward     *big.Int `json:"maxReward,omitempty"` // reward cap in wei (nil = uncapped)
+}
+
+// String implements the fmt.Stringer interface.
+func (r *RewardConfig) String() string {
+	return fmt.Sprintf("{Initial: %v Ratio: %v/%v Period: %v Rounding: %v Max: %v}",
+		r.InitialReward, r.RatioNum, r.RatioDenom, r.Period, r.Rounding, r.MaxReward)
 }
 
 // String implements the fmt.Stringer interface.
 func (c *ChainConfig) String() string {
-	return fmt.Sprintf("{ChainID: %v Byzantium: %v Engine: %v}",
-		c.ChainId,
-		c.ByzantiumBlock,
-		"DPOS-BFT",
-	)
+	var forks string
+	for _, fork := range c.Forks() {
+		forks += fmt.Sprintf(" %s: %v", fork.Name, fork.Block)
+	}
+	return fmt.Sprintf("{ChainID: %v%s Engine: %v}", c.ChainId, forks, "DPOS-BFT")
+}
+
+// Fork is a named protocol upgrade activated at a block number.
+type Fork struct {
+	Name    string
+	Block   *big.Int // nil = not scheduled
+	Extends string   // fork this one builds on and can't activate before ("" = independent)
+}
+
+// Forks returns the protocol upgrades of the chain in the order they were
+// introduced. A new fork gets a block in ChainConfig, a flag in Rules and an
+// entry here, which also covers the compatibility checks on restart and the
+// order checks of CheckConfigForkOrder.
+func (c *ChainConfig) Forks() []Fork {
+	return []Fork{
+		{"Byzantium", c.ByzantiumBlock, ""},
+		{"Jail", c.JailBlock, ""},
+		{"Slash", c.SlashBlock, ""},
+		{"Unregister", c.UnregisterBlock, ""},
+		{"Randao", c.RandaoBlock, ""},
+		{"RewardShare", c.RewardShareBlock, ""},
+		{"AssetSupply", c.AssetSupplyBlock, ""},
+		{"AssetRegistry", c.AssetRegistryBlock, ""},
+		{"AssetMetadata", c.AssetMetadataBlock, ""},
+		{"AssetControl", c.AssetControlBlock, ""},
+		{"AssetFeePool", c.AssetFeePoolBlock, ""},
+		{"BatchTransfer", c.BatchTransferBlock, ""},
+		{"AssetAllowance", c.AssetAllowanceBlock, ""},
+		{"AssetPrecompile", c.AssetPrecompileBlock, ""},
+		{"Petersburg", c.PetersburgBlock, ""},
+		{"Istanbul", c.IstanbulBlock, "Petersburg"},
+		{"Shanghai", c.ShanghaiBlock, "Istanbul"},
+		{"RegisterCost", c.RegisterCostBlock, ""},
+		{"DelegatePrecompile", c.DelegatePrecompileBlock, ""},
+	}
+}
+
+// CheckConfigForkOrder checks that no fork is scheduled without or before the
+// fork it extends, the evm instruction sets for instance build on each other.
+func (c *ChainConfig) CheckConfigForkOrder() error {
+	forks := c.Forks()
+	blocks := make(map[string]*big.Int, len(forks))
+	for _, fork := range forks {
+		blocks[fork.Name] = fork.Block
+	}
+	for _, fork := range forks {
+		if fork.Extends == "" || fork.Block == nil {
+			continue
+		}
+		base := blocks[fork.Extends]
+		if base == nil {
+			return fmt.Errorf("unsupported fork ordering: %v not enabled, but %v enabled at %v", fork.Extends, fork.Name, fork.Block)
+		}
+		if base.Cmp(fork.Block) > 0 {
+			return fmt.Errorf("unsupported fork ordering: %v enabled at %v, but %v enabled at %v", fork.Extends, base, fork.Name, fork.Block)
+		}
+	}
+	return nil
 }
 
 // CheckCompatible checks whether scheduled fork transitions have been imported
@@ -107,11 +254,12 @@ func (c *ChainConfig) CheckCompatible(newcfg *ChainConfig, height uint64) *Confi
 }
 
 func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int) *ConfigCompatError {
-
-	if isForkIncompatible(c.ByzantiumBlock, newcfg.ByzantiumBlock, head) {
-		return newCompatError("Byzantium fork block", c.ByzantiumBlock, newcfg.ByzantiumBlock)
+	forks, newForks := c.Forks(), newcfg.Forks()
+	for i, fork := range forks {
+		if isForkIncompatible(fork.Block, newForks[i].Block, head) {
+			return newCompatError(fork.Name+" fork block", fork.Block, newForks[i].Block)
+		}
 	}
-
 	return nil
 }
 
@@ -174,10 +322,198 @@ func (c *ChainConfig) IsByzantium(num *big.Int) bool {
 	return isForked(c.ByzantiumBlock, num)
 }
 
+// IsJail returns whether num is either equal to the jail fork block or greater,
+// from which on missed slots are tracked and offline delegates are jailed.
+func (c *ChainConfig) IsJail(num *big.Int) bool {
+	return isForked(c.JailBlock, num)
+}
+
+// JailThreshold returns the number of consecutive missed slots after which a
+// delegate is excluded from the shuffle.
+func (c *ChainConfig) JailThreshold() uint64 {
+	if c.MaxMissedSlots == nil || c.MaxMissedSlots.Sign() <= 0 {
+		return DefaultMaxMissedSlots
+	}
+	return c.MaxMissedSlots.Uint64()
+}
+
+// MinActiveDelegateCount returns the number of shuffled delegates that jailing
+// never goes below. It defaults to the quorum confirming a block, with fewer
+// delegates left the chain would stall.
+func (c *ChainConfig) MinActiveDelegateCount() int {
+	if c.MinActiveDelegates == nil || c.MinActiveDelegates.Sign() <= 0 {
+		return int(c.MaxElectDelegate.Int64()/3*2) + 1
+	}
+	return int(c.MinActiveDelegates.Int64())
+}
+
+// IsSlash returns whether num is either equal to the slash fork block or greater,
+// from which on double-sign evidence transactions are accepted.
+func (c *ChainConfig) IsSlash(num *big.Int) bool {
+	return isForked(c.SlashBlock, num)
+}
+
+// IsUnregister returns whether num is either equal to the unregister fork block
+// or greater, from which on delegates can leave with an unbonding period.
+func (c *ChainConfig) IsUnregister(num *big.Int) bool {
+	return isForked(c.UnregisterBlock, num)
+}
+
+// UnbondingDuration returns the number of seconds a leaving delegate's deposit
+// stays locked before it is released.
+func (c *ChainConfig) UnbondingDuration() uint64 {
+	if c.UnbondingPeriod == nil || c.UnbondingPeriod.Sign() < 0 {
+		return DefaultUnbondingPeriod
+	}
+	return c.UnbondingPeriod.Uint64()
+}
+
+// RewardSchedule returns the block reward schedule of the chain.
+func (c *ChainConfig) RewardSchedule() *RewardConfig {
+	if c.Reward == nil {
+		return DefaultRewardConfig
+	}
+	return c.Reward
+}
+
+// CheckRewardSchedule checks that the reward schedule of the chain can be
+// computed for every block. A period shorter than MinRewardPeriod blocks would
+// raise the ratio to a power growing with every block.
+func (c *ChainConfig) CheckRewardSchedule() error {
+	r := c.RewardSchedule()
+	if r.InitialReward == nil || r.InitialReward.Sign() < 0 {
+		return fmt.Errorf("invalid initial block reward: %v", r.InitialReward)
+	}
+	if r.Period == nil || r.Period.Sign() == 0 {
+		return nil
+	}
+	if r.Period.Cmp(big.NewInt(MinRewardPeriod)) < 0 {
+		return fmt.Errorf("reward period too short: have %v, want at least %d", r.Period, MinRewardPeriod)
+	}
+	if r.RatioNum == nil || r.RatioDenom == nil || r.RatioNum.Sign() < 0 || r.RatioDenom.Sign() <= 0 {
+		return fmt.Errorf("invalid reward ratio: %v/%v", r.RatioNum, r.RatioDenom)
+	}
+	return nil
+}
+
+// IsRandao returns whether num is either equal to the randao fork block or greater,
+// from which on headers carry a randao reveal and rounds are shuffled with the
+// seed mixed from the reveals of the previous round.
+func (c *ChainConfig) IsRandao(num *big.Int) bool {
+	return isForked(c.RandaoBlock, num)
+}
+
+// IsRewardShare returns whether num is either equal to the reward share fork
+// block or greater, from which on delegates share block rewards with their voters.
+func (c *ChainConfig) IsRewardShare(num *big.Int) bool {
+	return isForked(c.RewardShareBlock, num)
+}
+
+// IsAssetSupply returns whether num is either equal to the asset supply fork
+// block or greater, from which on issuers can mint and holders can burn assets.
+func (c *ChainConfig) IsAssetSupply(num *big.Int) bool {
+	return isForked(c.AssetSupplyBlock, num)
+}
+
+// IsAssetRegistry returns whether num is either equal to the asset registry
+// fork block or greater, from which on asset symbols are unique.
+func (c *ChainConfig) IsAssetRegistry(num *big.Int) bool {
+	return isForked(c.AssetRegistryBlock, num)
+}
+
+// IsAssetMetadata returns whether num is either equal to the asset metadata
+// fork block or greater, from which on assets carry decimals and metadata the
+// issuer can update.
+func (c *ChainConfig) IsAssetMetadata(num *big.Int) bool {
+	return isForked(c.AssetMetadataBlock, num)
+}
+
+// IsAssetControl returns whether num is either equal to the asset control fork
+// block or greater, from which on issuers can freeze and whitelist holders of
+// assets published with these controls.
+func (c *ChainConfig) IsAssetControl(num *big.Int) bool {
+	return isForked(c.AssetControlBlock, num)
+}
+
+// IsAssetFeePool returns whether num is either equal to the asset fee pool fork
+// block or greater, from which on issuers can fund a pool paying the gas of
+// transactions moving their asset in exchange for the asset.
+func (c *ChainConfig) IsAssetFeePool(num *big.Int) bool {
+	return isForked(c.AssetFeePoolBlock, num)
+}
+
+// IsBatchTransfer returns whether num is either equal to the batch transfer fork
+// block or greater, from which on a single transaction can pay out AOA and
+// assets to many recipients.
+func (c *ChainConfig) IsBatchTransfer(num *big.Int) bool {
+	return isForked(c.BatchTransferBlock, num)
+}
+
+// IsAssetAllowance returns whether num is either equal to the asset allowance fork
+// block or greater, from which on holders can approve spenders of their assets
+// and contracts can spend against the approvals.
+func (c *ChainConfig) IsAssetAllowance(num *big.Int) bool {
+	return isForked(c.AssetAllowanceBlock, num)
+}
+
+// IsAssetPrecompile returns whether num is either equal to the native asset
+// precompile fork block or greater, from which on contracts can query and move
+// native assets through the native asset contract.
+func (c *ChainConfig) IsAssetPrecompile(num *big.Int) bool {
+	return isForked(c.AssetPrecompileBlock, num)
+}
+
+// IsPetersburg returns whether num is either equal to the Petersburg fork block or greater,
+// enabling the CREATE2 and EXTCODEHASH instructions.
+func (c *ChainConfig) IsPetersburg(num *big.Int) bool {
+	return isForked(c.PetersburgBlock, num)
+}
+
+// IsIstanbul returns whether num is either equal to the Istanbul fork block or greater,
+// enabling the CHAINID and SELFBALANCE instructions and the EIP-1884 repricing of
+// the state reading instructions.
+func (c *ChainConfig) IsIstanbul(num *big.Int) bool {
+	return isForked(c.IstanbulBlock, num)
+}
+
+// IsShanghai returns whether num is either equal to the Shanghai fork block or greater,
+// enabling the PUSH0 instruction.
+func (c *ChainConfig) IsShanghai(num *big.Int) bool {
+	return isForked(c.ShanghaiBlock, num)
+}
+
+// IsRegisterCost returns whether num is either equal to the register cost fork block or greater.
+func (c *ChainConfig) IsRegisterCost(num *big.Int) bool {
+	return isForked(c.RegisterCostBlock, num)
+}
+
+// IsDelegatePrecompile returns whether num is either equal to the DPoS precompile
+// fork block or greater.
+func (c *ChainConfig) IsDelegatePrecompile(num *big.Int) bool {
+	return isForked(c.DelegatePrecompileBlock, num)
+}
+
+// RegisterCost returns the balance a delegate has to hold at block num, which
+// is also the deposit kept when it unregisters. Before the register cost fork
+// the original TxGasAgentCreationOld applies, chains without the fork scheduled
+// use the reduced TxGasAgentCreation from genesis. The deposit paid is recorded
+// in the delegate state and paid back as is, whatever the cost at release.
+func (c *ChainConfig) RegisterCost(num *big.Int) *big.Int {
+	cost := TxGasAgentCreation
+	if c.RegisterCostBlock != nil && !c.IsRegisterCost(num) {
+		cost = TxGasAgentCreationOld
+	}
+	registerCost, _ := new(big.Int).SetString(cost, 10)
+	return registerCost
+}
+
 // GasTable returns the gas table corresponding to the current phase .
 //
 // The returned GasTable's fields shouldn't, under any circumstances, be changed.
 func (c *ChainConfig) GasTable(num *big.Int) GasTable {
+	if c.IsIstanbul(num) {
+		return GasTableIstanbul
+	}
 	return GasTableFrontier
 }
 
@@ -187,8 +523,26 @@ func (c *ChainConfig) GasTable(num *big.Int) GasTable {
 // Rules is a one time interface meaning that it shouldn't be used in between transition
 // phases.
 type Rules struct {
-	ChainId     *big.Int
-	IsByzantium bool
+	ChainId              *big.Int
+	IsByzantium          bool
+	IsJail               bool
+	IsSlash              bool
+	IsUnregister         bool
+	IsRandao             bool
+	IsRewardShare        bool
+	IsAssetSupply        bool
+	IsAssetRegistry      bool
+	IsAssetMetadata      bool
+	IsAssetControl       bool
+	IsAssetFeePool       bool
+	IsBatchTransfer      bool
+	IsAssetAllowance     bool
+	IsAssetPrecompile    bool
+	IsPetersburg         bool
+	IsIstanbul           bool
+	IsShanghai           bool
+	IsRegisterCost       bool
+	IsDelegatePrecompile bool
 }
 
 func (c *ChainConfig) Rules(num *big.Int) Rules {
@@ -196,5 +550,26 @@ func (c *ChainConfig) Rules(num *big.Int) Rules {
 	if chainId == nil {
 		chainId = new(big.Int)
 	}
-	return Rules{ChainId: new(big.Int).Set(chainId), IsByzantium: c.IsByzantium(num)}
+	return Rules{
+		ChainId:              new(big.Int).Set(chainId),
+		IsByzantium:          c.IsByzantium(num),
+		IsJail:               c.IsJail(num),
+		IsSlash:              c.IsSlash(num),
+		IsUnregister:         c.IsUnregister(num),
+		IsRandao:             c.IsRandao(num),
+		IsRewardShare:        c.IsRewardShare(num),
+		IsAssetSupply:        c.IsAssetSupply(num),
+		IsAssetRegistry:      c.IsAssetRegistry(num),
+		IsAssetMetadata:      c.IsAssetMetadata(num),
+		IsAssetControl:       c.IsAssetControl(num),
+		IsAssetFeePool:       c.IsAssetFeePool(num),
+		IsBatchTransfer:      c.IsBatchTransfer(num),
+		IsAssetAllowance:     c.IsAssetAllowance(num),
+		IsAssetPrecompile:    c.IsAssetPrecompile(num),
+		IsPetersburg:         c.IsPetersburg(num),
+		IsIstanbul:           c.IsIstanbul(num),
+		IsShanghai:           c.IsShanghai(num),
+		IsRegisterCost:       c.IsRegisterCost(num),
+		IsDelegatePrecompile: c.IsDelegatePrecompile(num),
+	}
 }
@@ -17,6 +17,7 @@
 package params
 
 import (
+	"math"
 	"math/big"
 	"reflect"
 	"testing"
@@ -37,6 +38,12 @@ func TestCheckCompatible(t *testing.T) {
 			head:    9,
 			wantErr: nil,
 		},
+		{
+			stored:  &ChainConfig{JailBlock: big.NewInt(10)},
+			new:     &ChainConfig{JailBlock: big.NewInt(20)},
+			head:    15,
+			wantErr: &ConfigCompatError{What: "Jail fork block", StoredConfig: big.NewInt(10), NewConfig: big.NewInt(20), RewindTo: 9},
+		},
 	}
 
 	for _, test := range tests {
@@ -47,21 +54,65 @@ func TestCheckCompatible(t *testing.T) {
 	}
 }
 
+func TestCheckConfigForkOrder(t *testing.T) {
+	tests := []struct {
+		config  *ChainConfig
+		wantErr bool
+	}{
+		{config: AllDacchainProtocolChanges},
+		{config: &ChainConfig{}},
+		{config: &ChainConfig{AssetAllowanceBlock: big.NewInt(0), PetersburgBlock: big.NewInt(10), IstanbulBlock: big.NewInt(10)}},
+		// independent forks can be scheduled in any order
+		{config: &ChainConfig{JailBlock: big.NewInt(10), AssetSupplyBlock: big.NewInt(0)}},
+		// the evm forks don't depend on the asset allowance fork
+		{config: &ChainConfig{PetersburgBlock: big.NewInt(10)}},
+		{config: &ChainConfig{PetersburgBlock: big.NewInt(0), IstanbulBlock: big.NewInt(0), AssetAllowanceBlock: big.NewInt(10)}},
+		{config: &ChainConfig{IstanbulBlock: big.NewInt(10)}, wantErr: true},
+		{config: &ChainConfig{PetersburgBlock: big.NewInt(10), IstanbulBlock: big.NewInt(5)}, wantErr: true},
+		{config: &ChainConfig{PetersburgBlock: big.NewInt(0), ShanghaiBlock: big.NewInt(0)}, wantErr: true},
+	}
+	for i, test := range tests {
+		if err := test.config.CheckConfigForkOrder(); (err != nil) != test.wantErr {
+			t.Errorf("test %d: error mismatch: have %v, want error %v", i, err, test.wantErr)
+		}
+	}
+}
+
+func TestCheckRewardSchedule(t *testing.T) {
+	tests := []struct {
+		reward  *RewardConfig
+		wantErr bool
+	}{
+		{reward: nil},
+		{reward: &RewardConfig{InitialReward: big.NewInt(1)}},
+		{reward: &RewardConfig{InitialReward: big.NewInt(1), RatioNum: big.NewInt(1), RatioDenom: big.NewInt(2), Period: big.NewInt(MinRewardPeriod)}},
+		{reward: &RewardConfig{}, wantErr: true},
+		{reward: &RewardConfig{InitialReward: big.NewInt(1), RatioNum: big.NewInt(11), RatioDenom: big.NewInt(10), Period: big.NewInt(1)}, wantErr: true},
+		{reward: &RewardConfig{InitialReward: big.NewInt(1), RatioNum: big.NewInt(1), Period: big.NewInt(MinRewardPeriod)}, wantErr: true},
+		{reward: &RewardConfig{InitialReward: big.NewInt(1), RatioNum: big.NewInt(1), RatioDenom: big.NewInt(0), Period: big.NewInt(MinRewardPeriod)}, wantErr: true},
+	}
+	for i, test := range tests {
+		config := &ChainConfig{Reward: test.reward}
+		if err := config.CheckRewardSchedule(); (err != nil) != test.wantErr {
+			t.Errorf("test %d: error mismatch: have %v, want error %v", i, err, test.wantErr)
+		}
+	}
+}
+
 func TestConfigRules(t *testing.T) {
 	c := &ChainConfig{
-		LondonBlock:  new(big.Int),
-		ShanghaiTime: newUint64(450),
+		ShanghaiBlock: big.NewInt(450),
 	}
-	var stamp uint64
-	if r := c.Rules(big.NewInt(1), true, stamp); r.IsShanghai {
-		t.Errorf("expected %v to not be", stamp)
+	var num int64
+	if r := c.Rules(big.NewInt(num)); r.IsShanghai {
+		t.Errorf("expected %v to not be", num)
 	}
-	stamp = 450
-	if r := c.Rules(big.NewInt(1), true, stamp); !r.IsShanghai {
-		t.Errorf("expected %v to be", stamp)
+	num = 450
+	if r := c.Rules(big.NewInt(num)); !r.IsShanghai {
+		t.Errorf("expected %v to be", num)
 	}
-	stamp = math.MaxInt64
-	if r := c.Rules(big.NewInt(1), true, stamp); !r.IsShanghai {
-		t.Errorf("expected %v to be", stamp)
+	num = math.MaxInt64
+	if r := c.Rules(big.NewInt(num)); !r.IsShanghai {
+		t.Errorf("expected %v to be", num)
 	}
 }
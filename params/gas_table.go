@@ -19,6 +19,7 @@ package params
 type GasTable struct {
 	ExtcodeSize uint64
 	ExtcodeCopy uint64
+	ExtcodeHash uint64
 	Balance     uint64
 	SLoad       uint64
 	Calls       uint64
@@ -39,6 +40,7 @@ type GasTable struct {
 var GasTableFrontier = GasTable{
 	ExtcodeSize: 45,
 	ExtcodeCopy: 45,
+	ExtcodeHash: 400,
 	Balance:     25,
 	SLoad:       20,
 	Calls:       45,
@@ -47,3 +49,18 @@ var GasTableFrontier = GasTable{
 
 	CreateBySuicide: 2500,
 }
+
+// GasTableIstanbul contain the gas re-prices of the state reading
+// instructions for the Istanbul phase (EIP-1884).
+var GasTableIstanbul = GasTable{
+	ExtcodeSize: 45,
+	ExtcodeCopy: 45,
+	ExtcodeHash: 700,
+	Balance:     700,
+	SLoad:       800,
+	Calls:       45,
+	Suicide:     350,
+	ExpByte:     4,
+
+	CreateBySuicide: 2500,
+}
@@ -23,4 +23,8 @@ const (
 	// BloomBitsBlocks is the number of blocks a single bloom bit section vector
 	// contains.
 	BloomBitsBlocks uint64 = 4096
+
+	// AssetHolderIndexBlocks is the number of blocks the asset holder index is
+	// updated by at once.
+	AssetHolderIndexBlocks uint64 = 16
 )
@@ -41,12 +41,29 @@ const (
 	LogDataGas             uint64 = 1      // Per byte in a LOG* operation's data.
 	CallStipend            uint64 = 1000   // Free gas given at beginning of call.
 	TxGasAssetPublish      uint64 = 100000 // Gas for publishing an asset.
+	TxGasAssetUpdate       uint64 = 50000  // Gas for updating the metadata of an asset.
+	TxGasBatchTransfer     uint64 = 1500   // Per recipient of a batch transfer, on top of TxGas. New recipients also pay CallNewAccountGas.
 	MaxContractGasLimit    uint64 = 60000000
 	MaxOneContractGasLimit uint64 = 1000000
+	DefaultMaxMissedSlots         = 50 // Consecutive missed slots before a delegate is jailed
+	MinRewardPeriod               = 1000 // Fewest blocks between two steps of a block reward schedule
+	SlashReporterRewardDivisor    = 10 // Share (1/n) of a slashed deposit paid to the evidence reporter, the rest is burned
+	DefaultUnbondingPeriod        = 7 * 24 * 3600 // Seconds a leaving delegate's registration deposit stays locked
+	CommissionDenominator         = 10000 // Delegate commission rates are given in basis points
+	MaxCommissionIncrease         = 500 // Basis points a delegate may raise its commission by at once
+	CommissionCooldown            = 24 * 3600 // Seconds between two commission raises of a delegate
+	MaxBatchTransfers             = 500 // Maximum number of recipients of a single batch transfer
 
 	// Multi-asset
 	BalanceOfGas     uint64 = 50
 	TransferAssetGas uint64 = 550
+	AllowanceGas     uint64 = 50
+	TransferFromGas  uint64 = 860 // TransferAssetGas plus updating the allowance
+	AssetInfoGas     uint64 = 200 // Reading the published information or the supply of an asset
+
+	// DPoS precompile
+	DelegateReadGas uint64 = 200  // Reading the votes of a single account or candidate
+	DelegateListGas uint64 = 2000 // Reading the candidate list or the producer schedule
 
 	Sha3Gas          uint64 = 2    // Once per SHA3 operation.
 	Sha3WordGas      uint64 = 1    // Once per word of the SHA3 operation's data.
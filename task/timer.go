@@ -19,6 +19,7 @@ package task
 import (
 	"container/heap"
 	"context"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"sync"
 	"time"
 )
@@ -111,7 +112,7 @@ func (t *timerType) isRepeat() bool {
 type TimingWheel struct {
 	timeOutChan chan *OnTimeOut
 	timers      timerHeapType
-	ticker      *time.Ticker
+	clock       mclock.Clock
 	wg          *sync.WaitGroup
 	addChan     chan *timerType // add timer in loop
 	cancelChan  chan int64      // cancel timer in loop
@@ -122,10 +123,16 @@ type TimingWheel struct {
 
 // NewTimingWheel returns a *TimingWheel ready for use.
 func NewTimingWheel(ctx context.Context) *TimingWheel {
+	return NewTimingWheelWithClock(ctx, mclock.System{})
+}
+
+// NewTimingWheelWithClock returns a *TimingWheel which reads the time from and
+// ticks on the given clock.
+func NewTimingWheelWithClock(ctx context.Context, clock mclock.Clock) *TimingWheel {
 	timingWheel := &TimingWheel{
 		timeOutChan: make(chan *OnTimeOut, bufferSize),
 		timers:      make(timerHeapType, 0),
-		ticker:      time.NewTicker(tickPeriod),
+		clock:       clock,
 		wg:          &sync.WaitGroup{},
 		addChan:     make(chan *timerType, bufferSize),
 		cancelChan:  make(chan int64, bufferSize),
@@ -186,7 +193,7 @@ func (tw *TimingWheel) getExpired() []*timerType {
 	expired := make([]*timerType, 0)
 	for tw.timers.Len() > 0 {
 		timer := heap.Pop(&tw.timers).(*timerType)
-		elapsed := time.Since(timer.expiration).Seconds()
+		elapsed := tw.clock.Time().Sub(timer.expiration).Seconds()
 		if elapsed > 1.0 {
 
 		}
@@ -207,8 +214,8 @@ func (tw *TimingWheel) update(timers []*timerType) {
 			if t.isRepeat() { // repeatable timer task
 				t.expiration = t.expiration.Add(t.interval)
 
-				if time.Since(t.expiration).Seconds() >= 10.0 {
-					t.expiration = time.Now()
+				if tw.clock.Time().Sub(t.expiration).Seconds() >= 10.0 {
+					t.expiration = tw.clock.Time()
 				}
 				heap.Push(&tw.timers, t)
 			}
@@ -222,13 +229,13 @@ func (tw *TimingWheel) StartOnTimeOut() {
 		case onTimeOut := <-tw.timeOutChan:
 			go onTimeOut.Callback(onTimeOut.Ctx)
 		case <-tw.ctx.Done():
-			tw.ticker.Stop()
 			return
 		}
 	}
 
 }
 func (tw *TimingWheel) start() {
+	tick := tw.clock.After(tickPeriod)
 	for {
 		select {
 		case timerID := <-tw.cancelChan:
@@ -240,13 +247,13 @@ func (tw *TimingWheel) start() {
 		case tw.sizeChan <- tw.timers.Len():
 
 		case <-tw.ctx.Done():
-			tw.ticker.Stop()
 			return
 
 		case timer := <-tw.addChan:
 			heap.Push(&tw.timers, timer)
 
-		case <-tw.ticker.C:
+		case <-tick:
+			tick = tw.clock.After(tickPeriod)
 			timers := tw.getExpired()
 			for _, t := range timers {
 				tw.TimeOutChannel() <- t.timeout
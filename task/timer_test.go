@@ -21,6 +21,7 @@ import (
 
 	"context"
 	"fmt"
+	"github.com/Aurorachain-io/go-aoa/common/mclock"
 	"sync"
 	"time"
 )
@@ -71,3 +72,33 @@ func TestNewTimingWheel(t *testing.T) {
 		-1, onTimeOut4)
 	wb.Wait()
 }
+
+func TestTimingWheelSimulatedClock(t *testing.T) {
+	clock := mclock.NewSimulated(time.Unix(1000, 0))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tw := NewTimingWheelWithClock(ctx, clock)
+
+	fired := make(chan struct{}, 1)
+	tw.AddTimer(time.Unix(1005, 0), -1, &OnTimeOut{
+		Callback: func(ctx context.Context) { fired <- struct{}{} },
+		Ctx:      context.Background(),
+	})
+	for tw.Size() != 1 {
+	}
+	// advance the clock tick by tick up to just before the expiration
+	for i := 0; i < 9; i++ {
+		clock.WaitForTimers(1)
+		clock.Run(tickPeriod)
+	}
+	if size := tw.Size(); size != 1 {
+		t.Fatalf("timer fired early at %v", clock.Time())
+	}
+	clock.WaitForTimers(1)
+	clock.Run(2 * tickPeriod)
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatalf("timer not fired at %v", clock.Time())
+	}
+}
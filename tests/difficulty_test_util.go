@@ -17,10 +17,13 @@
 package tests
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/math"
+	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/params"
 )
 
 //go:generate gencodec -type DifficultyTest -field-override difficultyTestMarshaling -out gen_difficultytest.go
@@ -42,3 +45,14 @@ type difficultyTestMarshaling struct {
 	UncleHash          common.Hash
 	CurrentBlockNumber math.HexOrDecimal64
 }
+
+// Run checks the difficulty of the current block. Dpos blocks all carry the
+// same difficulty, whatever the parent and the chain config.
+func (test *DifficultyTest) Run(config *params.ChainConfig) error {
+	if test.CurrentDifficulty.Cmp(types.BlockDifficult) != 0 {
+		return fmt.Errorf("parent[time %v diff %v unclehash:%x] child[time %v number %v] diff %v != expected %v",
+			test.ParentTimestamp, test.ParentDifficulty, test.UncleHash,
+			test.CurrentTimestamp, test.CurrentBlockNumber, types.BlockDifficult, test.CurrentDifficulty)
+	}
+	return nil
+}
@@ -26,7 +26,30 @@ import (
 // This table defines supported forks and their chain config.
 var Forks = map[string]*params.ChainConfig{
 	"Frontier": {
-		ChainId: big.NewInt(1),
+		ChainId:          big.NewInt(1),
+		MaxElectDelegate: big.NewInt(101),
+		BlockInterval:    big.NewInt(10),
+	},
+	"Petersburg": {
+		ChainId:          big.NewInt(1),
+		MaxElectDelegate: big.NewInt(101),
+		BlockInterval:    big.NewInt(10),
+		PetersburgBlock:  big.NewInt(0),
+	},
+	"Istanbul": {
+		ChainId:          big.NewInt(1),
+		MaxElectDelegate: big.NewInt(101),
+		BlockInterval:    big.NewInt(10),
+		PetersburgBlock:  big.NewInt(0),
+		IstanbulBlock:    big.NewInt(0),
+	},
+	"Shanghai": {
+		ChainId:          big.NewInt(1),
+		MaxElectDelegate: big.NewInt(101),
+		BlockInterval:    big.NewInt(10),
+		PetersburgBlock:  big.NewInt(0),
+		IstanbulBlock:    big.NewInt(0),
+		ShanghaiBlock:    big.NewInt(0),
 	},
 }
 
@@ -23,6 +23,7 @@ import (
 	"math/big"
 	"strings"
 
+	"github.com/Aurorachain-io/go-aoa/aoadb"
 	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/common/hexutil"
 	"github.com/Aurorachain-io/go-aoa/common/math"
@@ -32,7 +33,6 @@ import (
 	"github.com/Aurorachain-io/go-aoa/core/vm"
 	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/crypto/sha3"
-	"github.com/Aurorachain-io/go-aoa/emdb"
 	"github.com/Aurorachain-io/go-aoa/params"
 	"github.com/Aurorachain-io/go-aoa/rlp"
 )
@@ -126,7 +126,7 @@ func (t *StateTest) Run(subtest StateSubtest, vmconfig vm.Config) (*state.StateD
 		return nil, UnsupportedForkError{subtest.Fork}
 	}
 	block, _, _ := t.genesis(config).ToBlock()
-	db, _ := emdb.NewMemDatabase()
+	db, _ := aoadb.NewMemDatabase()
 	statedb := MakePreState(db, t.json.Pre)
 
 	post := t.json.Post[subtest.Fork][subtest.Index]
@@ -158,7 +158,7 @@ func (t *StateTest) gasLimit(subtest StateSubtest) uint64 {
 	return t.json.Tx.GasLimit[t.json.Post[subtest.Fork][subtest.Index].Indexes.Gas]
 }
 
-func MakePreState(db emdb.Database, accounts core.GenesisAlloc) *state.StateDB {
+func MakePreState(db aoadb.Database, accounts core.GenesisAlloc) *state.StateDB {
 	sdb := state.NewDatabase(db)
 	statedb, _ := state.New(common.Hash{}, sdb)
 	for addr, a := range accounts {
@@ -183,6 +183,7 @@ func (t *StateTest) genesis(config *params.ChainConfig) *core.Genesis {
 		Number:    t.json.Env.Number,
 		Timestamp: t.json.Env.Timestamp,
 		Alloc:     t.json.Pre,
+		Agents:    core.GenesisAgents{{Address: t.json.Env.Coinbase.Hex(), Vote: 1, Nickname: "coinbase"}},
 	}
 }
 
@@ -232,7 +233,11 @@ func (tx *stTransaction) toMessage(ps stPostState) (core.Message, error) {
 		return nil, fmt.Errorf("invalid tx data %q", dataHex)
 	}
 
-	msg := types.NewMessage(from, to, tx.Nonce, value, gasLimit, tx.GasPrice, data, true, 0, nil, "", nil, "", "")
+	action := uint64(types.ActionTrans)
+	if to == nil {
+		action = types.ActionCreateContract
+	}
+	msg := types.NewMessage(from, to, tx.Nonce, value, gasLimit, tx.GasPrice, data, true, action, nil, nil, nil, "", "")
 	return msg, nil
 }
 
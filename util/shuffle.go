@@ -18,15 +18,23 @@ package util
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"crypto/sha256"
+	"encoding/binary"
 	"encoding/hex"
+	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
 	"github.com/Aurorachain-io/go-aoa/log"
 	"math"
+	"math/big"
 	"strconv"
 	"time"
 )
 
+// randaoPrefix separates the randao secrets from any other use of a delegate key.
+var randaoPrefix = []byte("aoa randao reveal")
+
 func Shuffle(height int64, delegateNumber int) []int {
 	var truncDelegateList []int
 
@@ -60,6 +68,37 @@ func Shuffle(height int64, delegateNumber int) []int {
 
 }
 
+// ShuffleWithSeed returns a Fisher-Yates permutation of [0, delegateNumber) driven
+// by seed. The swap index of position i is keccak256(seed, i) mod (i+1).
+func ShuffleWithSeed(seed common.Hash, delegateNumber int) []int {
+	list := make([]int, delegateNumber)
+	for i := range list {
+		list[i] = i
+	}
+	var index [8]byte
+	for i := delegateNumber - 1; i > 0; i-- {
+		binary.BigEndian.PutUint64(index[:], uint64(i))
+		r := new(big.Int).SetBytes(crypto.Keccak256(seed[:], index[:]))
+		j := int(r.Mod(r, big.NewInt(int64(i+1))).Int64())
+		list[i], list[j] = list[j], list[i]
+	}
+	return list
+}
+
+// RevealSecret returns the randao secret a delegate commits to in its count-th
+// block and reveals in the next one. It is derived from the delegate key, so
+// the producer doesn't have to keep it until then.
+func RevealSecret(key *ecdsa.PrivateKey, count uint64) common.Hash {
+	var index [8]byte
+	binary.BigEndian.PutUint64(index[:], count)
+	return crypto.Keccak256Hash(randaoPrefix, crypto.FromECDSA(key), index[:])
+}
+
+// MixReveal folds a randao reveal into the running seed.
+func MixReveal(mix common.Hash, reveal []byte) common.Hash {
+	return crypto.Keccak256Hash(mix[:], reveal)
+}
+
 // beginTime: current shuffle time
 // delegateNumber: Max delegate number
 // currentDposList: current top delegates
@@ -80,6 +119,23 @@ func ShuffleNewRound(beginTime int64, maxElectDelegate int, currentDposList []ty
 	return newRoundList
 }
 
+// ShuffleNewRoundWithSeed is ShuffleNewRound with the order taken from
+// ShuffleWithSeed instead of the shuffle time.
+func ShuffleNewRoundWithSeed(beginTime int64, maxElectDelegate int, currentDposList []types.Candidate, blockInterval int64, seed common.Hash) []types.ShuffleDel {
+	if len(currentDposList) < maxElectDelegate {
+		maxElectDelegate = len(currentDposList)
+	}
+	log.Info("shuffle with seed", "beginTime", beginTime, "current delegate", len(currentDposList), "delegateNumber", maxElectDelegate, "seed", seed)
+	var newRoundList []types.ShuffleDel
+	truncDelegateList := ShuffleWithSeed(seed, maxElectDelegate)
+	for index := int64(0); index < int64(maxElectDelegate); index++ {
+		delegateIndex := truncDelegateList[index]
+		workTime := beginTime + index*blockInterval
+		newRoundList = append(newRoundList, types.ShuffleDel{WorkTime: uint64(workTime), Address: currentDposList[delegateIndex].Address, Vote: currentDposList[delegateIndex].Vote, Nickname: currentDposList[delegateIndex].Nickname})
+	}
+	return newRoundList
+}
+
 // get block shuffle time by block time and any shuffleTime,can not get future shuffle time
 func CalShuffleTimeByHeaderTime(nextRoundBeginTime, blockTime, blockInterval, maxElectDelegate int64) int64 {
 	var count int64
@@ -18,7 +18,10 @@ package util
 
 import (
 	"fmt"
+	"github.com/Aurorachain-io/go-aoa/common"
 	"github.com/Aurorachain-io/go-aoa/core/types"
+	"github.com/Aurorachain-io/go-aoa/crypto"
+	"reflect"
 	"testing"
 	"time"
 )
@@ -48,7 +51,7 @@ func TestShuffleNewRound(t *testing.T) {
 	}
 
 	lastBlockTime := time.Now().Unix()
-	newRound := ShuffleNewRound(lastBlockTime, 10, initDelegate)
+	newRound := ShuffleNewRound(lastBlockTime, 10, initDelegate, 10)
 	for _, v := range newRound {
 		fmt.Println(v)
 	}
@@ -56,7 +59,62 @@ func TestShuffleNewRound(t *testing.T) {
 }
 
 func TestCalShuffleTimeByHeaderTime(t *testing.T) {
-	shuffleTime := CalShuffleTimeByHeaderTime(3030, 2040)
+	shuffleTime := CalShuffleTimeByHeaderTime(3030, 2040, 10, 101)
 	fmt.Println(shuffleTime)
 
 }
+
+// Reference vectors of the randao seeded shuffle, any change of the permutation
+// is a consensus change.
+var shuffleWithSeedTests = []struct {
+	seed  common.Hash
+	count int
+	want  []int
+}{
+	{common.Hash{}, 1, []int{0}},
+	{common.Hash{}, 2, []int{1, 0}},
+	{common.Hash{}, 5, []int{1, 3, 2, 4, 0}},
+	{common.Hash{}, 21, []int{10, 3, 2, 17, 7, 0, 1, 15, 8, 18, 6, 20, 4, 12, 5, 11, 14, 13, 9, 16, 19}},
+	{common.HexToHash("0x01"), 5, []int{1, 4, 2, 0, 3}},
+	{common.HexToHash("0x01"), 21, []int{6, 4, 2, 11, 20, 14, 5, 17, 9, 1, 3, 12, 15, 10, 8, 16, 19, 7, 0, 18, 13}},
+	{common.HexToHash("0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"), 5, []int{1, 2, 0, 4, 3}},
+	{common.HexToHash("0xc5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"), 21, []int{15, 18, 9, 12, 17, 3, 19, 4, 6, 10, 20, 8, 14, 13, 7, 1, 16, 5, 11, 2, 0}},
+}
+
+func TestShuffleWithSeed(t *testing.T) {
+	for i, test := range shuffleWithSeedTests {
+		if have := ShuffleWithSeed(test.seed, test.count); !reflect.DeepEqual(have, test.want) {
+			t.Errorf("test %d: permutation mismatch: have %v, want %v", i, have, test.want)
+		}
+	}
+}
+
+func TestShuffleWithSeedPermutation(t *testing.T) {
+	seed := common.Hash{}
+	for n := 0; n <= 101; n++ {
+		seed = MixReveal(seed, []byte{byte(n)})
+		seen := make(map[int]bool)
+		for _, index := range ShuffleWithSeed(seed, n) {
+			if index < 0 || index >= n || seen[index] {
+				t.Fatalf("not a permutation of %d: %v", n, ShuffleWithSeed(seed, n))
+			}
+			seen[index] = true
+		}
+		if len(seen) != n {
+			t.Fatalf("permutation of %d has %d entries", n, len(seen))
+		}
+	}
+}
+
+func TestRandaoHashes(t *testing.T) {
+	key, _ := crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	if have, want := RevealSecret(key, 1), common.HexToHash("0xb75a3723a834cb0d016343d98ca95379d294fb9ad988952c29d59b9386f37fd7"); have != want {
+		t.Errorf("reveal secret mismatch: have %x, want %x", have, want)
+	}
+	if RevealSecret(key, 1) == RevealSecret(key, 2) {
+		t.Errorf("reveal secrets repeat")
+	}
+	if have, want := MixReveal(common.Hash{}, []byte{1, 2, 3}), common.HexToHash("0xae089306fc12d02d7a4bdf508006df45ac448816b312d6a8db56f5a9f2eacbe1"); have != want {
+		t.Errorf("mix mismatch: have %x, want %x", have, want)
+	}
+}